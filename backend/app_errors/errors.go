@@ -1,7 +1,64 @@
 package app_errors
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 var (
-	ErrTodoAlreadyDone = errors.New("cannot update a completed todo")
+	ErrTodoAlreadyDone              = errors.New("cannot update a completed todo")
+	ErrNoBreakdownSteps             = errors.New("no steps could be generated")
+	ErrBreakdownAlreadyAccepted     = errors.New("breakdown has already been accepted")
+	ErrInvalidBreakdownStepIndexes  = errors.New("invalid step indexes")
+	ErrAIQuotaExceeded              = errors.New("ai usage quota exceeded")
+	ErrEmailAlreadyRegistered       = errors.New("email is already registered")
+	ErrRegistrationClosed           = errors.New("registration is closed")
+	ErrInvalidInviteCode            = errors.New("invalid invite code")
+	ErrInvalidRefreshToken          = errors.New("invalid refresh token")
+	ErrRefreshTokenReused           = errors.New("refresh token reuse detected")
+	ErrInvalidCurrentPassword       = errors.New("current password is incorrect")
+	ErrInvalidResetToken            = errors.New("invalid or expired password reset token")
+	ErrInvalidEmailChangeToken      = errors.New("invalid or expired email change token")
+	ErrOIDCInvalidState             = errors.New("invalid or expired oidc login state")
+	ErrOIDCEmailNotVerified         = errors.New("email is not verified by the identity provider")
+	ErrOIDCAccountNotFound          = errors.New("no account is linked to this identity")
+	ErrMFAAlreadyEnabled            = errors.New("two-factor authentication is already enabled")
+	ErrMFANotEnabled                = errors.New("two-factor authentication is not enabled")
+	ErrInvalidMFACode               = errors.New("invalid two-factor authentication code")
+	ErrInvalidMFAToken              = errors.New("invalid or expired mfa token")
+	ErrLoginThrottled               = errors.New("too many failed login attempts")
+	ErrAccountDeletionScheduled     = errors.New("account deletion is already scheduled")
+	ErrAccountDeletionNotScheduled  = errors.New("account deletion is not scheduled")
+	ErrInvalidAccountDeletionToken  = errors.New("invalid or expired account deletion token")
+	ErrAccountOwnsTodoLists         = errors.New("transfer ownership of your shared lists before deleting your account")
+	ErrAccountAdminsWorkspaces      = errors.New("transfer the admin role of your team workspaces before deleting your account")
+	ErrAccountDisabled              = errors.New("account is disabled")
+	ErrCannotModifySelf             = errors.New("cannot perform this action on your own account")
+	ErrCannotImpersonateAdmin       = errors.New("cannot impersonate an administrator")
+	ErrTodoListPermissionDenied     = errors.New("insufficient permission for this list")
+	ErrLastTodoListOwner            = errors.New("a list must have at least one owner")
+	ErrAlreadyTodoListMember        = errors.New("already a member of this list")
+	ErrInvalidTodoListInvite        = errors.New("invalid or expired list invite")
+	ErrTodoListInviteEmailMismatch  = errors.New("this invite was sent to a different email address")
+	ErrNotWorkspaceMember           = errors.New("the user is not a member of this workspace")
+	ErrWorkspacePermissionDenied    = errors.New("insufficient permission for this workspace")
+	ErrLastWorkspaceAdmin           = errors.New("a workspace must have at least one admin")
+	ErrAlreadyWorkspaceMember       = errors.New("already a member of this workspace")
+	ErrPersonalWorkspace            = errors.New("this action is not available for a personal workspace")
+	ErrInvalidWorkspaceInvite       = errors.New("invalid or expired workspace invite")
+	ErrWorkspaceInviteEmailMismatch = errors.New("this invite was sent to a different email address")
 )
+
+// LoginThrottledError は失敗が続いたためにログインの試行を制限している場合のエラー。
+// errors.Is で ErrLoginThrottled と比較でき、RetryAfter 経過後に再試行できる
+type LoginThrottledError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginThrottledError) Error() string {
+	return ErrLoginThrottled.Error()
+}
+
+func (e *LoginThrottledError) Unwrap() error {
+	return ErrLoginThrottled
+}
@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"todo-app/ent"
+	"todo-app/repositories"
 
 	_ "github.com/go-sql-driver/mysql"
 )
@@ -29,6 +30,13 @@ func main() {
 		}
 	}()
 
+	// Todo は個人のワークスペースに作成する
+	member, err := repositories.NewWorkspaceRepository(client).EnsurePersonal(context.Background(), 1)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
 	var todos []Todo
 	for i := 0; i < 1000; i++ {
 		todos = append(todos, Todo{
@@ -40,7 +48,8 @@ func main() {
 		c.
 			SetTitle(todos[i].Title).
 			SetDescription(todos[i].Description).
-			SetUserID(1)
+			SetUserID(1).
+			SetWorkspaceID(member.WorkspaceID)
 	}).Save(context.Background())
 
 	if err != nil {
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+	"todo-app/ent"
+	"todo-app/services"
+	"todo-app/utils"
+)
+
+// Case はデータセットの 1 件。query を now 時点で投げたときに期待する Tool と引数を持つ
+type Case struct {
+	Name     string    `json:"name"`
+	Query    string    `json:"query"`
+	Now      time.Time `json:"now"`
+	Locale   string    `json:"locale,omitempty"`
+	TimeZone string    `json:"time_zone,omitempty"`
+	// Tool が呼び出されないことを期待する場合は空にする
+	ExpectedFunction string                 `json:"expected_function"`
+	ExpectedArgs     map[string]interface{} `json:"expected_args,omitempty"`
+}
+
+// ArgDiff は期待と異なった引数。値はユーザーのタイムゾーンの RFC3339 形式に揃えたもの
+type ArgDiff struct {
+	Key      string
+	Expected interface{}
+	Actual   interface{}
+}
+
+type CaseResult struct {
+	Case         Case
+	FunctionName string
+	Args         map[string]interface{}
+	Text         string
+	Diffs        []ArgDiff
+	Latency      time.Duration
+	Err          error
+}
+
+// Passed は Tool と引数が期待通りだった場合に true を返す
+func (r CaseResult) Passed() bool {
+	return r.Err == nil && r.FunctionName == r.Case.ExpectedFunction && len(r.Diffs) == 0
+}
+
+type Report struct {
+	Results []CaseResult
+}
+
+// Accuracy は Tool と引数の両方が期待通りだった割合
+func (r *Report) Accuracy() float64 {
+	if len(r.Results) == 0 {
+		return 0
+	}
+	passed := 0
+	for _, res := range r.Results {
+		if res.Passed() {
+			passed++
+		}
+	}
+	return float64(passed) / float64(len(r.Results))
+}
+
+// FunctionAccuracy は Tool の選択だけが期待通りだった割合
+func (r *Report) FunctionAccuracy() float64 {
+	if len(r.Results) == 0 {
+		return 0
+	}
+	matched := 0
+	for _, res := range r.Results {
+		if res.Err == nil && res.FunctionName == res.Case.ExpectedFunction {
+			matched++
+		}
+	}
+	return float64(matched) / float64(len(r.Results))
+}
+
+// LatencyPercentile は p (0-100) パーセンタイルの応答時間を返す
+func (r *Report) LatencyPercentile(p float64) time.Duration {
+	if len(r.Results) == 0 {
+		return 0
+	}
+	latencies := make([]time.Duration, len(r.Results))
+	for i, res := range r.Results {
+		latencies[i] = res.Latency
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+	// nearest-rank 法
+	rank := int(p/100*float64(len(latencies))+0.5) - 1
+	rank = max(0, min(rank, len(latencies)-1))
+	return latencies[rank]
+}
+
+func LoadDataset(path string) ([]Case, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cases []Case
+	if err := json.Unmarshal(b, &cases); err != nil {
+		return nil, fmt.Errorf("invalid dataset: %w", err)
+	}
+	for i, c := range cases {
+		if c.Query == "" || c.Now.IsZero() {
+			return nil, fmt.Errorf("invalid dataset[%d]: query and now are required", i)
+		}
+	}
+	return cases, nil
+}
+
+type Evaluator struct {
+	ai     *services.AIService
+	client utils.IGenAIClient
+}
+
+func NewEvaluator(client utils.IGenAIClient) *Evaluator {
+	// Tool の選択と引数の解決だけを評価するため、ToDo を検索するリポジトリは使わない
+	return &Evaluator{ai: services.NewAIService(nil), client: client}
+}
+
+// Run はデータセットの各ケースを順に評価する。応答時間を正しく測るため並列には実行しない
+func (e *Evaluator) Run(ctx context.Context, cases []Case) *Report {
+	report := &Report{Results: make([]CaseResult, 0, len(cases))}
+	for _, c := range cases {
+		report.Results = append(report.Results, e.evaluate(ctx, c))
+	}
+	return report
+}
+
+func (e *Evaluator) evaluate(ctx context.Context, c Case) CaseResult {
+	result := CaseResult{Case: c}
+
+	ctx = utils.WithUser(ctx, &ent.User{Locale: c.Locale, TimeZone: c.TimeZone})
+	ctx = services.WithPromptNow(ctx, c.Now)
+
+	start := time.Now()
+	decided, err := e.ai.DecideFilterTodosFunction(ctx, e.client, c.Query)
+	result.Latency = time.Since(start)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	if decided != nil {
+		result.FunctionName = decided.FunctionName
+		result.Args = decided.Args
+		result.Text = decided.Text
+	}
+
+	if c.ExpectedFunction == "" || result.FunctionName != c.ExpectedFunction {
+		return result
+	}
+
+	expected, err := e.ai.ResolveFilterArgs(ctx, c.ExpectedFunction, c.ExpectedArgs)
+	if err != nil {
+		result.Err = fmt.Errorf("invalid expected_args: %w", err)
+		return result
+	}
+	actual, err := e.ai.ResolveFilterArgs(ctx, result.FunctionName, result.Args)
+	if err != nil {
+		result.Err = fmt.Errorf("invalid args: %w", err)
+		return result
+	}
+	result.Diffs = diffArgs(expected, actual)
+	return result
+}
+
+// diffArgs は解決済みの引数を比較する。
+// 解決済みの日時は同じタイムゾーンの文字列に揃っているため、同じ時刻であれば表記も一致する
+func diffArgs(expected, actual map[string]interface{}) []ArgDiff {
+	keys := make([]string, 0, len(expected))
+	for k := range expected {
+		keys = append(keys, k)
+	}
+	for k := range actual {
+		if _, ok := expected[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var diffs []ArgDiff
+	for _, k := range keys {
+		if expected[k] != actual[k] {
+			diffs = append(diffs, ArgDiff{Key: k, Expected: expected[k], Actual: actual[k]})
+		}
+	}
+	return diffs
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"todo-app/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genai"
+)
+
+type failingGenAIClient struct{}
+
+func (failingGenAIClient) GenerateContent(ctx context.Context, model string, contents []*genai.Content, config *genai.GenerateContentConfig) (*genai.GenerateContentResponse, error) {
+	return nil, errors.New("unavailable")
+}
+
+// データセットと固定応答のスタブで評価し、プロンプトや引数の解決が変わって結果がずれた場合に検知する
+func TestEvaluator_Dataset(t *testing.T) {
+	cases, err := LoadDataset("testdata/dataset.json")
+	require.NoError(t, err)
+	client, err := utils.LoadFakeGenAIClient("testdata/canned_responses.json")
+	require.NoError(t, err)
+
+	report := NewEvaluator(client).Run(context.Background(), cases)
+
+	for _, res := range report.Results {
+		assert.True(t, res.Passed(), "%s: function=%q diffs=%v err=%v", res.Case.Name, res.FunctionName, res.Diffs, res.Err)
+	}
+	assert.Equal(t, 1.0, report.Accuracy())
+}
+
+func TestEvaluator_Run(t *testing.T) {
+	now := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+	c := Case{
+		Name:             "yesterday",
+		Query:            "昨日完了したタスク",
+		Now:              now,
+		TimeZone:         "Asia/Tokyo",
+		ExpectedFunction: "ListTodosByDoneAt",
+		ExpectedArgs: map[string]interface{}{
+			"done_from": "2026-03-03T00:00:00+09:00",
+			"done_to":   "2026-03-03T23:59:59+09:00",
+		},
+	}
+
+	t.Run("引数の差分を報告する", func(t *testing.T) {
+		client, err := utils.NewFakeGenAIClient([]utils.FakeAIRule{{
+			Pattern: "昨日",
+			FunctionCalls: []*genai.FunctionCall{{
+				Name: "ListTodosByDoneAt",
+				Args: map[string]interface{}{
+					"done_from": "2026-03-03T00:00:00+09:00",
+					"done_to":   "2026-03-04T00:00:00+09:00",
+				},
+			}},
+		}})
+		require.NoError(t, err)
+
+		report := NewEvaluator(client).Run(context.Background(), []Case{c})
+
+		res := report.Results[0]
+		assert.False(t, res.Passed())
+		assert.Equal(t, []ArgDiff{{Key: "done_to", Expected: "2026-03-03T23:59:59+09:00", Actual: "2026-03-04T00:00:00+09:00"}}, res.Diffs)
+		assert.Equal(t, 1.0, report.FunctionAccuracy())
+		assert.Equal(t, 0.0, report.Accuracy())
+	})
+
+	t.Run("Tool が呼び出されなかった場合は不正解とする", func(t *testing.T) {
+		client, err := utils.NewFakeGenAIClient([]utils.FakeAIRule{{Pattern: "", Text: "対応できる Tool がありません"}})
+		require.NoError(t, err)
+
+		report := NewEvaluator(client).Run(context.Background(), []Case{c})
+
+		res := report.Results[0]
+		assert.False(t, res.Passed())
+		assert.Equal(t, "", res.FunctionName)
+		assert.Equal(t, "対応できる Tool がありません", res.Text)
+		assert.Equal(t, 0.0, report.FunctionAccuracy())
+	})
+
+	t.Run("クライアントのエラーは不正解として記録する", func(t *testing.T) {
+		report := NewEvaluator(failingGenAIClient{}).Run(context.Background(), []Case{c})
+
+		res := report.Results[0]
+		assert.False(t, res.Passed())
+		assert.EqualError(t, res.Err, "unavailable")
+	})
+}
+
+func TestReport_LatencyPercentile(t *testing.T) {
+	report := &Report{}
+	for i := 1; i <= 20; i++ {
+		report.Results = append(report.Results, CaseResult{Latency: time.Duration(i) * time.Millisecond})
+	}
+
+	assert.Equal(t, 10*time.Millisecond, report.LatencyPercentile(50))
+	assert.Equal(t, 19*time.Millisecond, report.LatencyPercentile(95))
+	assert.Equal(t, time.Duration(0), (&Report{}).LatencyPercentile(50))
+}
@@ -0,0 +1,91 @@
+// eval_ai_filter は AI フィルタのデータセットを IGenAIClient に投げ、Tool の選択と引数の正解率を集計する。
+//
+// backend ディレクトリで実行する:
+//
+//	go run ./cmds/eval_ai_filter                      # AI_CLIENT の設定に従ったクライアントで評価する
+//	go run ./cmds/eval_ai_filter -stub cmds/eval_ai_filter/testdata/canned_responses.json
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+	"todo-app/utils"
+
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	datasetPath := flag.String("dataset", "cmds/eval_ai_filter/testdata/dataset.json", "評価に使うデータセット")
+	stubPath := flag.String("stub", "", "指定した場合、API を呼び出さずにこのルール表 (FakeGenAIClient 形式) の応答で評価する")
+	envFile := flag.String("env", "envs/local.env", "読み込む env ファイル")
+	minAccuracy := flag.Float64("min-accuracy", 0, "正解率がこの値 (0-1) を下回った場合に終了コード 1 で終了する")
+	flag.Parse()
+
+	if err := godotenv.Load(*envFile); err != nil && !os.IsNotExist(err) {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	cases, err := LoadDataset(*datasetPath)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	ctx := context.Background()
+	var client utils.IGenAIClient
+	if *stubPath != "" {
+		client, err = utils.LoadFakeGenAIClient(*stubPath)
+	} else {
+		client, err = utils.NewAIFactory().GetGeminiClient(ctx)
+	}
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	report := NewEvaluator(client).Run(ctx, cases)
+	printReport(report)
+
+	if report.Accuracy() < *minAccuracy {
+		fmt.Printf("accuracy %.3f is below %.3f\n", report.Accuracy(), *minAccuracy)
+		os.Exit(1)
+	}
+}
+
+func printReport(report *Report) {
+	for _, res := range report.Results {
+		status := "PASS"
+		if !res.Passed() {
+			status = "FAIL"
+		}
+		fmt.Printf("%s %-40s %8s  %s\n", status, res.Case.Name, res.Latency.Round(time.Millisecond), res.Case.Query)
+		if res.Passed() {
+			continue
+		}
+
+		if res.Err != nil {
+			fmt.Printf("    error: %v\n", res.Err)
+			continue
+		}
+		if res.FunctionName != res.Case.ExpectedFunction {
+			fmt.Printf("    function: expected %q, got %q\n", res.Case.ExpectedFunction, res.FunctionName)
+			if res.Text != "" {
+				fmt.Printf("    text: %s\n", res.Text)
+			}
+		}
+		for _, d := range res.Diffs {
+			fmt.Printf("    %s: expected %v, got %v\n", d.Key, d.Expected, d.Actual)
+		}
+	}
+
+	fmt.Println()
+	fmt.Printf("cases:             %d\n", len(report.Results))
+	fmt.Printf("accuracy:          %.3f\n", report.Accuracy())
+	fmt.Printf("function accuracy: %.3f\n", report.FunctionAccuracy())
+	fmt.Printf("latency p50:       %s\n", report.LatencyPercentile(50).Round(time.Millisecond))
+	fmt.Printf("latency p95:       %s\n", report.LatencyPercentile(95).Round(time.Millisecond))
+}
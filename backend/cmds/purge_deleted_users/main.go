@@ -0,0 +1,43 @@
+// purge_deleted_users は退会の猶予期間が過ぎたユーザーを、紐づくデータごと完全に削除する。
+//
+// cron などで定期的に、backend ディレクトリで実行する:
+//
+//	go run ./cmds/purge_deleted_users
+//	go run ./cmds/purge_deleted_users -env envs/production.env
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+	"todo-app/providers"
+	"todo-app/repositories"
+
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	envFile := flag.String("env", "envs/local.env", "読み込む env ファイル")
+	flag.Parse()
+
+	if err := godotenv.Load(*envFile); err != nil && !os.IsNotExist(err) {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	client, cleanup, err := providers.NewEntClient()
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	defer cleanup()
+
+	n, err := repositories.NewUserRepository(client).DeleteScheduled(context.Background(), time.Now())
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	fmt.Printf("deleted %d users\n", n)
+}
@@ -0,0 +1,53 @@
+// set_user_role はユーザーの権限を変更する。最初の管理者を作る場合などに、backend ディレクトリで実行する:
+//
+//	go run ./cmds/set_user_role -email admin@example.com -role admin
+//	go run ./cmds/set_user_role -email admin@example.com -role user -env envs/production.env
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"todo-app/providers"
+	"todo-app/repositories"
+
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	envFile := flag.String("env", "envs/local.env", "読み込む env ファイル")
+	email := flag.String("email", "", "権限を変更するユーザーのメールアドレス")
+	role := flag.String("role", repositories.UserRoleAdmin, "設定する権限 (user / admin)")
+	flag.Parse()
+
+	if *email == "" || (*role != repositories.UserRoleUser && *role != repositories.UserRoleAdmin) {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := godotenv.Load(*envFile); err != nil && !os.IsNotExist(err) {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	client, cleanup, err := providers.NewEntClient()
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := repositories.NewUserRepository(client)
+	u, err := repo.FindByEmail(ctx, *email)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if _, err := repo.SetRole(ctx, u.ID, *role); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	fmt.Printf("set role of %s to %s\n", u.Email, *role)
+}
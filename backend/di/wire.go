@@ -23,18 +23,82 @@ var todoSet = wire.NewSet(
 	wire.Bind(new(repositories.ITodoRepository), new(*repositories.TodoRepository)),
 	repositories.NewTodoFilterHistoryRepository,
 	wire.Bind(new(repositories.ITodoFilterHistoryRepository), new(*repositories.TodoFilterHistoryRepository)),
+	repositories.NewTodoBreakdownRepository,
+	wire.Bind(new(repositories.ITodoBreakdownRepository), new(*repositories.TodoBreakdownRepository)),
+	repositories.NewTodoSummaryRepository,
+	wire.Bind(new(repositories.ITodoSummaryRepository), new(*repositories.TodoSummaryRepository)),
+	repositories.NewTodoEmbeddingRepository,
+	wire.Bind(new(repositories.ITodoEmbeddingRepository), new(*repositories.TodoEmbeddingRepository)),
+	repositories.NewTodoListRepository,
+	wire.Bind(new(repositories.ITodoListRepository), new(*repositories.TodoListRepository)),
+	repositories.NewTodoListInviteRepository,
+	wire.Bind(new(repositories.ITodoListInviteRepository), new(*repositories.TodoListInviteRepository)),
+	repositories.NewWorkspaceRepository,
+	wire.Bind(new(repositories.IWorkspaceRepository), new(*repositories.WorkspaceRepository)),
+	repositories.NewWorkspaceInviteRepository,
+	wire.Bind(new(repositories.IWorkspaceInviteRepository), new(*repositories.WorkspaceInviteRepository)),
 	services.NewTodoService,
 	services.NewAIService,
+	services.NewTodoBreakdownService,
+	services.NewTodoSummaryService,
+	services.NewTodoEmbeddingService,
 	services.NewTodoFilterHistoryService,
 	wire.Bind(new(services.ITodoFilterHistoryService), new(*services.TodoFilterHistoryService)),
+	services.NewTodoListService,
+	services.NewWorkspaceService,
 	handlers.NewTodoHandler,
+	handlers.NewTodoListHandler,
+	handlers.NewWorkspaceHandler,
 	routes.NewTodoRouter,
+	routes.NewTodoListRouter,
+	routes.NewWorkspaceRouter,
+	middleware.NewWorkspaceMiddleware,
+)
+
+// me
+var meSet = wire.NewSet(
+	repositories.NewAIUsageRepository,
+	wire.Bind(new(repositories.IAIUsageRepository), new(*repositories.AIUsageRepository)),
+	services.NewAIUsageService,
+	services.NewMeteredAIFactory,
+	services.NewMeteredEmbedderFactory,
+	handlers.NewMeHandler,
+	routes.NewMeRouter,
 )
 
 // auth
 var authSet = wire.NewSet(
 	repositories.NewUserRepository,
 	wire.Bind(new(repositories.IUserRepository), new(*repositories.UserRepository)),
+	repositories.NewSessionRepository,
+	wire.Bind(new(repositories.ISessionRepository), new(*repositories.SessionRepository)),
+	repositories.NewRefreshTokenRepository,
+	wire.Bind(new(repositories.IRefreshTokenRepository), new(*repositories.RefreshTokenRepository)),
+	repositories.NewPasswordResetTokenRepository,
+	wire.Bind(new(repositories.IPasswordResetTokenRepository), new(*repositories.PasswordResetTokenRepository)),
+	repositories.NewPersonalAccessTokenRepository,
+	wire.Bind(new(repositories.IPersonalAccessTokenRepository), new(*repositories.PersonalAccessTokenRepository)),
+	services.NewSessionService,
+	services.NewPasswordService,
+	repositories.NewEmailChangeTokenRepository,
+	wire.Bind(new(repositories.IEmailChangeTokenRepository), new(*repositories.EmailChangeTokenRepository)),
+	repositories.NewAccountDeletionTokenRepository,
+	wire.Bind(new(repositories.IAccountDeletionTokenRepository), new(*repositories.AccountDeletionTokenRepository)),
+	services.NewProfileService,
+	repositories.NewPersonalDataRepository,
+	wire.Bind(new(repositories.IPersonalDataRepository), new(*repositories.PersonalDataRepository)),
+	services.NewAccountService,
+	services.NewPersonalAccessTokenService,
+	repositories.NewUserIdentityRepository,
+	wire.Bind(new(repositories.IUserIdentityRepository), new(*repositories.UserIdentityRepository)),
+	services.NewOIDCService,
+	handlers.NewOIDCHandler,
+	repositories.NewMFARepository,
+	wire.Bind(new(repositories.IMFARepository), new(*repositories.MFARepository)),
+	repositories.NewLoginAttemptRepository,
+	wire.Bind(new(repositories.ILoginAttemptRepository), new(*repositories.LoginAttemptRepository)),
+	services.NewLoginThrottle,
+	services.NewMFAService,
 	services.NewAuthService,
 	wire.Bind(new(services.IAuthService), new(*services.AuthService)),
 	handlers.NewAuthHandler,
@@ -42,6 +106,18 @@ var authSet = wire.NewSet(
 	middleware.NewAuthMiddleware,
 )
 
+// admin
+var adminSet = wire.NewSet(
+	repositories.NewAdminRepository,
+	wire.Bind(new(repositories.IAdminRepository), new(*repositories.AdminRepository)),
+	repositories.NewAdminAuditLogRepository,
+	wire.Bind(new(repositories.IAdminAuditLogRepository), new(*repositories.AdminAuditLogRepository)),
+	services.NewAdminService,
+	handlers.NewAdminHandler,
+	routes.NewAdminRouter,
+	middleware.NewAdminAuditMiddleware,
+)
+
 // app
 var appSet = wire.NewSet(
 	providers.NewEntClient,
@@ -63,18 +139,30 @@ func NewApp(e *echo.Echo, r *routes.Router) *App {
 func InitializeApp() (*App, func(), error) {
 	wire.Build(
 		todoSet,
+		meSet,
 		authSet,
+		adminSet,
 		appSet,
 		utils.NewAIFactory,
+		utils.NewEmbedderFactory,
+		utils.NewMailer,
+		utils.NewOIDCRegistry,
+		utils.NewJWTKeyManagerFromEnv,
 	)
 	return &App{}, nil, nil
 }
 
-func InitializeTestApp(e *echo.Echo, client *ent.Client, aiFactory utils.IAIFactory) (*App, error) {
+func InitializeTestApp(e *echo.Echo, client *ent.Client, aiFactory utils.IGenAIClientFactory) (*App, error) {
 	wire.Build(
 		todoSet,
+		meSet,
 		authSet,
+		adminSet,
 		routes.NewRouter,
+		utils.NewLocalEmbedderFactory,
+		utils.NewMailer,
+		utils.NewOIDCRegistry,
+		utils.NewDevJWTKeyManager,
 		NewLogger,
 		NewApp,
 	)
@@ -29,40 +29,162 @@ func InitializeApp() (*App, func(), error) {
 		return nil, nil, err
 	}
 	todoRepository := repositories.NewTodoRepository(client, logger)
-	todoService := services.NewTodoService(client, logger, todoRepository)
+	todoListRepository := repositories.NewTodoListRepository(client)
+	todoService := services.NewTodoService(client, logger, todoRepository, todoListRepository)
 	todoFilterHistoryRepository := repositories.NewTodoFilterHistoryRepository(client)
-	todoFilterHistoryService := services.NewTodoFilterHistoryService(todoFilterHistoryRepository, logger)
 	aiService := services.NewAIService(todoRepository)
-	iaiFactory := utils.NewAIFactory()
-	todoHandler := handlers.NewTodoHandler(logger, todoService, todoFilterHistoryService, aiService, iaiFactory)
+	iGenAIClientFactory := utils.NewAIFactory()
+	aiUsageRepository := repositories.NewAIUsageRepository(client)
+	aiUsageService := services.NewAIUsageService(logger, aiUsageRepository)
+	iaiFactory := services.NewMeteredAIFactory(iGenAIClientFactory, aiUsageService)
+	todoFilterHistoryService := services.NewTodoFilterHistoryService(todoFilterHistoryRepository, logger, aiService, iaiFactory)
+	todoBreakdownRepository := repositories.NewTodoBreakdownRepository(client)
+	todoBreakdownService := services.NewTodoBreakdownService(client, logger, todoRepository, todoBreakdownRepository, aiService)
+	todoSummaryRepository := repositories.NewTodoSummaryRepository(client)
+	todoSummaryService := services.NewTodoSummaryService(logger, todoRepository, todoSummaryRepository, aiService, iaiFactory)
+	todoEmbeddingRepository := repositories.NewTodoEmbeddingRepository(client)
+	iEmbeddingClientFactory := utils.NewEmbedderFactory()
+	iEmbedderFactory := services.NewMeteredEmbedderFactory(iEmbeddingClientFactory, aiUsageService)
+	todoEmbeddingService := services.NewTodoEmbeddingService(logger, todoEmbeddingRepository, iEmbedderFactory)
+	todoHandler := handlers.NewTodoHandler(logger, todoService, todoFilterHistoryService, aiService, todoBreakdownService, todoSummaryService, todoEmbeddingService, iaiFactory)
 	todoRouter := routes.NewTodoRouter(todoHandler)
+	todoListInviteRepository := repositories.NewTodoListInviteRepository(client)
+	workspaceRepository := repositories.NewWorkspaceRepository(client)
+	iMailer, err := utils.NewMailer(logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	todoListService := services.NewTodoListService(client, logger, todoListRepository, todoListInviteRepository, workspaceRepository, iMailer)
+	todoListHandler := handlers.NewTodoListHandler(logger, todoListService)
+	todoListRouter := routes.NewTodoListRouter(todoListHandler)
+	workspaceInviteRepository := repositories.NewWorkspaceInviteRepository(client)
+	workspaceService := services.NewWorkspaceService(client, logger, workspaceRepository, workspaceInviteRepository, iMailer)
+	workspaceHandler := handlers.NewWorkspaceHandler(logger, workspaceService)
+	workspaceRouter := routes.NewWorkspaceRouter(workspaceHandler)
 	userRepository := repositories.NewUserRepository(client)
-	authService := services.NewAuthService(userRepository)
-	authHandler := handlers.NewAuthHandler(logger, authService)
-	authRouter := routes.NewAuthRouter(authHandler)
-	authMiddleware := middleware.NewAuthMiddleware(userRepository)
-	router := routes.NewRouter(todoRouter, authRouter, authMiddleware)
+	sessionRepository := repositories.NewSessionRepository(client)
+	refreshTokenRepository := repositories.NewRefreshTokenRepository(client)
+	mfaRepository := repositories.NewMFARepository(client)
+	loginAttemptRepository := repositories.NewLoginAttemptRepository(client)
+	loginThrottle := services.NewLoginThrottle(loginAttemptRepository)
+	jwtKeyManager, err := utils.NewJWTKeyManagerFromEnv(logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	authService := services.NewAuthService(userRepository, sessionRepository, refreshTokenRepository, mfaRepository, loginThrottle, jwtKeyManager)
+	sessionService := services.NewSessionService(sessionRepository)
+	personalAccessTokenRepository := repositories.NewPersonalAccessTokenRepository(client)
+	passwordResetTokenRepository := repositories.NewPasswordResetTokenRepository(client)
+	passwordService := services.NewPasswordService(logger, userRepository, sessionRepository, personalAccessTokenRepository, passwordResetTokenRepository, iMailer)
+	emailChangeTokenRepository := repositories.NewEmailChangeTokenRepository(client)
+	profileService := services.NewProfileService(userRepository, sessionRepository, personalAccessTokenRepository, emailChangeTokenRepository, iMailer)
+	authHandler := handlers.NewAuthHandler(logger, authService, sessionService, passwordService, profileService)
+	oidcRegistry, err := utils.NewOIDCRegistry()
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	userIdentityRepository := repositories.NewUserIdentityRepository(client)
+	oidcService := services.NewOIDCService(logger, oidcRegistry, userRepository, userIdentityRepository, sessionRepository, personalAccessTokenRepository, mfaRepository, authService, jwtKeyManager)
+	oidcHandler := handlers.NewOIDCHandler(logger, oidcService)
+	authRouter := routes.NewAuthRouter(authHandler, oidcHandler)
+	personalAccessTokenService := services.NewPersonalAccessTokenService(personalAccessTokenRepository)
+	mfaService := services.NewMFAService(client, mfaRepository)
+	personalDataRepository := repositories.NewPersonalDataRepository(client)
+	accountDeletionTokenRepository := repositories.NewAccountDeletionTokenRepository(client)
+	accountService := services.NewAccountService(logger, userRepository, sessionRepository, personalDataRepository, accountDeletionTokenRepository, todoListRepository, workspaceRepository, profileService, iMailer)
+	meHandler := handlers.NewMeHandler(logger, aiUsageService, passwordService, personalAccessTokenService, mfaService, profileService, accountService)
+	meRouter := routes.NewMeRouter(meHandler)
+	adminRepository := repositories.NewAdminRepository(client)
+	adminAuditLogRepository := repositories.NewAdminAuditLogRepository(client)
+	adminService := services.NewAdminService(logger, userRepository, adminRepository, adminAuditLogRepository, sessionRepository, passwordService, authService)
+	adminHandler := handlers.NewAdminHandler(logger, adminService)
+	adminRouter := routes.NewAdminRouter(adminHandler)
+	authMiddleware := middleware.NewAuthMiddleware(userRepository, sessionRepository, personalAccessTokenService, jwtKeyManager)
+	adminAuditMiddleware := middleware.NewAdminAuditMiddleware(adminAuditLogRepository)
+	workspaceMiddleware := middleware.NewWorkspaceMiddleware(workspaceRepository)
+	router := routes.NewRouter(todoRouter, todoListRouter, workspaceRouter, authRouter, meRouter, adminRouter, authMiddleware, adminAuditMiddleware, workspaceMiddleware)
 	app := NewApp(echoEcho, router)
 	return app, func() {
 		cleanup()
 	}, nil
 }
 
-func InitializeTestApp(e *echo.Echo, client *ent.Client, aiFactory utils.IAIFactory) (*App, error) {
+func InitializeTestApp(e *echo.Echo, client *ent.Client, aiFactory utils.IGenAIClientFactory) (*App, error) {
 	logger := NewLogger()
 	todoRepository := repositories.NewTodoRepository(client, logger)
-	todoService := services.NewTodoService(client, logger, todoRepository)
+	todoListRepository := repositories.NewTodoListRepository(client)
+	todoService := services.NewTodoService(client, logger, todoRepository, todoListRepository)
 	todoFilterHistoryRepository := repositories.NewTodoFilterHistoryRepository(client)
-	todoFilterHistoryService := services.NewTodoFilterHistoryService(todoFilterHistoryRepository, logger)
 	aiService := services.NewAIService(todoRepository)
-	todoHandler := handlers.NewTodoHandler(logger, todoService, todoFilterHistoryService, aiService, aiFactory)
+	aiUsageRepository := repositories.NewAIUsageRepository(client)
+	aiUsageService := services.NewAIUsageService(logger, aiUsageRepository)
+	iaiFactory := services.NewMeteredAIFactory(aiFactory, aiUsageService)
+	todoFilterHistoryService := services.NewTodoFilterHistoryService(todoFilterHistoryRepository, logger, aiService, iaiFactory)
+	todoBreakdownRepository := repositories.NewTodoBreakdownRepository(client)
+	todoBreakdownService := services.NewTodoBreakdownService(client, logger, todoRepository, todoBreakdownRepository, aiService)
+	todoSummaryRepository := repositories.NewTodoSummaryRepository(client)
+	todoSummaryService := services.NewTodoSummaryService(logger, todoRepository, todoSummaryRepository, aiService, iaiFactory)
+	todoEmbeddingRepository := repositories.NewTodoEmbeddingRepository(client)
+	iEmbeddingClientFactory := utils.NewLocalEmbedderFactory()
+	iEmbedderFactory := services.NewMeteredEmbedderFactory(iEmbeddingClientFactory, aiUsageService)
+	todoEmbeddingService := services.NewTodoEmbeddingService(logger, todoEmbeddingRepository, iEmbedderFactory)
+	todoHandler := handlers.NewTodoHandler(logger, todoService, todoFilterHistoryService, aiService, todoBreakdownService, todoSummaryService, todoEmbeddingService, iaiFactory)
 	todoRouter := routes.NewTodoRouter(todoHandler)
+	todoListInviteRepository := repositories.NewTodoListInviteRepository(client)
+	workspaceRepository := repositories.NewWorkspaceRepository(client)
+	iMailer, err := utils.NewMailer(logger)
+	if err != nil {
+		return nil, err
+	}
+	todoListService := services.NewTodoListService(client, logger, todoListRepository, todoListInviteRepository, workspaceRepository, iMailer)
+	todoListHandler := handlers.NewTodoListHandler(logger, todoListService)
+	todoListRouter := routes.NewTodoListRouter(todoListHandler)
+	workspaceInviteRepository := repositories.NewWorkspaceInviteRepository(client)
+	workspaceService := services.NewWorkspaceService(client, logger, workspaceRepository, workspaceInviteRepository, iMailer)
+	workspaceHandler := handlers.NewWorkspaceHandler(logger, workspaceService)
+	workspaceRouter := routes.NewWorkspaceRouter(workspaceHandler)
 	userRepository := repositories.NewUserRepository(client)
-	authService := services.NewAuthService(userRepository)
-	authHandler := handlers.NewAuthHandler(logger, authService)
-	authRouter := routes.NewAuthRouter(authHandler)
-	authMiddleware := middleware.NewAuthMiddleware(userRepository)
-	router := routes.NewRouter(todoRouter, authRouter, authMiddleware)
+	sessionRepository := repositories.NewSessionRepository(client)
+	refreshTokenRepository := repositories.NewRefreshTokenRepository(client)
+	mfaRepository := repositories.NewMFARepository(client)
+	loginAttemptRepository := repositories.NewLoginAttemptRepository(client)
+	loginThrottle := services.NewLoginThrottle(loginAttemptRepository)
+	jwtKeyManager := utils.NewDevJWTKeyManager()
+	authService := services.NewAuthService(userRepository, sessionRepository, refreshTokenRepository, mfaRepository, loginThrottle, jwtKeyManager)
+	sessionService := services.NewSessionService(sessionRepository)
+	personalAccessTokenRepository := repositories.NewPersonalAccessTokenRepository(client)
+	passwordResetTokenRepository := repositories.NewPasswordResetTokenRepository(client)
+	passwordService := services.NewPasswordService(logger, userRepository, sessionRepository, personalAccessTokenRepository, passwordResetTokenRepository, iMailer)
+	emailChangeTokenRepository := repositories.NewEmailChangeTokenRepository(client)
+	profileService := services.NewProfileService(userRepository, sessionRepository, personalAccessTokenRepository, emailChangeTokenRepository, iMailer)
+	authHandler := handlers.NewAuthHandler(logger, authService, sessionService, passwordService, profileService)
+	oidcRegistry, err := utils.NewOIDCRegistry()
+	if err != nil {
+		return nil, err
+	}
+	userIdentityRepository := repositories.NewUserIdentityRepository(client)
+	oidcService := services.NewOIDCService(logger, oidcRegistry, userRepository, userIdentityRepository, sessionRepository, personalAccessTokenRepository, mfaRepository, authService, jwtKeyManager)
+	oidcHandler := handlers.NewOIDCHandler(logger, oidcService)
+	authRouter := routes.NewAuthRouter(authHandler, oidcHandler)
+	personalAccessTokenService := services.NewPersonalAccessTokenService(personalAccessTokenRepository)
+	mfaService := services.NewMFAService(client, mfaRepository)
+	personalDataRepository := repositories.NewPersonalDataRepository(client)
+	accountDeletionTokenRepository := repositories.NewAccountDeletionTokenRepository(client)
+	accountService := services.NewAccountService(logger, userRepository, sessionRepository, personalDataRepository, accountDeletionTokenRepository, todoListRepository, workspaceRepository, profileService, iMailer)
+	meHandler := handlers.NewMeHandler(logger, aiUsageService, passwordService, personalAccessTokenService, mfaService, profileService, accountService)
+	meRouter := routes.NewMeRouter(meHandler)
+	adminRepository := repositories.NewAdminRepository(client)
+	adminAuditLogRepository := repositories.NewAdminAuditLogRepository(client)
+	adminService := services.NewAdminService(logger, userRepository, adminRepository, adminAuditLogRepository, sessionRepository, passwordService, authService)
+	adminHandler := handlers.NewAdminHandler(logger, adminService)
+	adminRouter := routes.NewAdminRouter(adminHandler)
+	authMiddleware := middleware.NewAuthMiddleware(userRepository, sessionRepository, personalAccessTokenService, jwtKeyManager)
+	adminAuditMiddleware := middleware.NewAdminAuditMiddleware(adminAuditLogRepository)
+	workspaceMiddleware := middleware.NewWorkspaceMiddleware(workspaceRepository)
+	router := routes.NewRouter(todoRouter, todoListRouter, workspaceRouter, authRouter, meRouter, adminRouter, authMiddleware, adminAuditMiddleware, workspaceMiddleware)
 	app := NewApp(e, router)
 	return app, nil
 }
@@ -70,10 +192,16 @@ func InitializeTestApp(e *echo.Echo, client *ent.Client, aiFactory utils.IAIFact
 // wire.go:
 
 // todo
-var todoSet = wire.NewSet(repositories.NewTodoRepository, wire.Bind(new(repositories.ITodoRepository), new(*repositories.TodoRepository)), repositories.NewTodoFilterHistoryRepository, wire.Bind(new(repositories.ITodoFilterHistoryRepository), new(*repositories.TodoFilterHistoryRepository)), services.NewTodoService, services.NewAIService, services.NewTodoFilterHistoryService, wire.Bind(new(services.ITodoFilterHistoryService), new(*services.TodoFilterHistoryService)), handlers.NewTodoHandler, routes.NewTodoRouter)
+var todoSet = wire.NewSet(repositories.NewTodoRepository, wire.Bind(new(repositories.ITodoRepository), new(*repositories.TodoRepository)), repositories.NewTodoFilterHistoryRepository, wire.Bind(new(repositories.ITodoFilterHistoryRepository), new(*repositories.TodoFilterHistoryRepository)), repositories.NewTodoBreakdownRepository, wire.Bind(new(repositories.ITodoBreakdownRepository), new(*repositories.TodoBreakdownRepository)), repositories.NewTodoSummaryRepository, wire.Bind(new(repositories.ITodoSummaryRepository), new(*repositories.TodoSummaryRepository)), repositories.NewTodoEmbeddingRepository, wire.Bind(new(repositories.ITodoEmbeddingRepository), new(*repositories.TodoEmbeddingRepository)), repositories.NewTodoListRepository, wire.Bind(new(repositories.ITodoListRepository), new(*repositories.TodoListRepository)), repositories.NewTodoListInviteRepository, wire.Bind(new(repositories.ITodoListInviteRepository), new(*repositories.TodoListInviteRepository)), repositories.NewWorkspaceRepository, wire.Bind(new(repositories.IWorkspaceRepository), new(*repositories.WorkspaceRepository)), repositories.NewWorkspaceInviteRepository, wire.Bind(new(repositories.IWorkspaceInviteRepository), new(*repositories.WorkspaceInviteRepository)), services.NewTodoService, services.NewAIService, services.NewTodoBreakdownService, services.NewTodoSummaryService, services.NewTodoEmbeddingService, services.NewTodoFilterHistoryService, wire.Bind(new(services.ITodoFilterHistoryService), new(*services.TodoFilterHistoryService)), services.NewTodoListService, services.NewWorkspaceService, handlers.NewTodoHandler, handlers.NewTodoListHandler, handlers.NewWorkspaceHandler, routes.NewTodoRouter, routes.NewTodoListRouter, routes.NewWorkspaceRouter, middleware.NewWorkspaceMiddleware)
+
+// me
+var meSet = wire.NewSet(repositories.NewAIUsageRepository, wire.Bind(new(repositories.IAIUsageRepository), new(*repositories.AIUsageRepository)), services.NewAIUsageService, services.NewMeteredAIFactory, services.NewMeteredEmbedderFactory, handlers.NewMeHandler, routes.NewMeRouter)
 
 // auth
-var authSet = wire.NewSet(repositories.NewUserRepository, wire.Bind(new(repositories.IUserRepository), new(*repositories.UserRepository)), services.NewAuthService, wire.Bind(new(services.IAuthService), new(*services.AuthService)), handlers.NewAuthHandler, routes.NewAuthRouter, middleware.NewAuthMiddleware)
+var authSet = wire.NewSet(repositories.NewUserRepository, wire.Bind(new(repositories.IUserRepository), new(*repositories.UserRepository)), repositories.NewSessionRepository, wire.Bind(new(repositories.ISessionRepository), new(*repositories.SessionRepository)), repositories.NewRefreshTokenRepository, wire.Bind(new(repositories.IRefreshTokenRepository), new(*repositories.RefreshTokenRepository)), repositories.NewPasswordResetTokenRepository, wire.Bind(new(repositories.IPasswordResetTokenRepository), new(*repositories.PasswordResetTokenRepository)), repositories.NewPersonalAccessTokenRepository, wire.Bind(new(repositories.IPersonalAccessTokenRepository), new(*repositories.PersonalAccessTokenRepository)), services.NewSessionService, services.NewPasswordService, repositories.NewEmailChangeTokenRepository, wire.Bind(new(repositories.IEmailChangeTokenRepository), new(*repositories.EmailChangeTokenRepository)), repositories.NewAccountDeletionTokenRepository, wire.Bind(new(repositories.IAccountDeletionTokenRepository), new(*repositories.AccountDeletionTokenRepository)), services.NewProfileService, repositories.NewPersonalDataRepository, wire.Bind(new(repositories.IPersonalDataRepository), new(*repositories.PersonalDataRepository)), services.NewAccountService, services.NewPersonalAccessTokenService, repositories.NewUserIdentityRepository, wire.Bind(new(repositories.IUserIdentityRepository), new(*repositories.UserIdentityRepository)), services.NewOIDCService, handlers.NewOIDCHandler, repositories.NewMFARepository, wire.Bind(new(repositories.IMFARepository), new(*repositories.MFARepository)), repositories.NewLoginAttemptRepository, wire.Bind(new(repositories.ILoginAttemptRepository), new(*repositories.LoginAttemptRepository)), services.NewLoginThrottle, services.NewMFAService, services.NewAuthService, wire.Bind(new(services.IAuthService), new(*services.AuthService)), handlers.NewAuthHandler, routes.NewAuthRouter, middleware.NewAuthMiddleware)
+
+// admin
+var adminSet = wire.NewSet(repositories.NewAdminRepository, wire.Bind(new(repositories.IAdminRepository), new(*repositories.AdminRepository)), repositories.NewAdminAuditLogRepository, wire.Bind(new(repositories.IAdminAuditLogRepository), new(*repositories.AdminAuditLogRepository)), services.NewAdminService, handlers.NewAdminHandler, routes.NewAdminRouter, middleware.NewAdminAuditMiddleware)
 
 // app
 var appSet = wire.NewSet(providers.NewEntClient, routes.NewRouter, NewLogger, echo.New, NewApp)
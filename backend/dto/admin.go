@@ -0,0 +1,51 @@
+package dto
+
+import "time"
+
+// AdminUserDto は管理者の画面で表示するユーザーの情報
+type AdminUserDto struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	// user / admin
+	Role string `json:"role"`
+	// 無効にしていない場合は null
+	DisabledAt *time.Time `json:"disabled_at"`
+	// 退会を申請していない場合は null
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at"`
+	CreatedAt           time.Time  `json:"created_at"`
+}
+
+type AdminListUsersResponseDto struct {
+	Data       []AdminUserDto `json:"data"`
+	Pagination *PaginationDto `json:"pagination"`
+}
+
+type AdminTodoCountsDto struct {
+	Total int `json:"total"`
+	Done  int `json:"done"`
+	Open  int `json:"open"`
+}
+
+// AdminUserDetailDto はユーザーの情報に、Todo の件数と AI の利用状況を加えたもの
+type AdminUserDetailDto struct {
+	AdminUserDto
+	Todos   AdminTodoCountsDto `json:"todos"`
+	AIUsage AIUsageResponseDto `json:"ai_usage"`
+}
+
+type AdminAuditLogDto struct {
+	ID string `json:"id"`
+	// 操作した管理者のユーザー ID
+	ActorID      int                    `json:"actor_id"`
+	TargetUserID *int                   `json:"target_user_id"`
+	Action       string                 `json:"action"`
+	Detail       map[string]interface{} `json:"detail"`
+	IPAddress    string                 `json:"ip_address"`
+	CreatedAt    time.Time              `json:"created_at"`
+}
+
+type AdminListAuditLogsResponseDto struct {
+	Data       []AdminAuditLogDto `json:"data"`
+	Pagination *PaginationDto     `json:"pagination"`
+}
@@ -0,0 +1,37 @@
+package dto
+
+// AI のストリーミング API で送信するイベント名
+const (
+	// モデルが応答を生成している
+	AIStreamEventThinking = "thinking"
+	// モデルが Tool を選択した
+	AIStreamEventToolChosen = "tool_chosen"
+	// Tool を実行した
+	AIStreamEventToolExecuted = "tool_executed"
+	// 結果の一部
+	AIStreamEventPartial = "partial"
+	// 最終結果。通常の API のレスポンスと同じ形式
+	AIStreamEventResult = "result"
+	// エラーが発生し、ストリームを終了する。通常の API のエラーレスポンスに status を加えた形式
+	AIStreamEventError = "error"
+)
+
+type AIStreamThinkingDto struct {
+	Message string `json:"message"`
+}
+
+type AIStreamToolChosenDto struct {
+	FunctionName string                 `json:"function_name"`
+	Args         map[string]interface{} `json:"args"`
+	ResolvedArgs map[string]interface{} `json:"resolved_args,omitempty"`
+}
+
+type AIStreamToolExecutedDto struct {
+	FunctionName string `json:"function_name"`
+	Count        int    `json:"count"`
+}
+
+type AIStreamPartialDto struct {
+	Drafts []TodoDraftDto `json:"drafts,omitempty"`
+	Todos  []TodoDto      `json:"todos,omitempty"`
+}
@@ -1,6 +1,99 @@
 package dto
 
+import "time"
+
 type LoginInput struct {
 	Email    string
 	Password string
+	// セッションの一覧に表示する端末の情報
+	IPAddress string
+	UserAgent string
+}
+
+// LoginResult はパスワードを確認した結果。
+// 2 段階認証を有効にしている場合は Tokens を発行せず、POST /auth/login/mfa に渡す MFAToken を返す
+type LoginResult struct {
+	Tokens      *AuthTokens
+	MFARequired bool
+	MFAToken    string
+}
+
+type MFALoginInput struct {
+	MFAToken string
+	// 認証アプリのコード、またはリカバリーコード
+	Code      string
+	IPAddress string
+	UserAgent string
+}
+
+type RefreshInput struct {
+	RefreshToken string
+	IPAddress    string
+	UserAgent    string
+}
+
+// AuthTokens はログインまたはリフレッシュで発行したトークン。Cookie に設定して返す
+type AuthTokens struct {
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+}
+
+type RegisterInput struct {
+	Name       string
+	Email      string
+	Password   string
+	InviteCode string
+}
+
+type UserDto struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type SessionDto struct {
+	ID         string    `json:"id"`
+	IPAddress  string    `json:"ip_address"`
+	UserAgent  string    `json:"user_agent"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	// リクエストに使われたセッションであれば true
+	Current bool `json:"current"`
+}
+
+type OIDCProviderDto struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	// ブラウザで開くログイン開始の URL
+	LoginURL string `json:"login_url"`
+}
+
+type OIDCCallbackInput struct {
+	Provider string
+	Code     string
+	State    string
+	// /auth/oidc/:provider/login で Cookie に保存した値
+	LoginState string
+	IPAddress  string
+	UserAgent  string
+}
+
+type MFAStatusDto struct {
+	TOTPEnabled bool `json:"totp_enabled"`
+	// 未使用のリカバリーコードの数
+	RecoveryCodesRemaining int `json:"recovery_codes_remaining"`
+}
+
+// TOTPEnrollmentDto は認証アプリに登録する情報。コードで確認するまで 2 段階認証は有効にならない
+type TOTPEnrollmentDto struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+// RecoveryCodesDto は発行したリカバリーコード。ハッシュ化して保存するため、再表示はできない
+type RecoveryCodesDto struct {
+	RecoveryCodes []string `json:"recovery_codes"`
 }
@@ -0,0 +1,83 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// GET /me/export で返すデータの形式のバージョン。項目を削除・変更した場合に上げる
+const PersonalDataExportVersion = 1
+
+// 以下は GET /me/export で返すデータの各要素。
+// パスワードやトークンのハッシュ、2 段階認証のシークレットなど、認証に使う値は含めない
+
+type ExportTodoFilterHistoryDto struct {
+	ID            string                 `json:"id"`
+	Query         string                 `json:"query"`
+	PromptVersion string                 `json:"prompt_version"`
+	FunctionName  string                 `json:"function_name"`
+	Args          map[string]interface{} `json:"args"`
+	ResultTodoIDs []int                  `json:"result_todo_ids"`
+	CreatedAt     time.Time              `json:"created_at"`
+}
+
+type ExportTodoBreakdownDto struct {
+	ID                  string              `json:"id"`
+	TodoID              int                 `json:"todo_id"`
+	Model               string              `json:"model"`
+	Steps               []map[string]string `json:"steps"`
+	AcceptedStepIndexes []int               `json:"accepted_step_indexes"`
+	AcceptedAt          *time.Time          `json:"accepted_at"`
+	CreatedAt           time.Time           `json:"created_at"`
+}
+
+type ExportTodoSummaryDto struct {
+	ID       string          `json:"id"`
+	From     time.Time       `json:"from"`
+	To       time.Time       `json:"to"`
+	Model    string          `json:"model"`
+	Content  json.RawMessage `json:"content"`
+	Markdown string          `json:"markdown"`
+	// 生成した日時
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ExportSessionDto struct {
+	ID         string     `json:"id"`
+	IPAddress  string     `json:"ip_address"`
+	UserAgent  string     `json:"user_agent"`
+	LastSeenAt time.Time  `json:"last_seen_at"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type ExportAIUsageDto struct {
+	Model            string    `json:"model"`
+	PromptTokens     int       `json:"prompt_tokens"`
+	CandidatesTokens int       `json:"candidates_tokens"`
+	TotalTokens      int       `json:"total_tokens"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+type ExportPersonalAccessTokenDto struct {
+	PersonalAccessTokenDto
+	RevokedAt *time.Time `json:"revoked_at"`
+}
+
+type ExportIdentityDto struct {
+	Provider    string    `json:"provider"`
+	Subject     string    `json:"subject"`
+	Email       string    `json:"email"`
+	LastLoginAt time.Time `json:"last_login_at"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type ExportLoginAttemptDto struct {
+	Email     string    `json:"email"`
+	IPAddress string    `json:"ip_address"`
+	UserAgent string    `json:"user_agent"`
+	Result    string    `json:"result"`
+	CreatedAt time.Time `json:"created_at"`
+}
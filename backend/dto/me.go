@@ -0,0 +1,73 @@
+package dto
+
+import "time"
+
+type AIUsagePeriodDto struct {
+	Requests int `json:"requests"`
+	Tokens   int `json:"tokens"`
+	// 上限が設定されていない場合は null
+	RequestLimit *int      `json:"request_limit"`
+	TokenLimit   *int      `json:"token_limit"`
+	ResetsAt     time.Time `json:"resets_at"`
+}
+
+type AIUsageResponseDto struct {
+	Daily   AIUsagePeriodDto `json:"daily"`
+	Monthly AIUsagePeriodDto `json:"monthly"`
+}
+
+type PersonalAccessTokenDto struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	TokenPrefix string   `json:"token_prefix"`
+	Scopes      []string `json:"scopes"`
+	// 無期限の場合は null
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// CreatedPersonalAccessTokenDto は発行したトークンを返す。トークンはハッシュだけを保存するため、表示できるのはこの時だけ
+type CreatedPersonalAccessTokenDto struct {
+	PersonalAccessTokenDto
+	Token string `json:"token"`
+}
+
+// MeDto はログイン中のユーザーのプロフィール
+type MeDto struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	// 変更を確認するメールを送信済みで、まだ確認されていないメールアドレス。無い場合は null
+	PendingEmail *string            `json:"pending_email"`
+	Locale       string             `json:"locale"`
+	TimeZone     string             `json:"time_zone"`
+	Preferences  UserPreferencesDto `json:"preferences"`
+	// 退会を申請している場合に完全に削除される日時。申請していない場合は null
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at"`
+	CreatedAt           time.Time  `json:"created_at"`
+}
+
+// AccountDeletionDto は退会の申請の受付結果。DeletionScheduledAt までは申請を取り消せる
+type AccountDeletionDto struct {
+	DeletionScheduledAt time.Time `json:"deletion_scheduled_at"`
+}
+
+type UserPreferencesDto struct {
+	// Todo の一覧で limit を指定しない場合の件数
+	TodoPageSize int `json:"todo_page_size"`
+	// Todo の一覧で include_done を指定しない場合に、完了した Todo を含めるか
+	TodoIncludeDone bool `json:"todo_include_done"`
+}
+
+// UpdateProfileInput はプロフィールの変更内容。nil のフィールドは変更しない
+type UpdateProfileInput struct {
+	Name            *string
+	Email           *string
+	Locale          *string
+	TimeZone        *string
+	TodoPageSize    *int
+	TodoIncludeDone *bool
+	// メールアドレスを変更する場合に確認する現在のパスワード
+	CurrentPassword string
+}
@@ -12,6 +12,9 @@ type TodoDto struct {
 	CreatedAt   time.Time  `json:"created_at"`
 	UpdatedAt   time.Time  `json:"updated_at"`
 	DoneAt      *time.Time `json:"done_at"`
+	ParentID    *int       `json:"parent_id"`
+	// 共有のリストに属する場合のリスト ID
+	ListID *int `json:"list_id"`
 }
 
 type ListTodoResponseDto struct {
@@ -30,6 +33,83 @@ type PaginationDto struct {
 type AIFilterDto struct {
 	FunctionName string                 `json:"function_name"`
 	Args         map[string]interface{} `json:"args"`
+	// Tool が呼び出されなかった場合のモデルの回答
+	Text string `json:"text,omitempty"`
+}
+
+const (
+	// Tool が呼び出され、該当する ToDo があった
+	AIFilterStatusMatched = "matched"
+	// Tool が呼び出されたが、該当する ToDo が無かった
+	AIFilterStatusNoResults = "no_results"
+	// クエリに対応する Tool が無かった
+	AIFilterStatusNoTool = "no_tool"
+)
+
+type AIFilterResponseDto struct {
+	Status       string                 `json:"status"`
+	QueryID      string                 `json:"query_id"`
+	FunctionName *string                `json:"function_name"`
+	Args         map[string]interface{} `json:"args"`
+	ResolvedArgs map[string]interface{} `json:"resolved_args"`
+	Text         string                 `json:"text"`
+	Todos        []TodoDto              `json:"todos"`
+}
+
+type TodoDraftDto struct {
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Errors      map[string]string `json:"errors,omitempty"`
+}
+
+type AICreateTodoResponseDto struct {
+	Mode   string         `json:"mode"`
+	Drafts []TodoDraftDto `json:"drafts"`
+	Todos  []TodoDto      `json:"todos"`
+}
+
+type TodoBreakdownStepDto struct {
+	Index       int               `json:"index"`
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Errors      map[string]string `json:"errors,omitempty"`
+}
+
+type TodoBreakdownDto struct {
+	ID                  string                 `json:"id"`
+	TodoID              int                    `json:"todo_id"`
+	Model               string                 `json:"model"`
+	Steps               []TodoBreakdownStepDto `json:"steps"`
+	AcceptedStepIndexes []int                  `json:"accepted_step_indexes"`
+	AcceptedAt          *time.Time             `json:"accepted_at"`
+	CreatedAt           time.Time              `json:"created_at"`
+}
+
+type AcceptTodoBreakdownResponseDto struct {
+	Breakdown TodoBreakdownDto `json:"breakdown"`
+	Todos     []TodoDto        `json:"todos"`
+}
+
+type TodoSummarySectionDto struct {
+	Heading string `json:"heading"`
+	Summary string `json:"summary"`
+	TodoIDs []int  `json:"todo_ids"`
+}
+
+type TodoSummaryContentDto struct {
+	Overview   string                  `json:"overview"`
+	Highlights []string                `json:"highlights"`
+	Sections   []TodoSummarySectionDto `json:"sections"`
+}
+
+type TodoSummaryResponseDto struct {
+	From        time.Time `json:"from"`
+	To          time.Time `json:"to"`
+	TodoCount   int       `json:"todo_count"`
+	Cached      bool      `json:"cached"`
+	GeneratedAt time.Time `json:"generated_at"`
+	Markdown    string    `json:"markdown"`
+	TodoSummaryContentDto
 }
 
 type TodoFilterHistoryQueryDto struct {
@@ -49,6 +129,34 @@ func EntityToTodoDto(todo *ent.Todo) TodoDto {
 		CreatedAt:   todo.CreatedAt,
 		UpdatedAt:   todo.UpdatedAt,
 		DoneAt:      todo.DoneAt,
+		ParentID:    todo.ParentID,
+		ListID:      todo.ListID,
+	}
+}
+
+func EntityToTodoBreakdownDto(b *ent.TodoBreakdown) TodoBreakdownDto {
+	steps := make([]TodoBreakdownStepDto, len(b.Steps))
+	for i, step := range b.Steps {
+		steps[i] = TodoBreakdownStepDto{
+			Index:       i,
+			Title:       step["title"],
+			Description: step["description"],
+		}
+	}
+
+	accepted := b.AcceptedStepIndexes
+	if accepted == nil {
+		accepted = []int{}
+	}
+
+	return TodoBreakdownDto{
+		ID:                  b.ID.String(),
+		TodoID:              b.TodoID,
+		Model:               b.Model,
+		Steps:               steps,
+		AcceptedStepIndexes: accepted,
+		AcceptedAt:          b.AcceptedAt,
+		CreatedAt:           b.CreatedAt,
 	}
 }
 
@@ -62,3 +170,13 @@ func EntitiesToTodoFilterHistoryQueryDtos(histories []*ent.TodoFilterHistory) []
 	}
 	return dtos
 }
+
+type TodoSearchResultDto struct {
+	Score float64 `json:"score"`
+	Todo  TodoDto `json:"todo"`
+}
+
+type SemanticSearchResponseDto struct {
+	Query   string                `json:"query"`
+	Results []TodoSearchResultDto `json:"results"`
+}
@@ -0,0 +1,42 @@
+package dto
+
+import "time"
+
+// TodoListDto は共有のリストと、ログイン中のユーザーの権限
+type TodoListDto struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ListTodoListsResponseDto struct {
+	Data []TodoListDto `json:"data"`
+}
+
+type TodoListMemberDto struct {
+	UserID   int       `json:"user_id"`
+	Name     string    `json:"name"`
+	Email    string    `json:"email"`
+	Role     string    `json:"role"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+// TodoListDetailDto はリストにメンバーの一覧を加えたもの
+type TodoListDetailDto struct {
+	TodoListDto
+	Members []TodoListMemberDto `json:"members"`
+}
+
+type TodoListInviteDto struct {
+	ID        string    `json:"id"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ListTodoListInvitesResponseDto struct {
+	Data []TodoListInviteDto `json:"data"`
+}
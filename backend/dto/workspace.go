@@ -0,0 +1,44 @@
+package dto
+
+import "time"
+
+// WorkspaceDto はワークスペースと、ログイン中のユーザーの権限
+type WorkspaceDto struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Role string `json:"role"`
+	// 個人のワークスペースの場合は true。メンバーの追加や削除はできない
+	Personal  bool      `json:"personal"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ListWorkspacesResponseDto struct {
+	Data []WorkspaceDto `json:"data"`
+}
+
+type WorkspaceMemberDto struct {
+	UserID   int       `json:"user_id"`
+	Name     string    `json:"name"`
+	Email    string    `json:"email"`
+	Role     string    `json:"role"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+// WorkspaceDetailDto はワークスペースにメンバーの一覧を加えたもの
+type WorkspaceDetailDto struct {
+	WorkspaceDto
+	Members []WorkspaceMemberDto `json:"members"`
+}
+
+type WorkspaceInviteDto struct {
+	ID        string    `json:"id"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ListWorkspaceInvitesResponseDto struct {
+	Data []WorkspaceInviteDto `json:"data"`
+}
@@ -0,0 +1,315 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo-app/ent/adminauditlog"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// AdminAuditLogCreate is the builder for creating a AdminAuditLog entity.
+type AdminAuditLogCreate struct {
+	config
+	mutation *AdminAuditLogMutation
+	hooks    []Hook
+}
+
+// SetActorID sets the "actor_id" field.
+func (_c *AdminAuditLogCreate) SetActorID(v int) *AdminAuditLogCreate {
+	_c.mutation.SetActorID(v)
+	return _c
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (_c *AdminAuditLogCreate) SetTargetUserID(v int) *AdminAuditLogCreate {
+	_c.mutation.SetTargetUserID(v)
+	return _c
+}
+
+// SetNillableTargetUserID sets the "target_user_id" field if the given value is not nil.
+func (_c *AdminAuditLogCreate) SetNillableTargetUserID(v *int) *AdminAuditLogCreate {
+	if v != nil {
+		_c.SetTargetUserID(*v)
+	}
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *AdminAuditLogCreate) SetAction(v string) *AdminAuditLogCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetDetail sets the "detail" field.
+func (_c *AdminAuditLogCreate) SetDetail(v map[string]interface{}) *AdminAuditLogCreate {
+	_c.mutation.SetDetail(v)
+	return _c
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (_c *AdminAuditLogCreate) SetIPAddress(v string) *AdminAuditLogCreate {
+	_c.mutation.SetIPAddress(v)
+	return _c
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (_c *AdminAuditLogCreate) SetNillableIPAddress(v *string) *AdminAuditLogCreate {
+	if v != nil {
+		_c.SetIPAddress(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AdminAuditLogCreate) SetCreatedAt(v time.Time) *AdminAuditLogCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AdminAuditLogCreate) SetNillableCreatedAt(v *time.Time) *AdminAuditLogCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AdminAuditLogCreate) SetID(v uuid.UUID) *AdminAuditLogCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AdminAuditLogCreate) SetNillableID(v *uuid.UUID) *AdminAuditLogCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AdminAuditLogMutation object of the builder.
+func (_c *AdminAuditLogCreate) Mutation() *AdminAuditLogMutation {
+	return _c.mutation
+}
+
+// Save creates the AdminAuditLog in the database.
+func (_c *AdminAuditLogCreate) Save(ctx context.Context) (*AdminAuditLog, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AdminAuditLogCreate) SaveX(ctx context.Context) *AdminAuditLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AdminAuditLogCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AdminAuditLogCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AdminAuditLogCreate) defaults() {
+	if _, ok := _c.mutation.IPAddress(); !ok {
+		v := adminauditlog.DefaultIPAddress
+		_c.mutation.SetIPAddress(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := adminauditlog.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := adminauditlog.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AdminAuditLogCreate) check() error {
+	if _, ok := _c.mutation.ActorID(); !ok {
+		return &ValidationError{Name: "actor_id", err: errors.New(`ent: missing required field "AdminAuditLog.actor_id"`)}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "AdminAuditLog.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := adminauditlog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AdminAuditLog.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IPAddress(); !ok {
+		return &ValidationError{Name: "ip_address", err: errors.New(`ent: missing required field "AdminAuditLog.ip_address"`)}
+	}
+	if v, ok := _c.mutation.IPAddress(); ok {
+		if err := adminauditlog.IPAddressValidator(v); err != nil {
+			return &ValidationError{Name: "ip_address", err: fmt.Errorf(`ent: validator failed for field "AdminAuditLog.ip_address": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AdminAuditLog.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *AdminAuditLogCreate) sqlSave(ctx context.Context) (*AdminAuditLog, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AdminAuditLogCreate) createSpec() (*AdminAuditLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AdminAuditLog{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(adminauditlog.Table, sqlgraph.NewFieldSpec(adminauditlog.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.ActorID(); ok {
+		_spec.SetField(adminauditlog.FieldActorID, field.TypeInt, value)
+		_node.ActorID = value
+	}
+	if value, ok := _c.mutation.TargetUserID(); ok {
+		_spec.SetField(adminauditlog.FieldTargetUserID, field.TypeInt, value)
+		_node.TargetUserID = &value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(adminauditlog.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Detail(); ok {
+		_spec.SetField(adminauditlog.FieldDetail, field.TypeJSON, value)
+		_node.Detail = value
+	}
+	if value, ok := _c.mutation.IPAddress(); ok {
+		_spec.SetField(adminauditlog.FieldIPAddress, field.TypeString, value)
+		_node.IPAddress = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(adminauditlog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AdminAuditLogCreateBulk is the builder for creating many AdminAuditLog entities in bulk.
+type AdminAuditLogCreateBulk struct {
+	config
+	err      error
+	builders []*AdminAuditLogCreate
+}
+
+// Save creates the AdminAuditLog entities in the database.
+func (_c *AdminAuditLogCreateBulk) Save(ctx context.Context) ([]*AdminAuditLog, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AdminAuditLog, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AdminAuditLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AdminAuditLogCreateBulk) SaveX(ctx context.Context) []*AdminAuditLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AdminAuditLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AdminAuditLogCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,441 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"todo-app/ent/adminauditlog"
+	"todo-app/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AdminAuditLogUpdate is the builder for updating AdminAuditLog entities.
+type AdminAuditLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AdminAuditLogMutation
+}
+
+// Where appends a list predicates to the AdminAuditLogUpdate builder.
+func (_u *AdminAuditLogUpdate) Where(ps ...predicate.AdminAuditLog) *AdminAuditLogUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetActorID sets the "actor_id" field.
+func (_u *AdminAuditLogUpdate) SetActorID(v int) *AdminAuditLogUpdate {
+	_u.mutation.ResetActorID()
+	_u.mutation.SetActorID(v)
+	return _u
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_u *AdminAuditLogUpdate) SetNillableActorID(v *int) *AdminAuditLogUpdate {
+	if v != nil {
+		_u.SetActorID(*v)
+	}
+	return _u
+}
+
+// AddActorID adds value to the "actor_id" field.
+func (_u *AdminAuditLogUpdate) AddActorID(v int) *AdminAuditLogUpdate {
+	_u.mutation.AddActorID(v)
+	return _u
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (_u *AdminAuditLogUpdate) SetTargetUserID(v int) *AdminAuditLogUpdate {
+	_u.mutation.ResetTargetUserID()
+	_u.mutation.SetTargetUserID(v)
+	return _u
+}
+
+// SetNillableTargetUserID sets the "target_user_id" field if the given value is not nil.
+func (_u *AdminAuditLogUpdate) SetNillableTargetUserID(v *int) *AdminAuditLogUpdate {
+	if v != nil {
+		_u.SetTargetUserID(*v)
+	}
+	return _u
+}
+
+// AddTargetUserID adds value to the "target_user_id" field.
+func (_u *AdminAuditLogUpdate) AddTargetUserID(v int) *AdminAuditLogUpdate {
+	_u.mutation.AddTargetUserID(v)
+	return _u
+}
+
+// ClearTargetUserID clears the value of the "target_user_id" field.
+func (_u *AdminAuditLogUpdate) ClearTargetUserID() *AdminAuditLogUpdate {
+	_u.mutation.ClearTargetUserID()
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *AdminAuditLogUpdate) SetAction(v string) *AdminAuditLogUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *AdminAuditLogUpdate) SetNillableAction(v *string) *AdminAuditLogUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetDetail sets the "detail" field.
+func (_u *AdminAuditLogUpdate) SetDetail(v map[string]interface{}) *AdminAuditLogUpdate {
+	_u.mutation.SetDetail(v)
+	return _u
+}
+
+// ClearDetail clears the value of the "detail" field.
+func (_u *AdminAuditLogUpdate) ClearDetail() *AdminAuditLogUpdate {
+	_u.mutation.ClearDetail()
+	return _u
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (_u *AdminAuditLogUpdate) SetIPAddress(v string) *AdminAuditLogUpdate {
+	_u.mutation.SetIPAddress(v)
+	return _u
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (_u *AdminAuditLogUpdate) SetNillableIPAddress(v *string) *AdminAuditLogUpdate {
+	if v != nil {
+		_u.SetIPAddress(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AdminAuditLogMutation object of the builder.
+func (_u *AdminAuditLogUpdate) Mutation() *AdminAuditLogMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AdminAuditLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AdminAuditLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AdminAuditLogUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AdminAuditLogUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AdminAuditLogUpdate) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := adminauditlog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AdminAuditLog.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IPAddress(); ok {
+		if err := adminauditlog.IPAddressValidator(v); err != nil {
+			return &ValidationError{Name: "ip_address", err: fmt.Errorf(`ent: validator failed for field "AdminAuditLog.ip_address": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AdminAuditLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(adminauditlog.Table, adminauditlog.Columns, sqlgraph.NewFieldSpec(adminauditlog.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ActorID(); ok {
+		_spec.SetField(adminauditlog.FieldActorID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedActorID(); ok {
+		_spec.AddField(adminauditlog.FieldActorID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TargetUserID(); ok {
+		_spec.SetField(adminauditlog.FieldTargetUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTargetUserID(); ok {
+		_spec.AddField(adminauditlog.FieldTargetUserID, field.TypeInt, value)
+	}
+	if _u.mutation.TargetUserIDCleared() {
+		_spec.ClearField(adminauditlog.FieldTargetUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(adminauditlog.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Detail(); ok {
+		_spec.SetField(adminauditlog.FieldDetail, field.TypeJSON, value)
+	}
+	if _u.mutation.DetailCleared() {
+		_spec.ClearField(adminauditlog.FieldDetail, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.IPAddress(); ok {
+		_spec.SetField(adminauditlog.FieldIPAddress, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{adminauditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AdminAuditLogUpdateOne is the builder for updating a single AdminAuditLog entity.
+type AdminAuditLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AdminAuditLogMutation
+}
+
+// SetActorID sets the "actor_id" field.
+func (_u *AdminAuditLogUpdateOne) SetActorID(v int) *AdminAuditLogUpdateOne {
+	_u.mutation.ResetActorID()
+	_u.mutation.SetActorID(v)
+	return _u
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_u *AdminAuditLogUpdateOne) SetNillableActorID(v *int) *AdminAuditLogUpdateOne {
+	if v != nil {
+		_u.SetActorID(*v)
+	}
+	return _u
+}
+
+// AddActorID adds value to the "actor_id" field.
+func (_u *AdminAuditLogUpdateOne) AddActorID(v int) *AdminAuditLogUpdateOne {
+	_u.mutation.AddActorID(v)
+	return _u
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (_u *AdminAuditLogUpdateOne) SetTargetUserID(v int) *AdminAuditLogUpdateOne {
+	_u.mutation.ResetTargetUserID()
+	_u.mutation.SetTargetUserID(v)
+	return _u
+}
+
+// SetNillableTargetUserID sets the "target_user_id" field if the given value is not nil.
+func (_u *AdminAuditLogUpdateOne) SetNillableTargetUserID(v *int) *AdminAuditLogUpdateOne {
+	if v != nil {
+		_u.SetTargetUserID(*v)
+	}
+	return _u
+}
+
+// AddTargetUserID adds value to the "target_user_id" field.
+func (_u *AdminAuditLogUpdateOne) AddTargetUserID(v int) *AdminAuditLogUpdateOne {
+	_u.mutation.AddTargetUserID(v)
+	return _u
+}
+
+// ClearTargetUserID clears the value of the "target_user_id" field.
+func (_u *AdminAuditLogUpdateOne) ClearTargetUserID() *AdminAuditLogUpdateOne {
+	_u.mutation.ClearTargetUserID()
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *AdminAuditLogUpdateOne) SetAction(v string) *AdminAuditLogUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *AdminAuditLogUpdateOne) SetNillableAction(v *string) *AdminAuditLogUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetDetail sets the "detail" field.
+func (_u *AdminAuditLogUpdateOne) SetDetail(v map[string]interface{}) *AdminAuditLogUpdateOne {
+	_u.mutation.SetDetail(v)
+	return _u
+}
+
+// ClearDetail clears the value of the "detail" field.
+func (_u *AdminAuditLogUpdateOne) ClearDetail() *AdminAuditLogUpdateOne {
+	_u.mutation.ClearDetail()
+	return _u
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (_u *AdminAuditLogUpdateOne) SetIPAddress(v string) *AdminAuditLogUpdateOne {
+	_u.mutation.SetIPAddress(v)
+	return _u
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (_u *AdminAuditLogUpdateOne) SetNillableIPAddress(v *string) *AdminAuditLogUpdateOne {
+	if v != nil {
+		_u.SetIPAddress(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AdminAuditLogMutation object of the builder.
+func (_u *AdminAuditLogUpdateOne) Mutation() *AdminAuditLogMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AdminAuditLogUpdate builder.
+func (_u *AdminAuditLogUpdateOne) Where(ps ...predicate.AdminAuditLog) *AdminAuditLogUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AdminAuditLogUpdateOne) Select(field string, fields ...string) *AdminAuditLogUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AdminAuditLog entity.
+func (_u *AdminAuditLogUpdateOne) Save(ctx context.Context) (*AdminAuditLog, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AdminAuditLogUpdateOne) SaveX(ctx context.Context) *AdminAuditLog {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AdminAuditLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AdminAuditLogUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AdminAuditLogUpdateOne) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := adminauditlog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AdminAuditLog.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IPAddress(); ok {
+		if err := adminauditlog.IPAddressValidator(v); err != nil {
+			return &ValidationError{Name: "ip_address", err: fmt.Errorf(`ent: validator failed for field "AdminAuditLog.ip_address": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AdminAuditLogUpdateOne) sqlSave(ctx context.Context) (_node *AdminAuditLog, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(adminauditlog.Table, adminauditlog.Columns, sqlgraph.NewFieldSpec(adminauditlog.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AdminAuditLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, adminauditlog.FieldID)
+		for _, f := range fields {
+			if !adminauditlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != adminauditlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ActorID(); ok {
+		_spec.SetField(adminauditlog.FieldActorID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedActorID(); ok {
+		_spec.AddField(adminauditlog.FieldActorID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TargetUserID(); ok {
+		_spec.SetField(adminauditlog.FieldTargetUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTargetUserID(); ok {
+		_spec.AddField(adminauditlog.FieldTargetUserID, field.TypeInt, value)
+	}
+	if _u.mutation.TargetUserIDCleared() {
+		_spec.ClearField(adminauditlog.FieldTargetUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(adminauditlog.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Detail(); ok {
+		_spec.SetField(adminauditlog.FieldDetail, field.TypeJSON, value)
+	}
+	if _u.mutation.DetailCleared() {
+		_spec.ClearField(adminauditlog.FieldDetail, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.IPAddress(); ok {
+		_spec.SetField(adminauditlog.FieldIPAddress, field.TypeString, value)
+	}
+	_node = &AdminAuditLog{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{adminauditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
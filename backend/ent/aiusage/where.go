@@ -0,0 +1,370 @@
+// Code generated by ent, DO NOT EDIT.
+
+package aiusage
+
+import (
+	"time"
+	"todo-app/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldUserID, v))
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldModel, v))
+}
+
+// PromptTokens applies equality check predicate on the "prompt_tokens" field. It's identical to PromptTokensEQ.
+func PromptTokens(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldPromptTokens, v))
+}
+
+// CandidatesTokens applies equality check predicate on the "candidates_tokens" field. It's identical to CandidatesTokensEQ.
+func CandidatesTokens(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldCandidatesTokens, v))
+}
+
+// TotalTokens applies equality check predicate on the "total_tokens" field. It's identical to TotalTokensEQ.
+func TotalTokens(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldTotalTokens, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldModel, v))
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNEQ(FieldModel, v))
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldIn(FieldModel, vs...))
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNotIn(FieldModel, vs...))
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGT(FieldModel, v))
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGTE(FieldModel, v))
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLT(FieldModel, v))
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLTE(FieldModel, v))
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldContains(FieldModel, v))
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldHasPrefix(FieldModel, v))
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldHasSuffix(FieldModel, v))
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEqualFold(FieldModel, v))
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldContainsFold(FieldModel, v))
+}
+
+// PromptTokensEQ applies the EQ predicate on the "prompt_tokens" field.
+func PromptTokensEQ(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldPromptTokens, v))
+}
+
+// PromptTokensNEQ applies the NEQ predicate on the "prompt_tokens" field.
+func PromptTokensNEQ(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNEQ(FieldPromptTokens, v))
+}
+
+// PromptTokensIn applies the In predicate on the "prompt_tokens" field.
+func PromptTokensIn(vs ...int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldIn(FieldPromptTokens, vs...))
+}
+
+// PromptTokensNotIn applies the NotIn predicate on the "prompt_tokens" field.
+func PromptTokensNotIn(vs ...int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNotIn(FieldPromptTokens, vs...))
+}
+
+// PromptTokensGT applies the GT predicate on the "prompt_tokens" field.
+func PromptTokensGT(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGT(FieldPromptTokens, v))
+}
+
+// PromptTokensGTE applies the GTE predicate on the "prompt_tokens" field.
+func PromptTokensGTE(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGTE(FieldPromptTokens, v))
+}
+
+// PromptTokensLT applies the LT predicate on the "prompt_tokens" field.
+func PromptTokensLT(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLT(FieldPromptTokens, v))
+}
+
+// PromptTokensLTE applies the LTE predicate on the "prompt_tokens" field.
+func PromptTokensLTE(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLTE(FieldPromptTokens, v))
+}
+
+// CandidatesTokensEQ applies the EQ predicate on the "candidates_tokens" field.
+func CandidatesTokensEQ(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldCandidatesTokens, v))
+}
+
+// CandidatesTokensNEQ applies the NEQ predicate on the "candidates_tokens" field.
+func CandidatesTokensNEQ(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNEQ(FieldCandidatesTokens, v))
+}
+
+// CandidatesTokensIn applies the In predicate on the "candidates_tokens" field.
+func CandidatesTokensIn(vs ...int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldIn(FieldCandidatesTokens, vs...))
+}
+
+// CandidatesTokensNotIn applies the NotIn predicate on the "candidates_tokens" field.
+func CandidatesTokensNotIn(vs ...int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNotIn(FieldCandidatesTokens, vs...))
+}
+
+// CandidatesTokensGT applies the GT predicate on the "candidates_tokens" field.
+func CandidatesTokensGT(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGT(FieldCandidatesTokens, v))
+}
+
+// CandidatesTokensGTE applies the GTE predicate on the "candidates_tokens" field.
+func CandidatesTokensGTE(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGTE(FieldCandidatesTokens, v))
+}
+
+// CandidatesTokensLT applies the LT predicate on the "candidates_tokens" field.
+func CandidatesTokensLT(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLT(FieldCandidatesTokens, v))
+}
+
+// CandidatesTokensLTE applies the LTE predicate on the "candidates_tokens" field.
+func CandidatesTokensLTE(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLTE(FieldCandidatesTokens, v))
+}
+
+// TotalTokensEQ applies the EQ predicate on the "total_tokens" field.
+func TotalTokensEQ(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldTotalTokens, v))
+}
+
+// TotalTokensNEQ applies the NEQ predicate on the "total_tokens" field.
+func TotalTokensNEQ(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNEQ(FieldTotalTokens, v))
+}
+
+// TotalTokensIn applies the In predicate on the "total_tokens" field.
+func TotalTokensIn(vs ...int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldIn(FieldTotalTokens, vs...))
+}
+
+// TotalTokensNotIn applies the NotIn predicate on the "total_tokens" field.
+func TotalTokensNotIn(vs ...int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNotIn(FieldTotalTokens, vs...))
+}
+
+// TotalTokensGT applies the GT predicate on the "total_tokens" field.
+func TotalTokensGT(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGT(FieldTotalTokens, v))
+}
+
+// TotalTokensGTE applies the GTE predicate on the "total_tokens" field.
+func TotalTokensGTE(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGTE(FieldTotalTokens, v))
+}
+
+// TotalTokensLT applies the LT predicate on the "total_tokens" field.
+func TotalTokensLT(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLT(FieldTotalTokens, v))
+}
+
+// TotalTokensLTE applies the LTE predicate on the "total_tokens" field.
+func TotalTokensLTE(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLTE(FieldTotalTokens, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.AIUsage {
+	return predicate.AIUsage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.AIUsage {
+	return predicate.AIUsage(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AIUsage) predicate.AIUsage {
+	return predicate.AIUsage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AIUsage) predicate.AIUsage {
+	return predicate.AIUsage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AIUsage) predicate.AIUsage {
+	return predicate.AIUsage(sql.NotPredicates(p))
+}
@@ -0,0 +1,354 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo-app/ent/aiusage"
+	"todo-app/ent/user"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// AIUsageCreate is the builder for creating a AIUsage entity.
+type AIUsageCreate struct {
+	config
+	mutation *AIUsageMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *AIUsageCreate) SetUserID(v int) *AIUsageCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetModel sets the "model" field.
+func (_c *AIUsageCreate) SetModel(v string) *AIUsageCreate {
+	_c.mutation.SetModel(v)
+	return _c
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_c *AIUsageCreate) SetPromptTokens(v int) *AIUsageCreate {
+	_c.mutation.SetPromptTokens(v)
+	return _c
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_c *AIUsageCreate) SetNillablePromptTokens(v *int) *AIUsageCreate {
+	if v != nil {
+		_c.SetPromptTokens(*v)
+	}
+	return _c
+}
+
+// SetCandidatesTokens sets the "candidates_tokens" field.
+func (_c *AIUsageCreate) SetCandidatesTokens(v int) *AIUsageCreate {
+	_c.mutation.SetCandidatesTokens(v)
+	return _c
+}
+
+// SetNillableCandidatesTokens sets the "candidates_tokens" field if the given value is not nil.
+func (_c *AIUsageCreate) SetNillableCandidatesTokens(v *int) *AIUsageCreate {
+	if v != nil {
+		_c.SetCandidatesTokens(*v)
+	}
+	return _c
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (_c *AIUsageCreate) SetTotalTokens(v int) *AIUsageCreate {
+	_c.mutation.SetTotalTokens(v)
+	return _c
+}
+
+// SetNillableTotalTokens sets the "total_tokens" field if the given value is not nil.
+func (_c *AIUsageCreate) SetNillableTotalTokens(v *int) *AIUsageCreate {
+	if v != nil {
+		_c.SetTotalTokens(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AIUsageCreate) SetCreatedAt(v time.Time) *AIUsageCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AIUsageCreate) SetNillableCreatedAt(v *time.Time) *AIUsageCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AIUsageCreate) SetID(v uuid.UUID) *AIUsageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AIUsageCreate) SetNillableID(v *uuid.UUID) *AIUsageCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *AIUsageCreate) SetUser(v *User) *AIUsageCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the AIUsageMutation object of the builder.
+func (_c *AIUsageCreate) Mutation() *AIUsageMutation {
+	return _c.mutation
+}
+
+// Save creates the AIUsage in the database.
+func (_c *AIUsageCreate) Save(ctx context.Context) (*AIUsage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AIUsageCreate) SaveX(ctx context.Context) *AIUsage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AIUsageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AIUsageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AIUsageCreate) defaults() {
+	if _, ok := _c.mutation.PromptTokens(); !ok {
+		v := aiusage.DefaultPromptTokens
+		_c.mutation.SetPromptTokens(v)
+	}
+	if _, ok := _c.mutation.CandidatesTokens(); !ok {
+		v := aiusage.DefaultCandidatesTokens
+		_c.mutation.SetCandidatesTokens(v)
+	}
+	if _, ok := _c.mutation.TotalTokens(); !ok {
+		v := aiusage.DefaultTotalTokens
+		_c.mutation.SetTotalTokens(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := aiusage.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := aiusage.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AIUsageCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "AIUsage.user_id"`)}
+	}
+	if _, ok := _c.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "AIUsage.model"`)}
+	}
+	if v, ok := _c.mutation.Model(); ok {
+		if err := aiusage.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "AIUsage.model": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PromptTokens(); !ok {
+		return &ValidationError{Name: "prompt_tokens", err: errors.New(`ent: missing required field "AIUsage.prompt_tokens"`)}
+	}
+	if _, ok := _c.mutation.CandidatesTokens(); !ok {
+		return &ValidationError{Name: "candidates_tokens", err: errors.New(`ent: missing required field "AIUsage.candidates_tokens"`)}
+	}
+	if _, ok := _c.mutation.TotalTokens(); !ok {
+		return &ValidationError{Name: "total_tokens", err: errors.New(`ent: missing required field "AIUsage.total_tokens"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AIUsage.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "AIUsage.user"`)}
+	}
+	return nil
+}
+
+func (_c *AIUsageCreate) sqlSave(ctx context.Context) (*AIUsage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AIUsageCreate) createSpec() (*AIUsage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AIUsage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(aiusage.Table, sqlgraph.NewFieldSpec(aiusage.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Model(); ok {
+		_spec.SetField(aiusage.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := _c.mutation.PromptTokens(); ok {
+		_spec.SetField(aiusage.FieldPromptTokens, field.TypeInt, value)
+		_node.PromptTokens = value
+	}
+	if value, ok := _c.mutation.CandidatesTokens(); ok {
+		_spec.SetField(aiusage.FieldCandidatesTokens, field.TypeInt, value)
+		_node.CandidatesTokens = value
+	}
+	if value, ok := _c.mutation.TotalTokens(); ok {
+		_spec.SetField(aiusage.FieldTotalTokens, field.TypeInt, value)
+		_node.TotalTokens = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(aiusage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   aiusage.UserTable,
+			Columns: []string{aiusage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AIUsageCreateBulk is the builder for creating many AIUsage entities in bulk.
+type AIUsageCreateBulk struct {
+	config
+	err      error
+	builders []*AIUsageCreate
+}
+
+// Save creates the AIUsage entities in the database.
+func (_c *AIUsageCreateBulk) Save(ctx context.Context) ([]*AIUsage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AIUsage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AIUsageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AIUsageCreateBulk) SaveX(ctx context.Context) []*AIUsage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AIUsageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AIUsageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
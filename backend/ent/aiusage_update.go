@@ -0,0 +1,512 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"todo-app/ent/aiusage"
+	"todo-app/ent/predicate"
+	"todo-app/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AIUsageUpdate is the builder for updating AIUsage entities.
+type AIUsageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AIUsageMutation
+}
+
+// Where appends a list predicates to the AIUsageUpdate builder.
+func (_u *AIUsageUpdate) Where(ps ...predicate.AIUsage) *AIUsageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *AIUsageUpdate) SetUserID(v int) *AIUsageUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *AIUsageUpdate) SetNillableUserID(v *int) *AIUsageUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *AIUsageUpdate) SetModel(v string) *AIUsageUpdate {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *AIUsageUpdate) SetNillableModel(v *string) *AIUsageUpdate {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_u *AIUsageUpdate) SetPromptTokens(v int) *AIUsageUpdate {
+	_u.mutation.ResetPromptTokens()
+	_u.mutation.SetPromptTokens(v)
+	return _u
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_u *AIUsageUpdate) SetNillablePromptTokens(v *int) *AIUsageUpdate {
+	if v != nil {
+		_u.SetPromptTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptTokens adds value to the "prompt_tokens" field.
+func (_u *AIUsageUpdate) AddPromptTokens(v int) *AIUsageUpdate {
+	_u.mutation.AddPromptTokens(v)
+	return _u
+}
+
+// SetCandidatesTokens sets the "candidates_tokens" field.
+func (_u *AIUsageUpdate) SetCandidatesTokens(v int) *AIUsageUpdate {
+	_u.mutation.ResetCandidatesTokens()
+	_u.mutation.SetCandidatesTokens(v)
+	return _u
+}
+
+// SetNillableCandidatesTokens sets the "candidates_tokens" field if the given value is not nil.
+func (_u *AIUsageUpdate) SetNillableCandidatesTokens(v *int) *AIUsageUpdate {
+	if v != nil {
+		_u.SetCandidatesTokens(*v)
+	}
+	return _u
+}
+
+// AddCandidatesTokens adds value to the "candidates_tokens" field.
+func (_u *AIUsageUpdate) AddCandidatesTokens(v int) *AIUsageUpdate {
+	_u.mutation.AddCandidatesTokens(v)
+	return _u
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (_u *AIUsageUpdate) SetTotalTokens(v int) *AIUsageUpdate {
+	_u.mutation.ResetTotalTokens()
+	_u.mutation.SetTotalTokens(v)
+	return _u
+}
+
+// SetNillableTotalTokens sets the "total_tokens" field if the given value is not nil.
+func (_u *AIUsageUpdate) SetNillableTotalTokens(v *int) *AIUsageUpdate {
+	if v != nil {
+		_u.SetTotalTokens(*v)
+	}
+	return _u
+}
+
+// AddTotalTokens adds value to the "total_tokens" field.
+func (_u *AIUsageUpdate) AddTotalTokens(v int) *AIUsageUpdate {
+	_u.mutation.AddTotalTokens(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *AIUsageUpdate) SetUser(v *User) *AIUsageUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the AIUsageMutation object of the builder.
+func (_u *AIUsageUpdate) Mutation() *AIUsageMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *AIUsageUpdate) ClearUser() *AIUsageUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AIUsageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AIUsageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AIUsageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AIUsageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AIUsageUpdate) check() error {
+	if v, ok := _u.mutation.Model(); ok {
+		if err := aiusage.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "AIUsage.model": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AIUsage.user"`)
+	}
+	return nil
+}
+
+func (_u *AIUsageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(aiusage.Table, aiusage.Columns, sqlgraph.NewFieldSpec(aiusage.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(aiusage.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PromptTokens(); ok {
+		_spec.SetField(aiusage.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPromptTokens(); ok {
+		_spec.AddField(aiusage.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CandidatesTokens(); ok {
+		_spec.SetField(aiusage.FieldCandidatesTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCandidatesTokens(); ok {
+		_spec.AddField(aiusage.FieldCandidatesTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalTokens(); ok {
+		_spec.SetField(aiusage.FieldTotalTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalTokens(); ok {
+		_spec.AddField(aiusage.FieldTotalTokens, field.TypeInt, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   aiusage.UserTable,
+			Columns: []string{aiusage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   aiusage.UserTable,
+			Columns: []string{aiusage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{aiusage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AIUsageUpdateOne is the builder for updating a single AIUsage entity.
+type AIUsageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AIUsageMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *AIUsageUpdateOne) SetUserID(v int) *AIUsageUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *AIUsageUpdateOne) SetNillableUserID(v *int) *AIUsageUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *AIUsageUpdateOne) SetModel(v string) *AIUsageUpdateOne {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *AIUsageUpdateOne) SetNillableModel(v *string) *AIUsageUpdateOne {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_u *AIUsageUpdateOne) SetPromptTokens(v int) *AIUsageUpdateOne {
+	_u.mutation.ResetPromptTokens()
+	_u.mutation.SetPromptTokens(v)
+	return _u
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_u *AIUsageUpdateOne) SetNillablePromptTokens(v *int) *AIUsageUpdateOne {
+	if v != nil {
+		_u.SetPromptTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptTokens adds value to the "prompt_tokens" field.
+func (_u *AIUsageUpdateOne) AddPromptTokens(v int) *AIUsageUpdateOne {
+	_u.mutation.AddPromptTokens(v)
+	return _u
+}
+
+// SetCandidatesTokens sets the "candidates_tokens" field.
+func (_u *AIUsageUpdateOne) SetCandidatesTokens(v int) *AIUsageUpdateOne {
+	_u.mutation.ResetCandidatesTokens()
+	_u.mutation.SetCandidatesTokens(v)
+	return _u
+}
+
+// SetNillableCandidatesTokens sets the "candidates_tokens" field if the given value is not nil.
+func (_u *AIUsageUpdateOne) SetNillableCandidatesTokens(v *int) *AIUsageUpdateOne {
+	if v != nil {
+		_u.SetCandidatesTokens(*v)
+	}
+	return _u
+}
+
+// AddCandidatesTokens adds value to the "candidates_tokens" field.
+func (_u *AIUsageUpdateOne) AddCandidatesTokens(v int) *AIUsageUpdateOne {
+	_u.mutation.AddCandidatesTokens(v)
+	return _u
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (_u *AIUsageUpdateOne) SetTotalTokens(v int) *AIUsageUpdateOne {
+	_u.mutation.ResetTotalTokens()
+	_u.mutation.SetTotalTokens(v)
+	return _u
+}
+
+// SetNillableTotalTokens sets the "total_tokens" field if the given value is not nil.
+func (_u *AIUsageUpdateOne) SetNillableTotalTokens(v *int) *AIUsageUpdateOne {
+	if v != nil {
+		_u.SetTotalTokens(*v)
+	}
+	return _u
+}
+
+// AddTotalTokens adds value to the "total_tokens" field.
+func (_u *AIUsageUpdateOne) AddTotalTokens(v int) *AIUsageUpdateOne {
+	_u.mutation.AddTotalTokens(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *AIUsageUpdateOne) SetUser(v *User) *AIUsageUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the AIUsageMutation object of the builder.
+func (_u *AIUsageUpdateOne) Mutation() *AIUsageMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *AIUsageUpdateOne) ClearUser() *AIUsageUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the AIUsageUpdate builder.
+func (_u *AIUsageUpdateOne) Where(ps ...predicate.AIUsage) *AIUsageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AIUsageUpdateOne) Select(field string, fields ...string) *AIUsageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AIUsage entity.
+func (_u *AIUsageUpdateOne) Save(ctx context.Context) (*AIUsage, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AIUsageUpdateOne) SaveX(ctx context.Context) *AIUsage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AIUsageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AIUsageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AIUsageUpdateOne) check() error {
+	if v, ok := _u.mutation.Model(); ok {
+		if err := aiusage.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "AIUsage.model": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AIUsage.user"`)
+	}
+	return nil
+}
+
+func (_u *AIUsageUpdateOne) sqlSave(ctx context.Context) (_node *AIUsage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(aiusage.Table, aiusage.Columns, sqlgraph.NewFieldSpec(aiusage.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AIUsage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, aiusage.FieldID)
+		for _, f := range fields {
+			if !aiusage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != aiusage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(aiusage.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PromptTokens(); ok {
+		_spec.SetField(aiusage.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPromptTokens(); ok {
+		_spec.AddField(aiusage.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CandidatesTokens(); ok {
+		_spec.SetField(aiusage.FieldCandidatesTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCandidatesTokens(); ok {
+		_spec.AddField(aiusage.FieldCandidatesTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalTokens(); ok {
+		_spec.SetField(aiusage.FieldTotalTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalTokens(); ok {
+		_spec.AddField(aiusage.FieldTotalTokens, field.TypeInt, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   aiusage.UserTable,
+			Columns: []string{aiusage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   aiusage.UserTable,
+			Columns: []string{aiusage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AIUsage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{aiusage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
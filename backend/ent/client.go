@@ -11,9 +11,31 @@ import (
 
 	"todo-app/ent/migrate"
 
+	"todo-app/ent/accountdeletiontoken"
+	"todo-app/ent/adminauditlog"
+	"todo-app/ent/aiusage"
+	"todo-app/ent/emailchangetoken"
+	"todo-app/ent/loginattempt"
+	"todo-app/ent/passwordresettoken"
+	"todo-app/ent/personalaccesstoken"
+	"todo-app/ent/recoverycode"
+	"todo-app/ent/refreshtoken"
+	"todo-app/ent/session"
 	"todo-app/ent/todo"
+	"todo-app/ent/todobreakdown"
+	"todo-app/ent/todoembedding"
 	"todo-app/ent/todofilterhistory"
+	"todo-app/ent/todolist"
+	"todo-app/ent/todolistinvite"
+	"todo-app/ent/todolistmember"
+	"todo-app/ent/todosummary"
+	"todo-app/ent/totpcredential"
+	"todo-app/ent/usedmfatoken"
 	"todo-app/ent/user"
+	"todo-app/ent/useridentity"
+	"todo-app/ent/workspace"
+	"todo-app/ent/workspaceinvite"
+	"todo-app/ent/workspacemember"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -27,12 +49,56 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AIUsage is the client for interacting with the AIUsage builders.
+	AIUsage *AIUsageClient
+	// AccountDeletionToken is the client for interacting with the AccountDeletionToken builders.
+	AccountDeletionToken *AccountDeletionTokenClient
+	// AdminAuditLog is the client for interacting with the AdminAuditLog builders.
+	AdminAuditLog *AdminAuditLogClient
+	// EmailChangeToken is the client for interacting with the EmailChangeToken builders.
+	EmailChangeToken *EmailChangeTokenClient
+	// LoginAttempt is the client for interacting with the LoginAttempt builders.
+	LoginAttempt *LoginAttemptClient
+	// PasswordResetToken is the client for interacting with the PasswordResetToken builders.
+	PasswordResetToken *PasswordResetTokenClient
+	// PersonalAccessToken is the client for interacting with the PersonalAccessToken builders.
+	PersonalAccessToken *PersonalAccessTokenClient
+	// RecoveryCode is the client for interacting with the RecoveryCode builders.
+	RecoveryCode *RecoveryCodeClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
+	// TOTPCredential is the client for interacting with the TOTPCredential builders.
+	TOTPCredential *TOTPCredentialClient
 	// Todo is the client for interacting with the Todo builders.
 	Todo *TodoClient
+	// TodoBreakdown is the client for interacting with the TodoBreakdown builders.
+	TodoBreakdown *TodoBreakdownClient
+	// TodoEmbedding is the client for interacting with the TodoEmbedding builders.
+	TodoEmbedding *TodoEmbeddingClient
 	// TodoFilterHistory is the client for interacting with the TodoFilterHistory builders.
 	TodoFilterHistory *TodoFilterHistoryClient
+	// TodoList is the client for interacting with the TodoList builders.
+	TodoList *TodoListClient
+	// TodoListInvite is the client for interacting with the TodoListInvite builders.
+	TodoListInvite *TodoListInviteClient
+	// TodoListMember is the client for interacting with the TodoListMember builders.
+	TodoListMember *TodoListMemberClient
+	// TodoSummary is the client for interacting with the TodoSummary builders.
+	TodoSummary *TodoSummaryClient
+	// UsedMFAToken is the client for interacting with the UsedMFAToken builders.
+	UsedMFAToken *UsedMFATokenClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserIdentity is the client for interacting with the UserIdentity builders.
+	UserIdentity *UserIdentityClient
+	// Workspace is the client for interacting with the Workspace builders.
+	Workspace *WorkspaceClient
+	// WorkspaceInvite is the client for interacting with the WorkspaceInvite builders.
+	WorkspaceInvite *WorkspaceInviteClient
+	// WorkspaceMember is the client for interacting with the WorkspaceMember builders.
+	WorkspaceMember *WorkspaceMemberClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -44,9 +110,31 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AIUsage = NewAIUsageClient(c.config)
+	c.AccountDeletionToken = NewAccountDeletionTokenClient(c.config)
+	c.AdminAuditLog = NewAdminAuditLogClient(c.config)
+	c.EmailChangeToken = NewEmailChangeTokenClient(c.config)
+	c.LoginAttempt = NewLoginAttemptClient(c.config)
+	c.PasswordResetToken = NewPasswordResetTokenClient(c.config)
+	c.PersonalAccessToken = NewPersonalAccessTokenClient(c.config)
+	c.RecoveryCode = NewRecoveryCodeClient(c.config)
+	c.RefreshToken = NewRefreshTokenClient(c.config)
+	c.Session = NewSessionClient(c.config)
+	c.TOTPCredential = NewTOTPCredentialClient(c.config)
 	c.Todo = NewTodoClient(c.config)
+	c.TodoBreakdown = NewTodoBreakdownClient(c.config)
+	c.TodoEmbedding = NewTodoEmbeddingClient(c.config)
 	c.TodoFilterHistory = NewTodoFilterHistoryClient(c.config)
+	c.TodoList = NewTodoListClient(c.config)
+	c.TodoListInvite = NewTodoListInviteClient(c.config)
+	c.TodoListMember = NewTodoListMemberClient(c.config)
+	c.TodoSummary = NewTodoSummaryClient(c.config)
+	c.UsedMFAToken = NewUsedMFATokenClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.UserIdentity = NewUserIdentityClient(c.config)
+	c.Workspace = NewWorkspaceClient(c.config)
+	c.WorkspaceInvite = NewWorkspaceInviteClient(c.config)
+	c.WorkspaceMember = NewWorkspaceMemberClient(c.config)
 }
 
 type (
@@ -137,11 +225,33 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:               ctx,
-		config:            cfg,
-		Todo:              NewTodoClient(cfg),
-		TodoFilterHistory: NewTodoFilterHistoryClient(cfg),
-		User:              NewUserClient(cfg),
+		ctx:                  ctx,
+		config:               cfg,
+		AIUsage:              NewAIUsageClient(cfg),
+		AccountDeletionToken: NewAccountDeletionTokenClient(cfg),
+		AdminAuditLog:        NewAdminAuditLogClient(cfg),
+		EmailChangeToken:     NewEmailChangeTokenClient(cfg),
+		LoginAttempt:         NewLoginAttemptClient(cfg),
+		PasswordResetToken:   NewPasswordResetTokenClient(cfg),
+		PersonalAccessToken:  NewPersonalAccessTokenClient(cfg),
+		RecoveryCode:         NewRecoveryCodeClient(cfg),
+		RefreshToken:         NewRefreshTokenClient(cfg),
+		Session:              NewSessionClient(cfg),
+		TOTPCredential:       NewTOTPCredentialClient(cfg),
+		Todo:                 NewTodoClient(cfg),
+		TodoBreakdown:        NewTodoBreakdownClient(cfg),
+		TodoEmbedding:        NewTodoEmbeddingClient(cfg),
+		TodoFilterHistory:    NewTodoFilterHistoryClient(cfg),
+		TodoList:             NewTodoListClient(cfg),
+		TodoListInvite:       NewTodoListInviteClient(cfg),
+		TodoListMember:       NewTodoListMemberClient(cfg),
+		TodoSummary:          NewTodoSummaryClient(cfg),
+		UsedMFAToken:         NewUsedMFATokenClient(cfg),
+		User:                 NewUserClient(cfg),
+		UserIdentity:         NewUserIdentityClient(cfg),
+		Workspace:            NewWorkspaceClient(cfg),
+		WorkspaceInvite:      NewWorkspaceInviteClient(cfg),
+		WorkspaceMember:      NewWorkspaceMemberClient(cfg),
 	}, nil
 }
 
@@ -159,18 +269,40 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:               ctx,
-		config:            cfg,
-		Todo:              NewTodoClient(cfg),
-		TodoFilterHistory: NewTodoFilterHistoryClient(cfg),
-		User:              NewUserClient(cfg),
+		ctx:                  ctx,
+		config:               cfg,
+		AIUsage:              NewAIUsageClient(cfg),
+		AccountDeletionToken: NewAccountDeletionTokenClient(cfg),
+		AdminAuditLog:        NewAdminAuditLogClient(cfg),
+		EmailChangeToken:     NewEmailChangeTokenClient(cfg),
+		LoginAttempt:         NewLoginAttemptClient(cfg),
+		PasswordResetToken:   NewPasswordResetTokenClient(cfg),
+		PersonalAccessToken:  NewPersonalAccessTokenClient(cfg),
+		RecoveryCode:         NewRecoveryCodeClient(cfg),
+		RefreshToken:         NewRefreshTokenClient(cfg),
+		Session:              NewSessionClient(cfg),
+		TOTPCredential:       NewTOTPCredentialClient(cfg),
+		Todo:                 NewTodoClient(cfg),
+		TodoBreakdown:        NewTodoBreakdownClient(cfg),
+		TodoEmbedding:        NewTodoEmbeddingClient(cfg),
+		TodoFilterHistory:    NewTodoFilterHistoryClient(cfg),
+		TodoList:             NewTodoListClient(cfg),
+		TodoListInvite:       NewTodoListInviteClient(cfg),
+		TodoListMember:       NewTodoListMemberClient(cfg),
+		TodoSummary:          NewTodoSummaryClient(cfg),
+		UsedMFAToken:         NewUsedMFATokenClient(cfg),
+		User:                 NewUserClient(cfg),
+		UserIdentity:         NewUserIdentityClient(cfg),
+		Workspace:            NewWorkspaceClient(cfg),
+		WorkspaceInvite:      NewWorkspaceInviteClient(cfg),
+		WorkspaceMember:      NewWorkspaceMemberClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Todo.
+//		AIUsage.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -192,134 +324,192 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Todo.Use(hooks...)
-	c.TodoFilterHistory.Use(hooks...)
-	c.User.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.AIUsage, c.AccountDeletionToken, c.AdminAuditLog, c.EmailChangeToken,
+		c.LoginAttempt, c.PasswordResetToken, c.PersonalAccessToken, c.RecoveryCode,
+		c.RefreshToken, c.Session, c.TOTPCredential, c.Todo, c.TodoBreakdown,
+		c.TodoEmbedding, c.TodoFilterHistory, c.TodoList, c.TodoListInvite,
+		c.TodoListMember, c.TodoSummary, c.UsedMFAToken, c.User, c.UserIdentity,
+		c.Workspace, c.WorkspaceInvite, c.WorkspaceMember,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Todo.Intercept(interceptors...)
-	c.TodoFilterHistory.Intercept(interceptors...)
-	c.User.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.AIUsage, c.AccountDeletionToken, c.AdminAuditLog, c.EmailChangeToken,
+		c.LoginAttempt, c.PasswordResetToken, c.PersonalAccessToken, c.RecoveryCode,
+		c.RefreshToken, c.Session, c.TOTPCredential, c.Todo, c.TodoBreakdown,
+		c.TodoEmbedding, c.TodoFilterHistory, c.TodoList, c.TodoListInvite,
+		c.TodoListMember, c.TodoSummary, c.UsedMFAToken, c.User, c.UserIdentity,
+		c.Workspace, c.WorkspaceInvite, c.WorkspaceMember,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AIUsageMutation:
+		return c.AIUsage.mutate(ctx, m)
+	case *AccountDeletionTokenMutation:
+		return c.AccountDeletionToken.mutate(ctx, m)
+	case *AdminAuditLogMutation:
+		return c.AdminAuditLog.mutate(ctx, m)
+	case *EmailChangeTokenMutation:
+		return c.EmailChangeToken.mutate(ctx, m)
+	case *LoginAttemptMutation:
+		return c.LoginAttempt.mutate(ctx, m)
+	case *PasswordResetTokenMutation:
+		return c.PasswordResetToken.mutate(ctx, m)
+	case *PersonalAccessTokenMutation:
+		return c.PersonalAccessToken.mutate(ctx, m)
+	case *RecoveryCodeMutation:
+		return c.RecoveryCode.mutate(ctx, m)
+	case *RefreshTokenMutation:
+		return c.RefreshToken.mutate(ctx, m)
+	case *SessionMutation:
+		return c.Session.mutate(ctx, m)
+	case *TOTPCredentialMutation:
+		return c.TOTPCredential.mutate(ctx, m)
 	case *TodoMutation:
 		return c.Todo.mutate(ctx, m)
+	case *TodoBreakdownMutation:
+		return c.TodoBreakdown.mutate(ctx, m)
+	case *TodoEmbeddingMutation:
+		return c.TodoEmbedding.mutate(ctx, m)
 	case *TodoFilterHistoryMutation:
 		return c.TodoFilterHistory.mutate(ctx, m)
+	case *TodoListMutation:
+		return c.TodoList.mutate(ctx, m)
+	case *TodoListInviteMutation:
+		return c.TodoListInvite.mutate(ctx, m)
+	case *TodoListMemberMutation:
+		return c.TodoListMember.mutate(ctx, m)
+	case *TodoSummaryMutation:
+		return c.TodoSummary.mutate(ctx, m)
+	case *UsedMFATokenMutation:
+		return c.UsedMFAToken.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *UserIdentityMutation:
+		return c.UserIdentity.mutate(ctx, m)
+	case *WorkspaceMutation:
+		return c.Workspace.mutate(ctx, m)
+	case *WorkspaceInviteMutation:
+		return c.WorkspaceInvite.mutate(ctx, m)
+	case *WorkspaceMemberMutation:
+		return c.WorkspaceMember.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
-// TodoClient is a client for the Todo schema.
-type TodoClient struct {
+// AIUsageClient is a client for the AIUsage schema.
+type AIUsageClient struct {
 	config
 }
 
-// NewTodoClient returns a client for the Todo from the given config.
-func NewTodoClient(c config) *TodoClient {
-	return &TodoClient{config: c}
+// NewAIUsageClient returns a client for the AIUsage from the given config.
+func NewAIUsageClient(c config) *AIUsageClient {
+	return &AIUsageClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `todo.Hooks(f(g(h())))`.
-func (c *TodoClient) Use(hooks ...Hook) {
-	c.hooks.Todo = append(c.hooks.Todo, hooks...)
+// A call to `Use(f, g, h)` equals to `aiusage.Hooks(f(g(h())))`.
+func (c *AIUsageClient) Use(hooks ...Hook) {
+	c.hooks.AIUsage = append(c.hooks.AIUsage, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `todo.Intercept(f(g(h())))`.
-func (c *TodoClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Todo = append(c.inters.Todo, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `aiusage.Intercept(f(g(h())))`.
+func (c *AIUsageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AIUsage = append(c.inters.AIUsage, interceptors...)
 }
 
-// Create returns a builder for creating a Todo entity.
-func (c *TodoClient) Create() *TodoCreate {
-	mutation := newTodoMutation(c.config, OpCreate)
-	return &TodoCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AIUsage entity.
+func (c *AIUsageClient) Create() *AIUsageCreate {
+	mutation := newAIUsageMutation(c.config, OpCreate)
+	return &AIUsageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Todo entities.
-func (c *TodoClient) CreateBulk(builders ...*TodoCreate) *TodoCreateBulk {
-	return &TodoCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AIUsage entities.
+func (c *AIUsageClient) CreateBulk(builders ...*AIUsageCreate) *AIUsageCreateBulk {
+	return &AIUsageCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TodoClient) MapCreateBulk(slice any, setFunc func(*TodoCreate, int)) *TodoCreateBulk {
+func (c *AIUsageClient) MapCreateBulk(slice any, setFunc func(*AIUsageCreate, int)) *AIUsageCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TodoCreateBulk{err: fmt.Errorf("calling to TodoClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AIUsageCreateBulk{err: fmt.Errorf("calling to AIUsageClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TodoCreate, rv.Len())
+	builders := make([]*AIUsageCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TodoCreateBulk{config: c.config, builders: builders}
+	return &AIUsageCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Todo.
-func (c *TodoClient) Update() *TodoUpdate {
-	mutation := newTodoMutation(c.config, OpUpdate)
-	return &TodoUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AIUsage.
+func (c *AIUsageClient) Update() *AIUsageUpdate {
+	mutation := newAIUsageMutation(c.config, OpUpdate)
+	return &AIUsageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TodoClient) UpdateOne(_m *Todo) *TodoUpdateOne {
-	mutation := newTodoMutation(c.config, OpUpdateOne, withTodo(_m))
-	return &TodoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AIUsageClient) UpdateOne(_m *AIUsage) *AIUsageUpdateOne {
+	mutation := newAIUsageMutation(c.config, OpUpdateOne, withAIUsage(_m))
+	return &AIUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TodoClient) UpdateOneID(id int) *TodoUpdateOne {
-	mutation := newTodoMutation(c.config, OpUpdateOne, withTodoID(id))
-	return &TodoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AIUsageClient) UpdateOneID(id uuid.UUID) *AIUsageUpdateOne {
+	mutation := newAIUsageMutation(c.config, OpUpdateOne, withAIUsageID(id))
+	return &AIUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Todo.
-func (c *TodoClient) Delete() *TodoDelete {
-	mutation := newTodoMutation(c.config, OpDelete)
-	return &TodoDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AIUsage.
+func (c *AIUsageClient) Delete() *AIUsageDelete {
+	mutation := newAIUsageMutation(c.config, OpDelete)
+	return &AIUsageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TodoClient) DeleteOne(_m *Todo) *TodoDeleteOne {
+func (c *AIUsageClient) DeleteOne(_m *AIUsage) *AIUsageDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TodoClient) DeleteOneID(id int) *TodoDeleteOne {
-	builder := c.Delete().Where(todo.ID(id))
+func (c *AIUsageClient) DeleteOneID(id uuid.UUID) *AIUsageDeleteOne {
+	builder := c.Delete().Where(aiusage.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TodoDeleteOne{builder}
+	return &AIUsageDeleteOne{builder}
 }
 
-// Query returns a query builder for Todo.
-func (c *TodoClient) Query() *TodoQuery {
-	return &TodoQuery{
+// Query returns a query builder for AIUsage.
+func (c *AIUsageClient) Query() *AIUsageQuery {
+	return &AIUsageQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTodo},
+		ctx:    &QueryContext{Type: TypeAIUsage},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Todo entity by its id.
-func (c *TodoClient) Get(ctx context.Context, id int) (*Todo, error) {
-	return c.Query().Where(todo.ID(id)).Only(ctx)
+// Get returns a AIUsage entity by its id.
+func (c *AIUsageClient) Get(ctx context.Context, id uuid.UUID) (*AIUsage, error) {
+	return c.Query().Where(aiusage.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TodoClient) GetX(ctx context.Context, id int) *Todo {
+func (c *AIUsageClient) GetX(ctx context.Context, id uuid.UUID) *AIUsage {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -327,15 +517,15 @@ func (c *TodoClient) GetX(ctx context.Context, id int) *Todo {
 	return obj
 }
 
-// QueryUser queries the user edge of a Todo.
-func (c *TodoClient) QueryUser(_m *Todo) *UserQuery {
+// QueryUser queries the user edge of a AIUsage.
+func (c *AIUsageClient) QueryUser(_m *AIUsage) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(todo.Table, todo.FieldID, id),
+			sqlgraph.From(aiusage.Table, aiusage.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, todo.UserTable, todo.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, aiusage.UserTable, aiusage.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -344,131 +534,131 @@ func (c *TodoClient) QueryUser(_m *Todo) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *TodoClient) Hooks() []Hook {
-	return c.hooks.Todo
+func (c *AIUsageClient) Hooks() []Hook {
+	return c.hooks.AIUsage
 }
 
 // Interceptors returns the client interceptors.
-func (c *TodoClient) Interceptors() []Interceptor {
-	return c.inters.Todo
+func (c *AIUsageClient) Interceptors() []Interceptor {
+	return c.inters.AIUsage
 }
 
-func (c *TodoClient) mutate(ctx context.Context, m *TodoMutation) (Value, error) {
+func (c *AIUsageClient) mutate(ctx context.Context, m *AIUsageMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TodoCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AIUsageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TodoUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AIUsageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TodoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AIUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TodoDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AIUsageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Todo mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AIUsage mutation op: %q", m.Op())
 	}
 }
 
-// TodoFilterHistoryClient is a client for the TodoFilterHistory schema.
-type TodoFilterHistoryClient struct {
+// AccountDeletionTokenClient is a client for the AccountDeletionToken schema.
+type AccountDeletionTokenClient struct {
 	config
 }
 
-// NewTodoFilterHistoryClient returns a client for the TodoFilterHistory from the given config.
-func NewTodoFilterHistoryClient(c config) *TodoFilterHistoryClient {
-	return &TodoFilterHistoryClient{config: c}
+// NewAccountDeletionTokenClient returns a client for the AccountDeletionToken from the given config.
+func NewAccountDeletionTokenClient(c config) *AccountDeletionTokenClient {
+	return &AccountDeletionTokenClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `todofilterhistory.Hooks(f(g(h())))`.
-func (c *TodoFilterHistoryClient) Use(hooks ...Hook) {
-	c.hooks.TodoFilterHistory = append(c.hooks.TodoFilterHistory, hooks...)
+// A call to `Use(f, g, h)` equals to `accountdeletiontoken.Hooks(f(g(h())))`.
+func (c *AccountDeletionTokenClient) Use(hooks ...Hook) {
+	c.hooks.AccountDeletionToken = append(c.hooks.AccountDeletionToken, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `todofilterhistory.Intercept(f(g(h())))`.
-func (c *TodoFilterHistoryClient) Intercept(interceptors ...Interceptor) {
-	c.inters.TodoFilterHistory = append(c.inters.TodoFilterHistory, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `accountdeletiontoken.Intercept(f(g(h())))`.
+func (c *AccountDeletionTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AccountDeletionToken = append(c.inters.AccountDeletionToken, interceptors...)
 }
 
-// Create returns a builder for creating a TodoFilterHistory entity.
-func (c *TodoFilterHistoryClient) Create() *TodoFilterHistoryCreate {
-	mutation := newTodoFilterHistoryMutation(c.config, OpCreate)
-	return &TodoFilterHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AccountDeletionToken entity.
+func (c *AccountDeletionTokenClient) Create() *AccountDeletionTokenCreate {
+	mutation := newAccountDeletionTokenMutation(c.config, OpCreate)
+	return &AccountDeletionTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of TodoFilterHistory entities.
-func (c *TodoFilterHistoryClient) CreateBulk(builders ...*TodoFilterHistoryCreate) *TodoFilterHistoryCreateBulk {
-	return &TodoFilterHistoryCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AccountDeletionToken entities.
+func (c *AccountDeletionTokenClient) CreateBulk(builders ...*AccountDeletionTokenCreate) *AccountDeletionTokenCreateBulk {
+	return &AccountDeletionTokenCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TodoFilterHistoryClient) MapCreateBulk(slice any, setFunc func(*TodoFilterHistoryCreate, int)) *TodoFilterHistoryCreateBulk {
+func (c *AccountDeletionTokenClient) MapCreateBulk(slice any, setFunc func(*AccountDeletionTokenCreate, int)) *AccountDeletionTokenCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TodoFilterHistoryCreateBulk{err: fmt.Errorf("calling to TodoFilterHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AccountDeletionTokenCreateBulk{err: fmt.Errorf("calling to AccountDeletionTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TodoFilterHistoryCreate, rv.Len())
+	builders := make([]*AccountDeletionTokenCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TodoFilterHistoryCreateBulk{config: c.config, builders: builders}
+	return &AccountDeletionTokenCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for TodoFilterHistory.
-func (c *TodoFilterHistoryClient) Update() *TodoFilterHistoryUpdate {
-	mutation := newTodoFilterHistoryMutation(c.config, OpUpdate)
-	return &TodoFilterHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AccountDeletionToken.
+func (c *AccountDeletionTokenClient) Update() *AccountDeletionTokenUpdate {
+	mutation := newAccountDeletionTokenMutation(c.config, OpUpdate)
+	return &AccountDeletionTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TodoFilterHistoryClient) UpdateOne(_m *TodoFilterHistory) *TodoFilterHistoryUpdateOne {
-	mutation := newTodoFilterHistoryMutation(c.config, OpUpdateOne, withTodoFilterHistory(_m))
-	return &TodoFilterHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AccountDeletionTokenClient) UpdateOne(_m *AccountDeletionToken) *AccountDeletionTokenUpdateOne {
+	mutation := newAccountDeletionTokenMutation(c.config, OpUpdateOne, withAccountDeletionToken(_m))
+	return &AccountDeletionTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TodoFilterHistoryClient) UpdateOneID(id uuid.UUID) *TodoFilterHistoryUpdateOne {
-	mutation := newTodoFilterHistoryMutation(c.config, OpUpdateOne, withTodoFilterHistoryID(id))
-	return &TodoFilterHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AccountDeletionTokenClient) UpdateOneID(id uuid.UUID) *AccountDeletionTokenUpdateOne {
+	mutation := newAccountDeletionTokenMutation(c.config, OpUpdateOne, withAccountDeletionTokenID(id))
+	return &AccountDeletionTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for TodoFilterHistory.
-func (c *TodoFilterHistoryClient) Delete() *TodoFilterHistoryDelete {
-	mutation := newTodoFilterHistoryMutation(c.config, OpDelete)
-	return &TodoFilterHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AccountDeletionToken.
+func (c *AccountDeletionTokenClient) Delete() *AccountDeletionTokenDelete {
+	mutation := newAccountDeletionTokenMutation(c.config, OpDelete)
+	return &AccountDeletionTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TodoFilterHistoryClient) DeleteOne(_m *TodoFilterHistory) *TodoFilterHistoryDeleteOne {
+func (c *AccountDeletionTokenClient) DeleteOne(_m *AccountDeletionToken) *AccountDeletionTokenDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TodoFilterHistoryClient) DeleteOneID(id uuid.UUID) *TodoFilterHistoryDeleteOne {
-	builder := c.Delete().Where(todofilterhistory.ID(id))
+func (c *AccountDeletionTokenClient) DeleteOneID(id uuid.UUID) *AccountDeletionTokenDeleteOne {
+	builder := c.Delete().Where(accountdeletiontoken.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TodoFilterHistoryDeleteOne{builder}
+	return &AccountDeletionTokenDeleteOne{builder}
 }
 
-// Query returns a query builder for TodoFilterHistory.
-func (c *TodoFilterHistoryClient) Query() *TodoFilterHistoryQuery {
-	return &TodoFilterHistoryQuery{
+// Query returns a query builder for AccountDeletionToken.
+func (c *AccountDeletionTokenClient) Query() *AccountDeletionTokenQuery {
+	return &AccountDeletionTokenQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTodoFilterHistory},
+		ctx:    &QueryContext{Type: TypeAccountDeletionToken},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a TodoFilterHistory entity by its id.
-func (c *TodoFilterHistoryClient) Get(ctx context.Context, id uuid.UUID) (*TodoFilterHistory, error) {
-	return c.Query().Where(todofilterhistory.ID(id)).Only(ctx)
+// Get returns a AccountDeletionToken entity by its id.
+func (c *AccountDeletionTokenClient) Get(ctx context.Context, id uuid.UUID) (*AccountDeletionToken, error) {
+	return c.Query().Where(accountdeletiontoken.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TodoFilterHistoryClient) GetX(ctx context.Context, id uuid.UUID) *TodoFilterHistory {
+func (c *AccountDeletionTokenClient) GetX(ctx context.Context, id uuid.UUID) *AccountDeletionToken {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -476,15 +666,15 @@ func (c *TodoFilterHistoryClient) GetX(ctx context.Context, id uuid.UUID) *TodoF
 	return obj
 }
 
-// QueryUser queries the user edge of a TodoFilterHistory.
-func (c *TodoFilterHistoryClient) QueryUser(_m *TodoFilterHistory) *UserQuery {
+// QueryUser queries the user edge of a AccountDeletionToken.
+func (c *AccountDeletionTokenClient) QueryUser(_m *AccountDeletionToken) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(todofilterhistory.Table, todofilterhistory.FieldID, id),
+			sqlgraph.From(accountdeletiontoken.Table, accountdeletiontoken.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, todofilterhistory.UserTable, todofilterhistory.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, accountdeletiontoken.UserTable, accountdeletiontoken.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -493,131 +683,131 @@ func (c *TodoFilterHistoryClient) QueryUser(_m *TodoFilterHistory) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *TodoFilterHistoryClient) Hooks() []Hook {
-	return c.hooks.TodoFilterHistory
+func (c *AccountDeletionTokenClient) Hooks() []Hook {
+	return c.hooks.AccountDeletionToken
 }
 
 // Interceptors returns the client interceptors.
-func (c *TodoFilterHistoryClient) Interceptors() []Interceptor {
-	return c.inters.TodoFilterHistory
+func (c *AccountDeletionTokenClient) Interceptors() []Interceptor {
+	return c.inters.AccountDeletionToken
 }
 
-func (c *TodoFilterHistoryClient) mutate(ctx context.Context, m *TodoFilterHistoryMutation) (Value, error) {
+func (c *AccountDeletionTokenClient) mutate(ctx context.Context, m *AccountDeletionTokenMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TodoFilterHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AccountDeletionTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TodoFilterHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AccountDeletionTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TodoFilterHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AccountDeletionTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TodoFilterHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AccountDeletionTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown TodoFilterHistory mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AccountDeletionToken mutation op: %q", m.Op())
 	}
 }
 
-// UserClient is a client for the User schema.
-type UserClient struct {
+// AdminAuditLogClient is a client for the AdminAuditLog schema.
+type AdminAuditLogClient struct {
 	config
 }
 
-// NewUserClient returns a client for the User from the given config.
-func NewUserClient(c config) *UserClient {
-	return &UserClient{config: c}
+// NewAdminAuditLogClient returns a client for the AdminAuditLog from the given config.
+func NewAdminAuditLogClient(c config) *AdminAuditLogClient {
+	return &AdminAuditLogClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
-func (c *UserClient) Use(hooks ...Hook) {
-	c.hooks.User = append(c.hooks.User, hooks...)
+// A call to `Use(f, g, h)` equals to `adminauditlog.Hooks(f(g(h())))`.
+func (c *AdminAuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AdminAuditLog = append(c.hooks.AdminAuditLog, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
-func (c *UserClient) Intercept(interceptors ...Interceptor) {
-	c.inters.User = append(c.inters.User, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `adminauditlog.Intercept(f(g(h())))`.
+func (c *AdminAuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AdminAuditLog = append(c.inters.AdminAuditLog, interceptors...)
 }
 
-// Create returns a builder for creating a User entity.
-func (c *UserClient) Create() *UserCreate {
-	mutation := newUserMutation(c.config, OpCreate)
-	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AdminAuditLog entity.
+func (c *AdminAuditLogClient) Create() *AdminAuditLogCreate {
+	mutation := newAdminAuditLogMutation(c.config, OpCreate)
+	return &AdminAuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of User entities.
-func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
-	return &UserCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AdminAuditLog entities.
+func (c *AdminAuditLogClient) CreateBulk(builders ...*AdminAuditLogCreate) *AdminAuditLogCreateBulk {
+	return &AdminAuditLogCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+func (c *AdminAuditLogClient) MapCreateBulk(slice any, setFunc func(*AdminAuditLogCreate, int)) *AdminAuditLogCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AdminAuditLogCreateBulk{err: fmt.Errorf("calling to AdminAuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UserCreate, rv.Len())
+	builders := make([]*AdminAuditLogCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UserCreateBulk{config: c.config, builders: builders}
+	return &AdminAuditLogCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for User.
-func (c *UserClient) Update() *UserUpdate {
-	mutation := newUserMutation(c.config, OpUpdate)
-	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AdminAuditLog.
+func (c *AdminAuditLogClient) Update() *AdminAuditLogUpdate {
+	mutation := newAdminAuditLogMutation(c.config, OpUpdate)
+	return &AdminAuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UserClient) UpdateOne(_m *User) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUser(_m))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdminAuditLogClient) UpdateOne(_m *AdminAuditLog) *AdminAuditLogUpdateOne {
+	mutation := newAdminAuditLogMutation(c.config, OpUpdateOne, withAdminAuditLog(_m))
+	return &AdminAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdminAuditLogClient) UpdateOneID(id uuid.UUID) *AdminAuditLogUpdateOne {
+	mutation := newAdminAuditLogMutation(c.config, OpUpdateOne, withAdminAuditLogID(id))
+	return &AdminAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for User.
-func (c *UserClient) Delete() *UserDelete {
-	mutation := newUserMutation(c.config, OpDelete)
-	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AdminAuditLog.
+func (c *AdminAuditLogClient) Delete() *AdminAuditLogDelete {
+	mutation := newAdminAuditLogMutation(c.config, OpDelete)
+	return &AdminAuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UserClient) DeleteOne(_m *User) *UserDeleteOne {
+func (c *AdminAuditLogClient) DeleteOne(_m *AdminAuditLog) *AdminAuditLogDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
-	builder := c.Delete().Where(user.ID(id))
+func (c *AdminAuditLogClient) DeleteOneID(id uuid.UUID) *AdminAuditLogDeleteOne {
+	builder := c.Delete().Where(adminauditlog.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UserDeleteOne{builder}
+	return &AdminAuditLogDeleteOne{builder}
 }
 
-// Query returns a query builder for User.
-func (c *UserClient) Query() *UserQuery {
-	return &UserQuery{
+// Query returns a query builder for AdminAuditLog.
+func (c *AdminAuditLogClient) Query() *AdminAuditLogQuery {
+	return &AdminAuditLogQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUser},
+		ctx:    &QueryContext{Type: TypeAdminAuditLog},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a User entity by its id.
-func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
-	return c.Query().Where(user.ID(id)).Only(ctx)
+// Get returns a AdminAuditLog entity by its id.
+func (c *AdminAuditLogClient) Get(ctx context.Context, id uuid.UUID) (*AdminAuditLog, error) {
+	return c.Query().Where(adminauditlog.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UserClient) GetX(ctx context.Context, id int) *User {
+func (c *AdminAuditLogClient) GetX(ctx context.Context, id uuid.UUID) *AdminAuditLog {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -625,69 +815,3963 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 	return obj
 }
 
-// QueryTodos queries the todos edge of a User.
-func (c *UserClient) QueryTodos(_m *User) *TodoQuery {
-	query := (&TodoClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(todo.Table, todo.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.TodosTable, user.TodosColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryTodoFilterHistories queries the todo_filter_histories edge of a User.
-func (c *UserClient) QueryTodoFilterHistories(_m *User) *TodoFilterHistoryQuery {
-	query := (&TodoFilterHistoryClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(todofilterhistory.Table, todofilterhistory.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.TodoFilterHistoriesTable, user.TodoFilterHistoriesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *UserClient) Hooks() []Hook {
-	return c.hooks.User
+func (c *AdminAuditLogClient) Hooks() []Hook {
+	return c.hooks.AdminAuditLog
 }
 
 // Interceptors returns the client interceptors.
-func (c *UserClient) Interceptors() []Interceptor {
-	return c.inters.User
+func (c *AdminAuditLogClient) Interceptors() []Interceptor {
+	return c.inters.AdminAuditLog
 }
 
-func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+func (c *AdminAuditLogClient) mutate(ctx context.Context, m *AdminAuditLogMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminAuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminAuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AdminAuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AdminAuditLog mutation op: %q", m.Op())
+	}
+}
+
+// EmailChangeTokenClient is a client for the EmailChangeToken schema.
+type EmailChangeTokenClient struct {
+	config
+}
+
+// NewEmailChangeTokenClient returns a client for the EmailChangeToken from the given config.
+func NewEmailChangeTokenClient(c config) *EmailChangeTokenClient {
+	return &EmailChangeTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `emailchangetoken.Hooks(f(g(h())))`.
+func (c *EmailChangeTokenClient) Use(hooks ...Hook) {
+	c.hooks.EmailChangeToken = append(c.hooks.EmailChangeToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `emailchangetoken.Intercept(f(g(h())))`.
+func (c *EmailChangeTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmailChangeToken = append(c.inters.EmailChangeToken, interceptors...)
+}
+
+// Create returns a builder for creating a EmailChangeToken entity.
+func (c *EmailChangeTokenClient) Create() *EmailChangeTokenCreate {
+	mutation := newEmailChangeTokenMutation(c.config, OpCreate)
+	return &EmailChangeTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmailChangeToken entities.
+func (c *EmailChangeTokenClient) CreateBulk(builders ...*EmailChangeTokenCreate) *EmailChangeTokenCreateBulk {
+	return &EmailChangeTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmailChangeTokenClient) MapCreateBulk(slice any, setFunc func(*EmailChangeTokenCreate, int)) *EmailChangeTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmailChangeTokenCreateBulk{err: fmt.Errorf("calling to EmailChangeTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmailChangeTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmailChangeTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmailChangeToken.
+func (c *EmailChangeTokenClient) Update() *EmailChangeTokenUpdate {
+	mutation := newEmailChangeTokenMutation(c.config, OpUpdate)
+	return &EmailChangeTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmailChangeTokenClient) UpdateOne(_m *EmailChangeToken) *EmailChangeTokenUpdateOne {
+	mutation := newEmailChangeTokenMutation(c.config, OpUpdateOne, withEmailChangeToken(_m))
+	return &EmailChangeTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmailChangeTokenClient) UpdateOneID(id uuid.UUID) *EmailChangeTokenUpdateOne {
+	mutation := newEmailChangeTokenMutation(c.config, OpUpdateOne, withEmailChangeTokenID(id))
+	return &EmailChangeTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmailChangeToken.
+func (c *EmailChangeTokenClient) Delete() *EmailChangeTokenDelete {
+	mutation := newEmailChangeTokenMutation(c.config, OpDelete)
+	return &EmailChangeTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmailChangeTokenClient) DeleteOne(_m *EmailChangeToken) *EmailChangeTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmailChangeTokenClient) DeleteOneID(id uuid.UUID) *EmailChangeTokenDeleteOne {
+	builder := c.Delete().Where(emailchangetoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmailChangeTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for EmailChangeToken.
+func (c *EmailChangeTokenClient) Query() *EmailChangeTokenQuery {
+	return &EmailChangeTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmailChangeToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmailChangeToken entity by its id.
+func (c *EmailChangeTokenClient) Get(ctx context.Context, id uuid.UUID) (*EmailChangeToken, error) {
+	return c.Query().Where(emailchangetoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmailChangeTokenClient) GetX(ctx context.Context, id uuid.UUID) *EmailChangeToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a EmailChangeToken.
+func (c *EmailChangeTokenClient) QueryUser(_m *EmailChangeToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emailchangetoken.Table, emailchangetoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, emailchangetoken.UserTable, emailchangetoken.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EmailChangeTokenClient) Hooks() []Hook {
+	return c.hooks.EmailChangeToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmailChangeTokenClient) Interceptors() []Interceptor {
+	return c.inters.EmailChangeToken
+}
+
+func (c *EmailChangeTokenClient) mutate(ctx context.Context, m *EmailChangeTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmailChangeTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmailChangeTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmailChangeTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmailChangeTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmailChangeToken mutation op: %q", m.Op())
+	}
+}
+
+// LoginAttemptClient is a client for the LoginAttempt schema.
+type LoginAttemptClient struct {
+	config
+}
+
+// NewLoginAttemptClient returns a client for the LoginAttempt from the given config.
+func NewLoginAttemptClient(c config) *LoginAttemptClient {
+	return &LoginAttemptClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `loginattempt.Hooks(f(g(h())))`.
+func (c *LoginAttemptClient) Use(hooks ...Hook) {
+	c.hooks.LoginAttempt = append(c.hooks.LoginAttempt, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `loginattempt.Intercept(f(g(h())))`.
+func (c *LoginAttemptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LoginAttempt = append(c.inters.LoginAttempt, interceptors...)
+}
+
+// Create returns a builder for creating a LoginAttempt entity.
+func (c *LoginAttemptClient) Create() *LoginAttemptCreate {
+	mutation := newLoginAttemptMutation(c.config, OpCreate)
+	return &LoginAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LoginAttempt entities.
+func (c *LoginAttemptClient) CreateBulk(builders ...*LoginAttemptCreate) *LoginAttemptCreateBulk {
+	return &LoginAttemptCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LoginAttemptClient) MapCreateBulk(slice any, setFunc func(*LoginAttemptCreate, int)) *LoginAttemptCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LoginAttemptCreateBulk{err: fmt.Errorf("calling to LoginAttemptClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LoginAttemptCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LoginAttemptCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LoginAttempt.
+func (c *LoginAttemptClient) Update() *LoginAttemptUpdate {
+	mutation := newLoginAttemptMutation(c.config, OpUpdate)
+	return &LoginAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LoginAttemptClient) UpdateOne(_m *LoginAttempt) *LoginAttemptUpdateOne {
+	mutation := newLoginAttemptMutation(c.config, OpUpdateOne, withLoginAttempt(_m))
+	return &LoginAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LoginAttemptClient) UpdateOneID(id int) *LoginAttemptUpdateOne {
+	mutation := newLoginAttemptMutation(c.config, OpUpdateOne, withLoginAttemptID(id))
+	return &LoginAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LoginAttempt.
+func (c *LoginAttemptClient) Delete() *LoginAttemptDelete {
+	mutation := newLoginAttemptMutation(c.config, OpDelete)
+	return &LoginAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LoginAttemptClient) DeleteOne(_m *LoginAttempt) *LoginAttemptDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LoginAttemptClient) DeleteOneID(id int) *LoginAttemptDeleteOne {
+	builder := c.Delete().Where(loginattempt.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LoginAttemptDeleteOne{builder}
+}
+
+// Query returns a query builder for LoginAttempt.
+func (c *LoginAttemptClient) Query() *LoginAttemptQuery {
+	return &LoginAttemptQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLoginAttempt},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LoginAttempt entity by its id.
+func (c *LoginAttemptClient) Get(ctx context.Context, id int) (*LoginAttempt, error) {
+	return c.Query().Where(loginattempt.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LoginAttemptClient) GetX(ctx context.Context, id int) *LoginAttempt {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a LoginAttempt.
+func (c *LoginAttemptClient) QueryUser(_m *LoginAttempt) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loginattempt.Table, loginattempt.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, loginattempt.UserTable, loginattempt.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LoginAttemptClient) Hooks() []Hook {
+	return c.hooks.LoginAttempt
+}
+
+// Interceptors returns the client interceptors.
+func (c *LoginAttemptClient) Interceptors() []Interceptor {
+	return c.inters.LoginAttempt
+}
+
+func (c *LoginAttemptClient) mutate(ctx context.Context, m *LoginAttemptMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LoginAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LoginAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LoginAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LoginAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LoginAttempt mutation op: %q", m.Op())
+	}
+}
+
+// PasswordResetTokenClient is a client for the PasswordResetToken schema.
+type PasswordResetTokenClient struct {
+	config
+}
+
+// NewPasswordResetTokenClient returns a client for the PasswordResetToken from the given config.
+func NewPasswordResetTokenClient(c config) *PasswordResetTokenClient {
+	return &PasswordResetTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `passwordresettoken.Hooks(f(g(h())))`.
+func (c *PasswordResetTokenClient) Use(hooks ...Hook) {
+	c.hooks.PasswordResetToken = append(c.hooks.PasswordResetToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `passwordresettoken.Intercept(f(g(h())))`.
+func (c *PasswordResetTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PasswordResetToken = append(c.inters.PasswordResetToken, interceptors...)
+}
+
+// Create returns a builder for creating a PasswordResetToken entity.
+func (c *PasswordResetTokenClient) Create() *PasswordResetTokenCreate {
+	mutation := newPasswordResetTokenMutation(c.config, OpCreate)
+	return &PasswordResetTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PasswordResetToken entities.
+func (c *PasswordResetTokenClient) CreateBulk(builders ...*PasswordResetTokenCreate) *PasswordResetTokenCreateBulk {
+	return &PasswordResetTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PasswordResetTokenClient) MapCreateBulk(slice any, setFunc func(*PasswordResetTokenCreate, int)) *PasswordResetTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PasswordResetTokenCreateBulk{err: fmt.Errorf("calling to PasswordResetTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PasswordResetTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PasswordResetTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PasswordResetToken.
+func (c *PasswordResetTokenClient) Update() *PasswordResetTokenUpdate {
+	mutation := newPasswordResetTokenMutation(c.config, OpUpdate)
+	return &PasswordResetTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PasswordResetTokenClient) UpdateOne(_m *PasswordResetToken) *PasswordResetTokenUpdateOne {
+	mutation := newPasswordResetTokenMutation(c.config, OpUpdateOne, withPasswordResetToken(_m))
+	return &PasswordResetTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PasswordResetTokenClient) UpdateOneID(id uuid.UUID) *PasswordResetTokenUpdateOne {
+	mutation := newPasswordResetTokenMutation(c.config, OpUpdateOne, withPasswordResetTokenID(id))
+	return &PasswordResetTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PasswordResetToken.
+func (c *PasswordResetTokenClient) Delete() *PasswordResetTokenDelete {
+	mutation := newPasswordResetTokenMutation(c.config, OpDelete)
+	return &PasswordResetTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PasswordResetTokenClient) DeleteOne(_m *PasswordResetToken) *PasswordResetTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PasswordResetTokenClient) DeleteOneID(id uuid.UUID) *PasswordResetTokenDeleteOne {
+	builder := c.Delete().Where(passwordresettoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PasswordResetTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for PasswordResetToken.
+func (c *PasswordResetTokenClient) Query() *PasswordResetTokenQuery {
+	return &PasswordResetTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePasswordResetToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PasswordResetToken entity by its id.
+func (c *PasswordResetTokenClient) Get(ctx context.Context, id uuid.UUID) (*PasswordResetToken, error) {
+	return c.Query().Where(passwordresettoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PasswordResetTokenClient) GetX(ctx context.Context, id uuid.UUID) *PasswordResetToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a PasswordResetToken.
+func (c *PasswordResetTokenClient) QueryUser(_m *PasswordResetToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(passwordresettoken.Table, passwordresettoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, passwordresettoken.UserTable, passwordresettoken.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PasswordResetTokenClient) Hooks() []Hook {
+	return c.hooks.PasswordResetToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *PasswordResetTokenClient) Interceptors() []Interceptor {
+	return c.inters.PasswordResetToken
+}
+
+func (c *PasswordResetTokenClient) mutate(ctx context.Context, m *PasswordResetTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PasswordResetTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PasswordResetTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PasswordResetTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PasswordResetTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PasswordResetToken mutation op: %q", m.Op())
+	}
+}
+
+// PersonalAccessTokenClient is a client for the PersonalAccessToken schema.
+type PersonalAccessTokenClient struct {
+	config
+}
+
+// NewPersonalAccessTokenClient returns a client for the PersonalAccessToken from the given config.
+func NewPersonalAccessTokenClient(c config) *PersonalAccessTokenClient {
+	return &PersonalAccessTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `personalaccesstoken.Hooks(f(g(h())))`.
+func (c *PersonalAccessTokenClient) Use(hooks ...Hook) {
+	c.hooks.PersonalAccessToken = append(c.hooks.PersonalAccessToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `personalaccesstoken.Intercept(f(g(h())))`.
+func (c *PersonalAccessTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PersonalAccessToken = append(c.inters.PersonalAccessToken, interceptors...)
+}
+
+// Create returns a builder for creating a PersonalAccessToken entity.
+func (c *PersonalAccessTokenClient) Create() *PersonalAccessTokenCreate {
+	mutation := newPersonalAccessTokenMutation(c.config, OpCreate)
+	return &PersonalAccessTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PersonalAccessToken entities.
+func (c *PersonalAccessTokenClient) CreateBulk(builders ...*PersonalAccessTokenCreate) *PersonalAccessTokenCreateBulk {
+	return &PersonalAccessTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PersonalAccessTokenClient) MapCreateBulk(slice any, setFunc func(*PersonalAccessTokenCreate, int)) *PersonalAccessTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PersonalAccessTokenCreateBulk{err: fmt.Errorf("calling to PersonalAccessTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PersonalAccessTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PersonalAccessTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PersonalAccessToken.
+func (c *PersonalAccessTokenClient) Update() *PersonalAccessTokenUpdate {
+	mutation := newPersonalAccessTokenMutation(c.config, OpUpdate)
+	return &PersonalAccessTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PersonalAccessTokenClient) UpdateOne(_m *PersonalAccessToken) *PersonalAccessTokenUpdateOne {
+	mutation := newPersonalAccessTokenMutation(c.config, OpUpdateOne, withPersonalAccessToken(_m))
+	return &PersonalAccessTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PersonalAccessTokenClient) UpdateOneID(id uuid.UUID) *PersonalAccessTokenUpdateOne {
+	mutation := newPersonalAccessTokenMutation(c.config, OpUpdateOne, withPersonalAccessTokenID(id))
+	return &PersonalAccessTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PersonalAccessToken.
+func (c *PersonalAccessTokenClient) Delete() *PersonalAccessTokenDelete {
+	mutation := newPersonalAccessTokenMutation(c.config, OpDelete)
+	return &PersonalAccessTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PersonalAccessTokenClient) DeleteOne(_m *PersonalAccessToken) *PersonalAccessTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PersonalAccessTokenClient) DeleteOneID(id uuid.UUID) *PersonalAccessTokenDeleteOne {
+	builder := c.Delete().Where(personalaccesstoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PersonalAccessTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for PersonalAccessToken.
+func (c *PersonalAccessTokenClient) Query() *PersonalAccessTokenQuery {
+	return &PersonalAccessTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePersonalAccessToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PersonalAccessToken entity by its id.
+func (c *PersonalAccessTokenClient) Get(ctx context.Context, id uuid.UUID) (*PersonalAccessToken, error) {
+	return c.Query().Where(personalaccesstoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PersonalAccessTokenClient) GetX(ctx context.Context, id uuid.UUID) *PersonalAccessToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a PersonalAccessToken.
+func (c *PersonalAccessTokenClient) QueryUser(_m *PersonalAccessToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(personalaccesstoken.Table, personalaccesstoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, personalaccesstoken.UserTable, personalaccesstoken.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PersonalAccessTokenClient) Hooks() []Hook {
+	return c.hooks.PersonalAccessToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *PersonalAccessTokenClient) Interceptors() []Interceptor {
+	return c.inters.PersonalAccessToken
+}
+
+func (c *PersonalAccessTokenClient) mutate(ctx context.Context, m *PersonalAccessTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PersonalAccessTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PersonalAccessTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PersonalAccessTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PersonalAccessTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PersonalAccessToken mutation op: %q", m.Op())
+	}
+}
+
+// RecoveryCodeClient is a client for the RecoveryCode schema.
+type RecoveryCodeClient struct {
+	config
+}
+
+// NewRecoveryCodeClient returns a client for the RecoveryCode from the given config.
+func NewRecoveryCodeClient(c config) *RecoveryCodeClient {
+	return &RecoveryCodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `recoverycode.Hooks(f(g(h())))`.
+func (c *RecoveryCodeClient) Use(hooks ...Hook) {
+	c.hooks.RecoveryCode = append(c.hooks.RecoveryCode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `recoverycode.Intercept(f(g(h())))`.
+func (c *RecoveryCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RecoveryCode = append(c.inters.RecoveryCode, interceptors...)
+}
+
+// Create returns a builder for creating a RecoveryCode entity.
+func (c *RecoveryCodeClient) Create() *RecoveryCodeCreate {
+	mutation := newRecoveryCodeMutation(c.config, OpCreate)
+	return &RecoveryCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RecoveryCode entities.
+func (c *RecoveryCodeClient) CreateBulk(builders ...*RecoveryCodeCreate) *RecoveryCodeCreateBulk {
+	return &RecoveryCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RecoveryCodeClient) MapCreateBulk(slice any, setFunc func(*RecoveryCodeCreate, int)) *RecoveryCodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RecoveryCodeCreateBulk{err: fmt.Errorf("calling to RecoveryCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RecoveryCodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RecoveryCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RecoveryCode.
+func (c *RecoveryCodeClient) Update() *RecoveryCodeUpdate {
+	mutation := newRecoveryCodeMutation(c.config, OpUpdate)
+	return &RecoveryCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RecoveryCodeClient) UpdateOne(_m *RecoveryCode) *RecoveryCodeUpdateOne {
+	mutation := newRecoveryCodeMutation(c.config, OpUpdateOne, withRecoveryCode(_m))
+	return &RecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RecoveryCodeClient) UpdateOneID(id int) *RecoveryCodeUpdateOne {
+	mutation := newRecoveryCodeMutation(c.config, OpUpdateOne, withRecoveryCodeID(id))
+	return &RecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RecoveryCode.
+func (c *RecoveryCodeClient) Delete() *RecoveryCodeDelete {
+	mutation := newRecoveryCodeMutation(c.config, OpDelete)
+	return &RecoveryCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RecoveryCodeClient) DeleteOne(_m *RecoveryCode) *RecoveryCodeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RecoveryCodeClient) DeleteOneID(id int) *RecoveryCodeDeleteOne {
+	builder := c.Delete().Where(recoverycode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RecoveryCodeDeleteOne{builder}
+}
+
+// Query returns a query builder for RecoveryCode.
+func (c *RecoveryCodeClient) Query() *RecoveryCodeQuery {
+	return &RecoveryCodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRecoveryCode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RecoveryCode entity by its id.
+func (c *RecoveryCodeClient) Get(ctx context.Context, id int) (*RecoveryCode, error) {
+	return c.Query().Where(recoverycode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RecoveryCodeClient) GetX(ctx context.Context, id int) *RecoveryCode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a RecoveryCode.
+func (c *RecoveryCodeClient) QueryUser(_m *RecoveryCode) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recoverycode.Table, recoverycode.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, recoverycode.UserTable, recoverycode.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RecoveryCodeClient) Hooks() []Hook {
+	return c.hooks.RecoveryCode
+}
+
+// Interceptors returns the client interceptors.
+func (c *RecoveryCodeClient) Interceptors() []Interceptor {
+	return c.inters.RecoveryCode
+}
+
+func (c *RecoveryCodeClient) mutate(ctx context.Context, m *RecoveryCodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RecoveryCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RecoveryCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RecoveryCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RecoveryCode mutation op: %q", m.Op())
+	}
+}
+
+// RefreshTokenClient is a client for the RefreshToken schema.
+type RefreshTokenClient struct {
+	config
+}
+
+// NewRefreshTokenClient returns a client for the RefreshToken from the given config.
+func NewRefreshTokenClient(c config) *RefreshTokenClient {
+	return &RefreshTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `refreshtoken.Hooks(f(g(h())))`.
+func (c *RefreshTokenClient) Use(hooks ...Hook) {
+	c.hooks.RefreshToken = append(c.hooks.RefreshToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `refreshtoken.Intercept(f(g(h())))`.
+func (c *RefreshTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RefreshToken = append(c.inters.RefreshToken, interceptors...)
+}
+
+// Create returns a builder for creating a RefreshToken entity.
+func (c *RefreshTokenClient) Create() *RefreshTokenCreate {
+	mutation := newRefreshTokenMutation(c.config, OpCreate)
+	return &RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RefreshToken entities.
+func (c *RefreshTokenClient) CreateBulk(builders ...*RefreshTokenCreate) *RefreshTokenCreateBulk {
+	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RefreshTokenClient) MapCreateBulk(slice any, setFunc func(*RefreshTokenCreate, int)) *RefreshTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RefreshTokenCreateBulk{err: fmt.Errorf("calling to RefreshTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RefreshTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RefreshToken.
+func (c *RefreshTokenClient) Update() *RefreshTokenUpdate {
+	mutation := newRefreshTokenMutation(c.config, OpUpdate)
+	return &RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RefreshTokenClient) UpdateOne(_m *RefreshToken) *RefreshTokenUpdateOne {
+	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshToken(_m))
+	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RefreshTokenClient) UpdateOneID(id uuid.UUID) *RefreshTokenUpdateOne {
+	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshTokenID(id))
+	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RefreshToken.
+func (c *RefreshTokenClient) Delete() *RefreshTokenDelete {
+	mutation := newRefreshTokenMutation(c.config, OpDelete)
+	return &RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RefreshTokenClient) DeleteOne(_m *RefreshToken) *RefreshTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RefreshTokenClient) DeleteOneID(id uuid.UUID) *RefreshTokenDeleteOne {
+	builder := c.Delete().Where(refreshtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RefreshTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for RefreshToken.
+func (c *RefreshTokenClient) Query() *RefreshTokenQuery {
+	return &RefreshTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRefreshToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RefreshToken entity by its id.
+func (c *RefreshTokenClient) Get(ctx context.Context, id uuid.UUID) (*RefreshToken, error) {
+	return c.Query().Where(refreshtoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RefreshTokenClient) GetX(ctx context.Context, id uuid.UUID) *RefreshToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySession queries the session edge of a RefreshToken.
+func (c *RefreshTokenClient) QuerySession(_m *RefreshToken) *SessionQuery {
+	query := (&SessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(refreshtoken.Table, refreshtoken.FieldID, id),
+			sqlgraph.To(session.Table, session.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, refreshtoken.SessionTable, refreshtoken.SessionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RefreshTokenClient) Hooks() []Hook {
+	return c.hooks.RefreshToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *RefreshTokenClient) Interceptors() []Interceptor {
+	return c.inters.RefreshToken
+}
+
+func (c *RefreshTokenClient) mutate(ctx context.Context, m *RefreshTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RefreshToken mutation op: %q", m.Op())
+	}
+}
+
+// SessionClient is a client for the Session schema.
+type SessionClient struct {
+	config
+}
+
+// NewSessionClient returns a client for the Session from the given config.
+func NewSessionClient(c config) *SessionClient {
+	return &SessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `session.Hooks(f(g(h())))`.
+func (c *SessionClient) Use(hooks ...Hook) {
+	c.hooks.Session = append(c.hooks.Session, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `session.Intercept(f(g(h())))`.
+func (c *SessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Session = append(c.inters.Session, interceptors...)
+}
+
+// Create returns a builder for creating a Session entity.
+func (c *SessionClient) Create() *SessionCreate {
+	mutation := newSessionMutation(c.config, OpCreate)
+	return &SessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Session entities.
+func (c *SessionClient) CreateBulk(builders ...*SessionCreate) *SessionCreateBulk {
+	return &SessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SessionClient) MapCreateBulk(slice any, setFunc func(*SessionCreate, int)) *SessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SessionCreateBulk{err: fmt.Errorf("calling to SessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Session.
+func (c *SessionClient) Update() *SessionUpdate {
+	mutation := newSessionMutation(c.config, OpUpdate)
+	return &SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SessionClient) UpdateOne(_m *Session) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSession(_m))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SessionClient) UpdateOneID(id uuid.UUID) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSessionID(id))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Session.
+func (c *SessionClient) Delete() *SessionDelete {
+	mutation := newSessionMutation(c.config, OpDelete)
+	return &SessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SessionClient) DeleteOne(_m *Session) *SessionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SessionClient) DeleteOneID(id uuid.UUID) *SessionDeleteOne {
+	builder := c.Delete().Where(session.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SessionDeleteOne{builder}
+}
+
+// Query returns a query builder for Session.
+func (c *SessionClient) Query() *SessionQuery {
+	return &SessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Session entity by its id.
+func (c *SessionClient) Get(ctx context.Context, id uuid.UUID) (*Session, error) {
+	return c.Query().Where(session.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SessionClient) GetX(ctx context.Context, id uuid.UUID) *Session {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Session.
+func (c *SessionClient) QueryUser(_m *Session) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(session.Table, session.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, session.UserTable, session.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRefreshTokens queries the refresh_tokens edge of a Session.
+func (c *SessionClient) QueryRefreshTokens(_m *Session) *RefreshTokenQuery {
+	query := (&RefreshTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(session.Table, session.FieldID, id),
+			sqlgraph.To(refreshtoken.Table, refreshtoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, session.RefreshTokensTable, session.RefreshTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SessionClient) Hooks() []Hook {
+	return c.hooks.Session
+}
+
+// Interceptors returns the client interceptors.
+func (c *SessionClient) Interceptors() []Interceptor {
+	return c.inters.Session
+}
+
+func (c *SessionClient) mutate(ctx context.Context, m *SessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Session mutation op: %q", m.Op())
+	}
+}
+
+// TOTPCredentialClient is a client for the TOTPCredential schema.
+type TOTPCredentialClient struct {
+	config
+}
+
+// NewTOTPCredentialClient returns a client for the TOTPCredential from the given config.
+func NewTOTPCredentialClient(c config) *TOTPCredentialClient {
+	return &TOTPCredentialClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `totpcredential.Hooks(f(g(h())))`.
+func (c *TOTPCredentialClient) Use(hooks ...Hook) {
+	c.hooks.TOTPCredential = append(c.hooks.TOTPCredential, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `totpcredential.Intercept(f(g(h())))`.
+func (c *TOTPCredentialClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TOTPCredential = append(c.inters.TOTPCredential, interceptors...)
+}
+
+// Create returns a builder for creating a TOTPCredential entity.
+func (c *TOTPCredentialClient) Create() *TOTPCredentialCreate {
+	mutation := newTOTPCredentialMutation(c.config, OpCreate)
+	return &TOTPCredentialCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TOTPCredential entities.
+func (c *TOTPCredentialClient) CreateBulk(builders ...*TOTPCredentialCreate) *TOTPCredentialCreateBulk {
+	return &TOTPCredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TOTPCredentialClient) MapCreateBulk(slice any, setFunc func(*TOTPCredentialCreate, int)) *TOTPCredentialCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TOTPCredentialCreateBulk{err: fmt.Errorf("calling to TOTPCredentialClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TOTPCredentialCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TOTPCredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TOTPCredential.
+func (c *TOTPCredentialClient) Update() *TOTPCredentialUpdate {
+	mutation := newTOTPCredentialMutation(c.config, OpUpdate)
+	return &TOTPCredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TOTPCredentialClient) UpdateOne(_m *TOTPCredential) *TOTPCredentialUpdateOne {
+	mutation := newTOTPCredentialMutation(c.config, OpUpdateOne, withTOTPCredential(_m))
+	return &TOTPCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TOTPCredentialClient) UpdateOneID(id int) *TOTPCredentialUpdateOne {
+	mutation := newTOTPCredentialMutation(c.config, OpUpdateOne, withTOTPCredentialID(id))
+	return &TOTPCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TOTPCredential.
+func (c *TOTPCredentialClient) Delete() *TOTPCredentialDelete {
+	mutation := newTOTPCredentialMutation(c.config, OpDelete)
+	return &TOTPCredentialDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TOTPCredentialClient) DeleteOne(_m *TOTPCredential) *TOTPCredentialDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TOTPCredentialClient) DeleteOneID(id int) *TOTPCredentialDeleteOne {
+	builder := c.Delete().Where(totpcredential.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TOTPCredentialDeleteOne{builder}
+}
+
+// Query returns a query builder for TOTPCredential.
+func (c *TOTPCredentialClient) Query() *TOTPCredentialQuery {
+	return &TOTPCredentialQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTOTPCredential},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TOTPCredential entity by its id.
+func (c *TOTPCredentialClient) Get(ctx context.Context, id int) (*TOTPCredential, error) {
+	return c.Query().Where(totpcredential.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TOTPCredentialClient) GetX(ctx context.Context, id int) *TOTPCredential {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a TOTPCredential.
+func (c *TOTPCredentialClient) QueryUser(_m *TOTPCredential) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(totpcredential.Table, totpcredential.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, totpcredential.UserTable, totpcredential.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TOTPCredentialClient) Hooks() []Hook {
+	return c.hooks.TOTPCredential
+}
+
+// Interceptors returns the client interceptors.
+func (c *TOTPCredentialClient) Interceptors() []Interceptor {
+	return c.inters.TOTPCredential
+}
+
+func (c *TOTPCredentialClient) mutate(ctx context.Context, m *TOTPCredentialMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TOTPCredentialCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TOTPCredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TOTPCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TOTPCredentialDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TOTPCredential mutation op: %q", m.Op())
+	}
+}
+
+// TodoClient is a client for the Todo schema.
+type TodoClient struct {
+	config
+}
+
+// NewTodoClient returns a client for the Todo from the given config.
+func NewTodoClient(c config) *TodoClient {
+	return &TodoClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `todo.Hooks(f(g(h())))`.
+func (c *TodoClient) Use(hooks ...Hook) {
+	c.hooks.Todo = append(c.hooks.Todo, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `todo.Intercept(f(g(h())))`.
+func (c *TodoClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Todo = append(c.inters.Todo, interceptors...)
+}
+
+// Create returns a builder for creating a Todo entity.
+func (c *TodoClient) Create() *TodoCreate {
+	mutation := newTodoMutation(c.config, OpCreate)
+	return &TodoCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Todo entities.
+func (c *TodoClient) CreateBulk(builders ...*TodoCreate) *TodoCreateBulk {
+	return &TodoCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TodoClient) MapCreateBulk(slice any, setFunc func(*TodoCreate, int)) *TodoCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TodoCreateBulk{err: fmt.Errorf("calling to TodoClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TodoCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TodoCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Todo.
+func (c *TodoClient) Update() *TodoUpdate {
+	mutation := newTodoMutation(c.config, OpUpdate)
+	return &TodoUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TodoClient) UpdateOne(_m *Todo) *TodoUpdateOne {
+	mutation := newTodoMutation(c.config, OpUpdateOne, withTodo(_m))
+	return &TodoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TodoClient) UpdateOneID(id int) *TodoUpdateOne {
+	mutation := newTodoMutation(c.config, OpUpdateOne, withTodoID(id))
+	return &TodoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Todo.
+func (c *TodoClient) Delete() *TodoDelete {
+	mutation := newTodoMutation(c.config, OpDelete)
+	return &TodoDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TodoClient) DeleteOne(_m *Todo) *TodoDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TodoClient) DeleteOneID(id int) *TodoDeleteOne {
+	builder := c.Delete().Where(todo.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TodoDeleteOne{builder}
+}
+
+// Query returns a query builder for Todo.
+func (c *TodoClient) Query() *TodoQuery {
+	return &TodoQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTodo},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Todo entity by its id.
+func (c *TodoClient) Get(ctx context.Context, id int) (*Todo, error) {
+	return c.Query().Where(todo.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TodoClient) GetX(ctx context.Context, id int) *Todo {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Todo.
+func (c *TodoClient) QueryUser(_m *Todo) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todo.Table, todo.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todo.UserTable, todo.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWorkspace queries the workspace edge of a Todo.
+func (c *TodoClient) QueryWorkspace(_m *Todo) *WorkspaceQuery {
+	query := (&WorkspaceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todo.Table, todo.FieldID, id),
+			sqlgraph.To(workspace.Table, workspace.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todo.WorkspaceTable, todo.WorkspaceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryList queries the list edge of a Todo.
+func (c *TodoClient) QueryList(_m *Todo) *TodoListQuery {
+	query := (&TodoListClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todo.Table, todo.FieldID, id),
+			sqlgraph.To(todolist.Table, todolist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todo.ListTable, todo.ListColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a Todo.
+func (c *TodoClient) QueryParent(_m *Todo) *TodoQuery {
+	query := (&TodoClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todo.Table, todo.FieldID, id),
+			sqlgraph.To(todo.Table, todo.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todo.ParentTable, todo.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Todo.
+func (c *TodoClient) QueryChildren(_m *Todo) *TodoQuery {
+	query := (&TodoClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todo.Table, todo.FieldID, id),
+			sqlgraph.To(todo.Table, todo.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todo.ChildrenTable, todo.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBreakdowns queries the breakdowns edge of a Todo.
+func (c *TodoClient) QueryBreakdowns(_m *Todo) *TodoBreakdownQuery {
+	query := (&TodoBreakdownClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todo.Table, todo.FieldID, id),
+			sqlgraph.To(todobreakdown.Table, todobreakdown.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todo.BreakdownsTable, todo.BreakdownsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEmbedding queries the embedding edge of a Todo.
+func (c *TodoClient) QueryEmbedding(_m *Todo) *TodoEmbeddingQuery {
+	query := (&TodoEmbeddingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todo.Table, todo.FieldID, id),
+			sqlgraph.To(todoembedding.Table, todoembedding.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, todo.EmbeddingTable, todo.EmbeddingColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TodoClient) Hooks() []Hook {
+	return c.hooks.Todo
+}
+
+// Interceptors returns the client interceptors.
+func (c *TodoClient) Interceptors() []Interceptor {
+	return c.inters.Todo
+}
+
+func (c *TodoClient) mutate(ctx context.Context, m *TodoMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TodoCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TodoUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TodoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TodoDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Todo mutation op: %q", m.Op())
+	}
+}
+
+// TodoBreakdownClient is a client for the TodoBreakdown schema.
+type TodoBreakdownClient struct {
+	config
+}
+
+// NewTodoBreakdownClient returns a client for the TodoBreakdown from the given config.
+func NewTodoBreakdownClient(c config) *TodoBreakdownClient {
+	return &TodoBreakdownClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `todobreakdown.Hooks(f(g(h())))`.
+func (c *TodoBreakdownClient) Use(hooks ...Hook) {
+	c.hooks.TodoBreakdown = append(c.hooks.TodoBreakdown, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `todobreakdown.Intercept(f(g(h())))`.
+func (c *TodoBreakdownClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TodoBreakdown = append(c.inters.TodoBreakdown, interceptors...)
+}
+
+// Create returns a builder for creating a TodoBreakdown entity.
+func (c *TodoBreakdownClient) Create() *TodoBreakdownCreate {
+	mutation := newTodoBreakdownMutation(c.config, OpCreate)
+	return &TodoBreakdownCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TodoBreakdown entities.
+func (c *TodoBreakdownClient) CreateBulk(builders ...*TodoBreakdownCreate) *TodoBreakdownCreateBulk {
+	return &TodoBreakdownCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TodoBreakdownClient) MapCreateBulk(slice any, setFunc func(*TodoBreakdownCreate, int)) *TodoBreakdownCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TodoBreakdownCreateBulk{err: fmt.Errorf("calling to TodoBreakdownClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TodoBreakdownCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TodoBreakdownCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TodoBreakdown.
+func (c *TodoBreakdownClient) Update() *TodoBreakdownUpdate {
+	mutation := newTodoBreakdownMutation(c.config, OpUpdate)
+	return &TodoBreakdownUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TodoBreakdownClient) UpdateOne(_m *TodoBreakdown) *TodoBreakdownUpdateOne {
+	mutation := newTodoBreakdownMutation(c.config, OpUpdateOne, withTodoBreakdown(_m))
+	return &TodoBreakdownUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TodoBreakdownClient) UpdateOneID(id uuid.UUID) *TodoBreakdownUpdateOne {
+	mutation := newTodoBreakdownMutation(c.config, OpUpdateOne, withTodoBreakdownID(id))
+	return &TodoBreakdownUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TodoBreakdown.
+func (c *TodoBreakdownClient) Delete() *TodoBreakdownDelete {
+	mutation := newTodoBreakdownMutation(c.config, OpDelete)
+	return &TodoBreakdownDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TodoBreakdownClient) DeleteOne(_m *TodoBreakdown) *TodoBreakdownDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TodoBreakdownClient) DeleteOneID(id uuid.UUID) *TodoBreakdownDeleteOne {
+	builder := c.Delete().Where(todobreakdown.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TodoBreakdownDeleteOne{builder}
+}
+
+// Query returns a query builder for TodoBreakdown.
+func (c *TodoBreakdownClient) Query() *TodoBreakdownQuery {
+	return &TodoBreakdownQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTodoBreakdown},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TodoBreakdown entity by its id.
+func (c *TodoBreakdownClient) Get(ctx context.Context, id uuid.UUID) (*TodoBreakdown, error) {
+	return c.Query().Where(todobreakdown.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TodoBreakdownClient) GetX(ctx context.Context, id uuid.UUID) *TodoBreakdown {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a TodoBreakdown.
+func (c *TodoBreakdownClient) QueryUser(_m *TodoBreakdown) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todobreakdown.Table, todobreakdown.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todobreakdown.UserTable, todobreakdown.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWorkspace queries the workspace edge of a TodoBreakdown.
+func (c *TodoBreakdownClient) QueryWorkspace(_m *TodoBreakdown) *WorkspaceQuery {
+	query := (&WorkspaceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todobreakdown.Table, todobreakdown.FieldID, id),
+			sqlgraph.To(workspace.Table, workspace.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todobreakdown.WorkspaceTable, todobreakdown.WorkspaceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTodo queries the todo edge of a TodoBreakdown.
+func (c *TodoBreakdownClient) QueryTodo(_m *TodoBreakdown) *TodoQuery {
+	query := (&TodoClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todobreakdown.Table, todobreakdown.FieldID, id),
+			sqlgraph.To(todo.Table, todo.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todobreakdown.TodoTable, todobreakdown.TodoColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TodoBreakdownClient) Hooks() []Hook {
+	return c.hooks.TodoBreakdown
+}
+
+// Interceptors returns the client interceptors.
+func (c *TodoBreakdownClient) Interceptors() []Interceptor {
+	return c.inters.TodoBreakdown
+}
+
+func (c *TodoBreakdownClient) mutate(ctx context.Context, m *TodoBreakdownMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TodoBreakdownCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TodoBreakdownUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TodoBreakdownUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TodoBreakdownDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TodoBreakdown mutation op: %q", m.Op())
+	}
+}
+
+// TodoEmbeddingClient is a client for the TodoEmbedding schema.
+type TodoEmbeddingClient struct {
+	config
+}
+
+// NewTodoEmbeddingClient returns a client for the TodoEmbedding from the given config.
+func NewTodoEmbeddingClient(c config) *TodoEmbeddingClient {
+	return &TodoEmbeddingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `todoembedding.Hooks(f(g(h())))`.
+func (c *TodoEmbeddingClient) Use(hooks ...Hook) {
+	c.hooks.TodoEmbedding = append(c.hooks.TodoEmbedding, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `todoembedding.Intercept(f(g(h())))`.
+func (c *TodoEmbeddingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TodoEmbedding = append(c.inters.TodoEmbedding, interceptors...)
+}
+
+// Create returns a builder for creating a TodoEmbedding entity.
+func (c *TodoEmbeddingClient) Create() *TodoEmbeddingCreate {
+	mutation := newTodoEmbeddingMutation(c.config, OpCreate)
+	return &TodoEmbeddingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TodoEmbedding entities.
+func (c *TodoEmbeddingClient) CreateBulk(builders ...*TodoEmbeddingCreate) *TodoEmbeddingCreateBulk {
+	return &TodoEmbeddingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TodoEmbeddingClient) MapCreateBulk(slice any, setFunc func(*TodoEmbeddingCreate, int)) *TodoEmbeddingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TodoEmbeddingCreateBulk{err: fmt.Errorf("calling to TodoEmbeddingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TodoEmbeddingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TodoEmbeddingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TodoEmbedding.
+func (c *TodoEmbeddingClient) Update() *TodoEmbeddingUpdate {
+	mutation := newTodoEmbeddingMutation(c.config, OpUpdate)
+	return &TodoEmbeddingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TodoEmbeddingClient) UpdateOne(_m *TodoEmbedding) *TodoEmbeddingUpdateOne {
+	mutation := newTodoEmbeddingMutation(c.config, OpUpdateOne, withTodoEmbedding(_m))
+	return &TodoEmbeddingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TodoEmbeddingClient) UpdateOneID(id uuid.UUID) *TodoEmbeddingUpdateOne {
+	mutation := newTodoEmbeddingMutation(c.config, OpUpdateOne, withTodoEmbeddingID(id))
+	return &TodoEmbeddingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TodoEmbedding.
+func (c *TodoEmbeddingClient) Delete() *TodoEmbeddingDelete {
+	mutation := newTodoEmbeddingMutation(c.config, OpDelete)
+	return &TodoEmbeddingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TodoEmbeddingClient) DeleteOne(_m *TodoEmbedding) *TodoEmbeddingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TodoEmbeddingClient) DeleteOneID(id uuid.UUID) *TodoEmbeddingDeleteOne {
+	builder := c.Delete().Where(todoembedding.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TodoEmbeddingDeleteOne{builder}
+}
+
+// Query returns a query builder for TodoEmbedding.
+func (c *TodoEmbeddingClient) Query() *TodoEmbeddingQuery {
+	return &TodoEmbeddingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTodoEmbedding},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TodoEmbedding entity by its id.
+func (c *TodoEmbeddingClient) Get(ctx context.Context, id uuid.UUID) (*TodoEmbedding, error) {
+	return c.Query().Where(todoembedding.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TodoEmbeddingClient) GetX(ctx context.Context, id uuid.UUID) *TodoEmbedding {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTodo queries the todo edge of a TodoEmbedding.
+func (c *TodoEmbeddingClient) QueryTodo(_m *TodoEmbedding) *TodoQuery {
+	query := (&TodoClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoembedding.Table, todoembedding.FieldID, id),
+			sqlgraph.To(todo.Table, todo.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, todoembedding.TodoTable, todoembedding.TodoColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TodoEmbeddingClient) Hooks() []Hook {
+	return c.hooks.TodoEmbedding
+}
+
+// Interceptors returns the client interceptors.
+func (c *TodoEmbeddingClient) Interceptors() []Interceptor {
+	return c.inters.TodoEmbedding
+}
+
+func (c *TodoEmbeddingClient) mutate(ctx context.Context, m *TodoEmbeddingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TodoEmbeddingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TodoEmbeddingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TodoEmbeddingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TodoEmbeddingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TodoEmbedding mutation op: %q", m.Op())
+	}
+}
+
+// TodoFilterHistoryClient is a client for the TodoFilterHistory schema.
+type TodoFilterHistoryClient struct {
+	config
+}
+
+// NewTodoFilterHistoryClient returns a client for the TodoFilterHistory from the given config.
+func NewTodoFilterHistoryClient(c config) *TodoFilterHistoryClient {
+	return &TodoFilterHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `todofilterhistory.Hooks(f(g(h())))`.
+func (c *TodoFilterHistoryClient) Use(hooks ...Hook) {
+	c.hooks.TodoFilterHistory = append(c.hooks.TodoFilterHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `todofilterhistory.Intercept(f(g(h())))`.
+func (c *TodoFilterHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TodoFilterHistory = append(c.inters.TodoFilterHistory, interceptors...)
+}
+
+// Create returns a builder for creating a TodoFilterHistory entity.
+func (c *TodoFilterHistoryClient) Create() *TodoFilterHistoryCreate {
+	mutation := newTodoFilterHistoryMutation(c.config, OpCreate)
+	return &TodoFilterHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TodoFilterHistory entities.
+func (c *TodoFilterHistoryClient) CreateBulk(builders ...*TodoFilterHistoryCreate) *TodoFilterHistoryCreateBulk {
+	return &TodoFilterHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TodoFilterHistoryClient) MapCreateBulk(slice any, setFunc func(*TodoFilterHistoryCreate, int)) *TodoFilterHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TodoFilterHistoryCreateBulk{err: fmt.Errorf("calling to TodoFilterHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TodoFilterHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TodoFilterHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TodoFilterHistory.
+func (c *TodoFilterHistoryClient) Update() *TodoFilterHistoryUpdate {
+	mutation := newTodoFilterHistoryMutation(c.config, OpUpdate)
+	return &TodoFilterHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TodoFilterHistoryClient) UpdateOne(_m *TodoFilterHistory) *TodoFilterHistoryUpdateOne {
+	mutation := newTodoFilterHistoryMutation(c.config, OpUpdateOne, withTodoFilterHistory(_m))
+	return &TodoFilterHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TodoFilterHistoryClient) UpdateOneID(id uuid.UUID) *TodoFilterHistoryUpdateOne {
+	mutation := newTodoFilterHistoryMutation(c.config, OpUpdateOne, withTodoFilterHistoryID(id))
+	return &TodoFilterHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TodoFilterHistory.
+func (c *TodoFilterHistoryClient) Delete() *TodoFilterHistoryDelete {
+	mutation := newTodoFilterHistoryMutation(c.config, OpDelete)
+	return &TodoFilterHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TodoFilterHistoryClient) DeleteOne(_m *TodoFilterHistory) *TodoFilterHistoryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TodoFilterHistoryClient) DeleteOneID(id uuid.UUID) *TodoFilterHistoryDeleteOne {
+	builder := c.Delete().Where(todofilterhistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TodoFilterHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for TodoFilterHistory.
+func (c *TodoFilterHistoryClient) Query() *TodoFilterHistoryQuery {
+	return &TodoFilterHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTodoFilterHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TodoFilterHistory entity by its id.
+func (c *TodoFilterHistoryClient) Get(ctx context.Context, id uuid.UUID) (*TodoFilterHistory, error) {
+	return c.Query().Where(todofilterhistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TodoFilterHistoryClient) GetX(ctx context.Context, id uuid.UUID) *TodoFilterHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a TodoFilterHistory.
+func (c *TodoFilterHistoryClient) QueryUser(_m *TodoFilterHistory) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todofilterhistory.Table, todofilterhistory.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todofilterhistory.UserTable, todofilterhistory.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWorkspace queries the workspace edge of a TodoFilterHistory.
+func (c *TodoFilterHistoryClient) QueryWorkspace(_m *TodoFilterHistory) *WorkspaceQuery {
+	query := (&WorkspaceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todofilterhistory.Table, todofilterhistory.FieldID, id),
+			sqlgraph.To(workspace.Table, workspace.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todofilterhistory.WorkspaceTable, todofilterhistory.WorkspaceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TodoFilterHistoryClient) Hooks() []Hook {
+	return c.hooks.TodoFilterHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *TodoFilterHistoryClient) Interceptors() []Interceptor {
+	return c.inters.TodoFilterHistory
+}
+
+func (c *TodoFilterHistoryClient) mutate(ctx context.Context, m *TodoFilterHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TodoFilterHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TodoFilterHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TodoFilterHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TodoFilterHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TodoFilterHistory mutation op: %q", m.Op())
+	}
+}
+
+// TodoListClient is a client for the TodoList schema.
+type TodoListClient struct {
+	config
+}
+
+// NewTodoListClient returns a client for the TodoList from the given config.
+func NewTodoListClient(c config) *TodoListClient {
+	return &TodoListClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `todolist.Hooks(f(g(h())))`.
+func (c *TodoListClient) Use(hooks ...Hook) {
+	c.hooks.TodoList = append(c.hooks.TodoList, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `todolist.Intercept(f(g(h())))`.
+func (c *TodoListClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TodoList = append(c.inters.TodoList, interceptors...)
+}
+
+// Create returns a builder for creating a TodoList entity.
+func (c *TodoListClient) Create() *TodoListCreate {
+	mutation := newTodoListMutation(c.config, OpCreate)
+	return &TodoListCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TodoList entities.
+func (c *TodoListClient) CreateBulk(builders ...*TodoListCreate) *TodoListCreateBulk {
+	return &TodoListCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TodoListClient) MapCreateBulk(slice any, setFunc func(*TodoListCreate, int)) *TodoListCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TodoListCreateBulk{err: fmt.Errorf("calling to TodoListClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TodoListCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TodoListCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TodoList.
+func (c *TodoListClient) Update() *TodoListUpdate {
+	mutation := newTodoListMutation(c.config, OpUpdate)
+	return &TodoListUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TodoListClient) UpdateOne(_m *TodoList) *TodoListUpdateOne {
+	mutation := newTodoListMutation(c.config, OpUpdateOne, withTodoList(_m))
+	return &TodoListUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TodoListClient) UpdateOneID(id int) *TodoListUpdateOne {
+	mutation := newTodoListMutation(c.config, OpUpdateOne, withTodoListID(id))
+	return &TodoListUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TodoList.
+func (c *TodoListClient) Delete() *TodoListDelete {
+	mutation := newTodoListMutation(c.config, OpDelete)
+	return &TodoListDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TodoListClient) DeleteOne(_m *TodoList) *TodoListDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TodoListClient) DeleteOneID(id int) *TodoListDeleteOne {
+	builder := c.Delete().Where(todolist.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TodoListDeleteOne{builder}
+}
+
+// Query returns a query builder for TodoList.
+func (c *TodoListClient) Query() *TodoListQuery {
+	return &TodoListQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTodoList},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TodoList entity by its id.
+func (c *TodoListClient) Get(ctx context.Context, id int) (*TodoList, error) {
+	return c.Query().Where(todolist.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TodoListClient) GetX(ctx context.Context, id int) *TodoList {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWorkspace queries the workspace edge of a TodoList.
+func (c *TodoListClient) QueryWorkspace(_m *TodoList) *WorkspaceQuery {
+	query := (&WorkspaceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todolist.Table, todolist.FieldID, id),
+			sqlgraph.To(workspace.Table, workspace.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todolist.WorkspaceTable, todolist.WorkspaceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMembers queries the members edge of a TodoList.
+func (c *TodoListClient) QueryMembers(_m *TodoList) *TodoListMemberQuery {
+	query := (&TodoListMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todolist.Table, todolist.FieldID, id),
+			sqlgraph.To(todolistmember.Table, todolistmember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todolist.MembersTable, todolist.MembersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvites queries the invites edge of a TodoList.
+func (c *TodoListClient) QueryInvites(_m *TodoList) *TodoListInviteQuery {
+	query := (&TodoListInviteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todolist.Table, todolist.FieldID, id),
+			sqlgraph.To(todolistinvite.Table, todolistinvite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todolist.InvitesTable, todolist.InvitesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTodos queries the todos edge of a TodoList.
+func (c *TodoListClient) QueryTodos(_m *TodoList) *TodoQuery {
+	query := (&TodoClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todolist.Table, todolist.FieldID, id),
+			sqlgraph.To(todo.Table, todo.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todolist.TodosTable, todolist.TodosColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TodoListClient) Hooks() []Hook {
+	return c.hooks.TodoList
+}
+
+// Interceptors returns the client interceptors.
+func (c *TodoListClient) Interceptors() []Interceptor {
+	return c.inters.TodoList
+}
+
+func (c *TodoListClient) mutate(ctx context.Context, m *TodoListMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TodoListCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TodoListUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TodoListUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TodoListDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TodoList mutation op: %q", m.Op())
+	}
+}
+
+// TodoListInviteClient is a client for the TodoListInvite schema.
+type TodoListInviteClient struct {
+	config
+}
+
+// NewTodoListInviteClient returns a client for the TodoListInvite from the given config.
+func NewTodoListInviteClient(c config) *TodoListInviteClient {
+	return &TodoListInviteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `todolistinvite.Hooks(f(g(h())))`.
+func (c *TodoListInviteClient) Use(hooks ...Hook) {
+	c.hooks.TodoListInvite = append(c.hooks.TodoListInvite, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `todolistinvite.Intercept(f(g(h())))`.
+func (c *TodoListInviteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TodoListInvite = append(c.inters.TodoListInvite, interceptors...)
+}
+
+// Create returns a builder for creating a TodoListInvite entity.
+func (c *TodoListInviteClient) Create() *TodoListInviteCreate {
+	mutation := newTodoListInviteMutation(c.config, OpCreate)
+	return &TodoListInviteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TodoListInvite entities.
+func (c *TodoListInviteClient) CreateBulk(builders ...*TodoListInviteCreate) *TodoListInviteCreateBulk {
+	return &TodoListInviteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TodoListInviteClient) MapCreateBulk(slice any, setFunc func(*TodoListInviteCreate, int)) *TodoListInviteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TodoListInviteCreateBulk{err: fmt.Errorf("calling to TodoListInviteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TodoListInviteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TodoListInviteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TodoListInvite.
+func (c *TodoListInviteClient) Update() *TodoListInviteUpdate {
+	mutation := newTodoListInviteMutation(c.config, OpUpdate)
+	return &TodoListInviteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TodoListInviteClient) UpdateOne(_m *TodoListInvite) *TodoListInviteUpdateOne {
+	mutation := newTodoListInviteMutation(c.config, OpUpdateOne, withTodoListInvite(_m))
+	return &TodoListInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TodoListInviteClient) UpdateOneID(id uuid.UUID) *TodoListInviteUpdateOne {
+	mutation := newTodoListInviteMutation(c.config, OpUpdateOne, withTodoListInviteID(id))
+	return &TodoListInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TodoListInvite.
+func (c *TodoListInviteClient) Delete() *TodoListInviteDelete {
+	mutation := newTodoListInviteMutation(c.config, OpDelete)
+	return &TodoListInviteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TodoListInviteClient) DeleteOne(_m *TodoListInvite) *TodoListInviteDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TodoListInviteClient) DeleteOneID(id uuid.UUID) *TodoListInviteDeleteOne {
+	builder := c.Delete().Where(todolistinvite.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TodoListInviteDeleteOne{builder}
+}
+
+// Query returns a query builder for TodoListInvite.
+func (c *TodoListInviteClient) Query() *TodoListInviteQuery {
+	return &TodoListInviteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTodoListInvite},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TodoListInvite entity by its id.
+func (c *TodoListInviteClient) Get(ctx context.Context, id uuid.UUID) (*TodoListInvite, error) {
+	return c.Query().Where(todolistinvite.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TodoListInviteClient) GetX(ctx context.Context, id uuid.UUID) *TodoListInvite {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryList queries the list edge of a TodoListInvite.
+func (c *TodoListInviteClient) QueryList(_m *TodoListInvite) *TodoListQuery {
+	query := (&TodoListClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todolistinvite.Table, todolistinvite.FieldID, id),
+			sqlgraph.To(todolist.Table, todolist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todolistinvite.ListTable, todolistinvite.ListColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TodoListInviteClient) Hooks() []Hook {
+	return c.hooks.TodoListInvite
+}
+
+// Interceptors returns the client interceptors.
+func (c *TodoListInviteClient) Interceptors() []Interceptor {
+	return c.inters.TodoListInvite
+}
+
+func (c *TodoListInviteClient) mutate(ctx context.Context, m *TodoListInviteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TodoListInviteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TodoListInviteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TodoListInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TodoListInviteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TodoListInvite mutation op: %q", m.Op())
+	}
+}
+
+// TodoListMemberClient is a client for the TodoListMember schema.
+type TodoListMemberClient struct {
+	config
+}
+
+// NewTodoListMemberClient returns a client for the TodoListMember from the given config.
+func NewTodoListMemberClient(c config) *TodoListMemberClient {
+	return &TodoListMemberClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `todolistmember.Hooks(f(g(h())))`.
+func (c *TodoListMemberClient) Use(hooks ...Hook) {
+	c.hooks.TodoListMember = append(c.hooks.TodoListMember, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `todolistmember.Intercept(f(g(h())))`.
+func (c *TodoListMemberClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TodoListMember = append(c.inters.TodoListMember, interceptors...)
+}
+
+// Create returns a builder for creating a TodoListMember entity.
+func (c *TodoListMemberClient) Create() *TodoListMemberCreate {
+	mutation := newTodoListMemberMutation(c.config, OpCreate)
+	return &TodoListMemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TodoListMember entities.
+func (c *TodoListMemberClient) CreateBulk(builders ...*TodoListMemberCreate) *TodoListMemberCreateBulk {
+	return &TodoListMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TodoListMemberClient) MapCreateBulk(slice any, setFunc func(*TodoListMemberCreate, int)) *TodoListMemberCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TodoListMemberCreateBulk{err: fmt.Errorf("calling to TodoListMemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TodoListMemberCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TodoListMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TodoListMember.
+func (c *TodoListMemberClient) Update() *TodoListMemberUpdate {
+	mutation := newTodoListMemberMutation(c.config, OpUpdate)
+	return &TodoListMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TodoListMemberClient) UpdateOne(_m *TodoListMember) *TodoListMemberUpdateOne {
+	mutation := newTodoListMemberMutation(c.config, OpUpdateOne, withTodoListMember(_m))
+	return &TodoListMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TodoListMemberClient) UpdateOneID(id int) *TodoListMemberUpdateOne {
+	mutation := newTodoListMemberMutation(c.config, OpUpdateOne, withTodoListMemberID(id))
+	return &TodoListMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TodoListMember.
+func (c *TodoListMemberClient) Delete() *TodoListMemberDelete {
+	mutation := newTodoListMemberMutation(c.config, OpDelete)
+	return &TodoListMemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TodoListMemberClient) DeleteOne(_m *TodoListMember) *TodoListMemberDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TodoListMemberClient) DeleteOneID(id int) *TodoListMemberDeleteOne {
+	builder := c.Delete().Where(todolistmember.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TodoListMemberDeleteOne{builder}
+}
+
+// Query returns a query builder for TodoListMember.
+func (c *TodoListMemberClient) Query() *TodoListMemberQuery {
+	return &TodoListMemberQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTodoListMember},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TodoListMember entity by its id.
+func (c *TodoListMemberClient) Get(ctx context.Context, id int) (*TodoListMember, error) {
+	return c.Query().Where(todolistmember.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TodoListMemberClient) GetX(ctx context.Context, id int) *TodoListMember {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryList queries the list edge of a TodoListMember.
+func (c *TodoListMemberClient) QueryList(_m *TodoListMember) *TodoListQuery {
+	query := (&TodoListClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todolistmember.Table, todolistmember.FieldID, id),
+			sqlgraph.To(todolist.Table, todolist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todolistmember.ListTable, todolistmember.ListColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a TodoListMember.
+func (c *TodoListMemberClient) QueryUser(_m *TodoListMember) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todolistmember.Table, todolistmember.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todolistmember.UserTable, todolistmember.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TodoListMemberClient) Hooks() []Hook {
+	return c.hooks.TodoListMember
+}
+
+// Interceptors returns the client interceptors.
+func (c *TodoListMemberClient) Interceptors() []Interceptor {
+	return c.inters.TodoListMember
+}
+
+func (c *TodoListMemberClient) mutate(ctx context.Context, m *TodoListMemberMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TodoListMemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TodoListMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TodoListMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TodoListMemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TodoListMember mutation op: %q", m.Op())
+	}
+}
+
+// TodoSummaryClient is a client for the TodoSummary schema.
+type TodoSummaryClient struct {
+	config
+}
+
+// NewTodoSummaryClient returns a client for the TodoSummary from the given config.
+func NewTodoSummaryClient(c config) *TodoSummaryClient {
+	return &TodoSummaryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `todosummary.Hooks(f(g(h())))`.
+func (c *TodoSummaryClient) Use(hooks ...Hook) {
+	c.hooks.TodoSummary = append(c.hooks.TodoSummary, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `todosummary.Intercept(f(g(h())))`.
+func (c *TodoSummaryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TodoSummary = append(c.inters.TodoSummary, interceptors...)
+}
+
+// Create returns a builder for creating a TodoSummary entity.
+func (c *TodoSummaryClient) Create() *TodoSummaryCreate {
+	mutation := newTodoSummaryMutation(c.config, OpCreate)
+	return &TodoSummaryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TodoSummary entities.
+func (c *TodoSummaryClient) CreateBulk(builders ...*TodoSummaryCreate) *TodoSummaryCreateBulk {
+	return &TodoSummaryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TodoSummaryClient) MapCreateBulk(slice any, setFunc func(*TodoSummaryCreate, int)) *TodoSummaryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TodoSummaryCreateBulk{err: fmt.Errorf("calling to TodoSummaryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TodoSummaryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TodoSummaryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TodoSummary.
+func (c *TodoSummaryClient) Update() *TodoSummaryUpdate {
+	mutation := newTodoSummaryMutation(c.config, OpUpdate)
+	return &TodoSummaryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TodoSummaryClient) UpdateOne(_m *TodoSummary) *TodoSummaryUpdateOne {
+	mutation := newTodoSummaryMutation(c.config, OpUpdateOne, withTodoSummary(_m))
+	return &TodoSummaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TodoSummaryClient) UpdateOneID(id uuid.UUID) *TodoSummaryUpdateOne {
+	mutation := newTodoSummaryMutation(c.config, OpUpdateOne, withTodoSummaryID(id))
+	return &TodoSummaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TodoSummary.
+func (c *TodoSummaryClient) Delete() *TodoSummaryDelete {
+	mutation := newTodoSummaryMutation(c.config, OpDelete)
+	return &TodoSummaryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TodoSummaryClient) DeleteOne(_m *TodoSummary) *TodoSummaryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TodoSummaryClient) DeleteOneID(id uuid.UUID) *TodoSummaryDeleteOne {
+	builder := c.Delete().Where(todosummary.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TodoSummaryDeleteOne{builder}
+}
+
+// Query returns a query builder for TodoSummary.
+func (c *TodoSummaryClient) Query() *TodoSummaryQuery {
+	return &TodoSummaryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTodoSummary},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TodoSummary entity by its id.
+func (c *TodoSummaryClient) Get(ctx context.Context, id uuid.UUID) (*TodoSummary, error) {
+	return c.Query().Where(todosummary.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TodoSummaryClient) GetX(ctx context.Context, id uuid.UUID) *TodoSummary {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a TodoSummary.
+func (c *TodoSummaryClient) QueryUser(_m *TodoSummary) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todosummary.Table, todosummary.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todosummary.UserTable, todosummary.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWorkspace queries the workspace edge of a TodoSummary.
+func (c *TodoSummaryClient) QueryWorkspace(_m *TodoSummary) *WorkspaceQuery {
+	query := (&WorkspaceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todosummary.Table, todosummary.FieldID, id),
+			sqlgraph.To(workspace.Table, workspace.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todosummary.WorkspaceTable, todosummary.WorkspaceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TodoSummaryClient) Hooks() []Hook {
+	return c.hooks.TodoSummary
+}
+
+// Interceptors returns the client interceptors.
+func (c *TodoSummaryClient) Interceptors() []Interceptor {
+	return c.inters.TodoSummary
+}
+
+func (c *TodoSummaryClient) mutate(ctx context.Context, m *TodoSummaryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TodoSummaryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TodoSummaryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TodoSummaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TodoSummaryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TodoSummary mutation op: %q", m.Op())
+	}
+}
+
+// UsedMFATokenClient is a client for the UsedMFAToken schema.
+type UsedMFATokenClient struct {
+	config
+}
+
+// NewUsedMFATokenClient returns a client for the UsedMFAToken from the given config.
+func NewUsedMFATokenClient(c config) *UsedMFATokenClient {
+	return &UsedMFATokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usedmfatoken.Hooks(f(g(h())))`.
+func (c *UsedMFATokenClient) Use(hooks ...Hook) {
+	c.hooks.UsedMFAToken = append(c.hooks.UsedMFAToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usedmfatoken.Intercept(f(g(h())))`.
+func (c *UsedMFATokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UsedMFAToken = append(c.inters.UsedMFAToken, interceptors...)
+}
+
+// Create returns a builder for creating a UsedMFAToken entity.
+func (c *UsedMFATokenClient) Create() *UsedMFATokenCreate {
+	mutation := newUsedMFATokenMutation(c.config, OpCreate)
+	return &UsedMFATokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UsedMFAToken entities.
+func (c *UsedMFATokenClient) CreateBulk(builders ...*UsedMFATokenCreate) *UsedMFATokenCreateBulk {
+	return &UsedMFATokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UsedMFATokenClient) MapCreateBulk(slice any, setFunc func(*UsedMFATokenCreate, int)) *UsedMFATokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UsedMFATokenCreateBulk{err: fmt.Errorf("calling to UsedMFATokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UsedMFATokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UsedMFATokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UsedMFAToken.
+func (c *UsedMFATokenClient) Update() *UsedMFATokenUpdate {
+	mutation := newUsedMFATokenMutation(c.config, OpUpdate)
+	return &UsedMFATokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UsedMFATokenClient) UpdateOne(_m *UsedMFAToken) *UsedMFATokenUpdateOne {
+	mutation := newUsedMFATokenMutation(c.config, OpUpdateOne, withUsedMFAToken(_m))
+	return &UsedMFATokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UsedMFATokenClient) UpdateOneID(id uuid.UUID) *UsedMFATokenUpdateOne {
+	mutation := newUsedMFATokenMutation(c.config, OpUpdateOne, withUsedMFATokenID(id))
+	return &UsedMFATokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UsedMFAToken.
+func (c *UsedMFATokenClient) Delete() *UsedMFATokenDelete {
+	mutation := newUsedMFATokenMutation(c.config, OpDelete)
+	return &UsedMFATokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UsedMFATokenClient) DeleteOne(_m *UsedMFAToken) *UsedMFATokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UsedMFATokenClient) DeleteOneID(id uuid.UUID) *UsedMFATokenDeleteOne {
+	builder := c.Delete().Where(usedmfatoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UsedMFATokenDeleteOne{builder}
+}
+
+// Query returns a query builder for UsedMFAToken.
+func (c *UsedMFATokenClient) Query() *UsedMFATokenQuery {
+	return &UsedMFATokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUsedMFAToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UsedMFAToken entity by its id.
+func (c *UsedMFATokenClient) Get(ctx context.Context, id uuid.UUID) (*UsedMFAToken, error) {
+	return c.Query().Where(usedmfatoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UsedMFATokenClient) GetX(ctx context.Context, id uuid.UUID) *UsedMFAToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a UsedMFAToken.
+func (c *UsedMFATokenClient) QueryUser(_m *UsedMFAToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(usedmfatoken.Table, usedmfatoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, usedmfatoken.UserTable, usedmfatoken.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UsedMFATokenClient) Hooks() []Hook {
+	return c.hooks.UsedMFAToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *UsedMFATokenClient) Interceptors() []Interceptor {
+	return c.inters.UsedMFAToken
+}
+
+func (c *UsedMFATokenClient) mutate(ctx context.Context, m *UsedMFATokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UsedMFATokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UsedMFATokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UsedMFATokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UsedMFATokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UsedMFAToken mutation op: %q", m.Op())
+	}
+}
+
+// UserClient is a client for the User schema.
+type UserClient struct {
+	config
+}
+
+// NewUserClient returns a client for the User from the given config.
+func NewUserClient(c config) *UserClient {
+	return &UserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
+func (c *UserClient) Use(hooks ...Hook) {
+	c.hooks.User = append(c.hooks.User, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
+// Create returns a builder for creating a User entity.
+func (c *UserClient) Create() *UserCreate {
+	mutation := newUserMutation(c.config, OpCreate)
+	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for User.
+func (c *UserClient) Update() *UserUpdate {
+	mutation := newUserMutation(c.config, OpUpdate)
+	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserClient) UpdateOne(_m *User) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUser(_m))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for User.
+func (c *UserClient) Delete() *UserDelete {
+	mutation := newUserMutation(c.config, OpDelete)
+	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserClient) DeleteOne(_m *User) *UserDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
+	builder := c.Delete().Where(user.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserDeleteOne{builder}
+}
+
+// Query returns a query builder for User.
+func (c *UserClient) Query() *UserQuery {
+	return &UserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a User entity by its id.
+func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
+	return c.Query().Where(user.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserClient) GetX(ctx context.Context, id int) *User {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTodos queries the todos edge of a User.
+func (c *UserClient) QueryTodos(_m *User) *TodoQuery {
+	query := (&TodoClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(todo.Table, todo.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TodosTable, user.TodosColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTodoFilterHistories queries the todo_filter_histories edge of a User.
+func (c *UserClient) QueryTodoFilterHistories(_m *User) *TodoFilterHistoryQuery {
+	query := (&TodoFilterHistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(todofilterhistory.Table, todofilterhistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TodoFilterHistoriesTable, user.TodoFilterHistoriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTodoBreakdowns queries the todo_breakdowns edge of a User.
+func (c *UserClient) QueryTodoBreakdowns(_m *User) *TodoBreakdownQuery {
+	query := (&TodoBreakdownClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(todobreakdown.Table, todobreakdown.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TodoBreakdownsTable, user.TodoBreakdownsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTodoSummaries queries the todo_summaries edge of a User.
+func (c *UserClient) QueryTodoSummaries(_m *User) *TodoSummaryQuery {
+	query := (&TodoSummaryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(todosummary.Table, todosummary.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TodoSummariesTable, user.TodoSummariesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAiUsages queries the ai_usages edge of a User.
+func (c *UserClient) QueryAiUsages(_m *User) *AIUsageQuery {
+	query := (&AIUsageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(aiusage.Table, aiusage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AiUsagesTable, user.AiUsagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySessions queries the sessions edge of a User.
+func (c *UserClient) QuerySessions(_m *User) *SessionQuery {
+	query := (&SessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(session.Table, session.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SessionsTable, user.SessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPasswordResetTokens queries the password_reset_tokens edge of a User.
+func (c *UserClient) QueryPasswordResetTokens(_m *User) *PasswordResetTokenQuery {
+	query := (&PasswordResetTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(passwordresettoken.Table, passwordresettoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PasswordResetTokensTable, user.PasswordResetTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPersonalAccessTokens queries the personal_access_tokens edge of a User.
+func (c *UserClient) QueryPersonalAccessTokens(_m *User) *PersonalAccessTokenQuery {
+	query := (&PersonalAccessTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(personalaccesstoken.Table, personalaccesstoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PersonalAccessTokensTable, user.PersonalAccessTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIdentities queries the identities edge of a User.
+func (c *UserClient) QueryIdentities(_m *User) *UserIdentityQuery {
+	query := (&UserIdentityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(useridentity.Table, useridentity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.IdentitiesTable, user.IdentitiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTotpCredential queries the totp_credential edge of a User.
+func (c *UserClient) QueryTotpCredential(_m *User) *TOTPCredentialQuery {
+	query := (&TOTPCredentialClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(totpcredential.Table, totpcredential.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.TotpCredentialTable, user.TotpCredentialColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRecoveryCodes queries the recovery_codes edge of a User.
+func (c *UserClient) QueryRecoveryCodes(_m *User) *RecoveryCodeQuery {
+	query := (&RecoveryCodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(recoverycode.Table, recoverycode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RecoveryCodesTable, user.RecoveryCodesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUsedMfaTokens queries the used_mfa_tokens edge of a User.
+func (c *UserClient) QueryUsedMfaTokens(_m *User) *UsedMFATokenQuery {
+	query := (&UsedMFATokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(usedmfatoken.Table, usedmfatoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UsedMfaTokensTable, user.UsedMfaTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLoginAttempts queries the login_attempts edge of a User.
+func (c *UserClient) QueryLoginAttempts(_m *User) *LoginAttemptQuery {
+	query := (&LoginAttemptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(loginattempt.Table, loginattempt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LoginAttemptsTable, user.LoginAttemptsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEmailChangeTokens queries the email_change_tokens edge of a User.
+func (c *UserClient) QueryEmailChangeTokens(_m *User) *EmailChangeTokenQuery {
+	query := (&EmailChangeTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(emailchangetoken.Table, emailchangetoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.EmailChangeTokensTable, user.EmailChangeTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAccountDeletionTokens queries the account_deletion_tokens edge of a User.
+func (c *UserClient) QueryAccountDeletionTokens(_m *User) *AccountDeletionTokenQuery {
+	query := (&AccountDeletionTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(accountdeletiontoken.Table, accountdeletiontoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AccountDeletionTokensTable, user.AccountDeletionTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTodoListMemberships queries the todo_list_memberships edge of a User.
+func (c *UserClient) QueryTodoListMemberships(_m *User) *TodoListMemberQuery {
+	query := (&TodoListMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(todolistmember.Table, todolistmember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TodoListMembershipsTable, user.TodoListMembershipsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPersonalWorkspace queries the personal_workspace edge of a User.
+func (c *UserClient) QueryPersonalWorkspace(_m *User) *WorkspaceQuery {
+	query := (&WorkspaceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(workspace.Table, workspace.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.PersonalWorkspaceTable, user.PersonalWorkspaceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWorkspaceMemberships queries the workspace_memberships edge of a User.
+func (c *UserClient) QueryWorkspaceMemberships(_m *User) *WorkspaceMemberQuery {
+	query := (&WorkspaceMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(workspacemember.Table, workspacemember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.WorkspaceMembershipsTable, user.WorkspaceMembershipsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserClient) Hooks() []Hook {
+	return c.hooks.User
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
+
+func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+	}
+}
+
+// UserIdentityClient is a client for the UserIdentity schema.
+type UserIdentityClient struct {
+	config
+}
+
+// NewUserIdentityClient returns a client for the UserIdentity from the given config.
+func NewUserIdentityClient(c config) *UserIdentityClient {
+	return &UserIdentityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `useridentity.Hooks(f(g(h())))`.
+func (c *UserIdentityClient) Use(hooks ...Hook) {
+	c.hooks.UserIdentity = append(c.hooks.UserIdentity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `useridentity.Intercept(f(g(h())))`.
+func (c *UserIdentityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserIdentity = append(c.inters.UserIdentity, interceptors...)
+}
+
+// Create returns a builder for creating a UserIdentity entity.
+func (c *UserIdentityClient) Create() *UserIdentityCreate {
+	mutation := newUserIdentityMutation(c.config, OpCreate)
+	return &UserIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserIdentity entities.
+func (c *UserIdentityClient) CreateBulk(builders ...*UserIdentityCreate) *UserIdentityCreateBulk {
+	return &UserIdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserIdentityClient) MapCreateBulk(slice any, setFunc func(*UserIdentityCreate, int)) *UserIdentityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserIdentityCreateBulk{err: fmt.Errorf("calling to UserIdentityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserIdentityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserIdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserIdentity.
+func (c *UserIdentityClient) Update() *UserIdentityUpdate {
+	mutation := newUserIdentityMutation(c.config, OpUpdate)
+	return &UserIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserIdentityClient) UpdateOne(_m *UserIdentity) *UserIdentityUpdateOne {
+	mutation := newUserIdentityMutation(c.config, OpUpdateOne, withUserIdentity(_m))
+	return &UserIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserIdentityClient) UpdateOneID(id int) *UserIdentityUpdateOne {
+	mutation := newUserIdentityMutation(c.config, OpUpdateOne, withUserIdentityID(id))
+	return &UserIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserIdentity.
+func (c *UserIdentityClient) Delete() *UserIdentityDelete {
+	mutation := newUserIdentityMutation(c.config, OpDelete)
+	return &UserIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserIdentityClient) DeleteOne(_m *UserIdentity) *UserIdentityDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserIdentityClient) DeleteOneID(id int) *UserIdentityDeleteOne {
+	builder := c.Delete().Where(useridentity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserIdentityDeleteOne{builder}
+}
+
+// Query returns a query builder for UserIdentity.
+func (c *UserIdentityClient) Query() *UserIdentityQuery {
+	return &UserIdentityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserIdentity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserIdentity entity by its id.
+func (c *UserIdentityClient) Get(ctx context.Context, id int) (*UserIdentity, error) {
+	return c.Query().Where(useridentity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserIdentityClient) GetX(ctx context.Context, id int) *UserIdentity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a UserIdentity.
+func (c *UserIdentityClient) QueryUser(_m *UserIdentity) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(useridentity.Table, useridentity.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, useridentity.UserTable, useridentity.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserIdentityClient) Hooks() []Hook {
+	return c.hooks.UserIdentity
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserIdentityClient) Interceptors() []Interceptor {
+	return c.inters.UserIdentity
+}
+
+func (c *UserIdentityClient) mutate(ctx context.Context, m *UserIdentityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserIdentity mutation op: %q", m.Op())
+	}
+}
+
+// WorkspaceClient is a client for the Workspace schema.
+type WorkspaceClient struct {
+	config
+}
+
+// NewWorkspaceClient returns a client for the Workspace from the given config.
+func NewWorkspaceClient(c config) *WorkspaceClient {
+	return &WorkspaceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `workspace.Hooks(f(g(h())))`.
+func (c *WorkspaceClient) Use(hooks ...Hook) {
+	c.hooks.Workspace = append(c.hooks.Workspace, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `workspace.Intercept(f(g(h())))`.
+func (c *WorkspaceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Workspace = append(c.inters.Workspace, interceptors...)
+}
+
+// Create returns a builder for creating a Workspace entity.
+func (c *WorkspaceClient) Create() *WorkspaceCreate {
+	mutation := newWorkspaceMutation(c.config, OpCreate)
+	return &WorkspaceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Workspace entities.
+func (c *WorkspaceClient) CreateBulk(builders ...*WorkspaceCreate) *WorkspaceCreateBulk {
+	return &WorkspaceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WorkspaceClient) MapCreateBulk(slice any, setFunc func(*WorkspaceCreate, int)) *WorkspaceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WorkspaceCreateBulk{err: fmt.Errorf("calling to WorkspaceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WorkspaceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WorkspaceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Workspace.
+func (c *WorkspaceClient) Update() *WorkspaceUpdate {
+	mutation := newWorkspaceMutation(c.config, OpUpdate)
+	return &WorkspaceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WorkspaceClient) UpdateOne(_m *Workspace) *WorkspaceUpdateOne {
+	mutation := newWorkspaceMutation(c.config, OpUpdateOne, withWorkspace(_m))
+	return &WorkspaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WorkspaceClient) UpdateOneID(id int) *WorkspaceUpdateOne {
+	mutation := newWorkspaceMutation(c.config, OpUpdateOne, withWorkspaceID(id))
+	return &WorkspaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Workspace.
+func (c *WorkspaceClient) Delete() *WorkspaceDelete {
+	mutation := newWorkspaceMutation(c.config, OpDelete)
+	return &WorkspaceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WorkspaceClient) DeleteOne(_m *Workspace) *WorkspaceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WorkspaceClient) DeleteOneID(id int) *WorkspaceDeleteOne {
+	builder := c.Delete().Where(workspace.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WorkspaceDeleteOne{builder}
+}
+
+// Query returns a query builder for Workspace.
+func (c *WorkspaceClient) Query() *WorkspaceQuery {
+	return &WorkspaceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWorkspace},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Workspace entity by its id.
+func (c *WorkspaceClient) Get(ctx context.Context, id int) (*Workspace, error) {
+	return c.Query().Where(workspace.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WorkspaceClient) GetX(ctx context.Context, id int) *Workspace {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPersonalOwner queries the personal_owner edge of a Workspace.
+func (c *WorkspaceClient) QueryPersonalOwner(_m *Workspace) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspace.Table, workspace.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, workspace.PersonalOwnerTable, workspace.PersonalOwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMembers queries the members edge of a Workspace.
+func (c *WorkspaceClient) QueryMembers(_m *Workspace) *WorkspaceMemberQuery {
+	query := (&WorkspaceMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspace.Table, workspace.FieldID, id),
+			sqlgraph.To(workspacemember.Table, workspacemember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workspace.MembersTable, workspace.MembersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvites queries the invites edge of a Workspace.
+func (c *WorkspaceClient) QueryInvites(_m *Workspace) *WorkspaceInviteQuery {
+	query := (&WorkspaceInviteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspace.Table, workspace.FieldID, id),
+			sqlgraph.To(workspaceinvite.Table, workspaceinvite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workspace.InvitesTable, workspace.InvitesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTodos queries the todos edge of a Workspace.
+func (c *WorkspaceClient) QueryTodos(_m *Workspace) *TodoQuery {
+	query := (&TodoClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspace.Table, workspace.FieldID, id),
+			sqlgraph.To(todo.Table, todo.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workspace.TodosTable, workspace.TodosColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTodoFilterHistories queries the todo_filter_histories edge of a Workspace.
+func (c *WorkspaceClient) QueryTodoFilterHistories(_m *Workspace) *TodoFilterHistoryQuery {
+	query := (&TodoFilterHistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspace.Table, workspace.FieldID, id),
+			sqlgraph.To(todofilterhistory.Table, todofilterhistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workspace.TodoFilterHistoriesTable, workspace.TodoFilterHistoriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTodoBreakdowns queries the todo_breakdowns edge of a Workspace.
+func (c *WorkspaceClient) QueryTodoBreakdowns(_m *Workspace) *TodoBreakdownQuery {
+	query := (&TodoBreakdownClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspace.Table, workspace.FieldID, id),
+			sqlgraph.To(todobreakdown.Table, todobreakdown.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workspace.TodoBreakdownsTable, workspace.TodoBreakdownsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTodoSummaries queries the todo_summaries edge of a Workspace.
+func (c *WorkspaceClient) QueryTodoSummaries(_m *Workspace) *TodoSummaryQuery {
+	query := (&TodoSummaryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspace.Table, workspace.FieldID, id),
+			sqlgraph.To(todosummary.Table, todosummary.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workspace.TodoSummariesTable, workspace.TodoSummariesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTodoLists queries the todo_lists edge of a Workspace.
+func (c *WorkspaceClient) QueryTodoLists(_m *Workspace) *TodoListQuery {
+	query := (&TodoListClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspace.Table, workspace.FieldID, id),
+			sqlgraph.To(todolist.Table, todolist.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workspace.TodoListsTable, workspace.TodoListsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WorkspaceClient) Hooks() []Hook {
+	return c.hooks.Workspace
+}
+
+// Interceptors returns the client interceptors.
+func (c *WorkspaceClient) Interceptors() []Interceptor {
+	return c.inters.Workspace
+}
+
+func (c *WorkspaceClient) mutate(ctx context.Context, m *WorkspaceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WorkspaceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WorkspaceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WorkspaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WorkspaceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Workspace mutation op: %q", m.Op())
+	}
+}
+
+// WorkspaceInviteClient is a client for the WorkspaceInvite schema.
+type WorkspaceInviteClient struct {
+	config
+}
+
+// NewWorkspaceInviteClient returns a client for the WorkspaceInvite from the given config.
+func NewWorkspaceInviteClient(c config) *WorkspaceInviteClient {
+	return &WorkspaceInviteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `workspaceinvite.Hooks(f(g(h())))`.
+func (c *WorkspaceInviteClient) Use(hooks ...Hook) {
+	c.hooks.WorkspaceInvite = append(c.hooks.WorkspaceInvite, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `workspaceinvite.Intercept(f(g(h())))`.
+func (c *WorkspaceInviteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WorkspaceInvite = append(c.inters.WorkspaceInvite, interceptors...)
+}
+
+// Create returns a builder for creating a WorkspaceInvite entity.
+func (c *WorkspaceInviteClient) Create() *WorkspaceInviteCreate {
+	mutation := newWorkspaceInviteMutation(c.config, OpCreate)
+	return &WorkspaceInviteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WorkspaceInvite entities.
+func (c *WorkspaceInviteClient) CreateBulk(builders ...*WorkspaceInviteCreate) *WorkspaceInviteCreateBulk {
+	return &WorkspaceInviteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WorkspaceInviteClient) MapCreateBulk(slice any, setFunc func(*WorkspaceInviteCreate, int)) *WorkspaceInviteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WorkspaceInviteCreateBulk{err: fmt.Errorf("calling to WorkspaceInviteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WorkspaceInviteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WorkspaceInviteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WorkspaceInvite.
+func (c *WorkspaceInviteClient) Update() *WorkspaceInviteUpdate {
+	mutation := newWorkspaceInviteMutation(c.config, OpUpdate)
+	return &WorkspaceInviteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WorkspaceInviteClient) UpdateOne(_m *WorkspaceInvite) *WorkspaceInviteUpdateOne {
+	mutation := newWorkspaceInviteMutation(c.config, OpUpdateOne, withWorkspaceInvite(_m))
+	return &WorkspaceInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WorkspaceInviteClient) UpdateOneID(id uuid.UUID) *WorkspaceInviteUpdateOne {
+	mutation := newWorkspaceInviteMutation(c.config, OpUpdateOne, withWorkspaceInviteID(id))
+	return &WorkspaceInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WorkspaceInvite.
+func (c *WorkspaceInviteClient) Delete() *WorkspaceInviteDelete {
+	mutation := newWorkspaceInviteMutation(c.config, OpDelete)
+	return &WorkspaceInviteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WorkspaceInviteClient) DeleteOne(_m *WorkspaceInvite) *WorkspaceInviteDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WorkspaceInviteClient) DeleteOneID(id uuid.UUID) *WorkspaceInviteDeleteOne {
+	builder := c.Delete().Where(workspaceinvite.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WorkspaceInviteDeleteOne{builder}
+}
+
+// Query returns a query builder for WorkspaceInvite.
+func (c *WorkspaceInviteClient) Query() *WorkspaceInviteQuery {
+	return &WorkspaceInviteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWorkspaceInvite},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WorkspaceInvite entity by its id.
+func (c *WorkspaceInviteClient) Get(ctx context.Context, id uuid.UUID) (*WorkspaceInvite, error) {
+	return c.Query().Where(workspaceinvite.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WorkspaceInviteClient) GetX(ctx context.Context, id uuid.UUID) *WorkspaceInvite {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWorkspace queries the workspace edge of a WorkspaceInvite.
+func (c *WorkspaceInviteClient) QueryWorkspace(_m *WorkspaceInvite) *WorkspaceQuery {
+	query := (&WorkspaceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspaceinvite.Table, workspaceinvite.FieldID, id),
+			sqlgraph.To(workspace.Table, workspace.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, workspaceinvite.WorkspaceTable, workspaceinvite.WorkspaceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WorkspaceInviteClient) Hooks() []Hook {
+	return c.hooks.WorkspaceInvite
+}
+
+// Interceptors returns the client interceptors.
+func (c *WorkspaceInviteClient) Interceptors() []Interceptor {
+	return c.inters.WorkspaceInvite
+}
+
+func (c *WorkspaceInviteClient) mutate(ctx context.Context, m *WorkspaceInviteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WorkspaceInviteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WorkspaceInviteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WorkspaceInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WorkspaceInviteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WorkspaceInvite mutation op: %q", m.Op())
+	}
+}
+
+// WorkspaceMemberClient is a client for the WorkspaceMember schema.
+type WorkspaceMemberClient struct {
+	config
+}
+
+// NewWorkspaceMemberClient returns a client for the WorkspaceMember from the given config.
+func NewWorkspaceMemberClient(c config) *WorkspaceMemberClient {
+	return &WorkspaceMemberClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `workspacemember.Hooks(f(g(h())))`.
+func (c *WorkspaceMemberClient) Use(hooks ...Hook) {
+	c.hooks.WorkspaceMember = append(c.hooks.WorkspaceMember, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `workspacemember.Intercept(f(g(h())))`.
+func (c *WorkspaceMemberClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WorkspaceMember = append(c.inters.WorkspaceMember, interceptors...)
+}
+
+// Create returns a builder for creating a WorkspaceMember entity.
+func (c *WorkspaceMemberClient) Create() *WorkspaceMemberCreate {
+	mutation := newWorkspaceMemberMutation(c.config, OpCreate)
+	return &WorkspaceMemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WorkspaceMember entities.
+func (c *WorkspaceMemberClient) CreateBulk(builders ...*WorkspaceMemberCreate) *WorkspaceMemberCreateBulk {
+	return &WorkspaceMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WorkspaceMemberClient) MapCreateBulk(slice any, setFunc func(*WorkspaceMemberCreate, int)) *WorkspaceMemberCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WorkspaceMemberCreateBulk{err: fmt.Errorf("calling to WorkspaceMemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WorkspaceMemberCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WorkspaceMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WorkspaceMember.
+func (c *WorkspaceMemberClient) Update() *WorkspaceMemberUpdate {
+	mutation := newWorkspaceMemberMutation(c.config, OpUpdate)
+	return &WorkspaceMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WorkspaceMemberClient) UpdateOne(_m *WorkspaceMember) *WorkspaceMemberUpdateOne {
+	mutation := newWorkspaceMemberMutation(c.config, OpUpdateOne, withWorkspaceMember(_m))
+	return &WorkspaceMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WorkspaceMemberClient) UpdateOneID(id int) *WorkspaceMemberUpdateOne {
+	mutation := newWorkspaceMemberMutation(c.config, OpUpdateOne, withWorkspaceMemberID(id))
+	return &WorkspaceMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WorkspaceMember.
+func (c *WorkspaceMemberClient) Delete() *WorkspaceMemberDelete {
+	mutation := newWorkspaceMemberMutation(c.config, OpDelete)
+	return &WorkspaceMemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WorkspaceMemberClient) DeleteOne(_m *WorkspaceMember) *WorkspaceMemberDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WorkspaceMemberClient) DeleteOneID(id int) *WorkspaceMemberDeleteOne {
+	builder := c.Delete().Where(workspacemember.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WorkspaceMemberDeleteOne{builder}
+}
+
+// Query returns a query builder for WorkspaceMember.
+func (c *WorkspaceMemberClient) Query() *WorkspaceMemberQuery {
+	return &WorkspaceMemberQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWorkspaceMember},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WorkspaceMember entity by its id.
+func (c *WorkspaceMemberClient) Get(ctx context.Context, id int) (*WorkspaceMember, error) {
+	return c.Query().Where(workspacemember.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WorkspaceMemberClient) GetX(ctx context.Context, id int) *WorkspaceMember {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWorkspace queries the workspace edge of a WorkspaceMember.
+func (c *WorkspaceMemberClient) QueryWorkspace(_m *WorkspaceMember) *WorkspaceQuery {
+	query := (&WorkspaceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspacemember.Table, workspacemember.FieldID, id),
+			sqlgraph.To(workspace.Table, workspace.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, workspacemember.WorkspaceTable, workspacemember.WorkspaceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a WorkspaceMember.
+func (c *WorkspaceMemberClient) QueryUser(_m *WorkspaceMember) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspacemember.Table, workspacemember.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, workspacemember.UserTable, workspacemember.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WorkspaceMemberClient) Hooks() []Hook {
+	return c.hooks.WorkspaceMember
+}
+
+// Interceptors returns the client interceptors.
+func (c *WorkspaceMemberClient) Interceptors() []Interceptor {
+	return c.inters.WorkspaceMember
+}
+
+func (c *WorkspaceMemberClient) mutate(ctx context.Context, m *WorkspaceMemberMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WorkspaceMemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WorkspaceMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WorkspaceMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WorkspaceMemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WorkspaceMember mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Todo, TodoFilterHistory, User []ent.Hook
+		AIUsage, AccountDeletionToken, AdminAuditLog, EmailChangeToken, LoginAttempt,
+		PasswordResetToken, PersonalAccessToken, RecoveryCode, RefreshToken, Session,
+		TOTPCredential, Todo, TodoBreakdown, TodoEmbedding, TodoFilterHistory,
+		TodoList, TodoListInvite, TodoListMember, TodoSummary, UsedMFAToken, User,
+		UserIdentity, Workspace, WorkspaceInvite, WorkspaceMember []ent.Hook
 	}
 	inters struct {
-		Todo, TodoFilterHistory, User []ent.Interceptor
+		AIUsage, AccountDeletionToken, AdminAuditLog, EmailChangeToken, LoginAttempt,
+		PasswordResetToken, PersonalAccessToken, RecoveryCode, RefreshToken, Session,
+		TOTPCredential, Todo, TodoBreakdown, TodoEmbedding, TodoFilterHistory,
+		TodoList, TodoListInvite, TodoListMember, TodoSummary, UsedMFAToken, User,
+		UserIdentity, Workspace, WorkspaceInvite, WorkspaceMember []ent.Interceptor
 	}
 )
@@ -0,0 +1,246 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"todo-app/ent/emailchangetoken"
+	"todo-app/ent/user"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// EmailChangeToken is the model entity for the EmailChangeToken schema.
+type EmailChangeToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// NewEmail holds the value of the "new_email" field.
+	NewEmail string `json:"new_email,omitempty"`
+	// OldEmail holds the value of the "old_email" field.
+	OldEmail string `json:"old_email,omitempty"`
+	// TokenHash holds the value of the "token_hash" field.
+	TokenHash string `json:"token_hash,omitempty"`
+	// CancelTokenHash holds the value of the "cancel_token_hash" field.
+	CancelTokenHash *string `json:"cancel_token_hash,omitempty"`
+	// Purpose holds the value of the "purpose" field.
+	Purpose string `json:"purpose,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// UsedAt holds the value of the "used_at" field.
+	UsedAt *time.Time `json:"used_at,omitempty"`
+	// CancelledAt holds the value of the "cancelled_at" field.
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EmailChangeTokenQuery when eager-loading is set.
+	Edges        EmailChangeTokenEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EmailChangeTokenEdges holds the relations/edges for other nodes in the graph.
+type EmailChangeTokenEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EmailChangeTokenEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmailChangeToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case emailchangetoken.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case emailchangetoken.FieldNewEmail, emailchangetoken.FieldOldEmail, emailchangetoken.FieldTokenHash, emailchangetoken.FieldCancelTokenHash, emailchangetoken.FieldPurpose:
+			values[i] = new(sql.NullString)
+		case emailchangetoken.FieldExpiresAt, emailchangetoken.FieldUsedAt, emailchangetoken.FieldCancelledAt, emailchangetoken.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case emailchangetoken.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmailChangeToken fields.
+func (_m *EmailChangeToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case emailchangetoken.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case emailchangetoken.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case emailchangetoken.FieldNewEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field new_email", values[i])
+			} else if value.Valid {
+				_m.NewEmail = value.String
+			}
+		case emailchangetoken.FieldOldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field old_email", values[i])
+			} else if value.Valid {
+				_m.OldEmail = value.String
+			}
+		case emailchangetoken.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				_m.TokenHash = value.String
+			}
+		case emailchangetoken.FieldCancelTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cancel_token_hash", values[i])
+			} else if value.Valid {
+				_m.CancelTokenHash = new(string)
+				*_m.CancelTokenHash = value.String
+			}
+		case emailchangetoken.FieldPurpose:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field purpose", values[i])
+			} else if value.Valid {
+				_m.Purpose = value.String
+			}
+		case emailchangetoken.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case emailchangetoken.FieldUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field used_at", values[i])
+			} else if value.Valid {
+				_m.UsedAt = new(time.Time)
+				*_m.UsedAt = value.Time
+			}
+		case emailchangetoken.FieldCancelledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_at", values[i])
+			} else if value.Valid {
+				_m.CancelledAt = new(time.Time)
+				*_m.CancelledAt = value.Time
+			}
+		case emailchangetoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EmailChangeToken.
+// This includes values selected through modifiers, order, etc.
+func (_m *EmailChangeToken) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the EmailChangeToken entity.
+func (_m *EmailChangeToken) QueryUser() *UserQuery {
+	return NewEmailChangeTokenClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this EmailChangeToken.
+// Note that you need to call EmailChangeToken.Unwrap() before calling this method if this EmailChangeToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EmailChangeToken) Update() *EmailChangeTokenUpdateOne {
+	return NewEmailChangeTokenClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EmailChangeToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EmailChangeToken) Unwrap() *EmailChangeToken {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmailChangeToken is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EmailChangeToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmailChangeToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("new_email=")
+	builder.WriteString(_m.NewEmail)
+	builder.WriteString(", ")
+	builder.WriteString("old_email=")
+	builder.WriteString(_m.OldEmail)
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=")
+	builder.WriteString(_m.TokenHash)
+	builder.WriteString(", ")
+	if v := _m.CancelTokenHash; v != nil {
+		builder.WriteString("cancel_token_hash=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("purpose=")
+	builder.WriteString(_m.Purpose)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.UsedAt; v != nil {
+		builder.WriteString("used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CancelledAt; v != nil {
+		builder.WriteString("cancelled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmailChangeTokens is a parsable slice of EmailChangeToken.
+type EmailChangeTokens []*EmailChangeToken
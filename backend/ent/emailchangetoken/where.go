@@ -0,0 +1,680 @@
+// Code generated by ent, DO NOT EDIT.
+
+package emailchangetoken
+
+import (
+	"time"
+	"todo-app/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEQ(FieldUserID, v))
+}
+
+// NewEmail applies equality check predicate on the "new_email" field. It's identical to NewEmailEQ.
+func NewEmail(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEQ(FieldNewEmail, v))
+}
+
+// OldEmail applies equality check predicate on the "old_email" field. It's identical to OldEmailEQ.
+func OldEmail(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEQ(FieldOldEmail, v))
+}
+
+// TokenHash applies equality check predicate on the "token_hash" field. It's identical to TokenHashEQ.
+func TokenHash(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEQ(FieldTokenHash, v))
+}
+
+// CancelTokenHash applies equality check predicate on the "cancel_token_hash" field. It's identical to CancelTokenHashEQ.
+func CancelTokenHash(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEQ(FieldCancelTokenHash, v))
+}
+
+// Purpose applies equality check predicate on the "purpose" field. It's identical to PurposeEQ.
+func Purpose(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEQ(FieldPurpose, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// UsedAt applies equality check predicate on the "used_at" field. It's identical to UsedAtEQ.
+func UsedAt(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEQ(FieldUsedAt, v))
+}
+
+// CancelledAt applies equality check predicate on the "cancelled_at" field. It's identical to CancelledAtEQ.
+func CancelledAt(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// NewEmailEQ applies the EQ predicate on the "new_email" field.
+func NewEmailEQ(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEQ(FieldNewEmail, v))
+}
+
+// NewEmailNEQ applies the NEQ predicate on the "new_email" field.
+func NewEmailNEQ(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldNEQ(FieldNewEmail, v))
+}
+
+// NewEmailIn applies the In predicate on the "new_email" field.
+func NewEmailIn(vs ...string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldIn(FieldNewEmail, vs...))
+}
+
+// NewEmailNotIn applies the NotIn predicate on the "new_email" field.
+func NewEmailNotIn(vs ...string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldNotIn(FieldNewEmail, vs...))
+}
+
+// NewEmailGT applies the GT predicate on the "new_email" field.
+func NewEmailGT(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldGT(FieldNewEmail, v))
+}
+
+// NewEmailGTE applies the GTE predicate on the "new_email" field.
+func NewEmailGTE(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldGTE(FieldNewEmail, v))
+}
+
+// NewEmailLT applies the LT predicate on the "new_email" field.
+func NewEmailLT(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldLT(FieldNewEmail, v))
+}
+
+// NewEmailLTE applies the LTE predicate on the "new_email" field.
+func NewEmailLTE(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldLTE(FieldNewEmail, v))
+}
+
+// NewEmailContains applies the Contains predicate on the "new_email" field.
+func NewEmailContains(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldContains(FieldNewEmail, v))
+}
+
+// NewEmailHasPrefix applies the HasPrefix predicate on the "new_email" field.
+func NewEmailHasPrefix(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldHasPrefix(FieldNewEmail, v))
+}
+
+// NewEmailHasSuffix applies the HasSuffix predicate on the "new_email" field.
+func NewEmailHasSuffix(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldHasSuffix(FieldNewEmail, v))
+}
+
+// NewEmailEqualFold applies the EqualFold predicate on the "new_email" field.
+func NewEmailEqualFold(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEqualFold(FieldNewEmail, v))
+}
+
+// NewEmailContainsFold applies the ContainsFold predicate on the "new_email" field.
+func NewEmailContainsFold(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldContainsFold(FieldNewEmail, v))
+}
+
+// OldEmailEQ applies the EQ predicate on the "old_email" field.
+func OldEmailEQ(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEQ(FieldOldEmail, v))
+}
+
+// OldEmailNEQ applies the NEQ predicate on the "old_email" field.
+func OldEmailNEQ(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldNEQ(FieldOldEmail, v))
+}
+
+// OldEmailIn applies the In predicate on the "old_email" field.
+func OldEmailIn(vs ...string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldIn(FieldOldEmail, vs...))
+}
+
+// OldEmailNotIn applies the NotIn predicate on the "old_email" field.
+func OldEmailNotIn(vs ...string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldNotIn(FieldOldEmail, vs...))
+}
+
+// OldEmailGT applies the GT predicate on the "old_email" field.
+func OldEmailGT(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldGT(FieldOldEmail, v))
+}
+
+// OldEmailGTE applies the GTE predicate on the "old_email" field.
+func OldEmailGTE(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldGTE(FieldOldEmail, v))
+}
+
+// OldEmailLT applies the LT predicate on the "old_email" field.
+func OldEmailLT(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldLT(FieldOldEmail, v))
+}
+
+// OldEmailLTE applies the LTE predicate on the "old_email" field.
+func OldEmailLTE(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldLTE(FieldOldEmail, v))
+}
+
+// OldEmailContains applies the Contains predicate on the "old_email" field.
+func OldEmailContains(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldContains(FieldOldEmail, v))
+}
+
+// OldEmailHasPrefix applies the HasPrefix predicate on the "old_email" field.
+func OldEmailHasPrefix(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldHasPrefix(FieldOldEmail, v))
+}
+
+// OldEmailHasSuffix applies the HasSuffix predicate on the "old_email" field.
+func OldEmailHasSuffix(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldHasSuffix(FieldOldEmail, v))
+}
+
+// OldEmailEqualFold applies the EqualFold predicate on the "old_email" field.
+func OldEmailEqualFold(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEqualFold(FieldOldEmail, v))
+}
+
+// OldEmailContainsFold applies the ContainsFold predicate on the "old_email" field.
+func OldEmailContainsFold(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldContainsFold(FieldOldEmail, v))
+}
+
+// TokenHashEQ applies the EQ predicate on the "token_hash" field.
+func TokenHashEQ(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEQ(FieldTokenHash, v))
+}
+
+// TokenHashNEQ applies the NEQ predicate on the "token_hash" field.
+func TokenHashNEQ(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldNEQ(FieldTokenHash, v))
+}
+
+// TokenHashIn applies the In predicate on the "token_hash" field.
+func TokenHashIn(vs ...string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldIn(FieldTokenHash, vs...))
+}
+
+// TokenHashNotIn applies the NotIn predicate on the "token_hash" field.
+func TokenHashNotIn(vs ...string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldNotIn(FieldTokenHash, vs...))
+}
+
+// TokenHashGT applies the GT predicate on the "token_hash" field.
+func TokenHashGT(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldGT(FieldTokenHash, v))
+}
+
+// TokenHashGTE applies the GTE predicate on the "token_hash" field.
+func TokenHashGTE(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldGTE(FieldTokenHash, v))
+}
+
+// TokenHashLT applies the LT predicate on the "token_hash" field.
+func TokenHashLT(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldLT(FieldTokenHash, v))
+}
+
+// TokenHashLTE applies the LTE predicate on the "token_hash" field.
+func TokenHashLTE(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldLTE(FieldTokenHash, v))
+}
+
+// TokenHashContains applies the Contains predicate on the "token_hash" field.
+func TokenHashContains(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldContains(FieldTokenHash, v))
+}
+
+// TokenHashHasPrefix applies the HasPrefix predicate on the "token_hash" field.
+func TokenHashHasPrefix(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldHasPrefix(FieldTokenHash, v))
+}
+
+// TokenHashHasSuffix applies the HasSuffix predicate on the "token_hash" field.
+func TokenHashHasSuffix(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldHasSuffix(FieldTokenHash, v))
+}
+
+// TokenHashEqualFold applies the EqualFold predicate on the "token_hash" field.
+func TokenHashEqualFold(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEqualFold(FieldTokenHash, v))
+}
+
+// TokenHashContainsFold applies the ContainsFold predicate on the "token_hash" field.
+func TokenHashContainsFold(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldContainsFold(FieldTokenHash, v))
+}
+
+// CancelTokenHashEQ applies the EQ predicate on the "cancel_token_hash" field.
+func CancelTokenHashEQ(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEQ(FieldCancelTokenHash, v))
+}
+
+// CancelTokenHashNEQ applies the NEQ predicate on the "cancel_token_hash" field.
+func CancelTokenHashNEQ(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldNEQ(FieldCancelTokenHash, v))
+}
+
+// CancelTokenHashIn applies the In predicate on the "cancel_token_hash" field.
+func CancelTokenHashIn(vs ...string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldIn(FieldCancelTokenHash, vs...))
+}
+
+// CancelTokenHashNotIn applies the NotIn predicate on the "cancel_token_hash" field.
+func CancelTokenHashNotIn(vs ...string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldNotIn(FieldCancelTokenHash, vs...))
+}
+
+// CancelTokenHashGT applies the GT predicate on the "cancel_token_hash" field.
+func CancelTokenHashGT(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldGT(FieldCancelTokenHash, v))
+}
+
+// CancelTokenHashGTE applies the GTE predicate on the "cancel_token_hash" field.
+func CancelTokenHashGTE(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldGTE(FieldCancelTokenHash, v))
+}
+
+// CancelTokenHashLT applies the LT predicate on the "cancel_token_hash" field.
+func CancelTokenHashLT(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldLT(FieldCancelTokenHash, v))
+}
+
+// CancelTokenHashLTE applies the LTE predicate on the "cancel_token_hash" field.
+func CancelTokenHashLTE(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldLTE(FieldCancelTokenHash, v))
+}
+
+// CancelTokenHashContains applies the Contains predicate on the "cancel_token_hash" field.
+func CancelTokenHashContains(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldContains(FieldCancelTokenHash, v))
+}
+
+// CancelTokenHashHasPrefix applies the HasPrefix predicate on the "cancel_token_hash" field.
+func CancelTokenHashHasPrefix(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldHasPrefix(FieldCancelTokenHash, v))
+}
+
+// CancelTokenHashHasSuffix applies the HasSuffix predicate on the "cancel_token_hash" field.
+func CancelTokenHashHasSuffix(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldHasSuffix(FieldCancelTokenHash, v))
+}
+
+// CancelTokenHashIsNil applies the IsNil predicate on the "cancel_token_hash" field.
+func CancelTokenHashIsNil() predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldIsNull(FieldCancelTokenHash))
+}
+
+// CancelTokenHashNotNil applies the NotNil predicate on the "cancel_token_hash" field.
+func CancelTokenHashNotNil() predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldNotNull(FieldCancelTokenHash))
+}
+
+// CancelTokenHashEqualFold applies the EqualFold predicate on the "cancel_token_hash" field.
+func CancelTokenHashEqualFold(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEqualFold(FieldCancelTokenHash, v))
+}
+
+// CancelTokenHashContainsFold applies the ContainsFold predicate on the "cancel_token_hash" field.
+func CancelTokenHashContainsFold(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldContainsFold(FieldCancelTokenHash, v))
+}
+
+// PurposeEQ applies the EQ predicate on the "purpose" field.
+func PurposeEQ(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEQ(FieldPurpose, v))
+}
+
+// PurposeNEQ applies the NEQ predicate on the "purpose" field.
+func PurposeNEQ(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldNEQ(FieldPurpose, v))
+}
+
+// PurposeIn applies the In predicate on the "purpose" field.
+func PurposeIn(vs ...string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldIn(FieldPurpose, vs...))
+}
+
+// PurposeNotIn applies the NotIn predicate on the "purpose" field.
+func PurposeNotIn(vs ...string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldNotIn(FieldPurpose, vs...))
+}
+
+// PurposeGT applies the GT predicate on the "purpose" field.
+func PurposeGT(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldGT(FieldPurpose, v))
+}
+
+// PurposeGTE applies the GTE predicate on the "purpose" field.
+func PurposeGTE(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldGTE(FieldPurpose, v))
+}
+
+// PurposeLT applies the LT predicate on the "purpose" field.
+func PurposeLT(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldLT(FieldPurpose, v))
+}
+
+// PurposeLTE applies the LTE predicate on the "purpose" field.
+func PurposeLTE(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldLTE(FieldPurpose, v))
+}
+
+// PurposeContains applies the Contains predicate on the "purpose" field.
+func PurposeContains(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldContains(FieldPurpose, v))
+}
+
+// PurposeHasPrefix applies the HasPrefix predicate on the "purpose" field.
+func PurposeHasPrefix(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldHasPrefix(FieldPurpose, v))
+}
+
+// PurposeHasSuffix applies the HasSuffix predicate on the "purpose" field.
+func PurposeHasSuffix(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldHasSuffix(FieldPurpose, v))
+}
+
+// PurposeEqualFold applies the EqualFold predicate on the "purpose" field.
+func PurposeEqualFold(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEqualFold(FieldPurpose, v))
+}
+
+// PurposeContainsFold applies the ContainsFold predicate on the "purpose" field.
+func PurposeContainsFold(v string) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldContainsFold(FieldPurpose, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// UsedAtEQ applies the EQ predicate on the "used_at" field.
+func UsedAtEQ(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEQ(FieldUsedAt, v))
+}
+
+// UsedAtNEQ applies the NEQ predicate on the "used_at" field.
+func UsedAtNEQ(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldNEQ(FieldUsedAt, v))
+}
+
+// UsedAtIn applies the In predicate on the "used_at" field.
+func UsedAtIn(vs ...time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldIn(FieldUsedAt, vs...))
+}
+
+// UsedAtNotIn applies the NotIn predicate on the "used_at" field.
+func UsedAtNotIn(vs ...time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldNotIn(FieldUsedAt, vs...))
+}
+
+// UsedAtGT applies the GT predicate on the "used_at" field.
+func UsedAtGT(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldGT(FieldUsedAt, v))
+}
+
+// UsedAtGTE applies the GTE predicate on the "used_at" field.
+func UsedAtGTE(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldGTE(FieldUsedAt, v))
+}
+
+// UsedAtLT applies the LT predicate on the "used_at" field.
+func UsedAtLT(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldLT(FieldUsedAt, v))
+}
+
+// UsedAtLTE applies the LTE predicate on the "used_at" field.
+func UsedAtLTE(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldLTE(FieldUsedAt, v))
+}
+
+// UsedAtIsNil applies the IsNil predicate on the "used_at" field.
+func UsedAtIsNil() predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldIsNull(FieldUsedAt))
+}
+
+// UsedAtNotNil applies the NotNil predicate on the "used_at" field.
+func UsedAtNotNil() predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldNotNull(FieldUsedAt))
+}
+
+// CancelledAtEQ applies the EQ predicate on the "cancelled_at" field.
+func CancelledAtEQ(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtNEQ applies the NEQ predicate on the "cancelled_at" field.
+func CancelledAtNEQ(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldNEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtIn applies the In predicate on the "cancelled_at" field.
+func CancelledAtIn(vs ...time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtNotIn applies the NotIn predicate on the "cancelled_at" field.
+func CancelledAtNotIn(vs ...time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldNotIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtGT applies the GT predicate on the "cancelled_at" field.
+func CancelledAtGT(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldGT(FieldCancelledAt, v))
+}
+
+// CancelledAtGTE applies the GTE predicate on the "cancelled_at" field.
+func CancelledAtGTE(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldGTE(FieldCancelledAt, v))
+}
+
+// CancelledAtLT applies the LT predicate on the "cancelled_at" field.
+func CancelledAtLT(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldLT(FieldCancelledAt, v))
+}
+
+// CancelledAtLTE applies the LTE predicate on the "cancelled_at" field.
+func CancelledAtLTE(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldLTE(FieldCancelledAt, v))
+}
+
+// CancelledAtIsNil applies the IsNil predicate on the "cancelled_at" field.
+func CancelledAtIsNil() predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldIsNull(FieldCancelledAt))
+}
+
+// CancelledAtNotNil applies the NotNil predicate on the "cancelled_at" field.
+func CancelledAtNotNil() predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldNotNull(FieldCancelledAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EmailChangeToken) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EmailChangeToken) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EmailChangeToken) predicate.EmailChangeToken {
+	return predicate.EmailChangeToken(sql.NotPredicates(p))
+}
@@ -0,0 +1,429 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo-app/ent/emailchangetoken"
+	"todo-app/ent/user"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// EmailChangeTokenCreate is the builder for creating a EmailChangeToken entity.
+type EmailChangeTokenCreate struct {
+	config
+	mutation *EmailChangeTokenMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *EmailChangeTokenCreate) SetUserID(v int) *EmailChangeTokenCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNewEmail sets the "new_email" field.
+func (_c *EmailChangeTokenCreate) SetNewEmail(v string) *EmailChangeTokenCreate {
+	_c.mutation.SetNewEmail(v)
+	return _c
+}
+
+// SetOldEmail sets the "old_email" field.
+func (_c *EmailChangeTokenCreate) SetOldEmail(v string) *EmailChangeTokenCreate {
+	_c.mutation.SetOldEmail(v)
+	return _c
+}
+
+// SetNillableOldEmail sets the "old_email" field if the given value is not nil.
+func (_c *EmailChangeTokenCreate) SetNillableOldEmail(v *string) *EmailChangeTokenCreate {
+	if v != nil {
+		_c.SetOldEmail(*v)
+	}
+	return _c
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_c *EmailChangeTokenCreate) SetTokenHash(v string) *EmailChangeTokenCreate {
+	_c.mutation.SetTokenHash(v)
+	return _c
+}
+
+// SetCancelTokenHash sets the "cancel_token_hash" field.
+func (_c *EmailChangeTokenCreate) SetCancelTokenHash(v string) *EmailChangeTokenCreate {
+	_c.mutation.SetCancelTokenHash(v)
+	return _c
+}
+
+// SetNillableCancelTokenHash sets the "cancel_token_hash" field if the given value is not nil.
+func (_c *EmailChangeTokenCreate) SetNillableCancelTokenHash(v *string) *EmailChangeTokenCreate {
+	if v != nil {
+		_c.SetCancelTokenHash(*v)
+	}
+	return _c
+}
+
+// SetPurpose sets the "purpose" field.
+func (_c *EmailChangeTokenCreate) SetPurpose(v string) *EmailChangeTokenCreate {
+	_c.mutation.SetPurpose(v)
+	return _c
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (_c *EmailChangeTokenCreate) SetNillablePurpose(v *string) *EmailChangeTokenCreate {
+	if v != nil {
+		_c.SetPurpose(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *EmailChangeTokenCreate) SetExpiresAt(v time.Time) *EmailChangeTokenCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_c *EmailChangeTokenCreate) SetUsedAt(v time.Time) *EmailChangeTokenCreate {
+	_c.mutation.SetUsedAt(v)
+	return _c
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_c *EmailChangeTokenCreate) SetNillableUsedAt(v *time.Time) *EmailChangeTokenCreate {
+	if v != nil {
+		_c.SetUsedAt(*v)
+	}
+	return _c
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_c *EmailChangeTokenCreate) SetCancelledAt(v time.Time) *EmailChangeTokenCreate {
+	_c.mutation.SetCancelledAt(v)
+	return _c
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_c *EmailChangeTokenCreate) SetNillableCancelledAt(v *time.Time) *EmailChangeTokenCreate {
+	if v != nil {
+		_c.SetCancelledAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EmailChangeTokenCreate) SetCreatedAt(v time.Time) *EmailChangeTokenCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EmailChangeTokenCreate) SetNillableCreatedAt(v *time.Time) *EmailChangeTokenCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EmailChangeTokenCreate) SetID(v uuid.UUID) *EmailChangeTokenCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *EmailChangeTokenCreate) SetNillableID(v *uuid.UUID) *EmailChangeTokenCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *EmailChangeTokenCreate) SetUser(v *User) *EmailChangeTokenCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the EmailChangeTokenMutation object of the builder.
+func (_c *EmailChangeTokenCreate) Mutation() *EmailChangeTokenMutation {
+	return _c.mutation
+}
+
+// Save creates the EmailChangeToken in the database.
+func (_c *EmailChangeTokenCreate) Save(ctx context.Context) (*EmailChangeToken, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EmailChangeTokenCreate) SaveX(ctx context.Context) *EmailChangeToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmailChangeTokenCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmailChangeTokenCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EmailChangeTokenCreate) defaults() {
+	if _, ok := _c.mutation.OldEmail(); !ok {
+		v := emailchangetoken.DefaultOldEmail
+		_c.mutation.SetOldEmail(v)
+	}
+	if _, ok := _c.mutation.Purpose(); !ok {
+		v := emailchangetoken.DefaultPurpose
+		_c.mutation.SetPurpose(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := emailchangetoken.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := emailchangetoken.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EmailChangeTokenCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "EmailChangeToken.user_id"`)}
+	}
+	if _, ok := _c.mutation.NewEmail(); !ok {
+		return &ValidationError{Name: "new_email", err: errors.New(`ent: missing required field "EmailChangeToken.new_email"`)}
+	}
+	if v, ok := _c.mutation.NewEmail(); ok {
+		if err := emailchangetoken.NewEmailValidator(v); err != nil {
+			return &ValidationError{Name: "new_email", err: fmt.Errorf(`ent: validator failed for field "EmailChangeToken.new_email": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OldEmail(); !ok {
+		return &ValidationError{Name: "old_email", err: errors.New(`ent: missing required field "EmailChangeToken.old_email"`)}
+	}
+	if v, ok := _c.mutation.OldEmail(); ok {
+		if err := emailchangetoken.OldEmailValidator(v); err != nil {
+			return &ValidationError{Name: "old_email", err: fmt.Errorf(`ent: validator failed for field "EmailChangeToken.old_email": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "EmailChangeToken.token_hash"`)}
+	}
+	if v, ok := _c.mutation.TokenHash(); ok {
+		if err := emailchangetoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "EmailChangeToken.token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.CancelTokenHash(); ok {
+		if err := emailchangetoken.CancelTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "cancel_token_hash", err: fmt.Errorf(`ent: validator failed for field "EmailChangeToken.cancel_token_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Purpose(); !ok {
+		return &ValidationError{Name: "purpose", err: errors.New(`ent: missing required field "EmailChangeToken.purpose"`)}
+	}
+	if v, ok := _c.mutation.Purpose(); ok {
+		if err := emailchangetoken.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "EmailChangeToken.purpose": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "EmailChangeToken.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EmailChangeToken.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "EmailChangeToken.user"`)}
+	}
+	return nil
+}
+
+func (_c *EmailChangeTokenCreate) sqlSave(ctx context.Context) (*EmailChangeToken, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EmailChangeTokenCreate) createSpec() (*EmailChangeToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmailChangeToken{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(emailchangetoken.Table, sqlgraph.NewFieldSpec(emailchangetoken.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.NewEmail(); ok {
+		_spec.SetField(emailchangetoken.FieldNewEmail, field.TypeString, value)
+		_node.NewEmail = value
+	}
+	if value, ok := _c.mutation.OldEmail(); ok {
+		_spec.SetField(emailchangetoken.FieldOldEmail, field.TypeString, value)
+		_node.OldEmail = value
+	}
+	if value, ok := _c.mutation.TokenHash(); ok {
+		_spec.SetField(emailchangetoken.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := _c.mutation.CancelTokenHash(); ok {
+		_spec.SetField(emailchangetoken.FieldCancelTokenHash, field.TypeString, value)
+		_node.CancelTokenHash = &value
+	}
+	if value, ok := _c.mutation.Purpose(); ok {
+		_spec.SetField(emailchangetoken.FieldPurpose, field.TypeString, value)
+		_node.Purpose = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(emailchangetoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.UsedAt(); ok {
+		_spec.SetField(emailchangetoken.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	if value, ok := _c.mutation.CancelledAt(); ok {
+		_spec.SetField(emailchangetoken.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(emailchangetoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailchangetoken.UserTable,
+			Columns: []string{emailchangetoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EmailChangeTokenCreateBulk is the builder for creating many EmailChangeToken entities in bulk.
+type EmailChangeTokenCreateBulk struct {
+	config
+	err      error
+	builders []*EmailChangeTokenCreate
+}
+
+// Save creates the EmailChangeToken entities in the database.
+func (_c *EmailChangeTokenCreateBulk) Save(ctx context.Context) ([]*EmailChangeToken, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EmailChangeToken, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmailChangeTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EmailChangeTokenCreateBulk) SaveX(ctx context.Context) []*EmailChangeToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmailChangeTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmailChangeTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
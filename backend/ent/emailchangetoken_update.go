@@ -0,0 +1,683 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo-app/ent/emailchangetoken"
+	"todo-app/ent/predicate"
+	"todo-app/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EmailChangeTokenUpdate is the builder for updating EmailChangeToken entities.
+type EmailChangeTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmailChangeTokenMutation
+}
+
+// Where appends a list predicates to the EmailChangeTokenUpdate builder.
+func (_u *EmailChangeTokenUpdate) Where(ps ...predicate.EmailChangeToken) *EmailChangeTokenUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *EmailChangeTokenUpdate) SetUserID(v int) *EmailChangeTokenUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *EmailChangeTokenUpdate) SetNillableUserID(v *int) *EmailChangeTokenUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetNewEmail sets the "new_email" field.
+func (_u *EmailChangeTokenUpdate) SetNewEmail(v string) *EmailChangeTokenUpdate {
+	_u.mutation.SetNewEmail(v)
+	return _u
+}
+
+// SetNillableNewEmail sets the "new_email" field if the given value is not nil.
+func (_u *EmailChangeTokenUpdate) SetNillableNewEmail(v *string) *EmailChangeTokenUpdate {
+	if v != nil {
+		_u.SetNewEmail(*v)
+	}
+	return _u
+}
+
+// SetOldEmail sets the "old_email" field.
+func (_u *EmailChangeTokenUpdate) SetOldEmail(v string) *EmailChangeTokenUpdate {
+	_u.mutation.SetOldEmail(v)
+	return _u
+}
+
+// SetNillableOldEmail sets the "old_email" field if the given value is not nil.
+func (_u *EmailChangeTokenUpdate) SetNillableOldEmail(v *string) *EmailChangeTokenUpdate {
+	if v != nil {
+		_u.SetOldEmail(*v)
+	}
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *EmailChangeTokenUpdate) SetTokenHash(v string) *EmailChangeTokenUpdate {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *EmailChangeTokenUpdate) SetNillableTokenHash(v *string) *EmailChangeTokenUpdate {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// SetCancelTokenHash sets the "cancel_token_hash" field.
+func (_u *EmailChangeTokenUpdate) SetCancelTokenHash(v string) *EmailChangeTokenUpdate {
+	_u.mutation.SetCancelTokenHash(v)
+	return _u
+}
+
+// SetNillableCancelTokenHash sets the "cancel_token_hash" field if the given value is not nil.
+func (_u *EmailChangeTokenUpdate) SetNillableCancelTokenHash(v *string) *EmailChangeTokenUpdate {
+	if v != nil {
+		_u.SetCancelTokenHash(*v)
+	}
+	return _u
+}
+
+// ClearCancelTokenHash clears the value of the "cancel_token_hash" field.
+func (_u *EmailChangeTokenUpdate) ClearCancelTokenHash() *EmailChangeTokenUpdate {
+	_u.mutation.ClearCancelTokenHash()
+	return _u
+}
+
+// SetPurpose sets the "purpose" field.
+func (_u *EmailChangeTokenUpdate) SetPurpose(v string) *EmailChangeTokenUpdate {
+	_u.mutation.SetPurpose(v)
+	return _u
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (_u *EmailChangeTokenUpdate) SetNillablePurpose(v *string) *EmailChangeTokenUpdate {
+	if v != nil {
+		_u.SetPurpose(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *EmailChangeTokenUpdate) SetExpiresAt(v time.Time) *EmailChangeTokenUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *EmailChangeTokenUpdate) SetNillableExpiresAt(v *time.Time) *EmailChangeTokenUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *EmailChangeTokenUpdate) SetUsedAt(v time.Time) *EmailChangeTokenUpdate {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *EmailChangeTokenUpdate) SetNillableUsedAt(v *time.Time) *EmailChangeTokenUpdate {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *EmailChangeTokenUpdate) ClearUsedAt() *EmailChangeTokenUpdate {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *EmailChangeTokenUpdate) SetCancelledAt(v time.Time) *EmailChangeTokenUpdate {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *EmailChangeTokenUpdate) SetNillableCancelledAt(v *time.Time) *EmailChangeTokenUpdate {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *EmailChangeTokenUpdate) ClearCancelledAt() *EmailChangeTokenUpdate {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *EmailChangeTokenUpdate) SetUser(v *User) *EmailChangeTokenUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the EmailChangeTokenMutation object of the builder.
+func (_u *EmailChangeTokenUpdate) Mutation() *EmailChangeTokenMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *EmailChangeTokenUpdate) ClearUser() *EmailChangeTokenUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EmailChangeTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmailChangeTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EmailChangeTokenUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmailChangeTokenUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmailChangeTokenUpdate) check() error {
+	if v, ok := _u.mutation.NewEmail(); ok {
+		if err := emailchangetoken.NewEmailValidator(v); err != nil {
+			return &ValidationError{Name: "new_email", err: fmt.Errorf(`ent: validator failed for field "EmailChangeToken.new_email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OldEmail(); ok {
+		if err := emailchangetoken.OldEmailValidator(v); err != nil {
+			return &ValidationError{Name: "old_email", err: fmt.Errorf(`ent: validator failed for field "EmailChangeToken.old_email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenHash(); ok {
+		if err := emailchangetoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "EmailChangeToken.token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CancelTokenHash(); ok {
+		if err := emailchangetoken.CancelTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "cancel_token_hash", err: fmt.Errorf(`ent: validator failed for field "EmailChangeToken.cancel_token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Purpose(); ok {
+		if err := emailchangetoken.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "EmailChangeToken.purpose": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmailChangeToken.user"`)
+	}
+	return nil
+}
+
+func (_u *EmailChangeTokenUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailchangetoken.Table, emailchangetoken.Columns, sqlgraph.NewFieldSpec(emailchangetoken.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.NewEmail(); ok {
+		_spec.SetField(emailchangetoken.FieldNewEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OldEmail(); ok {
+		_spec.SetField(emailchangetoken.FieldOldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(emailchangetoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CancelTokenHash(); ok {
+		_spec.SetField(emailchangetoken.FieldCancelTokenHash, field.TypeString, value)
+	}
+	if _u.mutation.CancelTokenHashCleared() {
+		_spec.ClearField(emailchangetoken.FieldCancelTokenHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Purpose(); ok {
+		_spec.SetField(emailchangetoken.FieldPurpose, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(emailchangetoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(emailchangetoken.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(emailchangetoken.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(emailchangetoken.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(emailchangetoken.FieldCancelledAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailchangetoken.UserTable,
+			Columns: []string{emailchangetoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailchangetoken.UserTable,
+			Columns: []string{emailchangetoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailchangetoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EmailChangeTokenUpdateOne is the builder for updating a single EmailChangeToken entity.
+type EmailChangeTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmailChangeTokenMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *EmailChangeTokenUpdateOne) SetUserID(v int) *EmailChangeTokenUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *EmailChangeTokenUpdateOne) SetNillableUserID(v *int) *EmailChangeTokenUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetNewEmail sets the "new_email" field.
+func (_u *EmailChangeTokenUpdateOne) SetNewEmail(v string) *EmailChangeTokenUpdateOne {
+	_u.mutation.SetNewEmail(v)
+	return _u
+}
+
+// SetNillableNewEmail sets the "new_email" field if the given value is not nil.
+func (_u *EmailChangeTokenUpdateOne) SetNillableNewEmail(v *string) *EmailChangeTokenUpdateOne {
+	if v != nil {
+		_u.SetNewEmail(*v)
+	}
+	return _u
+}
+
+// SetOldEmail sets the "old_email" field.
+func (_u *EmailChangeTokenUpdateOne) SetOldEmail(v string) *EmailChangeTokenUpdateOne {
+	_u.mutation.SetOldEmail(v)
+	return _u
+}
+
+// SetNillableOldEmail sets the "old_email" field if the given value is not nil.
+func (_u *EmailChangeTokenUpdateOne) SetNillableOldEmail(v *string) *EmailChangeTokenUpdateOne {
+	if v != nil {
+		_u.SetOldEmail(*v)
+	}
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *EmailChangeTokenUpdateOne) SetTokenHash(v string) *EmailChangeTokenUpdateOne {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *EmailChangeTokenUpdateOne) SetNillableTokenHash(v *string) *EmailChangeTokenUpdateOne {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// SetCancelTokenHash sets the "cancel_token_hash" field.
+func (_u *EmailChangeTokenUpdateOne) SetCancelTokenHash(v string) *EmailChangeTokenUpdateOne {
+	_u.mutation.SetCancelTokenHash(v)
+	return _u
+}
+
+// SetNillableCancelTokenHash sets the "cancel_token_hash" field if the given value is not nil.
+func (_u *EmailChangeTokenUpdateOne) SetNillableCancelTokenHash(v *string) *EmailChangeTokenUpdateOne {
+	if v != nil {
+		_u.SetCancelTokenHash(*v)
+	}
+	return _u
+}
+
+// ClearCancelTokenHash clears the value of the "cancel_token_hash" field.
+func (_u *EmailChangeTokenUpdateOne) ClearCancelTokenHash() *EmailChangeTokenUpdateOne {
+	_u.mutation.ClearCancelTokenHash()
+	return _u
+}
+
+// SetPurpose sets the "purpose" field.
+func (_u *EmailChangeTokenUpdateOne) SetPurpose(v string) *EmailChangeTokenUpdateOne {
+	_u.mutation.SetPurpose(v)
+	return _u
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (_u *EmailChangeTokenUpdateOne) SetNillablePurpose(v *string) *EmailChangeTokenUpdateOne {
+	if v != nil {
+		_u.SetPurpose(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *EmailChangeTokenUpdateOne) SetExpiresAt(v time.Time) *EmailChangeTokenUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *EmailChangeTokenUpdateOne) SetNillableExpiresAt(v *time.Time) *EmailChangeTokenUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *EmailChangeTokenUpdateOne) SetUsedAt(v time.Time) *EmailChangeTokenUpdateOne {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *EmailChangeTokenUpdateOne) SetNillableUsedAt(v *time.Time) *EmailChangeTokenUpdateOne {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *EmailChangeTokenUpdateOne) ClearUsedAt() *EmailChangeTokenUpdateOne {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *EmailChangeTokenUpdateOne) SetCancelledAt(v time.Time) *EmailChangeTokenUpdateOne {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *EmailChangeTokenUpdateOne) SetNillableCancelledAt(v *time.Time) *EmailChangeTokenUpdateOne {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *EmailChangeTokenUpdateOne) ClearCancelledAt() *EmailChangeTokenUpdateOne {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *EmailChangeTokenUpdateOne) SetUser(v *User) *EmailChangeTokenUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the EmailChangeTokenMutation object of the builder.
+func (_u *EmailChangeTokenUpdateOne) Mutation() *EmailChangeTokenMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *EmailChangeTokenUpdateOne) ClearUser() *EmailChangeTokenUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the EmailChangeTokenUpdate builder.
+func (_u *EmailChangeTokenUpdateOne) Where(ps ...predicate.EmailChangeToken) *EmailChangeTokenUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EmailChangeTokenUpdateOne) Select(field string, fields ...string) *EmailChangeTokenUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EmailChangeToken entity.
+func (_u *EmailChangeTokenUpdateOne) Save(ctx context.Context) (*EmailChangeToken, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmailChangeTokenUpdateOne) SaveX(ctx context.Context) *EmailChangeToken {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EmailChangeTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmailChangeTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmailChangeTokenUpdateOne) check() error {
+	if v, ok := _u.mutation.NewEmail(); ok {
+		if err := emailchangetoken.NewEmailValidator(v); err != nil {
+			return &ValidationError{Name: "new_email", err: fmt.Errorf(`ent: validator failed for field "EmailChangeToken.new_email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OldEmail(); ok {
+		if err := emailchangetoken.OldEmailValidator(v); err != nil {
+			return &ValidationError{Name: "old_email", err: fmt.Errorf(`ent: validator failed for field "EmailChangeToken.old_email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenHash(); ok {
+		if err := emailchangetoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "EmailChangeToken.token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CancelTokenHash(); ok {
+		if err := emailchangetoken.CancelTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "cancel_token_hash", err: fmt.Errorf(`ent: validator failed for field "EmailChangeToken.cancel_token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Purpose(); ok {
+		if err := emailchangetoken.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "EmailChangeToken.purpose": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmailChangeToken.user"`)
+	}
+	return nil
+}
+
+func (_u *EmailChangeTokenUpdateOne) sqlSave(ctx context.Context) (_node *EmailChangeToken, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailchangetoken.Table, emailchangetoken.Columns, sqlgraph.NewFieldSpec(emailchangetoken.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmailChangeToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emailchangetoken.FieldID)
+		for _, f := range fields {
+			if !emailchangetoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != emailchangetoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.NewEmail(); ok {
+		_spec.SetField(emailchangetoken.FieldNewEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OldEmail(); ok {
+		_spec.SetField(emailchangetoken.FieldOldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(emailchangetoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CancelTokenHash(); ok {
+		_spec.SetField(emailchangetoken.FieldCancelTokenHash, field.TypeString, value)
+	}
+	if _u.mutation.CancelTokenHashCleared() {
+		_spec.ClearField(emailchangetoken.FieldCancelTokenHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Purpose(); ok {
+		_spec.SetField(emailchangetoken.FieldPurpose, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(emailchangetoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(emailchangetoken.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(emailchangetoken.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(emailchangetoken.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(emailchangetoken.FieldCancelledAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailchangetoken.UserTable,
+			Columns: []string{emailchangetoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailchangetoken.UserTable,
+			Columns: []string{emailchangetoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &EmailChangeToken{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailchangetoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -8,9 +8,31 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"todo-app/ent/accountdeletiontoken"
+	"todo-app/ent/adminauditlog"
+	"todo-app/ent/aiusage"
+	"todo-app/ent/emailchangetoken"
+	"todo-app/ent/loginattempt"
+	"todo-app/ent/passwordresettoken"
+	"todo-app/ent/personalaccesstoken"
+	"todo-app/ent/recoverycode"
+	"todo-app/ent/refreshtoken"
+	"todo-app/ent/session"
 	"todo-app/ent/todo"
+	"todo-app/ent/todobreakdown"
+	"todo-app/ent/todoembedding"
 	"todo-app/ent/todofilterhistory"
+	"todo-app/ent/todolist"
+	"todo-app/ent/todolistinvite"
+	"todo-app/ent/todolistmember"
+	"todo-app/ent/todosummary"
+	"todo-app/ent/totpcredential"
+	"todo-app/ent/usedmfatoken"
 	"todo-app/ent/user"
+	"todo-app/ent/useridentity"
+	"todo-app/ent/workspace"
+	"todo-app/ent/workspaceinvite"
+	"todo-app/ent/workspacemember"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -75,9 +97,31 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			todo.Table:              todo.ValidColumn,
-			todofilterhistory.Table: todofilterhistory.ValidColumn,
-			user.Table:              user.ValidColumn,
+			aiusage.Table:              aiusage.ValidColumn,
+			accountdeletiontoken.Table: accountdeletiontoken.ValidColumn,
+			adminauditlog.Table:        adminauditlog.ValidColumn,
+			emailchangetoken.Table:     emailchangetoken.ValidColumn,
+			loginattempt.Table:         loginattempt.ValidColumn,
+			passwordresettoken.Table:   passwordresettoken.ValidColumn,
+			personalaccesstoken.Table:  personalaccesstoken.ValidColumn,
+			recoverycode.Table:         recoverycode.ValidColumn,
+			refreshtoken.Table:         refreshtoken.ValidColumn,
+			session.Table:              session.ValidColumn,
+			totpcredential.Table:       totpcredential.ValidColumn,
+			todo.Table:                 todo.ValidColumn,
+			todobreakdown.Table:        todobreakdown.ValidColumn,
+			todoembedding.Table:        todoembedding.ValidColumn,
+			todofilterhistory.Table:    todofilterhistory.ValidColumn,
+			todolist.Table:             todolist.ValidColumn,
+			todolistinvite.Table:       todolistinvite.ValidColumn,
+			todolistmember.Table:       todolistmember.ValidColumn,
+			todosummary.Table:          todosummary.ValidColumn,
+			usedmfatoken.Table:         usedmfatoken.ValidColumn,
+			user.Table:                 user.ValidColumn,
+			useridentity.Table:         useridentity.ValidColumn,
+			workspace.Table:            workspace.ValidColumn,
+			workspaceinvite.Table:      workspaceinvite.ValidColumn,
+			workspacemember.Table:      workspacemember.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
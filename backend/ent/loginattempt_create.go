@@ -0,0 +1,323 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo-app/ent/loginattempt"
+	"todo-app/ent/user"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginAttemptCreate is the builder for creating a LoginAttempt entity.
+type LoginAttemptCreate struct {
+	config
+	mutation *LoginAttemptMutation
+	hooks    []Hook
+}
+
+// SetEmail sets the "email" field.
+func (_c *LoginAttemptCreate) SetEmail(v string) *LoginAttemptCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (_c *LoginAttemptCreate) SetIPAddress(v string) *LoginAttemptCreate {
+	_c.mutation.SetIPAddress(v)
+	return _c
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_c *LoginAttemptCreate) SetUserAgent(v string) *LoginAttemptCreate {
+	_c.mutation.SetUserAgent(v)
+	return _c
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_c *LoginAttemptCreate) SetNillableUserAgent(v *string) *LoginAttemptCreate {
+	if v != nil {
+		_c.SetUserAgent(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *LoginAttemptCreate) SetUserID(v int) *LoginAttemptCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *LoginAttemptCreate) SetNillableUserID(v *int) *LoginAttemptCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetResult sets the "result" field.
+func (_c *LoginAttemptCreate) SetResult(v string) *LoginAttemptCreate {
+	_c.mutation.SetResult(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LoginAttemptCreate) SetCreatedAt(v time.Time) *LoginAttemptCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LoginAttemptCreate) SetNillableCreatedAt(v *time.Time) *LoginAttemptCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *LoginAttemptCreate) SetUser(v *User) *LoginAttemptCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the LoginAttemptMutation object of the builder.
+func (_c *LoginAttemptCreate) Mutation() *LoginAttemptMutation {
+	return _c.mutation
+}
+
+// Save creates the LoginAttempt in the database.
+func (_c *LoginAttemptCreate) Save(ctx context.Context) (*LoginAttempt, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LoginAttemptCreate) SaveX(ctx context.Context) *LoginAttempt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LoginAttemptCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LoginAttemptCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LoginAttemptCreate) defaults() {
+	if _, ok := _c.mutation.UserAgent(); !ok {
+		v := loginattempt.DefaultUserAgent
+		_c.mutation.SetUserAgent(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := loginattempt.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LoginAttemptCreate) check() error {
+	if _, ok := _c.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "LoginAttempt.email"`)}
+	}
+	if v, ok := _c.mutation.Email(); ok {
+		if err := loginattempt.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "LoginAttempt.email": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IPAddress(); !ok {
+		return &ValidationError{Name: "ip_address", err: errors.New(`ent: missing required field "LoginAttempt.ip_address"`)}
+	}
+	if v, ok := _c.mutation.IPAddress(); ok {
+		if err := loginattempt.IPAddressValidator(v); err != nil {
+			return &ValidationError{Name: "ip_address", err: fmt.Errorf(`ent: validator failed for field "LoginAttempt.ip_address": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserAgent(); !ok {
+		return &ValidationError{Name: "user_agent", err: errors.New(`ent: missing required field "LoginAttempt.user_agent"`)}
+	}
+	if v, ok := _c.mutation.UserAgent(); ok {
+		if err := loginattempt.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "LoginAttempt.user_agent": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Result(); !ok {
+		return &ValidationError{Name: "result", err: errors.New(`ent: missing required field "LoginAttempt.result"`)}
+	}
+	if v, ok := _c.mutation.Result(); ok {
+		if err := loginattempt.ResultValidator(v); err != nil {
+			return &ValidationError{Name: "result", err: fmt.Errorf(`ent: validator failed for field "LoginAttempt.result": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LoginAttempt.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *LoginAttemptCreate) sqlSave(ctx context.Context) (*LoginAttempt, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LoginAttemptCreate) createSpec() (*LoginAttempt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoginAttempt{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(loginattempt.Table, sqlgraph.NewFieldSpec(loginattempt.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(loginattempt.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := _c.mutation.IPAddress(); ok {
+		_spec.SetField(loginattempt.FieldIPAddress, field.TypeString, value)
+		_node.IPAddress = value
+	}
+	if value, ok := _c.mutation.UserAgent(); ok {
+		_spec.SetField(loginattempt.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := _c.mutation.Result(); ok {
+		_spec.SetField(loginattempt.FieldResult, field.TypeString, value)
+		_node.Result = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(loginattempt.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loginattempt.UserTable,
+			Columns: []string{loginattempt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LoginAttemptCreateBulk is the builder for creating many LoginAttempt entities in bulk.
+type LoginAttemptCreateBulk struct {
+	config
+	err      error
+	builders []*LoginAttemptCreate
+}
+
+// Save creates the LoginAttempt entities in the database.
+func (_c *LoginAttemptCreateBulk) Save(ctx context.Context) ([]*LoginAttempt, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LoginAttempt, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoginAttemptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LoginAttemptCreateBulk) SaveX(ctx context.Context) []*LoginAttempt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LoginAttemptCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LoginAttemptCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,18 @@
+-- Modify "todos" table
+ALTER TABLE `todos` ADD COLUMN `parent_id` bigint NULL, ADD INDEX `todos_todos_children` (`parent_id`), ADD CONSTRAINT `todos_todos_children` FOREIGN KEY (`parent_id`) REFERENCES `todos` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Create "todo_breakdowns" table
+CREATE TABLE `todo_breakdowns` (
+  `id` char(36) NOT NULL,
+  `model` varchar(100) NOT NULL,
+  `steps` json NOT NULL,
+  `accepted_step_indexes` json NULL,
+  `accepted_at` timestamp NULL,
+  `created_at` timestamp NOT NULL,
+  `todo_id` bigint NOT NULL,
+  `user_id` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `todo_breakdowns_todos_breakdowns` (`todo_id`),
+  INDEX `todo_breakdowns_users_todo_breakdowns` (`user_id`),
+  CONSTRAINT `todo_breakdowns_todos_breakdowns` FOREIGN KEY (`todo_id`) REFERENCES `todos` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT `todo_breakdowns_users_todo_breakdowns` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
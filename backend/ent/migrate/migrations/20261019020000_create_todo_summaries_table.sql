@@ -0,0 +1,16 @@
+-- Create "todo_summaries" table
+CREATE TABLE `todo_summaries` (
+  `id` char(36) NOT NULL,
+  `range_from` timestamp NOT NULL,
+  `range_to` timestamp NOT NULL,
+  `source_hash` varchar(64) NOT NULL,
+  `model` varchar(100) NOT NULL,
+  `content` longtext NOT NULL,
+  `markdown` longtext NOT NULL,
+  `created_at` timestamp NOT NULL,
+  `updated_at` timestamp NOT NULL,
+  `user_id` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `todosummary_user_id_range_from_range_to` (`user_id`, `range_from`, `range_to`),
+  CONSTRAINT `todo_summaries_users_todo_summaries` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,2 @@
+-- Modify "todo_filter_histories" table
+ALTER TABLE `todo_filter_histories` ADD COLUMN `normalized_query` varchar(400) NULL, ADD COLUMN `date_bucket` varchar(20) NULL, ADD INDEX `todofilterhistory_user_id_normalized_query_date_bucket` (`user_id`, `normalized_query`, `date_bucket`);
@@ -0,0 +1,13 @@
+-- Create "ai_usages" table
+CREATE TABLE `ai_usages` (
+  `id` char(36) NOT NULL,
+  `model` varchar(100) NOT NULL,
+  `prompt_tokens` bigint NOT NULL DEFAULT 0,
+  `candidates_tokens` bigint NOT NULL DEFAULT 0,
+  `total_tokens` bigint NOT NULL DEFAULT 0,
+  `created_at` timestamp NOT NULL,
+  `user_id` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `aiusage_user_id_created_at` (`user_id`, `created_at`),
+  CONSTRAINT `ai_usages_users_ai_usages` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
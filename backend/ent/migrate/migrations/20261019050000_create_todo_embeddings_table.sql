@@ -0,0 +1,13 @@
+-- Create "todo_embeddings" table
+CREATE TABLE `todo_embeddings` (
+  `id` char(36) NOT NULL,
+  `model` varchar(100) NOT NULL,
+  `content_hash` varchar(64) NOT NULL,
+  `vector` json NOT NULL,
+  `created_at` timestamp NOT NULL,
+  `updated_at` timestamp NOT NULL,
+  `todo_id` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `todo_id` (`todo_id`),
+  CONSTRAINT `todo_embeddings_todos_embedding` FOREIGN KEY (`todo_id`) REFERENCES `todos` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
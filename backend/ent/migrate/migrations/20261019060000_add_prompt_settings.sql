@@ -0,0 +1,4 @@
+-- Modify "todo_filter_histories" table
+ALTER TABLE `todo_filter_histories` ADD COLUMN `prompt_version` varchar(20) NULL;
+-- Modify "users" table
+ALTER TABLE `users` ADD COLUMN `locale` varchar(10) NOT NULL DEFAULT 'ja', ADD COLUMN `time_zone` varchar(64) NOT NULL DEFAULT 'Asia/Tokyo';
@@ -0,0 +1,13 @@
+-- Create "sessions" table
+CREATE TABLE `sessions` (
+  `id` char(36) NOT NULL,
+  `ip_address` varchar(64) NOT NULL DEFAULT '',
+  `user_agent` varchar(512) NOT NULL DEFAULT '',
+  `last_seen_at` timestamp NOT NULL,
+  `revoked_at` timestamp NULL,
+  `created_at` timestamp NOT NULL,
+  `user_id` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `session_user_id_last_seen_at` (`user_id`, `last_seen_at`),
+  CONSTRAINT `sessions_users_sessions` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,16 @@
+-- Delete sessions issued before refresh tokens (they have no absolute lifetime, users log in again)
+DELETE FROM `sessions`;
+-- Modify "sessions" table
+ALTER TABLE `sessions` ADD COLUMN `expires_at` timestamp NOT NULL;
+-- Create "refresh_tokens" table
+CREATE TABLE `refresh_tokens` (
+  `id` char(36) NOT NULL,
+  `token_hash` varchar(64) NOT NULL,
+  `expires_at` timestamp NOT NULL,
+  `used_at` timestamp NULL,
+  `created_at` timestamp NOT NULL,
+  `session_id` char(36) NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `token_hash` (`token_hash`),
+  CONSTRAINT `refresh_tokens_sessions_refresh_tokens` FOREIGN KEY (`session_id`) REFERENCES `sessions` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
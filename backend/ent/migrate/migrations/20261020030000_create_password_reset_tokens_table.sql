@@ -0,0 +1,12 @@
+-- Create "password_reset_tokens" table
+CREATE TABLE `password_reset_tokens` (
+  `id` char(36) NOT NULL,
+  `token_hash` varchar(64) NOT NULL,
+  `expires_at` timestamp NOT NULL,
+  `used_at` timestamp NULL,
+  `created_at` timestamp NOT NULL,
+  `user_id` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `token_hash` (`token_hash`),
+  CONSTRAINT `password_reset_tokens_users_password_reset_tokens` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
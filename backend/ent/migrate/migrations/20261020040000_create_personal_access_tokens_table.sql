@@ -0,0 +1,17 @@
+-- Create "personal_access_tokens" table
+CREATE TABLE `personal_access_tokens` (
+  `id` char(36) NOT NULL,
+  `name` varchar(100) NOT NULL,
+  `token_hash` varchar(64) NOT NULL,
+  `token_prefix` varchar(16) NOT NULL,
+  `scopes` json NOT NULL,
+  `expires_at` timestamp NULL,
+  `last_used_at` timestamp NULL,
+  `revoked_at` timestamp NULL,
+  `created_at` timestamp NOT NULL,
+  `user_id` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `token_hash` (`token_hash`),
+  INDEX `personalaccesstoken_user_id_created_at` (`user_id`, `created_at`),
+  CONSTRAINT `personal_access_tokens_users_personal_access_tokens` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
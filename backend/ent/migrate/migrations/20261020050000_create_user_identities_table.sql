@@ -0,0 +1,14 @@
+-- Create "user_identities" table
+CREATE TABLE `user_identities` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `provider` varchar(64) NOT NULL,
+  `subject` varchar(255) NOT NULL,
+  `email` varchar(255) NOT NULL,
+  `last_login_at` timestamp NOT NULL,
+  `created_at` timestamp NOT NULL,
+  `user_id` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `useridentity_provider_subject` (`provider`, `subject`),
+  INDEX `user_identities_users_identities` (`user_id`),
+  CONSTRAINT `user_identities_users_identities` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
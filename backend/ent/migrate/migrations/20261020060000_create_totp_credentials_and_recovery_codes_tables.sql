@@ -0,0 +1,23 @@
+-- Create "recovery_codes" table
+CREATE TABLE `recovery_codes` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `code_hash` varchar(64) NOT NULL,
+  `used_at` timestamp NULL,
+  `created_at` timestamp NOT NULL,
+  `user_id` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `recoverycode_user_id_code_hash` (`user_id`, `code_hash`),
+  CONSTRAINT `recovery_codes_users_recovery_codes` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "totp_credentials" table
+CREATE TABLE `totp_credentials` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `secret` varchar(64) NOT NULL,
+  `confirmed_at` timestamp NULL,
+  `last_used_step` bigint NOT NULL DEFAULT 0,
+  `created_at` timestamp NOT NULL,
+  `user_id` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `user_id` (`user_id`),
+  CONSTRAINT `totp_credentials_users_totp_credential` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,15 @@
+-- Create "login_attempts" table
+CREATE TABLE `login_attempts` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `email` varchar(255) NOT NULL,
+  `ip_address` varchar(64) NOT NULL,
+  `user_agent` varchar(512) NOT NULL DEFAULT '',
+  `result` varchar(32) NOT NULL,
+  `created_at` timestamp NOT NULL,
+  `user_id` bigint NULL,
+  PRIMARY KEY (`id`),
+  INDEX `loginattempt_email_created_at` (`email`, `created_at`),
+  INDEX `loginattempt_ip_address_created_at` (`ip_address`, `created_at`),
+  INDEX `login_attempts_users_login_attempts` (`user_id`),
+  CONSTRAINT `login_attempts_users_login_attempts` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,16 @@
+-- Modify "users" table
+ALTER TABLE `users` ADD COLUMN `todo_page_size` bigint NOT NULL DEFAULT 20, ADD COLUMN `todo_include_done` bool NOT NULL DEFAULT 0;
+-- Create "email_change_tokens" table
+CREATE TABLE `email_change_tokens` (
+  `id` char(36) NOT NULL,
+  `new_email` varchar(255) NOT NULL,
+  `token_hash` varchar(64) NOT NULL,
+  `expires_at` timestamp NOT NULL,
+  `used_at` timestamp NULL,
+  `created_at` timestamp NOT NULL,
+  `user_id` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `token_hash` (`token_hash`),
+  INDEX `email_change_tokens_users_email_change_tokens` (`user_id`),
+  CONSTRAINT `email_change_tokens_users_email_change_tokens` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
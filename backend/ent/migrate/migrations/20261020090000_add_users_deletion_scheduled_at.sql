@@ -0,0 +1,2 @@
+-- Modify "users" table
+ALTER TABLE `users` ADD COLUMN `deletion_scheduled_at` timestamp NULL;
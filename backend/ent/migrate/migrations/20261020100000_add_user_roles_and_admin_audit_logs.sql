@@ -0,0 +1,17 @@
+-- Modify "users" table
+ALTER TABLE `users` ADD COLUMN `role` varchar(16) NOT NULL DEFAULT 'user', ADD COLUMN `disabled_at` timestamp NULL;
+-- Modify "sessions" table
+ALTER TABLE `sessions` ADD COLUMN `impersonator_id` bigint NULL;
+-- Create "admin_audit_logs" table
+CREATE TABLE `admin_audit_logs` (
+  `id` char(36) NOT NULL,
+  `actor_id` bigint NOT NULL,
+  `target_user_id` bigint NULL,
+  `action` varchar(64) NOT NULL,
+  `detail` json NULL,
+  `ip_address` varchar(64) NOT NULL DEFAULT '',
+  `created_at` timestamp NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `adminauditlog_actor_id_created_at` (`actor_id`, `created_at`),
+  INDEX `adminauditlog_target_user_id_created_at` (`target_user_id`, `created_at`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,39 @@
+-- Create "todo_lists" table
+CREATE TABLE `todo_lists` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `name` varchar(64) NOT NULL,
+  `created_at` timestamp NOT NULL,
+  `updated_at` timestamp NOT NULL,
+  PRIMARY KEY (`id`)
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Modify "todos" table
+ALTER TABLE `todos` ADD COLUMN `list_id` bigint NULL, ADD INDEX `todos_todo_lists_todos` (`list_id`), ADD CONSTRAINT `todos_todo_lists_todos` FOREIGN KEY (`list_id`) REFERENCES `todo_lists` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Create "todo_list_invites" table
+CREATE TABLE `todo_list_invites` (
+  `id` char(36) NOT NULL,
+  `email` varchar(255) NOT NULL,
+  `role` varchar(16) NOT NULL,
+  `token_hash` varchar(64) NOT NULL,
+  `invited_by` bigint NOT NULL,
+  `expires_at` timestamp NOT NULL,
+  `used_at` timestamp NULL,
+  `created_at` timestamp NOT NULL,
+  `list_id` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `token_hash` (`token_hash`),
+  INDEX `todolistinvite_list_id_email` (`list_id`, `email`),
+  CONSTRAINT `todo_list_invites_todo_lists_invites` FOREIGN KEY (`list_id`) REFERENCES `todo_lists` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "todo_list_members" table
+CREATE TABLE `todo_list_members` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `role` varchar(16) NOT NULL,
+  `created_at` timestamp NOT NULL,
+  `list_id` bigint NOT NULL,
+  `user_id` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `todolistmember_list_id_user_id` (`list_id`, `user_id`),
+  INDEX `todolistmember_user_id` (`user_id`),
+  CONSTRAINT `todo_list_members_todo_lists_members` FOREIGN KEY (`list_id`) REFERENCES `todo_lists` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT `todo_list_members_users_todo_list_memberships` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,51 @@
+-- Create "workspaces" table
+CREATE TABLE `workspaces` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `name` varchar(64) NOT NULL,
+  `created_at` timestamp NOT NULL,
+  `updated_at` timestamp NOT NULL,
+  `personal_owner_id` bigint NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `personal_owner_id` (`personal_owner_id`),
+  CONSTRAINT `workspaces_users_personal_workspace` FOREIGN KEY (`personal_owner_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "workspace_members" table
+CREATE TABLE `workspace_members` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `role` varchar(16) NOT NULL,
+  `created_at` timestamp NOT NULL,
+  `user_id` bigint NOT NULL,
+  `workspace_id` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `workspacemember_workspace_id_user_id` (`workspace_id`, `user_id`),
+  INDEX `workspacemember_user_id` (`user_id`),
+  CONSTRAINT `workspace_members_users_workspace_memberships` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT `workspace_members_workspaces_members` FOREIGN KEY (`workspace_id`) REFERENCES `workspaces` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- 既存のユーザーごとに個人のワークスペースを作成する
+INSERT INTO `workspaces` (`name`, `personal_owner_id`, `created_at`, `updated_at`)
+SELECT 'Personal', `id`, NOW(), NOW() FROM `users`;
+INSERT INTO `workspace_members` (`workspace_id`, `user_id`, `role`, `created_at`)
+SELECT `id`, `personal_owner_id`, 'admin', NOW() FROM `workspaces` WHERE `personal_owner_id` IS NOT NULL;
+-- 既存の共有のリストはメンバーが複数のユーザーにまたがるため、リストごとにチームのワークスペースを作成して移す
+ALTER TABLE `workspaces` ADD COLUMN `migrated_list_id` bigint NULL;
+INSERT INTO `workspaces` (`name`, `migrated_list_id`, `created_at`, `updated_at`)
+SELECT `name`, `id`, NOW(), NOW() FROM `todo_lists`;
+INSERT INTO `workspace_members` (`workspace_id`, `user_id`, `role`, `created_at`)
+SELECT w.`id`, m.`user_id`, IF(m.`role` = 'owner', 'admin', 'member'), m.`created_at`
+FROM `todo_list_members` m JOIN `workspaces` w ON w.`migrated_list_id` = m.`list_id`;
+-- Modify "todo_lists" table
+ALTER TABLE `todo_lists` ADD COLUMN `workspace_id` bigint NULL;
+UPDATE `todo_lists` l JOIN `workspaces` w ON w.`migrated_list_id` = l.`id` SET l.`workspace_id` = w.`id`;
+ALTER TABLE `todo_lists` MODIFY COLUMN `workspace_id` bigint NOT NULL, ADD INDEX `todo_lists_workspaces_todo_lists` (`workspace_id`), ADD CONSTRAINT `todo_lists_workspaces_todo_lists` FOREIGN KEY (`workspace_id`) REFERENCES `workspaces` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE;
+ALTER TABLE `workspaces` DROP COLUMN `migrated_list_id`;
+-- Modify "todos" table
+ALTER TABLE `todos` ADD COLUMN `workspace_id` bigint NULL;
+UPDATE `todos` t JOIN `todo_lists` l ON l.`id` = t.`list_id` SET t.`workspace_id` = l.`workspace_id`;
+UPDATE `todos` t JOIN `workspaces` w ON w.`personal_owner_id` = t.`user_id` SET t.`workspace_id` = w.`id` WHERE t.`workspace_id` IS NULL;
+ALTER TABLE `todos` MODIFY COLUMN `workspace_id` bigint NOT NULL, ADD INDEX `todos_workspaces_todos` (`workspace_id`), ADD CONSTRAINT `todos_workspaces_todos` FOREIGN KEY (`workspace_id`) REFERENCES `workspaces` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "todo_filter_histories" table
+ALTER TABLE `todo_filter_histories` ADD COLUMN `workspace_id` bigint NULL;
+UPDATE `todo_filter_histories` h JOIN `workspaces` w ON w.`personal_owner_id` = h.`user_id` SET h.`workspace_id` = w.`id`;
+ALTER TABLE `todo_filter_histories` MODIFY COLUMN `workspace_id` bigint NOT NULL, ADD INDEX `todofilterhistory_user_id_workspace_id_normalized_query_date_bucket` (`user_id`, `workspace_id`, `normalized_query`, `date_bucket`), ADD CONSTRAINT `todo_filter_histories_workspaces_todo_filter_histories` FOREIGN KEY (`workspace_id`) REFERENCES `workspaces` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE;
+ALTER TABLE `todo_filter_histories` DROP INDEX `todofilterhistory_user_id_normalized_query_date_bucket`;
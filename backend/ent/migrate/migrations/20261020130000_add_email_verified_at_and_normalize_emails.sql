@@ -0,0 +1,9 @@
+-- Modify "users" table
+ALTER TABLE `users` ADD COLUMN `email_verified_at` timestamp NULL;
+-- 大文字・小文字だけが異なるメールアドレスを別のアカウントにしないよう、小文字で保存する。
+-- 小文字にすると重複するメールアドレスは、一意制約に違反するため変更しない
+UPDATE `users` SET `email` = LOWER(`email`)
+WHERE `email` <> LOWER(`email`)
+  AND LOWER(`email`) IN (
+    SELECT `e` FROM (SELECT LOWER(`email`) AS `e` FROM `users` GROUP BY LOWER(`email`) HAVING COUNT(*) = 1) AS `t`
+  );
@@ -0,0 +1,9 @@
+-- Create "used_mfa_tokens" table
+CREATE TABLE `used_mfa_tokens` (
+  `id` char(36) NOT NULL,
+  `expires_at` timestamp NOT NULL,
+  `created_at` timestamp NOT NULL,
+  `user_id` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  CONSTRAINT `used_mfa_tokens_users_used_mfa_tokens` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,2 @@
+-- Modify "email_change_tokens" table
+ALTER TABLE `email_change_tokens` ADD COLUMN `old_email` varchar(255) NOT NULL DEFAULT '', ADD COLUMN `cancel_token_hash` varchar(64) NULL, ADD COLUMN `cancelled_at` timestamp NULL, ADD UNIQUE INDEX `cancel_token_hash` (`cancel_token_hash`);
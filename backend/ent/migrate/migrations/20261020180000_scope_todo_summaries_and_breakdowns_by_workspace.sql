@@ -0,0 +1,10 @@
+-- Modify "todo_breakdowns" table
+ALTER TABLE `todo_breakdowns` ADD COLUMN `workspace_id` bigint NULL;
+UPDATE `todo_breakdowns` b JOIN `todos` t ON t.`id` = b.`todo_id` SET b.`workspace_id` = t.`workspace_id`;
+ALTER TABLE `todo_breakdowns` MODIFY COLUMN `workspace_id` bigint NOT NULL, ADD INDEX `todo_breakdowns_workspaces_todo_breakdowns` (`workspace_id`), ADD CONSTRAINT `todo_breakdowns_workspaces_todo_breakdowns` FOREIGN KEY (`workspace_id`) REFERENCES `workspaces` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "todo_summaries" table
+-- 既存のサマリーはワークスペースの導入前に個人の Todo から作成したため、個人のワークスペースに移す
+ALTER TABLE `todo_summaries` ADD COLUMN `workspace_id` bigint NULL;
+UPDATE `todo_summaries` s JOIN `workspaces` w ON w.`personal_owner_id` = s.`user_id` SET s.`workspace_id` = w.`id`;
+ALTER TABLE `todo_summaries` MODIFY COLUMN `workspace_id` bigint NOT NULL, ADD UNIQUE INDEX `todosummary_user_id_workspace_id_range_from_range_to` (`user_id`, `workspace_id`, `range_from`, `range_to`), ADD INDEX `todo_summaries_workspaces_todo_summaries` (`workspace_id`), ADD CONSTRAINT `todo_summaries_workspaces_todo_summaries` FOREIGN KEY (`workspace_id`) REFERENCES `workspaces` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE;
+ALTER TABLE `todo_summaries` DROP INDEX `todosummary_user_id_range_from_range_to`;
@@ -0,0 +1,16 @@
+-- Create "workspace_invites" table
+CREATE TABLE `workspace_invites` (
+  `id` char(36) NOT NULL,
+  `email` varchar(255) NOT NULL,
+  `role` varchar(16) NOT NULL,
+  `token_hash` varchar(64) NOT NULL,
+  `invited_by` bigint NOT NULL,
+  `expires_at` timestamp NOT NULL,
+  `used_at` timestamp NULL,
+  `created_at` timestamp NOT NULL,
+  `workspace_id` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `token_hash` (`token_hash`),
+  INDEX `workspaceinvite_workspace_id_email` (`workspace_id`, `email`),
+  CONSTRAINT `workspace_invites_workspaces_invites` FOREIGN KEY (`workspace_id`) REFERENCES `workspaces` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,2 @@
+-- Modify "email_change_tokens" table
+ALTER TABLE `email_change_tokens` ADD COLUMN `purpose` varchar(32) NOT NULL DEFAULT 'confirm';
@@ -9,6 +9,317 @@ import (
 )
 
 var (
+	// AiUsagesColumns holds the columns for the "ai_usages" table.
+	AiUsagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "model", Type: field.TypeString, Size: 100},
+		{Name: "prompt_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "candidates_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "total_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// AiUsagesTable holds the schema information for the "ai_usages" table.
+	AiUsagesTable = &schema.Table{
+		Name:       "ai_usages",
+		Columns:    AiUsagesColumns,
+		PrimaryKey: []*schema.Column{AiUsagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ai_usages_users_ai_usages",
+				Columns:    []*schema.Column{AiUsagesColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "aiusage_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AiUsagesColumns[6], AiUsagesColumns[5]},
+			},
+		},
+	}
+	// AccountDeletionTokensColumns holds the columns for the "account_deletion_tokens" table.
+	AccountDeletionTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// AccountDeletionTokensTable holds the schema information for the "account_deletion_tokens" table.
+	AccountDeletionTokensTable = &schema.Table{
+		Name:       "account_deletion_tokens",
+		Columns:    AccountDeletionTokensColumns,
+		PrimaryKey: []*schema.Column{AccountDeletionTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "account_deletion_tokens_users_account_deletion_tokens",
+				Columns:    []*schema.Column{AccountDeletionTokensColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// AdminAuditLogsColumns holds the columns for the "admin_audit_logs" table.
+	AdminAuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "actor_id", Type: field.TypeInt},
+		{Name: "target_user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "action", Type: field.TypeString, Size: 64},
+		{Name: "detail", Type: field.TypeJSON, Nullable: true},
+		{Name: "ip_address", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AdminAuditLogsTable holds the schema information for the "admin_audit_logs" table.
+	AdminAuditLogsTable = &schema.Table{
+		Name:       "admin_audit_logs",
+		Columns:    AdminAuditLogsColumns,
+		PrimaryKey: []*schema.Column{AdminAuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "adminauditlog_actor_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AdminAuditLogsColumns[1], AdminAuditLogsColumns[6]},
+			},
+			{
+				Name:    "adminauditlog_target_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AdminAuditLogsColumns[2], AdminAuditLogsColumns[6]},
+			},
+		},
+	}
+	// EmailChangeTokensColumns holds the columns for the "email_change_tokens" table.
+	EmailChangeTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "new_email", Type: field.TypeString, Size: 255},
+		{Name: "old_email", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "cancel_token_hash", Type: field.TypeString, Unique: true, Nullable: true, Size: 64},
+		{Name: "purpose", Type: field.TypeString, Size: 32, Default: "confirm"},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// EmailChangeTokensTable holds the schema information for the "email_change_tokens" table.
+	EmailChangeTokensTable = &schema.Table{
+		Name:       "email_change_tokens",
+		Columns:    EmailChangeTokensColumns,
+		PrimaryKey: []*schema.Column{EmailChangeTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "email_change_tokens_users_email_change_tokens",
+				Columns:    []*schema.Column{EmailChangeTokensColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// LoginAttemptsColumns holds the columns for the "login_attempts" table.
+	LoginAttemptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "email", Type: field.TypeString, Size: 255},
+		{Name: "ip_address", Type: field.TypeString, Size: 64},
+		{Name: "user_agent", Type: field.TypeString, Size: 512, Default: ""},
+		{Name: "result", Type: field.TypeString, Size: 32},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+	}
+	// LoginAttemptsTable holds the schema information for the "login_attempts" table.
+	LoginAttemptsTable = &schema.Table{
+		Name:       "login_attempts",
+		Columns:    LoginAttemptsColumns,
+		PrimaryKey: []*schema.Column{LoginAttemptsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "login_attempts_users_login_attempts",
+				Columns:    []*schema.Column{LoginAttemptsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "loginattempt_email_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LoginAttemptsColumns[1], LoginAttemptsColumns[5]},
+			},
+			{
+				Name:    "loginattempt_ip_address_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LoginAttemptsColumns[2], LoginAttemptsColumns[5]},
+			},
+		},
+	}
+	// PasswordResetTokensColumns holds the columns for the "password_reset_tokens" table.
+	PasswordResetTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// PasswordResetTokensTable holds the schema information for the "password_reset_tokens" table.
+	PasswordResetTokensTable = &schema.Table{
+		Name:       "password_reset_tokens",
+		Columns:    PasswordResetTokensColumns,
+		PrimaryKey: []*schema.Column{PasswordResetTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "password_reset_tokens_users_password_reset_tokens",
+				Columns:    []*schema.Column{PasswordResetTokensColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// PersonalAccessTokensColumns holds the columns for the "personal_access_tokens" table.
+	PersonalAccessTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "token_prefix", Type: field.TypeString, Size: 16},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// PersonalAccessTokensTable holds the schema information for the "personal_access_tokens" table.
+	PersonalAccessTokensTable = &schema.Table{
+		Name:       "personal_access_tokens",
+		Columns:    PersonalAccessTokensColumns,
+		PrimaryKey: []*schema.Column{PersonalAccessTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "personal_access_tokens_users_personal_access_tokens",
+				Columns:    []*schema.Column{PersonalAccessTokensColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "personalaccesstoken_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PersonalAccessTokensColumns[9], PersonalAccessTokensColumns[8]},
+			},
+		},
+	}
+	// RecoveryCodesColumns holds the columns for the "recovery_codes" table.
+	RecoveryCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code_hash", Type: field.TypeString, Size: 64},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// RecoveryCodesTable holds the schema information for the "recovery_codes" table.
+	RecoveryCodesTable = &schema.Table{
+		Name:       "recovery_codes",
+		Columns:    RecoveryCodesColumns,
+		PrimaryKey: []*schema.Column{RecoveryCodesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "recovery_codes_users_recovery_codes",
+				Columns:    []*schema.Column{RecoveryCodesColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "recoverycode_user_id_code_hash",
+				Unique:  true,
+				Columns: []*schema.Column{RecoveryCodesColumns[4], RecoveryCodesColumns[1]},
+			},
+		},
+	}
+	// RefreshTokensColumns holds the columns for the "refresh_tokens" table.
+	RefreshTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "session_id", Type: field.TypeUUID},
+	}
+	// RefreshTokensTable holds the schema information for the "refresh_tokens" table.
+	RefreshTokensTable = &schema.Table{
+		Name:       "refresh_tokens",
+		Columns:    RefreshTokensColumns,
+		PrimaryKey: []*schema.Column{RefreshTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "refresh_tokens_sessions_refresh_tokens",
+				Columns:    []*schema.Column{RefreshTokensColumns[5]},
+				RefColumns: []*schema.Column{SessionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// SessionsColumns holds the columns for the "sessions" table.
+	SessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "ip_address", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "user_agent", Type: field.TypeString, Size: 512, Default: ""},
+		{Name: "last_seen_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "impersonator_id", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// SessionsTable holds the schema information for the "sessions" table.
+	SessionsTable = &schema.Table{
+		Name:       "sessions",
+		Columns:    SessionsColumns,
+		PrimaryKey: []*schema.Column{SessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sessions_users_sessions",
+				Columns:    []*schema.Column{SessionsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "session_user_id_last_seen_at",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[8], SessionsColumns[3]},
+			},
+		},
+	}
+	// TotpCredentialsColumns holds the columns for the "totp_credentials" table.
+	TotpCredentialsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "secret", Type: field.TypeString, Size: 64},
+		{Name: "confirmed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_step", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt, Unique: true},
+	}
+	// TotpCredentialsTable holds the schema information for the "totp_credentials" table.
+	TotpCredentialsTable = &schema.Table{
+		Name:       "totp_credentials",
+		Columns:    TotpCredentialsColumns,
+		PrimaryKey: []*schema.Column{TotpCredentialsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "totp_credentials_users_totp_credential",
+				Columns:    []*schema.Column{TotpCredentialsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// TodosColumns holds the columns for the "todos" table.
 	TodosColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -17,7 +328,10 @@ var (
 		{Name: "done_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "user_id", Type: field.TypeInt},
+		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
+		{Name: "list_id", Type: field.TypeInt, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "workspace_id", Type: field.TypeInt},
 	}
 	// TodosTable holds the schema information for the "todos" table.
 	TodosTable = &schema.Table{
@@ -26,9 +340,89 @@ var (
 		PrimaryKey: []*schema.Column{TodosColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "todos_users_todos",
+				Symbol:     "todos_todos_children",
 				Columns:    []*schema.Column{TodosColumns[6]},
+				RefColumns: []*schema.Column{TodosColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "todos_todo_lists_todos",
+				Columns:    []*schema.Column{TodosColumns[7]},
+				RefColumns: []*schema.Column{TodoListsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "todos_users_todos",
+				Columns:    []*schema.Column{TodosColumns[8]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "todos_workspaces_todos",
+				Columns:    []*schema.Column{TodosColumns[9]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TodoBreakdownsColumns holds the columns for the "todo_breakdowns" table.
+	TodoBreakdownsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "model", Type: field.TypeString, Size: 100},
+		{Name: "steps", Type: field.TypeJSON},
+		{Name: "accepted_step_indexes", Type: field.TypeJSON, Nullable: true},
+		{Name: "accepted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "todo_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "workspace_id", Type: field.TypeInt},
+	}
+	// TodoBreakdownsTable holds the schema information for the "todo_breakdowns" table.
+	TodoBreakdownsTable = &schema.Table{
+		Name:       "todo_breakdowns",
+		Columns:    TodoBreakdownsColumns,
+		PrimaryKey: []*schema.Column{TodoBreakdownsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "todo_breakdowns_todos_breakdowns",
+				Columns:    []*schema.Column{TodoBreakdownsColumns[6]},
+				RefColumns: []*schema.Column{TodosColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "todo_breakdowns_users_todo_breakdowns",
+				Columns:    []*schema.Column{TodoBreakdownsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "todo_breakdowns_workspaces_todo_breakdowns",
+				Columns:    []*schema.Column{TodoBreakdownsColumns[8]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TodoEmbeddingsColumns holds the columns for the "todo_embeddings" table.
+	TodoEmbeddingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "model", Type: field.TypeString, Size: 100},
+		{Name: "content_hash", Type: field.TypeString, Size: 64},
+		{Name: "vector", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "todo_id", Type: field.TypeInt, Unique: true},
+	}
+	// TodoEmbeddingsTable holds the schema information for the "todo_embeddings" table.
+	TodoEmbeddingsTable = &schema.Table{
+		Name:       "todo_embeddings",
+		Columns:    TodoEmbeddingsColumns,
+		PrimaryKey: []*schema.Column{TodoEmbeddingsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "todo_embeddings_todos_embedding",
+				Columns:    []*schema.Column{TodoEmbeddingsColumns[6]},
+				RefColumns: []*schema.Column{TodosColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
@@ -37,11 +431,15 @@ var (
 	TodoFilterHistoriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "query", Type: field.TypeString, Size: 400},
+		{Name: "normalized_query", Type: field.TypeString, Nullable: true, Size: 400},
+		{Name: "date_bucket", Type: field.TypeString, Nullable: true, Size: 20},
+		{Name: "prompt_version", Type: field.TypeString, Nullable: true, Size: 20},
 		{Name: "function_name", Type: field.TypeString, Nullable: true, Size: 100},
 		{Name: "args", Type: field.TypeJSON, Nullable: true},
 		{Name: "result_todo_ids", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "user_id", Type: field.TypeInt},
+		{Name: "workspace_id", Type: field.TypeInt},
 	}
 	// TodoFilterHistoriesTable holds the schema information for the "todo_filter_histories" table.
 	TodoFilterHistoriesTable = &schema.Table{
@@ -51,7 +449,177 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "todo_filter_histories_users_todo_filter_histories",
-				Columns:    []*schema.Column{TodoFilterHistoriesColumns[6]},
+				Columns:    []*schema.Column{TodoFilterHistoriesColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "todo_filter_histories_workspaces_todo_filter_histories",
+				Columns:    []*schema.Column{TodoFilterHistoriesColumns[10]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "todofilterhistory_user_id_workspace_id_normalized_query_date_bucket",
+				Unique:  false,
+				Columns: []*schema.Column{TodoFilterHistoriesColumns[9], TodoFilterHistoriesColumns[10], TodoFilterHistoriesColumns[2], TodoFilterHistoriesColumns[3]},
+			},
+		},
+	}
+	// TodoListsColumns holds the columns for the "todo_lists" table.
+	TodoListsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 64},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "workspace_id", Type: field.TypeInt},
+	}
+	// TodoListsTable holds the schema information for the "todo_lists" table.
+	TodoListsTable = &schema.Table{
+		Name:       "todo_lists",
+		Columns:    TodoListsColumns,
+		PrimaryKey: []*schema.Column{TodoListsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "todo_lists_workspaces_todo_lists",
+				Columns:    []*schema.Column{TodoListsColumns[4]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TodoListInvitesColumns holds the columns for the "todo_list_invites" table.
+	TodoListInvitesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "email", Type: field.TypeString, Size: 255},
+		{Name: "role", Type: field.TypeString, Size: 16},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "invited_by", Type: field.TypeInt},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "list_id", Type: field.TypeInt},
+	}
+	// TodoListInvitesTable holds the schema information for the "todo_list_invites" table.
+	TodoListInvitesTable = &schema.Table{
+		Name:       "todo_list_invites",
+		Columns:    TodoListInvitesColumns,
+		PrimaryKey: []*schema.Column{TodoListInvitesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "todo_list_invites_todo_lists_invites",
+				Columns:    []*schema.Column{TodoListInvitesColumns[8]},
+				RefColumns: []*schema.Column{TodoListsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "todolistinvite_list_id_email",
+				Unique:  false,
+				Columns: []*schema.Column{TodoListInvitesColumns[8], TodoListInvitesColumns[1]},
+			},
+		},
+	}
+	// TodoListMembersColumns holds the columns for the "todo_list_members" table.
+	TodoListMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "role", Type: field.TypeString, Size: 16},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "list_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// TodoListMembersTable holds the schema information for the "todo_list_members" table.
+	TodoListMembersTable = &schema.Table{
+		Name:       "todo_list_members",
+		Columns:    TodoListMembersColumns,
+		PrimaryKey: []*schema.Column{TodoListMembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "todo_list_members_todo_lists_members",
+				Columns:    []*schema.Column{TodoListMembersColumns[3]},
+				RefColumns: []*schema.Column{TodoListsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "todo_list_members_users_todo_list_memberships",
+				Columns:    []*schema.Column{TodoListMembersColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "todolistmember_list_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{TodoListMembersColumns[3], TodoListMembersColumns[4]},
+			},
+			{
+				Name:    "todolistmember_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{TodoListMembersColumns[4]},
+			},
+		},
+	}
+	// TodoSummariesColumns holds the columns for the "todo_summaries" table.
+	TodoSummariesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "range_from", Type: field.TypeTime},
+		{Name: "range_to", Type: field.TypeTime},
+		{Name: "source_hash", Type: field.TypeString, Size: 64},
+		{Name: "model", Type: field.TypeString, Size: 100},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "markdown", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "workspace_id", Type: field.TypeInt},
+	}
+	// TodoSummariesTable holds the schema information for the "todo_summaries" table.
+	TodoSummariesTable = &schema.Table{
+		Name:       "todo_summaries",
+		Columns:    TodoSummariesColumns,
+		PrimaryKey: []*schema.Column{TodoSummariesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "todo_summaries_users_todo_summaries",
+				Columns:    []*schema.Column{TodoSummariesColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "todo_summaries_workspaces_todo_summaries",
+				Columns:    []*schema.Column{TodoSummariesColumns[10]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "todosummary_user_id_workspace_id_range_from_range_to",
+				Unique:  true,
+				Columns: []*schema.Column{TodoSummariesColumns[9], TodoSummariesColumns[10], TodoSummariesColumns[1], TodoSummariesColumns[2]},
+			},
+		},
+	}
+	// UsedMfaTokensColumns holds the columns for the "used_mfa_tokens" table.
+	UsedMfaTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// UsedMfaTokensTable holds the schema information for the "used_mfa_tokens" table.
+	UsedMfaTokensTable = &schema.Table{
+		Name:       "used_mfa_tokens",
+		Columns:    UsedMfaTokensColumns,
+		PrimaryKey: []*schema.Column{UsedMfaTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "used_mfa_tokens_users_used_mfa_tokens",
+				Columns:    []*schema.Column{UsedMfaTokensColumns[3]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -63,6 +631,14 @@ var (
 		{Name: "name", Type: field.TypeString},
 		{Name: "email", Type: field.TypeString, Unique: true},
 		{Name: "password", Type: field.TypeString},
+		{Name: "email_verified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "locale", Type: field.TypeString, Size: 10, Default: "ja"},
+		{Name: "time_zone", Type: field.TypeString, Size: 64, Default: "Asia/Tokyo"},
+		{Name: "todo_page_size", Type: field.TypeInt, Default: 20},
+		{Name: "todo_include_done", Type: field.TypeBool, Default: false},
+		{Name: "deletion_scheduled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "role", Type: field.TypeString, Size: 16, Default: "user"},
+		{Name: "disabled_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 	}
 	// UsersTable holds the schema information for the "users" table.
@@ -71,18 +647,241 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// UserIdentitiesColumns holds the columns for the "user_identities" table.
+	UserIdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "provider", Type: field.TypeString, Size: 64},
+		{Name: "subject", Type: field.TypeString, Size: 255},
+		{Name: "email", Type: field.TypeString, Size: 255},
+		{Name: "last_login_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// UserIdentitiesTable holds the schema information for the "user_identities" table.
+	UserIdentitiesTable = &schema.Table{
+		Name:       "user_identities",
+		Columns:    UserIdentitiesColumns,
+		PrimaryKey: []*schema.Column{UserIdentitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_identities_users_identities",
+				Columns:    []*schema.Column{UserIdentitiesColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "useridentity_provider_subject",
+				Unique:  true,
+				Columns: []*schema.Column{UserIdentitiesColumns[1], UserIdentitiesColumns[2]},
+			},
+		},
+	}
+	// WorkspacesColumns holds the columns for the "workspaces" table.
+	WorkspacesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 64},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "personal_owner_id", Type: field.TypeInt, Unique: true, Nullable: true},
+	}
+	// WorkspacesTable holds the schema information for the "workspaces" table.
+	WorkspacesTable = &schema.Table{
+		Name:       "workspaces",
+		Columns:    WorkspacesColumns,
+		PrimaryKey: []*schema.Column{WorkspacesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "workspaces_users_personal_workspace",
+				Columns:    []*schema.Column{WorkspacesColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// WorkspaceInvitesColumns holds the columns for the "workspace_invites" table.
+	WorkspaceInvitesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "email", Type: field.TypeString, Size: 255},
+		{Name: "role", Type: field.TypeString, Size: 16},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "invited_by", Type: field.TypeInt},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "workspace_id", Type: field.TypeInt},
+	}
+	// WorkspaceInvitesTable holds the schema information for the "workspace_invites" table.
+	WorkspaceInvitesTable = &schema.Table{
+		Name:       "workspace_invites",
+		Columns:    WorkspaceInvitesColumns,
+		PrimaryKey: []*schema.Column{WorkspaceInvitesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "workspace_invites_workspaces_invites",
+				Columns:    []*schema.Column{WorkspaceInvitesColumns[8]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "workspaceinvite_workspace_id_email",
+				Unique:  false,
+				Columns: []*schema.Column{WorkspaceInvitesColumns[8], WorkspaceInvitesColumns[1]},
+			},
+		},
+	}
+	// WorkspaceMembersColumns holds the columns for the "workspace_members" table.
+	WorkspaceMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "role", Type: field.TypeString, Size: 16},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "workspace_id", Type: field.TypeInt},
+	}
+	// WorkspaceMembersTable holds the schema information for the "workspace_members" table.
+	WorkspaceMembersTable = &schema.Table{
+		Name:       "workspace_members",
+		Columns:    WorkspaceMembersColumns,
+		PrimaryKey: []*schema.Column{WorkspaceMembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "workspace_members_users_workspace_memberships",
+				Columns:    []*schema.Column{WorkspaceMembersColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "workspace_members_workspaces_members",
+				Columns:    []*schema.Column{WorkspaceMembersColumns[4]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "workspacemember_workspace_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{WorkspaceMembersColumns[4], WorkspaceMembersColumns[3]},
+			},
+			{
+				Name:    "workspacemember_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{WorkspaceMembersColumns[3]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AiUsagesTable,
+		AccountDeletionTokensTable,
+		AdminAuditLogsTable,
+		EmailChangeTokensTable,
+		LoginAttemptsTable,
+		PasswordResetTokensTable,
+		PersonalAccessTokensTable,
+		RecoveryCodesTable,
+		RefreshTokensTable,
+		SessionsTable,
+		TotpCredentialsTable,
 		TodosTable,
+		TodoBreakdownsTable,
+		TodoEmbeddingsTable,
 		TodoFilterHistoriesTable,
+		TodoListsTable,
+		TodoListInvitesTable,
+		TodoListMembersTable,
+		TodoSummariesTable,
+		UsedMfaTokensTable,
 		UsersTable,
+		UserIdentitiesTable,
+		WorkspacesTable,
+		WorkspaceInvitesTable,
+		WorkspaceMembersTable,
 	}
 )
 
 func init() {
-	TodosTable.ForeignKeys[0].RefTable = UsersTable
+	AiUsagesTable.ForeignKeys[0].RefTable = UsersTable
+	AiUsagesTable.Annotation = &entsql.Annotation{
+		Table: "ai_usages",
+	}
+	AccountDeletionTokensTable.ForeignKeys[0].RefTable = UsersTable
+	AccountDeletionTokensTable.Annotation = &entsql.Annotation{
+		Table: "account_deletion_tokens",
+	}
+	AdminAuditLogsTable.Annotation = &entsql.Annotation{
+		Table: "admin_audit_logs",
+	}
+	EmailChangeTokensTable.ForeignKeys[0].RefTable = UsersTable
+	EmailChangeTokensTable.Annotation = &entsql.Annotation{
+		Table: "email_change_tokens",
+	}
+	LoginAttemptsTable.ForeignKeys[0].RefTable = UsersTable
+	LoginAttemptsTable.Annotation = &entsql.Annotation{
+		Table: "login_attempts",
+	}
+	PasswordResetTokensTable.ForeignKeys[0].RefTable = UsersTable
+	PasswordResetTokensTable.Annotation = &entsql.Annotation{
+		Table: "password_reset_tokens",
+	}
+	PersonalAccessTokensTable.ForeignKeys[0].RefTable = UsersTable
+	PersonalAccessTokensTable.Annotation = &entsql.Annotation{
+		Table: "personal_access_tokens",
+	}
+	RecoveryCodesTable.ForeignKeys[0].RefTable = UsersTable
+	RecoveryCodesTable.Annotation = &entsql.Annotation{
+		Table: "recovery_codes",
+	}
+	RefreshTokensTable.ForeignKeys[0].RefTable = SessionsTable
+	RefreshTokensTable.Annotation = &entsql.Annotation{
+		Table: "refresh_tokens",
+	}
+	SessionsTable.ForeignKeys[0].RefTable = UsersTable
+	SessionsTable.Annotation = &entsql.Annotation{
+		Table: "sessions",
+	}
+	TotpCredentialsTable.ForeignKeys[0].RefTable = UsersTable
+	TotpCredentialsTable.Annotation = &entsql.Annotation{
+		Table: "totp_credentials",
+	}
+	TodosTable.ForeignKeys[0].RefTable = TodosTable
+	TodosTable.ForeignKeys[1].RefTable = TodoListsTable
+	TodosTable.ForeignKeys[2].RefTable = UsersTable
+	TodosTable.ForeignKeys[3].RefTable = WorkspacesTable
+	TodoBreakdownsTable.ForeignKeys[0].RefTable = TodosTable
+	TodoBreakdownsTable.ForeignKeys[1].RefTable = UsersTable
+	TodoBreakdownsTable.ForeignKeys[2].RefTable = WorkspacesTable
+	TodoEmbeddingsTable.ForeignKeys[0].RefTable = TodosTable
+	TodoEmbeddingsTable.Annotation = &entsql.Annotation{
+		Table: "todo_embeddings",
+	}
 	TodoFilterHistoriesTable.ForeignKeys[0].RefTable = UsersTable
+	TodoFilterHistoriesTable.ForeignKeys[1].RefTable = WorkspacesTable
 	TodoFilterHistoriesTable.Annotation = &entsql.Annotation{
 		Table: "todo_filter_histories",
 	}
+	TodoListsTable.ForeignKeys[0].RefTable = WorkspacesTable
+	TodoListInvitesTable.ForeignKeys[0].RefTable = TodoListsTable
+	TodoListMembersTable.ForeignKeys[0].RefTable = TodoListsTable
+	TodoListMembersTable.ForeignKeys[1].RefTable = UsersTable
+	TodoSummariesTable.ForeignKeys[0].RefTable = UsersTable
+	TodoSummariesTable.ForeignKeys[1].RefTable = WorkspacesTable
+	TodoSummariesTable.Annotation = &entsql.Annotation{
+		Table: "todo_summaries",
+	}
+	UsedMfaTokensTable.ForeignKeys[0].RefTable = UsersTable
+	UsedMfaTokensTable.Annotation = &entsql.Annotation{
+		Table: "used_mfa_tokens",
+	}
+	UserIdentitiesTable.ForeignKeys[0].RefTable = UsersTable
+	UserIdentitiesTable.Annotation = &entsql.Annotation{
+		Table: "user_identities",
+	}
+	WorkspacesTable.ForeignKeys[0].RefTable = UsersTable
+	WorkspaceInvitesTable.ForeignKeys[0].RefTable = WorkspacesTable
+	WorkspaceMembersTable.ForeignKeys[0].RefTable = UsersTable
+	WorkspaceMembersTable.ForeignKeys[1].RefTable = WorkspacesTable
 }
@@ -6,11 +6,77 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AIUsage is the predicate function for aiusage builders.
+type AIUsage func(*sql.Selector)
+
+// AccountDeletionToken is the predicate function for accountdeletiontoken builders.
+type AccountDeletionToken func(*sql.Selector)
+
+// AdminAuditLog is the predicate function for adminauditlog builders.
+type AdminAuditLog func(*sql.Selector)
+
+// EmailChangeToken is the predicate function for emailchangetoken builders.
+type EmailChangeToken func(*sql.Selector)
+
+// LoginAttempt is the predicate function for loginattempt builders.
+type LoginAttempt func(*sql.Selector)
+
+// PasswordResetToken is the predicate function for passwordresettoken builders.
+type PasswordResetToken func(*sql.Selector)
+
+// PersonalAccessToken is the predicate function for personalaccesstoken builders.
+type PersonalAccessToken func(*sql.Selector)
+
+// RecoveryCode is the predicate function for recoverycode builders.
+type RecoveryCode func(*sql.Selector)
+
+// RefreshToken is the predicate function for refreshtoken builders.
+type RefreshToken func(*sql.Selector)
+
+// Session is the predicate function for session builders.
+type Session func(*sql.Selector)
+
+// TOTPCredential is the predicate function for totpcredential builders.
+type TOTPCredential func(*sql.Selector)
+
 // Todo is the predicate function for todo builders.
 type Todo func(*sql.Selector)
 
+// TodoBreakdown is the predicate function for todobreakdown builders.
+type TodoBreakdown func(*sql.Selector)
+
+// TodoEmbedding is the predicate function for todoembedding builders.
+type TodoEmbedding func(*sql.Selector)
+
 // TodoFilterHistory is the predicate function for todofilterhistory builders.
 type TodoFilterHistory func(*sql.Selector)
 
+// TodoList is the predicate function for todolist builders.
+type TodoList func(*sql.Selector)
+
+// TodoListInvite is the predicate function for todolistinvite builders.
+type TodoListInvite func(*sql.Selector)
+
+// TodoListMember is the predicate function for todolistmember builders.
+type TodoListMember func(*sql.Selector)
+
+// TodoSummary is the predicate function for todosummary builders.
+type TodoSummary func(*sql.Selector)
+
+// UsedMFAToken is the predicate function for usedmfatoken builders.
+type UsedMFAToken func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// UserIdentity is the predicate function for useridentity builders.
+type UserIdentity func(*sql.Selector)
+
+// Workspace is the predicate function for workspace builders.
+type Workspace func(*sql.Selector)
+
+// WorkspaceInvite is the predicate function for workspaceinvite builders.
+type WorkspaceInvite func(*sql.Selector)
+
+// WorkspaceMember is the predicate function for workspacemember builders.
+type WorkspaceMember func(*sql.Selector)
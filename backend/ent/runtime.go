@@ -4,10 +4,32 @@ package ent
 
 import (
 	"time"
+	"todo-app/ent/accountdeletiontoken"
+	"todo-app/ent/adminauditlog"
+	"todo-app/ent/aiusage"
+	"todo-app/ent/emailchangetoken"
+	"todo-app/ent/loginattempt"
+	"todo-app/ent/passwordresettoken"
+	"todo-app/ent/personalaccesstoken"
+	"todo-app/ent/recoverycode"
+	"todo-app/ent/refreshtoken"
 	"todo-app/ent/schema"
+	"todo-app/ent/session"
 	"todo-app/ent/todo"
+	"todo-app/ent/todobreakdown"
+	"todo-app/ent/todoembedding"
 	"todo-app/ent/todofilterhistory"
+	"todo-app/ent/todolist"
+	"todo-app/ent/todolistinvite"
+	"todo-app/ent/todolistmember"
+	"todo-app/ent/todosummary"
+	"todo-app/ent/totpcredential"
+	"todo-app/ent/usedmfatoken"
 	"todo-app/ent/user"
+	"todo-app/ent/useridentity"
+	"todo-app/ent/workspace"
+	"todo-app/ent/workspaceinvite"
+	"todo-app/ent/workspacemember"
 
 	"github.com/google/uuid"
 )
@@ -16,6 +38,350 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	aiusageFields := schema.AIUsage{}.Fields()
+	_ = aiusageFields
+	// aiusageDescModel is the schema descriptor for model field.
+	aiusageDescModel := aiusageFields[2].Descriptor()
+	// aiusage.ModelValidator is a validator for the "model" field. It is called by the builders before save.
+	aiusage.ModelValidator = aiusageDescModel.Validators[0].(func(string) error)
+	// aiusageDescPromptTokens is the schema descriptor for prompt_tokens field.
+	aiusageDescPromptTokens := aiusageFields[3].Descriptor()
+	// aiusage.DefaultPromptTokens holds the default value on creation for the prompt_tokens field.
+	aiusage.DefaultPromptTokens = aiusageDescPromptTokens.Default.(int)
+	// aiusageDescCandidatesTokens is the schema descriptor for candidates_tokens field.
+	aiusageDescCandidatesTokens := aiusageFields[4].Descriptor()
+	// aiusage.DefaultCandidatesTokens holds the default value on creation for the candidates_tokens field.
+	aiusage.DefaultCandidatesTokens = aiusageDescCandidatesTokens.Default.(int)
+	// aiusageDescTotalTokens is the schema descriptor for total_tokens field.
+	aiusageDescTotalTokens := aiusageFields[5].Descriptor()
+	// aiusage.DefaultTotalTokens holds the default value on creation for the total_tokens field.
+	aiusage.DefaultTotalTokens = aiusageDescTotalTokens.Default.(int)
+	// aiusageDescCreatedAt is the schema descriptor for created_at field.
+	aiusageDescCreatedAt := aiusageFields[6].Descriptor()
+	// aiusage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	aiusage.DefaultCreatedAt = aiusageDescCreatedAt.Default.(func() time.Time)
+	// aiusageDescID is the schema descriptor for id field.
+	aiusageDescID := aiusageFields[0].Descriptor()
+	// aiusage.DefaultID holds the default value on creation for the id field.
+	aiusage.DefaultID = aiusageDescID.Default.(func() uuid.UUID)
+	accountdeletiontokenFields := schema.AccountDeletionToken{}.Fields()
+	_ = accountdeletiontokenFields
+	// accountdeletiontokenDescTokenHash is the schema descriptor for token_hash field.
+	accountdeletiontokenDescTokenHash := accountdeletiontokenFields[2].Descriptor()
+	// accountdeletiontoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	accountdeletiontoken.TokenHashValidator = func() func(string) error {
+		validators := accountdeletiontokenDescTokenHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(token_hash string) error {
+			for _, fn := range fns {
+				if err := fn(token_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// accountdeletiontokenDescCreatedAt is the schema descriptor for created_at field.
+	accountdeletiontokenDescCreatedAt := accountdeletiontokenFields[5].Descriptor()
+	// accountdeletiontoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accountdeletiontoken.DefaultCreatedAt = accountdeletiontokenDescCreatedAt.Default.(func() time.Time)
+	// accountdeletiontokenDescID is the schema descriptor for id field.
+	accountdeletiontokenDescID := accountdeletiontokenFields[0].Descriptor()
+	// accountdeletiontoken.DefaultID holds the default value on creation for the id field.
+	accountdeletiontoken.DefaultID = accountdeletiontokenDescID.Default.(func() uuid.UUID)
+	adminauditlogFields := schema.AdminAuditLog{}.Fields()
+	_ = adminauditlogFields
+	// adminauditlogDescAction is the schema descriptor for action field.
+	adminauditlogDescAction := adminauditlogFields[3].Descriptor()
+	// adminauditlog.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	adminauditlog.ActionValidator = adminauditlogDescAction.Validators[0].(func(string) error)
+	// adminauditlogDescIPAddress is the schema descriptor for ip_address field.
+	adminauditlogDescIPAddress := adminauditlogFields[5].Descriptor()
+	// adminauditlog.DefaultIPAddress holds the default value on creation for the ip_address field.
+	adminauditlog.DefaultIPAddress = adminauditlogDescIPAddress.Default.(string)
+	// adminauditlog.IPAddressValidator is a validator for the "ip_address" field. It is called by the builders before save.
+	adminauditlog.IPAddressValidator = adminauditlogDescIPAddress.Validators[0].(func(string) error)
+	// adminauditlogDescCreatedAt is the schema descriptor for created_at field.
+	adminauditlogDescCreatedAt := adminauditlogFields[6].Descriptor()
+	// adminauditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	adminauditlog.DefaultCreatedAt = adminauditlogDescCreatedAt.Default.(func() time.Time)
+	// adminauditlogDescID is the schema descriptor for id field.
+	adminauditlogDescID := adminauditlogFields[0].Descriptor()
+	// adminauditlog.DefaultID holds the default value on creation for the id field.
+	adminauditlog.DefaultID = adminauditlogDescID.Default.(func() uuid.UUID)
+	emailchangetokenFields := schema.EmailChangeToken{}.Fields()
+	_ = emailchangetokenFields
+	// emailchangetokenDescNewEmail is the schema descriptor for new_email field.
+	emailchangetokenDescNewEmail := emailchangetokenFields[2].Descriptor()
+	// emailchangetoken.NewEmailValidator is a validator for the "new_email" field. It is called by the builders before save.
+	emailchangetoken.NewEmailValidator = func() func(string) error {
+		validators := emailchangetokenDescNewEmail.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(new_email string) error {
+			for _, fn := range fns {
+				if err := fn(new_email); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// emailchangetokenDescOldEmail is the schema descriptor for old_email field.
+	emailchangetokenDescOldEmail := emailchangetokenFields[3].Descriptor()
+	// emailchangetoken.DefaultOldEmail holds the default value on creation for the old_email field.
+	emailchangetoken.DefaultOldEmail = emailchangetokenDescOldEmail.Default.(string)
+	// emailchangetoken.OldEmailValidator is a validator for the "old_email" field. It is called by the builders before save.
+	emailchangetoken.OldEmailValidator = emailchangetokenDescOldEmail.Validators[0].(func(string) error)
+	// emailchangetokenDescTokenHash is the schema descriptor for token_hash field.
+	emailchangetokenDescTokenHash := emailchangetokenFields[4].Descriptor()
+	// emailchangetoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	emailchangetoken.TokenHashValidator = func() func(string) error {
+		validators := emailchangetokenDescTokenHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(token_hash string) error {
+			for _, fn := range fns {
+				if err := fn(token_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// emailchangetokenDescCancelTokenHash is the schema descriptor for cancel_token_hash field.
+	emailchangetokenDescCancelTokenHash := emailchangetokenFields[5].Descriptor()
+	// emailchangetoken.CancelTokenHashValidator is a validator for the "cancel_token_hash" field. It is called by the builders before save.
+	emailchangetoken.CancelTokenHashValidator = emailchangetokenDescCancelTokenHash.Validators[0].(func(string) error)
+	// emailchangetokenDescPurpose is the schema descriptor for purpose field.
+	emailchangetokenDescPurpose := emailchangetokenFields[6].Descriptor()
+	// emailchangetoken.DefaultPurpose holds the default value on creation for the purpose field.
+	emailchangetoken.DefaultPurpose = emailchangetokenDescPurpose.Default.(string)
+	// emailchangetoken.PurposeValidator is a validator for the "purpose" field. It is called by the builders before save.
+	emailchangetoken.PurposeValidator = emailchangetokenDescPurpose.Validators[0].(func(string) error)
+	// emailchangetokenDescCreatedAt is the schema descriptor for created_at field.
+	emailchangetokenDescCreatedAt := emailchangetokenFields[10].Descriptor()
+	// emailchangetoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	emailchangetoken.DefaultCreatedAt = emailchangetokenDescCreatedAt.Default.(func() time.Time)
+	// emailchangetokenDescID is the schema descriptor for id field.
+	emailchangetokenDescID := emailchangetokenFields[0].Descriptor()
+	// emailchangetoken.DefaultID holds the default value on creation for the id field.
+	emailchangetoken.DefaultID = emailchangetokenDescID.Default.(func() uuid.UUID)
+	loginattemptFields := schema.LoginAttempt{}.Fields()
+	_ = loginattemptFields
+	// loginattemptDescEmail is the schema descriptor for email field.
+	loginattemptDescEmail := loginattemptFields[0].Descriptor()
+	// loginattempt.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	loginattempt.EmailValidator = loginattemptDescEmail.Validators[0].(func(string) error)
+	// loginattemptDescIPAddress is the schema descriptor for ip_address field.
+	loginattemptDescIPAddress := loginattemptFields[1].Descriptor()
+	// loginattempt.IPAddressValidator is a validator for the "ip_address" field. It is called by the builders before save.
+	loginattempt.IPAddressValidator = loginattemptDescIPAddress.Validators[0].(func(string) error)
+	// loginattemptDescUserAgent is the schema descriptor for user_agent field.
+	loginattemptDescUserAgent := loginattemptFields[2].Descriptor()
+	// loginattempt.DefaultUserAgent holds the default value on creation for the user_agent field.
+	loginattempt.DefaultUserAgent = loginattemptDescUserAgent.Default.(string)
+	// loginattempt.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	loginattempt.UserAgentValidator = loginattemptDescUserAgent.Validators[0].(func(string) error)
+	// loginattemptDescResult is the schema descriptor for result field.
+	loginattemptDescResult := loginattemptFields[4].Descriptor()
+	// loginattempt.ResultValidator is a validator for the "result" field. It is called by the builders before save.
+	loginattempt.ResultValidator = loginattemptDescResult.Validators[0].(func(string) error)
+	// loginattemptDescCreatedAt is the schema descriptor for created_at field.
+	loginattemptDescCreatedAt := loginattemptFields[5].Descriptor()
+	// loginattempt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loginattempt.DefaultCreatedAt = loginattemptDescCreatedAt.Default.(func() time.Time)
+	passwordresettokenFields := schema.PasswordResetToken{}.Fields()
+	_ = passwordresettokenFields
+	// passwordresettokenDescTokenHash is the schema descriptor for token_hash field.
+	passwordresettokenDescTokenHash := passwordresettokenFields[2].Descriptor()
+	// passwordresettoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	passwordresettoken.TokenHashValidator = func() func(string) error {
+		validators := passwordresettokenDescTokenHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(token_hash string) error {
+			for _, fn := range fns {
+				if err := fn(token_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// passwordresettokenDescCreatedAt is the schema descriptor for created_at field.
+	passwordresettokenDescCreatedAt := passwordresettokenFields[5].Descriptor()
+	// passwordresettoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	passwordresettoken.DefaultCreatedAt = passwordresettokenDescCreatedAt.Default.(func() time.Time)
+	// passwordresettokenDescID is the schema descriptor for id field.
+	passwordresettokenDescID := passwordresettokenFields[0].Descriptor()
+	// passwordresettoken.DefaultID holds the default value on creation for the id field.
+	passwordresettoken.DefaultID = passwordresettokenDescID.Default.(func() uuid.UUID)
+	personalaccesstokenFields := schema.PersonalAccessToken{}.Fields()
+	_ = personalaccesstokenFields
+	// personalaccesstokenDescName is the schema descriptor for name field.
+	personalaccesstokenDescName := personalaccesstokenFields[2].Descriptor()
+	// personalaccesstoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	personalaccesstoken.NameValidator = func() func(string) error {
+		validators := personalaccesstokenDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// personalaccesstokenDescTokenHash is the schema descriptor for token_hash field.
+	personalaccesstokenDescTokenHash := personalaccesstokenFields[3].Descriptor()
+	// personalaccesstoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	personalaccesstoken.TokenHashValidator = func() func(string) error {
+		validators := personalaccesstokenDescTokenHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(token_hash string) error {
+			for _, fn := range fns {
+				if err := fn(token_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// personalaccesstokenDescTokenPrefix is the schema descriptor for token_prefix field.
+	personalaccesstokenDescTokenPrefix := personalaccesstokenFields[4].Descriptor()
+	// personalaccesstoken.TokenPrefixValidator is a validator for the "token_prefix" field. It is called by the builders before save.
+	personalaccesstoken.TokenPrefixValidator = personalaccesstokenDescTokenPrefix.Validators[0].(func(string) error)
+	// personalaccesstokenDescCreatedAt is the schema descriptor for created_at field.
+	personalaccesstokenDescCreatedAt := personalaccesstokenFields[9].Descriptor()
+	// personalaccesstoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	personalaccesstoken.DefaultCreatedAt = personalaccesstokenDescCreatedAt.Default.(func() time.Time)
+	// personalaccesstokenDescID is the schema descriptor for id field.
+	personalaccesstokenDescID := personalaccesstokenFields[0].Descriptor()
+	// personalaccesstoken.DefaultID holds the default value on creation for the id field.
+	personalaccesstoken.DefaultID = personalaccesstokenDescID.Default.(func() uuid.UUID)
+	recoverycodeFields := schema.RecoveryCode{}.Fields()
+	_ = recoverycodeFields
+	// recoverycodeDescCodeHash is the schema descriptor for code_hash field.
+	recoverycodeDescCodeHash := recoverycodeFields[1].Descriptor()
+	// recoverycode.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	recoverycode.CodeHashValidator = func() func(string) error {
+		validators := recoverycodeDescCodeHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(code_hash string) error {
+			for _, fn := range fns {
+				if err := fn(code_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// recoverycodeDescCreatedAt is the schema descriptor for created_at field.
+	recoverycodeDescCreatedAt := recoverycodeFields[3].Descriptor()
+	// recoverycode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	recoverycode.DefaultCreatedAt = recoverycodeDescCreatedAt.Default.(func() time.Time)
+	refreshtokenFields := schema.RefreshToken{}.Fields()
+	_ = refreshtokenFields
+	// refreshtokenDescTokenHash is the schema descriptor for token_hash field.
+	refreshtokenDescTokenHash := refreshtokenFields[2].Descriptor()
+	// refreshtoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	refreshtoken.TokenHashValidator = func() func(string) error {
+		validators := refreshtokenDescTokenHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(token_hash string) error {
+			for _, fn := range fns {
+				if err := fn(token_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// refreshtokenDescCreatedAt is the schema descriptor for created_at field.
+	refreshtokenDescCreatedAt := refreshtokenFields[5].Descriptor()
+	// refreshtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	refreshtoken.DefaultCreatedAt = refreshtokenDescCreatedAt.Default.(func() time.Time)
+	// refreshtokenDescID is the schema descriptor for id field.
+	refreshtokenDescID := refreshtokenFields[0].Descriptor()
+	// refreshtoken.DefaultID holds the default value on creation for the id field.
+	refreshtoken.DefaultID = refreshtokenDescID.Default.(func() uuid.UUID)
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescIPAddress is the schema descriptor for ip_address field.
+	sessionDescIPAddress := sessionFields[2].Descriptor()
+	// session.DefaultIPAddress holds the default value on creation for the ip_address field.
+	session.DefaultIPAddress = sessionDescIPAddress.Default.(string)
+	// session.IPAddressValidator is a validator for the "ip_address" field. It is called by the builders before save.
+	session.IPAddressValidator = sessionDescIPAddress.Validators[0].(func(string) error)
+	// sessionDescUserAgent is the schema descriptor for user_agent field.
+	sessionDescUserAgent := sessionFields[3].Descriptor()
+	// session.DefaultUserAgent holds the default value on creation for the user_agent field.
+	session.DefaultUserAgent = sessionDescUserAgent.Default.(string)
+	// session.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	session.UserAgentValidator = sessionDescUserAgent.Validators[0].(func(string) error)
+	// sessionDescLastSeenAt is the schema descriptor for last_seen_at field.
+	sessionDescLastSeenAt := sessionFields[4].Descriptor()
+	// session.DefaultLastSeenAt holds the default value on creation for the last_seen_at field.
+	session.DefaultLastSeenAt = sessionDescLastSeenAt.Default.(func() time.Time)
+	// sessionDescCreatedAt is the schema descriptor for created_at field.
+	sessionDescCreatedAt := sessionFields[8].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the created_at field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
+	// sessionDescID is the schema descriptor for id field.
+	sessionDescID := sessionFields[0].Descriptor()
+	// session.DefaultID holds the default value on creation for the id field.
+	session.DefaultID = sessionDescID.Default.(func() uuid.UUID)
+	totpcredentialFields := schema.TOTPCredential{}.Fields()
+	_ = totpcredentialFields
+	// totpcredentialDescSecret is the schema descriptor for secret field.
+	totpcredentialDescSecret := totpcredentialFields[1].Descriptor()
+	// totpcredential.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	totpcredential.SecretValidator = func() func(string) error {
+		validators := totpcredentialDescSecret.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(secret string) error {
+			for _, fn := range fns {
+				if err := fn(secret); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// totpcredentialDescLastUsedStep is the schema descriptor for last_used_step field.
+	totpcredentialDescLastUsedStep := totpcredentialFields[3].Descriptor()
+	// totpcredential.DefaultLastUsedStep holds the default value on creation for the last_used_step field.
+	totpcredential.DefaultLastUsedStep = totpcredentialDescLastUsedStep.Default.(int64)
+	// totpcredentialDescCreatedAt is the schema descriptor for created_at field.
+	totpcredentialDescCreatedAt := totpcredentialFields[4].Descriptor()
+	// totpcredential.DefaultCreatedAt holds the default value on creation for the created_at field.
+	totpcredential.DefaultCreatedAt = totpcredentialDescCreatedAt.Default.(func() time.Time)
 	todoFields := schema.Todo{}.Fields()
 	_ = todoFields
 	// todoDescTitle is the schema descriptor for title field.
@@ -70,24 +436,198 @@ func init() {
 	todoDescID := todoFields[0].Descriptor()
 	// todo.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	todo.IDValidator = todoDescID.Validators[0].(func(int) error)
+	todobreakdownFields := schema.TodoBreakdown{}.Fields()
+	_ = todobreakdownFields
+	// todobreakdownDescModel is the schema descriptor for model field.
+	todobreakdownDescModel := todobreakdownFields[4].Descriptor()
+	// todobreakdown.ModelValidator is a validator for the "model" field. It is called by the builders before save.
+	todobreakdown.ModelValidator = todobreakdownDescModel.Validators[0].(func(string) error)
+	// todobreakdownDescCreatedAt is the schema descriptor for created_at field.
+	todobreakdownDescCreatedAt := todobreakdownFields[8].Descriptor()
+	// todobreakdown.DefaultCreatedAt holds the default value on creation for the created_at field.
+	todobreakdown.DefaultCreatedAt = todobreakdownDescCreatedAt.Default.(func() time.Time)
+	// todobreakdownDescID is the schema descriptor for id field.
+	todobreakdownDescID := todobreakdownFields[0].Descriptor()
+	// todobreakdown.DefaultID holds the default value on creation for the id field.
+	todobreakdown.DefaultID = todobreakdownDescID.Default.(func() uuid.UUID)
+	todoembeddingFields := schema.TodoEmbedding{}.Fields()
+	_ = todoembeddingFields
+	// todoembeddingDescModel is the schema descriptor for model field.
+	todoembeddingDescModel := todoembeddingFields[2].Descriptor()
+	// todoembedding.ModelValidator is a validator for the "model" field. It is called by the builders before save.
+	todoembedding.ModelValidator = todoembeddingDescModel.Validators[0].(func(string) error)
+	// todoembeddingDescContentHash is the schema descriptor for content_hash field.
+	todoembeddingDescContentHash := todoembeddingFields[3].Descriptor()
+	// todoembedding.ContentHashValidator is a validator for the "content_hash" field. It is called by the builders before save.
+	todoembedding.ContentHashValidator = todoembeddingDescContentHash.Validators[0].(func(string) error)
+	// todoembeddingDescCreatedAt is the schema descriptor for created_at field.
+	todoembeddingDescCreatedAt := todoembeddingFields[5].Descriptor()
+	// todoembedding.DefaultCreatedAt holds the default value on creation for the created_at field.
+	todoembedding.DefaultCreatedAt = todoembeddingDescCreatedAt.Default.(func() time.Time)
+	// todoembeddingDescUpdatedAt is the schema descriptor for updated_at field.
+	todoembeddingDescUpdatedAt := todoembeddingFields[6].Descriptor()
+	// todoembedding.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	todoembedding.DefaultUpdatedAt = todoembeddingDescUpdatedAt.Default.(func() time.Time)
+	// todoembedding.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	todoembedding.UpdateDefaultUpdatedAt = todoembeddingDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// todoembeddingDescID is the schema descriptor for id field.
+	todoembeddingDescID := todoembeddingFields[0].Descriptor()
+	// todoembedding.DefaultID holds the default value on creation for the id field.
+	todoembedding.DefaultID = todoembeddingDescID.Default.(func() uuid.UUID)
 	todofilterhistoryFields := schema.TodoFilterHistory{}.Fields()
 	_ = todofilterhistoryFields
 	// todofilterhistoryDescQuery is the schema descriptor for query field.
-	todofilterhistoryDescQuery := todofilterhistoryFields[2].Descriptor()
+	todofilterhistoryDescQuery := todofilterhistoryFields[3].Descriptor()
 	// todofilterhistory.QueryValidator is a validator for the "query" field. It is called by the builders before save.
 	todofilterhistory.QueryValidator = todofilterhistoryDescQuery.Validators[0].(func(string) error)
+	// todofilterhistoryDescNormalizedQuery is the schema descriptor for normalized_query field.
+	todofilterhistoryDescNormalizedQuery := todofilterhistoryFields[4].Descriptor()
+	// todofilterhistory.NormalizedQueryValidator is a validator for the "normalized_query" field. It is called by the builders before save.
+	todofilterhistory.NormalizedQueryValidator = todofilterhistoryDescNormalizedQuery.Validators[0].(func(string) error)
+	// todofilterhistoryDescDateBucket is the schema descriptor for date_bucket field.
+	todofilterhistoryDescDateBucket := todofilterhistoryFields[5].Descriptor()
+	// todofilterhistory.DateBucketValidator is a validator for the "date_bucket" field. It is called by the builders before save.
+	todofilterhistory.DateBucketValidator = todofilterhistoryDescDateBucket.Validators[0].(func(string) error)
+	// todofilterhistoryDescPromptVersion is the schema descriptor for prompt_version field.
+	todofilterhistoryDescPromptVersion := todofilterhistoryFields[6].Descriptor()
+	// todofilterhistory.PromptVersionValidator is a validator for the "prompt_version" field. It is called by the builders before save.
+	todofilterhistory.PromptVersionValidator = todofilterhistoryDescPromptVersion.Validators[0].(func(string) error)
 	// todofilterhistoryDescFunctionName is the schema descriptor for function_name field.
-	todofilterhistoryDescFunctionName := todofilterhistoryFields[3].Descriptor()
+	todofilterhistoryDescFunctionName := todofilterhistoryFields[7].Descriptor()
 	// todofilterhistory.FunctionNameValidator is a validator for the "function_name" field. It is called by the builders before save.
 	todofilterhistory.FunctionNameValidator = todofilterhistoryDescFunctionName.Validators[0].(func(string) error)
 	// todofilterhistoryDescCreatedAt is the schema descriptor for created_at field.
-	todofilterhistoryDescCreatedAt := todofilterhistoryFields[6].Descriptor()
+	todofilterhistoryDescCreatedAt := todofilterhistoryFields[10].Descriptor()
 	// todofilterhistory.DefaultCreatedAt holds the default value on creation for the created_at field.
 	todofilterhistory.DefaultCreatedAt = todofilterhistoryDescCreatedAt.Default.(func() time.Time)
 	// todofilterhistoryDescID is the schema descriptor for id field.
 	todofilterhistoryDescID := todofilterhistoryFields[0].Descriptor()
 	// todofilterhistory.DefaultID holds the default value on creation for the id field.
 	todofilterhistory.DefaultID = todofilterhistoryDescID.Default.(func() uuid.UUID)
+	todolistFields := schema.TodoList{}.Fields()
+	_ = todolistFields
+	// todolistDescName is the schema descriptor for name field.
+	todolistDescName := todolistFields[1].Descriptor()
+	// todolist.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	todolist.NameValidator = func() func(string) error {
+		validators := todolistDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// todolistDescCreatedAt is the schema descriptor for created_at field.
+	todolistDescCreatedAt := todolistFields[3].Descriptor()
+	// todolist.DefaultCreatedAt holds the default value on creation for the created_at field.
+	todolist.DefaultCreatedAt = todolistDescCreatedAt.Default.(func() time.Time)
+	// todolistDescUpdatedAt is the schema descriptor for updated_at field.
+	todolistDescUpdatedAt := todolistFields[4].Descriptor()
+	// todolist.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	todolist.DefaultUpdatedAt = todolistDescUpdatedAt.Default.(func() time.Time)
+	// todolist.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	todolist.UpdateDefaultUpdatedAt = todolistDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// todolistDescID is the schema descriptor for id field.
+	todolistDescID := todolistFields[0].Descriptor()
+	// todolist.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	todolist.IDValidator = todolistDescID.Validators[0].(func(int) error)
+	todolistinviteFields := schema.TodoListInvite{}.Fields()
+	_ = todolistinviteFields
+	// todolistinviteDescEmail is the schema descriptor for email field.
+	todolistinviteDescEmail := todolistinviteFields[2].Descriptor()
+	// todolistinvite.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	todolistinvite.EmailValidator = func() func(string) error {
+		validators := todolistinviteDescEmail.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(email string) error {
+			for _, fn := range fns {
+				if err := fn(email); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// todolistinviteDescRole is the schema descriptor for role field.
+	todolistinviteDescRole := todolistinviteFields[3].Descriptor()
+	// todolistinvite.RoleValidator is a validator for the "role" field. It is called by the builders before save.
+	todolistinvite.RoleValidator = todolistinviteDescRole.Validators[0].(func(string) error)
+	// todolistinviteDescTokenHash is the schema descriptor for token_hash field.
+	todolistinviteDescTokenHash := todolistinviteFields[4].Descriptor()
+	// todolistinvite.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	todolistinvite.TokenHashValidator = func() func(string) error {
+		validators := todolistinviteDescTokenHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(token_hash string) error {
+			for _, fn := range fns {
+				if err := fn(token_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// todolistinviteDescCreatedAt is the schema descriptor for created_at field.
+	todolistinviteDescCreatedAt := todolistinviteFields[8].Descriptor()
+	// todolistinvite.DefaultCreatedAt holds the default value on creation for the created_at field.
+	todolistinvite.DefaultCreatedAt = todolistinviteDescCreatedAt.Default.(func() time.Time)
+	// todolistinviteDescID is the schema descriptor for id field.
+	todolistinviteDescID := todolistinviteFields[0].Descriptor()
+	// todolistinvite.DefaultID holds the default value on creation for the id field.
+	todolistinvite.DefaultID = todolistinviteDescID.Default.(func() uuid.UUID)
+	todolistmemberFields := schema.TodoListMember{}.Fields()
+	_ = todolistmemberFields
+	// todolistmemberDescRole is the schema descriptor for role field.
+	todolistmemberDescRole := todolistmemberFields[2].Descriptor()
+	// todolistmember.RoleValidator is a validator for the "role" field. It is called by the builders before save.
+	todolistmember.RoleValidator = todolistmemberDescRole.Validators[0].(func(string) error)
+	// todolistmemberDescCreatedAt is the schema descriptor for created_at field.
+	todolistmemberDescCreatedAt := todolistmemberFields[3].Descriptor()
+	// todolistmember.DefaultCreatedAt holds the default value on creation for the created_at field.
+	todolistmember.DefaultCreatedAt = todolistmemberDescCreatedAt.Default.(func() time.Time)
+	todosummaryFields := schema.TodoSummary{}.Fields()
+	_ = todosummaryFields
+	// todosummaryDescSourceHash is the schema descriptor for source_hash field.
+	todosummaryDescSourceHash := todosummaryFields[5].Descriptor()
+	// todosummary.SourceHashValidator is a validator for the "source_hash" field. It is called by the builders before save.
+	todosummary.SourceHashValidator = todosummaryDescSourceHash.Validators[0].(func(string) error)
+	// todosummaryDescModel is the schema descriptor for model field.
+	todosummaryDescModel := todosummaryFields[6].Descriptor()
+	// todosummary.ModelValidator is a validator for the "model" field. It is called by the builders before save.
+	todosummary.ModelValidator = todosummaryDescModel.Validators[0].(func(string) error)
+	// todosummaryDescCreatedAt is the schema descriptor for created_at field.
+	todosummaryDescCreatedAt := todosummaryFields[9].Descriptor()
+	// todosummary.DefaultCreatedAt holds the default value on creation for the created_at field.
+	todosummary.DefaultCreatedAt = todosummaryDescCreatedAt.Default.(func() time.Time)
+	// todosummaryDescUpdatedAt is the schema descriptor for updated_at field.
+	todosummaryDescUpdatedAt := todosummaryFields[10].Descriptor()
+	// todosummary.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	todosummary.DefaultUpdatedAt = todosummaryDescUpdatedAt.Default.(func() time.Time)
+	// todosummary.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	todosummary.UpdateDefaultUpdatedAt = todosummaryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// todosummaryDescID is the schema descriptor for id field.
+	todosummaryDescID := todosummaryFields[0].Descriptor()
+	// todosummary.DefaultID holds the default value on creation for the id field.
+	todosummary.DefaultID = todosummaryDescID.Default.(func() uuid.UUID)
+	usedmfatokenFields := schema.UsedMFAToken{}.Fields()
+	_ = usedmfatokenFields
+	// usedmfatokenDescCreatedAt is the schema descriptor for created_at field.
+	usedmfatokenDescCreatedAt := usedmfatokenFields[3].Descriptor()
+	// usedmfatoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usedmfatoken.DefaultCreatedAt = usedmfatokenDescCreatedAt.Default.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescName is the schema descriptor for name field.
@@ -102,8 +642,180 @@ func init() {
 	userDescPassword := userFields[2].Descriptor()
 	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
 	user.PasswordValidator = userDescPassword.Validators[0].(func(string) error)
+	// userDescLocale is the schema descriptor for locale field.
+	userDescLocale := userFields[4].Descriptor()
+	// user.DefaultLocale holds the default value on creation for the locale field.
+	user.DefaultLocale = userDescLocale.Default.(string)
+	// user.LocaleValidator is a validator for the "locale" field. It is called by the builders before save.
+	user.LocaleValidator = userDescLocale.Validators[0].(func(string) error)
+	// userDescTimeZone is the schema descriptor for time_zone field.
+	userDescTimeZone := userFields[5].Descriptor()
+	// user.DefaultTimeZone holds the default value on creation for the time_zone field.
+	user.DefaultTimeZone = userDescTimeZone.Default.(string)
+	// user.TimeZoneValidator is a validator for the "time_zone" field. It is called by the builders before save.
+	user.TimeZoneValidator = userDescTimeZone.Validators[0].(func(string) error)
+	// userDescTodoPageSize is the schema descriptor for todo_page_size field.
+	userDescTodoPageSize := userFields[6].Descriptor()
+	// user.DefaultTodoPageSize holds the default value on creation for the todo_page_size field.
+	user.DefaultTodoPageSize = userDescTodoPageSize.Default.(int)
+	// user.TodoPageSizeValidator is a validator for the "todo_page_size" field. It is called by the builders before save.
+	user.TodoPageSizeValidator = userDescTodoPageSize.Validators[0].(func(int) error)
+	// userDescTodoIncludeDone is the schema descriptor for todo_include_done field.
+	userDescTodoIncludeDone := userFields[7].Descriptor()
+	// user.DefaultTodoIncludeDone holds the default value on creation for the todo_include_done field.
+	user.DefaultTodoIncludeDone = userDescTodoIncludeDone.Default.(bool)
+	// userDescRole is the schema descriptor for role field.
+	userDescRole := userFields[9].Descriptor()
+	// user.DefaultRole holds the default value on creation for the role field.
+	user.DefaultRole = userDescRole.Default.(string)
+	// user.RoleValidator is a validator for the "role" field. It is called by the builders before save.
+	user.RoleValidator = userDescRole.Validators[0].(func(string) error)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[3].Descriptor()
+	userDescCreatedAt := userFields[11].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	useridentityFields := schema.UserIdentity{}.Fields()
+	_ = useridentityFields
+	// useridentityDescProvider is the schema descriptor for provider field.
+	useridentityDescProvider := useridentityFields[1].Descriptor()
+	// useridentity.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	useridentity.ProviderValidator = func() func(string) error {
+		validators := useridentityDescProvider.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(provider string) error {
+			for _, fn := range fns {
+				if err := fn(provider); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// useridentityDescSubject is the schema descriptor for subject field.
+	useridentityDescSubject := useridentityFields[2].Descriptor()
+	// useridentity.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	useridentity.SubjectValidator = func() func(string) error {
+		validators := useridentityDescSubject.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(subject string) error {
+			for _, fn := range fns {
+				if err := fn(subject); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// useridentityDescEmail is the schema descriptor for email field.
+	useridentityDescEmail := useridentityFields[3].Descriptor()
+	// useridentity.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	useridentity.EmailValidator = useridentityDescEmail.Validators[0].(func(string) error)
+	// useridentityDescLastLoginAt is the schema descriptor for last_login_at field.
+	useridentityDescLastLoginAt := useridentityFields[4].Descriptor()
+	// useridentity.DefaultLastLoginAt holds the default value on creation for the last_login_at field.
+	useridentity.DefaultLastLoginAt = useridentityDescLastLoginAt.Default.(func() time.Time)
+	// useridentityDescCreatedAt is the schema descriptor for created_at field.
+	useridentityDescCreatedAt := useridentityFields[5].Descriptor()
+	// useridentity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	useridentity.DefaultCreatedAt = useridentityDescCreatedAt.Default.(func() time.Time)
+	workspaceFields := schema.Workspace{}.Fields()
+	_ = workspaceFields
+	// workspaceDescName is the schema descriptor for name field.
+	workspaceDescName := workspaceFields[1].Descriptor()
+	// workspace.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	workspace.NameValidator = func() func(string) error {
+		validators := workspaceDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// workspaceDescCreatedAt is the schema descriptor for created_at field.
+	workspaceDescCreatedAt := workspaceFields[3].Descriptor()
+	// workspace.DefaultCreatedAt holds the default value on creation for the created_at field.
+	workspace.DefaultCreatedAt = workspaceDescCreatedAt.Default.(func() time.Time)
+	// workspaceDescUpdatedAt is the schema descriptor for updated_at field.
+	workspaceDescUpdatedAt := workspaceFields[4].Descriptor()
+	// workspace.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	workspace.DefaultUpdatedAt = workspaceDescUpdatedAt.Default.(func() time.Time)
+	// workspace.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	workspace.UpdateDefaultUpdatedAt = workspaceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// workspaceDescID is the schema descriptor for id field.
+	workspaceDescID := workspaceFields[0].Descriptor()
+	// workspace.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	workspace.IDValidator = workspaceDescID.Validators[0].(func(int) error)
+	workspaceinviteFields := schema.WorkspaceInvite{}.Fields()
+	_ = workspaceinviteFields
+	// workspaceinviteDescEmail is the schema descriptor for email field.
+	workspaceinviteDescEmail := workspaceinviteFields[2].Descriptor()
+	// workspaceinvite.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	workspaceinvite.EmailValidator = func() func(string) error {
+		validators := workspaceinviteDescEmail.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(email string) error {
+			for _, fn := range fns {
+				if err := fn(email); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// workspaceinviteDescRole is the schema descriptor for role field.
+	workspaceinviteDescRole := workspaceinviteFields[3].Descriptor()
+	// workspaceinvite.RoleValidator is a validator for the "role" field. It is called by the builders before save.
+	workspaceinvite.RoleValidator = workspaceinviteDescRole.Validators[0].(func(string) error)
+	// workspaceinviteDescTokenHash is the schema descriptor for token_hash field.
+	workspaceinviteDescTokenHash := workspaceinviteFields[4].Descriptor()
+	// workspaceinvite.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	workspaceinvite.TokenHashValidator = func() func(string) error {
+		validators := workspaceinviteDescTokenHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(token_hash string) error {
+			for _, fn := range fns {
+				if err := fn(token_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// workspaceinviteDescCreatedAt is the schema descriptor for created_at field.
+	workspaceinviteDescCreatedAt := workspaceinviteFields[8].Descriptor()
+	// workspaceinvite.DefaultCreatedAt holds the default value on creation for the created_at field.
+	workspaceinvite.DefaultCreatedAt = workspaceinviteDescCreatedAt.Default.(func() time.Time)
+	// workspaceinviteDescID is the schema descriptor for id field.
+	workspaceinviteDescID := workspaceinviteFields[0].Descriptor()
+	// workspaceinvite.DefaultID holds the default value on creation for the id field.
+	workspaceinvite.DefaultID = workspaceinviteDescID.Default.(func() uuid.UUID)
+	workspacememberFields := schema.WorkspaceMember{}.Fields()
+	_ = workspacememberFields
+	// workspacememberDescRole is the schema descriptor for role field.
+	workspacememberDescRole := workspacememberFields[2].Descriptor()
+	// workspacemember.RoleValidator is a validator for the "role" field. It is called by the builders before save.
+	workspacemember.RoleValidator = workspacememberDescRole.Validators[0].(func(string) error)
+	// workspacememberDescCreatedAt is the schema descriptor for created_at field.
+	workspacememberDescCreatedAt := workspacememberFields[3].Descriptor()
+	// workspacemember.DefaultCreatedAt holds the default value on creation for the created_at field.
+	workspacemember.DefaultCreatedAt = workspacememberDescCreatedAt.Default.(func() time.Time)
 }
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AdminAuditLog holds the schema definition for the AdminAuditLog entity.
+// 管理者の操作の監査記録。ユーザーを削除しても記録が残るよう、ユーザーへの外部キーは設定しない。
+type AdminAuditLog struct {
+	ent.Schema
+}
+
+// Annotations of the AdminAuditLog.
+func (AdminAuditLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "admin_audit_logs"},
+	}
+}
+
+// Fields of the AdminAuditLog.
+func (AdminAuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(func() uuid.UUID { return uuid.Must(uuid.NewV7()) }),
+		// 操作した管理者のユーザー ID
+		field.Int("actor_id"),
+		// 操作の対象のユーザー ID
+		field.Int("target_user_id").Optional().Nillable(),
+		// user.disable / user.enable / user.password_reset / impersonation.start / impersonation.request
+		field.String("action").MaxLen(64),
+		// 操作の詳細 (なりすまし中のリクエストのメソッドやパスなど)
+		field.JSON("detail", map[string]interface{}{}).Optional(),
+		field.String("ip_address").MaxLen(64).Default(""),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (AdminAuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("actor_id", "created_at"),
+		index.Fields("target_user_id", "created_at"),
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AIUsage holds the schema definition for the AIUsage entity.
+// Gemini API の呼び出し1回ごとのトークン使用量を記録する。
+type AIUsage struct {
+	ent.Schema
+}
+
+// Annotations of the AIUsage.
+func (AIUsage) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "ai_usages"},
+	}
+}
+
+// Fields of the AIUsage.
+func (AIUsage) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(func() uuid.UUID { return uuid.Must(uuid.NewV7()) }),
+		field.Int("user_id"),
+		field.String("model").MaxLen(100),
+		field.Int("prompt_tokens").Default(0),
+		field.Int("candidates_tokens").Default(0),
+		field.Int("total_tokens").Default(0),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the AIUsage.
+func (AIUsage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("ai_usages").Unique().Field("user_id").Required(),
+	}
+}
+
+// Indexes of the AIUsage.
+func (AIUsage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+	}
+}
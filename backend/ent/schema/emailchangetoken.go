@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// EmailChangeToken holds the schema definition for the EmailChangeToken entity.
+// メールアドレスの変更を確認するために、新しいメールアドレスに送信する 1 回だけ使用できるトークン。
+// パスワードを持たないユーザーが変更を申請した場合は、先に変更前のメールアドレスに本人確認のトークンを送信する。
+type EmailChangeToken struct {
+	ent.Schema
+}
+
+// Annotations of the EmailChangeToken.
+func (EmailChangeToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "email_change_tokens"},
+	}
+}
+
+// Fields of the EmailChangeToken.
+func (EmailChangeToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(func() uuid.UUID { return uuid.Must(uuid.NewV7()) }),
+		field.Int("user_id"),
+		// 確認後に設定する新しいメールアドレス
+		field.String("new_email").MaxLen(255).NotEmpty(),
+		// 変更を申請した時点のメールアドレス。変更前のメールアドレスから取り消した場合に戻す
+		field.String("old_email").MaxLen(255).Default(""),
+		// トークン自体は保存せず、SHA-256 のハッシュ (16 進数) を保存する
+		field.String("token_hash").MaxLen(64).Unique().NotEmpty(),
+		// 変更前のメールアドレスに送信する、変更を取り消すためのトークンのハッシュ
+		field.String("cancel_token_hash").MaxLen(64).Unique().Optional().Nillable(),
+		// confirm: 新しいメールアドレスに送信する変更の確認、reauthenticate: 変更前のメールアドレスに送信する本人確認
+		field.String("purpose").MaxLen(32).Default("confirm"),
+		field.Time("expires_at"),
+		// メールアドレスの変更に使用した、または新しいトークンの発行で無効にした日時
+		field.Time("used_at").Optional().Nillable(),
+		// 変更前のメールアドレスに送信したリンクから、変更を取り消した日時
+		field.Time("cancelled_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the EmailChangeToken.
+func (EmailChangeToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("email_change_tokens").Unique().Field("user_id").Required(),
+	}
+}
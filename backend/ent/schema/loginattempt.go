@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LoginAttempt holds the schema definition for the LoginAttempt entity.
+// ログインの試行の監査記録。失敗の回数からログインの試行を制限する。
+type LoginAttempt struct {
+	ent.Schema
+}
+
+// Annotations of the LoginAttempt.
+func (LoginAttempt) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "login_attempts"},
+	}
+}
+
+// Fields of the LoginAttempt.
+func (LoginAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		// 入力されたメールアドレス (小文字に正規化)。未登録のメールアドレスでも記録する
+		field.String("email").MaxLen(255),
+		field.String("ip_address").MaxLen(64),
+		field.String("user_agent").MaxLen(512).Default(""),
+		// 該当するユーザーがいない場合は nil
+		field.Int("user_id").Optional().Nillable(),
+		// succeeded / invalid_credentials / invalid_mfa_code / mfa_required / throttled / account_disabled / pending
+		field.String("result").MaxLen(32),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the LoginAttempt.
+func (LoginAttempt) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("login_attempts").Unique().Field("user_id"),
+	}
+}
+
+// Indexes of the LoginAttempt.
+func (LoginAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("email", "created_at"),
+		index.Fields("ip_address", "created_at"),
+	}
+}
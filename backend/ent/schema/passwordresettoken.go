@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PasswordResetToken holds the schema definition for the PasswordResetToken entity.
+// パスワードを忘れた場合にメールで送信する、1 回だけ使用できるトークン。
+type PasswordResetToken struct {
+	ent.Schema
+}
+
+// Annotations of the PasswordResetToken.
+func (PasswordResetToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "password_reset_tokens"},
+	}
+}
+
+// Fields of the PasswordResetToken.
+func (PasswordResetToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(func() uuid.UUID { return uuid.Must(uuid.NewV7()) }),
+		field.Int("user_id"),
+		// トークン自体は保存せず、SHA-256 のハッシュ (16 進数) を保存する
+		field.String("token_hash").MaxLen(64).Unique().NotEmpty(),
+		field.Time("expires_at"),
+		// パスワードの再設定に使用した、または新しいトークンの発行で無効にした日時
+		field.Time("used_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the PasswordResetToken.
+func (PasswordResetToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("password_reset_tokens").Unique().Field("user_id").Required(),
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PersonalAccessToken holds the schema definition for the PersonalAccessToken entity.
+// スクリプトなどから Authorization: Bearer で API を呼び出すための、ユーザーが発行するトークン。
+type PersonalAccessToken struct {
+	ent.Schema
+}
+
+// Annotations of the PersonalAccessToken.
+func (PersonalAccessToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "personal_access_tokens"},
+	}
+}
+
+// Fields of the PersonalAccessToken.
+func (PersonalAccessToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(func() uuid.UUID { return uuid.Must(uuid.NewV7()) }),
+		field.Int("user_id"),
+		field.String("name").MaxLen(100).NotEmpty(),
+		// トークン自体は保存せず、SHA-256 のハッシュ (16 進数) を保存する
+		field.String("token_hash").MaxLen(64).Unique().NotEmpty(),
+		// 一覧でどのトークンか見分けられるよう、トークンの先頭部分だけを保存する
+		field.String("token_prefix").MaxLen(16),
+		// 許可する操作 (read / write / ai)
+		field.Strings("scopes"),
+		// 未設定の場合は無期限
+		field.Time("expires_at").Optional().Nillable(),
+		field.Time("last_used_at").Optional().Nillable(),
+		// 失効させた日時。設定されているトークンは受け付けない
+		field.Time("revoked_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the PersonalAccessToken.
+func (PersonalAccessToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("personal_access_tokens").Unique().Field("user_id").Required(),
+	}
+}
+
+// Indexes of the PersonalAccessToken.
+func (PersonalAccessToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+	}
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RecoveryCode holds the schema definition for the RecoveryCode entity.
+// 認証アプリを使えなくなった場合に、TOTP のコードの代わりに 1 回だけ使用できるコード。
+type RecoveryCode struct {
+	ent.Schema
+}
+
+// Annotations of the RecoveryCode.
+func (RecoveryCode) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "recovery_codes"},
+	}
+}
+
+// Fields of the RecoveryCode.
+func (RecoveryCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		// コード自体は保存せず、SHA-256 のハッシュ (16 進数) を保存する
+		field.String("code_hash").MaxLen(64).NotEmpty(),
+		field.Time("used_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the RecoveryCode.
+func (RecoveryCode) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("recovery_codes").Unique().Field("user_id").Required(),
+	}
+}
+
+// Indexes of the RecoveryCode.
+func (RecoveryCode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "code_hash").Unique(),
+	}
+}
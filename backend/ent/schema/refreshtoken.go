@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// RefreshToken holds the schema definition for the RefreshToken entity.
+// アクセストークンを再発行するためのトークン。使用するたびに新しいトークンに置き換える。
+type RefreshToken struct {
+	ent.Schema
+}
+
+// Annotations of the RefreshToken.
+func (RefreshToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "refresh_tokens"},
+	}
+}
+
+// Fields of the RefreshToken.
+func (RefreshToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(func() uuid.UUID { return uuid.Must(uuid.NewV7()) }),
+		field.UUID("session_id", uuid.UUID{}),
+		// トークン自体は保存せず、SHA-256 のハッシュ (16 進数) を保存する
+		field.String("token_hash").MaxLen(64).Unique().NotEmpty(),
+		field.Time("expires_at"),
+		// 新しいトークンに置き換えた日時。設定済みのトークンが再び使われた場合は漏洩とみなす
+		field.Time("used_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the RefreshToken.
+func (RefreshToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("session", Session.Type).Ref("refresh_tokens").Unique().Field("session_id").Required(),
+	}
+}
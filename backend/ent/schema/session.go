@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Session holds the schema definition for the Session entity.
+// ログインごとに作成し、id を JWT の jti として発行する。
+type Session struct {
+	ent.Schema
+}
+
+// Annotations of the Session.
+func (Session) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sessions"},
+	}
+}
+
+// Fields of the Session.
+func (Session) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(func() uuid.UUID { return uuid.Must(uuid.NewV7()) }),
+		field.Int("user_id"),
+		// 最後にアクセスした端末の IP アドレスと User-Agent
+		field.String("ip_address").MaxLen(64).Default(""),
+		field.String("user_agent").MaxLen(512).Default(""),
+		field.Time("last_seen_at").Default(time.Now),
+		// リフレッシュトークンを更新しても、この日時を過ぎたら再ログインが必要
+		field.Time("expires_at"),
+		// ログアウトまたは失効させた日時。設定されているセッションのトークンは受け付けない
+		field.Time("revoked_at").Optional().Nillable(),
+		// 管理者がなりすましのために作成したセッションの場合は、その管理者のユーザー ID
+		field.Int("impersonator_id").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Session.
+func (Session) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("sessions").Unique().Field("user_id").Required(),
+		edge.To("refresh_tokens", RefreshToken.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Session.
+func (Session) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "last_seen_at"),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -22,7 +23,12 @@ func (Todo) Fields() []ent.Field {
 		field.Time("done_at").Optional().Nillable(),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
-		field.Int("user_id"),
+		// 作成したユーザー ID。共有のリストやチームのワークスペースの Todo は、作成したユーザーが退会しても残すため未設定になる
+		field.Int("user_id").Optional(),
+		field.Int("workspace_id").Immutable(),
+		field.Int("parent_id").Optional().Nillable(),
+		// 共有のリストに属する場合のリスト ID。未設定の場合は user_id のユーザーだけの Todo
+		field.Int("list_id").Optional().Nillable(),
 	}
 }
 
@@ -32,7 +38,26 @@ func (Todo) Edges() []ent.Edge {
 		edge.From("user", User.Type).
 			Ref("todos").
 			Unique().
-			Field("user_id").
-			Required(),
+			Field("user_id"),
+		edge.From("workspace", Workspace.Type).
+			Ref("todos").
+			Unique().
+			Field("workspace_id").
+			Required().
+			Immutable(),
+		edge.From("list", TodoList.Type).
+			Ref("todos").
+			Unique().
+			Field("list_id"),
+		edge.To("children", Todo.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			From("parent").
+			Unique().
+			Field("parent_id"),
+		edge.To("breakdowns", TodoBreakdown.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("embedding", TodoEmbedding.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TodoBreakdown holds the schema definition for the TodoBreakdown entity.
+// AI による ToDo の分解結果を監査用に記録する。
+type TodoBreakdown struct {
+	ent.Schema
+}
+
+// Fields of the TodoBreakdown.
+func (TodoBreakdown) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(func() uuid.UUID { return uuid.Must(uuid.NewV7()) }),
+		field.Int("user_id"),
+		field.Int("workspace_id").Immutable(),
+		field.Int("todo_id"),
+		field.String("model").MaxLen(100),
+		field.JSON("steps", []map[string]string{}),
+		field.JSON("accepted_step_indexes", []int{}).Optional(),
+		field.Time("accepted_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the TodoBreakdown.
+func (TodoBreakdown) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("todo_breakdowns").Unique().Field("user_id").Required(),
+		edge.From("workspace", Workspace.Type).Ref("todo_breakdowns").Unique().Field("workspace_id").Required().Immutable(),
+		edge.From("todo", Todo.Type).Ref("breakdowns").Unique().Field("todo_id").Required(),
+	}
+}
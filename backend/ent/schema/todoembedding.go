@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TodoEmbedding holds the schema definition for the TodoEmbedding entity.
+// 意味検索のために ToDo のタイトルと説明をベクトル化したもの。
+type TodoEmbedding struct {
+	ent.Schema
+}
+
+// Annotations of the TodoEmbedding.
+func (TodoEmbedding) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "todo_embeddings"},
+	}
+}
+
+// Fields of the TodoEmbedding.
+func (TodoEmbedding) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(func() uuid.UUID { return uuid.Must(uuid.NewV7()) }),
+		field.Int("todo_id").Unique(),
+		field.String("model").MaxLen(100),
+		// ベクトル化した文章のハッシュ。変化した場合は再生成する
+		field.String("content_hash").MaxLen(64),
+		field.JSON("vector", []float32{}),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the TodoEmbedding.
+func (TodoEmbedding) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("todo", Todo.Type).Ref("embedding").Unique().Field("todo_id").Required(),
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
@@ -28,7 +29,13 @@ func (TodoFilterHistory) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(func() uuid.UUID { return uuid.Must(uuid.NewV7()) }),
 		field.Int("user_id"),
+		field.Int("workspace_id").Immutable(),
 		field.String("query").MaxLen(400),
+		// AI の判定結果をキャッシュする際のキー
+		field.String("normalized_query").MaxLen(400).Optional(),
+		field.String("date_bucket").MaxLen(20).Optional(),
+		// 判定に使用したプロンプトのテンプレート (例: v1.ja)
+		field.String("prompt_version").MaxLen(20).Optional(),
 		field.String("function_name").MaxLen(100).Optional(),
 		field.JSON("args", map[string]interface{}{}).Optional(),
 		field.JSON("result_todo_ids", []int{}).Optional(),
@@ -40,5 +47,13 @@ func (TodoFilterHistory) Fields() []ent.Field {
 func (TodoFilterHistory) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("user", User.Type).Ref("todo_filter_histories").Unique().Field("user_id").Required(),
+		edge.From("workspace", Workspace.Type).Ref("todo_filter_histories").Unique().Field("workspace_id").Required().Immutable(),
+	}
+}
+
+// Indexes of the TodoFilterHistory.
+func (TodoFilterHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "workspace_id", "normalized_query", "date_bucket"),
 	}
 }
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// TodoList holds the schema definition for the TodoList entity.
+// 複数のユーザーで共有する Todo のリスト。所有者を含むメンバーは TodoListMember で管理する。
+type TodoList struct {
+	ent.Schema
+}
+
+// Fields of the TodoList.
+func (TodoList) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Positive().Unique().Immutable(),
+		field.String("name").MaxLen(64).NotEmpty(),
+		field.Int("workspace_id").Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the TodoList.
+func (TodoList) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("workspace", Workspace.Type).
+			Ref("todo_lists").
+			Unique().
+			Field("workspace_id").
+			Required().
+			Immutable(),
+		edge.To("members", TodoListMember.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("invites", TodoListInvite.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("todos", Todo.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
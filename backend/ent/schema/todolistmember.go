@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TodoListMember holds the schema definition for the TodoListMember entity.
+type TodoListMember struct {
+	ent.Schema
+}
+
+// Fields of the TodoListMember.
+func (TodoListMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("list_id"),
+		field.Int("user_id"),
+		// viewer: 閲覧のみ / editor: Todo の追加・変更・削除 / owner: リストとメンバーの管理
+		field.String("role").MaxLen(16),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the TodoListMember.
+func (TodoListMember) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("list", TodoList.Type).Ref("members").Unique().Field("list_id").Required(),
+		edge.From("user", User.Type).Ref("todo_list_memberships").Unique().Field("user_id").Required(),
+	}
+}
+
+func (TodoListMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("list_id", "user_id").Unique(),
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// TodoSummary holds the schema definition for the TodoSummary entity.
+// ユーザーとワークスペース、期間ごとの AI サマリーをキャッシュする。
+type TodoSummary struct {
+	ent.Schema
+}
+
+// Annotations of the TodoSummary.
+func (TodoSummary) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "todo_summaries"},
+	}
+}
+
+// Fields of the TodoSummary.
+func (TodoSummary) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(func() uuid.UUID { return uuid.Must(uuid.NewV7()) }),
+		field.Int("user_id"),
+		field.Int("workspace_id").Immutable(),
+		field.Time("range_from"),
+		field.Time("range_to"),
+		// 集計対象の ToDo から算出したハッシュ。変化した場合はキャッシュを再生成する
+		field.String("source_hash").MaxLen(64),
+		field.String("model").MaxLen(100),
+		field.Text("content"),
+		field.Text("markdown"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the TodoSummary.
+func (TodoSummary) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("todo_summaries").Unique().Field("user_id").Required(),
+		edge.From("workspace", Workspace.Type).Ref("todo_summaries").Unique().Field("workspace_id").Required().Immutable(),
+	}
+}
+
+// Indexes of the TodoSummary.
+func (TodoSummary) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "workspace_id", "range_from", "range_to").Unique(),
+	}
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// TOTPCredential holds the schema definition for the TOTPCredential entity.
+// 2 段階認証 (TOTP) の共有シークレット。ユーザーごとに 1 件。
+type TOTPCredential struct {
+	ent.Schema
+}
+
+// Annotations of the TOTPCredential.
+func (TOTPCredential) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "totp_credentials"},
+	}
+}
+
+// Fields of the TOTPCredential.
+func (TOTPCredential) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").Unique(),
+		// 認証アプリに登録する Base32 のシークレット。コードの検証に必要なため、ハッシュ化せずに保存する
+		field.String("secret").MaxLen(64).NotEmpty().Sensitive(),
+		// 認証アプリのコードで登録を確認した日時。未確認の間はログインに 2 段階認証を求めない
+		field.Time("confirmed_at").Optional().Nillable(),
+		// 最後に使用したコードの時間ステップ。同じコードを再利用できないようにする
+		field.Int64("last_used_step").Default(0),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the TOTPCredential.
+func (TOTPCredential) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("totp_credential").Unique().Field("user_id").Required(),
+	}
+}
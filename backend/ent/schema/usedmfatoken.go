@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// UsedMFAToken holds the schema definition for the UsedMFAToken entity.
+// 2 段階認証のログインに使用済みのトークンの jti。同じトークンを使い回してログインできないようにする。
+type UsedMFAToken struct {
+	ent.Schema
+}
+
+// Annotations of the UsedMFAToken.
+func (UsedMFAToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "used_mfa_tokens"},
+	}
+}
+
+// Fields of the UsedMFAToken.
+func (UsedMFAToken) Fields() []ent.Field {
+	return []ent.Field{
+		// トークンの jti
+		field.UUID("id", uuid.UUID{}),
+		field.Int("user_id"),
+		// トークンの有効期限。期限を過ぎたトークンは jti を照合しなくても使用できないため、行を削除してよい
+		field.Time("expires_at"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the UsedMFAToken.
+func (UsedMFAToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("used_mfa_tokens").Unique().Field("user_id").Required(),
+	}
+}
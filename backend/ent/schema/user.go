@@ -20,6 +20,22 @@ func (User) Fields() []ent.Field {
 		field.String("name").NotEmpty(),
 		field.String("email").Unique().NotEmpty(),
 		field.String("password").NotEmpty(),
+		// メールアドレスの所有を確認した日時。メールアドレスの変更やパスワードの再設定のメール、
+		// プロバイダが確認済みとした OIDC のログインで確認する。登録しただけでは確認しない
+		field.Time("email_verified_at").Optional().Nillable(),
+		// AI のプロンプトの言語 (ja / en)
+		field.String("locale").MaxLen(10).Default("ja"),
+		// 相対的な日時表現を解釈する際のタイムゾーン (IANA 形式)
+		field.String("time_zone").MaxLen(64).Default("Asia/Tokyo"),
+		// Todo の一覧で page_size / include_done を指定しない場合の既定値
+		field.Int("todo_page_size").Default(20).Range(1, 100),
+		field.Bool("todo_include_done").Default(false),
+		// 退会を申請した場合に完全に削除する日時。それまでは申請を取り消せる
+		field.Time("deletion_scheduled_at").Optional().Nillable(),
+		// user / admin。admin は /admin の API を呼び出せる
+		field.String("role").MaxLen(16).Default("user"),
+		// 管理者が無効にした日時。設定されているユーザーはログインできず、発行済みのトークンも受け付けない
+		field.Time("disabled_at").Optional().Nillable(),
 		field.Time("created_at").Default(time.Now),
 	}
 }
@@ -27,9 +43,44 @@ func (User) Fields() []ent.Field {
 // Edges of the User.
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
+		// 個人のワークスペースの Todo はワークスペースごと削除し、共有のリストなどの Todo は残す
 		edge.To("todos", Todo.Type).
-			Annotations(entsql.OnDelete(entsql.Cascade)),
+			Annotations(entsql.OnDelete(entsql.SetNull)),
 		edge.To("todo_filter_histories", TodoFilterHistory.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("todo_breakdowns", TodoBreakdown.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("todo_summaries", TodoSummary.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("ai_usages", AIUsage.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("sessions", Session.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("password_reset_tokens", PasswordResetToken.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("personal_access_tokens", PersonalAccessToken.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("identities", UserIdentity.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("totp_credential", TOTPCredential.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("recovery_codes", RecoveryCode.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("used_mfa_tokens", UsedMFAToken.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("login_attempts", LoginAttempt.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("email_change_tokens", EmailChangeToken.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("account_deletion_tokens", AccountDeletionToken.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("todo_list_memberships", TodoListMember.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("personal_workspace", Workspace.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("workspace_memberships", WorkspaceMember.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserIdentity holds the schema definition for the UserIdentity entity.
+// OpenID Connect のプロバイダのアカウント (iss ごとの sub) とユーザーの紐付け。
+type UserIdentity struct {
+	ent.Schema
+}
+
+// Annotations of the UserIdentity.
+func (UserIdentity) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "user_identities"},
+	}
+}
+
+// Fields of the UserIdentity.
+func (UserIdentity) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		// OIDC_PROVIDERS で設定したプロバイダの名前
+		field.String("provider").MaxLen(64).NotEmpty(),
+		// ID トークンの sub。メールアドレスは変更される可能性があるため、2 回目以降のログインはこちらで照合する
+		field.String("subject").MaxLen(255).NotEmpty(),
+		// 紐付けた時点のメールアドレス
+		field.String("email").MaxLen(255),
+		field.Time("last_login_at").Default(time.Now),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the UserIdentity.
+func (UserIdentity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("identities").Unique().Field("user_id").Required(),
+	}
+}
+
+// Indexes of the UserIdentity.
+func (UserIdentity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "subject").Unique(),
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Workspace holds the schema definition for the Workspace entity.
+// Todo とフィルタの履歴、共有のリストはワークスペースに属し、他のワークスペースからは参照できない。
+// 全てのユーザーは自分だけが参加する個人のワークスペースを 1 つ持つ
+type Workspace struct {
+	ent.Schema
+}
+
+// Fields of the Workspace.
+func (Workspace) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Positive().Unique().Immutable(),
+		field.String("name").MaxLen(64).NotEmpty(),
+		// 個人のワークスペースの場合のユーザー ID。チームのワークスペースでは未設定
+		field.Int("personal_owner_id").Optional().Nillable().Unique().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Workspace.
+func (Workspace) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("personal_owner", User.Type).
+			Ref("personal_workspace").
+			Unique().
+			Field("personal_owner_id").
+			Immutable(),
+		edge.To("members", WorkspaceMember.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("invites", WorkspaceInvite.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("todos", Todo.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("todo_filter_histories", TodoFilterHistory.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("todo_breakdowns", TodoBreakdown.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("todo_summaries", TodoSummary.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("todo_lists", TodoList.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
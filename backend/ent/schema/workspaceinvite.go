@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// WorkspaceInvite holds the schema definition for the WorkspaceInvite entity.
+// メールアドレス宛てに送信したチームのワークスペースへの招待。招待されたメールアドレスのユーザーが承諾するとメンバーになる。
+type WorkspaceInvite struct {
+	ent.Schema
+}
+
+// Fields of the WorkspaceInvite.
+func (WorkspaceInvite) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(func() uuid.UUID { return uuid.Must(uuid.NewV7()) }),
+		field.Int("workspace_id"),
+		field.String("email").MaxLen(255).NotEmpty(),
+		// 承諾した場合に付与する権限
+		field.String("role").MaxLen(16),
+		// トークン自体は保存せず、SHA-256 のハッシュ (16 進数) を保存する
+		field.String("token_hash").MaxLen(64).Unique().NotEmpty(),
+		// 招待したユーザー ID。招待したユーザーが退会しても招待は残す
+		field.Int("invited_by"),
+		field.Time("expires_at"),
+		// 承諾した、取り消した、または同じメールアドレスへの新しい招待で無効にした日時
+		field.Time("used_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the WorkspaceInvite.
+func (WorkspaceInvite) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("workspace", Workspace.Type).Ref("invites").Unique().Field("workspace_id").Required(),
+	}
+}
+
+func (WorkspaceInvite) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workspace_id", "email"),
+	}
+}
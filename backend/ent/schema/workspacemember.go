@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WorkspaceMember holds the schema definition for the WorkspaceMember entity.
+type WorkspaceMember struct {
+	ent.Schema
+}
+
+// Fields of the WorkspaceMember.
+func (WorkspaceMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("workspace_id"),
+		field.Int("user_id"),
+		// member: Todo の利用 / admin: ワークスペースとメンバーの管理
+		field.String("role").MaxLen(16),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the WorkspaceMember.
+func (WorkspaceMember) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("workspace", Workspace.Type).Ref("members").Unique().Field("workspace_id").Required(),
+		edge.From("user", User.Type).Ref("workspace_memberships").Unique().Field("user_id").Required(),
+	}
+}
+
+func (WorkspaceMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workspace_id", "user_id").Unique(),
+		index.Fields("user_id"),
+	}
+}
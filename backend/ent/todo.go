@@ -7,7 +7,10 @@ import (
 	"strings"
 	"time"
 	"todo-app/ent/todo"
+	"todo-app/ent/todoembedding"
+	"todo-app/ent/todolist"
 	"todo-app/ent/user"
+	"todo-app/ent/workspace"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -30,6 +33,12 @@ type Todo struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// UserID holds the value of the "user_id" field.
 	UserID int `json:"user_id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID int `json:"workspace_id,omitempty"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID *int `json:"parent_id,omitempty"`
+	// ListID holds the value of the "list_id" field.
+	ListID *int `json:"list_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TodoQuery when eager-loading is set.
 	Edges        TodoEdges `json:"edges"`
@@ -40,9 +49,21 @@ type Todo struct {
 type TodoEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// Workspace holds the value of the workspace edge.
+	Workspace *Workspace `json:"workspace,omitempty"`
+	// List holds the value of the list edge.
+	List *TodoList `json:"list,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *Todo `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Todo `json:"children,omitempty"`
+	// Breakdowns holds the value of the breakdowns edge.
+	Breakdowns []*TodoBreakdown `json:"breakdowns,omitempty"`
+	// Embedding holds the value of the embedding edge.
+	Embedding *TodoEmbedding `json:"embedding,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [7]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -56,12 +77,74 @@ func (e TodoEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// WorkspaceOrErr returns the Workspace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoEdges) WorkspaceOrErr() (*Workspace, error) {
+	if e.Workspace != nil {
+		return e.Workspace, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: workspace.Label}
+	}
+	return nil, &NotLoadedError{edge: "workspace"}
+}
+
+// ListOrErr returns the List value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoEdges) ListOrErr() (*TodoList, error) {
+	if e.List != nil {
+		return e.List, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: todolist.Label}
+	}
+	return nil, &NotLoadedError{edge: "list"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoEdges) ParentOrErr() (*Todo, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: todo.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) ChildrenOrErr() ([]*Todo, error) {
+	if e.loadedTypes[4] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
+// BreakdownsOrErr returns the Breakdowns value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) BreakdownsOrErr() ([]*TodoBreakdown, error) {
+	if e.loadedTypes[5] {
+		return e.Breakdowns, nil
+	}
+	return nil, &NotLoadedError{edge: "breakdowns"}
+}
+
+// EmbeddingOrErr returns the Embedding value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoEdges) EmbeddingOrErr() (*TodoEmbedding, error) {
+	if e.Embedding != nil {
+		return e.Embedding, nil
+	} else if e.loadedTypes[6] {
+		return nil, &NotFoundError{label: todoembedding.Label}
+	}
+	return nil, &NotLoadedError{edge: "embedding"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Todo) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case todo.FieldID, todo.FieldUserID:
+		case todo.FieldID, todo.FieldUserID, todo.FieldWorkspaceID, todo.FieldParentID, todo.FieldListID:
 			values[i] = new(sql.NullInt64)
 		case todo.FieldTitle, todo.FieldDescription:
 			values[i] = new(sql.NullString)
@@ -125,6 +208,26 @@ func (_m *Todo) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UserID = int(value.Int64)
 			}
+		case todo.FieldWorkspaceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value.Valid {
+				_m.WorkspaceID = int(value.Int64)
+			}
+		case todo.FieldParentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				_m.ParentID = new(int)
+				*_m.ParentID = int(value.Int64)
+			}
+		case todo.FieldListID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field list_id", values[i])
+			} else if value.Valid {
+				_m.ListID = new(int)
+				*_m.ListID = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -143,6 +246,36 @@ func (_m *Todo) QueryUser() *UserQuery {
 	return NewTodoClient(_m.config).QueryUser(_m)
 }
 
+// QueryWorkspace queries the "workspace" edge of the Todo entity.
+func (_m *Todo) QueryWorkspace() *WorkspaceQuery {
+	return NewTodoClient(_m.config).QueryWorkspace(_m)
+}
+
+// QueryList queries the "list" edge of the Todo entity.
+func (_m *Todo) QueryList() *TodoListQuery {
+	return NewTodoClient(_m.config).QueryList(_m)
+}
+
+// QueryParent queries the "parent" edge of the Todo entity.
+func (_m *Todo) QueryParent() *TodoQuery {
+	return NewTodoClient(_m.config).QueryParent(_m)
+}
+
+// QueryChildren queries the "children" edge of the Todo entity.
+func (_m *Todo) QueryChildren() *TodoQuery {
+	return NewTodoClient(_m.config).QueryChildren(_m)
+}
+
+// QueryBreakdowns queries the "breakdowns" edge of the Todo entity.
+func (_m *Todo) QueryBreakdowns() *TodoBreakdownQuery {
+	return NewTodoClient(_m.config).QueryBreakdowns(_m)
+}
+
+// QueryEmbedding queries the "embedding" edge of the Todo entity.
+func (_m *Todo) QueryEmbedding() *TodoEmbeddingQuery {
+	return NewTodoClient(_m.config).QueryEmbedding(_m)
+}
+
 // Update returns a builder for updating this Todo.
 // Note that you need to call Todo.Unwrap() before calling this method if this Todo
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -185,6 +318,19 @@ func (_m *Todo) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
+	if v := _m.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ListID; v != nil {
+		builder.WriteString("list_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -26,8 +26,26 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
+	// FieldWorkspaceID holds the string denoting the workspace_id field in the database.
+	FieldWorkspaceID = "workspace_id"
+	// FieldParentID holds the string denoting the parent_id field in the database.
+	FieldParentID = "parent_id"
+	// FieldListID holds the string denoting the list_id field in the database.
+	FieldListID = "list_id"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
+	// EdgeWorkspace holds the string denoting the workspace edge name in mutations.
+	EdgeWorkspace = "workspace"
+	// EdgeList holds the string denoting the list edge name in mutations.
+	EdgeList = "list"
+	// EdgeParent holds the string denoting the parent edge name in mutations.
+	EdgeParent = "parent"
+	// EdgeChildren holds the string denoting the children edge name in mutations.
+	EdgeChildren = "children"
+	// EdgeBreakdowns holds the string denoting the breakdowns edge name in mutations.
+	EdgeBreakdowns = "breakdowns"
+	// EdgeEmbedding holds the string denoting the embedding edge name in mutations.
+	EdgeEmbedding = "embedding"
 	// Table holds the table name of the todo in the database.
 	Table = "todos"
 	// UserTable is the table that holds the user relation/edge.
@@ -37,6 +55,42 @@ const (
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
 	UserColumn = "user_id"
+	// WorkspaceTable is the table that holds the workspace relation/edge.
+	WorkspaceTable = "todos"
+	// WorkspaceInverseTable is the table name for the Workspace entity.
+	// It exists in this package in order to avoid circular dependency with the "workspace" package.
+	WorkspaceInverseTable = "workspaces"
+	// WorkspaceColumn is the table column denoting the workspace relation/edge.
+	WorkspaceColumn = "workspace_id"
+	// ListTable is the table that holds the list relation/edge.
+	ListTable = "todos"
+	// ListInverseTable is the table name for the TodoList entity.
+	// It exists in this package in order to avoid circular dependency with the "todolist" package.
+	ListInverseTable = "todo_lists"
+	// ListColumn is the table column denoting the list relation/edge.
+	ListColumn = "list_id"
+	// ParentTable is the table that holds the parent relation/edge.
+	ParentTable = "todos"
+	// ParentColumn is the table column denoting the parent relation/edge.
+	ParentColumn = "parent_id"
+	// ChildrenTable is the table that holds the children relation/edge.
+	ChildrenTable = "todos"
+	// ChildrenColumn is the table column denoting the children relation/edge.
+	ChildrenColumn = "parent_id"
+	// BreakdownsTable is the table that holds the breakdowns relation/edge.
+	BreakdownsTable = "todo_breakdowns"
+	// BreakdownsInverseTable is the table name for the TodoBreakdown entity.
+	// It exists in this package in order to avoid circular dependency with the "todobreakdown" package.
+	BreakdownsInverseTable = "todo_breakdowns"
+	// BreakdownsColumn is the table column denoting the breakdowns relation/edge.
+	BreakdownsColumn = "todo_id"
+	// EmbeddingTable is the table that holds the embedding relation/edge.
+	EmbeddingTable = "todo_embeddings"
+	// EmbeddingInverseTable is the table name for the TodoEmbedding entity.
+	// It exists in this package in order to avoid circular dependency with the "todoembedding" package.
+	EmbeddingInverseTable = "todo_embeddings"
+	// EmbeddingColumn is the table column denoting the embedding relation/edge.
+	EmbeddingColumn = "todo_id"
 )
 
 // Columns holds all SQL columns for todo fields.
@@ -48,6 +102,9 @@ var Columns = []string{
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldUserID,
+	FieldWorkspaceID,
+	FieldParentID,
+	FieldListID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -115,12 +172,83 @@ func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
 }
 
+// ByWorkspaceID orders the results by the workspace_id field.
+func ByWorkspaceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkspaceID, opts...).ToFunc()
+}
+
+// ByParentID orders the results by the parent_id field.
+func ByParentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentID, opts...).ToFunc()
+}
+
+// ByListID orders the results by the list_id field.
+func ByListID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldListID, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
 		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByWorkspaceField orders the results by workspace field.
+func ByWorkspaceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWorkspaceStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByListField orders the results by list field.
+func ByListField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newListStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByParentField orders the results by parent field.
+func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newParentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByChildrenCount orders the results by children count.
+func ByChildrenCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChildrenStep(), opts...)
+	}
+}
+
+// ByChildren orders the results by children terms.
+func ByChildren(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChildrenStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBreakdownsCount orders the results by breakdowns count.
+func ByBreakdownsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBreakdownsStep(), opts...)
+	}
+}
+
+// ByBreakdowns orders the results by breakdowns terms.
+func ByBreakdowns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBreakdownsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEmbeddingField orders the results by embedding field.
+func ByEmbeddingField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEmbeddingStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -128,3 +256,45 @@ func newUserStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
 	)
 }
+func newWorkspaceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WorkspaceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, WorkspaceTable, WorkspaceColumn),
+	)
+}
+func newListStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ListInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ListTable, ListColumn),
+	)
+}
+func newParentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+	)
+}
+func newChildrenStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+	)
+}
+func newBreakdownsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BreakdownsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BreakdownsTable, BreakdownsColumn),
+	)
+}
+func newEmbeddingStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EmbeddingInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, EmbeddingTable, EmbeddingColumn),
+	)
+}
@@ -85,6 +85,21 @@ func UserID(v int) predicate.Todo {
 	return predicate.Todo(sql.FieldEQ(FieldUserID, v))
 }
 
+// WorkspaceID applies equality check predicate on the "workspace_id" field. It's identical to WorkspaceIDEQ.
+func WorkspaceID(v int) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// ParentID applies equality check predicate on the "parent_id" field. It's identical to ParentIDEQ.
+func ParentID(v int) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldParentID, v))
+}
+
+// ListID applies equality check predicate on the "list_id" field. It's identical to ListIDEQ.
+func ListID(v int) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldListID, v))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.Todo {
 	return predicate.Todo(sql.FieldEQ(FieldTitle, v))
@@ -365,6 +380,96 @@ func UserIDNotIn(vs ...int) predicate.Todo {
 	return predicate.Todo(sql.FieldNotIn(FieldUserID, vs...))
 }
 
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.Todo {
+	return predicate.Todo(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.Todo {
+	return predicate.Todo(sql.FieldNotNull(FieldUserID))
+}
+
+// WorkspaceIDEQ applies the EQ predicate on the "workspace_id" field.
+func WorkspaceIDEQ(v int) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDNEQ applies the NEQ predicate on the "workspace_id" field.
+func WorkspaceIDNEQ(v int) predicate.Todo {
+	return predicate.Todo(sql.FieldNEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDIn applies the In predicate on the "workspace_id" field.
+func WorkspaceIDIn(vs ...int) predicate.Todo {
+	return predicate.Todo(sql.FieldIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDNotIn applies the NotIn predicate on the "workspace_id" field.
+func WorkspaceIDNotIn(vs ...int) predicate.Todo {
+	return predicate.Todo(sql.FieldNotIn(FieldWorkspaceID, vs...))
+}
+
+// ParentIDEQ applies the EQ predicate on the "parent_id" field.
+func ParentIDEQ(v int) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldParentID, v))
+}
+
+// ParentIDNEQ applies the NEQ predicate on the "parent_id" field.
+func ParentIDNEQ(v int) predicate.Todo {
+	return predicate.Todo(sql.FieldNEQ(FieldParentID, v))
+}
+
+// ParentIDIn applies the In predicate on the "parent_id" field.
+func ParentIDIn(vs ...int) predicate.Todo {
+	return predicate.Todo(sql.FieldIn(FieldParentID, vs...))
+}
+
+// ParentIDNotIn applies the NotIn predicate on the "parent_id" field.
+func ParentIDNotIn(vs ...int) predicate.Todo {
+	return predicate.Todo(sql.FieldNotIn(FieldParentID, vs...))
+}
+
+// ParentIDIsNil applies the IsNil predicate on the "parent_id" field.
+func ParentIDIsNil() predicate.Todo {
+	return predicate.Todo(sql.FieldIsNull(FieldParentID))
+}
+
+// ParentIDNotNil applies the NotNil predicate on the "parent_id" field.
+func ParentIDNotNil() predicate.Todo {
+	return predicate.Todo(sql.FieldNotNull(FieldParentID))
+}
+
+// ListIDEQ applies the EQ predicate on the "list_id" field.
+func ListIDEQ(v int) predicate.Todo {
+	return predicate.Todo(sql.FieldEQ(FieldListID, v))
+}
+
+// ListIDNEQ applies the NEQ predicate on the "list_id" field.
+func ListIDNEQ(v int) predicate.Todo {
+	return predicate.Todo(sql.FieldNEQ(FieldListID, v))
+}
+
+// ListIDIn applies the In predicate on the "list_id" field.
+func ListIDIn(vs ...int) predicate.Todo {
+	return predicate.Todo(sql.FieldIn(FieldListID, vs...))
+}
+
+// ListIDNotIn applies the NotIn predicate on the "list_id" field.
+func ListIDNotIn(vs ...int) predicate.Todo {
+	return predicate.Todo(sql.FieldNotIn(FieldListID, vs...))
+}
+
+// ListIDIsNil applies the IsNil predicate on the "list_id" field.
+func ListIDIsNil() predicate.Todo {
+	return predicate.Todo(sql.FieldIsNull(FieldListID))
+}
+
+// ListIDNotNil applies the NotNil predicate on the "list_id" field.
+func ListIDNotNil() predicate.Todo {
+	return predicate.Todo(sql.FieldNotNull(FieldListID))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Todo {
 	return predicate.Todo(func(s *sql.Selector) {
@@ -388,6 +493,144 @@ func HasUserWith(preds ...predicate.User) predicate.Todo {
 	})
 }
 
+// HasWorkspace applies the HasEdge predicate on the "workspace" edge.
+func HasWorkspace() predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WorkspaceTable, WorkspaceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkspaceWith applies the HasEdge predicate on the "workspace" edge with a given conditions (other predicates).
+func HasWorkspaceWith(preds ...predicate.Workspace) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := newWorkspaceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasList applies the HasEdge predicate on the "list" edge.
+func HasList() predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ListTable, ListColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasListWith applies the HasEdge predicate on the "list" edge with a given conditions (other predicates).
+func HasListWith(preds ...predicate.TodoList) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := newListStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.Todo) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := newParentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChildren applies the HasEdge predicate on the "children" edge.
+func HasChildren() predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChildrenWith applies the HasEdge predicate on the "children" edge with a given conditions (other predicates).
+func HasChildrenWith(preds ...predicate.Todo) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := newChildrenStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBreakdowns applies the HasEdge predicate on the "breakdowns" edge.
+func HasBreakdowns() predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BreakdownsTable, BreakdownsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBreakdownsWith applies the HasEdge predicate on the "breakdowns" edge with a given conditions (other predicates).
+func HasBreakdownsWith(preds ...predicate.TodoBreakdown) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := newBreakdownsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEmbedding applies the HasEdge predicate on the "embedding" edge.
+func HasEmbedding() predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, EmbeddingTable, EmbeddingColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEmbeddingWith applies the HasEdge predicate on the "embedding" edge with a given conditions (other predicates).
+func HasEmbeddingWith(preds ...predicate.TodoEmbedding) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := newEmbeddingStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Todo) predicate.Todo {
 	return predicate.Todo(sql.AndPredicates(predicates...))
@@ -8,10 +8,15 @@ import (
 	"fmt"
 	"time"
 	"todo-app/ent/todo"
+	"todo-app/ent/todobreakdown"
+	"todo-app/ent/todoembedding"
+	"todo-app/ent/todolist"
 	"todo-app/ent/user"
+	"todo-app/ent/workspace"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // TodoCreate is the builder for creating a Todo entity.
@@ -89,6 +94,48 @@ func (_c *TodoCreate) SetUserID(v int) *TodoCreate {
 	return _c
 }
 
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *TodoCreate) SetNillableUserID(v *int) *TodoCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *TodoCreate) SetWorkspaceID(v int) *TodoCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetParentID sets the "parent_id" field.
+func (_c *TodoCreate) SetParentID(v int) *TodoCreate {
+	_c.mutation.SetParentID(v)
+	return _c
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_c *TodoCreate) SetNillableParentID(v *int) *TodoCreate {
+	if v != nil {
+		_c.SetParentID(*v)
+	}
+	return _c
+}
+
+// SetListID sets the "list_id" field.
+func (_c *TodoCreate) SetListID(v int) *TodoCreate {
+	_c.mutation.SetListID(v)
+	return _c
+}
+
+// SetNillableListID sets the "list_id" field if the given value is not nil.
+func (_c *TodoCreate) SetNillableListID(v *int) *TodoCreate {
+	if v != nil {
+		_c.SetListID(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *TodoCreate) SetID(v int) *TodoCreate {
 	_c.mutation.SetID(v)
@@ -100,6 +147,70 @@ func (_c *TodoCreate) SetUser(v *User) *TodoCreate {
 	return _c.SetUserID(v.ID)
 }
 
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (_c *TodoCreate) SetWorkspace(v *Workspace) *TodoCreate {
+	return _c.SetWorkspaceID(v.ID)
+}
+
+// SetList sets the "list" edge to the TodoList entity.
+func (_c *TodoCreate) SetList(v *TodoList) *TodoCreate {
+	return _c.SetListID(v.ID)
+}
+
+// SetParent sets the "parent" edge to the Todo entity.
+func (_c *TodoCreate) SetParent(v *Todo) *TodoCreate {
+	return _c.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Todo entity by IDs.
+func (_c *TodoCreate) AddChildIDs(ids ...int) *TodoCreate {
+	_c.mutation.AddChildIDs(ids...)
+	return _c
+}
+
+// AddChildren adds the "children" edges to the Todo entity.
+func (_c *TodoCreate) AddChildren(v ...*Todo) *TodoCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChildIDs(ids...)
+}
+
+// AddBreakdownIDs adds the "breakdowns" edge to the TodoBreakdown entity by IDs.
+func (_c *TodoCreate) AddBreakdownIDs(ids ...uuid.UUID) *TodoCreate {
+	_c.mutation.AddBreakdownIDs(ids...)
+	return _c
+}
+
+// AddBreakdowns adds the "breakdowns" edges to the TodoBreakdown entity.
+func (_c *TodoCreate) AddBreakdowns(v ...*TodoBreakdown) *TodoCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBreakdownIDs(ids...)
+}
+
+// SetEmbeddingID sets the "embedding" edge to the TodoEmbedding entity by ID.
+func (_c *TodoCreate) SetEmbeddingID(id uuid.UUID) *TodoCreate {
+	_c.mutation.SetEmbeddingID(id)
+	return _c
+}
+
+// SetNillableEmbeddingID sets the "embedding" edge to the TodoEmbedding entity by ID if the given value is not nil.
+func (_c *TodoCreate) SetNillableEmbeddingID(id *uuid.UUID) *TodoCreate {
+	if id != nil {
+		_c = _c.SetEmbeddingID(*id)
+	}
+	return _c
+}
+
+// SetEmbedding sets the "embedding" edge to the TodoEmbedding entity.
+func (_c *TodoCreate) SetEmbedding(v *TodoEmbedding) *TodoCreate {
+	return _c.SetEmbeddingID(v.ID)
+}
+
 // Mutation returns the TodoMutation object of the builder.
 func (_c *TodoCreate) Mutation() *TodoMutation {
 	return _c.mutation
@@ -173,16 +284,16 @@ func (_c *TodoCreate) check() error {
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Todo.updated_at"`)}
 	}
-	if _, ok := _c.mutation.UserID(); !ok {
-		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Todo.user_id"`)}
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "Todo.workspace_id"`)}
 	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := todo.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Todo.id": %w`, err)}
 		}
 	}
-	if len(_c.mutation.UserIDs()) == 0 {
-		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Todo.user"`)}
+	if len(_c.mutation.WorkspaceIDs()) == 0 {
+		return &ValidationError{Name: "workspace", err: errors.New(`ent: missing required edge "Todo.workspace"`)}
 	}
 	return nil
 }
@@ -253,6 +364,105 @@ func (_c *TodoCreate) createSpec() (*Todo, *sqlgraph.CreateSpec) {
 		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.WorkspaceTable,
+			Columns: []string{todo.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkspaceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ListTable,
+			Columns: []string{todo.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todolist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ListID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ParentTable,
+			Columns: []string{todo.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChildrenTable,
+			Columns: []string{todo.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BreakdownsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.BreakdownsTable,
+			Columns: []string{todo.BreakdownsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todobreakdown.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EmbeddingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   todo.EmbeddingTable,
+			Columns: []string{todo.EmbeddingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoembedding.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -9,11 +9,15 @@ import (
 	"time"
 	"todo-app/ent/predicate"
 	"todo-app/ent/todo"
+	"todo-app/ent/todobreakdown"
+	"todo-app/ent/todoembedding"
+	"todo-app/ent/todolist"
 	"todo-app/ent/user"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // TodoUpdate is the builder for updating Todo entities.
@@ -111,11 +115,116 @@ func (_u *TodoUpdate) SetNillableUserID(v *int) *TodoUpdate {
 	return _u
 }
 
+// ClearUserID clears the value of the "user_id" field.
+func (_u *TodoUpdate) ClearUserID() *TodoUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetParentID sets the "parent_id" field.
+func (_u *TodoUpdate) SetParentID(v int) *TodoUpdate {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *TodoUpdate) SetNillableParentID(v *int) *TodoUpdate {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *TodoUpdate) ClearParentID() *TodoUpdate {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
+// SetListID sets the "list_id" field.
+func (_u *TodoUpdate) SetListID(v int) *TodoUpdate {
+	_u.mutation.SetListID(v)
+	return _u
+}
+
+// SetNillableListID sets the "list_id" field if the given value is not nil.
+func (_u *TodoUpdate) SetNillableListID(v *int) *TodoUpdate {
+	if v != nil {
+		_u.SetListID(*v)
+	}
+	return _u
+}
+
+// ClearListID clears the value of the "list_id" field.
+func (_u *TodoUpdate) ClearListID() *TodoUpdate {
+	_u.mutation.ClearListID()
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *TodoUpdate) SetUser(v *User) *TodoUpdate {
 	return _u.SetUserID(v.ID)
 }
 
+// SetList sets the "list" edge to the TodoList entity.
+func (_u *TodoUpdate) SetList(v *TodoList) *TodoUpdate {
+	return _u.SetListID(v.ID)
+}
+
+// SetParent sets the "parent" edge to the Todo entity.
+func (_u *TodoUpdate) SetParent(v *Todo) *TodoUpdate {
+	return _u.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Todo entity by IDs.
+func (_u *TodoUpdate) AddChildIDs(ids ...int) *TodoUpdate {
+	_u.mutation.AddChildIDs(ids...)
+	return _u
+}
+
+// AddChildren adds the "children" edges to the Todo entity.
+func (_u *TodoUpdate) AddChildren(v ...*Todo) *TodoUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChildIDs(ids...)
+}
+
+// AddBreakdownIDs adds the "breakdowns" edge to the TodoBreakdown entity by IDs.
+func (_u *TodoUpdate) AddBreakdownIDs(ids ...uuid.UUID) *TodoUpdate {
+	_u.mutation.AddBreakdownIDs(ids...)
+	return _u
+}
+
+// AddBreakdowns adds the "breakdowns" edges to the TodoBreakdown entity.
+func (_u *TodoUpdate) AddBreakdowns(v ...*TodoBreakdown) *TodoUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBreakdownIDs(ids...)
+}
+
+// SetEmbeddingID sets the "embedding" edge to the TodoEmbedding entity by ID.
+func (_u *TodoUpdate) SetEmbeddingID(id uuid.UUID) *TodoUpdate {
+	_u.mutation.SetEmbeddingID(id)
+	return _u
+}
+
+// SetNillableEmbeddingID sets the "embedding" edge to the TodoEmbedding entity by ID if the given value is not nil.
+func (_u *TodoUpdate) SetNillableEmbeddingID(id *uuid.UUID) *TodoUpdate {
+	if id != nil {
+		_u = _u.SetEmbeddingID(*id)
+	}
+	return _u
+}
+
+// SetEmbedding sets the "embedding" edge to the TodoEmbedding entity.
+func (_u *TodoUpdate) SetEmbedding(v *TodoEmbedding) *TodoUpdate {
+	return _u.SetEmbeddingID(v.ID)
+}
+
 // Mutation returns the TodoMutation object of the builder.
 func (_u *TodoUpdate) Mutation() *TodoMutation {
 	return _u.mutation
@@ -127,6 +236,66 @@ func (_u *TodoUpdate) ClearUser() *TodoUpdate {
 	return _u
 }
 
+// ClearList clears the "list" edge to the TodoList entity.
+func (_u *TodoUpdate) ClearList() *TodoUpdate {
+	_u.mutation.ClearList()
+	return _u
+}
+
+// ClearParent clears the "parent" edge to the Todo entity.
+func (_u *TodoUpdate) ClearParent() *TodoUpdate {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearChildren clears all "children" edges to the Todo entity.
+func (_u *TodoUpdate) ClearChildren() *TodoUpdate {
+	_u.mutation.ClearChildren()
+	return _u
+}
+
+// RemoveChildIDs removes the "children" edge to Todo entities by IDs.
+func (_u *TodoUpdate) RemoveChildIDs(ids ...int) *TodoUpdate {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
+}
+
+// RemoveChildren removes "children" edges to Todo entities.
+func (_u *TodoUpdate) RemoveChildren(v ...*Todo) *TodoUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChildIDs(ids...)
+}
+
+// ClearBreakdowns clears all "breakdowns" edges to the TodoBreakdown entity.
+func (_u *TodoUpdate) ClearBreakdowns() *TodoUpdate {
+	_u.mutation.ClearBreakdowns()
+	return _u
+}
+
+// RemoveBreakdownIDs removes the "breakdowns" edge to TodoBreakdown entities by IDs.
+func (_u *TodoUpdate) RemoveBreakdownIDs(ids ...uuid.UUID) *TodoUpdate {
+	_u.mutation.RemoveBreakdownIDs(ids...)
+	return _u
+}
+
+// RemoveBreakdowns removes "breakdowns" edges to TodoBreakdown entities.
+func (_u *TodoUpdate) RemoveBreakdowns(v ...*TodoBreakdown) *TodoUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBreakdownIDs(ids...)
+}
+
+// ClearEmbedding clears the "embedding" edge to the TodoEmbedding entity.
+func (_u *TodoUpdate) ClearEmbedding() *TodoUpdate {
+	_u.mutation.ClearEmbedding()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *TodoUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -175,8 +344,8 @@ func (_u *TodoUpdate) check() error {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Todo.description": %w`, err)}
 		}
 	}
-	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Todo.user"`)
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Todo.workspace"`)
 	}
 	return nil
 }
@@ -240,6 +409,183 @@ func (_u *TodoUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ListCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ListTable,
+			Columns: []string{todo.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todolist.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ListTable,
+			Columns: []string{todo.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todolist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ParentTable,
+			Columns: []string{todo.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ParentTable,
+			Columns: []string{todo.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChildrenTable,
+			Columns: []string{todo.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !_u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChildrenTable,
+			Columns: []string{todo.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChildrenTable,
+			Columns: []string{todo.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BreakdownsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.BreakdownsTable,
+			Columns: []string{todo.BreakdownsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todobreakdown.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBreakdownsIDs(); len(nodes) > 0 && !_u.mutation.BreakdownsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.BreakdownsTable,
+			Columns: []string{todo.BreakdownsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todobreakdown.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BreakdownsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.BreakdownsTable,
+			Columns: []string{todo.BreakdownsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todobreakdown.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EmbeddingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   todo.EmbeddingTable,
+			Columns: []string{todo.EmbeddingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoembedding.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EmbeddingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   todo.EmbeddingTable,
+			Columns: []string{todo.EmbeddingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoembedding.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{todo.Label}
@@ -342,11 +688,116 @@ func (_u *TodoUpdateOne) SetNillableUserID(v *int) *TodoUpdateOne {
 	return _u
 }
 
+// ClearUserID clears the value of the "user_id" field.
+func (_u *TodoUpdateOne) ClearUserID() *TodoUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetParentID sets the "parent_id" field.
+func (_u *TodoUpdateOne) SetParentID(v int) *TodoUpdateOne {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableParentID(v *int) *TodoUpdateOne {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *TodoUpdateOne) ClearParentID() *TodoUpdateOne {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
+// SetListID sets the "list_id" field.
+func (_u *TodoUpdateOne) SetListID(v int) *TodoUpdateOne {
+	_u.mutation.SetListID(v)
+	return _u
+}
+
+// SetNillableListID sets the "list_id" field if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableListID(v *int) *TodoUpdateOne {
+	if v != nil {
+		_u.SetListID(*v)
+	}
+	return _u
+}
+
+// ClearListID clears the value of the "list_id" field.
+func (_u *TodoUpdateOne) ClearListID() *TodoUpdateOne {
+	_u.mutation.ClearListID()
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *TodoUpdateOne) SetUser(v *User) *TodoUpdateOne {
 	return _u.SetUserID(v.ID)
 }
 
+// SetList sets the "list" edge to the TodoList entity.
+func (_u *TodoUpdateOne) SetList(v *TodoList) *TodoUpdateOne {
+	return _u.SetListID(v.ID)
+}
+
+// SetParent sets the "parent" edge to the Todo entity.
+func (_u *TodoUpdateOne) SetParent(v *Todo) *TodoUpdateOne {
+	return _u.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Todo entity by IDs.
+func (_u *TodoUpdateOne) AddChildIDs(ids ...int) *TodoUpdateOne {
+	_u.mutation.AddChildIDs(ids...)
+	return _u
+}
+
+// AddChildren adds the "children" edges to the Todo entity.
+func (_u *TodoUpdateOne) AddChildren(v ...*Todo) *TodoUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChildIDs(ids...)
+}
+
+// AddBreakdownIDs adds the "breakdowns" edge to the TodoBreakdown entity by IDs.
+func (_u *TodoUpdateOne) AddBreakdownIDs(ids ...uuid.UUID) *TodoUpdateOne {
+	_u.mutation.AddBreakdownIDs(ids...)
+	return _u
+}
+
+// AddBreakdowns adds the "breakdowns" edges to the TodoBreakdown entity.
+func (_u *TodoUpdateOne) AddBreakdowns(v ...*TodoBreakdown) *TodoUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBreakdownIDs(ids...)
+}
+
+// SetEmbeddingID sets the "embedding" edge to the TodoEmbedding entity by ID.
+func (_u *TodoUpdateOne) SetEmbeddingID(id uuid.UUID) *TodoUpdateOne {
+	_u.mutation.SetEmbeddingID(id)
+	return _u
+}
+
+// SetNillableEmbeddingID sets the "embedding" edge to the TodoEmbedding entity by ID if the given value is not nil.
+func (_u *TodoUpdateOne) SetNillableEmbeddingID(id *uuid.UUID) *TodoUpdateOne {
+	if id != nil {
+		_u = _u.SetEmbeddingID(*id)
+	}
+	return _u
+}
+
+// SetEmbedding sets the "embedding" edge to the TodoEmbedding entity.
+func (_u *TodoUpdateOne) SetEmbedding(v *TodoEmbedding) *TodoUpdateOne {
+	return _u.SetEmbeddingID(v.ID)
+}
+
 // Mutation returns the TodoMutation object of the builder.
 func (_u *TodoUpdateOne) Mutation() *TodoMutation {
 	return _u.mutation
@@ -358,6 +809,66 @@ func (_u *TodoUpdateOne) ClearUser() *TodoUpdateOne {
 	return _u
 }
 
+// ClearList clears the "list" edge to the TodoList entity.
+func (_u *TodoUpdateOne) ClearList() *TodoUpdateOne {
+	_u.mutation.ClearList()
+	return _u
+}
+
+// ClearParent clears the "parent" edge to the Todo entity.
+func (_u *TodoUpdateOne) ClearParent() *TodoUpdateOne {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearChildren clears all "children" edges to the Todo entity.
+func (_u *TodoUpdateOne) ClearChildren() *TodoUpdateOne {
+	_u.mutation.ClearChildren()
+	return _u
+}
+
+// RemoveChildIDs removes the "children" edge to Todo entities by IDs.
+func (_u *TodoUpdateOne) RemoveChildIDs(ids ...int) *TodoUpdateOne {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
+}
+
+// RemoveChildren removes "children" edges to Todo entities.
+func (_u *TodoUpdateOne) RemoveChildren(v ...*Todo) *TodoUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChildIDs(ids...)
+}
+
+// ClearBreakdowns clears all "breakdowns" edges to the TodoBreakdown entity.
+func (_u *TodoUpdateOne) ClearBreakdowns() *TodoUpdateOne {
+	_u.mutation.ClearBreakdowns()
+	return _u
+}
+
+// RemoveBreakdownIDs removes the "breakdowns" edge to TodoBreakdown entities by IDs.
+func (_u *TodoUpdateOne) RemoveBreakdownIDs(ids ...uuid.UUID) *TodoUpdateOne {
+	_u.mutation.RemoveBreakdownIDs(ids...)
+	return _u
+}
+
+// RemoveBreakdowns removes "breakdowns" edges to TodoBreakdown entities.
+func (_u *TodoUpdateOne) RemoveBreakdowns(v ...*TodoBreakdown) *TodoUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBreakdownIDs(ids...)
+}
+
+// ClearEmbedding clears the "embedding" edge to the TodoEmbedding entity.
+func (_u *TodoUpdateOne) ClearEmbedding() *TodoUpdateOne {
+	_u.mutation.ClearEmbedding()
+	return _u
+}
+
 // Where appends a list predicates to the TodoUpdate builder.
 func (_u *TodoUpdateOne) Where(ps ...predicate.Todo) *TodoUpdateOne {
 	_u.mutation.Where(ps...)
@@ -419,8 +930,8 @@ func (_u *TodoUpdateOne) check() error {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Todo.description": %w`, err)}
 		}
 	}
-	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Todo.user"`)
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Todo.workspace"`)
 	}
 	return nil
 }
@@ -501,6 +1012,183 @@ func (_u *TodoUpdateOne) sqlSave(ctx context.Context) (_node *Todo, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ListCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ListTable,
+			Columns: []string{todo.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todolist.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ListTable,
+			Columns: []string{todo.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todolist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ParentTable,
+			Columns: []string{todo.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ParentTable,
+			Columns: []string{todo.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChildrenTable,
+			Columns: []string{todo.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !_u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChildrenTable,
+			Columns: []string{todo.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChildrenTable,
+			Columns: []string{todo.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BreakdownsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.BreakdownsTable,
+			Columns: []string{todo.BreakdownsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todobreakdown.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBreakdownsIDs(); len(nodes) > 0 && !_u.mutation.BreakdownsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.BreakdownsTable,
+			Columns: []string{todo.BreakdownsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todobreakdown.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BreakdownsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.BreakdownsTable,
+			Columns: []string{todo.BreakdownsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todobreakdown.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EmbeddingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   todo.EmbeddingTable,
+			Columns: []string{todo.EmbeddingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoembedding.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EmbeddingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   todo.EmbeddingTable,
+			Columns: []string{todo.EmbeddingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoembedding.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Todo{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,263 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"todo-app/ent/todo"
+	"todo-app/ent/todobreakdown"
+	"todo-app/ent/user"
+	"todo-app/ent/workspace"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// TodoBreakdown is the model entity for the TodoBreakdown schema.
+type TodoBreakdown struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID int `json:"workspace_id,omitempty"`
+	// TodoID holds the value of the "todo_id" field.
+	TodoID int `json:"todo_id,omitempty"`
+	// Model holds the value of the "model" field.
+	Model string `json:"model,omitempty"`
+	// Steps holds the value of the "steps" field.
+	Steps []map[string]string `json:"steps,omitempty"`
+	// AcceptedStepIndexes holds the value of the "accepted_step_indexes" field.
+	AcceptedStepIndexes []int `json:"accepted_step_indexes,omitempty"`
+	// AcceptedAt holds the value of the "accepted_at" field.
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TodoBreakdownQuery when eager-loading is set.
+	Edges        TodoBreakdownEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TodoBreakdownEdges holds the relations/edges for other nodes in the graph.
+type TodoBreakdownEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Workspace holds the value of the workspace edge.
+	Workspace *Workspace `json:"workspace,omitempty"`
+	// Todo holds the value of the todo edge.
+	Todo *Todo `json:"todo,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoBreakdownEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// WorkspaceOrErr returns the Workspace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoBreakdownEdges) WorkspaceOrErr() (*Workspace, error) {
+	if e.Workspace != nil {
+		return e.Workspace, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: workspace.Label}
+	}
+	return nil, &NotLoadedError{edge: "workspace"}
+}
+
+// TodoOrErr returns the Todo value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoBreakdownEdges) TodoOrErr() (*Todo, error) {
+	if e.Todo != nil {
+		return e.Todo, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: todo.Label}
+	}
+	return nil, &NotLoadedError{edge: "todo"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TodoBreakdown) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case todobreakdown.FieldSteps, todobreakdown.FieldAcceptedStepIndexes:
+			values[i] = new([]byte)
+		case todobreakdown.FieldUserID, todobreakdown.FieldWorkspaceID, todobreakdown.FieldTodoID:
+			values[i] = new(sql.NullInt64)
+		case todobreakdown.FieldModel:
+			values[i] = new(sql.NullString)
+		case todobreakdown.FieldAcceptedAt, todobreakdown.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case todobreakdown.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TodoBreakdown fields.
+func (_m *TodoBreakdown) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case todobreakdown.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case todobreakdown.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case todobreakdown.FieldWorkspaceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value.Valid {
+				_m.WorkspaceID = int(value.Int64)
+			}
+		case todobreakdown.FieldTodoID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field todo_id", values[i])
+			} else if value.Valid {
+				_m.TodoID = int(value.Int64)
+			}
+		case todobreakdown.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				_m.Model = value.String
+			}
+		case todobreakdown.FieldSteps:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field steps", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Steps); err != nil {
+					return fmt.Errorf("unmarshal field steps: %w", err)
+				}
+			}
+		case todobreakdown.FieldAcceptedStepIndexes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted_step_indexes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AcceptedStepIndexes); err != nil {
+					return fmt.Errorf("unmarshal field accepted_step_indexes: %w", err)
+				}
+			}
+		case todobreakdown.FieldAcceptedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted_at", values[i])
+			} else if value.Valid {
+				_m.AcceptedAt = new(time.Time)
+				*_m.AcceptedAt = value.Time
+			}
+		case todobreakdown.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TodoBreakdown.
+// This includes values selected through modifiers, order, etc.
+func (_m *TodoBreakdown) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the TodoBreakdown entity.
+func (_m *TodoBreakdown) QueryUser() *UserQuery {
+	return NewTodoBreakdownClient(_m.config).QueryUser(_m)
+}
+
+// QueryWorkspace queries the "workspace" edge of the TodoBreakdown entity.
+func (_m *TodoBreakdown) QueryWorkspace() *WorkspaceQuery {
+	return NewTodoBreakdownClient(_m.config).QueryWorkspace(_m)
+}
+
+// QueryTodo queries the "todo" edge of the TodoBreakdown entity.
+func (_m *TodoBreakdown) QueryTodo() *TodoQuery {
+	return NewTodoBreakdownClient(_m.config).QueryTodo(_m)
+}
+
+// Update returns a builder for updating this TodoBreakdown.
+// Note that you need to call TodoBreakdown.Unwrap() before calling this method if this TodoBreakdown
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TodoBreakdown) Update() *TodoBreakdownUpdateOne {
+	return NewTodoBreakdownClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TodoBreakdown entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TodoBreakdown) Unwrap() *TodoBreakdown {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TodoBreakdown is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TodoBreakdown) String() string {
+	var builder strings.Builder
+	builder.WriteString("TodoBreakdown(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
+	builder.WriteString("todo_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TodoID))
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(_m.Model)
+	builder.WriteString(", ")
+	builder.WriteString("steps=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Steps))
+	builder.WriteString(", ")
+	builder.WriteString("accepted_step_indexes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AcceptedStepIndexes))
+	builder.WriteString(", ")
+	if v := _m.AcceptedAt; v != nil {
+		builder.WriteString("accepted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TodoBreakdowns is a parsable slice of TodoBreakdown.
+type TodoBreakdowns []*TodoBreakdown
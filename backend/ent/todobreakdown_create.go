@@ -0,0 +1,390 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo-app/ent/todo"
+	"todo-app/ent/todobreakdown"
+	"todo-app/ent/user"
+	"todo-app/ent/workspace"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TodoBreakdownCreate is the builder for creating a TodoBreakdown entity.
+type TodoBreakdownCreate struct {
+	config
+	mutation *TodoBreakdownMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *TodoBreakdownCreate) SetUserID(v int) *TodoBreakdownCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *TodoBreakdownCreate) SetWorkspaceID(v int) *TodoBreakdownCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetTodoID sets the "todo_id" field.
+func (_c *TodoBreakdownCreate) SetTodoID(v int) *TodoBreakdownCreate {
+	_c.mutation.SetTodoID(v)
+	return _c
+}
+
+// SetModel sets the "model" field.
+func (_c *TodoBreakdownCreate) SetModel(v string) *TodoBreakdownCreate {
+	_c.mutation.SetModel(v)
+	return _c
+}
+
+// SetSteps sets the "steps" field.
+func (_c *TodoBreakdownCreate) SetSteps(v []map[string]string) *TodoBreakdownCreate {
+	_c.mutation.SetSteps(v)
+	return _c
+}
+
+// SetAcceptedStepIndexes sets the "accepted_step_indexes" field.
+func (_c *TodoBreakdownCreate) SetAcceptedStepIndexes(v []int) *TodoBreakdownCreate {
+	_c.mutation.SetAcceptedStepIndexes(v)
+	return _c
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (_c *TodoBreakdownCreate) SetAcceptedAt(v time.Time) *TodoBreakdownCreate {
+	_c.mutation.SetAcceptedAt(v)
+	return _c
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (_c *TodoBreakdownCreate) SetNillableAcceptedAt(v *time.Time) *TodoBreakdownCreate {
+	if v != nil {
+		_c.SetAcceptedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TodoBreakdownCreate) SetCreatedAt(v time.Time) *TodoBreakdownCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TodoBreakdownCreate) SetNillableCreatedAt(v *time.Time) *TodoBreakdownCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TodoBreakdownCreate) SetID(v uuid.UUID) *TodoBreakdownCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TodoBreakdownCreate) SetNillableID(v *uuid.UUID) *TodoBreakdownCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *TodoBreakdownCreate) SetUser(v *User) *TodoBreakdownCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (_c *TodoBreakdownCreate) SetWorkspace(v *Workspace) *TodoBreakdownCreate {
+	return _c.SetWorkspaceID(v.ID)
+}
+
+// SetTodo sets the "todo" edge to the Todo entity.
+func (_c *TodoBreakdownCreate) SetTodo(v *Todo) *TodoBreakdownCreate {
+	return _c.SetTodoID(v.ID)
+}
+
+// Mutation returns the TodoBreakdownMutation object of the builder.
+func (_c *TodoBreakdownCreate) Mutation() *TodoBreakdownMutation {
+	return _c.mutation
+}
+
+// Save creates the TodoBreakdown in the database.
+func (_c *TodoBreakdownCreate) Save(ctx context.Context) (*TodoBreakdown, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TodoBreakdownCreate) SaveX(ctx context.Context) *TodoBreakdown {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TodoBreakdownCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TodoBreakdownCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TodoBreakdownCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := todobreakdown.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := todobreakdown.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TodoBreakdownCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "TodoBreakdown.user_id"`)}
+	}
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "TodoBreakdown.workspace_id"`)}
+	}
+	if _, ok := _c.mutation.TodoID(); !ok {
+		return &ValidationError{Name: "todo_id", err: errors.New(`ent: missing required field "TodoBreakdown.todo_id"`)}
+	}
+	if _, ok := _c.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "TodoBreakdown.model"`)}
+	}
+	if v, ok := _c.mutation.Model(); ok {
+		if err := todobreakdown.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "TodoBreakdown.model": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Steps(); !ok {
+		return &ValidationError{Name: "steps", err: errors.New(`ent: missing required field "TodoBreakdown.steps"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TodoBreakdown.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "TodoBreakdown.user"`)}
+	}
+	if len(_c.mutation.WorkspaceIDs()) == 0 {
+		return &ValidationError{Name: "workspace", err: errors.New(`ent: missing required edge "TodoBreakdown.workspace"`)}
+	}
+	if len(_c.mutation.TodoIDs()) == 0 {
+		return &ValidationError{Name: "todo", err: errors.New(`ent: missing required edge "TodoBreakdown.todo"`)}
+	}
+	return nil
+}
+
+func (_c *TodoBreakdownCreate) sqlSave(ctx context.Context) (*TodoBreakdown, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TodoBreakdownCreate) createSpec() (*TodoBreakdown, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TodoBreakdown{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(todobreakdown.Table, sqlgraph.NewFieldSpec(todobreakdown.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Model(); ok {
+		_spec.SetField(todobreakdown.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := _c.mutation.Steps(); ok {
+		_spec.SetField(todobreakdown.FieldSteps, field.TypeJSON, value)
+		_node.Steps = value
+	}
+	if value, ok := _c.mutation.AcceptedStepIndexes(); ok {
+		_spec.SetField(todobreakdown.FieldAcceptedStepIndexes, field.TypeJSON, value)
+		_node.AcceptedStepIndexes = value
+	}
+	if value, ok := _c.mutation.AcceptedAt(); ok {
+		_spec.SetField(todobreakdown.FieldAcceptedAt, field.TypeTime, value)
+		_node.AcceptedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(todobreakdown.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todobreakdown.UserTable,
+			Columns: []string{todobreakdown.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todobreakdown.WorkspaceTable,
+			Columns: []string{todobreakdown.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkspaceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TodoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todobreakdown.TodoTable,
+			Columns: []string{todobreakdown.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TodoID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TodoBreakdownCreateBulk is the builder for creating many TodoBreakdown entities in bulk.
+type TodoBreakdownCreateBulk struct {
+	config
+	err      error
+	builders []*TodoBreakdownCreate
+}
+
+// Save creates the TodoBreakdown entities in the database.
+func (_c *TodoBreakdownCreateBulk) Save(ctx context.Context) ([]*TodoBreakdown, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TodoBreakdown, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TodoBreakdownMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TodoBreakdownCreateBulk) SaveX(ctx context.Context) []*TodoBreakdown {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TodoBreakdownCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TodoBreakdownCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
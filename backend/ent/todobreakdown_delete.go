@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"todo-app/ent/predicate"
+	"todo-app/ent/todobreakdown"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TodoBreakdownDelete is the builder for deleting a TodoBreakdown entity.
+type TodoBreakdownDelete struct {
+	config
+	hooks    []Hook
+	mutation *TodoBreakdownMutation
+}
+
+// Where appends a list predicates to the TodoBreakdownDelete builder.
+func (_d *TodoBreakdownDelete) Where(ps ...predicate.TodoBreakdown) *TodoBreakdownDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *TodoBreakdownDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *TodoBreakdownDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *TodoBreakdownDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(todobreakdown.Table, sqlgraph.NewFieldSpec(todobreakdown.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// TodoBreakdownDeleteOne is the builder for deleting a single TodoBreakdown entity.
+type TodoBreakdownDeleteOne struct {
+	_d *TodoBreakdownDelete
+}
+
+// Where appends a list predicates to the TodoBreakdownDelete builder.
+func (_d *TodoBreakdownDeleteOne) Where(ps ...predicate.TodoBreakdown) *TodoBreakdownDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *TodoBreakdownDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{todobreakdown.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *TodoBreakdownDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,623 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo-app/ent/predicate"
+	"todo-app/ent/todo"
+	"todo-app/ent/todobreakdown"
+	"todo-app/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// TodoBreakdownUpdate is the builder for updating TodoBreakdown entities.
+type TodoBreakdownUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TodoBreakdownMutation
+}
+
+// Where appends a list predicates to the TodoBreakdownUpdate builder.
+func (_u *TodoBreakdownUpdate) Where(ps ...predicate.TodoBreakdown) *TodoBreakdownUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *TodoBreakdownUpdate) SetUserID(v int) *TodoBreakdownUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *TodoBreakdownUpdate) SetNillableUserID(v *int) *TodoBreakdownUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetTodoID sets the "todo_id" field.
+func (_u *TodoBreakdownUpdate) SetTodoID(v int) *TodoBreakdownUpdate {
+	_u.mutation.SetTodoID(v)
+	return _u
+}
+
+// SetNillableTodoID sets the "todo_id" field if the given value is not nil.
+func (_u *TodoBreakdownUpdate) SetNillableTodoID(v *int) *TodoBreakdownUpdate {
+	if v != nil {
+		_u.SetTodoID(*v)
+	}
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *TodoBreakdownUpdate) SetModel(v string) *TodoBreakdownUpdate {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *TodoBreakdownUpdate) SetNillableModel(v *string) *TodoBreakdownUpdate {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetSteps sets the "steps" field.
+func (_u *TodoBreakdownUpdate) SetSteps(v []map[string]string) *TodoBreakdownUpdate {
+	_u.mutation.SetSteps(v)
+	return _u
+}
+
+// AppendSteps appends value to the "steps" field.
+func (_u *TodoBreakdownUpdate) AppendSteps(v []map[string]string) *TodoBreakdownUpdate {
+	_u.mutation.AppendSteps(v)
+	return _u
+}
+
+// SetAcceptedStepIndexes sets the "accepted_step_indexes" field.
+func (_u *TodoBreakdownUpdate) SetAcceptedStepIndexes(v []int) *TodoBreakdownUpdate {
+	_u.mutation.SetAcceptedStepIndexes(v)
+	return _u
+}
+
+// AppendAcceptedStepIndexes appends value to the "accepted_step_indexes" field.
+func (_u *TodoBreakdownUpdate) AppendAcceptedStepIndexes(v []int) *TodoBreakdownUpdate {
+	_u.mutation.AppendAcceptedStepIndexes(v)
+	return _u
+}
+
+// ClearAcceptedStepIndexes clears the value of the "accepted_step_indexes" field.
+func (_u *TodoBreakdownUpdate) ClearAcceptedStepIndexes() *TodoBreakdownUpdate {
+	_u.mutation.ClearAcceptedStepIndexes()
+	return _u
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (_u *TodoBreakdownUpdate) SetAcceptedAt(v time.Time) *TodoBreakdownUpdate {
+	_u.mutation.SetAcceptedAt(v)
+	return _u
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (_u *TodoBreakdownUpdate) SetNillableAcceptedAt(v *time.Time) *TodoBreakdownUpdate {
+	if v != nil {
+		_u.SetAcceptedAt(*v)
+	}
+	return _u
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (_u *TodoBreakdownUpdate) ClearAcceptedAt() *TodoBreakdownUpdate {
+	_u.mutation.ClearAcceptedAt()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *TodoBreakdownUpdate) SetUser(v *User) *TodoBreakdownUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetTodo sets the "todo" edge to the Todo entity.
+func (_u *TodoBreakdownUpdate) SetTodo(v *Todo) *TodoBreakdownUpdate {
+	return _u.SetTodoID(v.ID)
+}
+
+// Mutation returns the TodoBreakdownMutation object of the builder.
+func (_u *TodoBreakdownUpdate) Mutation() *TodoBreakdownMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *TodoBreakdownUpdate) ClearUser() *TodoBreakdownUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearTodo clears the "todo" edge to the Todo entity.
+func (_u *TodoBreakdownUpdate) ClearTodo() *TodoBreakdownUpdate {
+	_u.mutation.ClearTodo()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TodoBreakdownUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TodoBreakdownUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TodoBreakdownUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TodoBreakdownUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TodoBreakdownUpdate) check() error {
+	if v, ok := _u.mutation.Model(); ok {
+		if err := todobreakdown.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "TodoBreakdown.model": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoBreakdown.user"`)
+	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoBreakdown.workspace"`)
+	}
+	if _u.mutation.TodoCleared() && len(_u.mutation.TodoIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoBreakdown.todo"`)
+	}
+	return nil
+}
+
+func (_u *TodoBreakdownUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(todobreakdown.Table, todobreakdown.Columns, sqlgraph.NewFieldSpec(todobreakdown.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(todobreakdown.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Steps(); ok {
+		_spec.SetField(todobreakdown.FieldSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, todobreakdown.FieldSteps, value)
+		})
+	}
+	if value, ok := _u.mutation.AcceptedStepIndexes(); ok {
+		_spec.SetField(todobreakdown.FieldAcceptedStepIndexes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAcceptedStepIndexes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, todobreakdown.FieldAcceptedStepIndexes, value)
+		})
+	}
+	if _u.mutation.AcceptedStepIndexesCleared() {
+		_spec.ClearField(todobreakdown.FieldAcceptedStepIndexes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AcceptedAt(); ok {
+		_spec.SetField(todobreakdown.FieldAcceptedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AcceptedAtCleared() {
+		_spec.ClearField(todobreakdown.FieldAcceptedAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todobreakdown.UserTable,
+			Columns: []string{todobreakdown.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todobreakdown.UserTable,
+			Columns: []string{todobreakdown.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TodoCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todobreakdown.TodoTable,
+			Columns: []string{todobreakdown.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TodoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todobreakdown.TodoTable,
+			Columns: []string{todobreakdown.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todobreakdown.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TodoBreakdownUpdateOne is the builder for updating a single TodoBreakdown entity.
+type TodoBreakdownUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TodoBreakdownMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *TodoBreakdownUpdateOne) SetUserID(v int) *TodoBreakdownUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *TodoBreakdownUpdateOne) SetNillableUserID(v *int) *TodoBreakdownUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetTodoID sets the "todo_id" field.
+func (_u *TodoBreakdownUpdateOne) SetTodoID(v int) *TodoBreakdownUpdateOne {
+	_u.mutation.SetTodoID(v)
+	return _u
+}
+
+// SetNillableTodoID sets the "todo_id" field if the given value is not nil.
+func (_u *TodoBreakdownUpdateOne) SetNillableTodoID(v *int) *TodoBreakdownUpdateOne {
+	if v != nil {
+		_u.SetTodoID(*v)
+	}
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *TodoBreakdownUpdateOne) SetModel(v string) *TodoBreakdownUpdateOne {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *TodoBreakdownUpdateOne) SetNillableModel(v *string) *TodoBreakdownUpdateOne {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetSteps sets the "steps" field.
+func (_u *TodoBreakdownUpdateOne) SetSteps(v []map[string]string) *TodoBreakdownUpdateOne {
+	_u.mutation.SetSteps(v)
+	return _u
+}
+
+// AppendSteps appends value to the "steps" field.
+func (_u *TodoBreakdownUpdateOne) AppendSteps(v []map[string]string) *TodoBreakdownUpdateOne {
+	_u.mutation.AppendSteps(v)
+	return _u
+}
+
+// SetAcceptedStepIndexes sets the "accepted_step_indexes" field.
+func (_u *TodoBreakdownUpdateOne) SetAcceptedStepIndexes(v []int) *TodoBreakdownUpdateOne {
+	_u.mutation.SetAcceptedStepIndexes(v)
+	return _u
+}
+
+// AppendAcceptedStepIndexes appends value to the "accepted_step_indexes" field.
+func (_u *TodoBreakdownUpdateOne) AppendAcceptedStepIndexes(v []int) *TodoBreakdownUpdateOne {
+	_u.mutation.AppendAcceptedStepIndexes(v)
+	return _u
+}
+
+// ClearAcceptedStepIndexes clears the value of the "accepted_step_indexes" field.
+func (_u *TodoBreakdownUpdateOne) ClearAcceptedStepIndexes() *TodoBreakdownUpdateOne {
+	_u.mutation.ClearAcceptedStepIndexes()
+	return _u
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (_u *TodoBreakdownUpdateOne) SetAcceptedAt(v time.Time) *TodoBreakdownUpdateOne {
+	_u.mutation.SetAcceptedAt(v)
+	return _u
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (_u *TodoBreakdownUpdateOne) SetNillableAcceptedAt(v *time.Time) *TodoBreakdownUpdateOne {
+	if v != nil {
+		_u.SetAcceptedAt(*v)
+	}
+	return _u
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (_u *TodoBreakdownUpdateOne) ClearAcceptedAt() *TodoBreakdownUpdateOne {
+	_u.mutation.ClearAcceptedAt()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *TodoBreakdownUpdateOne) SetUser(v *User) *TodoBreakdownUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetTodo sets the "todo" edge to the Todo entity.
+func (_u *TodoBreakdownUpdateOne) SetTodo(v *Todo) *TodoBreakdownUpdateOne {
+	return _u.SetTodoID(v.ID)
+}
+
+// Mutation returns the TodoBreakdownMutation object of the builder.
+func (_u *TodoBreakdownUpdateOne) Mutation() *TodoBreakdownMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *TodoBreakdownUpdateOne) ClearUser() *TodoBreakdownUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearTodo clears the "todo" edge to the Todo entity.
+func (_u *TodoBreakdownUpdateOne) ClearTodo() *TodoBreakdownUpdateOne {
+	_u.mutation.ClearTodo()
+	return _u
+}
+
+// Where appends a list predicates to the TodoBreakdownUpdate builder.
+func (_u *TodoBreakdownUpdateOne) Where(ps ...predicate.TodoBreakdown) *TodoBreakdownUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TodoBreakdownUpdateOne) Select(field string, fields ...string) *TodoBreakdownUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TodoBreakdown entity.
+func (_u *TodoBreakdownUpdateOne) Save(ctx context.Context) (*TodoBreakdown, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TodoBreakdownUpdateOne) SaveX(ctx context.Context) *TodoBreakdown {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TodoBreakdownUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TodoBreakdownUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TodoBreakdownUpdateOne) check() error {
+	if v, ok := _u.mutation.Model(); ok {
+		if err := todobreakdown.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "TodoBreakdown.model": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoBreakdown.user"`)
+	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoBreakdown.workspace"`)
+	}
+	if _u.mutation.TodoCleared() && len(_u.mutation.TodoIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoBreakdown.todo"`)
+	}
+	return nil
+}
+
+func (_u *TodoBreakdownUpdateOne) sqlSave(ctx context.Context) (_node *TodoBreakdown, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(todobreakdown.Table, todobreakdown.Columns, sqlgraph.NewFieldSpec(todobreakdown.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TodoBreakdown.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, todobreakdown.FieldID)
+		for _, f := range fields {
+			if !todobreakdown.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != todobreakdown.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(todobreakdown.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Steps(); ok {
+		_spec.SetField(todobreakdown.FieldSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, todobreakdown.FieldSteps, value)
+		})
+	}
+	if value, ok := _u.mutation.AcceptedStepIndexes(); ok {
+		_spec.SetField(todobreakdown.FieldAcceptedStepIndexes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAcceptedStepIndexes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, todobreakdown.FieldAcceptedStepIndexes, value)
+		})
+	}
+	if _u.mutation.AcceptedStepIndexesCleared() {
+		_spec.ClearField(todobreakdown.FieldAcceptedStepIndexes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AcceptedAt(); ok {
+		_spec.SetField(todobreakdown.FieldAcceptedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AcceptedAtCleared() {
+		_spec.ClearField(todobreakdown.FieldAcceptedAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todobreakdown.UserTable,
+			Columns: []string{todobreakdown.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todobreakdown.UserTable,
+			Columns: []string{todobreakdown.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TodoCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todobreakdown.TodoTable,
+			Columns: []string{todobreakdown.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TodoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todobreakdown.TodoTable,
+			Columns: []string{todobreakdown.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TodoBreakdown{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todobreakdown.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
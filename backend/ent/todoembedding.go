@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"todo-app/ent/todo"
+	"todo-app/ent/todoembedding"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// TodoEmbedding is the model entity for the TodoEmbedding schema.
+type TodoEmbedding struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// TodoID holds the value of the "todo_id" field.
+	TodoID int `json:"todo_id,omitempty"`
+	// Model holds the value of the "model" field.
+	Model string `json:"model,omitempty"`
+	// ContentHash holds the value of the "content_hash" field.
+	ContentHash string `json:"content_hash,omitempty"`
+	// Vector holds the value of the "vector" field.
+	Vector []float32 `json:"vector,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TodoEmbeddingQuery when eager-loading is set.
+	Edges        TodoEmbeddingEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TodoEmbeddingEdges holds the relations/edges for other nodes in the graph.
+type TodoEmbeddingEdges struct {
+	// Todo holds the value of the todo edge.
+	Todo *Todo `json:"todo,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TodoOrErr returns the Todo value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoEmbeddingEdges) TodoOrErr() (*Todo, error) {
+	if e.Todo != nil {
+		return e.Todo, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: todo.Label}
+	}
+	return nil, &NotLoadedError{edge: "todo"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TodoEmbedding) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case todoembedding.FieldVector:
+			values[i] = new([]byte)
+		case todoembedding.FieldTodoID:
+			values[i] = new(sql.NullInt64)
+		case todoembedding.FieldModel, todoembedding.FieldContentHash:
+			values[i] = new(sql.NullString)
+		case todoembedding.FieldCreatedAt, todoembedding.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case todoembedding.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TodoEmbedding fields.
+func (_m *TodoEmbedding) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case todoembedding.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case todoembedding.FieldTodoID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field todo_id", values[i])
+			} else if value.Valid {
+				_m.TodoID = int(value.Int64)
+			}
+		case todoembedding.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				_m.Model = value.String
+			}
+		case todoembedding.FieldContentHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_hash", values[i])
+			} else if value.Valid {
+				_m.ContentHash = value.String
+			}
+		case todoembedding.FieldVector:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field vector", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Vector); err != nil {
+					return fmt.Errorf("unmarshal field vector: %w", err)
+				}
+			}
+		case todoembedding.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case todoembedding.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TodoEmbedding.
+// This includes values selected through modifiers, order, etc.
+func (_m *TodoEmbedding) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTodo queries the "todo" edge of the TodoEmbedding entity.
+func (_m *TodoEmbedding) QueryTodo() *TodoQuery {
+	return NewTodoEmbeddingClient(_m.config).QueryTodo(_m)
+}
+
+// Update returns a builder for updating this TodoEmbedding.
+// Note that you need to call TodoEmbedding.Unwrap() before calling this method if this TodoEmbedding
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TodoEmbedding) Update() *TodoEmbeddingUpdateOne {
+	return NewTodoEmbeddingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TodoEmbedding entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TodoEmbedding) Unwrap() *TodoEmbedding {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TodoEmbedding is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TodoEmbedding) String() string {
+	var builder strings.Builder
+	builder.WriteString("TodoEmbedding(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("todo_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TodoID))
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(_m.Model)
+	builder.WriteString(", ")
+	builder.WriteString("content_hash=")
+	builder.WriteString(_m.ContentHash)
+	builder.WriteString(", ")
+	builder.WriteString("vector=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Vector))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TodoEmbeddings is a parsable slice of TodoEmbedding.
+type TodoEmbeddings []*TodoEmbedding
@@ -0,0 +1,335 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo-app/ent/todo"
+	"todo-app/ent/todoembedding"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TodoEmbeddingCreate is the builder for creating a TodoEmbedding entity.
+type TodoEmbeddingCreate struct {
+	config
+	mutation *TodoEmbeddingMutation
+	hooks    []Hook
+}
+
+// SetTodoID sets the "todo_id" field.
+func (_c *TodoEmbeddingCreate) SetTodoID(v int) *TodoEmbeddingCreate {
+	_c.mutation.SetTodoID(v)
+	return _c
+}
+
+// SetModel sets the "model" field.
+func (_c *TodoEmbeddingCreate) SetModel(v string) *TodoEmbeddingCreate {
+	_c.mutation.SetModel(v)
+	return _c
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_c *TodoEmbeddingCreate) SetContentHash(v string) *TodoEmbeddingCreate {
+	_c.mutation.SetContentHash(v)
+	return _c
+}
+
+// SetVector sets the "vector" field.
+func (_c *TodoEmbeddingCreate) SetVector(v []float32) *TodoEmbeddingCreate {
+	_c.mutation.SetVector(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TodoEmbeddingCreate) SetCreatedAt(v time.Time) *TodoEmbeddingCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TodoEmbeddingCreate) SetNillableCreatedAt(v *time.Time) *TodoEmbeddingCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TodoEmbeddingCreate) SetUpdatedAt(v time.Time) *TodoEmbeddingCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TodoEmbeddingCreate) SetNillableUpdatedAt(v *time.Time) *TodoEmbeddingCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TodoEmbeddingCreate) SetID(v uuid.UUID) *TodoEmbeddingCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TodoEmbeddingCreate) SetNillableID(v *uuid.UUID) *TodoEmbeddingCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTodo sets the "todo" edge to the Todo entity.
+func (_c *TodoEmbeddingCreate) SetTodo(v *Todo) *TodoEmbeddingCreate {
+	return _c.SetTodoID(v.ID)
+}
+
+// Mutation returns the TodoEmbeddingMutation object of the builder.
+func (_c *TodoEmbeddingCreate) Mutation() *TodoEmbeddingMutation {
+	return _c.mutation
+}
+
+// Save creates the TodoEmbedding in the database.
+func (_c *TodoEmbeddingCreate) Save(ctx context.Context) (*TodoEmbedding, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TodoEmbeddingCreate) SaveX(ctx context.Context) *TodoEmbedding {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TodoEmbeddingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TodoEmbeddingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TodoEmbeddingCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := todoembedding.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := todoembedding.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := todoembedding.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TodoEmbeddingCreate) check() error {
+	if _, ok := _c.mutation.TodoID(); !ok {
+		return &ValidationError{Name: "todo_id", err: errors.New(`ent: missing required field "TodoEmbedding.todo_id"`)}
+	}
+	if _, ok := _c.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "TodoEmbedding.model"`)}
+	}
+	if v, ok := _c.mutation.Model(); ok {
+		if err := todoembedding.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "TodoEmbedding.model": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ContentHash(); !ok {
+		return &ValidationError{Name: "content_hash", err: errors.New(`ent: missing required field "TodoEmbedding.content_hash"`)}
+	}
+	if v, ok := _c.mutation.ContentHash(); ok {
+		if err := todoembedding.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "TodoEmbedding.content_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Vector(); !ok {
+		return &ValidationError{Name: "vector", err: errors.New(`ent: missing required field "TodoEmbedding.vector"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TodoEmbedding.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TodoEmbedding.updated_at"`)}
+	}
+	if len(_c.mutation.TodoIDs()) == 0 {
+		return &ValidationError{Name: "todo", err: errors.New(`ent: missing required edge "TodoEmbedding.todo"`)}
+	}
+	return nil
+}
+
+func (_c *TodoEmbeddingCreate) sqlSave(ctx context.Context) (*TodoEmbedding, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TodoEmbeddingCreate) createSpec() (*TodoEmbedding, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TodoEmbedding{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(todoembedding.Table, sqlgraph.NewFieldSpec(todoembedding.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Model(); ok {
+		_spec.SetField(todoembedding.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := _c.mutation.ContentHash(); ok {
+		_spec.SetField(todoembedding.FieldContentHash, field.TypeString, value)
+		_node.ContentHash = value
+	}
+	if value, ok := _c.mutation.Vector(); ok {
+		_spec.SetField(todoembedding.FieldVector, field.TypeJSON, value)
+		_node.Vector = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(todoembedding.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(todoembedding.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.TodoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   todoembedding.TodoTable,
+			Columns: []string{todoembedding.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TodoID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TodoEmbeddingCreateBulk is the builder for creating many TodoEmbedding entities in bulk.
+type TodoEmbeddingCreateBulk struct {
+	config
+	err      error
+	builders []*TodoEmbeddingCreate
+}
+
+// Save creates the TodoEmbedding entities in the database.
+func (_c *TodoEmbeddingCreateBulk) Save(ctx context.Context) ([]*TodoEmbedding, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TodoEmbedding, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TodoEmbeddingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TodoEmbeddingCreateBulk) SaveX(ctx context.Context) []*TodoEmbedding {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TodoEmbeddingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TodoEmbeddingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
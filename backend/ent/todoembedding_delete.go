@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"todo-app/ent/predicate"
+	"todo-app/ent/todoembedding"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TodoEmbeddingDelete is the builder for deleting a TodoEmbedding entity.
+type TodoEmbeddingDelete struct {
+	config
+	hooks    []Hook
+	mutation *TodoEmbeddingMutation
+}
+
+// Where appends a list predicates to the TodoEmbeddingDelete builder.
+func (_d *TodoEmbeddingDelete) Where(ps ...predicate.TodoEmbedding) *TodoEmbeddingDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *TodoEmbeddingDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *TodoEmbeddingDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *TodoEmbeddingDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(todoembedding.Table, sqlgraph.NewFieldSpec(todoembedding.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// TodoEmbeddingDeleteOne is the builder for deleting a single TodoEmbedding entity.
+type TodoEmbeddingDeleteOne struct {
+	_d *TodoEmbeddingDelete
+}
+
+// Where appends a list predicates to the TodoEmbeddingDelete builder.
+func (_d *TodoEmbeddingDeleteOne) Where(ps ...predicate.TodoEmbedding) *TodoEmbeddingDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *TodoEmbeddingDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{todoembedding.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *TodoEmbeddingDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,472 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo-app/ent/predicate"
+	"todo-app/ent/todo"
+	"todo-app/ent/todoembedding"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// TodoEmbeddingUpdate is the builder for updating TodoEmbedding entities.
+type TodoEmbeddingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TodoEmbeddingMutation
+}
+
+// Where appends a list predicates to the TodoEmbeddingUpdate builder.
+func (_u *TodoEmbeddingUpdate) Where(ps ...predicate.TodoEmbedding) *TodoEmbeddingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTodoID sets the "todo_id" field.
+func (_u *TodoEmbeddingUpdate) SetTodoID(v int) *TodoEmbeddingUpdate {
+	_u.mutation.SetTodoID(v)
+	return _u
+}
+
+// SetNillableTodoID sets the "todo_id" field if the given value is not nil.
+func (_u *TodoEmbeddingUpdate) SetNillableTodoID(v *int) *TodoEmbeddingUpdate {
+	if v != nil {
+		_u.SetTodoID(*v)
+	}
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *TodoEmbeddingUpdate) SetModel(v string) *TodoEmbeddingUpdate {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *TodoEmbeddingUpdate) SetNillableModel(v *string) *TodoEmbeddingUpdate {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_u *TodoEmbeddingUpdate) SetContentHash(v string) *TodoEmbeddingUpdate {
+	_u.mutation.SetContentHash(v)
+	return _u
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_u *TodoEmbeddingUpdate) SetNillableContentHash(v *string) *TodoEmbeddingUpdate {
+	if v != nil {
+		_u.SetContentHash(*v)
+	}
+	return _u
+}
+
+// SetVector sets the "vector" field.
+func (_u *TodoEmbeddingUpdate) SetVector(v []float32) *TodoEmbeddingUpdate {
+	_u.mutation.SetVector(v)
+	return _u
+}
+
+// AppendVector appends value to the "vector" field.
+func (_u *TodoEmbeddingUpdate) AppendVector(v []float32) *TodoEmbeddingUpdate {
+	_u.mutation.AppendVector(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TodoEmbeddingUpdate) SetUpdatedAt(v time.Time) *TodoEmbeddingUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTodo sets the "todo" edge to the Todo entity.
+func (_u *TodoEmbeddingUpdate) SetTodo(v *Todo) *TodoEmbeddingUpdate {
+	return _u.SetTodoID(v.ID)
+}
+
+// Mutation returns the TodoEmbeddingMutation object of the builder.
+func (_u *TodoEmbeddingUpdate) Mutation() *TodoEmbeddingMutation {
+	return _u.mutation
+}
+
+// ClearTodo clears the "todo" edge to the Todo entity.
+func (_u *TodoEmbeddingUpdate) ClearTodo() *TodoEmbeddingUpdate {
+	_u.mutation.ClearTodo()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TodoEmbeddingUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TodoEmbeddingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TodoEmbeddingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TodoEmbeddingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TodoEmbeddingUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := todoembedding.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TodoEmbeddingUpdate) check() error {
+	if v, ok := _u.mutation.Model(); ok {
+		if err := todoembedding.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "TodoEmbedding.model": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentHash(); ok {
+		if err := todoembedding.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "TodoEmbedding.content_hash": %w`, err)}
+		}
+	}
+	if _u.mutation.TodoCleared() && len(_u.mutation.TodoIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoEmbedding.todo"`)
+	}
+	return nil
+}
+
+func (_u *TodoEmbeddingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(todoembedding.Table, todoembedding.Columns, sqlgraph.NewFieldSpec(todoembedding.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(todoembedding.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentHash(); ok {
+		_spec.SetField(todoembedding.FieldContentHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Vector(); ok {
+		_spec.SetField(todoembedding.FieldVector, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVector(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, todoembedding.FieldVector, value)
+		})
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(todoembedding.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TodoCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   todoembedding.TodoTable,
+			Columns: []string{todoembedding.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TodoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   todoembedding.TodoTable,
+			Columns: []string{todoembedding.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todoembedding.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TodoEmbeddingUpdateOne is the builder for updating a single TodoEmbedding entity.
+type TodoEmbeddingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TodoEmbeddingMutation
+}
+
+// SetTodoID sets the "todo_id" field.
+func (_u *TodoEmbeddingUpdateOne) SetTodoID(v int) *TodoEmbeddingUpdateOne {
+	_u.mutation.SetTodoID(v)
+	return _u
+}
+
+// SetNillableTodoID sets the "todo_id" field if the given value is not nil.
+func (_u *TodoEmbeddingUpdateOne) SetNillableTodoID(v *int) *TodoEmbeddingUpdateOne {
+	if v != nil {
+		_u.SetTodoID(*v)
+	}
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *TodoEmbeddingUpdateOne) SetModel(v string) *TodoEmbeddingUpdateOne {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *TodoEmbeddingUpdateOne) SetNillableModel(v *string) *TodoEmbeddingUpdateOne {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_u *TodoEmbeddingUpdateOne) SetContentHash(v string) *TodoEmbeddingUpdateOne {
+	_u.mutation.SetContentHash(v)
+	return _u
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_u *TodoEmbeddingUpdateOne) SetNillableContentHash(v *string) *TodoEmbeddingUpdateOne {
+	if v != nil {
+		_u.SetContentHash(*v)
+	}
+	return _u
+}
+
+// SetVector sets the "vector" field.
+func (_u *TodoEmbeddingUpdateOne) SetVector(v []float32) *TodoEmbeddingUpdateOne {
+	_u.mutation.SetVector(v)
+	return _u
+}
+
+// AppendVector appends value to the "vector" field.
+func (_u *TodoEmbeddingUpdateOne) AppendVector(v []float32) *TodoEmbeddingUpdateOne {
+	_u.mutation.AppendVector(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TodoEmbeddingUpdateOne) SetUpdatedAt(v time.Time) *TodoEmbeddingUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTodo sets the "todo" edge to the Todo entity.
+func (_u *TodoEmbeddingUpdateOne) SetTodo(v *Todo) *TodoEmbeddingUpdateOne {
+	return _u.SetTodoID(v.ID)
+}
+
+// Mutation returns the TodoEmbeddingMutation object of the builder.
+func (_u *TodoEmbeddingUpdateOne) Mutation() *TodoEmbeddingMutation {
+	return _u.mutation
+}
+
+// ClearTodo clears the "todo" edge to the Todo entity.
+func (_u *TodoEmbeddingUpdateOne) ClearTodo() *TodoEmbeddingUpdateOne {
+	_u.mutation.ClearTodo()
+	return _u
+}
+
+// Where appends a list predicates to the TodoEmbeddingUpdate builder.
+func (_u *TodoEmbeddingUpdateOne) Where(ps ...predicate.TodoEmbedding) *TodoEmbeddingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TodoEmbeddingUpdateOne) Select(field string, fields ...string) *TodoEmbeddingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TodoEmbedding entity.
+func (_u *TodoEmbeddingUpdateOne) Save(ctx context.Context) (*TodoEmbedding, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TodoEmbeddingUpdateOne) SaveX(ctx context.Context) *TodoEmbedding {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TodoEmbeddingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TodoEmbeddingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TodoEmbeddingUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := todoembedding.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TodoEmbeddingUpdateOne) check() error {
+	if v, ok := _u.mutation.Model(); ok {
+		if err := todoembedding.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "TodoEmbedding.model": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentHash(); ok {
+		if err := todoembedding.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "TodoEmbedding.content_hash": %w`, err)}
+		}
+	}
+	if _u.mutation.TodoCleared() && len(_u.mutation.TodoIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoEmbedding.todo"`)
+	}
+	return nil
+}
+
+func (_u *TodoEmbeddingUpdateOne) sqlSave(ctx context.Context) (_node *TodoEmbedding, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(todoembedding.Table, todoembedding.Columns, sqlgraph.NewFieldSpec(todoembedding.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TodoEmbedding.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, todoembedding.FieldID)
+		for _, f := range fields {
+			if !todoembedding.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != todoembedding.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(todoembedding.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentHash(); ok {
+		_spec.SetField(todoembedding.FieldContentHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Vector(); ok {
+		_spec.SetField(todoembedding.FieldVector, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVector(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, todoembedding.FieldVector, value)
+		})
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(todoembedding.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TodoCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   todoembedding.TodoTable,
+			Columns: []string{todoembedding.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TodoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   todoembedding.TodoTable,
+			Columns: []string{todoembedding.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TodoEmbedding{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todoembedding.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
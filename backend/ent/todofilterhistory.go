@@ -9,6 +9,7 @@ import (
 	"time"
 	"todo-app/ent/todofilterhistory"
 	"todo-app/ent/user"
+	"todo-app/ent/workspace"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -22,8 +23,16 @@ type TodoFilterHistory struct {
 	ID uuid.UUID `json:"id,omitempty"`
 	// UserID holds the value of the "user_id" field.
 	UserID int `json:"user_id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID int `json:"workspace_id,omitempty"`
 	// Query holds the value of the "query" field.
 	Query string `json:"query,omitempty"`
+	// NormalizedQuery holds the value of the "normalized_query" field.
+	NormalizedQuery string `json:"normalized_query,omitempty"`
+	// DateBucket holds the value of the "date_bucket" field.
+	DateBucket string `json:"date_bucket,omitempty"`
+	// PromptVersion holds the value of the "prompt_version" field.
+	PromptVersion string `json:"prompt_version,omitempty"`
 	// FunctionName holds the value of the "function_name" field.
 	FunctionName string `json:"function_name,omitempty"`
 	// Args holds the value of the "args" field.
@@ -42,9 +51,11 @@ type TodoFilterHistory struct {
 type TodoFilterHistoryEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// Workspace holds the value of the workspace edge.
+	Workspace *Workspace `json:"workspace,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -58,6 +69,17 @@ func (e TodoFilterHistoryEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// WorkspaceOrErr returns the Workspace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoFilterHistoryEdges) WorkspaceOrErr() (*Workspace, error) {
+	if e.Workspace != nil {
+		return e.Workspace, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: workspace.Label}
+	}
+	return nil, &NotLoadedError{edge: "workspace"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*TodoFilterHistory) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -65,9 +87,9 @@ func (*TodoFilterHistory) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case todofilterhistory.FieldArgs, todofilterhistory.FieldResultTodoIds:
 			values[i] = new([]byte)
-		case todofilterhistory.FieldUserID:
+		case todofilterhistory.FieldUserID, todofilterhistory.FieldWorkspaceID:
 			values[i] = new(sql.NullInt64)
-		case todofilterhistory.FieldQuery, todofilterhistory.FieldFunctionName:
+		case todofilterhistory.FieldQuery, todofilterhistory.FieldNormalizedQuery, todofilterhistory.FieldDateBucket, todofilterhistory.FieldPromptVersion, todofilterhistory.FieldFunctionName:
 			values[i] = new(sql.NullString)
 		case todofilterhistory.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -100,12 +122,36 @@ func (_m *TodoFilterHistory) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				_m.UserID = int(value.Int64)
 			}
+		case todofilterhistory.FieldWorkspaceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value.Valid {
+				_m.WorkspaceID = int(value.Int64)
+			}
 		case todofilterhistory.FieldQuery:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field query", values[i])
 			} else if value.Valid {
 				_m.Query = value.String
 			}
+		case todofilterhistory.FieldNormalizedQuery:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field normalized_query", values[i])
+			} else if value.Valid {
+				_m.NormalizedQuery = value.String
+			}
+		case todofilterhistory.FieldDateBucket:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field date_bucket", values[i])
+			} else if value.Valid {
+				_m.DateBucket = value.String
+			}
+		case todofilterhistory.FieldPromptVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt_version", values[i])
+			} else if value.Valid {
+				_m.PromptVersion = value.String
+			}
 		case todofilterhistory.FieldFunctionName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field function_name", values[i])
@@ -152,6 +198,11 @@ func (_m *TodoFilterHistory) QueryUser() *UserQuery {
 	return NewTodoFilterHistoryClient(_m.config).QueryUser(_m)
 }
 
+// QueryWorkspace queries the "workspace" edge of the TodoFilterHistory entity.
+func (_m *TodoFilterHistory) QueryWorkspace() *WorkspaceQuery {
+	return NewTodoFilterHistoryClient(_m.config).QueryWorkspace(_m)
+}
+
 // Update returns a builder for updating this TodoFilterHistory.
 // Note that you need to call TodoFilterHistory.Unwrap() before calling this method if this TodoFilterHistory
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -178,9 +229,21 @@ func (_m *TodoFilterHistory) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
 	builder.WriteString(", ")
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
 	builder.WriteString("query=")
 	builder.WriteString(_m.Query)
 	builder.WriteString(", ")
+	builder.WriteString("normalized_query=")
+	builder.WriteString(_m.NormalizedQuery)
+	builder.WriteString(", ")
+	builder.WriteString("date_bucket=")
+	builder.WriteString(_m.DateBucket)
+	builder.WriteString(", ")
+	builder.WriteString("prompt_version=")
+	builder.WriteString(_m.PromptVersion)
+	builder.WriteString(", ")
 	builder.WriteString("function_name=")
 	builder.WriteString(_m.FunctionName)
 	builder.WriteString(", ")
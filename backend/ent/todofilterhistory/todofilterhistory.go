@@ -17,8 +17,16 @@ const (
 	FieldID = "id"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
+	// FieldWorkspaceID holds the string denoting the workspace_id field in the database.
+	FieldWorkspaceID = "workspace_id"
 	// FieldQuery holds the string denoting the query field in the database.
 	FieldQuery = "query"
+	// FieldNormalizedQuery holds the string denoting the normalized_query field in the database.
+	FieldNormalizedQuery = "normalized_query"
+	// FieldDateBucket holds the string denoting the date_bucket field in the database.
+	FieldDateBucket = "date_bucket"
+	// FieldPromptVersion holds the string denoting the prompt_version field in the database.
+	FieldPromptVersion = "prompt_version"
 	// FieldFunctionName holds the string denoting the function_name field in the database.
 	FieldFunctionName = "function_name"
 	// FieldArgs holds the string denoting the args field in the database.
@@ -29,6 +37,8 @@ const (
 	FieldCreatedAt = "created_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
+	// EdgeWorkspace holds the string denoting the workspace edge name in mutations.
+	EdgeWorkspace = "workspace"
 	// Table holds the table name of the todofilterhistory in the database.
 	Table = "todo_filter_histories"
 	// UserTable is the table that holds the user relation/edge.
@@ -38,13 +48,24 @@ const (
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
 	UserColumn = "user_id"
+	// WorkspaceTable is the table that holds the workspace relation/edge.
+	WorkspaceTable = "todo_filter_histories"
+	// WorkspaceInverseTable is the table name for the Workspace entity.
+	// It exists in this package in order to avoid circular dependency with the "workspace" package.
+	WorkspaceInverseTable = "workspaces"
+	// WorkspaceColumn is the table column denoting the workspace relation/edge.
+	WorkspaceColumn = "workspace_id"
 )
 
 // Columns holds all SQL columns for todofilterhistory fields.
 var Columns = []string{
 	FieldID,
 	FieldUserID,
+	FieldWorkspaceID,
 	FieldQuery,
+	FieldNormalizedQuery,
+	FieldDateBucket,
+	FieldPromptVersion,
 	FieldFunctionName,
 	FieldArgs,
 	FieldResultTodoIds,
@@ -64,6 +85,12 @@ func ValidColumn(column string) bool {
 var (
 	// QueryValidator is a validator for the "query" field. It is called by the builders before save.
 	QueryValidator func(string) error
+	// NormalizedQueryValidator is a validator for the "normalized_query" field. It is called by the builders before save.
+	NormalizedQueryValidator func(string) error
+	// DateBucketValidator is a validator for the "date_bucket" field. It is called by the builders before save.
+	DateBucketValidator func(string) error
+	// PromptVersionValidator is a validator for the "prompt_version" field. It is called by the builders before save.
+	PromptVersionValidator func(string) error
 	// FunctionNameValidator is a validator for the "function_name" field. It is called by the builders before save.
 	FunctionNameValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -85,11 +112,31 @@ func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
 }
 
+// ByWorkspaceID orders the results by the workspace_id field.
+func ByWorkspaceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkspaceID, opts...).ToFunc()
+}
+
 // ByQuery orders the results by the query field.
 func ByQuery(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldQuery, opts...).ToFunc()
 }
 
+// ByNormalizedQuery orders the results by the normalized_query field.
+func ByNormalizedQuery(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNormalizedQuery, opts...).ToFunc()
+}
+
+// ByDateBucket orders the results by the date_bucket field.
+func ByDateBucket(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDateBucket, opts...).ToFunc()
+}
+
+// ByPromptVersion orders the results by the prompt_version field.
+func ByPromptVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPromptVersion, opts...).ToFunc()
+}
+
 // ByFunctionName orders the results by the function_name field.
 func ByFunctionName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFunctionName, opts...).ToFunc()
@@ -106,6 +153,13 @@ func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByWorkspaceField orders the results by workspace field.
+func ByWorkspaceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWorkspaceStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -113,3 +167,10 @@ func newUserStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
 	)
 }
+func newWorkspaceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WorkspaceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, WorkspaceTable, WorkspaceColumn),
+	)
+}
@@ -61,11 +61,31 @@ func UserID(v int) predicate.TodoFilterHistory {
 	return predicate.TodoFilterHistory(sql.FieldEQ(FieldUserID, v))
 }
 
+// WorkspaceID applies equality check predicate on the "workspace_id" field. It's identical to WorkspaceIDEQ.
+func WorkspaceID(v int) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
 // Query applies equality check predicate on the "query" field. It's identical to QueryEQ.
 func Query(v string) predicate.TodoFilterHistory {
 	return predicate.TodoFilterHistory(sql.FieldEQ(FieldQuery, v))
 }
 
+// NormalizedQuery applies equality check predicate on the "normalized_query" field. It's identical to NormalizedQueryEQ.
+func NormalizedQuery(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldEQ(FieldNormalizedQuery, v))
+}
+
+// DateBucket applies equality check predicate on the "date_bucket" field. It's identical to DateBucketEQ.
+func DateBucket(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldEQ(FieldDateBucket, v))
+}
+
+// PromptVersion applies equality check predicate on the "prompt_version" field. It's identical to PromptVersionEQ.
+func PromptVersion(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldEQ(FieldPromptVersion, v))
+}
+
 // FunctionName applies equality check predicate on the "function_name" field. It's identical to FunctionNameEQ.
 func FunctionName(v string) predicate.TodoFilterHistory {
 	return predicate.TodoFilterHistory(sql.FieldEQ(FieldFunctionName, v))
@@ -96,6 +116,26 @@ func UserIDNotIn(vs ...int) predicate.TodoFilterHistory {
 	return predicate.TodoFilterHistory(sql.FieldNotIn(FieldUserID, vs...))
 }
 
+// WorkspaceIDEQ applies the EQ predicate on the "workspace_id" field.
+func WorkspaceIDEQ(v int) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDNEQ applies the NEQ predicate on the "workspace_id" field.
+func WorkspaceIDNEQ(v int) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldNEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDIn applies the In predicate on the "workspace_id" field.
+func WorkspaceIDIn(vs ...int) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDNotIn applies the NotIn predicate on the "workspace_id" field.
+func WorkspaceIDNotIn(vs ...int) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldNotIn(FieldWorkspaceID, vs...))
+}
+
 // QueryEQ applies the EQ predicate on the "query" field.
 func QueryEQ(v string) predicate.TodoFilterHistory {
 	return predicate.TodoFilterHistory(sql.FieldEQ(FieldQuery, v))
@@ -161,6 +201,231 @@ func QueryContainsFold(v string) predicate.TodoFilterHistory {
 	return predicate.TodoFilterHistory(sql.FieldContainsFold(FieldQuery, v))
 }
 
+// NormalizedQueryEQ applies the EQ predicate on the "normalized_query" field.
+func NormalizedQueryEQ(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldEQ(FieldNormalizedQuery, v))
+}
+
+// NormalizedQueryNEQ applies the NEQ predicate on the "normalized_query" field.
+func NormalizedQueryNEQ(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldNEQ(FieldNormalizedQuery, v))
+}
+
+// NormalizedQueryIn applies the In predicate on the "normalized_query" field.
+func NormalizedQueryIn(vs ...string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldIn(FieldNormalizedQuery, vs...))
+}
+
+// NormalizedQueryNotIn applies the NotIn predicate on the "normalized_query" field.
+func NormalizedQueryNotIn(vs ...string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldNotIn(FieldNormalizedQuery, vs...))
+}
+
+// NormalizedQueryGT applies the GT predicate on the "normalized_query" field.
+func NormalizedQueryGT(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldGT(FieldNormalizedQuery, v))
+}
+
+// NormalizedQueryGTE applies the GTE predicate on the "normalized_query" field.
+func NormalizedQueryGTE(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldGTE(FieldNormalizedQuery, v))
+}
+
+// NormalizedQueryLT applies the LT predicate on the "normalized_query" field.
+func NormalizedQueryLT(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldLT(FieldNormalizedQuery, v))
+}
+
+// NormalizedQueryLTE applies the LTE predicate on the "normalized_query" field.
+func NormalizedQueryLTE(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldLTE(FieldNormalizedQuery, v))
+}
+
+// NormalizedQueryContains applies the Contains predicate on the "normalized_query" field.
+func NormalizedQueryContains(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldContains(FieldNormalizedQuery, v))
+}
+
+// NormalizedQueryHasPrefix applies the HasPrefix predicate on the "normalized_query" field.
+func NormalizedQueryHasPrefix(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldHasPrefix(FieldNormalizedQuery, v))
+}
+
+// NormalizedQueryHasSuffix applies the HasSuffix predicate on the "normalized_query" field.
+func NormalizedQueryHasSuffix(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldHasSuffix(FieldNormalizedQuery, v))
+}
+
+// NormalizedQueryIsNil applies the IsNil predicate on the "normalized_query" field.
+func NormalizedQueryIsNil() predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldIsNull(FieldNormalizedQuery))
+}
+
+// NormalizedQueryNotNil applies the NotNil predicate on the "normalized_query" field.
+func NormalizedQueryNotNil() predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldNotNull(FieldNormalizedQuery))
+}
+
+// NormalizedQueryEqualFold applies the EqualFold predicate on the "normalized_query" field.
+func NormalizedQueryEqualFold(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldEqualFold(FieldNormalizedQuery, v))
+}
+
+// NormalizedQueryContainsFold applies the ContainsFold predicate on the "normalized_query" field.
+func NormalizedQueryContainsFold(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldContainsFold(FieldNormalizedQuery, v))
+}
+
+// DateBucketEQ applies the EQ predicate on the "date_bucket" field.
+func DateBucketEQ(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldEQ(FieldDateBucket, v))
+}
+
+// DateBucketNEQ applies the NEQ predicate on the "date_bucket" field.
+func DateBucketNEQ(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldNEQ(FieldDateBucket, v))
+}
+
+// DateBucketIn applies the In predicate on the "date_bucket" field.
+func DateBucketIn(vs ...string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldIn(FieldDateBucket, vs...))
+}
+
+// DateBucketNotIn applies the NotIn predicate on the "date_bucket" field.
+func DateBucketNotIn(vs ...string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldNotIn(FieldDateBucket, vs...))
+}
+
+// DateBucketGT applies the GT predicate on the "date_bucket" field.
+func DateBucketGT(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldGT(FieldDateBucket, v))
+}
+
+// DateBucketGTE applies the GTE predicate on the "date_bucket" field.
+func DateBucketGTE(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldGTE(FieldDateBucket, v))
+}
+
+// DateBucketLT applies the LT predicate on the "date_bucket" field.
+func DateBucketLT(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldLT(FieldDateBucket, v))
+}
+
+// DateBucketLTE applies the LTE predicate on the "date_bucket" field.
+func DateBucketLTE(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldLTE(FieldDateBucket, v))
+}
+
+// DateBucketContains applies the Contains predicate on the "date_bucket" field.
+func DateBucketContains(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldContains(FieldDateBucket, v))
+}
+
+// DateBucketHasPrefix applies the HasPrefix predicate on the "date_bucket" field.
+func DateBucketHasPrefix(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldHasPrefix(FieldDateBucket, v))
+}
+
+// DateBucketHasSuffix applies the HasSuffix predicate on the "date_bucket" field.
+func DateBucketHasSuffix(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldHasSuffix(FieldDateBucket, v))
+}
+
+// DateBucketIsNil applies the IsNil predicate on the "date_bucket" field.
+func DateBucketIsNil() predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldIsNull(FieldDateBucket))
+}
+
+// DateBucketNotNil applies the NotNil predicate on the "date_bucket" field.
+func DateBucketNotNil() predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldNotNull(FieldDateBucket))
+}
+
+// DateBucketEqualFold applies the EqualFold predicate on the "date_bucket" field.
+func DateBucketEqualFold(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldEqualFold(FieldDateBucket, v))
+}
+
+// DateBucketContainsFold applies the ContainsFold predicate on the "date_bucket" field.
+func DateBucketContainsFold(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldContainsFold(FieldDateBucket, v))
+}
+
+// PromptVersionEQ applies the EQ predicate on the "prompt_version" field.
+func PromptVersionEQ(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldEQ(FieldPromptVersion, v))
+}
+
+// PromptVersionNEQ applies the NEQ predicate on the "prompt_version" field.
+func PromptVersionNEQ(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldNEQ(FieldPromptVersion, v))
+}
+
+// PromptVersionIn applies the In predicate on the "prompt_version" field.
+func PromptVersionIn(vs ...string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldIn(FieldPromptVersion, vs...))
+}
+
+// PromptVersionNotIn applies the NotIn predicate on the "prompt_version" field.
+func PromptVersionNotIn(vs ...string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldNotIn(FieldPromptVersion, vs...))
+}
+
+// PromptVersionGT applies the GT predicate on the "prompt_version" field.
+func PromptVersionGT(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldGT(FieldPromptVersion, v))
+}
+
+// PromptVersionGTE applies the GTE predicate on the "prompt_version" field.
+func PromptVersionGTE(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldGTE(FieldPromptVersion, v))
+}
+
+// PromptVersionLT applies the LT predicate on the "prompt_version" field.
+func PromptVersionLT(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldLT(FieldPromptVersion, v))
+}
+
+// PromptVersionLTE applies the LTE predicate on the "prompt_version" field.
+func PromptVersionLTE(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldLTE(FieldPromptVersion, v))
+}
+
+// PromptVersionContains applies the Contains predicate on the "prompt_version" field.
+func PromptVersionContains(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldContains(FieldPromptVersion, v))
+}
+
+// PromptVersionHasPrefix applies the HasPrefix predicate on the "prompt_version" field.
+func PromptVersionHasPrefix(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldHasPrefix(FieldPromptVersion, v))
+}
+
+// PromptVersionHasSuffix applies the HasSuffix predicate on the "prompt_version" field.
+func PromptVersionHasSuffix(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldHasSuffix(FieldPromptVersion, v))
+}
+
+// PromptVersionIsNil applies the IsNil predicate on the "prompt_version" field.
+func PromptVersionIsNil() predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldIsNull(FieldPromptVersion))
+}
+
+// PromptVersionNotNil applies the NotNil predicate on the "prompt_version" field.
+func PromptVersionNotNil() predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldNotNull(FieldPromptVersion))
+}
+
+// PromptVersionEqualFold applies the EqualFold predicate on the "prompt_version" field.
+func PromptVersionEqualFold(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldEqualFold(FieldPromptVersion, v))
+}
+
+// PromptVersionContainsFold applies the ContainsFold predicate on the "prompt_version" field.
+func PromptVersionContainsFold(v string) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(sql.FieldContainsFold(FieldPromptVersion, v))
+}
+
 // FunctionNameEQ applies the EQ predicate on the "function_name" field.
 func FunctionNameEQ(v string) predicate.TodoFilterHistory {
 	return predicate.TodoFilterHistory(sql.FieldEQ(FieldFunctionName, v))
@@ -319,6 +584,29 @@ func HasUserWith(preds ...predicate.User) predicate.TodoFilterHistory {
 	})
 }
 
+// HasWorkspace applies the HasEdge predicate on the "workspace" edge.
+func HasWorkspace() predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WorkspaceTable, WorkspaceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkspaceWith applies the HasEdge predicate on the "workspace" edge with a given conditions (other predicates).
+func HasWorkspaceWith(preds ...predicate.Workspace) predicate.TodoFilterHistory {
+	return predicate.TodoFilterHistory(func(s *sql.Selector) {
+		step := newWorkspaceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.TodoFilterHistory) predicate.TodoFilterHistory {
 	return predicate.TodoFilterHistory(sql.AndPredicates(predicates...))
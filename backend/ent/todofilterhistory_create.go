@@ -9,6 +9,7 @@ import (
 	"time"
 	"todo-app/ent/todofilterhistory"
 	"todo-app/ent/user"
+	"todo-app/ent/workspace"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -28,12 +29,60 @@ func (_c *TodoFilterHistoryCreate) SetUserID(v int) *TodoFilterHistoryCreate {
 	return _c
 }
 
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *TodoFilterHistoryCreate) SetWorkspaceID(v int) *TodoFilterHistoryCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
 // SetQuery sets the "query" field.
 func (_c *TodoFilterHistoryCreate) SetQuery(v string) *TodoFilterHistoryCreate {
 	_c.mutation.SetQuery(v)
 	return _c
 }
 
+// SetNormalizedQuery sets the "normalized_query" field.
+func (_c *TodoFilterHistoryCreate) SetNormalizedQuery(v string) *TodoFilterHistoryCreate {
+	_c.mutation.SetNormalizedQuery(v)
+	return _c
+}
+
+// SetNillableNormalizedQuery sets the "normalized_query" field if the given value is not nil.
+func (_c *TodoFilterHistoryCreate) SetNillableNormalizedQuery(v *string) *TodoFilterHistoryCreate {
+	if v != nil {
+		_c.SetNormalizedQuery(*v)
+	}
+	return _c
+}
+
+// SetDateBucket sets the "date_bucket" field.
+func (_c *TodoFilterHistoryCreate) SetDateBucket(v string) *TodoFilterHistoryCreate {
+	_c.mutation.SetDateBucket(v)
+	return _c
+}
+
+// SetNillableDateBucket sets the "date_bucket" field if the given value is not nil.
+func (_c *TodoFilterHistoryCreate) SetNillableDateBucket(v *string) *TodoFilterHistoryCreate {
+	if v != nil {
+		_c.SetDateBucket(*v)
+	}
+	return _c
+}
+
+// SetPromptVersion sets the "prompt_version" field.
+func (_c *TodoFilterHistoryCreate) SetPromptVersion(v string) *TodoFilterHistoryCreate {
+	_c.mutation.SetPromptVersion(v)
+	return _c
+}
+
+// SetNillablePromptVersion sets the "prompt_version" field if the given value is not nil.
+func (_c *TodoFilterHistoryCreate) SetNillablePromptVersion(v *string) *TodoFilterHistoryCreate {
+	if v != nil {
+		_c.SetPromptVersion(*v)
+	}
+	return _c
+}
+
 // SetFunctionName sets the "function_name" field.
 func (_c *TodoFilterHistoryCreate) SetFunctionName(v string) *TodoFilterHistoryCreate {
 	_c.mutation.SetFunctionName(v)
@@ -93,6 +142,11 @@ func (_c *TodoFilterHistoryCreate) SetUser(v *User) *TodoFilterHistoryCreate {
 	return _c.SetUserID(v.ID)
 }
 
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (_c *TodoFilterHistoryCreate) SetWorkspace(v *Workspace) *TodoFilterHistoryCreate {
+	return _c.SetWorkspaceID(v.ID)
+}
+
 // Mutation returns the TodoFilterHistoryMutation object of the builder.
 func (_c *TodoFilterHistoryCreate) Mutation() *TodoFilterHistoryMutation {
 	return _c.mutation
@@ -143,6 +197,9 @@ func (_c *TodoFilterHistoryCreate) check() error {
 	if _, ok := _c.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "TodoFilterHistory.user_id"`)}
 	}
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "TodoFilterHistory.workspace_id"`)}
+	}
 	if _, ok := _c.mutation.Query(); !ok {
 		return &ValidationError{Name: "query", err: errors.New(`ent: missing required field "TodoFilterHistory.query"`)}
 	}
@@ -151,6 +208,21 @@ func (_c *TodoFilterHistoryCreate) check() error {
 			return &ValidationError{Name: "query", err: fmt.Errorf(`ent: validator failed for field "TodoFilterHistory.query": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.NormalizedQuery(); ok {
+		if err := todofilterhistory.NormalizedQueryValidator(v); err != nil {
+			return &ValidationError{Name: "normalized_query", err: fmt.Errorf(`ent: validator failed for field "TodoFilterHistory.normalized_query": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DateBucket(); ok {
+		if err := todofilterhistory.DateBucketValidator(v); err != nil {
+			return &ValidationError{Name: "date_bucket", err: fmt.Errorf(`ent: validator failed for field "TodoFilterHistory.date_bucket": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.PromptVersion(); ok {
+		if err := todofilterhistory.PromptVersionValidator(v); err != nil {
+			return &ValidationError{Name: "prompt_version", err: fmt.Errorf(`ent: validator failed for field "TodoFilterHistory.prompt_version": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.FunctionName(); ok {
 		if err := todofilterhistory.FunctionNameValidator(v); err != nil {
 			return &ValidationError{Name: "function_name", err: fmt.Errorf(`ent: validator failed for field "TodoFilterHistory.function_name": %w`, err)}
@@ -162,6 +234,9 @@ func (_c *TodoFilterHistoryCreate) check() error {
 	if len(_c.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "TodoFilterHistory.user"`)}
 	}
+	if len(_c.mutation.WorkspaceIDs()) == 0 {
+		return &ValidationError{Name: "workspace", err: errors.New(`ent: missing required edge "TodoFilterHistory.workspace"`)}
+	}
 	return nil
 }
 
@@ -201,6 +276,18 @@ func (_c *TodoFilterHistoryCreate) createSpec() (*TodoFilterHistory, *sqlgraph.C
 		_spec.SetField(todofilterhistory.FieldQuery, field.TypeString, value)
 		_node.Query = value
 	}
+	if value, ok := _c.mutation.NormalizedQuery(); ok {
+		_spec.SetField(todofilterhistory.FieldNormalizedQuery, field.TypeString, value)
+		_node.NormalizedQuery = value
+	}
+	if value, ok := _c.mutation.DateBucket(); ok {
+		_spec.SetField(todofilterhistory.FieldDateBucket, field.TypeString, value)
+		_node.DateBucket = value
+	}
+	if value, ok := _c.mutation.PromptVersion(); ok {
+		_spec.SetField(todofilterhistory.FieldPromptVersion, field.TypeString, value)
+		_node.PromptVersion = value
+	}
 	if value, ok := _c.mutation.FunctionName(); ok {
 		_spec.SetField(todofilterhistory.FieldFunctionName, field.TypeString, value)
 		_node.FunctionName = value
@@ -234,6 +321,23 @@ func (_c *TodoFilterHistoryCreate) createSpec() (*TodoFilterHistory, *sqlgraph.C
 		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todofilterhistory.WorkspaceTable,
+			Columns: []string{todofilterhistory.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkspaceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -9,6 +9,7 @@ import (
 	"todo-app/ent/predicate"
 	"todo-app/ent/todofilterhistory"
 	"todo-app/ent/user"
+	"todo-app/ent/workspace"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -21,12 +22,13 @@ import (
 // TodoFilterHistoryQuery is the builder for querying TodoFilterHistory entities.
 type TodoFilterHistoryQuery struct {
 	config
-	ctx        *QueryContext
-	order      []todofilterhistory.OrderOption
-	inters     []Interceptor
-	predicates []predicate.TodoFilterHistory
-	withUser   *UserQuery
-	modifiers  []func(*sql.Selector)
+	ctx           *QueryContext
+	order         []todofilterhistory.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.TodoFilterHistory
+	withUser      *UserQuery
+	withWorkspace *WorkspaceQuery
+	modifiers     []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -85,6 +87,28 @@ func (_q *TodoFilterHistoryQuery) QueryUser() *UserQuery {
 	return query
 }
 
+// QueryWorkspace chains the current query on the "workspace" edge.
+func (_q *TodoFilterHistoryQuery) QueryWorkspace() *WorkspaceQuery {
+	query := (&WorkspaceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todofilterhistory.Table, todofilterhistory.FieldID, selector),
+			sqlgraph.To(workspace.Table, workspace.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todofilterhistory.WorkspaceTable, todofilterhistory.WorkspaceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first TodoFilterHistory entity from the query.
 // Returns a *NotFoundError when no TodoFilterHistory was found.
 func (_q *TodoFilterHistoryQuery) First(ctx context.Context) (*TodoFilterHistory, error) {
@@ -272,12 +296,13 @@ func (_q *TodoFilterHistoryQuery) Clone() *TodoFilterHistoryQuery {
 		return nil
 	}
 	return &TodoFilterHistoryQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]todofilterhistory.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.TodoFilterHistory{}, _q.predicates...),
-		withUser:   _q.withUser.Clone(),
+		config:        _q.config,
+		ctx:           _q.ctx.Clone(),
+		order:         append([]todofilterhistory.OrderOption{}, _q.order...),
+		inters:        append([]Interceptor{}, _q.inters...),
+		predicates:    append([]predicate.TodoFilterHistory{}, _q.predicates...),
+		withUser:      _q.withUser.Clone(),
+		withWorkspace: _q.withWorkspace.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -295,6 +320,17 @@ func (_q *TodoFilterHistoryQuery) WithUser(opts ...func(*UserQuery)) *TodoFilter
 	return _q
 }
 
+// WithWorkspace tells the query-builder to eager-load the nodes that are connected to
+// the "workspace" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TodoFilterHistoryQuery) WithWorkspace(opts ...func(*WorkspaceQuery)) *TodoFilterHistoryQuery {
+	query := (&WorkspaceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWorkspace = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -373,8 +409,9 @@ func (_q *TodoFilterHistoryQuery) sqlAll(ctx context.Context, hooks ...queryHook
 	var (
 		nodes       = []*TodoFilterHistory{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withUser != nil,
+			_q.withWorkspace != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -404,6 +441,12 @@ func (_q *TodoFilterHistoryQuery) sqlAll(ctx context.Context, hooks ...queryHook
 			return nil, err
 		}
 	}
+	if query := _q.withWorkspace; query != nil {
+		if err := _q.loadWorkspace(ctx, query, nodes, nil,
+			func(n *TodoFilterHistory, e *Workspace) { n.Edges.Workspace = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -436,6 +479,35 @@ func (_q *TodoFilterHistoryQuery) loadUser(ctx context.Context, query *UserQuery
 	}
 	return nil
 }
+func (_q *TodoFilterHistoryQuery) loadWorkspace(ctx context.Context, query *WorkspaceQuery, nodes []*TodoFilterHistory, init func(*TodoFilterHistory), assign func(*TodoFilterHistory, *Workspace)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TodoFilterHistory)
+	for i := range nodes {
+		fk := nodes[i].WorkspaceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(workspace.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "workspace_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *TodoFilterHistoryQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -468,6 +540,9 @@ func (_q *TodoFilterHistoryQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withUser != nil {
 			_spec.Node.AddColumnOnce(todofilterhistory.FieldUserID)
 		}
+		if _q.withWorkspace != nil {
+			_spec.Node.AddColumnOnce(todofilterhistory.FieldWorkspaceID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
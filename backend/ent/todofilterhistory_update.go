@@ -57,6 +57,66 @@ func (_u *TodoFilterHistoryUpdate) SetNillableQuery(v *string) *TodoFilterHistor
 	return _u
 }
 
+// SetNormalizedQuery sets the "normalized_query" field.
+func (_u *TodoFilterHistoryUpdate) SetNormalizedQuery(v string) *TodoFilterHistoryUpdate {
+	_u.mutation.SetNormalizedQuery(v)
+	return _u
+}
+
+// SetNillableNormalizedQuery sets the "normalized_query" field if the given value is not nil.
+func (_u *TodoFilterHistoryUpdate) SetNillableNormalizedQuery(v *string) *TodoFilterHistoryUpdate {
+	if v != nil {
+		_u.SetNormalizedQuery(*v)
+	}
+	return _u
+}
+
+// ClearNormalizedQuery clears the value of the "normalized_query" field.
+func (_u *TodoFilterHistoryUpdate) ClearNormalizedQuery() *TodoFilterHistoryUpdate {
+	_u.mutation.ClearNormalizedQuery()
+	return _u
+}
+
+// SetDateBucket sets the "date_bucket" field.
+func (_u *TodoFilterHistoryUpdate) SetDateBucket(v string) *TodoFilterHistoryUpdate {
+	_u.mutation.SetDateBucket(v)
+	return _u
+}
+
+// SetNillableDateBucket sets the "date_bucket" field if the given value is not nil.
+func (_u *TodoFilterHistoryUpdate) SetNillableDateBucket(v *string) *TodoFilterHistoryUpdate {
+	if v != nil {
+		_u.SetDateBucket(*v)
+	}
+	return _u
+}
+
+// ClearDateBucket clears the value of the "date_bucket" field.
+func (_u *TodoFilterHistoryUpdate) ClearDateBucket() *TodoFilterHistoryUpdate {
+	_u.mutation.ClearDateBucket()
+	return _u
+}
+
+// SetPromptVersion sets the "prompt_version" field.
+func (_u *TodoFilterHistoryUpdate) SetPromptVersion(v string) *TodoFilterHistoryUpdate {
+	_u.mutation.SetPromptVersion(v)
+	return _u
+}
+
+// SetNillablePromptVersion sets the "prompt_version" field if the given value is not nil.
+func (_u *TodoFilterHistoryUpdate) SetNillablePromptVersion(v *string) *TodoFilterHistoryUpdate {
+	if v != nil {
+		_u.SetPromptVersion(*v)
+	}
+	return _u
+}
+
+// ClearPromptVersion clears the value of the "prompt_version" field.
+func (_u *TodoFilterHistoryUpdate) ClearPromptVersion() *TodoFilterHistoryUpdate {
+	_u.mutation.ClearPromptVersion()
+	return _u
+}
+
 // SetFunctionName sets the "function_name" field.
 func (_u *TodoFilterHistoryUpdate) SetFunctionName(v string) *TodoFilterHistoryUpdate {
 	_u.mutation.SetFunctionName(v)
@@ -157,6 +217,21 @@ func (_u *TodoFilterHistoryUpdate) check() error {
 			return &ValidationError{Name: "query", err: fmt.Errorf(`ent: validator failed for field "TodoFilterHistory.query": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.NormalizedQuery(); ok {
+		if err := todofilterhistory.NormalizedQueryValidator(v); err != nil {
+			return &ValidationError{Name: "normalized_query", err: fmt.Errorf(`ent: validator failed for field "TodoFilterHistory.normalized_query": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DateBucket(); ok {
+		if err := todofilterhistory.DateBucketValidator(v); err != nil {
+			return &ValidationError{Name: "date_bucket", err: fmt.Errorf(`ent: validator failed for field "TodoFilterHistory.date_bucket": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PromptVersion(); ok {
+		if err := todofilterhistory.PromptVersionValidator(v); err != nil {
+			return &ValidationError{Name: "prompt_version", err: fmt.Errorf(`ent: validator failed for field "TodoFilterHistory.prompt_version": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.FunctionName(); ok {
 		if err := todofilterhistory.FunctionNameValidator(v); err != nil {
 			return &ValidationError{Name: "function_name", err: fmt.Errorf(`ent: validator failed for field "TodoFilterHistory.function_name": %w`, err)}
@@ -165,6 +240,9 @@ func (_u *TodoFilterHistoryUpdate) check() error {
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "TodoFilterHistory.user"`)
 	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoFilterHistory.workspace"`)
+	}
 	return nil
 }
 
@@ -183,6 +261,24 @@ func (_u *TodoFilterHistoryUpdate) sqlSave(ctx context.Context) (_node int, err
 	if value, ok := _u.mutation.Query(); ok {
 		_spec.SetField(todofilterhistory.FieldQuery, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.NormalizedQuery(); ok {
+		_spec.SetField(todofilterhistory.FieldNormalizedQuery, field.TypeString, value)
+	}
+	if _u.mutation.NormalizedQueryCleared() {
+		_spec.ClearField(todofilterhistory.FieldNormalizedQuery, field.TypeString)
+	}
+	if value, ok := _u.mutation.DateBucket(); ok {
+		_spec.SetField(todofilterhistory.FieldDateBucket, field.TypeString, value)
+	}
+	if _u.mutation.DateBucketCleared() {
+		_spec.ClearField(todofilterhistory.FieldDateBucket, field.TypeString)
+	}
+	if value, ok := _u.mutation.PromptVersion(); ok {
+		_spec.SetField(todofilterhistory.FieldPromptVersion, field.TypeString, value)
+	}
+	if _u.mutation.PromptVersionCleared() {
+		_spec.ClearField(todofilterhistory.FieldPromptVersion, field.TypeString)
+	}
 	if value, ok := _u.mutation.FunctionName(); ok {
 		_spec.SetField(todofilterhistory.FieldFunctionName, field.TypeString, value)
 	}
@@ -283,6 +379,66 @@ func (_u *TodoFilterHistoryUpdateOne) SetNillableQuery(v *string) *TodoFilterHis
 	return _u
 }
 
+// SetNormalizedQuery sets the "normalized_query" field.
+func (_u *TodoFilterHistoryUpdateOne) SetNormalizedQuery(v string) *TodoFilterHistoryUpdateOne {
+	_u.mutation.SetNormalizedQuery(v)
+	return _u
+}
+
+// SetNillableNormalizedQuery sets the "normalized_query" field if the given value is not nil.
+func (_u *TodoFilterHistoryUpdateOne) SetNillableNormalizedQuery(v *string) *TodoFilterHistoryUpdateOne {
+	if v != nil {
+		_u.SetNormalizedQuery(*v)
+	}
+	return _u
+}
+
+// ClearNormalizedQuery clears the value of the "normalized_query" field.
+func (_u *TodoFilterHistoryUpdateOne) ClearNormalizedQuery() *TodoFilterHistoryUpdateOne {
+	_u.mutation.ClearNormalizedQuery()
+	return _u
+}
+
+// SetDateBucket sets the "date_bucket" field.
+func (_u *TodoFilterHistoryUpdateOne) SetDateBucket(v string) *TodoFilterHistoryUpdateOne {
+	_u.mutation.SetDateBucket(v)
+	return _u
+}
+
+// SetNillableDateBucket sets the "date_bucket" field if the given value is not nil.
+func (_u *TodoFilterHistoryUpdateOne) SetNillableDateBucket(v *string) *TodoFilterHistoryUpdateOne {
+	if v != nil {
+		_u.SetDateBucket(*v)
+	}
+	return _u
+}
+
+// ClearDateBucket clears the value of the "date_bucket" field.
+func (_u *TodoFilterHistoryUpdateOne) ClearDateBucket() *TodoFilterHistoryUpdateOne {
+	_u.mutation.ClearDateBucket()
+	return _u
+}
+
+// SetPromptVersion sets the "prompt_version" field.
+func (_u *TodoFilterHistoryUpdateOne) SetPromptVersion(v string) *TodoFilterHistoryUpdateOne {
+	_u.mutation.SetPromptVersion(v)
+	return _u
+}
+
+// SetNillablePromptVersion sets the "prompt_version" field if the given value is not nil.
+func (_u *TodoFilterHistoryUpdateOne) SetNillablePromptVersion(v *string) *TodoFilterHistoryUpdateOne {
+	if v != nil {
+		_u.SetPromptVersion(*v)
+	}
+	return _u
+}
+
+// ClearPromptVersion clears the value of the "prompt_version" field.
+func (_u *TodoFilterHistoryUpdateOne) ClearPromptVersion() *TodoFilterHistoryUpdateOne {
+	_u.mutation.ClearPromptVersion()
+	return _u
+}
+
 // SetFunctionName sets the "function_name" field.
 func (_u *TodoFilterHistoryUpdateOne) SetFunctionName(v string) *TodoFilterHistoryUpdateOne {
 	_u.mutation.SetFunctionName(v)
@@ -396,6 +552,21 @@ func (_u *TodoFilterHistoryUpdateOne) check() error {
 			return &ValidationError{Name: "query", err: fmt.Errorf(`ent: validator failed for field "TodoFilterHistory.query": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.NormalizedQuery(); ok {
+		if err := todofilterhistory.NormalizedQueryValidator(v); err != nil {
+			return &ValidationError{Name: "normalized_query", err: fmt.Errorf(`ent: validator failed for field "TodoFilterHistory.normalized_query": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DateBucket(); ok {
+		if err := todofilterhistory.DateBucketValidator(v); err != nil {
+			return &ValidationError{Name: "date_bucket", err: fmt.Errorf(`ent: validator failed for field "TodoFilterHistory.date_bucket": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PromptVersion(); ok {
+		if err := todofilterhistory.PromptVersionValidator(v); err != nil {
+			return &ValidationError{Name: "prompt_version", err: fmt.Errorf(`ent: validator failed for field "TodoFilterHistory.prompt_version": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.FunctionName(); ok {
 		if err := todofilterhistory.FunctionNameValidator(v); err != nil {
 			return &ValidationError{Name: "function_name", err: fmt.Errorf(`ent: validator failed for field "TodoFilterHistory.function_name": %w`, err)}
@@ -404,6 +575,9 @@ func (_u *TodoFilterHistoryUpdateOne) check() error {
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "TodoFilterHistory.user"`)
 	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoFilterHistory.workspace"`)
+	}
 	return nil
 }
 
@@ -439,6 +613,24 @@ func (_u *TodoFilterHistoryUpdateOne) sqlSave(ctx context.Context) (_node *TodoF
 	if value, ok := _u.mutation.Query(); ok {
 		_spec.SetField(todofilterhistory.FieldQuery, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.NormalizedQuery(); ok {
+		_spec.SetField(todofilterhistory.FieldNormalizedQuery, field.TypeString, value)
+	}
+	if _u.mutation.NormalizedQueryCleared() {
+		_spec.ClearField(todofilterhistory.FieldNormalizedQuery, field.TypeString)
+	}
+	if value, ok := _u.mutation.DateBucket(); ok {
+		_spec.SetField(todofilterhistory.FieldDateBucket, field.TypeString, value)
+	}
+	if _u.mutation.DateBucketCleared() {
+		_spec.ClearField(todofilterhistory.FieldDateBucket, field.TypeString)
+	}
+	if value, ok := _u.mutation.PromptVersion(); ok {
+		_spec.SetField(todofilterhistory.FieldPromptVersion, field.TypeString, value)
+	}
+	if _u.mutation.PromptVersionCleared() {
+		_spec.ClearField(todofilterhistory.FieldPromptVersion, field.TypeString)
+	}
 	if value, ok := _u.mutation.FunctionName(); ok {
 		_spec.SetField(todofilterhistory.FieldFunctionName, field.TypeString, value)
 	}
@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"todo-app/ent/todolist"
+	"todo-app/ent/workspace"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TodoList is the model entity for the TodoList schema.
+type TodoList struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID int `json:"workspace_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TodoListQuery when eager-loading is set.
+	Edges        TodoListEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TodoListEdges holds the relations/edges for other nodes in the graph.
+type TodoListEdges struct {
+	// Workspace holds the value of the workspace edge.
+	Workspace *Workspace `json:"workspace,omitempty"`
+	// Members holds the value of the members edge.
+	Members []*TodoListMember `json:"members,omitempty"`
+	// Invites holds the value of the invites edge.
+	Invites []*TodoListInvite `json:"invites,omitempty"`
+	// Todos holds the value of the todos edge.
+	Todos []*Todo `json:"todos,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// WorkspaceOrErr returns the Workspace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoListEdges) WorkspaceOrErr() (*Workspace, error) {
+	if e.Workspace != nil {
+		return e.Workspace, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: workspace.Label}
+	}
+	return nil, &NotLoadedError{edge: "workspace"}
+}
+
+// MembersOrErr returns the Members value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoListEdges) MembersOrErr() ([]*TodoListMember, error) {
+	if e.loadedTypes[1] {
+		return e.Members, nil
+	}
+	return nil, &NotLoadedError{edge: "members"}
+}
+
+// InvitesOrErr returns the Invites value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoListEdges) InvitesOrErr() ([]*TodoListInvite, error) {
+	if e.loadedTypes[2] {
+		return e.Invites, nil
+	}
+	return nil, &NotLoadedError{edge: "invites"}
+}
+
+// TodosOrErr returns the Todos value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoListEdges) TodosOrErr() ([]*Todo, error) {
+	if e.loadedTypes[3] {
+		return e.Todos, nil
+	}
+	return nil, &NotLoadedError{edge: "todos"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TodoList) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case todolist.FieldID, todolist.FieldWorkspaceID:
+			values[i] = new(sql.NullInt64)
+		case todolist.FieldName:
+			values[i] = new(sql.NullString)
+		case todolist.FieldCreatedAt, todolist.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TodoList fields.
+func (_m *TodoList) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case todolist.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case todolist.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case todolist.FieldWorkspaceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value.Valid {
+				_m.WorkspaceID = int(value.Int64)
+			}
+		case todolist.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case todolist.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TodoList.
+// This includes values selected through modifiers, order, etc.
+func (_m *TodoList) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryWorkspace queries the "workspace" edge of the TodoList entity.
+func (_m *TodoList) QueryWorkspace() *WorkspaceQuery {
+	return NewTodoListClient(_m.config).QueryWorkspace(_m)
+}
+
+// QueryMembers queries the "members" edge of the TodoList entity.
+func (_m *TodoList) QueryMembers() *TodoListMemberQuery {
+	return NewTodoListClient(_m.config).QueryMembers(_m)
+}
+
+// QueryInvites queries the "invites" edge of the TodoList entity.
+func (_m *TodoList) QueryInvites() *TodoListInviteQuery {
+	return NewTodoListClient(_m.config).QueryInvites(_m)
+}
+
+// QueryTodos queries the "todos" edge of the TodoList entity.
+func (_m *TodoList) QueryTodos() *TodoQuery {
+	return NewTodoListClient(_m.config).QueryTodos(_m)
+}
+
+// Update returns a builder for updating this TodoList.
+// Note that you need to call TodoList.Unwrap() before calling this method if this TodoList
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TodoList) Update() *TodoListUpdateOne {
+	return NewTodoListClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TodoList entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TodoList) Unwrap() *TodoList {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TodoList is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TodoList) String() string {
+	var builder strings.Builder
+	builder.WriteString("TodoList(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TodoLists is a parsable slice of TodoList.
+type TodoLists []*TodoList
@@ -0,0 +1,218 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"todo-app/ent/todolist"
+	"todo-app/ent/todolistinvite"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// TodoListInvite is the model entity for the TodoListInvite schema.
+type TodoListInvite struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ListID holds the value of the "list_id" field.
+	ListID int `json:"list_id,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// Role holds the value of the "role" field.
+	Role string `json:"role,omitempty"`
+	// TokenHash holds the value of the "token_hash" field.
+	TokenHash string `json:"token_hash,omitempty"`
+	// InvitedBy holds the value of the "invited_by" field.
+	InvitedBy int `json:"invited_by,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// UsedAt holds the value of the "used_at" field.
+	UsedAt *time.Time `json:"used_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TodoListInviteQuery when eager-loading is set.
+	Edges        TodoListInviteEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TodoListInviteEdges holds the relations/edges for other nodes in the graph.
+type TodoListInviteEdges struct {
+	// List holds the value of the list edge.
+	List *TodoList `json:"list,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ListOrErr returns the List value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoListInviteEdges) ListOrErr() (*TodoList, error) {
+	if e.List != nil {
+		return e.List, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: todolist.Label}
+	}
+	return nil, &NotLoadedError{edge: "list"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TodoListInvite) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case todolistinvite.FieldListID, todolistinvite.FieldInvitedBy:
+			values[i] = new(sql.NullInt64)
+		case todolistinvite.FieldEmail, todolistinvite.FieldRole, todolistinvite.FieldTokenHash:
+			values[i] = new(sql.NullString)
+		case todolistinvite.FieldExpiresAt, todolistinvite.FieldUsedAt, todolistinvite.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case todolistinvite.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TodoListInvite fields.
+func (_m *TodoListInvite) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case todolistinvite.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case todolistinvite.FieldListID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field list_id", values[i])
+			} else if value.Valid {
+				_m.ListID = int(value.Int64)
+			}
+		case todolistinvite.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				_m.Email = value.String
+			}
+		case todolistinvite.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = value.String
+			}
+		case todolistinvite.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				_m.TokenHash = value.String
+			}
+		case todolistinvite.FieldInvitedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field invited_by", values[i])
+			} else if value.Valid {
+				_m.InvitedBy = int(value.Int64)
+			}
+		case todolistinvite.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case todolistinvite.FieldUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field used_at", values[i])
+			} else if value.Valid {
+				_m.UsedAt = new(time.Time)
+				*_m.UsedAt = value.Time
+			}
+		case todolistinvite.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TodoListInvite.
+// This includes values selected through modifiers, order, etc.
+func (_m *TodoListInvite) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryList queries the "list" edge of the TodoListInvite entity.
+func (_m *TodoListInvite) QueryList() *TodoListQuery {
+	return NewTodoListInviteClient(_m.config).QueryList(_m)
+}
+
+// Update returns a builder for updating this TodoListInvite.
+// Note that you need to call TodoListInvite.Unwrap() before calling this method if this TodoListInvite
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TodoListInvite) Update() *TodoListInviteUpdateOne {
+	return NewTodoListInviteClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TodoListInvite entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TodoListInvite) Unwrap() *TodoListInvite {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TodoListInvite is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TodoListInvite) String() string {
+	var builder strings.Builder
+	builder.WriteString("TodoListInvite(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("list_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ListID))
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(_m.Email)
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(_m.Role)
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=")
+	builder.WriteString(_m.TokenHash)
+	builder.WriteString(", ")
+	builder.WriteString("invited_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InvitedBy))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.UsedAt; v != nil {
+		builder.WriteString("used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TodoListInvites is a parsable slice of TodoListInvite.
+type TodoListInvites []*TodoListInvite
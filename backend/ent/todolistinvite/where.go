@@ -0,0 +1,520 @@
+// Code generated by ent, DO NOT EDIT.
+
+package todolistinvite
+
+import (
+	"time"
+	"todo-app/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldLTE(FieldID, id))
+}
+
+// ListID applies equality check predicate on the "list_id" field. It's identical to ListIDEQ.
+func ListID(v int) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldEQ(FieldListID, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldEQ(FieldEmail, v))
+}
+
+// Role applies equality check predicate on the "role" field. It's identical to RoleEQ.
+func Role(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldEQ(FieldRole, v))
+}
+
+// TokenHash applies equality check predicate on the "token_hash" field. It's identical to TokenHashEQ.
+func TokenHash(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldEQ(FieldTokenHash, v))
+}
+
+// InvitedBy applies equality check predicate on the "invited_by" field. It's identical to InvitedByEQ.
+func InvitedBy(v int) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldEQ(FieldInvitedBy, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// UsedAt applies equality check predicate on the "used_at" field. It's identical to UsedAtEQ.
+func UsedAt(v time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldEQ(FieldUsedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ListIDEQ applies the EQ predicate on the "list_id" field.
+func ListIDEQ(v int) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldEQ(FieldListID, v))
+}
+
+// ListIDNEQ applies the NEQ predicate on the "list_id" field.
+func ListIDNEQ(v int) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldNEQ(FieldListID, v))
+}
+
+// ListIDIn applies the In predicate on the "list_id" field.
+func ListIDIn(vs ...int) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldIn(FieldListID, vs...))
+}
+
+// ListIDNotIn applies the NotIn predicate on the "list_id" field.
+func ListIDNotIn(vs ...int) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldNotIn(FieldListID, vs...))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldNotIn(FieldRole, vs...))
+}
+
+// RoleGT applies the GT predicate on the "role" field.
+func RoleGT(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldGT(FieldRole, v))
+}
+
+// RoleGTE applies the GTE predicate on the "role" field.
+func RoleGTE(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldGTE(FieldRole, v))
+}
+
+// RoleLT applies the LT predicate on the "role" field.
+func RoleLT(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldLT(FieldRole, v))
+}
+
+// RoleLTE applies the LTE predicate on the "role" field.
+func RoleLTE(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldLTE(FieldRole, v))
+}
+
+// RoleContains applies the Contains predicate on the "role" field.
+func RoleContains(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldContains(FieldRole, v))
+}
+
+// RoleHasPrefix applies the HasPrefix predicate on the "role" field.
+func RoleHasPrefix(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldHasPrefix(FieldRole, v))
+}
+
+// RoleHasSuffix applies the HasSuffix predicate on the "role" field.
+func RoleHasSuffix(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldHasSuffix(FieldRole, v))
+}
+
+// RoleEqualFold applies the EqualFold predicate on the "role" field.
+func RoleEqualFold(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldEqualFold(FieldRole, v))
+}
+
+// RoleContainsFold applies the ContainsFold predicate on the "role" field.
+func RoleContainsFold(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldContainsFold(FieldRole, v))
+}
+
+// TokenHashEQ applies the EQ predicate on the "token_hash" field.
+func TokenHashEQ(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldEQ(FieldTokenHash, v))
+}
+
+// TokenHashNEQ applies the NEQ predicate on the "token_hash" field.
+func TokenHashNEQ(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldNEQ(FieldTokenHash, v))
+}
+
+// TokenHashIn applies the In predicate on the "token_hash" field.
+func TokenHashIn(vs ...string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldIn(FieldTokenHash, vs...))
+}
+
+// TokenHashNotIn applies the NotIn predicate on the "token_hash" field.
+func TokenHashNotIn(vs ...string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldNotIn(FieldTokenHash, vs...))
+}
+
+// TokenHashGT applies the GT predicate on the "token_hash" field.
+func TokenHashGT(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldGT(FieldTokenHash, v))
+}
+
+// TokenHashGTE applies the GTE predicate on the "token_hash" field.
+func TokenHashGTE(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldGTE(FieldTokenHash, v))
+}
+
+// TokenHashLT applies the LT predicate on the "token_hash" field.
+func TokenHashLT(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldLT(FieldTokenHash, v))
+}
+
+// TokenHashLTE applies the LTE predicate on the "token_hash" field.
+func TokenHashLTE(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldLTE(FieldTokenHash, v))
+}
+
+// TokenHashContains applies the Contains predicate on the "token_hash" field.
+func TokenHashContains(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldContains(FieldTokenHash, v))
+}
+
+// TokenHashHasPrefix applies the HasPrefix predicate on the "token_hash" field.
+func TokenHashHasPrefix(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldHasPrefix(FieldTokenHash, v))
+}
+
+// TokenHashHasSuffix applies the HasSuffix predicate on the "token_hash" field.
+func TokenHashHasSuffix(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldHasSuffix(FieldTokenHash, v))
+}
+
+// TokenHashEqualFold applies the EqualFold predicate on the "token_hash" field.
+func TokenHashEqualFold(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldEqualFold(FieldTokenHash, v))
+}
+
+// TokenHashContainsFold applies the ContainsFold predicate on the "token_hash" field.
+func TokenHashContainsFold(v string) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldContainsFold(FieldTokenHash, v))
+}
+
+// InvitedByEQ applies the EQ predicate on the "invited_by" field.
+func InvitedByEQ(v int) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldEQ(FieldInvitedBy, v))
+}
+
+// InvitedByNEQ applies the NEQ predicate on the "invited_by" field.
+func InvitedByNEQ(v int) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldNEQ(FieldInvitedBy, v))
+}
+
+// InvitedByIn applies the In predicate on the "invited_by" field.
+func InvitedByIn(vs ...int) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldIn(FieldInvitedBy, vs...))
+}
+
+// InvitedByNotIn applies the NotIn predicate on the "invited_by" field.
+func InvitedByNotIn(vs ...int) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldNotIn(FieldInvitedBy, vs...))
+}
+
+// InvitedByGT applies the GT predicate on the "invited_by" field.
+func InvitedByGT(v int) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldGT(FieldInvitedBy, v))
+}
+
+// InvitedByGTE applies the GTE predicate on the "invited_by" field.
+func InvitedByGTE(v int) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldGTE(FieldInvitedBy, v))
+}
+
+// InvitedByLT applies the LT predicate on the "invited_by" field.
+func InvitedByLT(v int) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldLT(FieldInvitedBy, v))
+}
+
+// InvitedByLTE applies the LTE predicate on the "invited_by" field.
+func InvitedByLTE(v int) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldLTE(FieldInvitedBy, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// UsedAtEQ applies the EQ predicate on the "used_at" field.
+func UsedAtEQ(v time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldEQ(FieldUsedAt, v))
+}
+
+// UsedAtNEQ applies the NEQ predicate on the "used_at" field.
+func UsedAtNEQ(v time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldNEQ(FieldUsedAt, v))
+}
+
+// UsedAtIn applies the In predicate on the "used_at" field.
+func UsedAtIn(vs ...time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldIn(FieldUsedAt, vs...))
+}
+
+// UsedAtNotIn applies the NotIn predicate on the "used_at" field.
+func UsedAtNotIn(vs ...time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldNotIn(FieldUsedAt, vs...))
+}
+
+// UsedAtGT applies the GT predicate on the "used_at" field.
+func UsedAtGT(v time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldGT(FieldUsedAt, v))
+}
+
+// UsedAtGTE applies the GTE predicate on the "used_at" field.
+func UsedAtGTE(v time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldGTE(FieldUsedAt, v))
+}
+
+// UsedAtLT applies the LT predicate on the "used_at" field.
+func UsedAtLT(v time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldLT(FieldUsedAt, v))
+}
+
+// UsedAtLTE applies the LTE predicate on the "used_at" field.
+func UsedAtLTE(v time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldLTE(FieldUsedAt, v))
+}
+
+// UsedAtIsNil applies the IsNil predicate on the "used_at" field.
+func UsedAtIsNil() predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldIsNull(FieldUsedAt))
+}
+
+// UsedAtNotNil applies the NotNil predicate on the "used_at" field.
+func UsedAtNotNil() predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldNotNull(FieldUsedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasList applies the HasEdge predicate on the "list" edge.
+func HasList() predicate.TodoListInvite {
+	return predicate.TodoListInvite(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ListTable, ListColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasListWith applies the HasEdge predicate on the "list" edge with a given conditions (other predicates).
+func HasListWith(preds ...predicate.TodoList) predicate.TodoListInvite {
+	return predicate.TodoListInvite(func(s *sql.Selector) {
+		step := newListStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TodoListInvite) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TodoListInvite) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TodoListInvite) predicate.TodoListInvite {
+	return predicate.TodoListInvite(sql.NotPredicates(p))
+}
@@ -0,0 +1,359 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo-app/ent/todolist"
+	"todo-app/ent/todolistinvite"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TodoListInviteCreate is the builder for creating a TodoListInvite entity.
+type TodoListInviteCreate struct {
+	config
+	mutation *TodoListInviteMutation
+	hooks    []Hook
+}
+
+// SetListID sets the "list_id" field.
+func (_c *TodoListInviteCreate) SetListID(v int) *TodoListInviteCreate {
+	_c.mutation.SetListID(v)
+	return _c
+}
+
+// SetEmail sets the "email" field.
+func (_c *TodoListInviteCreate) SetEmail(v string) *TodoListInviteCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetRole sets the "role" field.
+func (_c *TodoListInviteCreate) SetRole(v string) *TodoListInviteCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_c *TodoListInviteCreate) SetTokenHash(v string) *TodoListInviteCreate {
+	_c.mutation.SetTokenHash(v)
+	return _c
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (_c *TodoListInviteCreate) SetInvitedBy(v int) *TodoListInviteCreate {
+	_c.mutation.SetInvitedBy(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *TodoListInviteCreate) SetExpiresAt(v time.Time) *TodoListInviteCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_c *TodoListInviteCreate) SetUsedAt(v time.Time) *TodoListInviteCreate {
+	_c.mutation.SetUsedAt(v)
+	return _c
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_c *TodoListInviteCreate) SetNillableUsedAt(v *time.Time) *TodoListInviteCreate {
+	if v != nil {
+		_c.SetUsedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TodoListInviteCreate) SetCreatedAt(v time.Time) *TodoListInviteCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TodoListInviteCreate) SetNillableCreatedAt(v *time.Time) *TodoListInviteCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TodoListInviteCreate) SetID(v uuid.UUID) *TodoListInviteCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TodoListInviteCreate) SetNillableID(v *uuid.UUID) *TodoListInviteCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetList sets the "list" edge to the TodoList entity.
+func (_c *TodoListInviteCreate) SetList(v *TodoList) *TodoListInviteCreate {
+	return _c.SetListID(v.ID)
+}
+
+// Mutation returns the TodoListInviteMutation object of the builder.
+func (_c *TodoListInviteCreate) Mutation() *TodoListInviteMutation {
+	return _c.mutation
+}
+
+// Save creates the TodoListInvite in the database.
+func (_c *TodoListInviteCreate) Save(ctx context.Context) (*TodoListInvite, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TodoListInviteCreate) SaveX(ctx context.Context) *TodoListInvite {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TodoListInviteCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TodoListInviteCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TodoListInviteCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := todolistinvite.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := todolistinvite.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TodoListInviteCreate) check() error {
+	if _, ok := _c.mutation.ListID(); !ok {
+		return &ValidationError{Name: "list_id", err: errors.New(`ent: missing required field "TodoListInvite.list_id"`)}
+	}
+	if _, ok := _c.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "TodoListInvite.email"`)}
+	}
+	if v, ok := _c.mutation.Email(); ok {
+		if err := todolistinvite.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "TodoListInvite.email": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "TodoListInvite.role"`)}
+	}
+	if v, ok := _c.mutation.Role(); ok {
+		if err := todolistinvite.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "TodoListInvite.role": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "TodoListInvite.token_hash"`)}
+	}
+	if v, ok := _c.mutation.TokenHash(); ok {
+		if err := todolistinvite.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "TodoListInvite.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.InvitedBy(); !ok {
+		return &ValidationError{Name: "invited_by", err: errors.New(`ent: missing required field "TodoListInvite.invited_by"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "TodoListInvite.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TodoListInvite.created_at"`)}
+	}
+	if len(_c.mutation.ListIDs()) == 0 {
+		return &ValidationError{Name: "list", err: errors.New(`ent: missing required edge "TodoListInvite.list"`)}
+	}
+	return nil
+}
+
+func (_c *TodoListInviteCreate) sqlSave(ctx context.Context) (*TodoListInvite, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TodoListInviteCreate) createSpec() (*TodoListInvite, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TodoListInvite{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(todolistinvite.Table, sqlgraph.NewFieldSpec(todolistinvite.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(todolistinvite.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(todolistinvite.FieldRole, field.TypeString, value)
+		_node.Role = value
+	}
+	if value, ok := _c.mutation.TokenHash(); ok {
+		_spec.SetField(todolistinvite.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := _c.mutation.InvitedBy(); ok {
+		_spec.SetField(todolistinvite.FieldInvitedBy, field.TypeInt, value)
+		_node.InvitedBy = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(todolistinvite.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.UsedAt(); ok {
+		_spec.SetField(todolistinvite.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(todolistinvite.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.ListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todolistinvite.ListTable,
+			Columns: []string{todolistinvite.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todolist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ListID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TodoListInviteCreateBulk is the builder for creating many TodoListInvite entities in bulk.
+type TodoListInviteCreateBulk struct {
+	config
+	err      error
+	builders []*TodoListInviteCreate
+}
+
+// Save creates the TodoListInvite entities in the database.
+func (_c *TodoListInviteCreateBulk) Save(ctx context.Context) ([]*TodoListInvite, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TodoListInvite, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TodoListInviteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TodoListInviteCreateBulk) SaveX(ctx context.Context) []*TodoListInvite {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TodoListInviteCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TodoListInviteCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,579 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo-app/ent/predicate"
+	"todo-app/ent/todolist"
+	"todo-app/ent/todolistinvite"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TodoListInviteUpdate is the builder for updating TodoListInvite entities.
+type TodoListInviteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TodoListInviteMutation
+}
+
+// Where appends a list predicates to the TodoListInviteUpdate builder.
+func (_u *TodoListInviteUpdate) Where(ps ...predicate.TodoListInvite) *TodoListInviteUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetListID sets the "list_id" field.
+func (_u *TodoListInviteUpdate) SetListID(v int) *TodoListInviteUpdate {
+	_u.mutation.SetListID(v)
+	return _u
+}
+
+// SetNillableListID sets the "list_id" field if the given value is not nil.
+func (_u *TodoListInviteUpdate) SetNillableListID(v *int) *TodoListInviteUpdate {
+	if v != nil {
+		_u.SetListID(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *TodoListInviteUpdate) SetEmail(v string) *TodoListInviteUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *TodoListInviteUpdate) SetNillableEmail(v *string) *TodoListInviteUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *TodoListInviteUpdate) SetRole(v string) *TodoListInviteUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *TodoListInviteUpdate) SetNillableRole(v *string) *TodoListInviteUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *TodoListInviteUpdate) SetTokenHash(v string) *TodoListInviteUpdate {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *TodoListInviteUpdate) SetNillableTokenHash(v *string) *TodoListInviteUpdate {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (_u *TodoListInviteUpdate) SetInvitedBy(v int) *TodoListInviteUpdate {
+	_u.mutation.ResetInvitedBy()
+	_u.mutation.SetInvitedBy(v)
+	return _u
+}
+
+// SetNillableInvitedBy sets the "invited_by" field if the given value is not nil.
+func (_u *TodoListInviteUpdate) SetNillableInvitedBy(v *int) *TodoListInviteUpdate {
+	if v != nil {
+		_u.SetInvitedBy(*v)
+	}
+	return _u
+}
+
+// AddInvitedBy adds value to the "invited_by" field.
+func (_u *TodoListInviteUpdate) AddInvitedBy(v int) *TodoListInviteUpdate {
+	_u.mutation.AddInvitedBy(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *TodoListInviteUpdate) SetExpiresAt(v time.Time) *TodoListInviteUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *TodoListInviteUpdate) SetNillableExpiresAt(v *time.Time) *TodoListInviteUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *TodoListInviteUpdate) SetUsedAt(v time.Time) *TodoListInviteUpdate {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *TodoListInviteUpdate) SetNillableUsedAt(v *time.Time) *TodoListInviteUpdate {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *TodoListInviteUpdate) ClearUsedAt() *TodoListInviteUpdate {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// SetList sets the "list" edge to the TodoList entity.
+func (_u *TodoListInviteUpdate) SetList(v *TodoList) *TodoListInviteUpdate {
+	return _u.SetListID(v.ID)
+}
+
+// Mutation returns the TodoListInviteMutation object of the builder.
+func (_u *TodoListInviteUpdate) Mutation() *TodoListInviteMutation {
+	return _u.mutation
+}
+
+// ClearList clears the "list" edge to the TodoList entity.
+func (_u *TodoListInviteUpdate) ClearList() *TodoListInviteUpdate {
+	_u.mutation.ClearList()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TodoListInviteUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TodoListInviteUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TodoListInviteUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TodoListInviteUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TodoListInviteUpdate) check() error {
+	if v, ok := _u.mutation.Email(); ok {
+		if err := todolistinvite.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "TodoListInvite.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := todolistinvite.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "TodoListInvite.role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenHash(); ok {
+		if err := todolistinvite.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "TodoListInvite.token_hash": %w`, err)}
+		}
+	}
+	if _u.mutation.ListCleared() && len(_u.mutation.ListIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoListInvite.list"`)
+	}
+	return nil
+}
+
+func (_u *TodoListInviteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(todolistinvite.Table, todolistinvite.Columns, sqlgraph.NewFieldSpec(todolistinvite.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(todolistinvite.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(todolistinvite.FieldRole, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(todolistinvite.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InvitedBy(); ok {
+		_spec.SetField(todolistinvite.FieldInvitedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInvitedBy(); ok {
+		_spec.AddField(todolistinvite.FieldInvitedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(todolistinvite.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(todolistinvite.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(todolistinvite.FieldUsedAt, field.TypeTime)
+	}
+	if _u.mutation.ListCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todolistinvite.ListTable,
+			Columns: []string{todolistinvite.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todolist.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todolistinvite.ListTable,
+			Columns: []string{todolistinvite.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todolist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todolistinvite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TodoListInviteUpdateOne is the builder for updating a single TodoListInvite entity.
+type TodoListInviteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TodoListInviteMutation
+}
+
+// SetListID sets the "list_id" field.
+func (_u *TodoListInviteUpdateOne) SetListID(v int) *TodoListInviteUpdateOne {
+	_u.mutation.SetListID(v)
+	return _u
+}
+
+// SetNillableListID sets the "list_id" field if the given value is not nil.
+func (_u *TodoListInviteUpdateOne) SetNillableListID(v *int) *TodoListInviteUpdateOne {
+	if v != nil {
+		_u.SetListID(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *TodoListInviteUpdateOne) SetEmail(v string) *TodoListInviteUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *TodoListInviteUpdateOne) SetNillableEmail(v *string) *TodoListInviteUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *TodoListInviteUpdateOne) SetRole(v string) *TodoListInviteUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *TodoListInviteUpdateOne) SetNillableRole(v *string) *TodoListInviteUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *TodoListInviteUpdateOne) SetTokenHash(v string) *TodoListInviteUpdateOne {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *TodoListInviteUpdateOne) SetNillableTokenHash(v *string) *TodoListInviteUpdateOne {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (_u *TodoListInviteUpdateOne) SetInvitedBy(v int) *TodoListInviteUpdateOne {
+	_u.mutation.ResetInvitedBy()
+	_u.mutation.SetInvitedBy(v)
+	return _u
+}
+
+// SetNillableInvitedBy sets the "invited_by" field if the given value is not nil.
+func (_u *TodoListInviteUpdateOne) SetNillableInvitedBy(v *int) *TodoListInviteUpdateOne {
+	if v != nil {
+		_u.SetInvitedBy(*v)
+	}
+	return _u
+}
+
+// AddInvitedBy adds value to the "invited_by" field.
+func (_u *TodoListInviteUpdateOne) AddInvitedBy(v int) *TodoListInviteUpdateOne {
+	_u.mutation.AddInvitedBy(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *TodoListInviteUpdateOne) SetExpiresAt(v time.Time) *TodoListInviteUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *TodoListInviteUpdateOne) SetNillableExpiresAt(v *time.Time) *TodoListInviteUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *TodoListInviteUpdateOne) SetUsedAt(v time.Time) *TodoListInviteUpdateOne {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *TodoListInviteUpdateOne) SetNillableUsedAt(v *time.Time) *TodoListInviteUpdateOne {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *TodoListInviteUpdateOne) ClearUsedAt() *TodoListInviteUpdateOne {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// SetList sets the "list" edge to the TodoList entity.
+func (_u *TodoListInviteUpdateOne) SetList(v *TodoList) *TodoListInviteUpdateOne {
+	return _u.SetListID(v.ID)
+}
+
+// Mutation returns the TodoListInviteMutation object of the builder.
+func (_u *TodoListInviteUpdateOne) Mutation() *TodoListInviteMutation {
+	return _u.mutation
+}
+
+// ClearList clears the "list" edge to the TodoList entity.
+func (_u *TodoListInviteUpdateOne) ClearList() *TodoListInviteUpdateOne {
+	_u.mutation.ClearList()
+	return _u
+}
+
+// Where appends a list predicates to the TodoListInviteUpdate builder.
+func (_u *TodoListInviteUpdateOne) Where(ps ...predicate.TodoListInvite) *TodoListInviteUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TodoListInviteUpdateOne) Select(field string, fields ...string) *TodoListInviteUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TodoListInvite entity.
+func (_u *TodoListInviteUpdateOne) Save(ctx context.Context) (*TodoListInvite, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TodoListInviteUpdateOne) SaveX(ctx context.Context) *TodoListInvite {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TodoListInviteUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TodoListInviteUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TodoListInviteUpdateOne) check() error {
+	if v, ok := _u.mutation.Email(); ok {
+		if err := todolistinvite.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "TodoListInvite.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := todolistinvite.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "TodoListInvite.role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenHash(); ok {
+		if err := todolistinvite.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "TodoListInvite.token_hash": %w`, err)}
+		}
+	}
+	if _u.mutation.ListCleared() && len(_u.mutation.ListIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoListInvite.list"`)
+	}
+	return nil
+}
+
+func (_u *TodoListInviteUpdateOne) sqlSave(ctx context.Context) (_node *TodoListInvite, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(todolistinvite.Table, todolistinvite.Columns, sqlgraph.NewFieldSpec(todolistinvite.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TodoListInvite.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, todolistinvite.FieldID)
+		for _, f := range fields {
+			if !todolistinvite.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != todolistinvite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(todolistinvite.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(todolistinvite.FieldRole, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(todolistinvite.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InvitedBy(); ok {
+		_spec.SetField(todolistinvite.FieldInvitedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInvitedBy(); ok {
+		_spec.AddField(todolistinvite.FieldInvitedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(todolistinvite.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(todolistinvite.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(todolistinvite.FieldUsedAt, field.TypeTime)
+	}
+	if _u.mutation.ListCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todolistinvite.ListTable,
+			Columns: []string{todolistinvite.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todolist.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todolistinvite.ListTable,
+			Columns: []string{todolistinvite.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todolist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TodoListInvite{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todolistinvite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
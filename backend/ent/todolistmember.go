@@ -0,0 +1,187 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"todo-app/ent/todolist"
+	"todo-app/ent/todolistmember"
+	"todo-app/ent/user"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TodoListMember is the model entity for the TodoListMember schema.
+type TodoListMember struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ListID holds the value of the "list_id" field.
+	ListID int `json:"list_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Role holds the value of the "role" field.
+	Role string `json:"role,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TodoListMemberQuery when eager-loading is set.
+	Edges        TodoListMemberEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TodoListMemberEdges holds the relations/edges for other nodes in the graph.
+type TodoListMemberEdges struct {
+	// List holds the value of the list edge.
+	List *TodoList `json:"list,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ListOrErr returns the List value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoListMemberEdges) ListOrErr() (*TodoList, error) {
+	if e.List != nil {
+		return e.List, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: todolist.Label}
+	}
+	return nil, &NotLoadedError{edge: "list"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoListMemberEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TodoListMember) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case todolistmember.FieldID, todolistmember.FieldListID, todolistmember.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case todolistmember.FieldRole:
+			values[i] = new(sql.NullString)
+		case todolistmember.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TodoListMember fields.
+func (_m *TodoListMember) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case todolistmember.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case todolistmember.FieldListID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field list_id", values[i])
+			} else if value.Valid {
+				_m.ListID = int(value.Int64)
+			}
+		case todolistmember.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case todolistmember.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = value.String
+			}
+		case todolistmember.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TodoListMember.
+// This includes values selected through modifiers, order, etc.
+func (_m *TodoListMember) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryList queries the "list" edge of the TodoListMember entity.
+func (_m *TodoListMember) QueryList() *TodoListQuery {
+	return NewTodoListMemberClient(_m.config).QueryList(_m)
+}
+
+// QueryUser queries the "user" edge of the TodoListMember entity.
+func (_m *TodoListMember) QueryUser() *UserQuery {
+	return NewTodoListMemberClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this TodoListMember.
+// Note that you need to call TodoListMember.Unwrap() before calling this method if this TodoListMember
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TodoListMember) Update() *TodoListMemberUpdateOne {
+	return NewTodoListMemberClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TodoListMember entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TodoListMember) Unwrap() *TodoListMember {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TodoListMember is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TodoListMember) String() string {
+	var builder strings.Builder
+	builder.WriteString("TodoListMember(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("list_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ListID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(_m.Role)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TodoListMembers is a parsable slice of TodoListMember.
+type TodoListMembers []*TodoListMember
@@ -0,0 +1,282 @@
+// Code generated by ent, DO NOT EDIT.
+
+package todolistmember
+
+import (
+	"time"
+	"todo-app/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldLTE(FieldID, id))
+}
+
+// ListID applies equality check predicate on the "list_id" field. It's identical to ListIDEQ.
+func ListID(v int) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldEQ(FieldListID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldEQ(FieldUserID, v))
+}
+
+// Role applies equality check predicate on the "role" field. It's identical to RoleEQ.
+func Role(v string) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldEQ(FieldRole, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ListIDEQ applies the EQ predicate on the "list_id" field.
+func ListIDEQ(v int) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldEQ(FieldListID, v))
+}
+
+// ListIDNEQ applies the NEQ predicate on the "list_id" field.
+func ListIDNEQ(v int) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldNEQ(FieldListID, v))
+}
+
+// ListIDIn applies the In predicate on the "list_id" field.
+func ListIDIn(vs ...int) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldIn(FieldListID, vs...))
+}
+
+// ListIDNotIn applies the NotIn predicate on the "list_id" field.
+func ListIDNotIn(vs ...int) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldNotIn(FieldListID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v string) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v string) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...string) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...string) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldNotIn(FieldRole, vs...))
+}
+
+// RoleGT applies the GT predicate on the "role" field.
+func RoleGT(v string) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldGT(FieldRole, v))
+}
+
+// RoleGTE applies the GTE predicate on the "role" field.
+func RoleGTE(v string) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldGTE(FieldRole, v))
+}
+
+// RoleLT applies the LT predicate on the "role" field.
+func RoleLT(v string) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldLT(FieldRole, v))
+}
+
+// RoleLTE applies the LTE predicate on the "role" field.
+func RoleLTE(v string) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldLTE(FieldRole, v))
+}
+
+// RoleContains applies the Contains predicate on the "role" field.
+func RoleContains(v string) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldContains(FieldRole, v))
+}
+
+// RoleHasPrefix applies the HasPrefix predicate on the "role" field.
+func RoleHasPrefix(v string) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldHasPrefix(FieldRole, v))
+}
+
+// RoleHasSuffix applies the HasSuffix predicate on the "role" field.
+func RoleHasSuffix(v string) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldHasSuffix(FieldRole, v))
+}
+
+// RoleEqualFold applies the EqualFold predicate on the "role" field.
+func RoleEqualFold(v string) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldEqualFold(FieldRole, v))
+}
+
+// RoleContainsFold applies the ContainsFold predicate on the "role" field.
+func RoleContainsFold(v string) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldContainsFold(FieldRole, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasList applies the HasEdge predicate on the "list" edge.
+func HasList() predicate.TodoListMember {
+	return predicate.TodoListMember(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ListTable, ListColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasListWith applies the HasEdge predicate on the "list" edge with a given conditions (other predicates).
+func HasListWith(preds ...predicate.TodoList) predicate.TodoListMember {
+	return predicate.TodoListMember(func(s *sql.Selector) {
+		step := newListStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.TodoListMember {
+	return predicate.TodoListMember(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.TodoListMember {
+	return predicate.TodoListMember(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TodoListMember) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TodoListMember) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TodoListMember) predicate.TodoListMember {
+	return predicate.TodoListMember(sql.NotPredicates(p))
+}
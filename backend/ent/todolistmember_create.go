@@ -0,0 +1,290 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo-app/ent/todolist"
+	"todo-app/ent/todolistmember"
+	"todo-app/ent/user"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TodoListMemberCreate is the builder for creating a TodoListMember entity.
+type TodoListMemberCreate struct {
+	config
+	mutation *TodoListMemberMutation
+	hooks    []Hook
+}
+
+// SetListID sets the "list_id" field.
+func (_c *TodoListMemberCreate) SetListID(v int) *TodoListMemberCreate {
+	_c.mutation.SetListID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *TodoListMemberCreate) SetUserID(v int) *TodoListMemberCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetRole sets the "role" field.
+func (_c *TodoListMemberCreate) SetRole(v string) *TodoListMemberCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TodoListMemberCreate) SetCreatedAt(v time.Time) *TodoListMemberCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TodoListMemberCreate) SetNillableCreatedAt(v *time.Time) *TodoListMemberCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetList sets the "list" edge to the TodoList entity.
+func (_c *TodoListMemberCreate) SetList(v *TodoList) *TodoListMemberCreate {
+	return _c.SetListID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *TodoListMemberCreate) SetUser(v *User) *TodoListMemberCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the TodoListMemberMutation object of the builder.
+func (_c *TodoListMemberCreate) Mutation() *TodoListMemberMutation {
+	return _c.mutation
+}
+
+// Save creates the TodoListMember in the database.
+func (_c *TodoListMemberCreate) Save(ctx context.Context) (*TodoListMember, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TodoListMemberCreate) SaveX(ctx context.Context) *TodoListMember {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TodoListMemberCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TodoListMemberCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TodoListMemberCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := todolistmember.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TodoListMemberCreate) check() error {
+	if _, ok := _c.mutation.ListID(); !ok {
+		return &ValidationError{Name: "list_id", err: errors.New(`ent: missing required field "TodoListMember.list_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "TodoListMember.user_id"`)}
+	}
+	if _, ok := _c.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "TodoListMember.role"`)}
+	}
+	if v, ok := _c.mutation.Role(); ok {
+		if err := todolistmember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "TodoListMember.role": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TodoListMember.created_at"`)}
+	}
+	if len(_c.mutation.ListIDs()) == 0 {
+		return &ValidationError{Name: "list", err: errors.New(`ent: missing required edge "TodoListMember.list"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "TodoListMember.user"`)}
+	}
+	return nil
+}
+
+func (_c *TodoListMemberCreate) sqlSave(ctx context.Context) (*TodoListMember, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TodoListMemberCreate) createSpec() (*TodoListMember, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TodoListMember{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(todolistmember.Table, sqlgraph.NewFieldSpec(todolistmember.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(todolistmember.FieldRole, field.TypeString, value)
+		_node.Role = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(todolistmember.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.ListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todolistmember.ListTable,
+			Columns: []string{todolistmember.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todolist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ListID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todolistmember.UserTable,
+			Columns: []string{todolistmember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TodoListMemberCreateBulk is the builder for creating many TodoListMember entities in bulk.
+type TodoListMemberCreateBulk struct {
+	config
+	err      error
+	builders []*TodoListMemberCreate
+}
+
+// Save creates the TodoListMember entities in the database.
+func (_c *TodoListMemberCreateBulk) Save(ctx context.Context) ([]*TodoListMember, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TodoListMember, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TodoListMemberMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TodoListMemberCreateBulk) SaveX(ctx context.Context) []*TodoListMember {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TodoListMemberCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TodoListMemberCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
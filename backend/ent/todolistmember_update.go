@@ -0,0 +1,465 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"todo-app/ent/predicate"
+	"todo-app/ent/todolist"
+	"todo-app/ent/todolistmember"
+	"todo-app/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TodoListMemberUpdate is the builder for updating TodoListMember entities.
+type TodoListMemberUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TodoListMemberMutation
+}
+
+// Where appends a list predicates to the TodoListMemberUpdate builder.
+func (_u *TodoListMemberUpdate) Where(ps ...predicate.TodoListMember) *TodoListMemberUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetListID sets the "list_id" field.
+func (_u *TodoListMemberUpdate) SetListID(v int) *TodoListMemberUpdate {
+	_u.mutation.SetListID(v)
+	return _u
+}
+
+// SetNillableListID sets the "list_id" field if the given value is not nil.
+func (_u *TodoListMemberUpdate) SetNillableListID(v *int) *TodoListMemberUpdate {
+	if v != nil {
+		_u.SetListID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *TodoListMemberUpdate) SetUserID(v int) *TodoListMemberUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *TodoListMemberUpdate) SetNillableUserID(v *int) *TodoListMemberUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *TodoListMemberUpdate) SetRole(v string) *TodoListMemberUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *TodoListMemberUpdate) SetNillableRole(v *string) *TodoListMemberUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetList sets the "list" edge to the TodoList entity.
+func (_u *TodoListMemberUpdate) SetList(v *TodoList) *TodoListMemberUpdate {
+	return _u.SetListID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *TodoListMemberUpdate) SetUser(v *User) *TodoListMemberUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the TodoListMemberMutation object of the builder.
+func (_u *TodoListMemberUpdate) Mutation() *TodoListMemberMutation {
+	return _u.mutation
+}
+
+// ClearList clears the "list" edge to the TodoList entity.
+func (_u *TodoListMemberUpdate) ClearList() *TodoListMemberUpdate {
+	_u.mutation.ClearList()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *TodoListMemberUpdate) ClearUser() *TodoListMemberUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TodoListMemberUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TodoListMemberUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TodoListMemberUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TodoListMemberUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TodoListMemberUpdate) check() error {
+	if v, ok := _u.mutation.Role(); ok {
+		if err := todolistmember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "TodoListMember.role": %w`, err)}
+		}
+	}
+	if _u.mutation.ListCleared() && len(_u.mutation.ListIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoListMember.list"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoListMember.user"`)
+	}
+	return nil
+}
+
+func (_u *TodoListMemberUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(todolistmember.Table, todolistmember.Columns, sqlgraph.NewFieldSpec(todolistmember.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(todolistmember.FieldRole, field.TypeString, value)
+	}
+	if _u.mutation.ListCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todolistmember.ListTable,
+			Columns: []string{todolistmember.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todolist.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todolistmember.ListTable,
+			Columns: []string{todolistmember.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todolist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todolistmember.UserTable,
+			Columns: []string{todolistmember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todolistmember.UserTable,
+			Columns: []string{todolistmember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todolistmember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TodoListMemberUpdateOne is the builder for updating a single TodoListMember entity.
+type TodoListMemberUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TodoListMemberMutation
+}
+
+// SetListID sets the "list_id" field.
+func (_u *TodoListMemberUpdateOne) SetListID(v int) *TodoListMemberUpdateOne {
+	_u.mutation.SetListID(v)
+	return _u
+}
+
+// SetNillableListID sets the "list_id" field if the given value is not nil.
+func (_u *TodoListMemberUpdateOne) SetNillableListID(v *int) *TodoListMemberUpdateOne {
+	if v != nil {
+		_u.SetListID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *TodoListMemberUpdateOne) SetUserID(v int) *TodoListMemberUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *TodoListMemberUpdateOne) SetNillableUserID(v *int) *TodoListMemberUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *TodoListMemberUpdateOne) SetRole(v string) *TodoListMemberUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *TodoListMemberUpdateOne) SetNillableRole(v *string) *TodoListMemberUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetList sets the "list" edge to the TodoList entity.
+func (_u *TodoListMemberUpdateOne) SetList(v *TodoList) *TodoListMemberUpdateOne {
+	return _u.SetListID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *TodoListMemberUpdateOne) SetUser(v *User) *TodoListMemberUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the TodoListMemberMutation object of the builder.
+func (_u *TodoListMemberUpdateOne) Mutation() *TodoListMemberMutation {
+	return _u.mutation
+}
+
+// ClearList clears the "list" edge to the TodoList entity.
+func (_u *TodoListMemberUpdateOne) ClearList() *TodoListMemberUpdateOne {
+	_u.mutation.ClearList()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *TodoListMemberUpdateOne) ClearUser() *TodoListMemberUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the TodoListMemberUpdate builder.
+func (_u *TodoListMemberUpdateOne) Where(ps ...predicate.TodoListMember) *TodoListMemberUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TodoListMemberUpdateOne) Select(field string, fields ...string) *TodoListMemberUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TodoListMember entity.
+func (_u *TodoListMemberUpdateOne) Save(ctx context.Context) (*TodoListMember, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TodoListMemberUpdateOne) SaveX(ctx context.Context) *TodoListMember {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TodoListMemberUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TodoListMemberUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TodoListMemberUpdateOne) check() error {
+	if v, ok := _u.mutation.Role(); ok {
+		if err := todolistmember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "TodoListMember.role": %w`, err)}
+		}
+	}
+	if _u.mutation.ListCleared() && len(_u.mutation.ListIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoListMember.list"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoListMember.user"`)
+	}
+	return nil
+}
+
+func (_u *TodoListMemberUpdateOne) sqlSave(ctx context.Context) (_node *TodoListMember, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(todolistmember.Table, todolistmember.Columns, sqlgraph.NewFieldSpec(todolistmember.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TodoListMember.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, todolistmember.FieldID)
+		for _, f := range fields {
+			if !todolistmember.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != todolistmember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(todolistmember.FieldRole, field.TypeString, value)
+	}
+	if _u.mutation.ListCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todolistmember.ListTable,
+			Columns: []string{todolistmember.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todolist.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todolistmember.ListTable,
+			Columns: []string{todolistmember.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todolist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todolistmember.UserTable,
+			Columns: []string{todolistmember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todolistmember.UserTable,
+			Columns: []string{todolistmember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TodoListMember{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todolistmember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"todo-app/ent/todosummary"
+	"todo-app/ent/user"
+	"todo-app/ent/workspace"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// TodoSummary is the model entity for the TodoSummary schema.
+type TodoSummary struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID int `json:"workspace_id,omitempty"`
+	// RangeFrom holds the value of the "range_from" field.
+	RangeFrom time.Time `json:"range_from,omitempty"`
+	// RangeTo holds the value of the "range_to" field.
+	RangeTo time.Time `json:"range_to,omitempty"`
+	// SourceHash holds the value of the "source_hash" field.
+	SourceHash string `json:"source_hash,omitempty"`
+	// Model holds the value of the "model" field.
+	Model string `json:"model,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// Markdown holds the value of the "markdown" field.
+	Markdown string `json:"markdown,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TodoSummaryQuery when eager-loading is set.
+	Edges        TodoSummaryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TodoSummaryEdges holds the relations/edges for other nodes in the graph.
+type TodoSummaryEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Workspace holds the value of the workspace edge.
+	Workspace *Workspace `json:"workspace,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoSummaryEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// WorkspaceOrErr returns the Workspace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoSummaryEdges) WorkspaceOrErr() (*Workspace, error) {
+	if e.Workspace != nil {
+		return e.Workspace, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: workspace.Label}
+	}
+	return nil, &NotLoadedError{edge: "workspace"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TodoSummary) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case todosummary.FieldUserID, todosummary.FieldWorkspaceID:
+			values[i] = new(sql.NullInt64)
+		case todosummary.FieldSourceHash, todosummary.FieldModel, todosummary.FieldContent, todosummary.FieldMarkdown:
+			values[i] = new(sql.NullString)
+		case todosummary.FieldRangeFrom, todosummary.FieldRangeTo, todosummary.FieldCreatedAt, todosummary.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case todosummary.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TodoSummary fields.
+func (_m *TodoSummary) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case todosummary.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case todosummary.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case todosummary.FieldWorkspaceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value.Valid {
+				_m.WorkspaceID = int(value.Int64)
+			}
+		case todosummary.FieldRangeFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field range_from", values[i])
+			} else if value.Valid {
+				_m.RangeFrom = value.Time
+			}
+		case todosummary.FieldRangeTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field range_to", values[i])
+			} else if value.Valid {
+				_m.RangeTo = value.Time
+			}
+		case todosummary.FieldSourceHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_hash", values[i])
+			} else if value.Valid {
+				_m.SourceHash = value.String
+			}
+		case todosummary.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				_m.Model = value.String
+			}
+		case todosummary.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				_m.Content = value.String
+			}
+		case todosummary.FieldMarkdown:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field markdown", values[i])
+			} else if value.Valid {
+				_m.Markdown = value.String
+			}
+		case todosummary.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case todosummary.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TodoSummary.
+// This includes values selected through modifiers, order, etc.
+func (_m *TodoSummary) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the TodoSummary entity.
+func (_m *TodoSummary) QueryUser() *UserQuery {
+	return NewTodoSummaryClient(_m.config).QueryUser(_m)
+}
+
+// QueryWorkspace queries the "workspace" edge of the TodoSummary entity.
+func (_m *TodoSummary) QueryWorkspace() *WorkspaceQuery {
+	return NewTodoSummaryClient(_m.config).QueryWorkspace(_m)
+}
+
+// Update returns a builder for updating this TodoSummary.
+// Note that you need to call TodoSummary.Unwrap() before calling this method if this TodoSummary
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TodoSummary) Update() *TodoSummaryUpdateOne {
+	return NewTodoSummaryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TodoSummary entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TodoSummary) Unwrap() *TodoSummary {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TodoSummary is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TodoSummary) String() string {
+	var builder strings.Builder
+	builder.WriteString("TodoSummary(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
+	builder.WriteString("range_from=")
+	builder.WriteString(_m.RangeFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("range_to=")
+	builder.WriteString(_m.RangeTo.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("source_hash=")
+	builder.WriteString(_m.SourceHash)
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(_m.Model)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(_m.Content)
+	builder.WriteString(", ")
+	builder.WriteString("markdown=")
+	builder.WriteString(_m.Markdown)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TodoSummaries is a parsable slice of TodoSummary.
+type TodoSummaries []*TodoSummary
@@ -0,0 +1,628 @@
+// Code generated by ent, DO NOT EDIT.
+
+package todosummary
+
+import (
+	"time"
+	"todo-app/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldEQ(FieldUserID, v))
+}
+
+// WorkspaceID applies equality check predicate on the "workspace_id" field. It's identical to WorkspaceIDEQ.
+func WorkspaceID(v int) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// RangeFrom applies equality check predicate on the "range_from" field. It's identical to RangeFromEQ.
+func RangeFrom(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldEQ(FieldRangeFrom, v))
+}
+
+// RangeTo applies equality check predicate on the "range_to" field. It's identical to RangeToEQ.
+func RangeTo(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldEQ(FieldRangeTo, v))
+}
+
+// SourceHash applies equality check predicate on the "source_hash" field. It's identical to SourceHashEQ.
+func SourceHash(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldEQ(FieldSourceHash, v))
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldEQ(FieldModel, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldEQ(FieldContent, v))
+}
+
+// Markdown applies equality check predicate on the "markdown" field. It's identical to MarkdownEQ.
+func Markdown(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldEQ(FieldMarkdown, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// WorkspaceIDEQ applies the EQ predicate on the "workspace_id" field.
+func WorkspaceIDEQ(v int) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDNEQ applies the NEQ predicate on the "workspace_id" field.
+func WorkspaceIDNEQ(v int) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldNEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDIn applies the In predicate on the "workspace_id" field.
+func WorkspaceIDIn(vs ...int) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDNotIn applies the NotIn predicate on the "workspace_id" field.
+func WorkspaceIDNotIn(vs ...int) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldNotIn(FieldWorkspaceID, vs...))
+}
+
+// RangeFromEQ applies the EQ predicate on the "range_from" field.
+func RangeFromEQ(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldEQ(FieldRangeFrom, v))
+}
+
+// RangeFromNEQ applies the NEQ predicate on the "range_from" field.
+func RangeFromNEQ(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldNEQ(FieldRangeFrom, v))
+}
+
+// RangeFromIn applies the In predicate on the "range_from" field.
+func RangeFromIn(vs ...time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldIn(FieldRangeFrom, vs...))
+}
+
+// RangeFromNotIn applies the NotIn predicate on the "range_from" field.
+func RangeFromNotIn(vs ...time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldNotIn(FieldRangeFrom, vs...))
+}
+
+// RangeFromGT applies the GT predicate on the "range_from" field.
+func RangeFromGT(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldGT(FieldRangeFrom, v))
+}
+
+// RangeFromGTE applies the GTE predicate on the "range_from" field.
+func RangeFromGTE(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldGTE(FieldRangeFrom, v))
+}
+
+// RangeFromLT applies the LT predicate on the "range_from" field.
+func RangeFromLT(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldLT(FieldRangeFrom, v))
+}
+
+// RangeFromLTE applies the LTE predicate on the "range_from" field.
+func RangeFromLTE(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldLTE(FieldRangeFrom, v))
+}
+
+// RangeToEQ applies the EQ predicate on the "range_to" field.
+func RangeToEQ(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldEQ(FieldRangeTo, v))
+}
+
+// RangeToNEQ applies the NEQ predicate on the "range_to" field.
+func RangeToNEQ(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldNEQ(FieldRangeTo, v))
+}
+
+// RangeToIn applies the In predicate on the "range_to" field.
+func RangeToIn(vs ...time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldIn(FieldRangeTo, vs...))
+}
+
+// RangeToNotIn applies the NotIn predicate on the "range_to" field.
+func RangeToNotIn(vs ...time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldNotIn(FieldRangeTo, vs...))
+}
+
+// RangeToGT applies the GT predicate on the "range_to" field.
+func RangeToGT(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldGT(FieldRangeTo, v))
+}
+
+// RangeToGTE applies the GTE predicate on the "range_to" field.
+func RangeToGTE(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldGTE(FieldRangeTo, v))
+}
+
+// RangeToLT applies the LT predicate on the "range_to" field.
+func RangeToLT(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldLT(FieldRangeTo, v))
+}
+
+// RangeToLTE applies the LTE predicate on the "range_to" field.
+func RangeToLTE(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldLTE(FieldRangeTo, v))
+}
+
+// SourceHashEQ applies the EQ predicate on the "source_hash" field.
+func SourceHashEQ(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldEQ(FieldSourceHash, v))
+}
+
+// SourceHashNEQ applies the NEQ predicate on the "source_hash" field.
+func SourceHashNEQ(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldNEQ(FieldSourceHash, v))
+}
+
+// SourceHashIn applies the In predicate on the "source_hash" field.
+func SourceHashIn(vs ...string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldIn(FieldSourceHash, vs...))
+}
+
+// SourceHashNotIn applies the NotIn predicate on the "source_hash" field.
+func SourceHashNotIn(vs ...string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldNotIn(FieldSourceHash, vs...))
+}
+
+// SourceHashGT applies the GT predicate on the "source_hash" field.
+func SourceHashGT(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldGT(FieldSourceHash, v))
+}
+
+// SourceHashGTE applies the GTE predicate on the "source_hash" field.
+func SourceHashGTE(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldGTE(FieldSourceHash, v))
+}
+
+// SourceHashLT applies the LT predicate on the "source_hash" field.
+func SourceHashLT(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldLT(FieldSourceHash, v))
+}
+
+// SourceHashLTE applies the LTE predicate on the "source_hash" field.
+func SourceHashLTE(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldLTE(FieldSourceHash, v))
+}
+
+// SourceHashContains applies the Contains predicate on the "source_hash" field.
+func SourceHashContains(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldContains(FieldSourceHash, v))
+}
+
+// SourceHashHasPrefix applies the HasPrefix predicate on the "source_hash" field.
+func SourceHashHasPrefix(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldHasPrefix(FieldSourceHash, v))
+}
+
+// SourceHashHasSuffix applies the HasSuffix predicate on the "source_hash" field.
+func SourceHashHasSuffix(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldHasSuffix(FieldSourceHash, v))
+}
+
+// SourceHashEqualFold applies the EqualFold predicate on the "source_hash" field.
+func SourceHashEqualFold(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldEqualFold(FieldSourceHash, v))
+}
+
+// SourceHashContainsFold applies the ContainsFold predicate on the "source_hash" field.
+func SourceHashContainsFold(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldContainsFold(FieldSourceHash, v))
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldEQ(FieldModel, v))
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldNEQ(FieldModel, v))
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldIn(FieldModel, vs...))
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldNotIn(FieldModel, vs...))
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldGT(FieldModel, v))
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldGTE(FieldModel, v))
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldLT(FieldModel, v))
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldLTE(FieldModel, v))
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldContains(FieldModel, v))
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldHasPrefix(FieldModel, v))
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldHasSuffix(FieldModel, v))
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldEqualFold(FieldModel, v))
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldContainsFold(FieldModel, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldContainsFold(FieldContent, v))
+}
+
+// MarkdownEQ applies the EQ predicate on the "markdown" field.
+func MarkdownEQ(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldEQ(FieldMarkdown, v))
+}
+
+// MarkdownNEQ applies the NEQ predicate on the "markdown" field.
+func MarkdownNEQ(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldNEQ(FieldMarkdown, v))
+}
+
+// MarkdownIn applies the In predicate on the "markdown" field.
+func MarkdownIn(vs ...string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldIn(FieldMarkdown, vs...))
+}
+
+// MarkdownNotIn applies the NotIn predicate on the "markdown" field.
+func MarkdownNotIn(vs ...string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldNotIn(FieldMarkdown, vs...))
+}
+
+// MarkdownGT applies the GT predicate on the "markdown" field.
+func MarkdownGT(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldGT(FieldMarkdown, v))
+}
+
+// MarkdownGTE applies the GTE predicate on the "markdown" field.
+func MarkdownGTE(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldGTE(FieldMarkdown, v))
+}
+
+// MarkdownLT applies the LT predicate on the "markdown" field.
+func MarkdownLT(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldLT(FieldMarkdown, v))
+}
+
+// MarkdownLTE applies the LTE predicate on the "markdown" field.
+func MarkdownLTE(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldLTE(FieldMarkdown, v))
+}
+
+// MarkdownContains applies the Contains predicate on the "markdown" field.
+func MarkdownContains(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldContains(FieldMarkdown, v))
+}
+
+// MarkdownHasPrefix applies the HasPrefix predicate on the "markdown" field.
+func MarkdownHasPrefix(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldHasPrefix(FieldMarkdown, v))
+}
+
+// MarkdownHasSuffix applies the HasSuffix predicate on the "markdown" field.
+func MarkdownHasSuffix(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldHasSuffix(FieldMarkdown, v))
+}
+
+// MarkdownEqualFold applies the EqualFold predicate on the "markdown" field.
+func MarkdownEqualFold(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldEqualFold(FieldMarkdown, v))
+}
+
+// MarkdownContainsFold applies the ContainsFold predicate on the "markdown" field.
+func MarkdownContainsFold(v string) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldContainsFold(FieldMarkdown, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.TodoSummary {
+	return predicate.TodoSummary(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.TodoSummary {
+	return predicate.TodoSummary(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWorkspace applies the HasEdge predicate on the "workspace" edge.
+func HasWorkspace() predicate.TodoSummary {
+	return predicate.TodoSummary(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WorkspaceTable, WorkspaceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkspaceWith applies the HasEdge predicate on the "workspace" edge with a given conditions (other predicates).
+func HasWorkspaceWith(preds ...predicate.Workspace) predicate.TodoSummary {
+	return predicate.TodoSummary(func(s *sql.Selector) {
+		step := newWorkspaceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TodoSummary) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TodoSummary) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TodoSummary) predicate.TodoSummary {
+	return predicate.TodoSummary(sql.NotPredicates(p))
+}
@@ -0,0 +1,409 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo-app/ent/todosummary"
+	"todo-app/ent/user"
+	"todo-app/ent/workspace"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TodoSummaryCreate is the builder for creating a TodoSummary entity.
+type TodoSummaryCreate struct {
+	config
+	mutation *TodoSummaryMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *TodoSummaryCreate) SetUserID(v int) *TodoSummaryCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *TodoSummaryCreate) SetWorkspaceID(v int) *TodoSummaryCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetRangeFrom sets the "range_from" field.
+func (_c *TodoSummaryCreate) SetRangeFrom(v time.Time) *TodoSummaryCreate {
+	_c.mutation.SetRangeFrom(v)
+	return _c
+}
+
+// SetRangeTo sets the "range_to" field.
+func (_c *TodoSummaryCreate) SetRangeTo(v time.Time) *TodoSummaryCreate {
+	_c.mutation.SetRangeTo(v)
+	return _c
+}
+
+// SetSourceHash sets the "source_hash" field.
+func (_c *TodoSummaryCreate) SetSourceHash(v string) *TodoSummaryCreate {
+	_c.mutation.SetSourceHash(v)
+	return _c
+}
+
+// SetModel sets the "model" field.
+func (_c *TodoSummaryCreate) SetModel(v string) *TodoSummaryCreate {
+	_c.mutation.SetModel(v)
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *TodoSummaryCreate) SetContent(v string) *TodoSummaryCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetMarkdown sets the "markdown" field.
+func (_c *TodoSummaryCreate) SetMarkdown(v string) *TodoSummaryCreate {
+	_c.mutation.SetMarkdown(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TodoSummaryCreate) SetCreatedAt(v time.Time) *TodoSummaryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TodoSummaryCreate) SetNillableCreatedAt(v *time.Time) *TodoSummaryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TodoSummaryCreate) SetUpdatedAt(v time.Time) *TodoSummaryCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TodoSummaryCreate) SetNillableUpdatedAt(v *time.Time) *TodoSummaryCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TodoSummaryCreate) SetID(v uuid.UUID) *TodoSummaryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TodoSummaryCreate) SetNillableID(v *uuid.UUID) *TodoSummaryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *TodoSummaryCreate) SetUser(v *User) *TodoSummaryCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (_c *TodoSummaryCreate) SetWorkspace(v *Workspace) *TodoSummaryCreate {
+	return _c.SetWorkspaceID(v.ID)
+}
+
+// Mutation returns the TodoSummaryMutation object of the builder.
+func (_c *TodoSummaryCreate) Mutation() *TodoSummaryMutation {
+	return _c.mutation
+}
+
+// Save creates the TodoSummary in the database.
+func (_c *TodoSummaryCreate) Save(ctx context.Context) (*TodoSummary, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TodoSummaryCreate) SaveX(ctx context.Context) *TodoSummary {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TodoSummaryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TodoSummaryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TodoSummaryCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := todosummary.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := todosummary.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := todosummary.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TodoSummaryCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "TodoSummary.user_id"`)}
+	}
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "TodoSummary.workspace_id"`)}
+	}
+	if _, ok := _c.mutation.RangeFrom(); !ok {
+		return &ValidationError{Name: "range_from", err: errors.New(`ent: missing required field "TodoSummary.range_from"`)}
+	}
+	if _, ok := _c.mutation.RangeTo(); !ok {
+		return &ValidationError{Name: "range_to", err: errors.New(`ent: missing required field "TodoSummary.range_to"`)}
+	}
+	if _, ok := _c.mutation.SourceHash(); !ok {
+		return &ValidationError{Name: "source_hash", err: errors.New(`ent: missing required field "TodoSummary.source_hash"`)}
+	}
+	if v, ok := _c.mutation.SourceHash(); ok {
+		if err := todosummary.SourceHashValidator(v); err != nil {
+			return &ValidationError{Name: "source_hash", err: fmt.Errorf(`ent: validator failed for field "TodoSummary.source_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "TodoSummary.model"`)}
+	}
+	if v, ok := _c.mutation.Model(); ok {
+		if err := todosummary.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "TodoSummary.model": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "TodoSummary.content"`)}
+	}
+	if _, ok := _c.mutation.Markdown(); !ok {
+		return &ValidationError{Name: "markdown", err: errors.New(`ent: missing required field "TodoSummary.markdown"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TodoSummary.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TodoSummary.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "TodoSummary.user"`)}
+	}
+	if len(_c.mutation.WorkspaceIDs()) == 0 {
+		return &ValidationError{Name: "workspace", err: errors.New(`ent: missing required edge "TodoSummary.workspace"`)}
+	}
+	return nil
+}
+
+func (_c *TodoSummaryCreate) sqlSave(ctx context.Context) (*TodoSummary, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TodoSummaryCreate) createSpec() (*TodoSummary, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TodoSummary{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(todosummary.Table, sqlgraph.NewFieldSpec(todosummary.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.RangeFrom(); ok {
+		_spec.SetField(todosummary.FieldRangeFrom, field.TypeTime, value)
+		_node.RangeFrom = value
+	}
+	if value, ok := _c.mutation.RangeTo(); ok {
+		_spec.SetField(todosummary.FieldRangeTo, field.TypeTime, value)
+		_node.RangeTo = value
+	}
+	if value, ok := _c.mutation.SourceHash(); ok {
+		_spec.SetField(todosummary.FieldSourceHash, field.TypeString, value)
+		_node.SourceHash = value
+	}
+	if value, ok := _c.mutation.Model(); ok {
+		_spec.SetField(todosummary.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(todosummary.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.Markdown(); ok {
+		_spec.SetField(todosummary.FieldMarkdown, field.TypeString, value)
+		_node.Markdown = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(todosummary.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(todosummary.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todosummary.UserTable,
+			Columns: []string{todosummary.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todosummary.WorkspaceTable,
+			Columns: []string{todosummary.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkspaceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TodoSummaryCreateBulk is the builder for creating many TodoSummary entities in bulk.
+type TodoSummaryCreateBulk struct {
+	config
+	err      error
+	builders []*TodoSummaryCreate
+}
+
+// Save creates the TodoSummary entities in the database.
+func (_c *TodoSummaryCreateBulk) Save(ctx context.Context) ([]*TodoSummary, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TodoSummary, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TodoSummaryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TodoSummaryCreateBulk) SaveX(ctx context.Context) []*TodoSummary {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TodoSummaryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TodoSummaryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
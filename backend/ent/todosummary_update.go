@@ -0,0 +1,573 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo-app/ent/predicate"
+	"todo-app/ent/todosummary"
+	"todo-app/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TodoSummaryUpdate is the builder for updating TodoSummary entities.
+type TodoSummaryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TodoSummaryMutation
+}
+
+// Where appends a list predicates to the TodoSummaryUpdate builder.
+func (_u *TodoSummaryUpdate) Where(ps ...predicate.TodoSummary) *TodoSummaryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *TodoSummaryUpdate) SetUserID(v int) *TodoSummaryUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *TodoSummaryUpdate) SetNillableUserID(v *int) *TodoSummaryUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetRangeFrom sets the "range_from" field.
+func (_u *TodoSummaryUpdate) SetRangeFrom(v time.Time) *TodoSummaryUpdate {
+	_u.mutation.SetRangeFrom(v)
+	return _u
+}
+
+// SetNillableRangeFrom sets the "range_from" field if the given value is not nil.
+func (_u *TodoSummaryUpdate) SetNillableRangeFrom(v *time.Time) *TodoSummaryUpdate {
+	if v != nil {
+		_u.SetRangeFrom(*v)
+	}
+	return _u
+}
+
+// SetRangeTo sets the "range_to" field.
+func (_u *TodoSummaryUpdate) SetRangeTo(v time.Time) *TodoSummaryUpdate {
+	_u.mutation.SetRangeTo(v)
+	return _u
+}
+
+// SetNillableRangeTo sets the "range_to" field if the given value is not nil.
+func (_u *TodoSummaryUpdate) SetNillableRangeTo(v *time.Time) *TodoSummaryUpdate {
+	if v != nil {
+		_u.SetRangeTo(*v)
+	}
+	return _u
+}
+
+// SetSourceHash sets the "source_hash" field.
+func (_u *TodoSummaryUpdate) SetSourceHash(v string) *TodoSummaryUpdate {
+	_u.mutation.SetSourceHash(v)
+	return _u
+}
+
+// SetNillableSourceHash sets the "source_hash" field if the given value is not nil.
+func (_u *TodoSummaryUpdate) SetNillableSourceHash(v *string) *TodoSummaryUpdate {
+	if v != nil {
+		_u.SetSourceHash(*v)
+	}
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *TodoSummaryUpdate) SetModel(v string) *TodoSummaryUpdate {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *TodoSummaryUpdate) SetNillableModel(v *string) *TodoSummaryUpdate {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *TodoSummaryUpdate) SetContent(v string) *TodoSummaryUpdate {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *TodoSummaryUpdate) SetNillableContent(v *string) *TodoSummaryUpdate {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetMarkdown sets the "markdown" field.
+func (_u *TodoSummaryUpdate) SetMarkdown(v string) *TodoSummaryUpdate {
+	_u.mutation.SetMarkdown(v)
+	return _u
+}
+
+// SetNillableMarkdown sets the "markdown" field if the given value is not nil.
+func (_u *TodoSummaryUpdate) SetNillableMarkdown(v *string) *TodoSummaryUpdate {
+	if v != nil {
+		_u.SetMarkdown(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TodoSummaryUpdate) SetUpdatedAt(v time.Time) *TodoSummaryUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *TodoSummaryUpdate) SetUser(v *User) *TodoSummaryUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the TodoSummaryMutation object of the builder.
+func (_u *TodoSummaryUpdate) Mutation() *TodoSummaryMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *TodoSummaryUpdate) ClearUser() *TodoSummaryUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TodoSummaryUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TodoSummaryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TodoSummaryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TodoSummaryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TodoSummaryUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := todosummary.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TodoSummaryUpdate) check() error {
+	if v, ok := _u.mutation.SourceHash(); ok {
+		if err := todosummary.SourceHashValidator(v); err != nil {
+			return &ValidationError{Name: "source_hash", err: fmt.Errorf(`ent: validator failed for field "TodoSummary.source_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Model(); ok {
+		if err := todosummary.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "TodoSummary.model": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoSummary.user"`)
+	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoSummary.workspace"`)
+	}
+	return nil
+}
+
+func (_u *TodoSummaryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(todosummary.Table, todosummary.Columns, sqlgraph.NewFieldSpec(todosummary.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.RangeFrom(); ok {
+		_spec.SetField(todosummary.FieldRangeFrom, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RangeTo(); ok {
+		_spec.SetField(todosummary.FieldRangeTo, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.SourceHash(); ok {
+		_spec.SetField(todosummary.FieldSourceHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(todosummary.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(todosummary.FieldContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Markdown(); ok {
+		_spec.SetField(todosummary.FieldMarkdown, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(todosummary.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todosummary.UserTable,
+			Columns: []string{todosummary.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todosummary.UserTable,
+			Columns: []string{todosummary.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todosummary.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TodoSummaryUpdateOne is the builder for updating a single TodoSummary entity.
+type TodoSummaryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TodoSummaryMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *TodoSummaryUpdateOne) SetUserID(v int) *TodoSummaryUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *TodoSummaryUpdateOne) SetNillableUserID(v *int) *TodoSummaryUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetRangeFrom sets the "range_from" field.
+func (_u *TodoSummaryUpdateOne) SetRangeFrom(v time.Time) *TodoSummaryUpdateOne {
+	_u.mutation.SetRangeFrom(v)
+	return _u
+}
+
+// SetNillableRangeFrom sets the "range_from" field if the given value is not nil.
+func (_u *TodoSummaryUpdateOne) SetNillableRangeFrom(v *time.Time) *TodoSummaryUpdateOne {
+	if v != nil {
+		_u.SetRangeFrom(*v)
+	}
+	return _u
+}
+
+// SetRangeTo sets the "range_to" field.
+func (_u *TodoSummaryUpdateOne) SetRangeTo(v time.Time) *TodoSummaryUpdateOne {
+	_u.mutation.SetRangeTo(v)
+	return _u
+}
+
+// SetNillableRangeTo sets the "range_to" field if the given value is not nil.
+func (_u *TodoSummaryUpdateOne) SetNillableRangeTo(v *time.Time) *TodoSummaryUpdateOne {
+	if v != nil {
+		_u.SetRangeTo(*v)
+	}
+	return _u
+}
+
+// SetSourceHash sets the "source_hash" field.
+func (_u *TodoSummaryUpdateOne) SetSourceHash(v string) *TodoSummaryUpdateOne {
+	_u.mutation.SetSourceHash(v)
+	return _u
+}
+
+// SetNillableSourceHash sets the "source_hash" field if the given value is not nil.
+func (_u *TodoSummaryUpdateOne) SetNillableSourceHash(v *string) *TodoSummaryUpdateOne {
+	if v != nil {
+		_u.SetSourceHash(*v)
+	}
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *TodoSummaryUpdateOne) SetModel(v string) *TodoSummaryUpdateOne {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *TodoSummaryUpdateOne) SetNillableModel(v *string) *TodoSummaryUpdateOne {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *TodoSummaryUpdateOne) SetContent(v string) *TodoSummaryUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *TodoSummaryUpdateOne) SetNillableContent(v *string) *TodoSummaryUpdateOne {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetMarkdown sets the "markdown" field.
+func (_u *TodoSummaryUpdateOne) SetMarkdown(v string) *TodoSummaryUpdateOne {
+	_u.mutation.SetMarkdown(v)
+	return _u
+}
+
+// SetNillableMarkdown sets the "markdown" field if the given value is not nil.
+func (_u *TodoSummaryUpdateOne) SetNillableMarkdown(v *string) *TodoSummaryUpdateOne {
+	if v != nil {
+		_u.SetMarkdown(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TodoSummaryUpdateOne) SetUpdatedAt(v time.Time) *TodoSummaryUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *TodoSummaryUpdateOne) SetUser(v *User) *TodoSummaryUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the TodoSummaryMutation object of the builder.
+func (_u *TodoSummaryUpdateOne) Mutation() *TodoSummaryMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *TodoSummaryUpdateOne) ClearUser() *TodoSummaryUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the TodoSummaryUpdate builder.
+func (_u *TodoSummaryUpdateOne) Where(ps ...predicate.TodoSummary) *TodoSummaryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TodoSummaryUpdateOne) Select(field string, fields ...string) *TodoSummaryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TodoSummary entity.
+func (_u *TodoSummaryUpdateOne) Save(ctx context.Context) (*TodoSummary, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TodoSummaryUpdateOne) SaveX(ctx context.Context) *TodoSummary {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TodoSummaryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TodoSummaryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TodoSummaryUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := todosummary.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TodoSummaryUpdateOne) check() error {
+	if v, ok := _u.mutation.SourceHash(); ok {
+		if err := todosummary.SourceHashValidator(v); err != nil {
+			return &ValidationError{Name: "source_hash", err: fmt.Errorf(`ent: validator failed for field "TodoSummary.source_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Model(); ok {
+		if err := todosummary.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "TodoSummary.model": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoSummary.user"`)
+	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoSummary.workspace"`)
+	}
+	return nil
+}
+
+func (_u *TodoSummaryUpdateOne) sqlSave(ctx context.Context) (_node *TodoSummary, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(todosummary.Table, todosummary.Columns, sqlgraph.NewFieldSpec(todosummary.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TodoSummary.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, todosummary.FieldID)
+		for _, f := range fields {
+			if !todosummary.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != todosummary.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.RangeFrom(); ok {
+		_spec.SetField(todosummary.FieldRangeFrom, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RangeTo(); ok {
+		_spec.SetField(todosummary.FieldRangeTo, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.SourceHash(); ok {
+		_spec.SetField(todosummary.FieldSourceHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(todosummary.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(todosummary.FieldContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Markdown(); ok {
+		_spec.SetField(todosummary.FieldMarkdown, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(todosummary.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todosummary.UserTable,
+			Columns: []string{todosummary.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todosummary.UserTable,
+			Columns: []string{todosummary.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TodoSummary{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todosummary.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
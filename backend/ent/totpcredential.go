@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"todo-app/ent/totpcredential"
+	"todo-app/ent/user"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TOTPCredential is the model entity for the TOTPCredential schema.
+type TOTPCredential struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Secret holds the value of the "secret" field.
+	Secret string `json:"-"`
+	// ConfirmedAt holds the value of the "confirmed_at" field.
+	ConfirmedAt *time.Time `json:"confirmed_at,omitempty"`
+	// LastUsedStep holds the value of the "last_used_step" field.
+	LastUsedStep int64 `json:"last_used_step,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TOTPCredentialQuery when eager-loading is set.
+	Edges        TOTPCredentialEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TOTPCredentialEdges holds the relations/edges for other nodes in the graph.
+type TOTPCredentialEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TOTPCredentialEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TOTPCredential) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case totpcredential.FieldID, totpcredential.FieldUserID, totpcredential.FieldLastUsedStep:
+			values[i] = new(sql.NullInt64)
+		case totpcredential.FieldSecret:
+			values[i] = new(sql.NullString)
+		case totpcredential.FieldConfirmedAt, totpcredential.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TOTPCredential fields.
+func (_m *TOTPCredential) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case totpcredential.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case totpcredential.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case totpcredential.FieldSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				_m.Secret = value.String
+			}
+		case totpcredential.FieldConfirmedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field confirmed_at", values[i])
+			} else if value.Valid {
+				_m.ConfirmedAt = new(time.Time)
+				*_m.ConfirmedAt = value.Time
+			}
+		case totpcredential.FieldLastUsedStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_step", values[i])
+			} else if value.Valid {
+				_m.LastUsedStep = value.Int64
+			}
+		case totpcredential.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TOTPCredential.
+// This includes values selected through modifiers, order, etc.
+func (_m *TOTPCredential) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the TOTPCredential entity.
+func (_m *TOTPCredential) QueryUser() *UserQuery {
+	return NewTOTPCredentialClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this TOTPCredential.
+// Note that you need to call TOTPCredential.Unwrap() before calling this method if this TOTPCredential
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TOTPCredential) Update() *TOTPCredentialUpdateOne {
+	return NewTOTPCredentialClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TOTPCredential entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TOTPCredential) Unwrap() *TOTPCredential {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TOTPCredential is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TOTPCredential) String() string {
+	var builder strings.Builder
+	builder.WriteString("TOTPCredential(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("secret=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.ConfirmedAt; v != nil {
+		builder.WriteString("confirmed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_used_step=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastUsedStep))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TOTPCredentials is a parsable slice of TOTPCredential.
+type TOTPCredentials []*TOTPCredential
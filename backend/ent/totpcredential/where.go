@@ -0,0 +1,334 @@
+// Code generated by ent, DO NOT EDIT.
+
+package totpcredential
+
+import (
+	"time"
+	"todo-app/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldEQ(FieldUserID, v))
+}
+
+// Secret applies equality check predicate on the "secret" field. It's identical to SecretEQ.
+func Secret(v string) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldEQ(FieldSecret, v))
+}
+
+// ConfirmedAt applies equality check predicate on the "confirmed_at" field. It's identical to ConfirmedAtEQ.
+func ConfirmedAt(v time.Time) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldEQ(FieldConfirmedAt, v))
+}
+
+// LastUsedStep applies equality check predicate on the "last_used_step" field. It's identical to LastUsedStepEQ.
+func LastUsedStep(v int64) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldEQ(FieldLastUsedStep, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// SecretEQ applies the EQ predicate on the "secret" field.
+func SecretEQ(v string) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldEQ(FieldSecret, v))
+}
+
+// SecretNEQ applies the NEQ predicate on the "secret" field.
+func SecretNEQ(v string) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldNEQ(FieldSecret, v))
+}
+
+// SecretIn applies the In predicate on the "secret" field.
+func SecretIn(vs ...string) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldIn(FieldSecret, vs...))
+}
+
+// SecretNotIn applies the NotIn predicate on the "secret" field.
+func SecretNotIn(vs ...string) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldNotIn(FieldSecret, vs...))
+}
+
+// SecretGT applies the GT predicate on the "secret" field.
+func SecretGT(v string) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldGT(FieldSecret, v))
+}
+
+// SecretGTE applies the GTE predicate on the "secret" field.
+func SecretGTE(v string) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldGTE(FieldSecret, v))
+}
+
+// SecretLT applies the LT predicate on the "secret" field.
+func SecretLT(v string) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldLT(FieldSecret, v))
+}
+
+// SecretLTE applies the LTE predicate on the "secret" field.
+func SecretLTE(v string) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldLTE(FieldSecret, v))
+}
+
+// SecretContains applies the Contains predicate on the "secret" field.
+func SecretContains(v string) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldContains(FieldSecret, v))
+}
+
+// SecretHasPrefix applies the HasPrefix predicate on the "secret" field.
+func SecretHasPrefix(v string) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldHasPrefix(FieldSecret, v))
+}
+
+// SecretHasSuffix applies the HasSuffix predicate on the "secret" field.
+func SecretHasSuffix(v string) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldHasSuffix(FieldSecret, v))
+}
+
+// SecretEqualFold applies the EqualFold predicate on the "secret" field.
+func SecretEqualFold(v string) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldEqualFold(FieldSecret, v))
+}
+
+// SecretContainsFold applies the ContainsFold predicate on the "secret" field.
+func SecretContainsFold(v string) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldContainsFold(FieldSecret, v))
+}
+
+// ConfirmedAtEQ applies the EQ predicate on the "confirmed_at" field.
+func ConfirmedAtEQ(v time.Time) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldEQ(FieldConfirmedAt, v))
+}
+
+// ConfirmedAtNEQ applies the NEQ predicate on the "confirmed_at" field.
+func ConfirmedAtNEQ(v time.Time) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldNEQ(FieldConfirmedAt, v))
+}
+
+// ConfirmedAtIn applies the In predicate on the "confirmed_at" field.
+func ConfirmedAtIn(vs ...time.Time) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldIn(FieldConfirmedAt, vs...))
+}
+
+// ConfirmedAtNotIn applies the NotIn predicate on the "confirmed_at" field.
+func ConfirmedAtNotIn(vs ...time.Time) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldNotIn(FieldConfirmedAt, vs...))
+}
+
+// ConfirmedAtGT applies the GT predicate on the "confirmed_at" field.
+func ConfirmedAtGT(v time.Time) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldGT(FieldConfirmedAt, v))
+}
+
+// ConfirmedAtGTE applies the GTE predicate on the "confirmed_at" field.
+func ConfirmedAtGTE(v time.Time) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldGTE(FieldConfirmedAt, v))
+}
+
+// ConfirmedAtLT applies the LT predicate on the "confirmed_at" field.
+func ConfirmedAtLT(v time.Time) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldLT(FieldConfirmedAt, v))
+}
+
+// ConfirmedAtLTE applies the LTE predicate on the "confirmed_at" field.
+func ConfirmedAtLTE(v time.Time) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldLTE(FieldConfirmedAt, v))
+}
+
+// ConfirmedAtIsNil applies the IsNil predicate on the "confirmed_at" field.
+func ConfirmedAtIsNil() predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldIsNull(FieldConfirmedAt))
+}
+
+// ConfirmedAtNotNil applies the NotNil predicate on the "confirmed_at" field.
+func ConfirmedAtNotNil() predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldNotNull(FieldConfirmedAt))
+}
+
+// LastUsedStepEQ applies the EQ predicate on the "last_used_step" field.
+func LastUsedStepEQ(v int64) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldEQ(FieldLastUsedStep, v))
+}
+
+// LastUsedStepNEQ applies the NEQ predicate on the "last_used_step" field.
+func LastUsedStepNEQ(v int64) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldNEQ(FieldLastUsedStep, v))
+}
+
+// LastUsedStepIn applies the In predicate on the "last_used_step" field.
+func LastUsedStepIn(vs ...int64) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldIn(FieldLastUsedStep, vs...))
+}
+
+// LastUsedStepNotIn applies the NotIn predicate on the "last_used_step" field.
+func LastUsedStepNotIn(vs ...int64) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldNotIn(FieldLastUsedStep, vs...))
+}
+
+// LastUsedStepGT applies the GT predicate on the "last_used_step" field.
+func LastUsedStepGT(v int64) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldGT(FieldLastUsedStep, v))
+}
+
+// LastUsedStepGTE applies the GTE predicate on the "last_used_step" field.
+func LastUsedStepGTE(v int64) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldGTE(FieldLastUsedStep, v))
+}
+
+// LastUsedStepLT applies the LT predicate on the "last_used_step" field.
+func LastUsedStepLT(v int64) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldLT(FieldLastUsedStep, v))
+}
+
+// LastUsedStepLTE applies the LTE predicate on the "last_used_step" field.
+func LastUsedStepLTE(v int64) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldLTE(FieldLastUsedStep, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.TOTPCredential {
+	return predicate.TOTPCredential(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.TOTPCredential {
+	return predicate.TOTPCredential(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TOTPCredential) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TOTPCredential) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TOTPCredential) predicate.TOTPCredential {
+	return predicate.TOTPCredential(sql.NotPredicates(p))
+}
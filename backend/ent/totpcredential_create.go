@@ -0,0 +1,298 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo-app/ent/totpcredential"
+	"todo-app/ent/user"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TOTPCredentialCreate is the builder for creating a TOTPCredential entity.
+type TOTPCredentialCreate struct {
+	config
+	mutation *TOTPCredentialMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *TOTPCredentialCreate) SetUserID(v int) *TOTPCredentialCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetSecret sets the "secret" field.
+func (_c *TOTPCredentialCreate) SetSecret(v string) *TOTPCredentialCreate {
+	_c.mutation.SetSecret(v)
+	return _c
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (_c *TOTPCredentialCreate) SetConfirmedAt(v time.Time) *TOTPCredentialCreate {
+	_c.mutation.SetConfirmedAt(v)
+	return _c
+}
+
+// SetNillableConfirmedAt sets the "confirmed_at" field if the given value is not nil.
+func (_c *TOTPCredentialCreate) SetNillableConfirmedAt(v *time.Time) *TOTPCredentialCreate {
+	if v != nil {
+		_c.SetConfirmedAt(*v)
+	}
+	return _c
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (_c *TOTPCredentialCreate) SetLastUsedStep(v int64) *TOTPCredentialCreate {
+	_c.mutation.SetLastUsedStep(v)
+	return _c
+}
+
+// SetNillableLastUsedStep sets the "last_used_step" field if the given value is not nil.
+func (_c *TOTPCredentialCreate) SetNillableLastUsedStep(v *int64) *TOTPCredentialCreate {
+	if v != nil {
+		_c.SetLastUsedStep(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TOTPCredentialCreate) SetCreatedAt(v time.Time) *TOTPCredentialCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TOTPCredentialCreate) SetNillableCreatedAt(v *time.Time) *TOTPCredentialCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *TOTPCredentialCreate) SetUser(v *User) *TOTPCredentialCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the TOTPCredentialMutation object of the builder.
+func (_c *TOTPCredentialCreate) Mutation() *TOTPCredentialMutation {
+	return _c.mutation
+}
+
+// Save creates the TOTPCredential in the database.
+func (_c *TOTPCredentialCreate) Save(ctx context.Context) (*TOTPCredential, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TOTPCredentialCreate) SaveX(ctx context.Context) *TOTPCredential {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TOTPCredentialCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TOTPCredentialCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TOTPCredentialCreate) defaults() {
+	if _, ok := _c.mutation.LastUsedStep(); !ok {
+		v := totpcredential.DefaultLastUsedStep
+		_c.mutation.SetLastUsedStep(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := totpcredential.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TOTPCredentialCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "TOTPCredential.user_id"`)}
+	}
+	if _, ok := _c.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`ent: missing required field "TOTPCredential.secret"`)}
+	}
+	if v, ok := _c.mutation.Secret(); ok {
+		if err := totpcredential.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "TOTPCredential.secret": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LastUsedStep(); !ok {
+		return &ValidationError{Name: "last_used_step", err: errors.New(`ent: missing required field "TOTPCredential.last_used_step"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TOTPCredential.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "TOTPCredential.user"`)}
+	}
+	return nil
+}
+
+func (_c *TOTPCredentialCreate) sqlSave(ctx context.Context) (*TOTPCredential, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TOTPCredentialCreate) createSpec() (*TOTPCredential, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TOTPCredential{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(totpcredential.Table, sqlgraph.NewFieldSpec(totpcredential.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Secret(); ok {
+		_spec.SetField(totpcredential.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := _c.mutation.ConfirmedAt(); ok {
+		_spec.SetField(totpcredential.FieldConfirmedAt, field.TypeTime, value)
+		_node.ConfirmedAt = &value
+	}
+	if value, ok := _c.mutation.LastUsedStep(); ok {
+		_spec.SetField(totpcredential.FieldLastUsedStep, field.TypeInt64, value)
+		_node.LastUsedStep = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(totpcredential.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   totpcredential.UserTable,
+			Columns: []string{totpcredential.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TOTPCredentialCreateBulk is the builder for creating many TOTPCredential entities in bulk.
+type TOTPCredentialCreateBulk struct {
+	config
+	err      error
+	builders []*TOTPCredentialCreate
+}
+
+// Save creates the TOTPCredential entities in the database.
+func (_c *TOTPCredentialCreateBulk) Save(ctx context.Context) ([]*TOTPCredential, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TOTPCredential, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TOTPCredentialMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TOTPCredentialCreateBulk) SaveX(ctx context.Context) []*TOTPCredential {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TOTPCredentialCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TOTPCredentialCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -12,12 +12,56 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AIUsage is the client for interacting with the AIUsage builders.
+	AIUsage *AIUsageClient
+	// AccountDeletionToken is the client for interacting with the AccountDeletionToken builders.
+	AccountDeletionToken *AccountDeletionTokenClient
+	// AdminAuditLog is the client for interacting with the AdminAuditLog builders.
+	AdminAuditLog *AdminAuditLogClient
+	// EmailChangeToken is the client for interacting with the EmailChangeToken builders.
+	EmailChangeToken *EmailChangeTokenClient
+	// LoginAttempt is the client for interacting with the LoginAttempt builders.
+	LoginAttempt *LoginAttemptClient
+	// PasswordResetToken is the client for interacting with the PasswordResetToken builders.
+	PasswordResetToken *PasswordResetTokenClient
+	// PersonalAccessToken is the client for interacting with the PersonalAccessToken builders.
+	PersonalAccessToken *PersonalAccessTokenClient
+	// RecoveryCode is the client for interacting with the RecoveryCode builders.
+	RecoveryCode *RecoveryCodeClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
+	// TOTPCredential is the client for interacting with the TOTPCredential builders.
+	TOTPCredential *TOTPCredentialClient
 	// Todo is the client for interacting with the Todo builders.
 	Todo *TodoClient
+	// TodoBreakdown is the client for interacting with the TodoBreakdown builders.
+	TodoBreakdown *TodoBreakdownClient
+	// TodoEmbedding is the client for interacting with the TodoEmbedding builders.
+	TodoEmbedding *TodoEmbeddingClient
 	// TodoFilterHistory is the client for interacting with the TodoFilterHistory builders.
 	TodoFilterHistory *TodoFilterHistoryClient
+	// TodoList is the client for interacting with the TodoList builders.
+	TodoList *TodoListClient
+	// TodoListInvite is the client for interacting with the TodoListInvite builders.
+	TodoListInvite *TodoListInviteClient
+	// TodoListMember is the client for interacting with the TodoListMember builders.
+	TodoListMember *TodoListMemberClient
+	// TodoSummary is the client for interacting with the TodoSummary builders.
+	TodoSummary *TodoSummaryClient
+	// UsedMFAToken is the client for interacting with the UsedMFAToken builders.
+	UsedMFAToken *UsedMFATokenClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserIdentity is the client for interacting with the UserIdentity builders.
+	UserIdentity *UserIdentityClient
+	// Workspace is the client for interacting with the Workspace builders.
+	Workspace *WorkspaceClient
+	// WorkspaceInvite is the client for interacting with the WorkspaceInvite builders.
+	WorkspaceInvite *WorkspaceInviteClient
+	// WorkspaceMember is the client for interacting with the WorkspaceMember builders.
+	WorkspaceMember *WorkspaceMemberClient
 
 	// lazily loaded.
 	client     *Client
@@ -149,9 +193,31 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AIUsage = NewAIUsageClient(tx.config)
+	tx.AccountDeletionToken = NewAccountDeletionTokenClient(tx.config)
+	tx.AdminAuditLog = NewAdminAuditLogClient(tx.config)
+	tx.EmailChangeToken = NewEmailChangeTokenClient(tx.config)
+	tx.LoginAttempt = NewLoginAttemptClient(tx.config)
+	tx.PasswordResetToken = NewPasswordResetTokenClient(tx.config)
+	tx.PersonalAccessToken = NewPersonalAccessTokenClient(tx.config)
+	tx.RecoveryCode = NewRecoveryCodeClient(tx.config)
+	tx.RefreshToken = NewRefreshTokenClient(tx.config)
+	tx.Session = NewSessionClient(tx.config)
+	tx.TOTPCredential = NewTOTPCredentialClient(tx.config)
 	tx.Todo = NewTodoClient(tx.config)
+	tx.TodoBreakdown = NewTodoBreakdownClient(tx.config)
+	tx.TodoEmbedding = NewTodoEmbeddingClient(tx.config)
 	tx.TodoFilterHistory = NewTodoFilterHistoryClient(tx.config)
+	tx.TodoList = NewTodoListClient(tx.config)
+	tx.TodoListInvite = NewTodoListInviteClient(tx.config)
+	tx.TodoListMember = NewTodoListMemberClient(tx.config)
+	tx.TodoSummary = NewTodoSummaryClient(tx.config)
+	tx.UsedMFAToken = NewUsedMFATokenClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserIdentity = NewUserIdentityClient(tx.config)
+	tx.Workspace = NewWorkspaceClient(tx.config)
+	tx.WorkspaceInvite = NewWorkspaceInviteClient(tx.config)
+	tx.WorkspaceMember = NewWorkspaceMemberClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -161,7 +227,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Todo.QueryXXX(), the query will be executed
+// applies a query, for example: AIUsage.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
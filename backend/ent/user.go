@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"strings"
 	"time"
+	"todo-app/ent/totpcredential"
 	"todo-app/ent/user"
+	"todo-app/ent/workspace"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -23,6 +25,22 @@ type User struct {
 	Email string `json:"email,omitempty"`
 	// Password holds the value of the "password" field.
 	Password string `json:"password,omitempty"`
+	// EmailVerifiedAt holds the value of the "email_verified_at" field.
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	// Locale holds the value of the "locale" field.
+	Locale string `json:"locale,omitempty"`
+	// TimeZone holds the value of the "time_zone" field.
+	TimeZone string `json:"time_zone,omitempty"`
+	// TodoPageSize holds the value of the "todo_page_size" field.
+	TodoPageSize int `json:"todo_page_size,omitempty"`
+	// TodoIncludeDone holds the value of the "todo_include_done" field.
+	TodoIncludeDone bool `json:"todo_include_done,omitempty"`
+	// DeletionScheduledAt holds the value of the "deletion_scheduled_at" field.
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at,omitempty"`
+	// Role holds the value of the "role" field.
+	Role string `json:"role,omitempty"`
+	// DisabledAt holds the value of the "disabled_at" field.
+	DisabledAt *time.Time `json:"disabled_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -37,9 +55,41 @@ type UserEdges struct {
 	Todos []*Todo `json:"todos,omitempty"`
 	// TodoFilterHistories holds the value of the todo_filter_histories edge.
 	TodoFilterHistories []*TodoFilterHistory `json:"todo_filter_histories,omitempty"`
+	// TodoBreakdowns holds the value of the todo_breakdowns edge.
+	TodoBreakdowns []*TodoBreakdown `json:"todo_breakdowns,omitempty"`
+	// TodoSummaries holds the value of the todo_summaries edge.
+	TodoSummaries []*TodoSummary `json:"todo_summaries,omitempty"`
+	// AiUsages holds the value of the ai_usages edge.
+	AiUsages []*AIUsage `json:"ai_usages,omitempty"`
+	// Sessions holds the value of the sessions edge.
+	Sessions []*Session `json:"sessions,omitempty"`
+	// PasswordResetTokens holds the value of the password_reset_tokens edge.
+	PasswordResetTokens []*PasswordResetToken `json:"password_reset_tokens,omitempty"`
+	// PersonalAccessTokens holds the value of the personal_access_tokens edge.
+	PersonalAccessTokens []*PersonalAccessToken `json:"personal_access_tokens,omitempty"`
+	// Identities holds the value of the identities edge.
+	Identities []*UserIdentity `json:"identities,omitempty"`
+	// TotpCredential holds the value of the totp_credential edge.
+	TotpCredential *TOTPCredential `json:"totp_credential,omitempty"`
+	// RecoveryCodes holds the value of the recovery_codes edge.
+	RecoveryCodes []*RecoveryCode `json:"recovery_codes,omitempty"`
+	// UsedMfaTokens holds the value of the used_mfa_tokens edge.
+	UsedMfaTokens []*UsedMFAToken `json:"used_mfa_tokens,omitempty"`
+	// LoginAttempts holds the value of the login_attempts edge.
+	LoginAttempts []*LoginAttempt `json:"login_attempts,omitempty"`
+	// EmailChangeTokens holds the value of the email_change_tokens edge.
+	EmailChangeTokens []*EmailChangeToken `json:"email_change_tokens,omitempty"`
+	// AccountDeletionTokens holds the value of the account_deletion_tokens edge.
+	AccountDeletionTokens []*AccountDeletionToken `json:"account_deletion_tokens,omitempty"`
+	// TodoListMemberships holds the value of the todo_list_memberships edge.
+	TodoListMemberships []*TodoListMember `json:"todo_list_memberships,omitempty"`
+	// PersonalWorkspace holds the value of the personal_workspace edge.
+	PersonalWorkspace *Workspace `json:"personal_workspace,omitempty"`
+	// WorkspaceMemberships holds the value of the workspace_memberships edge.
+	WorkspaceMemberships []*WorkspaceMember `json:"workspace_memberships,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [18]bool
 }
 
 // TodosOrErr returns the Todos value or an error if the edge
@@ -60,16 +110,166 @@ func (e UserEdges) TodoFilterHistoriesOrErr() ([]*TodoFilterHistory, error) {
 	return nil, &NotLoadedError{edge: "todo_filter_histories"}
 }
 
+// TodoBreakdownsOrErr returns the TodoBreakdowns value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) TodoBreakdownsOrErr() ([]*TodoBreakdown, error) {
+	if e.loadedTypes[2] {
+		return e.TodoBreakdowns, nil
+	}
+	return nil, &NotLoadedError{edge: "todo_breakdowns"}
+}
+
+// TodoSummariesOrErr returns the TodoSummaries value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) TodoSummariesOrErr() ([]*TodoSummary, error) {
+	if e.loadedTypes[3] {
+		return e.TodoSummaries, nil
+	}
+	return nil, &NotLoadedError{edge: "todo_summaries"}
+}
+
+// AiUsagesOrErr returns the AiUsages value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AiUsagesOrErr() ([]*AIUsage, error) {
+	if e.loadedTypes[4] {
+		return e.AiUsages, nil
+	}
+	return nil, &NotLoadedError{edge: "ai_usages"}
+}
+
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SessionsOrErr() ([]*Session, error) {
+	if e.loadedTypes[5] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
+// PasswordResetTokensOrErr returns the PasswordResetTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PasswordResetTokensOrErr() ([]*PasswordResetToken, error) {
+	if e.loadedTypes[6] {
+		return e.PasswordResetTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "password_reset_tokens"}
+}
+
+// PersonalAccessTokensOrErr returns the PersonalAccessTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PersonalAccessTokensOrErr() ([]*PersonalAccessToken, error) {
+	if e.loadedTypes[7] {
+		return e.PersonalAccessTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "personal_access_tokens"}
+}
+
+// IdentitiesOrErr returns the Identities value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) IdentitiesOrErr() ([]*UserIdentity, error) {
+	if e.loadedTypes[8] {
+		return e.Identities, nil
+	}
+	return nil, &NotLoadedError{edge: "identities"}
+}
+
+// TotpCredentialOrErr returns the TotpCredential value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) TotpCredentialOrErr() (*TOTPCredential, error) {
+	if e.TotpCredential != nil {
+		return e.TotpCredential, nil
+	} else if e.loadedTypes[9] {
+		return nil, &NotFoundError{label: totpcredential.Label}
+	}
+	return nil, &NotLoadedError{edge: "totp_credential"}
+}
+
+// RecoveryCodesOrErr returns the RecoveryCodes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RecoveryCodesOrErr() ([]*RecoveryCode, error) {
+	if e.loadedTypes[10] {
+		return e.RecoveryCodes, nil
+	}
+	return nil, &NotLoadedError{edge: "recovery_codes"}
+}
+
+// UsedMfaTokensOrErr returns the UsedMfaTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) UsedMfaTokensOrErr() ([]*UsedMFAToken, error) {
+	if e.loadedTypes[11] {
+		return e.UsedMfaTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "used_mfa_tokens"}
+}
+
+// LoginAttemptsOrErr returns the LoginAttempts value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) LoginAttemptsOrErr() ([]*LoginAttempt, error) {
+	if e.loadedTypes[12] {
+		return e.LoginAttempts, nil
+	}
+	return nil, &NotLoadedError{edge: "login_attempts"}
+}
+
+// EmailChangeTokensOrErr returns the EmailChangeTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) EmailChangeTokensOrErr() ([]*EmailChangeToken, error) {
+	if e.loadedTypes[13] {
+		return e.EmailChangeTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "email_change_tokens"}
+}
+
+// AccountDeletionTokensOrErr returns the AccountDeletionTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AccountDeletionTokensOrErr() ([]*AccountDeletionToken, error) {
+	if e.loadedTypes[14] {
+		return e.AccountDeletionTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "account_deletion_tokens"}
+}
+
+// TodoListMembershipsOrErr returns the TodoListMemberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) TodoListMembershipsOrErr() ([]*TodoListMember, error) {
+	if e.loadedTypes[15] {
+		return e.TodoListMemberships, nil
+	}
+	return nil, &NotLoadedError{edge: "todo_list_memberships"}
+}
+
+// PersonalWorkspaceOrErr returns the PersonalWorkspace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) PersonalWorkspaceOrErr() (*Workspace, error) {
+	if e.PersonalWorkspace != nil {
+		return e.PersonalWorkspace, nil
+	} else if e.loadedTypes[16] {
+		return nil, &NotFoundError{label: workspace.Label}
+	}
+	return nil, &NotLoadedError{edge: "personal_workspace"}
+}
+
+// WorkspaceMembershipsOrErr returns the WorkspaceMemberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) WorkspaceMembershipsOrErr() ([]*WorkspaceMember, error) {
+	if e.loadedTypes[17] {
+		return e.WorkspaceMemberships, nil
+	}
+	return nil, &NotLoadedError{edge: "workspace_memberships"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldID:
+		case user.FieldTodoIncludeDone:
+			values[i] = new(sql.NullBool)
+		case user.FieldID, user.FieldTodoPageSize:
 			values[i] = new(sql.NullInt64)
-		case user.FieldName, user.FieldEmail, user.FieldPassword:
+		case user.FieldName, user.FieldEmail, user.FieldPassword, user.FieldLocale, user.FieldTimeZone, user.FieldRole:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt:
+		case user.FieldEmailVerifiedAt, user.FieldDeletionScheduledAt, user.FieldDisabledAt, user.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -110,6 +310,57 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Password = value.String
 			}
+		case user.FieldEmailVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field email_verified_at", values[i])
+			} else if value.Valid {
+				_m.EmailVerifiedAt = new(time.Time)
+				*_m.EmailVerifiedAt = value.Time
+			}
+		case user.FieldLocale:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field locale", values[i])
+			} else if value.Valid {
+				_m.Locale = value.String
+			}
+		case user.FieldTimeZone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field time_zone", values[i])
+			} else if value.Valid {
+				_m.TimeZone = value.String
+			}
+		case user.FieldTodoPageSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field todo_page_size", values[i])
+			} else if value.Valid {
+				_m.TodoPageSize = int(value.Int64)
+			}
+		case user.FieldTodoIncludeDone:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field todo_include_done", values[i])
+			} else if value.Valid {
+				_m.TodoIncludeDone = value.Bool
+			}
+		case user.FieldDeletionScheduledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deletion_scheduled_at", values[i])
+			} else if value.Valid {
+				_m.DeletionScheduledAt = new(time.Time)
+				*_m.DeletionScheduledAt = value.Time
+			}
+		case user.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = value.String
+			}
+		case user.FieldDisabledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field disabled_at", values[i])
+			} else if value.Valid {
+				_m.DisabledAt = new(time.Time)
+				*_m.DisabledAt = value.Time
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -139,6 +390,86 @@ func (_m *User) QueryTodoFilterHistories() *TodoFilterHistoryQuery {
 	return NewUserClient(_m.config).QueryTodoFilterHistories(_m)
 }
 
+// QueryTodoBreakdowns queries the "todo_breakdowns" edge of the User entity.
+func (_m *User) QueryTodoBreakdowns() *TodoBreakdownQuery {
+	return NewUserClient(_m.config).QueryTodoBreakdowns(_m)
+}
+
+// QueryTodoSummaries queries the "todo_summaries" edge of the User entity.
+func (_m *User) QueryTodoSummaries() *TodoSummaryQuery {
+	return NewUserClient(_m.config).QueryTodoSummaries(_m)
+}
+
+// QueryAiUsages queries the "ai_usages" edge of the User entity.
+func (_m *User) QueryAiUsages() *AIUsageQuery {
+	return NewUserClient(_m.config).QueryAiUsages(_m)
+}
+
+// QuerySessions queries the "sessions" edge of the User entity.
+func (_m *User) QuerySessions() *SessionQuery {
+	return NewUserClient(_m.config).QuerySessions(_m)
+}
+
+// QueryPasswordResetTokens queries the "password_reset_tokens" edge of the User entity.
+func (_m *User) QueryPasswordResetTokens() *PasswordResetTokenQuery {
+	return NewUserClient(_m.config).QueryPasswordResetTokens(_m)
+}
+
+// QueryPersonalAccessTokens queries the "personal_access_tokens" edge of the User entity.
+func (_m *User) QueryPersonalAccessTokens() *PersonalAccessTokenQuery {
+	return NewUserClient(_m.config).QueryPersonalAccessTokens(_m)
+}
+
+// QueryIdentities queries the "identities" edge of the User entity.
+func (_m *User) QueryIdentities() *UserIdentityQuery {
+	return NewUserClient(_m.config).QueryIdentities(_m)
+}
+
+// QueryTotpCredential queries the "totp_credential" edge of the User entity.
+func (_m *User) QueryTotpCredential() *TOTPCredentialQuery {
+	return NewUserClient(_m.config).QueryTotpCredential(_m)
+}
+
+// QueryRecoveryCodes queries the "recovery_codes" edge of the User entity.
+func (_m *User) QueryRecoveryCodes() *RecoveryCodeQuery {
+	return NewUserClient(_m.config).QueryRecoveryCodes(_m)
+}
+
+// QueryUsedMfaTokens queries the "used_mfa_tokens" edge of the User entity.
+func (_m *User) QueryUsedMfaTokens() *UsedMFATokenQuery {
+	return NewUserClient(_m.config).QueryUsedMfaTokens(_m)
+}
+
+// QueryLoginAttempts queries the "login_attempts" edge of the User entity.
+func (_m *User) QueryLoginAttempts() *LoginAttemptQuery {
+	return NewUserClient(_m.config).QueryLoginAttempts(_m)
+}
+
+// QueryEmailChangeTokens queries the "email_change_tokens" edge of the User entity.
+func (_m *User) QueryEmailChangeTokens() *EmailChangeTokenQuery {
+	return NewUserClient(_m.config).QueryEmailChangeTokens(_m)
+}
+
+// QueryAccountDeletionTokens queries the "account_deletion_tokens" edge of the User entity.
+func (_m *User) QueryAccountDeletionTokens() *AccountDeletionTokenQuery {
+	return NewUserClient(_m.config).QueryAccountDeletionTokens(_m)
+}
+
+// QueryTodoListMemberships queries the "todo_list_memberships" edge of the User entity.
+func (_m *User) QueryTodoListMemberships() *TodoListMemberQuery {
+	return NewUserClient(_m.config).QueryTodoListMemberships(_m)
+}
+
+// QueryPersonalWorkspace queries the "personal_workspace" edge of the User entity.
+func (_m *User) QueryPersonalWorkspace() *WorkspaceQuery {
+	return NewUserClient(_m.config).QueryPersonalWorkspace(_m)
+}
+
+// QueryWorkspaceMemberships queries the "workspace_memberships" edge of the User entity.
+func (_m *User) QueryWorkspaceMemberships() *WorkspaceMemberQuery {
+	return NewUserClient(_m.config).QueryWorkspaceMemberships(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -171,6 +502,36 @@ func (_m *User) String() string {
 	builder.WriteString("password=")
 	builder.WriteString(_m.Password)
 	builder.WriteString(", ")
+	if v := _m.EmailVerifiedAt; v != nil {
+		builder.WriteString("email_verified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("locale=")
+	builder.WriteString(_m.Locale)
+	builder.WriteString(", ")
+	builder.WriteString("time_zone=")
+	builder.WriteString(_m.TimeZone)
+	builder.WriteString(", ")
+	builder.WriteString("todo_page_size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TodoPageSize))
+	builder.WriteString(", ")
+	builder.WriteString("todo_include_done=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TodoIncludeDone))
+	builder.WriteString(", ")
+	if v := _m.DeletionScheduledAt; v != nil {
+		builder.WriteString("deletion_scheduled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(_m.Role)
+	builder.WriteString(", ")
+	if v := _m.DisabledAt; v != nil {
+		builder.WriteString("disabled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
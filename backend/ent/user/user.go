@@ -20,12 +20,60 @@ const (
 	FieldEmail = "email"
 	// FieldPassword holds the string denoting the password field in the database.
 	FieldPassword = "password"
+	// FieldEmailVerifiedAt holds the string denoting the email_verified_at field in the database.
+	FieldEmailVerifiedAt = "email_verified_at"
+	// FieldLocale holds the string denoting the locale field in the database.
+	FieldLocale = "locale"
+	// FieldTimeZone holds the string denoting the time_zone field in the database.
+	FieldTimeZone = "time_zone"
+	// FieldTodoPageSize holds the string denoting the todo_page_size field in the database.
+	FieldTodoPageSize = "todo_page_size"
+	// FieldTodoIncludeDone holds the string denoting the todo_include_done field in the database.
+	FieldTodoIncludeDone = "todo_include_done"
+	// FieldDeletionScheduledAt holds the string denoting the deletion_scheduled_at field in the database.
+	FieldDeletionScheduledAt = "deletion_scheduled_at"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
+	// FieldDisabledAt holds the string denoting the disabled_at field in the database.
+	FieldDisabledAt = "disabled_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeTodos holds the string denoting the todos edge name in mutations.
 	EdgeTodos = "todos"
 	// EdgeTodoFilterHistories holds the string denoting the todo_filter_histories edge name in mutations.
 	EdgeTodoFilterHistories = "todo_filter_histories"
+	// EdgeTodoBreakdowns holds the string denoting the todo_breakdowns edge name in mutations.
+	EdgeTodoBreakdowns = "todo_breakdowns"
+	// EdgeTodoSummaries holds the string denoting the todo_summaries edge name in mutations.
+	EdgeTodoSummaries = "todo_summaries"
+	// EdgeAiUsages holds the string denoting the ai_usages edge name in mutations.
+	EdgeAiUsages = "ai_usages"
+	// EdgeSessions holds the string denoting the sessions edge name in mutations.
+	EdgeSessions = "sessions"
+	// EdgePasswordResetTokens holds the string denoting the password_reset_tokens edge name in mutations.
+	EdgePasswordResetTokens = "password_reset_tokens"
+	// EdgePersonalAccessTokens holds the string denoting the personal_access_tokens edge name in mutations.
+	EdgePersonalAccessTokens = "personal_access_tokens"
+	// EdgeIdentities holds the string denoting the identities edge name in mutations.
+	EdgeIdentities = "identities"
+	// EdgeTotpCredential holds the string denoting the totp_credential edge name in mutations.
+	EdgeTotpCredential = "totp_credential"
+	// EdgeRecoveryCodes holds the string denoting the recovery_codes edge name in mutations.
+	EdgeRecoveryCodes = "recovery_codes"
+	// EdgeUsedMfaTokens holds the string denoting the used_mfa_tokens edge name in mutations.
+	EdgeUsedMfaTokens = "used_mfa_tokens"
+	// EdgeLoginAttempts holds the string denoting the login_attempts edge name in mutations.
+	EdgeLoginAttempts = "login_attempts"
+	// EdgeEmailChangeTokens holds the string denoting the email_change_tokens edge name in mutations.
+	EdgeEmailChangeTokens = "email_change_tokens"
+	// EdgeAccountDeletionTokens holds the string denoting the account_deletion_tokens edge name in mutations.
+	EdgeAccountDeletionTokens = "account_deletion_tokens"
+	// EdgeTodoListMemberships holds the string denoting the todo_list_memberships edge name in mutations.
+	EdgeTodoListMemberships = "todo_list_memberships"
+	// EdgePersonalWorkspace holds the string denoting the personal_workspace edge name in mutations.
+	EdgePersonalWorkspace = "personal_workspace"
+	// EdgeWorkspaceMemberships holds the string denoting the workspace_memberships edge name in mutations.
+	EdgeWorkspaceMemberships = "workspace_memberships"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// TodosTable is the table that holds the todos relation/edge.
@@ -42,6 +90,118 @@ const (
 	TodoFilterHistoriesInverseTable = "todo_filter_histories"
 	// TodoFilterHistoriesColumn is the table column denoting the todo_filter_histories relation/edge.
 	TodoFilterHistoriesColumn = "user_id"
+	// TodoBreakdownsTable is the table that holds the todo_breakdowns relation/edge.
+	TodoBreakdownsTable = "todo_breakdowns"
+	// TodoBreakdownsInverseTable is the table name for the TodoBreakdown entity.
+	// It exists in this package in order to avoid circular dependency with the "todobreakdown" package.
+	TodoBreakdownsInverseTable = "todo_breakdowns"
+	// TodoBreakdownsColumn is the table column denoting the todo_breakdowns relation/edge.
+	TodoBreakdownsColumn = "user_id"
+	// TodoSummariesTable is the table that holds the todo_summaries relation/edge.
+	TodoSummariesTable = "todo_summaries"
+	// TodoSummariesInverseTable is the table name for the TodoSummary entity.
+	// It exists in this package in order to avoid circular dependency with the "todosummary" package.
+	TodoSummariesInverseTable = "todo_summaries"
+	// TodoSummariesColumn is the table column denoting the todo_summaries relation/edge.
+	TodoSummariesColumn = "user_id"
+	// AiUsagesTable is the table that holds the ai_usages relation/edge.
+	AiUsagesTable = "ai_usages"
+	// AiUsagesInverseTable is the table name for the AIUsage entity.
+	// It exists in this package in order to avoid circular dependency with the "aiusage" package.
+	AiUsagesInverseTable = "ai_usages"
+	// AiUsagesColumn is the table column denoting the ai_usages relation/edge.
+	AiUsagesColumn = "user_id"
+	// SessionsTable is the table that holds the sessions relation/edge.
+	SessionsTable = "sessions"
+	// SessionsInverseTable is the table name for the Session entity.
+	// It exists in this package in order to avoid circular dependency with the "session" package.
+	SessionsInverseTable = "sessions"
+	// SessionsColumn is the table column denoting the sessions relation/edge.
+	SessionsColumn = "user_id"
+	// PasswordResetTokensTable is the table that holds the password_reset_tokens relation/edge.
+	PasswordResetTokensTable = "password_reset_tokens"
+	// PasswordResetTokensInverseTable is the table name for the PasswordResetToken entity.
+	// It exists in this package in order to avoid circular dependency with the "passwordresettoken" package.
+	PasswordResetTokensInverseTable = "password_reset_tokens"
+	// PasswordResetTokensColumn is the table column denoting the password_reset_tokens relation/edge.
+	PasswordResetTokensColumn = "user_id"
+	// PersonalAccessTokensTable is the table that holds the personal_access_tokens relation/edge.
+	PersonalAccessTokensTable = "personal_access_tokens"
+	// PersonalAccessTokensInverseTable is the table name for the PersonalAccessToken entity.
+	// It exists in this package in order to avoid circular dependency with the "personalaccesstoken" package.
+	PersonalAccessTokensInverseTable = "personal_access_tokens"
+	// PersonalAccessTokensColumn is the table column denoting the personal_access_tokens relation/edge.
+	PersonalAccessTokensColumn = "user_id"
+	// IdentitiesTable is the table that holds the identities relation/edge.
+	IdentitiesTable = "user_identities"
+	// IdentitiesInverseTable is the table name for the UserIdentity entity.
+	// It exists in this package in order to avoid circular dependency with the "useridentity" package.
+	IdentitiesInverseTable = "user_identities"
+	// IdentitiesColumn is the table column denoting the identities relation/edge.
+	IdentitiesColumn = "user_id"
+	// TotpCredentialTable is the table that holds the totp_credential relation/edge.
+	TotpCredentialTable = "totp_credentials"
+	// TotpCredentialInverseTable is the table name for the TOTPCredential entity.
+	// It exists in this package in order to avoid circular dependency with the "totpcredential" package.
+	TotpCredentialInverseTable = "totp_credentials"
+	// TotpCredentialColumn is the table column denoting the totp_credential relation/edge.
+	TotpCredentialColumn = "user_id"
+	// RecoveryCodesTable is the table that holds the recovery_codes relation/edge.
+	RecoveryCodesTable = "recovery_codes"
+	// RecoveryCodesInverseTable is the table name for the RecoveryCode entity.
+	// It exists in this package in order to avoid circular dependency with the "recoverycode" package.
+	RecoveryCodesInverseTable = "recovery_codes"
+	// RecoveryCodesColumn is the table column denoting the recovery_codes relation/edge.
+	RecoveryCodesColumn = "user_id"
+	// UsedMfaTokensTable is the table that holds the used_mfa_tokens relation/edge.
+	UsedMfaTokensTable = "used_mfa_tokens"
+	// UsedMfaTokensInverseTable is the table name for the UsedMFAToken entity.
+	// It exists in this package in order to avoid circular dependency with the "usedmfatoken" package.
+	UsedMfaTokensInverseTable = "used_mfa_tokens"
+	// UsedMfaTokensColumn is the table column denoting the used_mfa_tokens relation/edge.
+	UsedMfaTokensColumn = "user_id"
+	// LoginAttemptsTable is the table that holds the login_attempts relation/edge.
+	LoginAttemptsTable = "login_attempts"
+	// LoginAttemptsInverseTable is the table name for the LoginAttempt entity.
+	// It exists in this package in order to avoid circular dependency with the "loginattempt" package.
+	LoginAttemptsInverseTable = "login_attempts"
+	// LoginAttemptsColumn is the table column denoting the login_attempts relation/edge.
+	LoginAttemptsColumn = "user_id"
+	// EmailChangeTokensTable is the table that holds the email_change_tokens relation/edge.
+	EmailChangeTokensTable = "email_change_tokens"
+	// EmailChangeTokensInverseTable is the table name for the EmailChangeToken entity.
+	// It exists in this package in order to avoid circular dependency with the "emailchangetoken" package.
+	EmailChangeTokensInverseTable = "email_change_tokens"
+	// EmailChangeTokensColumn is the table column denoting the email_change_tokens relation/edge.
+	EmailChangeTokensColumn = "user_id"
+	// AccountDeletionTokensTable is the table that holds the account_deletion_tokens relation/edge.
+	AccountDeletionTokensTable = "account_deletion_tokens"
+	// AccountDeletionTokensInverseTable is the table name for the AccountDeletionToken entity.
+	// It exists in this package in order to avoid circular dependency with the "accountdeletiontoken" package.
+	AccountDeletionTokensInverseTable = "account_deletion_tokens"
+	// AccountDeletionTokensColumn is the table column denoting the account_deletion_tokens relation/edge.
+	AccountDeletionTokensColumn = "user_id"
+	// TodoListMembershipsTable is the table that holds the todo_list_memberships relation/edge.
+	TodoListMembershipsTable = "todo_list_members"
+	// TodoListMembershipsInverseTable is the table name for the TodoListMember entity.
+	// It exists in this package in order to avoid circular dependency with the "todolistmember" package.
+	TodoListMembershipsInverseTable = "todo_list_members"
+	// TodoListMembershipsColumn is the table column denoting the todo_list_memberships relation/edge.
+	TodoListMembershipsColumn = "user_id"
+	// PersonalWorkspaceTable is the table that holds the personal_workspace relation/edge.
+	PersonalWorkspaceTable = "workspaces"
+	// PersonalWorkspaceInverseTable is the table name for the Workspace entity.
+	// It exists in this package in order to avoid circular dependency with the "workspace" package.
+	PersonalWorkspaceInverseTable = "workspaces"
+	// PersonalWorkspaceColumn is the table column denoting the personal_workspace relation/edge.
+	PersonalWorkspaceColumn = "personal_owner_id"
+	// WorkspaceMembershipsTable is the table that holds the workspace_memberships relation/edge.
+	WorkspaceMembershipsTable = "workspace_members"
+	// WorkspaceMembershipsInverseTable is the table name for the WorkspaceMember entity.
+	// It exists in this package in order to avoid circular dependency with the "workspacemember" package.
+	WorkspaceMembershipsInverseTable = "workspace_members"
+	// WorkspaceMembershipsColumn is the table column denoting the workspace_memberships relation/edge.
+	WorkspaceMembershipsColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -50,6 +210,14 @@ var Columns = []string{
 	FieldName,
 	FieldEmail,
 	FieldPassword,
+	FieldEmailVerifiedAt,
+	FieldLocale,
+	FieldTimeZone,
+	FieldTodoPageSize,
+	FieldTodoIncludeDone,
+	FieldDeletionScheduledAt,
+	FieldRole,
+	FieldDisabledAt,
 	FieldCreatedAt,
 }
 
@@ -70,6 +238,24 @@ var (
 	EmailValidator func(string) error
 	// PasswordValidator is a validator for the "password" field. It is called by the builders before save.
 	PasswordValidator func(string) error
+	// DefaultLocale holds the default value on creation for the "locale" field.
+	DefaultLocale string
+	// LocaleValidator is a validator for the "locale" field. It is called by the builders before save.
+	LocaleValidator func(string) error
+	// DefaultTimeZone holds the default value on creation for the "time_zone" field.
+	DefaultTimeZone string
+	// TimeZoneValidator is a validator for the "time_zone" field. It is called by the builders before save.
+	TimeZoneValidator func(string) error
+	// DefaultTodoPageSize holds the default value on creation for the "todo_page_size" field.
+	DefaultTodoPageSize int
+	// TodoPageSizeValidator is a validator for the "todo_page_size" field. It is called by the builders before save.
+	TodoPageSizeValidator func(int) error
+	// DefaultTodoIncludeDone holds the default value on creation for the "todo_include_done" field.
+	DefaultTodoIncludeDone bool
+	// DefaultRole holds the default value on creation for the "role" field.
+	DefaultRole string
+	// RoleValidator is a validator for the "role" field. It is called by the builders before save.
+	RoleValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 )
@@ -97,6 +283,46 @@ func ByPassword(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPassword, opts...).ToFunc()
 }
 
+// ByEmailVerifiedAt orders the results by the email_verified_at field.
+func ByEmailVerifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailVerifiedAt, opts...).ToFunc()
+}
+
+// ByLocale orders the results by the locale field.
+func ByLocale(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocale, opts...).ToFunc()
+}
+
+// ByTimeZone orders the results by the time_zone field.
+func ByTimeZone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeZone, opts...).ToFunc()
+}
+
+// ByTodoPageSize orders the results by the todo_page_size field.
+func ByTodoPageSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTodoPageSize, opts...).ToFunc()
+}
+
+// ByTodoIncludeDone orders the results by the todo_include_done field.
+func ByTodoIncludeDone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTodoIncludeDone, opts...).ToFunc()
+}
+
+// ByDeletionScheduledAt orders the results by the deletion_scheduled_at field.
+func ByDeletionScheduledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletionScheduledAt, opts...).ToFunc()
+}
+
+// ByRole orders the results by the role field.
+func ByRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRole, opts...).ToFunc()
+}
+
+// ByDisabledAt orders the results by the disabled_at field.
+func ByDisabledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisabledAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -129,6 +355,216 @@ func ByTodoFilterHistories(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOpti
 		sqlgraph.OrderByNeighborTerms(s, newTodoFilterHistoriesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByTodoBreakdownsCount orders the results by todo_breakdowns count.
+func ByTodoBreakdownsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTodoBreakdownsStep(), opts...)
+	}
+}
+
+// ByTodoBreakdowns orders the results by todo_breakdowns terms.
+func ByTodoBreakdowns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTodoBreakdownsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTodoSummariesCount orders the results by todo_summaries count.
+func ByTodoSummariesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTodoSummariesStep(), opts...)
+	}
+}
+
+// ByTodoSummaries orders the results by todo_summaries terms.
+func ByTodoSummaries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTodoSummariesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAiUsagesCount orders the results by ai_usages count.
+func ByAiUsagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAiUsagesStep(), opts...)
+	}
+}
+
+// ByAiUsages orders the results by ai_usages terms.
+func ByAiUsages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAiUsagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySessionsCount orders the results by sessions count.
+func BySessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSessionsStep(), opts...)
+	}
+}
+
+// BySessions orders the results by sessions terms.
+func BySessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPasswordResetTokensCount orders the results by password_reset_tokens count.
+func ByPasswordResetTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPasswordResetTokensStep(), opts...)
+	}
+}
+
+// ByPasswordResetTokens orders the results by password_reset_tokens terms.
+func ByPasswordResetTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPasswordResetTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPersonalAccessTokensCount orders the results by personal_access_tokens count.
+func ByPersonalAccessTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPersonalAccessTokensStep(), opts...)
+	}
+}
+
+// ByPersonalAccessTokens orders the results by personal_access_tokens terms.
+func ByPersonalAccessTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPersonalAccessTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByIdentitiesCount orders the results by identities count.
+func ByIdentitiesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newIdentitiesStep(), opts...)
+	}
+}
+
+// ByIdentities orders the results by identities terms.
+func ByIdentities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIdentitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTotpCredentialField orders the results by totp_credential field.
+func ByTotpCredentialField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTotpCredentialStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRecoveryCodesCount orders the results by recovery_codes count.
+func ByRecoveryCodesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRecoveryCodesStep(), opts...)
+	}
+}
+
+// ByRecoveryCodes orders the results by recovery_codes terms.
+func ByRecoveryCodes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecoveryCodesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByUsedMfaTokensCount orders the results by used_mfa_tokens count.
+func ByUsedMfaTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newUsedMfaTokensStep(), opts...)
+	}
+}
+
+// ByUsedMfaTokens orders the results by used_mfa_tokens terms.
+func ByUsedMfaTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUsedMfaTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLoginAttemptsCount orders the results by login_attempts count.
+func ByLoginAttemptsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLoginAttemptsStep(), opts...)
+	}
+}
+
+// ByLoginAttempts orders the results by login_attempts terms.
+func ByLoginAttempts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLoginAttemptsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEmailChangeTokensCount orders the results by email_change_tokens count.
+func ByEmailChangeTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEmailChangeTokensStep(), opts...)
+	}
+}
+
+// ByEmailChangeTokens orders the results by email_change_tokens terms.
+func ByEmailChangeTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEmailChangeTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAccountDeletionTokensCount orders the results by account_deletion_tokens count.
+func ByAccountDeletionTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAccountDeletionTokensStep(), opts...)
+	}
+}
+
+// ByAccountDeletionTokens orders the results by account_deletion_tokens terms.
+func ByAccountDeletionTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccountDeletionTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTodoListMembershipsCount orders the results by todo_list_memberships count.
+func ByTodoListMembershipsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTodoListMembershipsStep(), opts...)
+	}
+}
+
+// ByTodoListMemberships orders the results by todo_list_memberships terms.
+func ByTodoListMemberships(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTodoListMembershipsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPersonalWorkspaceField orders the results by personal_workspace field.
+func ByPersonalWorkspaceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPersonalWorkspaceStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByWorkspaceMembershipsCount orders the results by workspace_memberships count.
+func ByWorkspaceMembershipsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWorkspaceMembershipsStep(), opts...)
+	}
+}
+
+// ByWorkspaceMemberships orders the results by workspace_memberships terms.
+func ByWorkspaceMemberships(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWorkspaceMembershipsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTodosStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -143,3 +579,115 @@ func newTodoFilterHistoriesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, TodoFilterHistoriesTable, TodoFilterHistoriesColumn),
 	)
 }
+func newTodoBreakdownsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TodoBreakdownsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TodoBreakdownsTable, TodoBreakdownsColumn),
+	)
+}
+func newTodoSummariesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TodoSummariesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TodoSummariesTable, TodoSummariesColumn),
+	)
+}
+func newAiUsagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AiUsagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AiUsagesTable, AiUsagesColumn),
+	)
+}
+func newSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SessionsTable, SessionsColumn),
+	)
+}
+func newPasswordResetTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PasswordResetTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PasswordResetTokensTable, PasswordResetTokensColumn),
+	)
+}
+func newPersonalAccessTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PersonalAccessTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PersonalAccessTokensTable, PersonalAccessTokensColumn),
+	)
+}
+func newIdentitiesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IdentitiesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, IdentitiesTable, IdentitiesColumn),
+	)
+}
+func newTotpCredentialStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TotpCredentialInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, TotpCredentialTable, TotpCredentialColumn),
+	)
+}
+func newRecoveryCodesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecoveryCodesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RecoveryCodesTable, RecoveryCodesColumn),
+	)
+}
+func newUsedMfaTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UsedMfaTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, UsedMfaTokensTable, UsedMfaTokensColumn),
+	)
+}
+func newLoginAttemptsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LoginAttemptsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LoginAttemptsTable, LoginAttemptsColumn),
+	)
+}
+func newEmailChangeTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EmailChangeTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EmailChangeTokensTable, EmailChangeTokensColumn),
+	)
+}
+func newAccountDeletionTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccountDeletionTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AccountDeletionTokensTable, AccountDeletionTokensColumn),
+	)
+}
+func newTodoListMembershipsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TodoListMembershipsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TodoListMembershipsTable, TodoListMembershipsColumn),
+	)
+}
+func newPersonalWorkspaceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PersonalWorkspaceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, PersonalWorkspaceTable, PersonalWorkspaceColumn),
+	)
+}
+func newWorkspaceMembershipsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WorkspaceMembershipsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WorkspaceMembershipsTable, WorkspaceMembershipsColumn),
+	)
+}
@@ -7,9 +7,25 @@ import (
 	"errors"
 	"fmt"
 	"time"
+	"todo-app/ent/accountdeletiontoken"
+	"todo-app/ent/aiusage"
+	"todo-app/ent/emailchangetoken"
+	"todo-app/ent/loginattempt"
+	"todo-app/ent/passwordresettoken"
+	"todo-app/ent/personalaccesstoken"
+	"todo-app/ent/recoverycode"
+	"todo-app/ent/session"
 	"todo-app/ent/todo"
+	"todo-app/ent/todobreakdown"
 	"todo-app/ent/todofilterhistory"
+	"todo-app/ent/todolistmember"
+	"todo-app/ent/todosummary"
+	"todo-app/ent/totpcredential"
+	"todo-app/ent/usedmfatoken"
 	"todo-app/ent/user"
+	"todo-app/ent/useridentity"
+	"todo-app/ent/workspace"
+	"todo-app/ent/workspacemember"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -41,6 +57,118 @@ func (_c *UserCreate) SetPassword(v string) *UserCreate {
 	return _c
 }
 
+// SetEmailVerifiedAt sets the "email_verified_at" field.
+func (_c *UserCreate) SetEmailVerifiedAt(v time.Time) *UserCreate {
+	_c.mutation.SetEmailVerifiedAt(v)
+	return _c
+}
+
+// SetNillableEmailVerifiedAt sets the "email_verified_at" field if the given value is not nil.
+func (_c *UserCreate) SetNillableEmailVerifiedAt(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetEmailVerifiedAt(*v)
+	}
+	return _c
+}
+
+// SetLocale sets the "locale" field.
+func (_c *UserCreate) SetLocale(v string) *UserCreate {
+	_c.mutation.SetLocale(v)
+	return _c
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_c *UserCreate) SetNillableLocale(v *string) *UserCreate {
+	if v != nil {
+		_c.SetLocale(*v)
+	}
+	return _c
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (_c *UserCreate) SetTimeZone(v string) *UserCreate {
+	_c.mutation.SetTimeZone(v)
+	return _c
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (_c *UserCreate) SetNillableTimeZone(v *string) *UserCreate {
+	if v != nil {
+		_c.SetTimeZone(*v)
+	}
+	return _c
+}
+
+// SetTodoPageSize sets the "todo_page_size" field.
+func (_c *UserCreate) SetTodoPageSize(v int) *UserCreate {
+	_c.mutation.SetTodoPageSize(v)
+	return _c
+}
+
+// SetNillableTodoPageSize sets the "todo_page_size" field if the given value is not nil.
+func (_c *UserCreate) SetNillableTodoPageSize(v *int) *UserCreate {
+	if v != nil {
+		_c.SetTodoPageSize(*v)
+	}
+	return _c
+}
+
+// SetTodoIncludeDone sets the "todo_include_done" field.
+func (_c *UserCreate) SetTodoIncludeDone(v bool) *UserCreate {
+	_c.mutation.SetTodoIncludeDone(v)
+	return _c
+}
+
+// SetNillableTodoIncludeDone sets the "todo_include_done" field if the given value is not nil.
+func (_c *UserCreate) SetNillableTodoIncludeDone(v *bool) *UserCreate {
+	if v != nil {
+		_c.SetTodoIncludeDone(*v)
+	}
+	return _c
+}
+
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (_c *UserCreate) SetDeletionScheduledAt(v time.Time) *UserCreate {
+	_c.mutation.SetDeletionScheduledAt(v)
+	return _c
+}
+
+// SetNillableDeletionScheduledAt sets the "deletion_scheduled_at" field if the given value is not nil.
+func (_c *UserCreate) SetNillableDeletionScheduledAt(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetDeletionScheduledAt(*v)
+	}
+	return _c
+}
+
+// SetRole sets the "role" field.
+func (_c *UserCreate) SetRole(v string) *UserCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_c *UserCreate) SetNillableRole(v *string) *UserCreate {
+	if v != nil {
+		_c.SetRole(*v)
+	}
+	return _c
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (_c *UserCreate) SetDisabledAt(v time.Time) *UserCreate {
+	_c.mutation.SetDisabledAt(v)
+	return _c
+}
+
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (_c *UserCreate) SetNillableDisabledAt(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetDisabledAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *UserCreate) SetCreatedAt(v time.Time) *UserCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -85,6 +213,254 @@ func (_c *UserCreate) AddTodoFilterHistories(v ...*TodoFilterHistory) *UserCreat
 	return _c.AddTodoFilterHistoryIDs(ids...)
 }
 
+// AddTodoBreakdownIDs adds the "todo_breakdowns" edge to the TodoBreakdown entity by IDs.
+func (_c *UserCreate) AddTodoBreakdownIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddTodoBreakdownIDs(ids...)
+	return _c
+}
+
+// AddTodoBreakdowns adds the "todo_breakdowns" edges to the TodoBreakdown entity.
+func (_c *UserCreate) AddTodoBreakdowns(v ...*TodoBreakdown) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTodoBreakdownIDs(ids...)
+}
+
+// AddTodoSummaryIDs adds the "todo_summaries" edge to the TodoSummary entity by IDs.
+func (_c *UserCreate) AddTodoSummaryIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddTodoSummaryIDs(ids...)
+	return _c
+}
+
+// AddTodoSummaries adds the "todo_summaries" edges to the TodoSummary entity.
+func (_c *UserCreate) AddTodoSummaries(v ...*TodoSummary) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTodoSummaryIDs(ids...)
+}
+
+// AddAiUsageIDs adds the "ai_usages" edge to the AIUsage entity by IDs.
+func (_c *UserCreate) AddAiUsageIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddAiUsageIDs(ids...)
+	return _c
+}
+
+// AddAiUsages adds the "ai_usages" edges to the AIUsage entity.
+func (_c *UserCreate) AddAiUsages(v ...*AIUsage) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAiUsageIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (_c *UserCreate) AddSessionIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddSessionIDs(ids...)
+	return _c
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (_c *UserCreate) AddSessions(v ...*Session) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSessionIDs(ids...)
+}
+
+// AddPasswordResetTokenIDs adds the "password_reset_tokens" edge to the PasswordResetToken entity by IDs.
+func (_c *UserCreate) AddPasswordResetTokenIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddPasswordResetTokenIDs(ids...)
+	return _c
+}
+
+// AddPasswordResetTokens adds the "password_reset_tokens" edges to the PasswordResetToken entity.
+func (_c *UserCreate) AddPasswordResetTokens(v ...*PasswordResetToken) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPasswordResetTokenIDs(ids...)
+}
+
+// AddPersonalAccessTokenIDs adds the "personal_access_tokens" edge to the PersonalAccessToken entity by IDs.
+func (_c *UserCreate) AddPersonalAccessTokenIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddPersonalAccessTokenIDs(ids...)
+	return _c
+}
+
+// AddPersonalAccessTokens adds the "personal_access_tokens" edges to the PersonalAccessToken entity.
+func (_c *UserCreate) AddPersonalAccessTokens(v ...*PersonalAccessToken) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPersonalAccessTokenIDs(ids...)
+}
+
+// AddIdentityIDs adds the "identities" edge to the UserIdentity entity by IDs.
+func (_c *UserCreate) AddIdentityIDs(ids ...int) *UserCreate {
+	_c.mutation.AddIdentityIDs(ids...)
+	return _c
+}
+
+// AddIdentities adds the "identities" edges to the UserIdentity entity.
+func (_c *UserCreate) AddIdentities(v ...*UserIdentity) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddIdentityIDs(ids...)
+}
+
+// SetTotpCredentialID sets the "totp_credential" edge to the TOTPCredential entity by ID.
+func (_c *UserCreate) SetTotpCredentialID(id int) *UserCreate {
+	_c.mutation.SetTotpCredentialID(id)
+	return _c
+}
+
+// SetNillableTotpCredentialID sets the "totp_credential" edge to the TOTPCredential entity by ID if the given value is not nil.
+func (_c *UserCreate) SetNillableTotpCredentialID(id *int) *UserCreate {
+	if id != nil {
+		_c = _c.SetTotpCredentialID(*id)
+	}
+	return _c
+}
+
+// SetTotpCredential sets the "totp_credential" edge to the TOTPCredential entity.
+func (_c *UserCreate) SetTotpCredential(v *TOTPCredential) *UserCreate {
+	return _c.SetTotpCredentialID(v.ID)
+}
+
+// AddRecoveryCodeIDs adds the "recovery_codes" edge to the RecoveryCode entity by IDs.
+func (_c *UserCreate) AddRecoveryCodeIDs(ids ...int) *UserCreate {
+	_c.mutation.AddRecoveryCodeIDs(ids...)
+	return _c
+}
+
+// AddRecoveryCodes adds the "recovery_codes" edges to the RecoveryCode entity.
+func (_c *UserCreate) AddRecoveryCodes(v ...*RecoveryCode) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRecoveryCodeIDs(ids...)
+}
+
+// AddUsedMfaTokenIDs adds the "used_mfa_tokens" edge to the UsedMFAToken entity by IDs.
+func (_c *UserCreate) AddUsedMfaTokenIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddUsedMfaTokenIDs(ids...)
+	return _c
+}
+
+// AddUsedMfaTokens adds the "used_mfa_tokens" edges to the UsedMFAToken entity.
+func (_c *UserCreate) AddUsedMfaTokens(v ...*UsedMFAToken) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddUsedMfaTokenIDs(ids...)
+}
+
+// AddLoginAttemptIDs adds the "login_attempts" edge to the LoginAttempt entity by IDs.
+func (_c *UserCreate) AddLoginAttemptIDs(ids ...int) *UserCreate {
+	_c.mutation.AddLoginAttemptIDs(ids...)
+	return _c
+}
+
+// AddLoginAttempts adds the "login_attempts" edges to the LoginAttempt entity.
+func (_c *UserCreate) AddLoginAttempts(v ...*LoginAttempt) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLoginAttemptIDs(ids...)
+}
+
+// AddEmailChangeTokenIDs adds the "email_change_tokens" edge to the EmailChangeToken entity by IDs.
+func (_c *UserCreate) AddEmailChangeTokenIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddEmailChangeTokenIDs(ids...)
+	return _c
+}
+
+// AddEmailChangeTokens adds the "email_change_tokens" edges to the EmailChangeToken entity.
+func (_c *UserCreate) AddEmailChangeTokens(v ...*EmailChangeToken) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEmailChangeTokenIDs(ids...)
+}
+
+// AddAccountDeletionTokenIDs adds the "account_deletion_tokens" edge to the AccountDeletionToken entity by IDs.
+func (_c *UserCreate) AddAccountDeletionTokenIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddAccountDeletionTokenIDs(ids...)
+	return _c
+}
+
+// AddAccountDeletionTokens adds the "account_deletion_tokens" edges to the AccountDeletionToken entity.
+func (_c *UserCreate) AddAccountDeletionTokens(v ...*AccountDeletionToken) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAccountDeletionTokenIDs(ids...)
+}
+
+// AddTodoListMembershipIDs adds the "todo_list_memberships" edge to the TodoListMember entity by IDs.
+func (_c *UserCreate) AddTodoListMembershipIDs(ids ...int) *UserCreate {
+	_c.mutation.AddTodoListMembershipIDs(ids...)
+	return _c
+}
+
+// AddTodoListMemberships adds the "todo_list_memberships" edges to the TodoListMember entity.
+func (_c *UserCreate) AddTodoListMemberships(v ...*TodoListMember) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTodoListMembershipIDs(ids...)
+}
+
+// SetPersonalWorkspaceID sets the "personal_workspace" edge to the Workspace entity by ID.
+func (_c *UserCreate) SetPersonalWorkspaceID(id int) *UserCreate {
+	_c.mutation.SetPersonalWorkspaceID(id)
+	return _c
+}
+
+// SetNillablePersonalWorkspaceID sets the "personal_workspace" edge to the Workspace entity by ID if the given value is not nil.
+func (_c *UserCreate) SetNillablePersonalWorkspaceID(id *int) *UserCreate {
+	if id != nil {
+		_c = _c.SetPersonalWorkspaceID(*id)
+	}
+	return _c
+}
+
+// SetPersonalWorkspace sets the "personal_workspace" edge to the Workspace entity.
+func (_c *UserCreate) SetPersonalWorkspace(v *Workspace) *UserCreate {
+	return _c.SetPersonalWorkspaceID(v.ID)
+}
+
+// AddWorkspaceMembershipIDs adds the "workspace_memberships" edge to the WorkspaceMember entity by IDs.
+func (_c *UserCreate) AddWorkspaceMembershipIDs(ids ...int) *UserCreate {
+	_c.mutation.AddWorkspaceMembershipIDs(ids...)
+	return _c
+}
+
+// AddWorkspaceMemberships adds the "workspace_memberships" edges to the WorkspaceMember entity.
+func (_c *UserCreate) AddWorkspaceMemberships(v ...*WorkspaceMember) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddWorkspaceMembershipIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -120,6 +496,26 @@ func (_c *UserCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *UserCreate) defaults() {
+	if _, ok := _c.mutation.Locale(); !ok {
+		v := user.DefaultLocale
+		_c.mutation.SetLocale(v)
+	}
+	if _, ok := _c.mutation.TimeZone(); !ok {
+		v := user.DefaultTimeZone
+		_c.mutation.SetTimeZone(v)
+	}
+	if _, ok := _c.mutation.TodoPageSize(); !ok {
+		v := user.DefaultTodoPageSize
+		_c.mutation.SetTodoPageSize(v)
+	}
+	if _, ok := _c.mutation.TodoIncludeDone(); !ok {
+		v := user.DefaultTodoIncludeDone
+		_c.mutation.SetTodoIncludeDone(v)
+	}
+	if _, ok := _c.mutation.Role(); !ok {
+		v := user.DefaultRole
+		_c.mutation.SetRole(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := user.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -152,6 +548,41 @@ func (_c *UserCreate) check() error {
 			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "User.password": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Locale(); !ok {
+		return &ValidationError{Name: "locale", err: errors.New(`ent: missing required field "User.locale"`)}
+	}
+	if v, ok := _c.mutation.Locale(); ok {
+		if err := user.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`ent: validator failed for field "User.locale": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TimeZone(); !ok {
+		return &ValidationError{Name: "time_zone", err: errors.New(`ent: missing required field "User.time_zone"`)}
+	}
+	if v, ok := _c.mutation.TimeZone(); ok {
+		if err := user.TimeZoneValidator(v); err != nil {
+			return &ValidationError{Name: "time_zone", err: fmt.Errorf(`ent: validator failed for field "User.time_zone": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TodoPageSize(); !ok {
+		return &ValidationError{Name: "todo_page_size", err: errors.New(`ent: missing required field "User.todo_page_size"`)}
+	}
+	if v, ok := _c.mutation.TodoPageSize(); ok {
+		if err := user.TodoPageSizeValidator(v); err != nil {
+			return &ValidationError{Name: "todo_page_size", err: fmt.Errorf(`ent: validator failed for field "User.todo_page_size": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TodoIncludeDone(); !ok {
+		return &ValidationError{Name: "todo_include_done", err: errors.New(`ent: missing required field "User.todo_include_done"`)}
+	}
+	if _, ok := _c.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "User.role"`)}
+	}
+	if v, ok := _c.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
 	}
@@ -193,6 +624,38 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldPassword, field.TypeString, value)
 		_node.Password = value
 	}
+	if value, ok := _c.mutation.EmailVerifiedAt(); ok {
+		_spec.SetField(user.FieldEmailVerifiedAt, field.TypeTime, value)
+		_node.EmailVerifiedAt = &value
+	}
+	if value, ok := _c.mutation.Locale(); ok {
+		_spec.SetField(user.FieldLocale, field.TypeString, value)
+		_node.Locale = value
+	}
+	if value, ok := _c.mutation.TimeZone(); ok {
+		_spec.SetField(user.FieldTimeZone, field.TypeString, value)
+		_node.TimeZone = value
+	}
+	if value, ok := _c.mutation.TodoPageSize(); ok {
+		_spec.SetField(user.FieldTodoPageSize, field.TypeInt, value)
+		_node.TodoPageSize = value
+	}
+	if value, ok := _c.mutation.TodoIncludeDone(); ok {
+		_spec.SetField(user.FieldTodoIncludeDone, field.TypeBool, value)
+		_node.TodoIncludeDone = value
+	}
+	if value, ok := _c.mutation.DeletionScheduledAt(); ok {
+		_spec.SetField(user.FieldDeletionScheduledAt, field.TypeTime, value)
+		_node.DeletionScheduledAt = &value
+	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeString, value)
+		_node.Role = value
+	}
+	if value, ok := _c.mutation.DisabledAt(); ok {
+		_spec.SetField(user.FieldDisabledAt, field.TypeTime, value)
+		_node.DisabledAt = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -229,6 +692,262 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.TodoBreakdownsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TodoBreakdownsTable,
+			Columns: []string{user.TodoBreakdownsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todobreakdown.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TodoSummariesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TodoSummariesTable,
+			Columns: []string{user.TodoSummariesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todosummary.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AiUsagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AiUsagesTable,
+			Columns: []string{user.AiUsagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aiusage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PasswordResetTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasswordResetTokensTable,
+			Columns: []string{user.PasswordResetTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(passwordresettoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PersonalAccessTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PersonalAccessTokensTable,
+			Columns: []string{user.PersonalAccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(personalaccesstoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.IdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TotpCredentialIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.TotpCredentialTable,
+			Columns: []string{user.TotpCredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(totpcredential.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RecoveryCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RecoveryCodesTable,
+			Columns: []string{user.RecoveryCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recoverycode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UsedMfaTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsedMfaTokensTable,
+			Columns: []string{user.UsedMfaTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usedmfatoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LoginAttemptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoginAttemptsTable,
+			Columns: []string{user.LoginAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loginattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EmailChangeTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailChangeTokensTable,
+			Columns: []string{user.EmailChangeTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailchangetoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccountDeletionTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AccountDeletionTokensTable,
+			Columns: []string{user.AccountDeletionTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountdeletiontoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TodoListMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TodoListMembershipsTable,
+			Columns: []string{user.TodoListMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todolistmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PersonalWorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.PersonalWorkspaceTable,
+			Columns: []string{user.PersonalWorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WorkspaceMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WorkspaceMembershipsTable,
+			Columns: []string{user.WorkspaceMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspacemember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
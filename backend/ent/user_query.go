@@ -7,10 +7,26 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"todo-app/ent/accountdeletiontoken"
+	"todo-app/ent/aiusage"
+	"todo-app/ent/emailchangetoken"
+	"todo-app/ent/loginattempt"
+	"todo-app/ent/passwordresettoken"
+	"todo-app/ent/personalaccesstoken"
 	"todo-app/ent/predicate"
+	"todo-app/ent/recoverycode"
+	"todo-app/ent/session"
 	"todo-app/ent/todo"
+	"todo-app/ent/todobreakdown"
 	"todo-app/ent/todofilterhistory"
+	"todo-app/ent/todolistmember"
+	"todo-app/ent/todosummary"
+	"todo-app/ent/totpcredential"
+	"todo-app/ent/usedmfatoken"
 	"todo-app/ent/user"
+	"todo-app/ent/useridentity"
+	"todo-app/ent/workspace"
+	"todo-app/ent/workspacemember"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -22,13 +38,29 @@ import (
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	ctx                     *QueryContext
-	order                   []user.OrderOption
-	inters                  []Interceptor
-	predicates              []predicate.User
-	withTodos               *TodoQuery
-	withTodoFilterHistories *TodoFilterHistoryQuery
-	modifiers               []func(*sql.Selector)
+	ctx                       *QueryContext
+	order                     []user.OrderOption
+	inters                    []Interceptor
+	predicates                []predicate.User
+	withTodos                 *TodoQuery
+	withTodoFilterHistories   *TodoFilterHistoryQuery
+	withTodoBreakdowns        *TodoBreakdownQuery
+	withTodoSummaries         *TodoSummaryQuery
+	withAiUsages              *AIUsageQuery
+	withSessions              *SessionQuery
+	withPasswordResetTokens   *PasswordResetTokenQuery
+	withPersonalAccessTokens  *PersonalAccessTokenQuery
+	withIdentities            *UserIdentityQuery
+	withTotpCredential        *TOTPCredentialQuery
+	withRecoveryCodes         *RecoveryCodeQuery
+	withUsedMfaTokens         *UsedMFATokenQuery
+	withLoginAttempts         *LoginAttemptQuery
+	withEmailChangeTokens     *EmailChangeTokenQuery
+	withAccountDeletionTokens *AccountDeletionTokenQuery
+	withTodoListMemberships   *TodoListMemberQuery
+	withPersonalWorkspace     *WorkspaceQuery
+	withWorkspaceMemberships  *WorkspaceMemberQuery
+	modifiers                 []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -109,141 +141,493 @@ func (_q *UserQuery) QueryTodoFilterHistories() *TodoFilterHistoryQuery {
 	return query
 }
 
-// First returns the first User entity from the query.
-// Returns a *NotFoundError when no User was found.
-func (_q *UserQuery) First(ctx context.Context) (*User, error) {
-	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
-	if err != nil {
-		return nil, err
-	}
-	if len(nodes) == 0 {
-		return nil, &NotFoundError{user.Label}
+// QueryTodoBreakdowns chains the current query on the "todo_breakdowns" edge.
+func (_q *UserQuery) QueryTodoBreakdowns() *TodoBreakdownQuery {
+	query := (&TodoBreakdownClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(todobreakdown.Table, todobreakdown.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TodoBreakdownsTable, user.TodoBreakdownsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return nodes[0], nil
+	return query
 }
 
-// FirstX is like First, but panics if an error occurs.
-func (_q *UserQuery) FirstX(ctx context.Context) *User {
-	node, err := _q.First(ctx)
-	if err != nil && !IsNotFound(err) {
-		panic(err)
+// QueryTodoSummaries chains the current query on the "todo_summaries" edge.
+func (_q *UserQuery) QueryTodoSummaries() *TodoSummaryQuery {
+	query := (&TodoSummaryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(todosummary.Table, todosummary.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TodoSummariesTable, user.TodoSummariesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return node
+	return query
 }
 
-// FirstID returns the first User ID from the query.
-// Returns a *NotFoundError when no User ID was found.
-func (_q *UserQuery) FirstID(ctx context.Context) (id int, err error) {
-	var ids []int
-	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
-		return
-	}
-	if len(ids) == 0 {
-		err = &NotFoundError{user.Label}
-		return
+// QueryAiUsages chains the current query on the "ai_usages" edge.
+func (_q *UserQuery) QueryAiUsages() *AIUsageQuery {
+	query := (&AIUsageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(aiusage.Table, aiusage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AiUsagesTable, user.AiUsagesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return ids[0], nil
+	return query
 }
 
-// FirstIDX is like FirstID, but panics if an error occurs.
-func (_q *UserQuery) FirstIDX(ctx context.Context) int {
-	id, err := _q.FirstID(ctx)
-	if err != nil && !IsNotFound(err) {
-		panic(err)
+// QuerySessions chains the current query on the "sessions" edge.
+func (_q *UserQuery) QuerySessions() *SessionQuery {
+	query := (&SessionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(session.Table, session.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SessionsTable, user.SessionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return id
+	return query
 }
 
-// Only returns a single User entity found by the query, ensuring it only returns one.
-// Returns a *NotSingularError when more than one User entity is found.
-// Returns a *NotFoundError when no User entities are found.
-func (_q *UserQuery) Only(ctx context.Context) (*User, error) {
-	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
-	if err != nil {
-		return nil, err
-	}
-	switch len(nodes) {
-	case 1:
-		return nodes[0], nil
-	case 0:
-		return nil, &NotFoundError{user.Label}
-	default:
-		return nil, &NotSingularError{user.Label}
+// QueryPasswordResetTokens chains the current query on the "password_reset_tokens" edge.
+func (_q *UserQuery) QueryPasswordResetTokens() *PasswordResetTokenQuery {
+	query := (&PasswordResetTokenClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(passwordresettoken.Table, passwordresettoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PasswordResetTokensTable, user.PasswordResetTokensColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
+	return query
 }
 
-// OnlyX is like Only, but panics if an error occurs.
-func (_q *UserQuery) OnlyX(ctx context.Context) *User {
-	node, err := _q.Only(ctx)
-	if err != nil {
-		panic(err)
+// QueryPersonalAccessTokens chains the current query on the "personal_access_tokens" edge.
+func (_q *UserQuery) QueryPersonalAccessTokens() *PersonalAccessTokenQuery {
+	query := (&PersonalAccessTokenClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(personalaccesstoken.Table, personalaccesstoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PersonalAccessTokensTable, user.PersonalAccessTokensColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return node
+	return query
 }
 
-// OnlyID is like Only, but returns the only User ID in the query.
-// Returns a *NotSingularError when more than one User ID is found.
-// Returns a *NotFoundError when no entities are found.
-func (_q *UserQuery) OnlyID(ctx context.Context) (id int, err error) {
-	var ids []int
-	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
-		return
-	}
-	switch len(ids) {
-	case 1:
-		id = ids[0]
-	case 0:
-		err = &NotFoundError{user.Label}
-	default:
-		err = &NotSingularError{user.Label}
+// QueryIdentities chains the current query on the "identities" edge.
+func (_q *UserQuery) QueryIdentities() *UserIdentityQuery {
+	query := (&UserIdentityClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(useridentity.Table, useridentity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.IdentitiesTable, user.IdentitiesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return
+	return query
 }
 
-// OnlyIDX is like OnlyID, but panics if an error occurs.
-func (_q *UserQuery) OnlyIDX(ctx context.Context) int {
-	id, err := _q.OnlyID(ctx)
-	if err != nil {
-		panic(err)
+// QueryTotpCredential chains the current query on the "totp_credential" edge.
+func (_q *UserQuery) QueryTotpCredential() *TOTPCredentialQuery {
+	query := (&TOTPCredentialClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(totpcredential.Table, totpcredential.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.TotpCredentialTable, user.TotpCredentialColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return id
+	return query
 }
 
-// All executes the query and returns a list of Users.
-func (_q *UserQuery) All(ctx context.Context) ([]*User, error) {
-	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
-	if err := _q.prepareQuery(ctx); err != nil {
-		return nil, err
+// QueryRecoveryCodes chains the current query on the "recovery_codes" edge.
+func (_q *UserQuery) QueryRecoveryCodes() *RecoveryCodeQuery {
+	query := (&RecoveryCodeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(recoverycode.Table, recoverycode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RecoveryCodesTable, user.RecoveryCodesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	qr := querierAll[[]*User, *UserQuery]()
-	return withInterceptors[[]*User](ctx, _q, qr, _q.inters)
+	return query
 }
 
-// AllX is like All, but panics if an error occurs.
-func (_q *UserQuery) AllX(ctx context.Context) []*User {
-	nodes, err := _q.All(ctx)
-	if err != nil {
-		panic(err)
+// QueryUsedMfaTokens chains the current query on the "used_mfa_tokens" edge.
+func (_q *UserQuery) QueryUsedMfaTokens() *UsedMFATokenQuery {
+	query := (&UsedMFATokenClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(usedmfatoken.Table, usedmfatoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UsedMfaTokensTable, user.UsedMfaTokensColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return nodes
+	return query
 }
 
-// IDs executes the query and returns a list of User IDs.
-func (_q *UserQuery) IDs(ctx context.Context) (ids []int, err error) {
-	if _q.ctx.Unique == nil && _q.path != nil {
-		_q.Unique(true)
-	}
-	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
-	if err = _q.Select(user.FieldID).Scan(ctx, &ids); err != nil {
-		return nil, err
+// QueryLoginAttempts chains the current query on the "login_attempts" edge.
+func (_q *UserQuery) QueryLoginAttempts() *LoginAttemptQuery {
+	query := (&LoginAttemptClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(loginattempt.Table, loginattempt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LoginAttemptsTable, user.LoginAttemptsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return ids, nil
+	return query
 }
 
-// IDsX is like IDs, but panics if an error occurs.
-func (_q *UserQuery) IDsX(ctx context.Context) []int {
-	ids, err := _q.IDs(ctx)
-	if err != nil {
+// QueryEmailChangeTokens chains the current query on the "email_change_tokens" edge.
+func (_q *UserQuery) QueryEmailChangeTokens() *EmailChangeTokenQuery {
+	query := (&EmailChangeTokenClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(emailchangetoken.Table, emailchangetoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.EmailChangeTokensTable, user.EmailChangeTokensColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAccountDeletionTokens chains the current query on the "account_deletion_tokens" edge.
+func (_q *UserQuery) QueryAccountDeletionTokens() *AccountDeletionTokenQuery {
+	query := (&AccountDeletionTokenClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(accountdeletiontoken.Table, accountdeletiontoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AccountDeletionTokensTable, user.AccountDeletionTokensColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTodoListMemberships chains the current query on the "todo_list_memberships" edge.
+func (_q *UserQuery) QueryTodoListMemberships() *TodoListMemberQuery {
+	query := (&TodoListMemberClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(todolistmember.Table, todolistmember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TodoListMembershipsTable, user.TodoListMembershipsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPersonalWorkspace chains the current query on the "personal_workspace" edge.
+func (_q *UserQuery) QueryPersonalWorkspace() *WorkspaceQuery {
+	query := (&WorkspaceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(workspace.Table, workspace.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.PersonalWorkspaceTable, user.PersonalWorkspaceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWorkspaceMemberships chains the current query on the "workspace_memberships" edge.
+func (_q *UserQuery) QueryWorkspaceMemberships() *WorkspaceMemberQuery {
+	query := (&WorkspaceMemberClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(workspacemember.Table, workspacemember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.WorkspaceMembershipsTable, user.WorkspaceMembershipsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first User entity from the query.
+// Returns a *NotFoundError when no User was found.
+func (_q *UserQuery) First(ctx context.Context) (*User, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{user.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *UserQuery) FirstX(ctx context.Context) *User {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first User ID from the query.
+// Returns a *NotFoundError when no User ID was found.
+func (_q *UserQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{user.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *UserQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single User entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one User entity is found.
+// Returns a *NotFoundError when no User entities are found.
+func (_q *UserQuery) Only(ctx context.Context) (*User, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{user.Label}
+	default:
+		return nil, &NotSingularError{user.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *UserQuery) OnlyX(ctx context.Context) *User {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only User ID in the query.
+// Returns a *NotSingularError when more than one User ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *UserQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{user.Label}
+	default:
+		err = &NotSingularError{user.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *UserQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Users.
+func (_q *UserQuery) All(ctx context.Context) ([]*User, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*User, *UserQuery]()
+	return withInterceptors[[]*User](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *UserQuery) AllX(ctx context.Context) []*User {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of User IDs.
+func (_q *UserQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(user.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *UserQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
 		panic(err)
 	}
 	return ids
@@ -284,177 +668,953 @@ func (_q *UserQuery) Exist(ctx context.Context) (bool, error) {
 func (_q *UserQuery) ExistX(ctx context.Context) bool {
 	exist, err := _q.Exist(ctx)
 	if err != nil {
-		panic(err)
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the UserQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *UserQuery) Clone() *UserQuery {
+	if _q == nil {
+		return nil
+	}
+	return &UserQuery{
+		config:                    _q.config,
+		ctx:                       _q.ctx.Clone(),
+		order:                     append([]user.OrderOption{}, _q.order...),
+		inters:                    append([]Interceptor{}, _q.inters...),
+		predicates:                append([]predicate.User{}, _q.predicates...),
+		withTodos:                 _q.withTodos.Clone(),
+		withTodoFilterHistories:   _q.withTodoFilterHistories.Clone(),
+		withTodoBreakdowns:        _q.withTodoBreakdowns.Clone(),
+		withTodoSummaries:         _q.withTodoSummaries.Clone(),
+		withAiUsages:              _q.withAiUsages.Clone(),
+		withSessions:              _q.withSessions.Clone(),
+		withPasswordResetTokens:   _q.withPasswordResetTokens.Clone(),
+		withPersonalAccessTokens:  _q.withPersonalAccessTokens.Clone(),
+		withIdentities:            _q.withIdentities.Clone(),
+		withTotpCredential:        _q.withTotpCredential.Clone(),
+		withRecoveryCodes:         _q.withRecoveryCodes.Clone(),
+		withUsedMfaTokens:         _q.withUsedMfaTokens.Clone(),
+		withLoginAttempts:         _q.withLoginAttempts.Clone(),
+		withEmailChangeTokens:     _q.withEmailChangeTokens.Clone(),
+		withAccountDeletionTokens: _q.withAccountDeletionTokens.Clone(),
+		withTodoListMemberships:   _q.withTodoListMemberships.Clone(),
+		withPersonalWorkspace:     _q.withPersonalWorkspace.Clone(),
+		withWorkspaceMemberships:  _q.withWorkspaceMemberships.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithTodos tells the query-builder to eager-load the nodes that are connected to
+// the "todos" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithTodos(opts ...func(*TodoQuery)) *UserQuery {
+	query := (&TodoClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTodos = query
+	return _q
+}
+
+// WithTodoFilterHistories tells the query-builder to eager-load the nodes that are connected to
+// the "todo_filter_histories" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithTodoFilterHistories(opts ...func(*TodoFilterHistoryQuery)) *UserQuery {
+	query := (&TodoFilterHistoryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTodoFilterHistories = query
+	return _q
+}
+
+// WithTodoBreakdowns tells the query-builder to eager-load the nodes that are connected to
+// the "todo_breakdowns" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithTodoBreakdowns(opts ...func(*TodoBreakdownQuery)) *UserQuery {
+	query := (&TodoBreakdownClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTodoBreakdowns = query
+	return _q
+}
+
+// WithTodoSummaries tells the query-builder to eager-load the nodes that are connected to
+// the "todo_summaries" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithTodoSummaries(opts ...func(*TodoSummaryQuery)) *UserQuery {
+	query := (&TodoSummaryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTodoSummaries = query
+	return _q
+}
+
+// WithAiUsages tells the query-builder to eager-load the nodes that are connected to
+// the "ai_usages" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithAiUsages(opts ...func(*AIUsageQuery)) *UserQuery {
+	query := (&AIUsageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAiUsages = query
+	return _q
+}
+
+// WithSessions tells the query-builder to eager-load the nodes that are connected to
+// the "sessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithSessions(opts ...func(*SessionQuery)) *UserQuery {
+	query := (&SessionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSessions = query
+	return _q
+}
+
+// WithPasswordResetTokens tells the query-builder to eager-load the nodes that are connected to
+// the "password_reset_tokens" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithPasswordResetTokens(opts ...func(*PasswordResetTokenQuery)) *UserQuery {
+	query := (&PasswordResetTokenClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPasswordResetTokens = query
+	return _q
+}
+
+// WithPersonalAccessTokens tells the query-builder to eager-load the nodes that are connected to
+// the "personal_access_tokens" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithPersonalAccessTokens(opts ...func(*PersonalAccessTokenQuery)) *UserQuery {
+	query := (&PersonalAccessTokenClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPersonalAccessTokens = query
+	return _q
+}
+
+// WithIdentities tells the query-builder to eager-load the nodes that are connected to
+// the "identities" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithIdentities(opts ...func(*UserIdentityQuery)) *UserQuery {
+	query := (&UserIdentityClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withIdentities = query
+	return _q
+}
+
+// WithTotpCredential tells the query-builder to eager-load the nodes that are connected to
+// the "totp_credential" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithTotpCredential(opts ...func(*TOTPCredentialQuery)) *UserQuery {
+	query := (&TOTPCredentialClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTotpCredential = query
+	return _q
+}
+
+// WithRecoveryCodes tells the query-builder to eager-load the nodes that are connected to
+// the "recovery_codes" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithRecoveryCodes(opts ...func(*RecoveryCodeQuery)) *UserQuery {
+	query := (&RecoveryCodeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRecoveryCodes = query
+	return _q
+}
+
+// WithUsedMfaTokens tells the query-builder to eager-load the nodes that are connected to
+// the "used_mfa_tokens" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithUsedMfaTokens(opts ...func(*UsedMFATokenQuery)) *UserQuery {
+	query := (&UsedMFATokenClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withUsedMfaTokens = query
+	return _q
+}
+
+// WithLoginAttempts tells the query-builder to eager-load the nodes that are connected to
+// the "login_attempts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithLoginAttempts(opts ...func(*LoginAttemptQuery)) *UserQuery {
+	query := (&LoginAttemptClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLoginAttempts = query
+	return _q
+}
+
+// WithEmailChangeTokens tells the query-builder to eager-load the nodes that are connected to
+// the "email_change_tokens" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithEmailChangeTokens(opts ...func(*EmailChangeTokenQuery)) *UserQuery {
+	query := (&EmailChangeTokenClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEmailChangeTokens = query
+	return _q
+}
+
+// WithAccountDeletionTokens tells the query-builder to eager-load the nodes that are connected to
+// the "account_deletion_tokens" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithAccountDeletionTokens(opts ...func(*AccountDeletionTokenQuery)) *UserQuery {
+	query := (&AccountDeletionTokenClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAccountDeletionTokens = query
+	return _q
+}
+
+// WithTodoListMemberships tells the query-builder to eager-load the nodes that are connected to
+// the "todo_list_memberships" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithTodoListMemberships(opts ...func(*TodoListMemberQuery)) *UserQuery {
+	query := (&TodoListMemberClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTodoListMemberships = query
+	return _q
+}
+
+// WithPersonalWorkspace tells the query-builder to eager-load the nodes that are connected to
+// the "personal_workspace" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithPersonalWorkspace(opts ...func(*WorkspaceQuery)) *UserQuery {
+	query := (&WorkspaceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPersonalWorkspace = query
+	return _q
+}
+
+// WithWorkspaceMemberships tells the query-builder to eager-load the nodes that are connected to
+// the "workspace_memberships" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithWorkspaceMemberships(opts ...func(*WorkspaceMemberQuery)) *UserQuery {
+	query := (&WorkspaceMemberClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWorkspaceMemberships = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.User.Query().
+//		GroupBy(user.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *UserQuery) GroupBy(field string, fields ...string) *UserGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &UserGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = user.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.User.Query().
+//		Select(user.FieldName).
+//		Scan(ctx, &v)
+func (_q *UserQuery) Select(fields ...string) *UserSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &UserSelect{UserQuery: _q}
+	sbuild.label = user.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a UserSelect configured with the given aggregations.
+func (_q *UserQuery) Aggregate(fns ...AggregateFunc) *UserSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *UserQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !user.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, error) {
+	var (
+		nodes       = []*User{}
+		_spec       = _q.querySpec()
+		loadedTypes = [18]bool{
+			_q.withTodos != nil,
+			_q.withTodoFilterHistories != nil,
+			_q.withTodoBreakdowns != nil,
+			_q.withTodoSummaries != nil,
+			_q.withAiUsages != nil,
+			_q.withSessions != nil,
+			_q.withPasswordResetTokens != nil,
+			_q.withPersonalAccessTokens != nil,
+			_q.withIdentities != nil,
+			_q.withTotpCredential != nil,
+			_q.withRecoveryCodes != nil,
+			_q.withUsedMfaTokens != nil,
+			_q.withLoginAttempts != nil,
+			_q.withEmailChangeTokens != nil,
+			_q.withAccountDeletionTokens != nil,
+			_q.withTodoListMemberships != nil,
+			_q.withPersonalWorkspace != nil,
+			_q.withWorkspaceMemberships != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*User).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &User{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withTodos; query != nil {
+		if err := _q.loadTodos(ctx, query, nodes,
+			func(n *User) { n.Edges.Todos = []*Todo{} },
+			func(n *User, e *Todo) { n.Edges.Todos = append(n.Edges.Todos, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTodoFilterHistories; query != nil {
+		if err := _q.loadTodoFilterHistories(ctx, query, nodes,
+			func(n *User) { n.Edges.TodoFilterHistories = []*TodoFilterHistory{} },
+			func(n *User, e *TodoFilterHistory) {
+				n.Edges.TodoFilterHistories = append(n.Edges.TodoFilterHistories, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTodoBreakdowns; query != nil {
+		if err := _q.loadTodoBreakdowns(ctx, query, nodes,
+			func(n *User) { n.Edges.TodoBreakdowns = []*TodoBreakdown{} },
+			func(n *User, e *TodoBreakdown) { n.Edges.TodoBreakdowns = append(n.Edges.TodoBreakdowns, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTodoSummaries; query != nil {
+		if err := _q.loadTodoSummaries(ctx, query, nodes,
+			func(n *User) { n.Edges.TodoSummaries = []*TodoSummary{} },
+			func(n *User, e *TodoSummary) { n.Edges.TodoSummaries = append(n.Edges.TodoSummaries, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAiUsages; query != nil {
+		if err := _q.loadAiUsages(ctx, query, nodes,
+			func(n *User) { n.Edges.AiUsages = []*AIUsage{} },
+			func(n *User, e *AIUsage) { n.Edges.AiUsages = append(n.Edges.AiUsages, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSessions; query != nil {
+		if err := _q.loadSessions(ctx, query, nodes,
+			func(n *User) { n.Edges.Sessions = []*Session{} },
+			func(n *User, e *Session) { n.Edges.Sessions = append(n.Edges.Sessions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPasswordResetTokens; query != nil {
+		if err := _q.loadPasswordResetTokens(ctx, query, nodes,
+			func(n *User) { n.Edges.PasswordResetTokens = []*PasswordResetToken{} },
+			func(n *User, e *PasswordResetToken) {
+				n.Edges.PasswordResetTokens = append(n.Edges.PasswordResetTokens, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPersonalAccessTokens; query != nil {
+		if err := _q.loadPersonalAccessTokens(ctx, query, nodes,
+			func(n *User) { n.Edges.PersonalAccessTokens = []*PersonalAccessToken{} },
+			func(n *User, e *PersonalAccessToken) {
+				n.Edges.PersonalAccessTokens = append(n.Edges.PersonalAccessTokens, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withIdentities; query != nil {
+		if err := _q.loadIdentities(ctx, query, nodes,
+			func(n *User) { n.Edges.Identities = []*UserIdentity{} },
+			func(n *User, e *UserIdentity) { n.Edges.Identities = append(n.Edges.Identities, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTotpCredential; query != nil {
+		if err := _q.loadTotpCredential(ctx, query, nodes, nil,
+			func(n *User, e *TOTPCredential) { n.Edges.TotpCredential = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRecoveryCodes; query != nil {
+		if err := _q.loadRecoveryCodes(ctx, query, nodes,
+			func(n *User) { n.Edges.RecoveryCodes = []*RecoveryCode{} },
+			func(n *User, e *RecoveryCode) { n.Edges.RecoveryCodes = append(n.Edges.RecoveryCodes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withUsedMfaTokens; query != nil {
+		if err := _q.loadUsedMfaTokens(ctx, query, nodes,
+			func(n *User) { n.Edges.UsedMfaTokens = []*UsedMFAToken{} },
+			func(n *User, e *UsedMFAToken) { n.Edges.UsedMfaTokens = append(n.Edges.UsedMfaTokens, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLoginAttempts; query != nil {
+		if err := _q.loadLoginAttempts(ctx, query, nodes,
+			func(n *User) { n.Edges.LoginAttempts = []*LoginAttempt{} },
+			func(n *User, e *LoginAttempt) { n.Edges.LoginAttempts = append(n.Edges.LoginAttempts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withEmailChangeTokens; query != nil {
+		if err := _q.loadEmailChangeTokens(ctx, query, nodes,
+			func(n *User) { n.Edges.EmailChangeTokens = []*EmailChangeToken{} },
+			func(n *User, e *EmailChangeToken) { n.Edges.EmailChangeTokens = append(n.Edges.EmailChangeTokens, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAccountDeletionTokens; query != nil {
+		if err := _q.loadAccountDeletionTokens(ctx, query, nodes,
+			func(n *User) { n.Edges.AccountDeletionTokens = []*AccountDeletionToken{} },
+			func(n *User, e *AccountDeletionToken) {
+				n.Edges.AccountDeletionTokens = append(n.Edges.AccountDeletionTokens, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTodoListMemberships; query != nil {
+		if err := _q.loadTodoListMemberships(ctx, query, nodes,
+			func(n *User) { n.Edges.TodoListMemberships = []*TodoListMember{} },
+			func(n *User, e *TodoListMember) { n.Edges.TodoListMemberships = append(n.Edges.TodoListMemberships, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPersonalWorkspace; query != nil {
+		if err := _q.loadPersonalWorkspace(ctx, query, nodes, nil,
+			func(n *User, e *Workspace) { n.Edges.PersonalWorkspace = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withWorkspaceMemberships; query != nil {
+		if err := _q.loadWorkspaceMemberships(ctx, query, nodes,
+			func(n *User) { n.Edges.WorkspaceMemberships = []*WorkspaceMember{} },
+			func(n *User, e *WorkspaceMember) {
+				n.Edges.WorkspaceMemberships = append(n.Edges.WorkspaceMemberships, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *UserQuery) loadTodos(ctx context.Context, query *TodoQuery, nodes []*User, init func(*User), assign func(*User, *Todo)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(todo.FieldUserID)
+	}
+	query.Where(predicate.Todo(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.TodosColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadTodoFilterHistories(ctx context.Context, query *TodoFilterHistoryQuery, nodes []*User, init func(*User), assign func(*User, *TodoFilterHistory)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(todofilterhistory.FieldUserID)
+	}
+	query.Where(predicate.TodoFilterHistory(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.TodoFilterHistoriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadTodoBreakdowns(ctx context.Context, query *TodoBreakdownQuery, nodes []*User, init func(*User), assign func(*User, *TodoBreakdown)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(todobreakdown.FieldUserID)
+	}
+	query.Where(predicate.TodoBreakdown(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.TodoBreakdownsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadTodoSummaries(ctx context.Context, query *TodoSummaryQuery, nodes []*User, init func(*User), assign func(*User, *TodoSummary)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(todosummary.FieldUserID)
+	}
+	query.Where(predicate.TodoSummary(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.TodoSummariesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadAiUsages(ctx context.Context, query *AIUsageQuery, nodes []*User, init func(*User), assign func(*User, *AIUsage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(aiusage.FieldUserID)
+	}
+	query.Where(predicate.AIUsage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.AiUsagesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadSessions(ctx context.Context, query *SessionQuery, nodes []*User, init func(*User), assign func(*User, *Session)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(session.FieldUserID)
+	}
+	query.Where(predicate.Session(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.SessionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadPasswordResetTokens(ctx context.Context, query *PasswordResetTokenQuery, nodes []*User, init func(*User), assign func(*User, *PasswordResetToken)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(passwordresettoken.FieldUserID)
+	}
+	query.Where(predicate.PasswordResetToken(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.PasswordResetTokensColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadPersonalAccessTokens(ctx context.Context, query *PersonalAccessTokenQuery, nodes []*User, init func(*User), assign func(*User, *PersonalAccessToken)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(personalaccesstoken.FieldUserID)
+	}
+	query.Where(predicate.PersonalAccessToken(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.PersonalAccessTokensColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadIdentities(ctx context.Context, query *UserIdentityQuery, nodes []*User, init func(*User), assign func(*User, *UserIdentity)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(useridentity.FieldUserID)
+	}
+	query.Where(predicate.UserIdentity(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.IdentitiesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadTotpCredential(ctx context.Context, query *TOTPCredentialQuery, nodes []*User, init func(*User), assign func(*User, *TOTPCredential)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(totpcredential.FieldUserID)
+	}
+	query.Where(predicate.TOTPCredential(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.TotpCredentialColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadRecoveryCodes(ctx context.Context, query *RecoveryCodeQuery, nodes []*User, init func(*User), assign func(*User, *RecoveryCode)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(recoverycode.FieldUserID)
+	}
+	query.Where(predicate.RecoveryCode(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.RecoveryCodesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
 	}
-	return exist
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
 }
-
-// Clone returns a duplicate of the UserQuery builder, including all associated steps. It can be
-// used to prepare common query builders and use them differently after the clone is made.
-func (_q *UserQuery) Clone() *UserQuery {
-	if _q == nil {
-		return nil
+func (_q *UserQuery) loadUsedMfaTokens(ctx context.Context, query *UsedMFATokenQuery, nodes []*User, init func(*User), assign func(*User, *UsedMFAToken)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
 	}
-	return &UserQuery{
-		config:                  _q.config,
-		ctx:                     _q.ctx.Clone(),
-		order:                   append([]user.OrderOption{}, _q.order...),
-		inters:                  append([]Interceptor{}, _q.inters...),
-		predicates:              append([]predicate.User{}, _q.predicates...),
-		withTodos:               _q.withTodos.Clone(),
-		withTodoFilterHistories: _q.withTodoFilterHistories.Clone(),
-		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(usedmfatoken.FieldUserID)
 	}
-}
-
-// WithTodos tells the query-builder to eager-load the nodes that are connected to
-// the "todos" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *UserQuery) WithTodos(opts ...func(*TodoQuery)) *UserQuery {
-	query := (&TodoClient{config: _q.config}).Query()
-	for _, opt := range opts {
-		opt(query)
+	query.Where(predicate.UsedMFAToken(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.UsedMfaTokensColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
 	}
-	_q.withTodos = query
-	return _q
-}
-
-// WithTodoFilterHistories tells the query-builder to eager-load the nodes that are connected to
-// the "todo_filter_histories" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *UserQuery) WithTodoFilterHistories(opts ...func(*TodoFilterHistoryQuery)) *UserQuery {
-	query := (&TodoFilterHistoryClient{config: _q.config}).Query()
-	for _, opt := range opts {
-		opt(query)
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
 	}
-	_q.withTodoFilterHistories = query
-	return _q
-}
-
-// GroupBy is used to group vertices by one or more fields/columns.
-// It is often used with aggregate functions, like: count, max, mean, min, sum.
-//
-// Example:
-//
-//	var v []struct {
-//		Name string `json:"name,omitempty"`
-//		Count int `json:"count,omitempty"`
-//	}
-//
-//	client.User.Query().
-//		GroupBy(user.FieldName).
-//		Aggregate(ent.Count()).
-//		Scan(ctx, &v)
-func (_q *UserQuery) GroupBy(field string, fields ...string) *UserGroupBy {
-	_q.ctx.Fields = append([]string{field}, fields...)
-	grbuild := &UserGroupBy{build: _q}
-	grbuild.flds = &_q.ctx.Fields
-	grbuild.label = user.Label
-	grbuild.scan = grbuild.Scan
-	return grbuild
-}
-
-// Select allows the selection one or more fields/columns for the given query,
-// instead of selecting all fields in the entity.
-//
-// Example:
-//
-//	var v []struct {
-//		Name string `json:"name,omitempty"`
-//	}
-//
-//	client.User.Query().
-//		Select(user.FieldName).
-//		Scan(ctx, &v)
-func (_q *UserQuery) Select(fields ...string) *UserSelect {
-	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
-	sbuild := &UserSelect{UserQuery: _q}
-	sbuild.label = user.Label
-	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
-	return sbuild
-}
-
-// Aggregate returns a UserSelect configured with the given aggregations.
-func (_q *UserQuery) Aggregate(fns ...AggregateFunc) *UserSelect {
-	return _q.Select().Aggregate(fns...)
+	return nil
 }
-
-func (_q *UserQuery) prepareQuery(ctx context.Context) error {
-	for _, inter := range _q.inters {
-		if inter == nil {
-			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
-		}
-		if trv, ok := inter.(Traverser); ok {
-			if err := trv.Traverse(ctx, _q); err != nil {
-				return err
-			}
+func (_q *UserQuery) loadLoginAttempts(ctx context.Context, query *LoginAttemptQuery, nodes []*User, init func(*User), assign func(*User, *LoginAttempt)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
 	}
-	for _, f := range _q.ctx.Fields {
-		if !user.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-		}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(loginattempt.FieldUserID)
 	}
-	if _q.path != nil {
-		prev, err := _q.path(ctx)
-		if err != nil {
-			return err
+	query.Where(predicate.LoginAttempt(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.LoginAttemptsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_id" is nil for node %v`, n.ID)
 		}
-		_q.sql = prev
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
 	}
 	return nil
 }
-
-func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, error) {
-	var (
-		nodes       = []*User{}
-		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
-			_q.withTodos != nil,
-			_q.withTodoFilterHistories != nil,
+func (_q *UserQuery) loadEmailChangeTokens(ctx context.Context, query *EmailChangeTokenQuery, nodes []*User, init func(*User), assign func(*User, *EmailChangeToken)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
-	)
-	_spec.ScanValues = func(columns []string) ([]any, error) {
-		return (*User).scanValues(nil, columns)
 	}
-	_spec.Assign = func(columns []string, values []any) error {
-		node := &User{config: _q.config}
-		nodes = append(nodes, node)
-		node.Edges.loadedTypes = loadedTypes
-		return node.assignValues(columns, values)
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(emailchangetoken.FieldUserID)
 	}
-	if len(_q.modifiers) > 0 {
-		_spec.Modifiers = _q.modifiers
+	query.Where(predicate.EmailChangeToken(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.EmailChangeTokensColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
 	}
-	for i := range hooks {
-		hooks[i](ctx, _spec)
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
 	}
-	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
-		return nil, err
+	return nil
+}
+func (_q *UserQuery) loadAccountDeletionTokens(ctx context.Context, query *AccountDeletionTokenQuery, nodes []*User, init func(*User), assign func(*User, *AccountDeletionToken)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
 	}
-	if len(nodes) == 0 {
-		return nodes, nil
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(accountdeletiontoken.FieldUserID)
 	}
-	if query := _q.withTodos; query != nil {
-		if err := _q.loadTodos(ctx, query, nodes,
-			func(n *User) { n.Edges.Todos = []*Todo{} },
-			func(n *User, e *Todo) { n.Edges.Todos = append(n.Edges.Todos, e) }); err != nil {
-			return nil, err
-		}
+	query.Where(predicate.AccountDeletionToken(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.AccountDeletionTokensColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
 	}
-	if query := _q.withTodoFilterHistories; query != nil {
-		if err := _q.loadTodoFilterHistories(ctx, query, nodes,
-			func(n *User) { n.Edges.TodoFilterHistories = []*TodoFilterHistory{} },
-			func(n *User, e *TodoFilterHistory) {
-				n.Edges.TodoFilterHistories = append(n.Edges.TodoFilterHistories, e)
-			}); err != nil {
-			return nil, err
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
+		assign(node, n)
 	}
-	return nodes, nil
+	return nil
 }
-
-func (_q *UserQuery) loadTodos(ctx context.Context, query *TodoQuery, nodes []*User, init func(*User), assign func(*User, *Todo)) error {
+func (_q *UserQuery) loadTodoListMemberships(ctx context.Context, query *TodoListMemberQuery, nodes []*User, init func(*User), assign func(*User, *TodoListMember)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*User)
 	for i := range nodes {
@@ -465,10 +1625,10 @@ func (_q *UserQuery) loadTodos(ctx context.Context, query *TodoQuery, nodes []*U
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(todo.FieldUserID)
+		query.ctx.AppendFieldOnce(todolistmember.FieldUserID)
 	}
-	query.Where(predicate.Todo(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.TodosColumn), fks...))
+	query.Where(predicate.TodoListMember(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.TodoListMembershipsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -484,7 +1644,37 @@ func (_q *UserQuery) loadTodos(ctx context.Context, query *TodoQuery, nodes []*U
 	}
 	return nil
 }
-func (_q *UserQuery) loadTodoFilterHistories(ctx context.Context, query *TodoFilterHistoryQuery, nodes []*User, init func(*User), assign func(*User, *TodoFilterHistory)) error {
+func (_q *UserQuery) loadPersonalWorkspace(ctx context.Context, query *WorkspaceQuery, nodes []*User, init func(*User), assign func(*User, *Workspace)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(workspace.FieldPersonalOwnerID)
+	}
+	query.Where(predicate.Workspace(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.PersonalWorkspaceColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PersonalOwnerID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "personal_owner_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "personal_owner_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadWorkspaceMemberships(ctx context.Context, query *WorkspaceMemberQuery, nodes []*User, init func(*User), assign func(*User, *WorkspaceMember)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*User)
 	for i := range nodes {
@@ -495,10 +1685,10 @@ func (_q *UserQuery) loadTodoFilterHistories(ctx context.Context, query *TodoFil
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(todofilterhistory.FieldUserID)
+		query.ctx.AppendFieldOnce(workspacemember.FieldUserID)
 	}
-	query.Where(predicate.TodoFilterHistory(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.TodoFilterHistoriesColumn), fks...))
+	query.Where(predicate.WorkspaceMember(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.WorkspaceMembershipsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -0,0 +1,283 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"todo-app/ent/user"
+	"todo-app/ent/workspace"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Workspace is the model entity for the Workspace schema.
+type Workspace struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// PersonalOwnerID holds the value of the "personal_owner_id" field.
+	PersonalOwnerID *int `json:"personal_owner_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WorkspaceQuery when eager-loading is set.
+	Edges        WorkspaceEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WorkspaceEdges holds the relations/edges for other nodes in the graph.
+type WorkspaceEdges struct {
+	// PersonalOwner holds the value of the personal_owner edge.
+	PersonalOwner *User `json:"personal_owner,omitempty"`
+	// Members holds the value of the members edge.
+	Members []*WorkspaceMember `json:"members,omitempty"`
+	// Invites holds the value of the invites edge.
+	Invites []*WorkspaceInvite `json:"invites,omitempty"`
+	// Todos holds the value of the todos edge.
+	Todos []*Todo `json:"todos,omitempty"`
+	// TodoFilterHistories holds the value of the todo_filter_histories edge.
+	TodoFilterHistories []*TodoFilterHistory `json:"todo_filter_histories,omitempty"`
+	// TodoBreakdowns holds the value of the todo_breakdowns edge.
+	TodoBreakdowns []*TodoBreakdown `json:"todo_breakdowns,omitempty"`
+	// TodoSummaries holds the value of the todo_summaries edge.
+	TodoSummaries []*TodoSummary `json:"todo_summaries,omitempty"`
+	// TodoLists holds the value of the todo_lists edge.
+	TodoLists []*TodoList `json:"todo_lists,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [8]bool
+}
+
+// PersonalOwnerOrErr returns the PersonalOwner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WorkspaceEdges) PersonalOwnerOrErr() (*User, error) {
+	if e.PersonalOwner != nil {
+		return e.PersonalOwner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "personal_owner"}
+}
+
+// MembersOrErr returns the Members value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkspaceEdges) MembersOrErr() ([]*WorkspaceMember, error) {
+	if e.loadedTypes[1] {
+		return e.Members, nil
+	}
+	return nil, &NotLoadedError{edge: "members"}
+}
+
+// InvitesOrErr returns the Invites value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkspaceEdges) InvitesOrErr() ([]*WorkspaceInvite, error) {
+	if e.loadedTypes[2] {
+		return e.Invites, nil
+	}
+	return nil, &NotLoadedError{edge: "invites"}
+}
+
+// TodosOrErr returns the Todos value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkspaceEdges) TodosOrErr() ([]*Todo, error) {
+	if e.loadedTypes[3] {
+		return e.Todos, nil
+	}
+	return nil, &NotLoadedError{edge: "todos"}
+}
+
+// TodoFilterHistoriesOrErr returns the TodoFilterHistories value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkspaceEdges) TodoFilterHistoriesOrErr() ([]*TodoFilterHistory, error) {
+	if e.loadedTypes[4] {
+		return e.TodoFilterHistories, nil
+	}
+	return nil, &NotLoadedError{edge: "todo_filter_histories"}
+}
+
+// TodoBreakdownsOrErr returns the TodoBreakdowns value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkspaceEdges) TodoBreakdownsOrErr() ([]*TodoBreakdown, error) {
+	if e.loadedTypes[5] {
+		return e.TodoBreakdowns, nil
+	}
+	return nil, &NotLoadedError{edge: "todo_breakdowns"}
+}
+
+// TodoSummariesOrErr returns the TodoSummaries value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkspaceEdges) TodoSummariesOrErr() ([]*TodoSummary, error) {
+	if e.loadedTypes[6] {
+		return e.TodoSummaries, nil
+	}
+	return nil, &NotLoadedError{edge: "todo_summaries"}
+}
+
+// TodoListsOrErr returns the TodoLists value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkspaceEdges) TodoListsOrErr() ([]*TodoList, error) {
+	if e.loadedTypes[7] {
+		return e.TodoLists, nil
+	}
+	return nil, &NotLoadedError{edge: "todo_lists"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Workspace) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case workspace.FieldID, workspace.FieldPersonalOwnerID:
+			values[i] = new(sql.NullInt64)
+		case workspace.FieldName:
+			values[i] = new(sql.NullString)
+		case workspace.FieldCreatedAt, workspace.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Workspace fields.
+func (_m *Workspace) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case workspace.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case workspace.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case workspace.FieldPersonalOwnerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field personal_owner_id", values[i])
+			} else if value.Valid {
+				_m.PersonalOwnerID = new(int)
+				*_m.PersonalOwnerID = int(value.Int64)
+			}
+		case workspace.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case workspace.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Workspace.
+// This includes values selected through modifiers, order, etc.
+func (_m *Workspace) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPersonalOwner queries the "personal_owner" edge of the Workspace entity.
+func (_m *Workspace) QueryPersonalOwner() *UserQuery {
+	return NewWorkspaceClient(_m.config).QueryPersonalOwner(_m)
+}
+
+// QueryMembers queries the "members" edge of the Workspace entity.
+func (_m *Workspace) QueryMembers() *WorkspaceMemberQuery {
+	return NewWorkspaceClient(_m.config).QueryMembers(_m)
+}
+
+// QueryInvites queries the "invites" edge of the Workspace entity.
+func (_m *Workspace) QueryInvites() *WorkspaceInviteQuery {
+	return NewWorkspaceClient(_m.config).QueryInvites(_m)
+}
+
+// QueryTodos queries the "todos" edge of the Workspace entity.
+func (_m *Workspace) QueryTodos() *TodoQuery {
+	return NewWorkspaceClient(_m.config).QueryTodos(_m)
+}
+
+// QueryTodoFilterHistories queries the "todo_filter_histories" edge of the Workspace entity.
+func (_m *Workspace) QueryTodoFilterHistories() *TodoFilterHistoryQuery {
+	return NewWorkspaceClient(_m.config).QueryTodoFilterHistories(_m)
+}
+
+// QueryTodoBreakdowns queries the "todo_breakdowns" edge of the Workspace entity.
+func (_m *Workspace) QueryTodoBreakdowns() *TodoBreakdownQuery {
+	return NewWorkspaceClient(_m.config).QueryTodoBreakdowns(_m)
+}
+
+// QueryTodoSummaries queries the "todo_summaries" edge of the Workspace entity.
+func (_m *Workspace) QueryTodoSummaries() *TodoSummaryQuery {
+	return NewWorkspaceClient(_m.config).QueryTodoSummaries(_m)
+}
+
+// QueryTodoLists queries the "todo_lists" edge of the Workspace entity.
+func (_m *Workspace) QueryTodoLists() *TodoListQuery {
+	return NewWorkspaceClient(_m.config).QueryTodoLists(_m)
+}
+
+// Update returns a builder for updating this Workspace.
+// Note that you need to call Workspace.Unwrap() before calling this method if this Workspace
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Workspace) Update() *WorkspaceUpdateOne {
+	return NewWorkspaceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Workspace entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Workspace) Unwrap() *Workspace {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Workspace is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Workspace) String() string {
+	var builder strings.Builder
+	builder.WriteString("Workspace(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	if v := _m.PersonalOwnerID; v != nil {
+		builder.WriteString("personal_owner_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Workspaces is a parsable slice of Workspace.
+type Workspaces []*Workspace
@@ -0,0 +1,320 @@
+// Code generated by ent, DO NOT EDIT.
+
+package workspace
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the workspace type in the database.
+	Label = "workspace"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldPersonalOwnerID holds the string denoting the personal_owner_id field in the database.
+	FieldPersonalOwnerID = "personal_owner_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgePersonalOwner holds the string denoting the personal_owner edge name in mutations.
+	EdgePersonalOwner = "personal_owner"
+	// EdgeMembers holds the string denoting the members edge name in mutations.
+	EdgeMembers = "members"
+	// EdgeInvites holds the string denoting the invites edge name in mutations.
+	EdgeInvites = "invites"
+	// EdgeTodos holds the string denoting the todos edge name in mutations.
+	EdgeTodos = "todos"
+	// EdgeTodoFilterHistories holds the string denoting the todo_filter_histories edge name in mutations.
+	EdgeTodoFilterHistories = "todo_filter_histories"
+	// EdgeTodoBreakdowns holds the string denoting the todo_breakdowns edge name in mutations.
+	EdgeTodoBreakdowns = "todo_breakdowns"
+	// EdgeTodoSummaries holds the string denoting the todo_summaries edge name in mutations.
+	EdgeTodoSummaries = "todo_summaries"
+	// EdgeTodoLists holds the string denoting the todo_lists edge name in mutations.
+	EdgeTodoLists = "todo_lists"
+	// Table holds the table name of the workspace in the database.
+	Table = "workspaces"
+	// PersonalOwnerTable is the table that holds the personal_owner relation/edge.
+	PersonalOwnerTable = "workspaces"
+	// PersonalOwnerInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	PersonalOwnerInverseTable = "users"
+	// PersonalOwnerColumn is the table column denoting the personal_owner relation/edge.
+	PersonalOwnerColumn = "personal_owner_id"
+	// MembersTable is the table that holds the members relation/edge.
+	MembersTable = "workspace_members"
+	// MembersInverseTable is the table name for the WorkspaceMember entity.
+	// It exists in this package in order to avoid circular dependency with the "workspacemember" package.
+	MembersInverseTable = "workspace_members"
+	// MembersColumn is the table column denoting the members relation/edge.
+	MembersColumn = "workspace_id"
+	// InvitesTable is the table that holds the invites relation/edge.
+	InvitesTable = "workspace_invites"
+	// InvitesInverseTable is the table name for the WorkspaceInvite entity.
+	// It exists in this package in order to avoid circular dependency with the "workspaceinvite" package.
+	InvitesInverseTable = "workspace_invites"
+	// InvitesColumn is the table column denoting the invites relation/edge.
+	InvitesColumn = "workspace_id"
+	// TodosTable is the table that holds the todos relation/edge.
+	TodosTable = "todos"
+	// TodosInverseTable is the table name for the Todo entity.
+	// It exists in this package in order to avoid circular dependency with the "todo" package.
+	TodosInverseTable = "todos"
+	// TodosColumn is the table column denoting the todos relation/edge.
+	TodosColumn = "workspace_id"
+	// TodoFilterHistoriesTable is the table that holds the todo_filter_histories relation/edge.
+	TodoFilterHistoriesTable = "todo_filter_histories"
+	// TodoFilterHistoriesInverseTable is the table name for the TodoFilterHistory entity.
+	// It exists in this package in order to avoid circular dependency with the "todofilterhistory" package.
+	TodoFilterHistoriesInverseTable = "todo_filter_histories"
+	// TodoFilterHistoriesColumn is the table column denoting the todo_filter_histories relation/edge.
+	TodoFilterHistoriesColumn = "workspace_id"
+	// TodoBreakdownsTable is the table that holds the todo_breakdowns relation/edge.
+	TodoBreakdownsTable = "todo_breakdowns"
+	// TodoBreakdownsInverseTable is the table name for the TodoBreakdown entity.
+	// It exists in this package in order to avoid circular dependency with the "todobreakdown" package.
+	TodoBreakdownsInverseTable = "todo_breakdowns"
+	// TodoBreakdownsColumn is the table column denoting the todo_breakdowns relation/edge.
+	TodoBreakdownsColumn = "workspace_id"
+	// TodoSummariesTable is the table that holds the todo_summaries relation/edge.
+	TodoSummariesTable = "todo_summaries"
+	// TodoSummariesInverseTable is the table name for the TodoSummary entity.
+	// It exists in this package in order to avoid circular dependency with the "todosummary" package.
+	TodoSummariesInverseTable = "todo_summaries"
+	// TodoSummariesColumn is the table column denoting the todo_summaries relation/edge.
+	TodoSummariesColumn = "workspace_id"
+	// TodoListsTable is the table that holds the todo_lists relation/edge.
+	TodoListsTable = "todo_lists"
+	// TodoListsInverseTable is the table name for the TodoList entity.
+	// It exists in this package in order to avoid circular dependency with the "todolist" package.
+	TodoListsInverseTable = "todo_lists"
+	// TodoListsColumn is the table column denoting the todo_lists relation/edge.
+	TodoListsColumn = "workspace_id"
+)
+
+// Columns holds all SQL columns for workspace fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldPersonalOwnerID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(int) error
+)
+
+// OrderOption defines the ordering options for the Workspace queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByPersonalOwnerID orders the results by the personal_owner_id field.
+func ByPersonalOwnerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPersonalOwnerID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByPersonalOwnerField orders the results by personal_owner field.
+func ByPersonalOwnerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPersonalOwnerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMembersCount orders the results by members count.
+func ByMembersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMembersStep(), opts...)
+	}
+}
+
+// ByMembers orders the results by members terms.
+func ByMembers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMembersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByInvitesCount orders the results by invites count.
+func ByInvitesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInvitesStep(), opts...)
+	}
+}
+
+// ByInvites orders the results by invites terms.
+func ByInvites(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInvitesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTodosCount orders the results by todos count.
+func ByTodosCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTodosStep(), opts...)
+	}
+}
+
+// ByTodos orders the results by todos terms.
+func ByTodos(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTodosStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTodoFilterHistoriesCount orders the results by todo_filter_histories count.
+func ByTodoFilterHistoriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTodoFilterHistoriesStep(), opts...)
+	}
+}
+
+// ByTodoFilterHistories orders the results by todo_filter_histories terms.
+func ByTodoFilterHistories(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTodoFilterHistoriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTodoBreakdownsCount orders the results by todo_breakdowns count.
+func ByTodoBreakdownsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTodoBreakdownsStep(), opts...)
+	}
+}
+
+// ByTodoBreakdowns orders the results by todo_breakdowns terms.
+func ByTodoBreakdowns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTodoBreakdownsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTodoSummariesCount orders the results by todo_summaries count.
+func ByTodoSummariesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTodoSummariesStep(), opts...)
+	}
+}
+
+// ByTodoSummaries orders the results by todo_summaries terms.
+func ByTodoSummaries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTodoSummariesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTodoListsCount orders the results by todo_lists count.
+func ByTodoListsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTodoListsStep(), opts...)
+	}
+}
+
+// ByTodoLists orders the results by todo_lists terms.
+func ByTodoLists(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTodoListsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newPersonalOwnerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PersonalOwnerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, PersonalOwnerTable, PersonalOwnerColumn),
+	)
+}
+func newMembersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MembersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MembersTable, MembersColumn),
+	)
+}
+func newInvitesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InvitesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InvitesTable, InvitesColumn),
+	)
+}
+func newTodosStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TodosInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TodosTable, TodosColumn),
+	)
+}
+func newTodoFilterHistoriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TodoFilterHistoriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TodoFilterHistoriesTable, TodoFilterHistoriesColumn),
+	)
+}
+func newTodoBreakdownsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TodoBreakdownsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TodoBreakdownsTable, TodoBreakdownsColumn),
+	)
+}
+func newTodoSummariesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TodoSummariesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TodoSummariesTable, TodoSummariesColumn),
+	)
+}
+func newTodoListsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TodoListsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TodoListsTable, TodoListsColumn),
+	)
+}
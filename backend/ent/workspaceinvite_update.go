@@ -0,0 +1,579 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo-app/ent/predicate"
+	"todo-app/ent/workspace"
+	"todo-app/ent/workspaceinvite"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WorkspaceInviteUpdate is the builder for updating WorkspaceInvite entities.
+type WorkspaceInviteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WorkspaceInviteMutation
+}
+
+// Where appends a list predicates to the WorkspaceInviteUpdate builder.
+func (_u *WorkspaceInviteUpdate) Where(ps ...predicate.WorkspaceInvite) *WorkspaceInviteUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *WorkspaceInviteUpdate) SetWorkspaceID(v int) *WorkspaceInviteUpdate {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *WorkspaceInviteUpdate) SetNillableWorkspaceID(v *int) *WorkspaceInviteUpdate {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *WorkspaceInviteUpdate) SetEmail(v string) *WorkspaceInviteUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *WorkspaceInviteUpdate) SetNillableEmail(v *string) *WorkspaceInviteUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *WorkspaceInviteUpdate) SetRole(v string) *WorkspaceInviteUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *WorkspaceInviteUpdate) SetNillableRole(v *string) *WorkspaceInviteUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *WorkspaceInviteUpdate) SetTokenHash(v string) *WorkspaceInviteUpdate {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *WorkspaceInviteUpdate) SetNillableTokenHash(v *string) *WorkspaceInviteUpdate {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (_u *WorkspaceInviteUpdate) SetInvitedBy(v int) *WorkspaceInviteUpdate {
+	_u.mutation.ResetInvitedBy()
+	_u.mutation.SetInvitedBy(v)
+	return _u
+}
+
+// SetNillableInvitedBy sets the "invited_by" field if the given value is not nil.
+func (_u *WorkspaceInviteUpdate) SetNillableInvitedBy(v *int) *WorkspaceInviteUpdate {
+	if v != nil {
+		_u.SetInvitedBy(*v)
+	}
+	return _u
+}
+
+// AddInvitedBy adds value to the "invited_by" field.
+func (_u *WorkspaceInviteUpdate) AddInvitedBy(v int) *WorkspaceInviteUpdate {
+	_u.mutation.AddInvitedBy(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *WorkspaceInviteUpdate) SetExpiresAt(v time.Time) *WorkspaceInviteUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *WorkspaceInviteUpdate) SetNillableExpiresAt(v *time.Time) *WorkspaceInviteUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *WorkspaceInviteUpdate) SetUsedAt(v time.Time) *WorkspaceInviteUpdate {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *WorkspaceInviteUpdate) SetNillableUsedAt(v *time.Time) *WorkspaceInviteUpdate {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *WorkspaceInviteUpdate) ClearUsedAt() *WorkspaceInviteUpdate {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (_u *WorkspaceInviteUpdate) SetWorkspace(v *Workspace) *WorkspaceInviteUpdate {
+	return _u.SetWorkspaceID(v.ID)
+}
+
+// Mutation returns the WorkspaceInviteMutation object of the builder.
+func (_u *WorkspaceInviteUpdate) Mutation() *WorkspaceInviteMutation {
+	return _u.mutation
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (_u *WorkspaceInviteUpdate) ClearWorkspace() *WorkspaceInviteUpdate {
+	_u.mutation.ClearWorkspace()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WorkspaceInviteUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WorkspaceInviteUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WorkspaceInviteUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WorkspaceInviteUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WorkspaceInviteUpdate) check() error {
+	if v, ok := _u.mutation.Email(); ok {
+		if err := workspaceinvite.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "WorkspaceInvite.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := workspaceinvite.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "WorkspaceInvite.role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenHash(); ok {
+		if err := workspaceinvite.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "WorkspaceInvite.token_hash": %w`, err)}
+		}
+	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WorkspaceInvite.workspace"`)
+	}
+	return nil
+}
+
+func (_u *WorkspaceInviteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(workspaceinvite.Table, workspaceinvite.Columns, sqlgraph.NewFieldSpec(workspaceinvite.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(workspaceinvite.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(workspaceinvite.FieldRole, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(workspaceinvite.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InvitedBy(); ok {
+		_spec.SetField(workspaceinvite.FieldInvitedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInvitedBy(); ok {
+		_spec.AddField(workspaceinvite.FieldInvitedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(workspaceinvite.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(workspaceinvite.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(workspaceinvite.FieldUsedAt, field.TypeTime)
+	}
+	if _u.mutation.WorkspaceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workspaceinvite.WorkspaceTable,
+			Columns: []string{workspaceinvite.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workspaceinvite.WorkspaceTable,
+			Columns: []string{workspaceinvite.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workspaceinvite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WorkspaceInviteUpdateOne is the builder for updating a single WorkspaceInvite entity.
+type WorkspaceInviteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WorkspaceInviteMutation
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_u *WorkspaceInviteUpdateOne) SetWorkspaceID(v int) *WorkspaceInviteUpdateOne {
+	_u.mutation.SetWorkspaceID(v)
+	return _u
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (_u *WorkspaceInviteUpdateOne) SetNillableWorkspaceID(v *int) *WorkspaceInviteUpdateOne {
+	if v != nil {
+		_u.SetWorkspaceID(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *WorkspaceInviteUpdateOne) SetEmail(v string) *WorkspaceInviteUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *WorkspaceInviteUpdateOne) SetNillableEmail(v *string) *WorkspaceInviteUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *WorkspaceInviteUpdateOne) SetRole(v string) *WorkspaceInviteUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *WorkspaceInviteUpdateOne) SetNillableRole(v *string) *WorkspaceInviteUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *WorkspaceInviteUpdateOne) SetTokenHash(v string) *WorkspaceInviteUpdateOne {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *WorkspaceInviteUpdateOne) SetNillableTokenHash(v *string) *WorkspaceInviteUpdateOne {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (_u *WorkspaceInviteUpdateOne) SetInvitedBy(v int) *WorkspaceInviteUpdateOne {
+	_u.mutation.ResetInvitedBy()
+	_u.mutation.SetInvitedBy(v)
+	return _u
+}
+
+// SetNillableInvitedBy sets the "invited_by" field if the given value is not nil.
+func (_u *WorkspaceInviteUpdateOne) SetNillableInvitedBy(v *int) *WorkspaceInviteUpdateOne {
+	if v != nil {
+		_u.SetInvitedBy(*v)
+	}
+	return _u
+}
+
+// AddInvitedBy adds value to the "invited_by" field.
+func (_u *WorkspaceInviteUpdateOne) AddInvitedBy(v int) *WorkspaceInviteUpdateOne {
+	_u.mutation.AddInvitedBy(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *WorkspaceInviteUpdateOne) SetExpiresAt(v time.Time) *WorkspaceInviteUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *WorkspaceInviteUpdateOne) SetNillableExpiresAt(v *time.Time) *WorkspaceInviteUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *WorkspaceInviteUpdateOne) SetUsedAt(v time.Time) *WorkspaceInviteUpdateOne {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *WorkspaceInviteUpdateOne) SetNillableUsedAt(v *time.Time) *WorkspaceInviteUpdateOne {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *WorkspaceInviteUpdateOne) ClearUsedAt() *WorkspaceInviteUpdateOne {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (_u *WorkspaceInviteUpdateOne) SetWorkspace(v *Workspace) *WorkspaceInviteUpdateOne {
+	return _u.SetWorkspaceID(v.ID)
+}
+
+// Mutation returns the WorkspaceInviteMutation object of the builder.
+func (_u *WorkspaceInviteUpdateOne) Mutation() *WorkspaceInviteMutation {
+	return _u.mutation
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (_u *WorkspaceInviteUpdateOne) ClearWorkspace() *WorkspaceInviteUpdateOne {
+	_u.mutation.ClearWorkspace()
+	return _u
+}
+
+// Where appends a list predicates to the WorkspaceInviteUpdate builder.
+func (_u *WorkspaceInviteUpdateOne) Where(ps ...predicate.WorkspaceInvite) *WorkspaceInviteUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WorkspaceInviteUpdateOne) Select(field string, fields ...string) *WorkspaceInviteUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WorkspaceInvite entity.
+func (_u *WorkspaceInviteUpdateOne) Save(ctx context.Context) (*WorkspaceInvite, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WorkspaceInviteUpdateOne) SaveX(ctx context.Context) *WorkspaceInvite {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WorkspaceInviteUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WorkspaceInviteUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WorkspaceInviteUpdateOne) check() error {
+	if v, ok := _u.mutation.Email(); ok {
+		if err := workspaceinvite.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "WorkspaceInvite.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := workspaceinvite.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "WorkspaceInvite.role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenHash(); ok {
+		if err := workspaceinvite.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "WorkspaceInvite.token_hash": %w`, err)}
+		}
+	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WorkspaceInvite.workspace"`)
+	}
+	return nil
+}
+
+func (_u *WorkspaceInviteUpdateOne) sqlSave(ctx context.Context) (_node *WorkspaceInvite, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(workspaceinvite.Table, workspaceinvite.Columns, sqlgraph.NewFieldSpec(workspaceinvite.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WorkspaceInvite.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, workspaceinvite.FieldID)
+		for _, f := range fields {
+			if !workspaceinvite.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != workspaceinvite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(workspaceinvite.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(workspaceinvite.FieldRole, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(workspaceinvite.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InvitedBy(); ok {
+		_spec.SetField(workspaceinvite.FieldInvitedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInvitedBy(); ok {
+		_spec.AddField(workspaceinvite.FieldInvitedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(workspaceinvite.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(workspaceinvite.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(workspaceinvite.FieldUsedAt, field.TypeTime)
+	}
+	if _u.mutation.WorkspaceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workspaceinvite.WorkspaceTable,
+			Columns: []string{workspaceinvite.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workspaceinvite.WorkspaceTable,
+			Columns: []string{workspaceinvite.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WorkspaceInvite{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workspaceinvite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,67 @@
+package function_declerations
+
+import (
+	"fmt"
+
+	"google.golang.org/genai"
+)
+
+type TodoDraft struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+// ParseCreateTodosArgs は CreateTodos の FunctionCall 引数を TodoDraft の配列に変換する
+func ParseCreateTodosArgs(args map[string]interface{}) ([]TodoDraft, error) {
+	raw, ok := args["todos"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid args: todos must be an array")
+	}
+
+	drafts := make([]TodoDraft, 0, len(raw))
+	for i, item := range raw {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid args: todos[%d] must be an object", i)
+		}
+
+		draft := TodoDraft{}
+		if v, ok := m["title"].(string); ok {
+			draft.Title = v
+		}
+		if v, ok := m["description"].(string); ok {
+			draft.Description = v
+		}
+		drafts = append(drafts, draft)
+	}
+	return drafts, nil
+}
+
+var CreateTodosDeclaration = &genai.FunctionDeclaration{
+	Name:        "CreateTodos",
+	Description: "自然文から抽出した ToDo を1件以上作成する",
+	Parameters: &genai.Schema{
+		Type: genai.TypeObject,
+		Properties: map[string]*genai.Schema{
+			"todos": {
+				Type:        genai.TypeArray,
+				Description: "作成する ToDo の一覧",
+				Items: &genai.Schema{
+					Type: genai.TypeObject,
+					Properties: map[string]*genai.Schema{
+						"title": {
+							Type:        genai.TypeString,
+							Description: "ToDo のタイトル (100文字以内)",
+						},
+						"description": {
+							Type:        genai.TypeString,
+							Description: "ToDo の詳細。期限などの日時は具体的な日付に変換して記載する (200文字以内)",
+						},
+					},
+					Required: []string{"title"},
+				},
+			},
+		},
+		Required: []string{"todos"},
+	},
+}
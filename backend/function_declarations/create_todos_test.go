@@ -0,0 +1,40 @@
+package function_declerations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCreateTodosArgs(t *testing.T) {
+	t.Run("success - multiple todos", func(t *testing.T) {
+		args := map[string]interface{}{
+			"todos": []interface{}{
+				map[string]interface{}{"title": "歯医者に電話する", "description": "来週火曜日"},
+				map[string]interface{}{"title": "パスポートを更新する"},
+			},
+		}
+
+		res, err := ParseCreateTodosArgs(args)
+		assert.NoError(t, err)
+		assert.Equal(t, []TodoDraft{
+			{Title: "歯医者に電話する", Description: "来週火曜日"},
+			{Title: "パスポートを更新する", Description: ""},
+		}, res)
+	})
+
+	t.Run("error - todos missing", func(t *testing.T) {
+		res, err := ParseCreateTodosArgs(map[string]interface{}{})
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("error - invalid item", func(t *testing.T) {
+		args := map[string]interface{}{
+			"todos": []interface{}{"invalid"},
+		}
+		res, err := ParseCreateTodosArgs(args)
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+}
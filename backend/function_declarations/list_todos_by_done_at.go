@@ -14,13 +14,14 @@ type ListTodosByDoneAtArgs struct {
 	DoneTo   string `json:"done_to"`
 }
 
-func ListTodosByDoneAt(ctx context.Context, repo repositories.ITodoRepository, args ListTodosByDoneAtArgs) ([]*ent.Todo, error) {
+// Resolve は完了日時の範囲を time.Time に変換する。指定されていない側は nil を返す
+func (args ListTodosByDoneAtArgs) Resolve() (*time.Time, *time.Time, error) {
 	var doneFrom, doneTo *time.Time
 
 	if args.DoneFrom != "" {
 		t, err := time.Parse(time.RFC3339, args.DoneFrom)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		doneFrom = &t
 	}
@@ -28,11 +29,20 @@ func ListTodosByDoneAt(ctx context.Context, repo repositories.ITodoRepository, a
 	if args.DoneTo != "" {
 		t, err := time.Parse(time.RFC3339, args.DoneTo)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		doneTo = &t
 	}
 
+	return doneFrom, doneTo, nil
+}
+
+func ListTodosByDoneAt(ctx context.Context, repo repositories.ITodoRepository, args ListTodosByDoneAtArgs) ([]*ent.Todo, error) {
+	doneFrom, doneTo, err := args.Resolve()
+	if err != nil {
+		return nil, err
+	}
+
 	return repo.FetchTodosByDoneAt(ctx, doneFrom, doneTo)
 }
 
@@ -0,0 +1,202 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+	"todo-app/dto"
+	"todo-app/ent/todo"
+	"todo-app/ent/user"
+	"todo-app/repositories"
+	"todo-app/services"
+	"todo-app/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMeHandler_Export_Integration(t *testing.T) {
+	t.Run("ログイン中のユーザーのデータだけを JSON ファイルとして返すこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+		otherID := createUserWithPassword(t, "other@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "")
+		createPersonalAccessToken(t, e, userID, `{"name":"cli","scopes":["read"]}`)
+
+		ctx := context.Background()
+		testClient.Todo.Create().SetTitle("my todo").SetDescription("desc").SetUserID(userID).SetWorkspaceID(personalWorkspaceID(t, userID)).SaveX(ctx)
+		testClient.Todo.Create().SetTitle("other todo").SetDescription("desc").SetUserID(otherID).SetWorkspaceID(personalWorkspaceID(t, otherID)).SaveX(ctx)
+		testClient.TodoFilterHistory.Create().SetUserID(userID).SetWorkspaceID(personalWorkspaceID(t, userID)).SetQuery("今日の予定").SetFunctionName("filter_todos").
+			SetArgs(map[string]interface{}{"keyword": "予定"}).SetResultTodoIds([]int{1}).SaveX(ctx)
+		testClient.AIUsage.Create().SetUserID(userID).SetModel("gemini").SetTotalTokens(42).SaveX(ctx)
+
+		rec := serveWithToken(e, http.MethodGet, "/me/export", token)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		assert.Contains(t, rec.Header().Get("Content-Disposition"), "attachment;")
+
+		var res struct {
+			Version              int                                `json:"version"`
+			Profile              dto.MeDto                          `json:"profile"`
+			Todos                []dto.TodoDto                      `json:"todos"`
+			TodoFilterHistories  []dto.ExportTodoFilterHistoryDto   `json:"todo_filter_histories"`
+			Sessions             []dto.ExportSessionDto             `json:"sessions"`
+			AIUsages             []dto.ExportAIUsageDto             `json:"ai_usages"`
+			PersonalAccessTokens []dto.ExportPersonalAccessTokenDto `json:"personal_access_tokens"`
+			LoginAttempts        []dto.ExportLoginAttemptDto        `json:"login_attempts"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		assert.Equal(t, dto.PersonalDataExportVersion, res.Version)
+		assert.Equal(t, userID, res.Profile.ID)
+		require.Len(t, res.Todos, 1)
+		assert.Equal(t, "my todo", res.Todos[0].Title)
+		require.Len(t, res.TodoFilterHistories, 1)
+		assert.Equal(t, map[string]interface{}{"keyword": "予定"}, res.TodoFilterHistories[0].Args)
+		assert.Equal(t, []int{1}, res.TodoFilterHistories[0].ResultTodoIDs)
+		assert.NotEmpty(t, res.Sessions)
+		require.Len(t, res.AIUsages, 1)
+		assert.Equal(t, 42, res.AIUsages[0].TotalTokens)
+		assert.Len(t, res.PersonalAccessTokens, 1)
+		require.Len(t, res.LoginAttempts, 1)
+		assert.Equal(t, "succeeded", res.LoginAttempts[0].Result)
+	})
+
+	t.Run("パーソナルアクセストークンではエクスポートできないこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+		pat := createPersonalAccessToken(t, e, userID, `{"name":"cli","scopes":["read","write"]}`)
+
+		rec := serveWithBearer(e, http.MethodGet, "/me/export", "", pat.Token)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
+
+func TestMeHandler_DeleteMe_Integration(t *testing.T) {
+	t.Run("パスワードを確認して退会を予約し、猶予期間内は取り消せること", func(t *testing.T) {
+		t.Setenv("ACCOUNT_DELETION_GRACE_DAYS", "14")
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "")
+		otherToken := login(t, e, "user@example.com", "password123", "")
+
+		rec := serveJSONWithToken(e, http.MethodDelete, "/me", `{"password":"wrong-password"}`, token)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = serveJSONWithToken(e, http.MethodDelete, "/me", `{"password":"password123"}`, token)
+		require.Equal(t, http.StatusAccepted, rec.Code, rec.Body.String())
+		var res dto.AccountDeletionDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		assert.WithinDuration(t, time.Now().Add(14*24*time.Hour), res.DeletionScheduledAt, time.Minute)
+
+		// リクエストした端末以外はログアウトされる
+		assert.Equal(t, http.StatusUnauthorized, serveWithToken(e, http.MethodGet, "/me", otherToken).Code)
+
+		rec = serveWithToken(e, http.MethodGet, "/me", token)
+		me := getMe(t, rec.Code, rec.Body.Bytes())
+		require.NotNil(t, me.DeletionScheduledAt)
+		assert.WithinDuration(t, res.DeletionScheduledAt, *me.DeletionScheduledAt, time.Second)
+
+		rec = serveJSONWithToken(e, http.MethodDelete, "/me", `{"password":"password123"}`, token)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+
+		// 猶予期間内は再ログインして取り消せる
+		token = login(t, e, "user@example.com", "password123", "")
+		rec = serveWithToken(e, http.MethodPost, "/me/deletion/cancel", token)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+
+		rec = serveWithToken(e, http.MethodGet, "/me", token)
+		assert.Nil(t, getMe(t, rec.Code, rec.Body.Bytes()).DeletionScheduledAt)
+
+		rec = serveWithToken(e, http.MethodPost, "/me/deletion/cancel", token)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("パスワードを持たない OpenID Connect のユーザーは、メールのリンクから退会を申請できること", func(t *testing.T) {
+		dir := t.TempDir()
+		t.Setenv("MAIL_DRIVER", "log")
+		t.Setenv("MAIL_DIR", dir)
+		e, provider := setupOIDCTestApp(t)
+		t.Setenv("REGISTRATION_MODE", "open")
+		provider.SetUser(testutils.MockOIDCUser{Subject: "sub-new", Email: "new@example.com", EmailVerified: true, Name: "New User"})
+		token := cookieValue(loginWithOIDC(t, e), "token")
+		require.NotEmpty(t, token)
+
+		rec := serveWithToken(e, http.MethodPost, "/me/deletion/request", token)
+		require.Equal(t, http.StatusAccepted, rec.Code, rec.Body.String())
+		tokens := readResetTokens(t, dir)
+		require.Len(t, tokens, 1)
+
+		rec = serveJSONWithToken(e, http.MethodPost, "/me/deletion/confirm", `{"token":"invalid"}`, token)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = serveJSONWithToken(e, http.MethodPost, "/me/deletion/confirm", `{"token":"`+tokens[0]+`"}`, token)
+		require.Equal(t, http.StatusAccepted, rec.Code, rec.Body.String())
+		var res dto.AccountDeletionDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		assert.WithinDuration(t, time.Now().Add(services.AccountDeletionGracePeriod()), res.DeletionScheduledAt, time.Minute)
+
+		u := testClient.User.Query().Where(user.Email("new@example.com")).OnlyX(context.Background())
+		assert.NotNil(t, u.DeletionScheduledAt)
+
+		// トークンは 1 回だけ使用できる
+		rec = serveJSONWithToken(e, http.MethodPost, "/me/deletion/confirm", `{"token":"`+tokens[0]+`"}`, token)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("退会の確認メールのリンクは、トークンを発行したユーザー以外は使用できないこと", func(t *testing.T) {
+		dir := t.TempDir()
+		t.Setenv("MAIL_DRIVER", "log")
+		t.Setenv("MAIL_DIR", dir)
+		e := setupAuthTestApp(t)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+		createUserWithPassword(t, "other@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "")
+		otherToken := login(t, e, "other@example.com", "password123", "")
+
+		require.Equal(t, http.StatusAccepted, serveWithToken(e, http.MethodPost, "/me/deletion/request", token).Code)
+		tokens := readResetTokens(t, dir)
+		require.Len(t, tokens, 1)
+
+		rec := serveJSONWithToken(e, http.MethodPost, "/me/deletion/confirm", `{"token":"`+tokens[0]+`"}`, otherToken)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Nil(t, testClient.User.GetX(context.Background(), userID).DeletionScheduledAt)
+		assert.Zero(t, testClient.User.Query().Where(user.DeletionScheduledAtNotNil()).CountX(context.Background()))
+	})
+
+	t.Run("パーソナルアクセストークンでは退会できないこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+		pat := createPersonalAccessToken(t, e, userID, `{"name":"cli","scopes":["read","write"]}`)
+
+		rec := serveWithBearer(e, http.MethodDelete, "/me", `{"password":"password123"}`, pat.Token)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("猶予期間が過ぎたユーザーだけをデータごと削除すること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+		otherID := createUserWithPassword(t, "other@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "")
+		otherToken := login(t, e, "other@example.com", "password123", "")
+		ctx := context.Background()
+		testClient.Todo.Create().SetTitle("my todo").SetDescription("desc").SetUserID(userID).SetWorkspaceID(personalWorkspaceID(t, userID)).SaveX(ctx)
+		testClient.Todo.Create().SetTitle("other todo").SetDescription("desc").SetUserID(otherID).SetWorkspaceID(personalWorkspaceID(t, otherID)).SaveX(ctx)
+
+		require.Equal(t, http.StatusAccepted, serveJSONWithToken(e, http.MethodDelete, "/me", `{"password":"password123"}`, token).Code)
+		require.Equal(t, http.StatusAccepted, serveJSONWithToken(e, http.MethodDelete, "/me", `{"password":"password123"}`, otherToken).Code)
+		// 一方のユーザーだけ猶予期間を過ぎた状態にする
+		testClient.User.UpdateOneID(userID).SetDeletionScheduledAt(time.Now().Add(-time.Minute)).ExecX(ctx)
+
+		n, err := repositories.NewUserRepository(testClient).DeleteScheduled(ctx, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		assert.False(t, testClient.User.Query().Where(user.ID(userID)).ExistX(ctx))
+		assert.Zero(t, testClient.Todo.Query().Where(todo.UserID(userID)).CountX(ctx))
+		assert.Equal(t, http.StatusUnauthorized, serveWithToken(e, http.MethodGet, "/me", token).Code)
+
+		assert.True(t, testClient.User.Query().Where(user.ID(otherID)).ExistX(ctx))
+		assert.Equal(t, 1, testClient.Todo.Query().Where(todo.UserID(otherID)).CountX(ctx))
+	})
+}
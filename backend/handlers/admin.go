@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"todo-app/app_errors"
+	"todo-app/ent"
+	"todo-app/services"
+	"todo-app/utils"
+	"todo-app/validators"
+
+	"github.com/labstack/echo/v5"
+)
+
+type AdminHandler struct {
+	logger  *slog.Logger
+	service *services.AdminService
+}
+
+func NewAdminHandler(logger *slog.Logger, service *services.AdminService) *AdminHandler {
+	return &AdminHandler{logger: logger, service: service}
+}
+
+// ListUsers はユーザーの一覧を返す。q を指定した場合はメールアドレスか名前で絞り込む
+func (h *AdminHandler) ListUsers(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	var req validators.ListAdminUsersRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	page, limit := req.GetPageAndLimit()
+	res, err := h.service.ListUsers(c.Request().Context(), req.Q, page, limit)
+	if err != nil {
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// GetUser はユーザーの情報と Todo の件数、AI の利用状況を返す
+func (h *AdminHandler) GetUser(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	id, err := echo.PathParam[int](c, "id")
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid idParam"), http.StatusBadRequest)
+	}
+
+	res, err := h.service.GetUser(c.Request().Context(), id)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// DisableUser はユーザーを無効にし、ログインとトークンによるアクセスを拒否する
+func (h *AdminHandler) DisableUser(c *echo.Context) error {
+	return h.setDisabled(c, true)
+}
+
+// EnableUser は無効にしたユーザーを元に戻す
+func (h *AdminHandler) EnableUser(c *echo.Context) error {
+	return h.setDisabled(c, false)
+}
+
+func (h *AdminHandler) setDisabled(c *echo.Context, disabled bool) error {
+	utils.LogRequest(h.logger, c)
+
+	id, err := echo.PathParam[int](c, "id")
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid idParam"), http.StatusBadRequest)
+	}
+
+	res, err := h.service.SetDisabled(c.Request().Context(), id, disabled, c.RealIP())
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// ResetPassword はユーザーのパスワードを無効にし、再設定のリンクをユーザーにメールで送る
+func (h *AdminHandler) ResetPassword(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	id, err := echo.PathParam[int](c, "id")
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid idParam"), http.StatusBadRequest)
+	}
+
+	if err := h.service.ResetPassword(c.Request().Context(), id, c.RealIP()); err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Impersonate はユーザーとしてログインした状態の Cookie を設定する。
+// 管理者のセッションは置き換えられるため、終了する場合はログアウトして管理者として再度ログインする
+func (h *AdminHandler) Impersonate(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	id, err := echo.PathParam[int](c, "id")
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid idParam"), http.StatusBadRequest)
+	}
+
+	tokens, err := h.service.Impersonate(c.Request().Context(), id, c.RealIP(), c.Request().UserAgent())
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	setAuthCookies(c, tokens)
+	return c.JSON(http.StatusOK, map[string]string{"message": "impersonation started"})
+}
+
+// ListAuditLogs は管理者の操作の記録を新しい順に返す
+func (h *AdminHandler) ListAuditLogs(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	var req validators.ListAdminAuditLogsRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	var targetUserID *int
+	if req.UserID != 0 {
+		targetUserID = &req.UserID
+	}
+	page, limit := req.GetPageAndLimit()
+	res, err := h.service.ListAuditLogs(c.Request().Context(), targetUserID, page, limit)
+	if err != nil {
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (h *AdminHandler) handleError(c *echo.Context, err error) error {
+	if ent.IsNotFound(err) {
+		return utils.HandleError(h.logger, c, errors.New("user not found"), http.StatusNotFound)
+	}
+	if errors.Is(err, app_errors.ErrCannotModifySelf) {
+		return utils.HandleError(h.logger, c, err, http.StatusBadRequest)
+	}
+	if errors.Is(err, app_errors.ErrCannotImpersonateAdmin) || errors.Is(err, app_errors.ErrAccountDisabled) {
+		return utils.HandleError(h.logger, c, err, http.StatusForbidden)
+	}
+	return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+}
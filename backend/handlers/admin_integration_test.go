@@ -0,0 +1,206 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+	"todo-app/dto"
+	"todo-app/ent/adminauditlog"
+	"todo-app/repositories"
+
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createAdmin は管理者のユーザーを作成してログインし、アクセストークンを返す
+func createAdmin(t *testing.T, e *echo.Echo) (int, string) {
+	id := createUserWithPassword(t, "admin@example.com", "password123")
+	testClient.User.UpdateOneID(id).SetRole(repositories.UserRoleAdmin).ExecX(context.Background())
+	return id, login(t, e, "admin@example.com", "password123", "")
+}
+
+func adminUserPath(id int, action string) string {
+	path := "/admin/users/" + strconv.Itoa(id)
+	if action != "" {
+		path += "/" + action
+	}
+	return path
+}
+
+func TestAdminHandler_Authorization_Integration(t *testing.T) {
+	t.Run("管理者以外は 403 を返すこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "")
+
+		assert.Equal(t, http.StatusForbidden, serveWithToken(e, http.MethodGet, "/admin/users", token).Code)
+		assert.Equal(t, http.StatusForbidden, serveWithToken(e, http.MethodPost, adminUserPath(userID, "disable"), token).Code)
+	})
+
+	t.Run("管理者でもパーソナルアクセストークンでは呼び出せないこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		adminID, _ := createAdmin(t, e)
+		pat := createPersonalAccessToken(t, e, adminID, `{"name":"cli","scopes":["read","write"]}`)
+
+		assert.Equal(t, http.StatusForbidden, serveWithBearer(e, http.MethodGet, "/admin/users", "", pat.Token).Code)
+	})
+
+	t.Run("ログインしていない場合は 401 を返すこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+
+		assert.Equal(t, http.StatusUnauthorized, serveWithToken(e, http.MethodGet, "/admin/users", "").Code)
+	})
+}
+
+func TestAdminHandler_Users_Integration(t *testing.T) {
+	t.Run("メールアドレスでユーザーを検索できること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		_, token := createAdmin(t, e)
+		createUserWithPassword(t, "alice@example.com", "password123")
+		createUserWithPassword(t, "bob@example.com", "password123")
+
+		rec := serveWithToken(e, http.MethodGet, "/admin/users?q=ALICE", token)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var res dto.AdminListUsersResponseDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Len(t, res.Data, 1)
+		assert.Equal(t, "alice@example.com", res.Data[0].Email)
+		assert.Equal(t, repositories.UserRoleUser, res.Data[0].Role)
+
+		rec = serveWithToken(e, http.MethodGet, "/admin/users?limit=2", token)
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		assert.Len(t, res.Data, 2)
+		assert.Equal(t, 2, res.Pagination.TotalPages)
+
+		assert.Equal(t, http.StatusBadRequest, serveWithToken(e, http.MethodGet, "/admin/users?limit=1000", token).Code)
+	})
+
+	t.Run("ユーザーの Todo の件数と AI の利用状況を返すこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		_, token := createAdmin(t, e)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+		ctx := context.Background()
+		testClient.Todo.Create().SetTitle("open").SetDescription("desc").SetUserID(userID).SetWorkspaceID(personalWorkspaceID(t, userID)).SaveX(ctx)
+		testClient.Todo.Create().SetTitle("done").SetDescription("desc").SetDoneAt(time.Now()).SetUserID(userID).SetWorkspaceID(personalWorkspaceID(t, userID)).SaveX(ctx)
+		testClient.AIUsage.Create().SetUserID(userID).SetModel("gemini").SetTotalTokens(42).SaveX(ctx)
+
+		rec := serveWithToken(e, http.MethodGet, adminUserPath(userID, ""), token)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var res dto.AdminUserDetailDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		assert.Equal(t, "user@example.com", res.Email)
+		assert.Equal(t, dto.AdminTodoCountsDto{Total: 2, Done: 1, Open: 1}, res.Todos)
+		assert.Equal(t, 1, res.AIUsage.Daily.Requests)
+		assert.Equal(t, 42, res.AIUsage.Monthly.Tokens)
+
+		assert.Equal(t, http.StatusNotFound, serveWithToken(e, http.MethodGet, adminUserPath(userID+100, ""), token).Code)
+	})
+
+	t.Run("無効にしたユーザーはログインもトークンも使えず、有効に戻すと使えること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		adminID, token := createAdmin(t, e)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+		userToken := login(t, e, "user@example.com", "password123", "")
+		pat := createPersonalAccessToken(t, e, userID, `{"name":"cli","scopes":["read"]}`)
+
+		rec := serveWithToken(e, http.MethodPost, adminUserPath(userID, "disable"), token)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var res dto.AdminUserDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		assert.NotNil(t, res.DisabledAt)
+
+		assert.Equal(t, http.StatusUnauthorized, serveWithToken(e, http.MethodGet, "/me", userToken).Code)
+		assert.Equal(t, http.StatusForbidden, serveWithBearer(e, http.MethodGet, "/me", "", pat.Token).Code)
+		assert.Equal(t, http.StatusForbidden, tryLogin(e, "user@example.com", "password123"))
+
+		rec = serveWithToken(e, http.MethodPost, adminUserPath(userID, "enable"), token)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		assert.Equal(t, http.StatusOK, tryLogin(e, "user@example.com", "password123"))
+		assert.Equal(t, http.StatusOK, serveWithBearer(e, http.MethodGet, "/me", "", pat.Token).Code)
+
+		// 自分自身は無効にできない
+		assert.Equal(t, http.StatusBadRequest, serveWithToken(e, http.MethodPost, adminUserPath(adminID, "disable"), token).Code)
+
+		logs := testClient.AdminAuditLog.Query().Order(adminauditlog.ByCreatedAt()).AllX(context.Background())
+		require.Len(t, logs, 2)
+		assert.Equal(t, repositories.AdminActionDisableUser, logs[0].Action)
+		assert.Equal(t, adminID, logs[0].ActorID)
+		assert.Equal(t, userID, *logs[0].TargetUserID)
+		assert.Equal(t, repositories.AdminActionEnableUser, logs[1].Action)
+	})
+
+	t.Run("パスワードをリセットすると古いパスワードは使えず、再設定のメールが届くこと", func(t *testing.T) {
+		dir := t.TempDir()
+		t.Setenv("MAIL_DRIVER", "log")
+		t.Setenv("MAIL_DIR", dir)
+		t.Setenv("PASSWORD_RESET_URL", "http://localhost:3000/reset-password")
+		e := setupAuthTestApp(t)
+		_, token := createAdmin(t, e)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+		userToken := login(t, e, "user@example.com", "password123", "")
+
+		rec := serveWithToken(e, http.MethodPost, adminUserPath(userID, "password_reset"), token)
+		require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+
+		assert.Equal(t, http.StatusUnauthorized, tryLogin(e, "user@example.com", "password123"))
+		assert.Equal(t, http.StatusUnauthorized, serveWithToken(e, http.MethodGet, "/me", userToken).Code)
+
+		tokens := readResetTokens(t, dir)
+		require.Len(t, tokens, 1)
+		rec = postJSON(e, "/auth/password/reset", `{"token":"`+tokens[0]+`","new_password":"newpassword456"}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, http.StatusOK, tryLogin(e, "user@example.com", "newpassword456"))
+	})
+}
+
+func TestAdminHandler_Impersonate_Integration(t *testing.T) {
+	t.Run("ユーザーとして閲覧でき、更新の操作は監査記録に残ること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		adminID, token := createAdmin(t, e)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+
+		rec := serveWithToken(e, http.MethodPost, adminUserPath(userID, "impersonate"), token)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		impersonationToken := cookieValue(rec, "token")
+		require.NotEmpty(t, impersonationToken)
+
+		rec = serveWithToken(e, http.MethodGet, "/me", impersonationToken)
+		assert.Equal(t, userID, getMe(t, rec.Code, rec.Body.Bytes()).ID)
+
+		rec = serveJSONWithToken(e, http.MethodPost, "/todo", `{"title":"by admin","description":"desc"}`, impersonationToken)
+		assert.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+		// プロフィールの変更や管理者の API などセッションに限定した操作は行えない
+		assert.Equal(t, http.StatusForbidden, serveJSONWithToken(e, http.MethodPatch, "/me", `{"name":"changed"}`, impersonationToken).Code)
+		assert.Equal(t, http.StatusForbidden, serveWithToken(e, http.MethodGet, "/admin/users", impersonationToken).Code)
+
+		rec = serveWithToken(e, http.MethodGet, "/admin/audit_logs?user_id="+strconv.Itoa(userID), token)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var res dto.AdminListAuditLogsResponseDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		// 開始の記録と、拒否されたものを含む更新のリクエストが新しい順に並ぶ。GET は記録しない
+		require.Len(t, res.Data, 3)
+		for _, l := range res.Data {
+			assert.Equal(t, adminID, l.ActorID)
+		}
+		assert.Equal(t, repositories.AdminActionImpersonatedRequest, res.Data[0].Action)
+		assert.Equal(t, "/me", res.Data[0].Detail["path"])
+		assert.Equal(t, http.MethodPost, res.Data[1].Detail["method"])
+		assert.Equal(t, repositories.AdminActionImpersonate, res.Data[2].Action)
+	})
+
+	t.Run("管理者にはなりすませないこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		_, token := createAdmin(t, e)
+		otherID := createUserWithPassword(t, "other-admin@example.com", "password123")
+		testClient.User.UpdateOneID(otherID).SetRole(repositories.UserRoleAdmin).ExecX(context.Background())
+
+		rec := serveWithToken(e, http.MethodPost, adminUserPath(otherID, "impersonate"), token)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Empty(t, cookieValue(rec, "token"))
+	})
+}
@@ -5,23 +5,32 @@ import (
 	"log/slog"
 	"net/http"
 
+	"todo-app/app_errors"
 	"todo-app/dto"
+	"todo-app/ent"
 	"todo-app/services"
 	"todo-app/utils"
 	"todo-app/validators"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v5"
 )
 
 type AuthHandler struct {
-	logger  *slog.Logger
-	service services.IAuthService
+	logger          *slog.Logger
+	service         services.IAuthService
+	sessionService  *services.SessionService
+	passwordService *services.PasswordService
+	profileService  *services.ProfileService
 }
 
-func NewAuthHandler(logger *slog.Logger, service services.IAuthService) *AuthHandler {
+func NewAuthHandler(logger *slog.Logger, service services.IAuthService, sessionService *services.SessionService, passwordService *services.PasswordService, profileService *services.ProfileService) *AuthHandler {
 	return &AuthHandler{
-		logger:  logger,
-		service: service,
+		logger:          logger,
+		service:         service,
+		sessionService:  sessionService,
+		passwordService: passwordService,
+		profileService:  profileService,
 	}
 }
 
@@ -39,23 +48,356 @@ func (h *AuthHandler) Login(c *echo.Context) error {
 	}
 
 	input := &dto.LoginInput{
-		Email:    req.Email,
-		Password: req.Password,
+		Email:     req.Email,
+		Password:  req.Password,
+		IPAddress: c.RealIP(),
+		UserAgent: c.Request().UserAgent(),
 	}
 
-	token, err := h.service.Login(c.Request().Context(), input)
+	res, err := h.service.Login(c.Request().Context(), input)
 	if err != nil {
+		if errors.Is(err, app_errors.ErrLoginThrottled) {
+			return utils.HandleError(h.logger, c, err, http.StatusTooManyRequests)
+		}
+		if errors.Is(err, app_errors.ErrAccountDisabled) {
+			return utils.HandleError(h.logger, c, err, http.StatusForbidden)
+		}
 		return utils.HandleError(h.logger, c, errors.New("invalid email or password"), http.StatusUnauthorized)
 	}
+	if res.MFARequired {
+		// セッションの Cookie は発行せず、POST /auth/login/mfa で 2 段階認証のコードと一緒に送信させる
+		return c.JSON(http.StatusOK, map[string]interface{}{"mfa_required": true, "mfa_token": res.MFAToken})
+	}
+	setAuthCookies(c, res.Tokens)
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "login success"})
+}
+
+// LoginMFA は 2 段階認証のコード (またはリカバリーコード) を確認してログインを完了する
+func (h *AuthHandler) LoginMFA(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	var req validators.MFALoginRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	// OIDC でログインした場合は、リクエストの本文ではなく Cookie でトークンを受け取る
+	mfaToken := req.MFAToken
+	if mfaToken == "" {
+		if cookie, err := c.Cookie(mfaTokenCookieName); err == nil {
+			mfaToken = cookie.Value
+		}
+	}
+	input := &dto.MFALoginInput{
+		MFAToken:  mfaToken,
+		Code:      req.Code,
+		IPAddress: c.RealIP(),
+		UserAgent: c.Request().UserAgent(),
+	}
+
+	tokens, err := h.service.CompleteMFALogin(c.Request().Context(), input)
+	if err != nil {
+		if errors.Is(err, app_errors.ErrLoginThrottled) {
+			return utils.HandleError(h.logger, c, err, http.StatusTooManyRequests)
+		}
+		if errors.Is(err, app_errors.ErrInvalidMFAToken) || errors.Is(err, app_errors.ErrInvalidMFACode) {
+			return utils.HandleError(h.logger, c, err, http.StatusUnauthorized)
+		}
+		if errors.Is(err, app_errors.ErrAccountDisabled) {
+			return utils.HandleError(h.logger, c, err, http.StatusForbidden)
+		}
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+	clearMFATokenCookie(c)
+	setAuthCookies(c, tokens)
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "login success"})
+}
+
+// Refresh はリフレッシュトークンの Cookie からアクセストークンを再発行する
+func (h *AuthHandler) Refresh(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	input := &dto.RefreshInput{
+		IPAddress: c.RealIP(),
+		UserAgent: c.Request().UserAgent(),
+	}
+	if cookie, err := c.Cookie(refreshTokenCookieName); err == nil {
+		input.RefreshToken = cookie.Value
+	}
+
+	tokens, err := h.service.Refresh(c.Request().Context(), input)
+	if err != nil {
+		if errors.Is(err, app_errors.ErrInvalidRefreshToken) || errors.Is(err, app_errors.ErrRefreshTokenReused) {
+			clearAuthCookies(c)
+			return utils.HandleError(h.logger, c, err, http.StatusUnauthorized)
+		}
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+	setAuthCookies(c, tokens)
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "refresh success"})
+}
+
+func (h *AuthHandler) Register(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	var req validators.RegisterRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	input := &dto.RegisterInput{
+		Name:       req.Name,
+		Email:      req.Email,
+		Password:   req.Password,
+		InviteCode: req.InviteCode,
+	}
+
+	u, err := h.service.Register(c.Request().Context(), input)
+	if err != nil {
+		if errors.Is(err, app_errors.ErrEmailAlreadyRegistered) {
+			return utils.HandleError(h.logger, c, err, http.StatusConflict)
+		}
+		if errors.Is(err, app_errors.ErrRegistrationClosed) || errors.Is(err, app_errors.ErrInvalidInviteCode) {
+			return utils.HandleError(h.logger, c, err, http.StatusForbidden)
+		}
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusCreated, dto.UserDto{
+		ID:        u.ID,
+		Name:      u.Name,
+		Email:     u.Email,
+		CreatedAt: u.CreatedAt,
+	})
+}
+
+func (h *AuthHandler) Logout(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
 
+	if err := h.sessionService.RevokeCurrent(c.Request().Context()); err != nil && !ent.IsNotFound(err) {
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+	clearAuthCookies(c)
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "logout success"})
+}
+
+func (h *AuthHandler) ListSessions(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	res, err := h.sessionService.List(c.Request().Context())
+	if err != nil {
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (h *AuthHandler) RevokeSession(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid session id"), http.StatusBadRequest)
+	}
+
+	ctx := c.Request().Context()
+	if err := h.sessionService.Revoke(ctx, id); err != nil {
+		if ent.IsNotFound(err) {
+			return utils.HandleError(h.logger, c, errors.New("session not found"), http.StatusNotFound)
+		}
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+	if currentID, ok := services.CurrentSessionID(ctx); ok && currentID == id {
+		clearAuthCookies(c)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// RevokeAllSessions はリクエストに使ったセッションを含め、全ての端末からログアウトする
+func (h *AuthHandler) RevokeAllSessions(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	n, err := h.sessionService.RevokeAll(c.Request().Context())
+	if err != nil {
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+	clearAuthCookies(c)
+
+	return c.JSON(http.StatusOK, map[string]int{"revoked": n})
+}
+
+// ForgotPassword はパスワード再設定用のリンクをメールで送信する。
+// 登録済みのメールアドレスかどうかを推測されないよう、常に同じレスポンスを返す
+func (h *AuthHandler) ForgotPassword(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	var req validators.ForgotPasswordRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	if err := h.passwordService.RequestReset(c.Request().Context(), req.Email); err != nil {
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "if the email is registered, a password reset link has been sent"})
+}
+
+// ResetPassword はメールで送信したトークンでパスワードを再設定する。全ての端末のセッションは失効する
+func (h *AuthHandler) ResetPassword(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	var req validators.ResetPasswordRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	if err := h.passwordService.ResetPassword(c.Request().Context(), req.Token, req.NewPassword); err != nil {
+		if errors.Is(err, app_errors.ErrInvalidResetToken) {
+			return utils.HandleError(h.logger, c, err, http.StatusBadRequest)
+		}
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+	clearAuthCookies(c)
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "password reset success"})
+}
+
+// ConfirmEmailChange は PATCH /me で送信した確認メールのトークンを受け取り、メールアドレスを変更する
+func (h *AuthHandler) ConfirmEmailChange(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	var req validators.ConfirmEmailChangeRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	if err := h.profileService.ConfirmEmailChange(c.Request().Context(), req.Token); err != nil {
+		if errors.Is(err, app_errors.ErrInvalidEmailChangeToken) {
+			return utils.HandleError(h.logger, c, err, http.StatusBadRequest)
+		}
+		if errors.Is(err, app_errors.ErrEmailAlreadyRegistered) {
+			return utils.HandleError(h.logger, c, err, http.StatusConflict)
+		}
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "email changed"})
+}
+
+// CancelEmailChange は変更前のメールアドレスに送信したリンクのトークンを受け取り、メールアドレスの変更を取り消す。
+// 確認済みの変更は元に戻し、全ての端末からログアウトさせる
+func (h *AuthHandler) CancelEmailChange(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	var req validators.CancelEmailChangeRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	if err := h.profileService.CancelEmailChange(c.Request().Context(), req.Token); err != nil {
+		if errors.Is(err, app_errors.ErrInvalidEmailChangeToken) {
+			return utils.HandleError(h.logger, c, err, http.StatusBadRequest)
+		}
+		if errors.Is(err, app_errors.ErrEmailAlreadyRegistered) {
+			return utils.HandleError(h.logger, c, err, http.StatusConflict)
+		}
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+	clearAuthCookies(c)
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "email change cancelled"})
+}
+
+// リフレッシュトークンは再発行とログアウトのときだけ送信されるよう、Path を /auth に限定する
+const (
+	refreshTokenCookieName = "refresh_token"
+	refreshTokenCookiePath = "/auth"
+)
+
+func setAuthCookies(c *echo.Context, tokens *dto.AuthTokens) {
 	cookie := new(http.Cookie)
 	cookie.Name = "token"
-	cookie.Value = token
+	cookie.Value = tokens.AccessToken
 	cookie.Path = "/"
 	cookie.HttpOnly = true
 	cookie.SameSite = http.SameSiteStrictMode
-
 	c.SetCookie(cookie)
 
-	return c.JSON(http.StatusOK, map[string]string{"message": "login success"})
+	refreshCookie := new(http.Cookie)
+	refreshCookie.Name = refreshTokenCookieName
+	refreshCookie.Value = tokens.RefreshToken
+	refreshCookie.Path = refreshTokenCookiePath
+	refreshCookie.Expires = tokens.RefreshTokenExpiresAt
+	refreshCookie.HttpOnly = true
+	refreshCookie.SameSite = http.SameSiteStrictMode
+	c.SetCookie(refreshCookie)
+}
+
+func clearAuthCookies(c *echo.Context) {
+	for _, cookie := range []*http.Cookie{
+		{Name: "token", Path: "/"},
+		{Name: refreshTokenCookieName, Path: refreshTokenCookiePath},
+	} {
+		cookie.MaxAge = -1
+		cookie.HttpOnly = true
+		cookie.SameSite = http.SameSiteStrictMode
+		c.SetCookie(cookie)
+	}
+}
+
+// OIDC でログインした場合に、2 段階認証のトークンを渡す Cookie。POST /auth/login/mfa だけに送信されるよう Path を限定する
+const (
+	mfaTokenCookieName = "mfa_token"
+	mfaTokenCookiePath = "/auth/login/mfa"
+)
+
+func setMFATokenCookie(c *echo.Context, mfaToken string) {
+	c.SetCookie(&http.Cookie{
+		Name:     mfaTokenCookieName,
+		Value:    mfaToken,
+		Path:     mfaTokenCookiePath,
+		MaxAge:   int(services.MFATokenLifetime.Seconds()),
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+	})
+}
+
+func clearMFATokenCookie(c *echo.Context) {
+	c.SetCookie(&http.Cookie{Name: mfaTokenCookieName, Path: mfaTokenCookiePath, MaxAge: -1, HttpOnly: true, SameSite: http.SameSiteStrictMode})
 }
@@ -0,0 +1,374 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"todo-app/di"
+	"todo-app/dto"
+	"todo-app/ent/session"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func setupAuthTestApp(t *testing.T) *echo.Echo {
+	cleanupDatabase(t)
+	e := echo.New()
+	app, err := di.InitializeTestApp(e, testClient, new(mockAIFactory))
+	require.NoError(t, err)
+	app.Router.Setup(e)
+	return e
+}
+
+// createUserWithPassword は /auth/login でログインできるユーザーを作成する
+func createUserWithPassword(t *testing.T, email string, password string) int {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	require.NoError(t, err)
+	return testClient.User.Create().SetName("test").SetEmail(email).SetPassword(string(hash)).SaveX(context.Background()).ID
+}
+
+// login は /auth/login を呼び出し、発行されたアクセストークンを返す
+func login(t *testing.T, e *echo.Echo, email string, password string, userAgent string) string {
+	token, _ := loginWithRefreshToken(t, e, email, password, userAgent)
+	return token
+}
+
+// loginWithRefreshToken は /auth/login を呼び出し、発行されたアクセストークンとリフレッシュトークンを返す
+func loginWithRefreshToken(t *testing.T, e *echo.Echo, email string, password string, userAgent string) (string, string) {
+	req := httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(`{"email":"`+email+`","password":"`+password+`"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("User-Agent", userAgent)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	token, refreshToken := cookieValue(rec, "token"), cookieValue(rec, "refresh_token")
+	require.NotEmpty(t, token)
+	require.NotEmpty(t, refreshToken)
+	return token, refreshToken
+}
+
+// cookieValue はレスポンスで最後に設定された Cookie の値を返す
+func cookieValue(rec *httptest.ResponseRecorder, name string) string {
+	value := ""
+	for _, cookie := range rec.Result().Cookies() {
+		if cookie.Name == name {
+			value = cookie.Value
+		}
+	}
+	return value
+}
+
+func refresh(e *echo.Echo, refreshToken string, withCSRF bool) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/auth/refresh", nil)
+	req.AddCookie(&http.Cookie{Name: "refresh_token", Value: refreshToken})
+	if withCSRF {
+		csrf := avoidCSRF{}
+		csrf.SetCSRFCookie(req)
+		csrf.SetCSRFHeader(req)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func serveWithToken(e *echo.Echo, method string, target string, token string) *httptest.ResponseRecorder {
+	return serveWithTokenAs(e, method, target, token, "")
+}
+
+// serveWithTokenAs は User-Agent を指定してリクエストする。
+// セッションの端末情報はリクエストごとに更新されるため、一覧を確認する場合に使う
+func serveWithTokenAs(e *echo.Echo, method string, target string, token string, userAgent string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, nil)
+	req.Header.Set("User-Agent", userAgent)
+	req.AddCookie(&http.Cookie{Name: "token", Value: token})
+	csrf := avoidCSRF{}
+	csrf.SetCSRFCookie(req)
+	csrf.SetCSRFHeader(req)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestAuthHandler_Register_Integration(t *testing.T) {
+	setup := setupAuthTestApp
+	register := func(e *echo.Echo, body string) *httptest.ResponseRecorder {
+		// 未ログインの状態で呼び出すため、認証用の Cookie や CSRF トークンは付けない
+		req := httptest.NewRequest(http.MethodPost, "/auth/register", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("登録したユーザーでログインできること", func(t *testing.T) {
+		e := setup(t)
+
+		rec := register(e, `{"name":"test","email":"new@example.com","password":"password123"}`)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		req := httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(`{"email":"new@example.com","password":"password123"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec = httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("登録済みのメールアドレスの場合は 409 を返すこと", func(t *testing.T) {
+		e := setup(t)
+
+		rec := register(e, `{"name":"test","email":"dup@example.com","password":"password123"}`)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		rec = register(e, `{"name":"other","email":"dup@example.com","password":"password456"}`)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		assert.Contains(t, rec.Body.String(), "email is already registered")
+
+		// 大文字・小文字だけが異なるメールアドレスも同じアカウントとして扱う
+		rec = register(e, `{"name":"other","email":"Dup@Example.com","password":"password456"}`)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("登録を受け付けていない場合は 403 を返すこと", func(t *testing.T) {
+		t.Setenv("REGISTRATION_MODE", "closed")
+		e := setup(t)
+
+		rec := register(e, `{"name":"test","email":"new@example.com","password":"password123"}`)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Equal(t, 0, testClient.User.Query().CountX(context.Background()))
+	})
+}
+
+func TestAuthHandler_Sessions_Integration(t *testing.T) {
+	t.Run("ログアウトしたトークンは使えなくなること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "test@example.com", "password123")
+		token := login(t, e, "test@example.com", "password123", "browser-a")
+
+		rec := serveWithToken(e, http.MethodGet, "/todo", token)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		rec = serveWithToken(e, http.MethodPost, "/auth/logout", token)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		// 最後に設定した Cookie が有効になるため、最後の token が削除になっていること
+		var last *http.Cookie
+		for _, cookie := range rec.Result().Cookies() {
+			if cookie.Name == "token" {
+				last = cookie
+			}
+		}
+		require.NotNil(t, last)
+		assert.Equal(t, -1, last.MaxAge)
+
+		rec = serveWithToken(e, http.MethodGet, "/todo", token)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Contains(t, rec.Body.String(), "session revoked")
+	})
+
+	t.Run("ログイン中の端末を一覧できること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "test@example.com", "password123")
+		login(t, e, "test@example.com", "password123", "browser-a")
+		token := login(t, e, "test@example.com", "password123", "browser-b")
+
+		// 他のユーザーのセッションは含まない
+		createUserWithPassword(t, "other@example.com", "password123")
+		login(t, e, "other@example.com", "password123", "browser-c")
+
+		rec := serveWithTokenAs(e, http.MethodGet, "/auth/sessions", token, "browser-b")
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var res []dto.SessionDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Len(t, res, 2)
+		agents := map[string]bool{}
+		for _, se := range res {
+			agents[se.UserAgent] = se.Current
+			assert.NotEmpty(t, se.IPAddress)
+		}
+		assert.Equal(t, map[string]bool{"browser-a": false, "browser-b": true}, agents)
+	})
+
+	t.Run("指定したセッションだけを失効させられること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "test@example.com", "password123")
+		tokenA := login(t, e, "test@example.com", "password123", "browser-a")
+		tokenB := login(t, e, "test@example.com", "password123", "browser-b")
+
+		rec := serveWithToken(e, http.MethodGet, "/auth/sessions", tokenB)
+		var res []dto.SessionDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		var sessionA string
+		for _, se := range res {
+			if !se.Current {
+				sessionA = se.ID
+			}
+		}
+
+		rec = serveWithToken(e, http.MethodDelete, "/auth/sessions/"+sessionA, tokenB)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+
+		rec = serveWithToken(e, http.MethodGet, "/todo", tokenA)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		rec = serveWithToken(e, http.MethodGet, "/todo", tokenB)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("他のユーザーのセッションは失効させられないこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "test@example.com", "password123")
+		token := login(t, e, "test@example.com", "password123", "browser-a")
+		otherID := createUserWithPassword(t, "other@example.com", "password123")
+		otherToken := login(t, e, "other@example.com", "password123", "browser-b")
+		otherSession := testClient.Session.Query().Where(session.UserID(otherID)).OnlyX(context.Background())
+
+		rec := serveWithToken(e, http.MethodDelete, "/auth/sessions/"+otherSession.ID.String(), token)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+
+		rec = serveWithToken(e, http.MethodGet, "/todo", otherToken)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("全ての端末からログアウトできること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "test@example.com", "password123")
+		tokenA := login(t, e, "test@example.com", "password123", "browser-a")
+		tokenB := login(t, e, "test@example.com", "password123", "browser-b")
+
+		rec := serveWithToken(e, http.MethodDelete, "/auth/sessions", tokenB)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"revoked":2}`, rec.Body.String())
+
+		rec = serveWithToken(e, http.MethodGet, "/todo", tokenA)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		rec = serveWithToken(e, http.MethodGet, "/todo", tokenB)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("セッションを持たないトークンは受け付けないこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		userID := createUserWithPassword(t, "test@example.com", "password123")
+
+		rec := serveWithToken(e, http.MethodGet, "/todo", createTokenForSession(t, userID, ""))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		rec = serveWithToken(e, http.MethodGet, "/todo", createTokenForSession(t, userID, uuid.NewString()))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("kid が無いトークンは正しい秘密鍵で署名されていても受け付けないこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		userID := createUserWithPassword(t, "test@example.com", "password123")
+		se := testClient.Session.Create().SetUserID(userID).SetExpiresAt(time.Now().Add(time.Hour)).SaveX(context.Background())
+
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"user_id": userID,
+			"jti":     se.ID.String(),
+			"exp":     time.Now().Add(time.Hour).Unix(),
+		}).SignedString([]byte("secret"))
+		require.NoError(t, err)
+
+		rec := serveWithToken(e, http.MethodGet, "/todo", token)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		rec = serveWithToken(e, http.MethodGet, "/todo", createTokenForSession(t, userID, se.ID.String()))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
+
+func TestAuthHandler_Refresh_Integration(t *testing.T) {
+	t.Run("リフレッシュトークンを新しいものに置き換えてアクセストークンを再発行すること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "test@example.com", "password123")
+		_, refreshToken := loginWithRefreshToken(t, e, "test@example.com", "password123", "browser-a")
+
+		rec := refresh(e, refreshToken, true)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		newToken, newRefreshToken := cookieValue(rec, "token"), cookieValue(rec, "refresh_token")
+		assert.NotEmpty(t, newToken)
+		assert.NotEqual(t, refreshToken, newRefreshToken)
+
+		rec = serveWithToken(e, http.MethodGet, "/todo", newToken)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		// 置き換えたトークンで続けて再発行できる
+		rec = refresh(e, newRefreshToken, true)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("使用済みのリフレッシュトークンが使われた場合はセッションごと失効させること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "test@example.com", "password123")
+		_, refreshToken := loginWithRefreshToken(t, e, "test@example.com", "password123", "browser-a")
+
+		rec := refresh(e, refreshToken, true)
+		require.Equal(t, http.StatusOK, rec.Code)
+		newToken, newRefreshToken := cookieValue(rec, "token"), cookieValue(rec, "refresh_token")
+
+		rec = refresh(e, refreshToken, true)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Contains(t, rec.Body.String(), "refresh token reuse detected")
+
+		// 正規の利用者が持つトークンも使えなくなる
+		rec = serveWithToken(e, http.MethodGet, "/todo", newToken)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		rec = refresh(e, newRefreshToken, true)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("最大期間を過ぎたセッションは再発行できないこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "test@example.com", "password123")
+		token, refreshToken := loginWithRefreshToken(t, e, "test@example.com", "password123", "browser-a")
+
+		testClient.Session.Update().SetExpiresAt(time.Now().Add(-time.Second)).ExecX(context.Background())
+
+		rec := refresh(e, refreshToken, true)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		rec = serveWithToken(e, http.MethodGet, "/todo", token)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("ログアウトしたセッションは再発行できないこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "test@example.com", "password123")
+		token, refreshToken := loginWithRefreshToken(t, e, "test@example.com", "password123", "browser-a")
+
+		rec := serveWithToken(e, http.MethodPost, "/auth/logout", token)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		rec = refresh(e, refreshToken, true)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("CSRF トークンが無い場合は拒否すること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "test@example.com", "password123")
+		_, refreshToken := loginWithRefreshToken(t, e, "test@example.com", "password123", "browser-a")
+
+		rec := refresh(e, refreshToken, false)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		// 拒否したリクエストではトークンを消費しない
+		rec = refresh(e, refreshToken, true)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("アクセストークンは延長しないこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "test@example.com", "password123")
+		token := login(t, e, "test@example.com", "password123", "browser-a")
+
+		rec := serveWithToken(e, http.MethodGet, "/todo", token)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, cookieValue(rec, "token"))
+	})
+}
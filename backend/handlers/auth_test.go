@@ -10,7 +10,10 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
+	"todo-app/app_errors"
 	"todo-app/dto"
+	"todo-app/ent"
 
 	"github.com/labstack/echo/v5"
 	"github.com/stretchr/testify/assert"
@@ -21,9 +24,36 @@ type MockAuthService struct {
 	mock.Mock
 }
 
-func (m *MockAuthService) Login(ctx context.Context, req *dto.LoginInput) (string, error) {
+func (m *MockAuthService) Login(ctx context.Context, req *dto.LoginInput) (*dto.LoginResult, error) {
 	args := m.Called(ctx, req)
-	return args.String(0), args.Error(1)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.LoginResult), args.Error(1)
+}
+
+func (m *MockAuthService) CompleteMFALogin(ctx context.Context, req *dto.MFALoginInput) (*dto.AuthTokens, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.AuthTokens), args.Error(1)
+}
+
+func (m *MockAuthService) Refresh(ctx context.Context, req *dto.RefreshInput) (*dto.AuthTokens, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.AuthTokens), args.Error(1)
+}
+
+func (m *MockAuthService) Register(ctx context.Context, req *dto.RegisterInput) (*ent.User, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.User), args.Error(1)
 }
 
 func TestAuthHandler_Login(t *testing.T) {
@@ -67,7 +97,7 @@ func TestAuthHandler_Login(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockService := new(MockAuthService)
-			handler := NewAuthHandler(logger, mockService)
+			handler := NewAuthHandler(logger, mockService, nil, nil, nil)
 
 			req := httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(tt.reqBody))
 			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
@@ -77,9 +107,17 @@ func TestAuthHandler_Login(t *testing.T) {
 			if tt.name != "InvalidRequest" {
 				var input dto.LoginInput
 				_ = json.Unmarshal([]byte(tt.reqBody), &input)
+				var res *dto.LoginResult
+				if tt.mockToken != "" {
+					res = &dto.LoginResult{Tokens: &dto.AuthTokens{
+						AccessToken:           tt.mockToken,
+						RefreshToken:          "mock_refresh_token",
+						RefreshTokenExpiresAt: time.Now().Add(time.Hour),
+					}}
+				}
 				mockService.On("Login", mock.Anything, mock.MatchedBy(func(r *dto.LoginInput) bool {
 					return r.Email == input.Email && r.Password == input.Password
-				})).Return(tt.mockToken, tt.mockError)
+				})).Return(res, tt.mockError)
 			}
 
 			err := handler.Login(c)
@@ -100,7 +138,120 @@ func TestAuthHandler_Login(t *testing.T) {
 					}
 				}
 				assert.True(t, found, "cookie 'token' should be set")
+
+				found = false
+				for _, cookie := range cookies {
+					if cookie.Name == "refresh_token" {
+						assert.Equal(t, "mock_refresh_token", cookie.Value)
+						assert.Equal(t, "/auth", cookie.Path)
+						assert.True(t, cookie.HttpOnly)
+						found = true
+					}
+				}
+				assert.True(t, found, "cookie 'refresh_token' should be set")
+			}
+		})
+	}
+}
+
+func TestAuthHandler_LoginMFARequired(t *testing.T) {
+	e := echo.New()
+	logger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	mockService := new(MockAuthService)
+	handler := NewAuthHandler(logger, mockService, nil, nil, nil)
+
+	mockService.On("Login", mock.Anything, mock.Anything).Return(&dto.LoginResult{MFARequired: true, MFAToken: "mfa_token"}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(`{"email":"test@example.com","password":"password"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	assert.NoError(t, handler.Login(e.NewContext(req, rec)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"mfa_required":true,"mfa_token":"mfa_token"}`, rec.Body.String())
+	// 2 段階認証が完了するまでセッションの Cookie は発行しない
+	assert.Empty(t, rec.Result().Cookies())
+}
+
+func TestAuthHandler_Register(t *testing.T) {
+	e := echo.New()
+	logger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+
+	tests := []struct {
+		name           string
+		reqBody        string
+		callService    bool
+		mockUser       *ent.User
+		mockError      error
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "Success",
+			reqBody:        `{"name":"test","email":"test@example.com","password":"password123"}`,
+			callService:    true,
+			mockUser:       &ent.User{ID: 1, Name: "test", Email: "test@example.com", Password: "hash"},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `"email":"test@example.com"`,
+		},
+		{
+			name:           "InvalidRequest",
+			reqBody:        `invalid_json`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "WeakPassword",
+			reqBody:        `{"name":"test","email":"test@example.com","password":"password"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `"password":"passwordは8文字以上72バイト以下で、英字と数字を両方含めてください"`,
+		},
+		{
+			name:           "InvalidEmail",
+			reqBody:        `{"name":"test","email":"test","password":"password123"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `"email"`,
+		},
+		{
+			name:           "DuplicateEmail",
+			reqBody:        `{"name":"test","email":"test@example.com","password":"password123"}`,
+			callService:    true,
+			mockError:      app_errors.ErrEmailAlreadyRegistered,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "RegistrationClosed",
+			reqBody:        `{"name":"test","email":"test@example.com","password":"password123"}`,
+			callService:    true,
+			mockError:      app_errors.ErrRegistrationClosed,
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockAuthService)
+			handler := NewAuthHandler(logger, mockService, nil, nil, nil)
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/register", strings.NewReader(tt.reqBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if tt.callService {
+				mockService.On("Register", mock.Anything, mock.MatchedBy(func(r *dto.RegisterInput) bool {
+					return r.Email == "test@example.com" && r.Password == "password123"
+				})).Return(tt.mockUser, tt.mockError)
+			}
+
+			err := handler.Register(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.Contains(t, rec.Body.String(), tt.expectedBody)
 			}
+			assert.NotContains(t, rec.Body.String(), "hash")
+			mockService.AssertExpectations(t)
 		})
 	}
 }
@@ -0,0 +1,107 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+	"todo-app/ent/loginattempt"
+	"todo-app/repositories"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// addFailedLoginAttempts は過去のログインの失敗を監査記録に追加する
+func addFailedLoginAttempts(t *testing.T, email string, ipAddress string, n int) {
+	for i := 0; i < n; i++ {
+		testClient.LoginAttempt.Create().
+			SetEmail(email).
+			SetIPAddress(ipAddress).
+			SetResult(repositories.LoginResultInvalidCredentials).
+			SetCreatedAt(time.Now()).
+			SaveX(context.Background())
+	}
+}
+
+func TestAuthHandler_LoginThrottle_Integration(t *testing.T) {
+	t.Run("ログインの成功と失敗が監査記録に残ること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+
+		assert.Equal(t, http.StatusUnauthorized, tryLogin(e, "user@example.com", "wrong-password"))
+		assert.Equal(t, http.StatusUnauthorized, tryLogin(e, "unknown@example.com", "password123"))
+		assert.Equal(t, http.StatusOK, tryLogin(e, "user@example.com", "password123"))
+
+		attempts := testClient.LoginAttempt.Query().Order(loginattempt.ByID()).AllX(context.Background())
+		require.Len(t, attempts, 3)
+		assert.Equal(t, repositories.LoginResultInvalidCredentials, attempts[0].Result)
+		assert.Equal(t, &userID, attempts[0].UserID)
+		assert.Equal(t, "192.0.2.1", attempts[0].IPAddress)
+		// 未登録のメールアドレスはユーザーを紐付けずに記録する
+		assert.Equal(t, "unknown@example.com", attempts[1].Email)
+		assert.Nil(t, attempts[1].UserID)
+		assert.Equal(t, repositories.LoginResultSucceeded, attempts[2].Result)
+	})
+
+	t.Run("失敗が続くと正しいパスワードでもログインできなくなること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+		addFailedLoginAttempts(t, "user@example.com", "198.51.100.1", 10)
+
+		rec := postJSON(e, "/auth/login", `{"email":"User@Example.com","password":"password123"}`)
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+		retryAfter, err := strconv.Atoi(rec.Header().Get("Retry-After"))
+		require.NoError(t, err)
+		assert.Greater(t, retryAfter, 13*60)
+		assert.LessOrEqual(t, retryAfter, 15*60)
+
+		// 制限されたメールアドレス以外には影響しないこと
+		createUserWithPassword(t, "other@example.com", "password123")
+		assert.Equal(t, http.StatusOK, tryLogin(e, "other@example.com", "password123"))
+	})
+
+	t.Run("未登録のメールアドレスも同じように制限されること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		addFailedLoginAttempts(t, "unknown@example.com", "198.51.100.1", 10)
+
+		assert.Equal(t, http.StatusTooManyRequests, tryLogin(e, "unknown@example.com", "password123"))
+	})
+
+	t.Run("少数の失敗では待たずに再試行でき、ログインに成功すると数え直すこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, http.StatusUnauthorized, tryLogin(e, "user@example.com", "wrong-password"))
+		}
+		assert.Equal(t, http.StatusOK, tryLogin(e, "user@example.com", "password123"))
+		assert.Equal(t, http.StatusUnauthorized, tryLogin(e, "user@example.com", "wrong-password"))
+	})
+
+	t.Run("同じ IP アドレスから多数のアカウントを試すと制限されること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+		for i := 0; i < 100; i++ {
+			addFailedLoginAttempts(t, "user"+strconv.Itoa(i)+"@example.com", "192.0.2.1", 1)
+		}
+
+		assert.Equal(t, http.StatusTooManyRequests, tryLogin(e, "user@example.com", "password123"))
+	})
+
+	t.Run("2 段階認証のコードの失敗も制限の対象になること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "test-agent")
+		enableTOTP(t, e, token)
+
+		mfaToken := loginFirstFactor(t, e, "user@example.com", "password123")
+		// 待たずに再試行できる回数まで失敗しておき、コードの失敗で制限がかかることを確認する
+		addFailedLoginAttempts(t, "user@example.com", "198.51.100.1", 3)
+		assert.Equal(t, http.StatusUnauthorized, loginMFA(e, mfaToken, "000000").Code)
+
+		assert.Equal(t, 1, testClient.LoginAttempt.Query().Where(loginattempt.Result(repositories.LoginResultInvalidMFACode)).CountX(context.Background()))
+		assert.Equal(t, http.StatusTooManyRequests, loginMFA(e, mfaToken, "000000").Code)
+	})
+}
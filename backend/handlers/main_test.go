@@ -12,6 +12,8 @@ import (
 	"testing"
 	"time"
 	"todo-app/ent"
+	"todo-app/repositories"
+	"todo-app/utils"
 
 	entsql "entgo.io/ent/dialect/sql"
 	_ "github.com/go-sql-driver/mysql"
@@ -165,12 +167,22 @@ func (a avoidCSRF) SetCSRFHeader(req *http.Request) {
 	req.Header.Set("X-CSRF-Token", "test")
 }
 
+// createToken はセッションを作成し、その jti を持つトークンを返す
 func createToken(t *testing.T, userID int) string {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+	session, err := testClient.Session.Create().SetUserID(userID).SetExpiresAt(time.Now().Add(time.Hour)).Save(context.Background())
+	if err != nil {
+		t.Fatalf("failed to create session: %v", err)
+	}
+	return createTokenForSession(t, userID, session.ID.String())
+}
+
+func createTokenForSession(t *testing.T, userID int, jti string) string {
+	// テスト用のアプリと同じ開発用の鍵で署名する
+	tokenString, err := utils.NewDevJWTKeyManager().Sign(jwt.MapClaims{
 		"user_id": float64(userID), // jwt deserializes numbers as float64
+		"jti":     jti,
 		"exp":     time.Now().Add(time.Hour).Unix(),
 	})
-	tokenString, err := token.SignedString([]byte("secret"))
 	if err != nil {
 		t.Fatalf("failed to sign token: %v", err)
 	}
@@ -197,3 +209,47 @@ func createAuthenticatedRequest(t *testing.T, method, target, body string, userI
 	rec := httptest.NewRecorder()
 	return req, rec
 }
+
+type sseEvent struct {
+	Event string
+	Data  string
+}
+
+// parseSSEEvents は SSE のレスポンスボディをイベントの配列に変換する
+func parseSSEEvents(t *testing.T, body string) []sseEvent {
+	t.Helper()
+	var events []sseEvent
+	for _, block := range strings.Split(strings.TrimSpace(body), "\n\n") {
+		if block == "" {
+			continue
+		}
+		var ev sseEvent
+		for _, line := range strings.Split(block, "\n") {
+			if v, ok := strings.CutPrefix(line, "event: "); ok {
+				ev.Event = v
+			}
+			if v, ok := strings.CutPrefix(line, "data: "); ok {
+				ev.Data = v
+			}
+		}
+		events = append(events, ev)
+	}
+	return events
+}
+
+func sseEventNames(events []sseEvent) []string {
+	names := make([]string, len(events))
+	for i, ev := range events {
+		names[i] = ev.Event
+	}
+	return names
+}
+
+// personalWorkspaceID はユーザーの個人のワークスペースの ID を返す。まだ作成していない場合は作成する
+func personalWorkspaceID(t *testing.T, userID int) int {
+	m, err := repositories.NewWorkspaceRepository(testClient).EnsurePersonal(context.Background(), userID)
+	if err != nil {
+		t.Fatalf("failed to ensure personal workspace: %v", err)
+	}
+	return m.WorkspaceID
+}
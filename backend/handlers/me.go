@@ -0,0 +1,459 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+	"todo-app/app_errors"
+	"todo-app/dto"
+	"todo-app/ent"
+	"todo-app/services"
+	"todo-app/utils"
+	"todo-app/validators"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+)
+
+type MeHandler struct {
+	logger          *slog.Logger
+	aiUsageService  *services.AIUsageService
+	passwordService *services.PasswordService
+	patService      *services.PersonalAccessTokenService
+	mfaService      *services.MFAService
+	profileService  *services.ProfileService
+	accountService  *services.AccountService
+}
+
+func NewMeHandler(logger *slog.Logger, aiUsageService *services.AIUsageService, passwordService *services.PasswordService, patService *services.PersonalAccessTokenService, mfaService *services.MFAService, profileService *services.ProfileService, accountService *services.AccountService) *MeHandler {
+	return &MeHandler{
+		logger:          logger,
+		aiUsageService:  aiUsageService,
+		passwordService: passwordService,
+		patService:      patService,
+		mfaService:      mfaService,
+		profileService:  profileService,
+		accountService:  accountService,
+	}
+}
+
+// GetMe はログイン中のユーザーのプロフィールと設定を返す
+func (h *MeHandler) GetMe(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	res, err := h.profileService.Get(c.Request().Context())
+	if err != nil {
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// UpdateMe はプロフィールと設定を変更する。メールアドレスは新しいメールアドレスで確認した時点で変更される。
+// メールアドレスを変更する場合は current_password で現在のパスワードを確認する
+func (h *MeHandler) UpdateMe(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	var req validators.UpdateMeRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	input := &dto.UpdateProfileInput{
+		Name:     req.Name,
+		Email:    req.Email,
+		Locale:   req.Locale,
+		TimeZone: req.TimeZone,
+		// メールアドレスを変更する場合だけ確認する
+		CurrentPassword: req.CurrentPassword,
+	}
+	if req.Preferences != nil {
+		input.TodoPageSize = req.Preferences.TodoPageSize
+		input.TodoIncludeDone = req.Preferences.TodoIncludeDone
+	}
+
+	res, err := h.profileService.Update(c.Request().Context(), input)
+	if err != nil {
+		if errors.Is(err, app_errors.ErrInvalidCurrentPassword) {
+			return utils.HandleError(h.logger, c, err, http.StatusBadRequest)
+		}
+		if errors.Is(err, app_errors.ErrEmailAlreadyRegistered) {
+			return utils.HandleError(h.logger, c, err, http.StatusConflict)
+		}
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// RequestEmailChange は変更前のメールアドレスに、メールアドレスの変更を確認するリンクを送信する。
+// パスワードを持たない OpenID Connect のユーザーがメールアドレスを変更する際に使う
+func (h *MeHandler) RequestEmailChange(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	var req validators.RequestEmailChangeRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	if err := h.profileService.RequestEmailChange(c.Request().Context(), req.Email); err != nil {
+		if errors.Is(err, app_errors.ErrEmailAlreadyRegistered) {
+			return utils.HandleError(h.logger, c, err, http.StatusConflict)
+		}
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusAccepted, map[string]string{"message": "email change confirmation sent"})
+}
+
+// AuthorizeEmailChange は変更前のメールアドレスに送信したトークンを確認してから、新しいメールアドレスに確認のメールを送信する
+func (h *MeHandler) AuthorizeEmailChange(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	var req validators.AuthorizeEmailChangeRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	res, err := h.profileService.AuthorizeEmailChange(c.Request().Context(), req.Token)
+	if err != nil {
+		if errors.Is(err, app_errors.ErrInvalidEmailChangeToken) {
+			return utils.HandleError(h.logger, c, err, http.StatusBadRequest)
+		}
+		if errors.Is(err, app_errors.ErrEmailAlreadyRegistered) {
+			return utils.HandleError(h.logger, c, err, http.StatusConflict)
+		}
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// DeleteMe はパスワードを確認してから退会を申請する。猶予期間が過ぎるとアカウントと全てのデータを削除する
+func (h *MeHandler) DeleteMe(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	var req validators.DeleteMeRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	res, err := h.accountService.ScheduleDeletion(c.Request().Context(), req.Password)
+	if err != nil {
+		if errors.Is(err, app_errors.ErrInvalidCurrentPassword) {
+			return utils.HandleError(h.logger, c, err, http.StatusBadRequest)
+		}
+		if isAccountDeletionConflict(err) {
+			return utils.HandleError(h.logger, c, err, http.StatusConflict)
+		}
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusAccepted, res)
+}
+
+// RequestDeletion は退会を確認するリンクをメールで送信する。パスワードを持たない OpenID Connect のユーザーが退会する際に使う
+func (h *MeHandler) RequestDeletion(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	if err := h.accountService.RequestDeletion(c.Request().Context()); err != nil {
+		if isAccountDeletionConflict(err) {
+			return utils.HandleError(h.logger, c, err, http.StatusConflict)
+		}
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusAccepted, map[string]string{"message": "account deletion confirmation sent"})
+}
+
+// ConfirmDeletion はメールで送信したトークンを確認してから退会を申請する
+func (h *MeHandler) ConfirmDeletion(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	var req validators.ConfirmDeletionRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	res, err := h.accountService.ConfirmDeletion(c.Request().Context(), req.Token)
+	if err != nil {
+		if errors.Is(err, app_errors.ErrInvalidAccountDeletionToken) {
+			return utils.HandleError(h.logger, c, err, http.StatusBadRequest)
+		}
+		if isAccountDeletionConflict(err) {
+			return utils.HandleError(h.logger, c, err, http.StatusConflict)
+		}
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusAccepted, res)
+}
+
+// isAccountDeletionConflict は退会を申請済みの場合や、退会前に権限を移す必要がある場合に true を返す
+func isAccountDeletionConflict(err error) bool {
+	return errors.Is(err, app_errors.ErrAccountDeletionScheduled) ||
+		errors.Is(err, app_errors.ErrAccountOwnsTodoLists) ||
+		errors.Is(err, app_errors.ErrAccountAdminsWorkspaces)
+}
+
+// CancelDeletion は退会の申請を取り消す
+func (h *MeHandler) CancelDeletion(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	if err := h.accountService.CancelDeletion(c.Request().Context()); err != nil {
+		if errors.Is(err, app_errors.ErrAccountDeletionNotScheduled) {
+			return utils.HandleError(h.logger, c, err, http.StatusNotFound)
+		}
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ExportMe はログイン中のユーザーに紐づく全てのデータを JSON ファイルとしてダウンロードさせる
+func (h *MeHandler) ExportMe(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	w := utils.NewAttachmentWriter(c, echo.MIMEApplicationJSON, fmt.Sprintf("todo-app-export-%s.json", time.Now().Format("20060102")))
+	if err := h.accountService.Export(c.Request().Context(), w); err != nil {
+		// 書き込みを開始した後はステータスを変更できないため、ログにだけ残す
+		if w.Started() {
+			h.logger.Error("failed to export personal data", slog.String("error", err.Error()))
+			return nil
+		}
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+	return nil
+}
+
+func (h *MeHandler) GetAIUsage(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	ctx := c.Request().Context()
+	res, err := h.aiUsageService.GetUsage(ctx)
+	if err != nil {
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// ChangePassword は現在のパスワードを確認してからパスワードを変更する。リクエストに使った端末以外はログアウトされる
+func (h *MeHandler) ChangePassword(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	var req validators.ChangePasswordRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	if err := h.passwordService.ChangePassword(c.Request().Context(), req.CurrentPassword, req.NewPassword); err != nil {
+		if errors.Is(err, app_errors.ErrInvalidCurrentPassword) {
+			return utils.HandleError(h.logger, c, err, http.StatusBadRequest)
+		}
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *MeHandler) ListTokens(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	res, err := h.patService.List(c.Request().Context())
+	if err != nil {
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// CreateToken はパーソナルアクセストークンを発行する。トークンはレスポンスでのみ返し、再表示はできない
+func (h *MeHandler) CreateToken(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	var req validators.CreatePersonalAccessTokenRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	var expiresIn *time.Duration
+	if req.ExpiresInDays != nil {
+		d := time.Duration(*req.ExpiresInDays) * 24 * time.Hour
+		expiresIn = &d
+	}
+
+	res, err := h.patService.Create(c.Request().Context(), req.Name, req.Scopes, expiresIn)
+	if err != nil {
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusCreated, res)
+}
+
+func (h *MeHandler) RevokeToken(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid token id"), http.StatusBadRequest)
+	}
+
+	if err := h.patService.Revoke(c.Request().Context(), id); err != nil {
+		if ent.IsNotFound(err) {
+			return utils.HandleError(h.logger, c, errors.New("token not found"), http.StatusNotFound)
+		}
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *MeHandler) GetMFAStatus(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	res, err := h.mfaService.GetStatus(c.Request().Context())
+	if err != nil {
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// EnrollTOTP は認証アプリに登録するシークレットを発行する。ConfirmTOTP でコードを確認すると 2 段階認証が有効になる
+func (h *MeHandler) EnrollTOTP(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	res, err := h.mfaService.StartTOTPEnrollment(c.Request().Context())
+	if err != nil {
+		if errors.Is(err, app_errors.ErrMFAAlreadyEnabled) {
+			return utils.HandleError(h.logger, c, err, http.StatusConflict)
+		}
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// ConfirmTOTP は認証アプリのコードを確認して 2 段階認証を有効にし、リカバリーコードを返す。リカバリーコードは再表示できない
+func (h *MeHandler) ConfirmTOTP(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	var req validators.ConfirmTOTPRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	res, err := h.mfaService.ConfirmTOTPEnrollment(c.Request().Context(), req.Code)
+	if err != nil {
+		if errors.Is(err, app_errors.ErrInvalidMFACode) {
+			return utils.HandleError(h.logger, c, err, http.StatusBadRequest)
+		}
+		if errors.Is(err, app_errors.ErrMFANotEnabled) {
+			return utils.HandleError(h.logger, c, errors.New("totp enrollment has not been started"), http.StatusNotFound)
+		}
+		if errors.Is(err, app_errors.ErrMFAAlreadyEnabled) {
+			return utils.HandleError(h.logger, c, err, http.StatusConflict)
+		}
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// RegenerateRecoveryCodes は認証アプリのコードを確認してから、リカバリーコードを再発行する。古いコードは使用できなくなる
+func (h *MeHandler) RegenerateRecoveryCodes(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	var req validators.ConfirmTOTPRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	res, err := h.mfaService.RegenerateRecoveryCodes(c.Request().Context(), req.Code)
+	if err != nil {
+		if errors.Is(err, app_errors.ErrInvalidMFACode) {
+			return utils.HandleError(h.logger, c, err, http.StatusBadRequest)
+		}
+		if errors.Is(err, app_errors.ErrMFANotEnabled) {
+			return utils.HandleError(h.logger, c, err, http.StatusNotFound)
+		}
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// DisableTOTP はパスワードを確認してから 2 段階認証を無効にする
+func (h *MeHandler) DisableTOTP(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	var req validators.DisableTOTPRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	if err := h.mfaService.DisableTOTP(c.Request().Context(), req.Password); err != nil {
+		if errors.Is(err, app_errors.ErrInvalidCurrentPassword) {
+			return utils.HandleError(h.logger, c, err, http.StatusBadRequest)
+		}
+		if errors.Is(err, app_errors.ErrMFANotEnabled) {
+			return utils.HandleError(h.logger, c, err, http.StatusNotFound)
+		}
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
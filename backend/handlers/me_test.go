@@ -0,0 +1,95 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"todo-app/di"
+	"todo-app/dto"
+
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/genai"
+)
+
+func TestMeHandler_GetAIUsage_Integration(t *testing.T) {
+	filterResponse := &genai.GenerateContentResponse{
+		Candidates: []*genai.Candidate{
+			{
+				Content: &genai.Content{
+					Parts: []*genai.Part{
+						{FunctionCall: &genai.FunctionCall{Name: "ListTodosByDoneAt", Args: map[string]interface{}{}}},
+					},
+				},
+			},
+		},
+		UsageMetadata: &genai.GenerateContentResponseUsageMetadata{
+			PromptTokenCount:     120,
+			CandidatesTokenCount: 30,
+			TotalTokenCount:      150,
+		},
+	}
+
+	setup := func(t *testing.T) *echo.Echo {
+		cleanupDatabase(t)
+		e := echo.New()
+
+		mClient := new(mockGenAIClient)
+		mFactory := new(mockAIFactory)
+		mFactory.On("GetGeminiClient", mock.Anything).Return(mClient, nil)
+		mClient.On("GenerateContent", mock.Anything, "gemini-3-flash-preview", mock.Anything, mock.Anything).Return(filterResponse, nil)
+
+		app, err := di.InitializeTestApp(e, testClient, mFactory)
+		assert.NoError(t, err)
+		app.Router.Setup(e)
+		return e
+	}
+
+	t.Run("AI の呼び出し回数とトークン数を返すこと", func(t *testing.T) {
+		e := setup(t)
+		user := testClient.User.Create().SetName("test").SetEmail("test").SetPassword("test").SaveX(context.Background())
+
+		req, rec := createAuthenticatedRequest(t, http.MethodGet, "/todo/ai_filter?query=completed+tasks", "", user.ID)
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		req, rec = createAuthenticatedRequest(t, http.MethodGet, "/me/ai_usage", "", user.ID)
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var res dto.AIUsageResponseDto
+		err := json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, res.Daily.Requests)
+		assert.Equal(t, 150, res.Daily.Tokens)
+		assert.Nil(t, res.Daily.RequestLimit)
+		assert.Equal(t, 1, res.Monthly.Requests)
+		assert.Equal(t, 150, res.Monthly.Tokens)
+	})
+
+	t.Run("上限に達した場合は 429 を返すこと", func(t *testing.T) {
+		t.Setenv("AI_DAILY_REQUEST_QUOTA", "1")
+		e := setup(t)
+		user := testClient.User.Create().SetName("test").SetEmail("test").SetPassword("test").SaveX(context.Background())
+
+		req, rec := createAuthenticatedRequest(t, http.MethodGet, "/todo/ai_filter?query=completed+tasks", "", user.ID)
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		req, rec = createAuthenticatedRequest(t, http.MethodGet, "/todo/ai_filter?query=tasks+done+yesterday", "", user.ID)
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+		assert.Contains(t, rec.Body.String(), "daily request limit of 1 reached")
+
+		req, rec = createAuthenticatedRequest(t, http.MethodGet, "/me/ai_usage", "", user.ID)
+		e.ServeHTTP(rec, req)
+
+		var res dto.AIUsageResponseDto
+		err := json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, res.Daily.Requests)
+		assert.Equal(t, 1, *res.Daily.RequestLimit)
+	})
+}
@@ -0,0 +1,222 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"todo-app/dto"
+	"todo-app/ent/recoverycode"
+	"todo-app/utils"
+
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serveJSONWithToken(e *echo.Echo, method string, target string, body string, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.AddCookie(&http.Cookie{Name: "token", Value: token})
+	csrf := avoidCSRF{}
+	csrf.SetCSRFCookie(req)
+	csrf.SetCSRFHeader(req)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+// enableTOTP は 2 段階認証を有効にし、シークレットとリカバリーコード、確認に使った時間ステップを返す
+func enableTOTP(t *testing.T, e *echo.Echo, token string) (string, []string, int64) {
+	rec := serveWithToken(e, http.MethodPost, "/me/mfa/totp", token)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var enrollment dto.TOTPEnrollmentDto
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &enrollment))
+
+	step := utils.TOTPStep(time.Now())
+	code, err := utils.TOTPCode(enrollment.Secret, step)
+	require.NoError(t, err)
+	rec = serveJSONWithToken(e, http.MethodPost, "/me/mfa/totp/confirm", `{"code":"`+code+`"}`, token)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var codes dto.RecoveryCodesDto
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &codes))
+	return enrollment.Secret, codes.RecoveryCodes, step
+}
+
+// loginFirstFactor はパスワードでログインし、2 段階認証のトークンを返す
+func loginFirstFactor(t *testing.T, e *echo.Echo, email string, password string) string {
+	rec := postJSON(e, "/auth/login", `{"email":"`+email+`","password":"`+password+`"}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, cookieValue(rec, "token"))
+
+	var res struct {
+		MFARequired bool   `json:"mfa_required"`
+		MFAToken    string `json:"mfa_token"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.True(t, res.MFARequired)
+	require.NotEmpty(t, res.MFAToken)
+	return res.MFAToken
+}
+
+func loginMFA(e *echo.Echo, mfaToken string, code string) *httptest.ResponseRecorder {
+	return postJSON(e, "/auth/login/mfa", `{"mfa_token":"`+mfaToken+`","code":"`+code+`"}`)
+}
+
+// loginMFAWithCookie は OIDC でのログインと同様に、2 段階認証のトークンを Cookie で送信する
+func loginMFAWithCookie(e *echo.Echo, mfaToken string, code string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/auth/login/mfa", strings.NewReader(`{"code":"`+code+`"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.AddCookie(&http.Cookie{Name: "mfa_token", Value: mfaToken})
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMeHandler_MFA_Integration(t *testing.T) {
+	t.Run("登録したシークレットは認証アプリのコードで確認するまで有効にならないこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "")
+
+		rec := serveWithToken(e, http.MethodPost, "/me/mfa/totp", token)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var enrollment dto.TOTPEnrollmentDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &enrollment))
+		assert.NotEmpty(t, enrollment.Secret)
+		assert.True(t, strings.HasPrefix(enrollment.ProvisioningURI, "otpauth://totp/"))
+		assert.Contains(t, enrollment.ProvisioningURI, "user@example.com")
+
+		// 未確認の間はパスワードだけでログインできる
+		login(t, e, "user@example.com", "password123", "")
+
+		rec = serveJSONWithToken(e, http.MethodPost, "/me/mfa/totp/confirm", `{"code":"000000"}`, token)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = serveWithToken(e, http.MethodGet, "/me/mfa", token)
+		assert.JSONEq(t, `{"totp_enabled":false,"recovery_codes_remaining":0}`, rec.Body.String())
+	})
+
+	t.Run("有効にするとログインに 2 段階認証のコードが必要になること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "")
+		secret, recoveryCodes, step := enableTOTP(t, e, token)
+		assert.Len(t, recoveryCodes, 10)
+
+		rec := serveWithToken(e, http.MethodGet, "/me/mfa", token)
+		assert.JSONEq(t, `{"totp_enabled":true,"recovery_codes_remaining":10}`, rec.Body.String())
+
+		// リカバリーコードはハッシュ化して保存する
+		stored := testClient.RecoveryCode.Query().AllX(context.Background())
+		for _, c := range stored {
+			assert.NotContains(t, recoveryCodes, c.CodeHash)
+		}
+
+		mfaToken := loginFirstFactor(t, e, "user@example.com", "password123")
+
+		// 誤ったコードや、登録の確認に使ったコードではログインできない
+		assert.Equal(t, http.StatusUnauthorized, loginMFA(e, mfaToken, "000000").Code)
+		usedCode, _ := utils.TOTPCode(secret, step)
+		assert.Equal(t, http.StatusUnauthorized, loginMFA(e, mfaToken, usedCode).Code)
+
+		code, _ := utils.TOTPCode(secret, step+1)
+		rec = loginMFA(e, mfaToken, code)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		newToken := cookieValue(rec, "token")
+		require.NotEmpty(t, newToken)
+		assert.NotEmpty(t, cookieValue(rec, "refresh_token"))
+		assert.Equal(t, http.StatusOK, serveWithToken(e, http.MethodGet, "/todo", newToken).Code)
+
+		// 同じコードは再利用できない
+		assert.Equal(t, http.StatusUnauthorized, loginMFA(e, mfaToken, code).Code)
+	})
+
+	t.Run("2 段階認証のトークンはログインに 1 回だけ使用できること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "")
+		secret, _, step := enableTOTP(t, e, token)
+
+		mfaToken := loginFirstFactor(t, e, "user@example.com", "password123")
+		code, _ := utils.TOTPCode(secret, step+1)
+		require.Equal(t, http.StatusOK, loginMFA(e, mfaToken, code).Code)
+
+		code, _ = utils.TOTPCode(secret, step+2)
+		assert.Equal(t, http.StatusUnauthorized, loginMFA(e, mfaToken, code).Code)
+	})
+
+	t.Run("2 段階認証のトークンはアクセストークンとして使えないこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "")
+		enableTOTP(t, e, token)
+
+		mfaToken := loginFirstFactor(t, e, "user@example.com", "password123")
+		assert.Equal(t, http.StatusUnauthorized, serveWithToken(e, http.MethodGet, "/todo", mfaToken).Code)
+		assert.Equal(t, http.StatusUnauthorized, loginMFA(e, token, "123456").Code)
+	})
+
+	t.Run("リカバリーコードは 1 回だけ使用できること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "")
+		_, recoveryCodes, _ := enableTOTP(t, e, token)
+
+		mfaToken := loginFirstFactor(t, e, "user@example.com", "password123")
+		rec := loginMFA(e, mfaToken, strings.ToUpper(recoveryCodes[0]))
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		assert.NotEmpty(t, cookieValue(rec, "token"))
+
+		assert.Equal(t, http.StatusUnauthorized, loginMFA(e, mfaToken, recoveryCodes[0]).Code)
+		assert.Equal(t, 9, testClient.RecoveryCode.Query().Where(recoverycode.UserID(userID), recoverycode.UsedAtIsNil()).CountX(context.Background()))
+	})
+
+	t.Run("リカバリーコードを再発行すると古いコードは使えなくなること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "")
+		secret, oldCodes, step := enableTOTP(t, e, token)
+
+		code, _ := utils.TOTPCode(secret, step+1)
+		rec := serveJSONWithToken(e, http.MethodPost, "/me/mfa/recovery_codes", `{"code":"`+code+`"}`, token)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var res dto.RecoveryCodesDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		assert.Len(t, res.RecoveryCodes, 10)
+
+		mfaToken := loginFirstFactor(t, e, "user@example.com", "password123")
+		assert.Equal(t, http.StatusUnauthorized, loginMFA(e, mfaToken, oldCodes[0]).Code)
+		assert.Equal(t, http.StatusOK, loginMFA(e, mfaToken, res.RecoveryCodes[0]).Code)
+	})
+
+	t.Run("パスワードを確認して 2 段階認証を無効にできること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "")
+		enableTOTP(t, e, token)
+
+		assert.Equal(t, http.StatusConflict, serveWithToken(e, http.MethodPost, "/me/mfa/totp", token).Code)
+
+		rec := serveJSONWithToken(e, http.MethodDelete, "/me/mfa/totp", `{"password":"wrong-password1"}`, token)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = serveJSONWithToken(e, http.MethodDelete, "/me/mfa/totp", `{"password":"password123"}`, token)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, 0, testClient.RecoveryCode.Query().CountX(context.Background()))
+
+		login(t, e, "user@example.com", "password123", "")
+	})
+
+	t.Run("パーソナルアクセストークンでは 2 段階認証を変更できないこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+		pat := createPersonalAccessToken(t, e, userID, `{"name":"cli","scopes":["read","write"]}`)
+
+		rec := serveWithBearer(e, http.MethodPost, "/me/mfa/totp", "", pat.Token)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
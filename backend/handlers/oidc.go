@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+
+	"todo-app/app_errors"
+	"todo-app/dto"
+	"todo-app/services"
+	"todo-app/utils"
+
+	"github.com/labstack/echo/v5"
+)
+
+// OIDC のログイン開始からコールバックまで、state などを保存する Cookie
+const (
+	oidcStateCookieName = "oidc_state"
+	oidcStateCookiePath = "/auth/oidc"
+)
+
+type OIDCHandler struct {
+	logger  *slog.Logger
+	service *services.OIDCService
+}
+
+func NewOIDCHandler(logger *slog.Logger, service *services.OIDCService) *OIDCHandler {
+	return &OIDCHandler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+func (h *OIDCHandler) ListProviders(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	return c.JSON(http.StatusOK, h.service.Providers())
+}
+
+// Login はプロバイダの認可エンドポイントへリダイレクトする
+func (h *OIDCHandler) Login(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	authURL, loginState, err := h.service.Begin(c.Request().Context(), c.Param("provider"))
+	if err != nil {
+		if errors.Is(err, utils.ErrOIDCProviderNotFound) {
+			return utils.HandleError(h.logger, c, err, http.StatusNotFound)
+		}
+		return utils.HandleError(h.logger, c, err, http.StatusBadGateway)
+	}
+
+	// プロバイダからのリダイレクト (クロスサイトのトップレベルの GET) でも送信されるよう、SameSite は Lax にする
+	c.SetCookie(&http.Cookie{
+		Name:     oidcStateCookieName,
+		Value:    loginState,
+		Path:     oidcStateCookiePath,
+		MaxAge:   int(services.OIDCLoginStateLifetime.Seconds()),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	return c.Redirect(http.StatusFound, authURL)
+}
+
+// Callback はプロバイダから戻ってきたリクエストを検証してログインし、フロントエンドへリダイレクトする。
+// ブラウザの画面遷移で呼ばれるため、失敗した場合も JSON ではなくフロントエンドのログイン画面へ error を付けてリダイレクトする
+func (h *OIDCHandler) Callback(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	// state の Cookie は成功・失敗にかかわらず 1 回で使い捨てる
+	input := &dto.OIDCCallbackInput{
+		Provider:  c.Param("provider"),
+		Code:      c.QueryParam("code"),
+		State:     c.QueryParam("state"),
+		IPAddress: c.RealIP(),
+		UserAgent: c.Request().UserAgent(),
+	}
+	if cookie, err := c.Cookie(oidcStateCookieName); err == nil {
+		input.LoginState = cookie.Value
+	}
+	c.SetCookie(&http.Cookie{Name: oidcStateCookieName, Path: oidcStateCookiePath, MaxAge: -1, HttpOnly: true, SameSite: http.SameSiteLaxMode})
+
+	if providerErr := c.QueryParam("error"); providerErr != "" {
+		h.logger.Warn("oidc provider returned an error", slog.String("provider", input.Provider), slog.String("error", providerErr))
+		return c.Redirect(http.StatusFound, oidcErrorRedirectURL("provider_error"))
+	}
+
+	res, err := h.service.Complete(c.Request().Context(), input)
+	if err != nil {
+		h.logger.Error("oidc login failed", slog.String("provider", input.Provider), slog.String("error", err.Error()))
+		code := "login_failed"
+		if errors.Is(err, app_errors.ErrOIDCInvalidState) {
+			code = "invalid_state"
+		}
+		if errors.Is(err, app_errors.ErrOIDCEmailNotVerified) {
+			code = "email_not_verified"
+		}
+		if errors.Is(err, app_errors.ErrOIDCAccountNotFound) {
+			code = "account_not_found"
+		}
+		if errors.Is(err, app_errors.ErrAccountDisabled) {
+			code = "account_disabled"
+		}
+		return c.Redirect(http.StatusFound, oidcErrorRedirectURL(code))
+	}
+	if res.MFARequired {
+		// ログイン画面で 2 段階認証のコードを入力させ、POST /auth/login/mfa でログインを完了させる。
+		// URL に含めると履歴やアクセスログ、Referer に残るため、トークンは Cookie で渡す
+		setMFATokenCookie(c, res.MFAToken)
+		return c.Redirect(http.StatusFound, os.Getenv("FRONTEND_ORIGIN")+"/login?mfa_required=1")
+	}
+	setAuthCookies(c, res.Tokens)
+
+	return c.Redirect(http.StatusFound, oidcLoginRedirectURL())
+}
+
+// oidcLoginRedirectURL はログイン後のリダイレクト先を返す。OIDC_LOGIN_REDIRECT_URL が未指定の場合は FRONTEND_ORIGIN のトップ
+func oidcLoginRedirectURL() string {
+	if u := os.Getenv("OIDC_LOGIN_REDIRECT_URL"); u != "" {
+		return u
+	}
+	return os.Getenv("FRONTEND_ORIGIN") + "/"
+}
+
+func oidcErrorRedirectURL(code string) string {
+	return os.Getenv("FRONTEND_ORIGIN") + "/login?error=" + url.QueryEscape(code)
+}
@@ -0,0 +1,262 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+	"todo-app/dto"
+	"todo-app/ent/user"
+	"todo-app/ent/useridentity"
+	"todo-app/testutils"
+	"todo-app/utils"
+
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupOIDCTestApp はモックのプロバイダを corp として設定したアプリを起動する
+func setupOIDCTestApp(t *testing.T) (*echo.Echo, *testutils.MockOIDCProvider) {
+	provider := testutils.NewMockOIDCProvider("todo-app", "client-secret")
+	t.Cleanup(provider.Close)
+
+	t.Setenv("OIDC_PROVIDERS", "corp")
+	t.Setenv("OIDC_CORP_ISSUER", provider.Issuer())
+	t.Setenv("OIDC_CORP_CLIENT_ID", "todo-app")
+	t.Setenv("OIDC_CORP_CLIENT_SECRET", "client-secret")
+	t.Setenv("OIDC_CORP_DISPLAY_NAME", "Corp SSO")
+	t.Setenv("OIDC_CALLBACK_BASE_URL", "http://api.example.com")
+	t.Setenv("FRONTEND_ORIGIN", "http://app.example.com")
+	return setupAuthTestApp(t), provider
+}
+
+// startOIDCLogin は /auth/oidc/:provider/login を呼び出し、認可エンドポイントの URL と state の Cookie を返す
+func startOIDCLogin(t *testing.T, e *echo.Echo) (string, *http.Cookie) {
+	req := httptest.NewRequest(http.MethodGet, "/auth/oidc/corp/login", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusFound, rec.Code, rec.Body.String())
+
+	for _, cookie := range rec.Result().Cookies() {
+		if cookie.Name == "oidc_state" {
+			return rec.Header().Get("Location"), cookie
+		}
+	}
+	t.Fatal("oidc_state cookie is not set")
+	return "", nil
+}
+
+// authorizeAtProvider はプロバイダの認可エンドポイントを開き、リダイレクト先のコールバックの URL を返す
+func authorizeAtProvider(t *testing.T, authURL string) *url.URL {
+	client := &http.Client{CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	resp, err := client.Get(authURL)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+
+	callback, err := url.Parse(resp.Header.Get("Location"))
+	require.NoError(t, err)
+	return callback
+}
+
+func oidcCallback(e *echo.Echo, callback *url.URL, stateCookie *http.Cookie) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, callback.RequestURI(), nil)
+	if stateCookie != nil {
+		req.AddCookie(stateCookie)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+// loginWithOIDC はログインの開始からコールバックまでを行い、コールバックのレスポンスを返す
+func loginWithOIDC(t *testing.T, e *echo.Echo) *httptest.ResponseRecorder {
+	authURL, stateCookie := startOIDCLogin(t, e)
+	return oidcCallback(e, authorizeAtProvider(t, authURL), stateCookie)
+}
+
+// verifyEmail はユーザーのメールアドレスを確認済みにする
+func verifyEmail(t *testing.T, userID int) {
+	testClient.User.UpdateOneID(userID).SetEmailVerifiedAt(time.Now()).ExecX(context.Background())
+}
+
+func TestOIDCHandler_Integration(t *testing.T) {
+	t.Run("確認済みのメールアドレスで既存のユーザーに紐付けてログインできること", func(t *testing.T) {
+		e, provider := setupOIDCTestApp(t)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+		verifyEmail(t, userID)
+		sessionToken := login(t, e, "user@example.com", "password123", "")
+		provider.SetUser(testutils.MockOIDCUser{Subject: "sub-1", Email: "User@Example.com", EmailVerified: true, Name: "User"})
+
+		rec := loginWithOIDC(t, e)
+		assert.Equal(t, http.StatusFound, rec.Code)
+		assert.Equal(t, "http://app.example.com/", rec.Header().Get("Location"))
+
+		token := cookieValue(rec, "token")
+		require.NotEmpty(t, token)
+		assert.NotEmpty(t, cookieValue(rec, "refresh_token"))
+		assert.Equal(t, http.StatusOK, serveWithToken(e, http.MethodGet, "/todo", token).Code)
+
+		identity := testClient.UserIdentity.Query().Where(useridentity.Provider("corp"), useridentity.Subject("sub-1")).OnlyX(context.Background())
+		assert.Equal(t, userID, identity.UserID)
+
+		// メールアドレスを確認済みのユーザーは、パスワードやログイン中のセッションもそのまま使える
+		assert.Equal(t, http.StatusOK, serveWithToken(e, http.MethodGet, "/todo", sessionToken).Code)
+		assert.Equal(t, http.StatusOK, tryLogin(e, "user@example.com", "password123"))
+	})
+
+	t.Run("メールアドレスが未確認のユーザーに紐付ける場合は、パスワード・セッション・アクセストークンを無効にすること", func(t *testing.T) {
+		e, provider := setupOIDCTestApp(t)
+		// 第三者が他人のメールアドレスで先に登録したアカウント
+		userID := createUserWithPassword(t, "victim@example.com", "password123")
+		attackerToken := login(t, e, "victim@example.com", "password123", "")
+		pat := createPersonalAccessToken(t, e, userID, `{"name":"cli","scopes":["read"]}`)
+		provider.SetUser(testutils.MockOIDCUser{Subject: "sub-1", Email: "victim@example.com", EmailVerified: true})
+
+		rec := loginWithOIDC(t, e)
+		assert.Equal(t, "http://app.example.com/", rec.Header().Get("Location"))
+		token := cookieValue(rec, "token")
+		require.NotEmpty(t, token)
+		assert.Equal(t, http.StatusOK, serveWithToken(e, http.MethodGet, "/todo", token).Code)
+
+		assert.Equal(t, http.StatusUnauthorized, serveWithToken(e, http.MethodGet, "/todo", attackerToken).Code)
+		assert.Equal(t, http.StatusUnauthorized, serveWithBearer(e, http.MethodGet, "/todo", "", pat.Token).Code)
+		assert.Equal(t, http.StatusUnauthorized, tryLogin(e, "victim@example.com", "password123"))
+		u := testClient.User.GetX(context.Background(), userID)
+		assert.NotNil(t, u.EmailVerifiedAt)
+		assert.Equal(t, userID, testClient.UserIdentity.Query().OnlyX(context.Background()).UserID)
+	})
+
+	t.Run("紐付け後はプロバイダ側でメールアドレスが変わっても同じユーザーでログインすること", func(t *testing.T) {
+		e, provider := setupOIDCTestApp(t)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+		provider.SetUser(testutils.MockOIDCUser{Subject: "sub-1", Email: "user@example.com", EmailVerified: true})
+		require.Equal(t, http.StatusFound, loginWithOIDC(t, e).Code)
+
+		provider.SetUser(testutils.MockOIDCUser{Subject: "sub-1", Email: "renamed@example.com", EmailVerified: false})
+		rec := loginWithOIDC(t, e)
+		assert.Equal(t, "http://app.example.com/", rec.Header().Get("Location"))
+		assert.Equal(t, 1, testClient.User.Query().CountX(context.Background()))
+		assert.Equal(t, userID, testClient.UserIdentity.Query().OnlyX(context.Background()).UserID)
+	})
+
+	t.Run("2 段階認証を有効にしている場合は、コードの入力画面へリダイレクトすること", func(t *testing.T) {
+		e, provider := setupOIDCTestApp(t)
+		verifyEmail(t, createUserWithPassword(t, "user@example.com", "password123"))
+		secret, _, step := enableTOTP(t, e, login(t, e, "user@example.com", "password123", ""))
+		provider.SetUser(testutils.MockOIDCUser{Subject: "sub-1", Email: "user@example.com", EmailVerified: true})
+
+		rec := loginWithOIDC(t, e)
+		assert.Empty(t, cookieValue(rec, "token"))
+		location, err := url.Parse(rec.Header().Get("Location"))
+		require.NoError(t, err)
+		assert.Equal(t, "/login", location.Path)
+		assert.Equal(t, "1", location.Query().Get("mfa_required"))
+		// トークンは URL に含めず、POST /auth/login/mfa だけに送信される HttpOnly の Cookie で渡す
+		assert.NotContains(t, location.RawQuery, "mfa_token")
+		var mfaCookie *http.Cookie
+		for _, cookie := range rec.Result().Cookies() {
+			if cookie.Name == "mfa_token" {
+				mfaCookie = cookie
+			}
+		}
+		require.NotNil(t, mfaCookie)
+		assert.Equal(t, "/auth/login/mfa", mfaCookie.Path)
+		assert.True(t, mfaCookie.HttpOnly)
+
+		code, _ := utils.TOTPCode(secret, step+1)
+		rec = loginMFAWithCookie(e, mfaCookie.Value, code)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		assert.NotEmpty(t, cookieValue(rec, "token"))
+		assert.Empty(t, cookieValue(rec, "mfa_token"))
+
+		// ログインに使ったトークンは、別のコードでも再利用できない
+		code, _ = utils.TOTPCode(secret, step+2)
+		assert.Equal(t, http.StatusUnauthorized, loginMFAWithCookie(e, mfaCookie.Value, code).Code)
+	})
+
+	t.Run("未確認のメールアドレスでは既存のユーザーに紐付けないこと", func(t *testing.T) {
+		e, provider := setupOIDCTestApp(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+		provider.SetUser(testutils.MockOIDCUser{Subject: "attacker", Email: "user@example.com", EmailVerified: false})
+
+		rec := loginWithOIDC(t, e)
+		assert.Equal(t, http.StatusFound, rec.Code)
+		assert.Equal(t, "http://app.example.com/login?error=email_not_verified", rec.Header().Get("Location"))
+		assert.Empty(t, cookieValue(rec, "token"))
+		assert.Equal(t, 0, testClient.UserIdentity.Query().CountX(context.Background()))
+	})
+
+	t.Run("該当するユーザーがいない場合、登録を受け付けていればユーザーを作成すること", func(t *testing.T) {
+		e, provider := setupOIDCTestApp(t)
+		t.Setenv("REGISTRATION_MODE", "open")
+		provider.SetUser(testutils.MockOIDCUser{Subject: "sub-new", Email: "new@example.com", EmailVerified: true, Name: "New User"})
+
+		rec := loginWithOIDC(t, e)
+		assert.Equal(t, "http://app.example.com/", rec.Header().Get("Location"))
+		u := testClient.User.Query().Where(user.Email("new@example.com")).OnlyX(context.Background())
+		assert.Equal(t, "New User", u.Name)
+	})
+
+	t.Run("該当するユーザーがいない場合、登録を受け付けていなければログインできないこと", func(t *testing.T) {
+		e, provider := setupOIDCTestApp(t)
+		t.Setenv("REGISTRATION_MODE", "closed")
+		provider.SetUser(testutils.MockOIDCUser{Subject: "sub-new", Email: "new@example.com", EmailVerified: true})
+
+		rec := loginWithOIDC(t, e)
+		assert.Equal(t, "http://app.example.com/login?error=account_not_found", rec.Header().Get("Location"))
+		assert.Equal(t, 0, testClient.User.Query().CountX(context.Background()))
+	})
+
+	t.Run("state が一致しない場合はログインできないこと", func(t *testing.T) {
+		e, provider := setupOIDCTestApp(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+		provider.SetUser(testutils.MockOIDCUser{Subject: "sub-1", Email: "user@example.com", EmailVerified: true})
+
+		// 別のブラウザで開始したログインのコールバックを、攻撃者の state の Cookie で受け取らせる
+		authURL, _ := startOIDCLogin(t, e)
+		_, otherCookie := startOIDCLogin(t, e)
+		rec := oidcCallback(e, authorizeAtProvider(t, authURL), otherCookie)
+		assert.Equal(t, "http://app.example.com/login?error=invalid_state", rec.Header().Get("Location"))
+
+		// Cookie が無い場合
+		authURL, _ = startOIDCLogin(t, e)
+		rec = oidcCallback(e, authorizeAtProvider(t, authURL), nil)
+		assert.Equal(t, "http://app.example.com/login?error=invalid_state", rec.Header().Get("Location"))
+		assert.Empty(t, cookieValue(rec, "token"))
+	})
+
+	t.Run("認可コードは 1 回だけ使用できること", func(t *testing.T) {
+		e, provider := setupOIDCTestApp(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+		provider.SetUser(testutils.MockOIDCUser{Subject: "sub-1", Email: "user@example.com", EmailVerified: true})
+
+		authURL, stateCookie := startOIDCLogin(t, e)
+		callback := authorizeAtProvider(t, authURL)
+		assert.Equal(t, "http://app.example.com/", oidcCallback(e, callback, stateCookie).Header().Get("Location"))
+		assert.Equal(t, "http://app.example.com/login?error=login_failed", oidcCallback(e, callback, stateCookie).Header().Get("Location"))
+	})
+
+	t.Run("プロバイダの一覧を返し、未設定のプロバイダは 404 を返すこと", func(t *testing.T) {
+		e, _ := setupOIDCTestApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/auth/oidc/providers", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var res []dto.OIDCProviderDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		assert.Equal(t, []dto.OIDCProviderDto{{Name: "corp", DisplayName: "Corp SSO", LoginURL: "/auth/oidc/corp/login"}}, res)
+
+		req = httptest.NewRequest(http.MethodGet, "/auth/oidc/unknown/login", nil)
+		rec = httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
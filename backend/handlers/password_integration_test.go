@@ -0,0 +1,196 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func postJSON(e *echo.Echo, target string, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func changePassword(e *echo.Echo, token string, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPut, "/me/password", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.AddCookie(&http.Cookie{Name: "token", Value: token})
+	csrf := avoidCSRF{}
+	csrf.SetCSRFCookie(req)
+	csrf.SetCSRFHeader(req)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func tryLogin(e *echo.Echo, email string, password string) int {
+	return postJSON(e, "/auth/login", `{"email":"`+email+`","password":"`+password+`"}`).Code
+}
+
+var resetTokenPattern = regexp.MustCompile(`\?token=(\S+)`)
+
+// readResetTokens は MAIL_DIR に保存されたメールから、再設定用のトークンを送信順に取り出す
+func readResetTokens(t *testing.T, dir string) []string {
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	require.NoError(t, err)
+
+	var tokens []string
+	for _, f := range files {
+		b, err := os.ReadFile(f)
+		require.NoError(t, err)
+		m := resetTokenPattern.FindSubmatch(b)
+		require.NotNil(t, m, "reset link not found in %s", f)
+		token, err := url.QueryUnescape(string(m[1]))
+		require.NoError(t, err)
+		tokens = append(tokens, token)
+	}
+	return tokens
+}
+
+// waitResetTokens は非同期で送信される再設定用のメールが n 件保存されるまで待ってから、トークンを送信順に返す
+func waitResetTokens(t *testing.T, dir string, n int) []string {
+	require.Eventually(t, func() bool {
+		files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+		return err == nil && len(files) >= n
+	}, 2*time.Second, 10*time.Millisecond)
+	return readResetTokens(t, dir)
+}
+
+func TestMeHandler_ChangePassword_Integration(t *testing.T) {
+	t.Run("パスワードを変更すると、他の端末のセッションだけが失効すること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+		current := login(t, e, "user@example.com", "password123", "current")
+		other := login(t, e, "user@example.com", "password123", "other")
+
+		rec := changePassword(e, current, `{"current_password":"password123","new_password":"newpassword456"}`)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+
+		assert.Equal(t, http.StatusOK, serveWithToken(e, http.MethodGet, "/auth/sessions", current).Code)
+		assert.Equal(t, http.StatusUnauthorized, serveWithToken(e, http.MethodGet, "/auth/sessions", other).Code)
+		assert.Equal(t, http.StatusUnauthorized, tryLogin(e, "user@example.com", "password123"))
+		assert.Equal(t, http.StatusOK, tryLogin(e, "user@example.com", "newpassword456"))
+	})
+
+	t.Run("現在のパスワードが間違っている場合は 400 を返し、変更しないこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "")
+
+		rec := changePassword(e, token, `{"current_password":"wrong","new_password":"newpassword456"}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "current password is incorrect")
+		assert.Equal(t, http.StatusOK, tryLogin(e, "user@example.com", "password123"))
+	})
+
+	t.Run("新しいパスワードがポリシーを満たさない場合は 400 を返すこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "")
+
+		rec := changePassword(e, token, `{"current_password":"password123","new_password":"short"}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "new_password")
+	})
+
+	t.Run("未ログインの場合は 401 を返すこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+
+		rec := changePassword(e, "", `{"current_password":"password123","new_password":"newpassword456"}`)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
+
+func TestAuthHandler_PasswordReset_Integration(t *testing.T) {
+	// LogMailer が保存したメールからリンクを取り出す
+	setup := func(t *testing.T) (*echo.Echo, string) {
+		dir := t.TempDir()
+		t.Setenv("MAIL_DRIVER", "log")
+		t.Setenv("MAIL_DIR", dir)
+		t.Setenv("PASSWORD_RESET_URL", "http://localhost:3000/reset-password")
+		return setupAuthTestApp(t), dir
+	}
+
+	t.Run("メールのトークンでパスワードを再設定でき、全てのセッションとアクセストークンが失効すること", func(t *testing.T) {
+		e, dir := setup(t)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "")
+		pat := createPersonalAccessToken(t, e, userID, `{"name":"cli","scopes":["read"]}`)
+
+		rec := postJSON(e, "/auth/password/forgot", `{"email":"user@example.com"}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		tokens := waitResetTokens(t, dir, 1)
+		require.Len(t, tokens, 1)
+
+		rec = postJSON(e, "/auth/password/reset", `{"token":"`+tokens[0]+`","new_password":"newpassword456"}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		assert.Equal(t, http.StatusUnauthorized, serveWithToken(e, http.MethodGet, "/auth/sessions", token).Code)
+		assert.Equal(t, http.StatusUnauthorized, serveWithBearer(e, http.MethodGet, "/todo", "", pat.Token).Code)
+		assert.Equal(t, http.StatusUnauthorized, tryLogin(e, "user@example.com", "password123"))
+		assert.Equal(t, http.StatusOK, tryLogin(e, "user@example.com", "newpassword456"))
+	})
+
+	t.Run("トークンは 1 回だけ使用できること", func(t *testing.T) {
+		e, dir := setup(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+
+		postJSON(e, "/auth/password/forgot", `{"email":"user@example.com"}`)
+		tokens := waitResetTokens(t, dir, 1)
+		require.Len(t, tokens, 1)
+
+		rec := postJSON(e, "/auth/password/reset", `{"token":"`+tokens[0]+`","new_password":"newpassword456"}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		rec = postJSON(e, "/auth/password/reset", `{"token":"`+tokens[0]+`","new_password":"another789"}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, http.StatusOK, tryLogin(e, "user@example.com", "newpassword456"))
+	})
+
+	t.Run("再度リクエストすると、古いトークンは使用できなくなること", func(t *testing.T) {
+		e, dir := setup(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+
+		postJSON(e, "/auth/password/forgot", `{"email":"user@example.com"}`)
+		waitResetTokens(t, dir, 1)
+		postJSON(e, "/auth/password/forgot", `{"email":"user@example.com"}`)
+		tokens := waitResetTokens(t, dir, 2)
+		require.Len(t, tokens, 2)
+
+		rec := postJSON(e, "/auth/password/reset", `{"token":"`+tokens[0]+`","new_password":"newpassword456"}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		rec = postJSON(e, "/auth/password/reset", `{"token":"`+tokens[1]+`","new_password":"newpassword456"}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("未登録のメールアドレスでも同じレスポンスを返し、メールは送信しないこと", func(t *testing.T) {
+		e, dir := setup(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+
+		registered := postJSON(e, "/auth/password/forgot", `{"email":"user@example.com"}`)
+		unknown := postJSON(e, "/auth/password/forgot", `{"email":"unknown@example.com"}`)
+		assert.Equal(t, http.StatusOK, unknown.Code)
+		assert.Equal(t, registered.Body.String(), unknown.Body.String())
+		assert.Len(t, waitResetTokens(t, dir, 1), 1)
+	})
+
+	t.Run("不正なトークンの場合は 400 を返すこと", func(t *testing.T) {
+		e, _ := setup(t)
+
+		rec := postJSON(e, "/auth/password/reset", `{"token":"invalid","new_password":"newpassword456"}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "invalid or expired password reset token")
+	})
+}
@@ -0,0 +1,148 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"todo-app/dto"
+	"todo-app/ent/personalaccesstoken"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createPersonalAccessToken は Cookie のセッションで /me/tokens を呼び出し、発行されたトークンを返す
+func createPersonalAccessToken(t *testing.T, e *echo.Echo, userID int, body string) dto.CreatedPersonalAccessTokenDto {
+	req, rec := createAuthenticatedRequest(t, http.MethodPost, "/me/tokens", body, userID)
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+	var res dto.CreatedPersonalAccessTokenDto
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	return res
+}
+
+// serveWithBearer は Cookie や CSRF トークンを付けず、Authorization ヘッダーだけでリクエストする
+func serveWithBearer(e *echo.Echo, method string, target string, body string, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	}
+	req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMeHandler_PersonalAccessTokens_Integration(t *testing.T) {
+	t.Run("発行したトークンで CSRF トークン無しに API を呼び出せること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+
+		pat := createPersonalAccessToken(t, e, userID, `{"name":"script","scopes":["read","write"]}`)
+		assert.True(t, strings.HasPrefix(pat.Token, "todo_pat_"))
+		assert.True(t, strings.HasPrefix(pat.Token, pat.TokenPrefix))
+		assert.Nil(t, pat.ExpiresAt)
+
+		rec := serveWithBearer(e, http.MethodPost, "/todo", `{"title":"from script","description":"created with a token"}`, pat.Token)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		rec = serveWithBearer(e, http.MethodGet, "/todo", "", pat.Token)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), "from script")
+
+		// トークン自体は保存せず、最終使用日時を記録していること
+		stored := testClient.PersonalAccessToken.GetX(context.Background(), uuid.MustParse(pat.ID))
+		assert.NotEqual(t, pat.Token, stored.TokenHash)
+		assert.NotNil(t, stored.LastUsedAt)
+	})
+
+	t.Run("一覧にはトークン自体を含めないこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+		otherID := createUserWithPassword(t, "other@example.com", "password123")
+		pat := createPersonalAccessToken(t, e, userID, `{"name":"script","scopes":["read"],"expires_in_days":30}`)
+		createPersonalAccessToken(t, e, otherID, `{"name":"other","scopes":["read"]}`)
+
+		req, rec := createAuthenticatedRequest(t, http.MethodGet, "/me/tokens", "", userID)
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.NotContains(t, rec.Body.String(), pat.Token)
+
+		var res []dto.PersonalAccessTokenDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Len(t, res, 1)
+		assert.Equal(t, "script", res[0].Name)
+		assert.Equal(t, []string{"read"}, res[0].Scopes)
+		require.NotNil(t, res[0].ExpiresAt)
+		assert.WithinDuration(t, time.Now().Add(30*24*time.Hour), *res[0].ExpiresAt, time.Minute)
+	})
+
+	t.Run("スコープに無い操作は 403 を返すこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+		readOnly := createPersonalAccessToken(t, e, userID, `{"name":"read","scopes":["read"]}`)
+		writeOnly := createPersonalAccessToken(t, e, userID, `{"name":"write","scopes":["write"]}`)
+
+		assert.Equal(t, http.StatusForbidden, serveWithBearer(e, http.MethodPost, "/todo", `{"title":"t"}`, readOnly.Token).Code)
+		assert.Equal(t, http.StatusForbidden, serveWithBearer(e, http.MethodGet, "/todo", "", writeOnly.Token).Code)
+		// AI を呼び出すエンドポイントには ai のスコープも必要
+		assert.Equal(t, http.StatusForbidden, serveWithBearer(e, http.MethodGet, "/todo/ai_filter?query=test", "", readOnly.Token).Code)
+	})
+
+	t.Run("トークンの管理やセッションの操作にはトークンを使えないこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+		pat := createPersonalAccessToken(t, e, userID, `{"name":"all","scopes":["read","write","ai"]}`)
+
+		assert.Equal(t, http.StatusForbidden, serveWithBearer(e, http.MethodGet, "/me/tokens", "", pat.Token).Code)
+		assert.Equal(t, http.StatusForbidden, serveWithBearer(e, http.MethodPost, "/me/tokens", `{"name":"x","scopes":["read"]}`, pat.Token).Code)
+		assert.Equal(t, http.StatusForbidden, serveWithBearer(e, http.MethodGet, "/auth/sessions", "", pat.Token).Code)
+		assert.Equal(t, http.StatusForbidden, serveWithBearer(e, http.MethodPut, "/me/password", `{"current_password":"password123","new_password":"newpassword456"}`, pat.Token).Code)
+	})
+
+	t.Run("失効させたトークンと期限切れのトークンは 401 を返すこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+		revoked := createPersonalAccessToken(t, e, userID, `{"name":"revoked","scopes":["read"]}`)
+		expired := createPersonalAccessToken(t, e, userID, `{"name":"expired","scopes":["read"],"expires_in_days":1}`)
+		testClient.PersonalAccessToken.Update().
+			Where(personalaccesstoken.ID(uuid.MustParse(expired.ID))).
+			SetExpiresAt(time.Now().Add(-time.Minute)).
+			ExecX(context.Background())
+
+		req, rec := createAuthenticatedRequest(t, http.MethodDelete, "/me/tokens/"+revoked.ID, "", userID)
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+
+		assert.Equal(t, http.StatusUnauthorized, serveWithBearer(e, http.MethodGet, "/todo", "", revoked.Token).Code)
+		assert.Equal(t, http.StatusUnauthorized, serveWithBearer(e, http.MethodGet, "/todo", "", expired.Token).Code)
+		assert.Equal(t, http.StatusUnauthorized, serveWithBearer(e, http.MethodGet, "/todo", "", "todo_pat_unknown").Code)
+	})
+
+	t.Run("他のユーザーのトークンは失効できないこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+		otherID := createUserWithPassword(t, "other@example.com", "password123")
+		pat := createPersonalAccessToken(t, e, otherID, `{"name":"other","scopes":["read"]}`)
+
+		req, rec := createAuthenticatedRequest(t, http.MethodDelete, "/me/tokens/"+pat.ID, "", userID)
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Equal(t, http.StatusOK, serveWithBearer(e, http.MethodGet, "/todo", "", pat.Token).Code)
+	})
+
+	t.Run("不正なスコープの場合は 400 を返すこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+
+		req, rec := createAuthenticatedRequest(t, http.MethodPost, "/me/tokens", `{"name":"script","scopes":["admin"]}`, userID)
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
@@ -0,0 +1,296 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+	"todo-app/dto"
+
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getMe(t *testing.T, rec int, body []byte) dto.MeDto {
+	require.Equal(t, http.StatusOK, rec, string(body))
+	var me dto.MeDto
+	require.NoError(t, json.Unmarshal(body, &me))
+	return me
+}
+
+func TestMeHandler_Profile_Integration(t *testing.T) {
+	t.Run("ログイン中のユーザーのプロフィールを取得・変更できること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "")
+
+		rec := serveWithToken(e, http.MethodGet, "/me", token)
+		me := getMe(t, rec.Code, rec.Body.Bytes())
+		assert.Equal(t, userID, me.ID)
+		assert.Equal(t, "user@example.com", me.Email)
+		assert.Equal(t, "ja", me.Locale)
+		assert.Equal(t, "Asia/Tokyo", me.TimeZone)
+		assert.Equal(t, dto.UserPreferencesDto{TodoPageSize: 20, TodoIncludeDone: false}, me.Preferences)
+		assert.Nil(t, me.PendingEmail)
+
+		rec = serveJSONWithToken(e, http.MethodPatch, "/me", `{"name":"renamed","locale":"en","time_zone":"America/New_York","preferences":{"todo_page_size":50,"todo_include_done":true}}`, token)
+		me = getMe(t, rec.Code, rec.Body.Bytes())
+		assert.Equal(t, "renamed", me.Name)
+		assert.Equal(t, "en", me.Locale)
+		assert.Equal(t, "America/New_York", me.TimeZone)
+		assert.Equal(t, dto.UserPreferencesDto{TodoPageSize: 50, TodoIncludeDone: true}, me.Preferences)
+
+		// 指定しなかった項目は変更しないこと
+		rec = serveJSONWithToken(e, http.MethodPatch, "/me", `{"preferences":{"todo_include_done":false}}`, token)
+		me = getMe(t, rec.Code, rec.Body.Bytes())
+		assert.Equal(t, "renamed", me.Name)
+		assert.Equal(t, dto.UserPreferencesDto{TodoPageSize: 50, TodoIncludeDone: false}, me.Preferences)
+	})
+
+	t.Run("不正な値は 400 を返すこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "")
+
+		for _, body := range []string{
+			`{"name":""}`,
+			`{"locale":"fr"}`,
+			`{"time_zone":"Mars/Olympus"}`,
+			`{"email":"not-an-email"}`,
+			`{"preferences":{"todo_page_size":0}}`,
+			`{"preferences":{"todo_page_size":101}}`,
+		} {
+			rec := serveJSONWithToken(e, http.MethodPatch, "/me", body, token)
+			assert.Equal(t, http.StatusBadRequest, rec.Code, body)
+		}
+	})
+
+	t.Run("パーソナルアクセストークンでは参照だけできること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+		pat := createPersonalAccessToken(t, e, userID, `{"name":"cli","scopes":["read","write"]}`)
+
+		rec := serveWithBearer(e, http.MethodGet, "/me", "", pat.Token)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		rec = serveWithBearer(e, http.MethodPatch, "/me", `{"email":"attacker@example.com"}`, pat.Token)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("Todo の一覧で設定を既定値として使うこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "")
+		for i := 0; i < 4; i++ {
+			q := testClient.Todo.Create().SetTitle("todo " + strconv.Itoa(i)).SetDescription("desc").SetUserID(userID).SetWorkspaceID(personalWorkspaceID(t, userID))
+			if i == 0 {
+				q.SetDoneAt(time.Now())
+			}
+			q.SaveX(context.Background())
+		}
+
+		listTodo := func(target string) dto.ListTodoResponseDto {
+			rec := serveWithToken(e, http.MethodGet, target, token)
+			require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+			var res dto.ListTodoResponseDto
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+			return res
+		}
+
+		res := listTodo("/todo")
+		assert.Len(t, res.Data, 3)
+		assert.Equal(t, 20, res.Pagination.Limit)
+
+		rec := serveJSONWithToken(e, http.MethodPatch, "/me", `{"preferences":{"todo_page_size":2,"todo_include_done":true}}`, token)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		res = listTodo("/todo")
+		assert.Len(t, res.Data, 2)
+		assert.Equal(t, 2, res.Pagination.Limit)
+		assert.Equal(t, 2, res.Pagination.TotalPages)
+
+		// クエリで指定した場合はそちらを優先すること
+		res = listTodo("/todo?limit=10&include_done=false")
+		assert.Len(t, res.Data, 3)
+		assert.Equal(t, 10, res.Pagination.Limit)
+	})
+}
+
+func TestMeHandler_ChangeEmail_Integration(t *testing.T) {
+	// LogMailer が保存したメールからリンクを取り出す
+	setup := func(t *testing.T) (*echo.Echo, string) {
+		dir := t.TempDir()
+		t.Setenv("MAIL_DRIVER", "log")
+		t.Setenv("MAIL_DIR", dir)
+		t.Setenv("EMAIL_CHANGE_URL", "http://localhost:3000/confirm-email")
+		t.Setenv("EMAIL_CHANGE_CANCEL_URL", "http://localhost:3000/cancel-email-change")
+		t.Setenv("EMAIL_CHANGE_AUTHORIZE_URL", "http://localhost:3000/authorize-email-change")
+		return setupAuthTestApp(t), dir
+	}
+
+	t.Run("新しいメールアドレスで確認した時点でメールアドレスが変更されること", func(t *testing.T) {
+		e, dir := setup(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "")
+
+		rec := serveJSONWithToken(e, http.MethodPatch, "/me", `{"email":"new@example.com","current_password":"password123"}`, token)
+		me := getMe(t, rec.Code, rec.Body.Bytes())
+		assert.Equal(t, "user@example.com", me.Email)
+		require.NotNil(t, me.PendingEmail)
+		assert.Equal(t, "new@example.com", *me.PendingEmail)
+
+		// 変更前のメールアドレスへの通知と、新しいメールアドレスへの確認を送信する
+		tokens := readResetTokens(t, dir)
+		require.Len(t, tokens, 2)
+		// 確認前は元のメールアドレスでログインする
+		assert.Equal(t, http.StatusUnauthorized, tryLogin(e, "new@example.com", "password123"))
+
+		rec = postJSON(e, "/auth/email/confirm", `{"token":"`+tokens[1]+`"}`)
+		assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		rec = serveWithToken(e, http.MethodGet, "/me", token)
+		me = getMe(t, rec.Code, rec.Body.Bytes())
+		assert.Equal(t, "new@example.com", me.Email)
+		assert.Nil(t, me.PendingEmail)
+		assert.Equal(t, http.StatusOK, tryLogin(e, "new@example.com", "password123"))
+		assert.Equal(t, http.StatusUnauthorized, tryLogin(e, "user@example.com", "password123"))
+
+		// トークンは 1 回だけ使用できること
+		rec = postJSON(e, "/auth/email/confirm", `{"token":"`+tokens[1]+`"}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("登録済みのメールアドレスには変更できないこと", func(t *testing.T) {
+		e, dir := setup(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+		createUserWithPassword(t, "other@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "")
+
+		rec := serveJSONWithToken(e, http.MethodPatch, "/me", `{"name":"renamed","email":"other@example.com","current_password":"password123"}`, token)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		assert.Empty(t, readResetTokens(t, dir))
+
+		rec = serveWithToken(e, http.MethodGet, "/me", token)
+		assert.Equal(t, "test", getMe(t, rec.Code, rec.Body.Bytes()).Name)
+	})
+
+	t.Run("確認までの間に他のユーザーが登録した場合は変更しないこと", func(t *testing.T) {
+		e, dir := setup(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "")
+
+		rec := serveJSONWithToken(e, http.MethodPatch, "/me", `{"email":"new@example.com","current_password":"password123"}`, token)
+		require.Equal(t, http.StatusOK, rec.Code)
+		createUserWithPassword(t, "new@example.com", "password123")
+
+		rec = postJSON(e, "/auth/email/confirm", `{"token":"`+readResetTokens(t, dir)[1]+`"}`)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+	t.Run("メールアドレスの変更には現在のパスワードが必要なこと", func(t *testing.T) {
+		e, dir := setup(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "")
+
+		for _, body := range []string{
+			`{"name":"renamed","email":"new@example.com"}`,
+			`{"name":"renamed","email":"new@example.com","current_password":"wrong-password"}`,
+		} {
+			rec := serveJSONWithToken(e, http.MethodPatch, "/me", body, token)
+			assert.Equal(t, http.StatusBadRequest, rec.Code, body)
+		}
+		assert.Empty(t, readResetTokens(t, dir))
+
+		rec := serveWithToken(e, http.MethodGet, "/me", token)
+		me := getMe(t, rec.Code, rec.Body.Bytes())
+		assert.Equal(t, "test", me.Name)
+		assert.Nil(t, me.PendingEmail)
+	})
+
+	t.Run("変更前のメールアドレスのリンクから、確認前の変更を取り消せること", func(t *testing.T) {
+		e, dir := setup(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "")
+
+		rec := serveJSONWithToken(e, http.MethodPatch, "/me", `{"email":"new@example.com","current_password":"password123"}`, token)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		tokens := readResetTokens(t, dir)
+		require.Len(t, tokens, 2)
+
+		rec = postJSON(e, "/auth/email/cancel", `{"token":"`+tokens[0]+`"}`)
+		assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		// 確認のリンクは使えなくなり、全ての端末からログアウトさせる
+		assert.Equal(t, http.StatusBadRequest, postJSON(e, "/auth/email/confirm", `{"token":"`+tokens[1]+`"}`).Code)
+		assert.Equal(t, http.StatusUnauthorized, serveWithToken(e, http.MethodGet, "/me", token).Code)
+		assert.Equal(t, http.StatusOK, tryLogin(e, "user@example.com", "password123"))
+
+		// 取り消しのリンクは 1 回だけ使用できること
+		assert.Equal(t, http.StatusBadRequest, postJSON(e, "/auth/email/cancel", `{"token":"`+tokens[0]+`"}`).Code)
+	})
+
+	t.Run("確認済みの変更を取り消すと、変更前のメールアドレスに戻ること", func(t *testing.T) {
+		e, dir := setup(t)
+		userID := createUserWithPassword(t, "user@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "")
+		pat := createPersonalAccessToken(t, e, userID, `{"name":"cli","scopes":["read"]}`)
+
+		rec := serveJSONWithToken(e, http.MethodPatch, "/me", `{"email":"new@example.com","current_password":"password123"}`, token)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		tokens := readResetTokens(t, dir)
+		require.Len(t, tokens, 2)
+		require.Equal(t, http.StatusOK, postJSON(e, "/auth/email/confirm", `{"token":"`+tokens[1]+`"}`).Code)
+
+		rec = postJSON(e, "/auth/email/cancel", `{"token":"`+tokens[0]+`"}`)
+		assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		assert.Equal(t, "user@example.com", testClient.User.GetX(context.Background(), userID).Email)
+		assert.Equal(t, http.StatusOK, tryLogin(e, "user@example.com", "password123"))
+		assert.Equal(t, http.StatusUnauthorized, tryLogin(e, "new@example.com", "password123"))
+		assert.Equal(t, http.StatusUnauthorized, serveWithToken(e, http.MethodGet, "/me", token).Code)
+		assert.Equal(t, http.StatusUnauthorized, serveWithBearer(e, http.MethodGet, "/me", "", pat.Token).Code)
+	})
+
+	t.Run("パスワードを使わずに、変更前のメールアドレスで本人確認してから変更できること", func(t *testing.T) {
+		e, dir := setup(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "")
+
+		rec := serveJSONWithToken(e, http.MethodPost, "/me/email/request", `{"email":"new@example.com"}`, token)
+		assert.Equal(t, http.StatusAccepted, rec.Code, rec.Body.String())
+		// 本人確認のメールだけを変更前のメールアドレスに送信する
+		tokens := readResetTokens(t, dir)
+		require.Len(t, tokens, 1)
+		// 本人確認のリンクでは、メールアドレスを変更できないこと
+		assert.Equal(t, http.StatusBadRequest, postJSON(e, "/auth/email/confirm", `{"token":"`+tokens[0]+`"}`).Code)
+
+		rec = serveJSONWithToken(e, http.MethodPost, "/me/email/authorize", `{"token":"`+tokens[0]+`"}`, token)
+		me := getMe(t, rec.Code, rec.Body.Bytes())
+		require.NotNil(t, me.PendingEmail)
+		assert.Equal(t, "new@example.com", *me.PendingEmail)
+		tokens = readResetTokens(t, dir)
+		require.Len(t, tokens, 3)
+
+		rec = postJSON(e, "/auth/email/confirm", `{"token":"`+tokens[2]+`"}`)
+		assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		assert.Equal(t, http.StatusOK, tryLogin(e, "new@example.com", "password123"))
+	})
+
+	t.Run("本人確認のリンクは、発行したユーザーのセッションからのみ使用できること", func(t *testing.T) {
+		e, dir := setup(t)
+		createUserWithPassword(t, "user@example.com", "password123")
+		createUserWithPassword(t, "other@example.com", "password123")
+		token := login(t, e, "user@example.com", "password123", "")
+		otherToken := login(t, e, "other@example.com", "password123", "")
+
+		rec := serveJSONWithToken(e, http.MethodPost, "/me/email/request", `{"email":"new@example.com"}`, token)
+		require.Equal(t, http.StatusAccepted, rec.Code, rec.Body.String())
+		tokens := readResetTokens(t, dir)
+		require.Len(t, tokens, 1)
+
+		rec = serveJSONWithToken(e, http.MethodPost, "/me/email/authorize", `{"token":"`+tokens[0]+`"}`, otherToken)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Len(t, readResetTokens(t, dir), 1)
+	})
+}
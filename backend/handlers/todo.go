@@ -1,12 +1,16 @@
 package handlers
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"todo-app/app_errors"
 	"todo-app/dto"
 	"todo-app/ent"
+	function_declerations "todo-app/function_declarations"
+	"todo-app/prompts"
 	"todo-app/services"
 	"todo-app/utils"
 	"todo-app/validators"
@@ -20,19 +24,46 @@ type TodoHandler struct {
 	service              *services.TodoService
 	filterHistoryService services.ITodoFilterHistoryService
 	aiService            *services.AIService
+	breakdownService     *services.TodoBreakdownService
+	summaryService       *services.TodoSummaryService
+	embeddingService     *services.TodoEmbeddingService
 	aiFactory            utils.IAIFactory
 }
 
-func NewTodoHandler(logger *slog.Logger, service *services.TodoService, filterHistoryService services.ITodoFilterHistoryService, aiService *services.AIService, aiFactory utils.IAIFactory) *TodoHandler {
+func NewTodoHandler(logger *slog.Logger, service *services.TodoService, filterHistoryService services.ITodoFilterHistoryService, aiService *services.AIService, breakdownService *services.TodoBreakdownService, summaryService *services.TodoSummaryService, embeddingService *services.TodoEmbeddingService, aiFactory utils.IAIFactory) *TodoHandler {
 	return &TodoHandler{
 		logger:               logger,
 		service:              service,
 		filterHistoryService: filterHistoryService,
 		aiService:            aiService,
+		breakdownService:     breakdownService,
+		summaryService:       summaryService,
+		embeddingService:     embeddingService,
 		aiFactory:            aiFactory,
 	}
 }
 
+// aiStreamEmitter は AI を使う処理の途中経過をイベントとして通知する
+type aiStreamEmitter func(event string, data interface{}) error
+
+// discardAIStreamEvent は途中経過を通知しない通常の API で使う
+func discardAIStreamEvent(string, interface{}) error {
+	return nil
+}
+
+// aiStreamPartialSize は partial イベント1件に含める ToDo の件数
+const aiStreamPartialSize = 20
+
+func emitPartialTodos(emit aiStreamEmitter, todos []dto.TodoDto) error {
+	for i := 0; i < len(todos); i += aiStreamPartialSize {
+		end := min(i+aiStreamPartialSize, len(todos))
+		if err := emit(dto.AIStreamEventPartial, dto.AIStreamPartialDto{Todos: todos[i:end]}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (h *TodoHandler) ListTodoFilterHistories(c *echo.Context) error {
 	utils.LogRequest(h.logger, c)
 
@@ -52,50 +83,101 @@ func (h *TodoHandler) ListTodoFilterHistories(c *echo.Context) error {
 func (h *TodoHandler) FilterTodosByQuery(c *echo.Context) error {
 	utils.LogRequest(h.logger, c)
 
-	query := c.QueryParam("query")
-	ctx := c.Request().Context()
-
-	aiClient, err := h.aiFactory.GetGeminiClient(ctx)
+	res, err := h.filterTodosByQuery(c.Request().Context(), c.QueryParam("query"), discardAIStreamEvent)
 	if err != nil {
 		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
 	}
 
-	aiDto, err := h.aiService.DecideFilterTodosFunction(ctx, aiClient, query)
+	return c.JSON(http.StatusOK, res)
+}
+
+// FilterTodosByQueryStream は FilterTodosByQuery の途中経過を SSE で送信し、最後に同じ形式の結果を result イベントで返す。
+func (h *TodoHandler) FilterTodosByQueryStream(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	w := utils.NewSSEWriter(c)
+	res, err := h.filterTodosByQuery(c.Request().Context(), c.QueryParam("query"), w.Send)
 	if err != nil {
-		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+		return utils.HandleStreamError(h.logger, c, w, err, http.StatusInternalServerError)
+	}
+	if err := w.Send(dto.AIStreamEventResult, res); err != nil {
+		return utils.HandleStreamError(h.logger, c, w, err, http.StatusInternalServerError)
+	}
+	return nil
+}
+
+func (h *TodoHandler) filterTodosByQuery(ctx context.Context, query string, emit aiStreamEmitter) (*dto.AIFilterResponseDto, error) {
+	if err := emit(dto.AIStreamEventThinking, dto.AIStreamThinkingDto{Message: "クエリを解釈しています"}); err != nil {
+		return nil, err
 	}
 
-	var todos []*ent.Todo
-	var functionName *string
+	settings := services.PromptSettingsFromContext(ctx)
+	key := services.NewTodoFilterCacheKey(query, settings.Now(), prompts.CurrentID(prompts.FilterTodos, settings.Locale))
+	aiDto, err := h.filterHistoryService.DecideFilter(ctx, key, query)
+	if err != nil {
+		return nil, err
+	}
+
+	res := dto.AIFilterResponseDto{
+		Status: dto.AIFilterStatusNoTool,
+		Todos:  []dto.TodoDto{},
+	}
+	todos := []*ent.Todo{}
 	var args map[string]interface{}
 
 	if aiDto != nil {
-		functionName = &aiDto.FunctionName
+		res.Text = aiDto.Text
+	}
+	if aiDto != nil && aiDto.FunctionName != "" {
+		res.FunctionName = &aiDto.FunctionName
 		args = aiDto.Args
+		res.Args = args
+
+		res.ResolvedArgs, err = h.aiService.ResolveFilterArgs(ctx, aiDto.FunctionName, aiDto.Args)
+		if err != nil {
+			return nil, err
+		}
+		if err := emit(dto.AIStreamEventToolChosen, dto.AIStreamToolChosenDto{
+			FunctionName: aiDto.FunctionName,
+			Args:         res.Args,
+			ResolvedArgs: res.ResolvedArgs,
+		}); err != nil {
+			return nil, err
+		}
+
 		todos, err = h.aiService.FilterTodos(ctx, aiDto.FunctionName, aiDto.Args)
 		if err != nil {
-			return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+			return nil, err
+		}
+		if err := emit(dto.AIStreamEventToolExecuted, dto.AIStreamToolExecutedDto{
+			FunctionName: aiDto.FunctionName,
+			Count:        len(todos),
+		}); err != nil {
+			return nil, err
+		}
+
+		res.Status = dto.AIFilterStatusNoResults
+		if len(todos) > 0 {
+			res.Status = dto.AIFilterStatusMatched
 		}
-	} else {
-		todos = []*ent.Todo{}
 	}
 
 	todoIds := make([]int, len(todos))
 	for i, t := range todos {
 		todoIds[i] = t.ID
+		res.Todos = append(res.Todos, dto.EntityToTodoDto(t))
 	}
-
-	_, err = h.filterHistoryService.SaveFilterHistory(ctx, query, functionName, args, todoIds)
-	if err != nil {
-		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	if err := emitPartialTodos(emit, res.Todos); err != nil {
+		return nil, err
 	}
 
-	res := make([]dto.TodoDto, len(todos))
-	for i, t := range todos {
-		res[i] = dto.EntityToTodoDto(t)
+	history, err := h.filterHistoryService.SaveFilterHistory(ctx, query, key, res.FunctionName, args, todoIds)
+	if err != nil {
+		return nil, err
 	}
+	res.QueryID = history.ID.String()
 
-	return c.JSON(http.StatusOK, res)
+	return &res, nil
 }
 
 func (h *TodoHandler) FilterTodosByQueryID(c *echo.Context) error {
@@ -145,44 +227,226 @@ func (h *TodoHandler) CreateTodo(c *echo.Context) error {
 	}
 
 	ctx := c.Request().Context()
-	todo, err := h.service.CreateTodo(ctx, req.Title, req.Description)
+	todo, err := h.service.CreateTodo(ctx, req.Title, req.Description, req.ListID)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return utils.HandleError(h.logger, c, errors.New("list not found"), http.StatusNotFound)
+		}
+		if errors.Is(err, app_errors.ErrTodoListPermissionDenied) {
+			return utils.HandleError(h.logger, c, err, http.StatusForbidden)
+		}
 		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
 	}
+	h.embeddingService.IndexTodos(ctx, todo)
 
 	res := dto.EntityToTodoDto(todo)
 
 	return c.JSON(http.StatusCreated, res)
 }
 
+func (h *TodoHandler) CreateTodosByAI(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	var req validators.AICreateTodoRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, err, http.StatusBadRequest)
+	}
+
+	if errorMessages := req.Validate(); errorMessages != nil {
+		h.logger.Error("validation error", slog.Any("errors", errorMessages))
+		return c.JSON(http.StatusBadRequest, map[string]map[string]string{
+			"error": errorMessages,
+		})
+	}
+
+	res, code, err := h.createTodosByAI(c.Request().Context(), req, discardAIStreamEvent)
+	if err != nil {
+		var draftErr *draftValidationError
+		if errors.As(err, &draftErr) {
+			h.logger.Error("validation error", slog.Any("errors", draftErr.messages))
+			return c.JSON(code, map[string]map[string]string{
+				"error": draftErr.messages,
+			})
+		}
+		return utils.HandleError(h.logger, c, err, code)
+	}
+
+	return c.JSON(code, res)
+}
+
+// CreateTodosByAIStream は CreateTodosByAI の途中経過を SSE で送信し、最後に同じ形式の結果を result イベントで返す。
+func (h *TodoHandler) CreateTodosByAIStream(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	var req validators.AICreateTodoRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, err, http.StatusBadRequest)
+	}
+
+	if errorMessages := req.Validate(); errorMessages != nil {
+		h.logger.Error("validation error", slog.Any("errors", errorMessages))
+		return c.JSON(http.StatusBadRequest, map[string]map[string]string{
+			"error": errorMessages,
+		})
+	}
+
+	w := utils.NewSSEWriter(c)
+	res, code, err := h.createTodosByAI(c.Request().Context(), req, w.Send)
+	if err != nil {
+		var draftErr *draftValidationError
+		if errors.As(err, &draftErr) && w.Started() {
+			h.logger.Error("validation error", slog.Any("errors", draftErr.messages))
+			if err := w.Send(dto.AIStreamEventError, map[string]interface{}{
+				"error":  draftErr.messages,
+				"status": code,
+			}); err != nil {
+				return utils.HandleStreamError(h.logger, c, w, err, http.StatusInternalServerError)
+			}
+			return nil
+		}
+		return utils.HandleStreamError(h.logger, c, w, err, code)
+	}
+	if err := w.Send(dto.AIStreamEventResult, res); err != nil {
+		return utils.HandleStreamError(h.logger, c, w, err, http.StatusInternalServerError)
+	}
+	return nil
+}
+
+// draftValidationError は下書きが通常の ToDo 作成のルールを満たさないことを表す
+type draftValidationError struct {
+	messages map[string]string
+}
+
+func (e *draftValidationError) Error() string {
+	return "validation error"
+}
+
+// createTodosByAI は下書きの作成と検証、commit モードでの ToDo 作成を行い、レスポンスとステータスコードを返す。
+func (h *TodoHandler) createTodosByAI(ctx context.Context, req validators.AICreateTodoRequest, emit aiStreamEmitter) (*dto.AICreateTodoResponseDto, int, error) {
+	var drafts []dto.TodoDraftDto
+	if len(req.Drafts) > 0 {
+		drafts = make([]dto.TodoDraftDto, len(req.Drafts))
+		for i, d := range req.Drafts {
+			drafts[i] = dto.TodoDraftDto{Title: d.Title, Description: d.Description}
+		}
+	} else {
+		if err := emit(dto.AIStreamEventThinking, dto.AIStreamThinkingDto{Message: "文章から ToDo を抽出しています"}); err != nil {
+			return nil, http.StatusInternalServerError, err
+		}
+
+		aiClient, err := h.aiFactory.GetGeminiClient(ctx)
+		if err != nil {
+			return nil, http.StatusInternalServerError, err
+		}
+
+		drafts, err = h.aiService.DraftTodos(ctx, aiClient, req.Text)
+		if err != nil {
+			return nil, http.StatusInternalServerError, err
+		}
+	}
+
+	// 通常の ToDo 作成と同じルールで下書きを検証する
+	errorMessages := map[string]string{}
+	for i := range drafts {
+		draftReq := validators.CreateTodoRequest{Title: drafts[i].Title, Description: drafts[i].Description}
+		drafts[i].Errors = draftReq.Validate()
+		for field, msg := range drafts[i].Errors {
+			errorMessages[fmt.Sprintf("drafts[%d].%s", i, field)] = msg
+		}
+	}
+	if len(drafts) > 0 {
+		if err := emit(dto.AIStreamEventPartial, dto.AIStreamPartialDto{Drafts: drafts}); err != nil {
+			return nil, http.StatusInternalServerError, err
+		}
+	}
+
+	if req.IsDryRun() {
+		return &dto.AICreateTodoResponseDto{
+			Mode:   validators.AICreateTodoModeDryRun,
+			Drafts: drafts,
+			Todos:  []dto.TodoDto{},
+		}, http.StatusOK, nil
+	}
+
+	if len(drafts) == 0 {
+		return nil, http.StatusUnprocessableEntity, errors.New("no todo could be extracted from text")
+	}
+	if len(errorMessages) > 0 {
+		return nil, http.StatusBadRequest, &draftValidationError{messages: errorMessages}
+	}
+
+	todos, err := h.service.CreateTodos(ctx, drafts)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	h.embeddingService.IndexTodos(ctx, todos...)
+	if err := emit(dto.AIStreamEventToolExecuted, dto.AIStreamToolExecutedDto{
+		FunctionName: function_declerations.CreateTodosDeclaration.Name,
+		Count:        len(todos),
+	}); err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	res := &dto.AICreateTodoResponseDto{
+		Mode:   validators.AICreateTodoModeCommit,
+		Drafts: drafts,
+		Todos:  make([]dto.TodoDto, len(todos)),
+	}
+	for i, t := range todos {
+		res.Todos[i] = dto.EntityToTodoDto(t)
+	}
+	if err := emitPartialTodos(emit, res.Todos); err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	return res, http.StatusCreated, nil
+}
+
 func (h *TodoHandler) ListTodo(c *echo.Context) error {
 	utils.LogRequest(h.logger, c)
 
+	ctx := c.Request().Context()
+
+	// limit と include_done を指定しない場合は、ユーザーの設定を既定値にする
+	defaultLimit, defaultIncludeDone := 20, false
+	if u, ok := utils.UserFromContext(ctx); ok {
+		defaultLimit, defaultIncludeDone = u.TodoPageSize, u.TodoIncludeDone
+	}
+
 	pageInt, err := echo.QueryParamOr(c, "page", 1)
 	if err != nil || pageInt < 1 {
 		pageInt = 1
 	}
 
-	limitInt, err := echo.QueryParamOr(c, "limit", 20)
+	limitInt, err := echo.QueryParamOr(c, "limit", defaultLimit)
 	if err != nil || limitInt < 1 {
-		limitInt = 20
+		limitInt = defaultLimit
 	}
 	if limitInt > 100 {
 		limitInt = 100
 	}
 
-	includeDone, err := echo.QueryParamOr(c, "include_done", false)
+	includeDone, err := echo.QueryParamOr(c, "include_done", defaultIncludeDone)
 	if err != nil {
-		includeDone = false
+		includeDone = defaultIncludeDone
 	}
 
-	ctx := c.Request().Context()
-	todos, err := h.service.GetTodoSlice(ctx, pageInt, limitInt, includeDone)
+	// list_id を指定した場合は共有のリストの Todo に絞り込む
+	var listID *int
+	if c.QueryParam("list_id") != "" {
+		id, err := echo.QueryParam[int](c, "list_id")
+		if err != nil || id < 1 {
+			return utils.HandleError(h.logger, c, errors.New("invalid list_id"), http.StatusBadRequest)
+		}
+		listID = &id
+	}
+
+	todos, err := h.service.GetTodoSlice(ctx, pageInt, limitInt, includeDone, listID)
 	if err != nil {
 		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
 	}
 
-	pagination, err := h.service.CalculatePagination(ctx, pageInt, limitInt, includeDone)
+	pagination, err := h.service.CalculatePagination(ctx, pageInt, limitInt, includeDone, listID)
 	if err != nil {
 		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
 	}
@@ -224,8 +488,14 @@ func (h *TodoHandler) UpdateTodo(c *echo.Context) error {
 		if errors.Is(err, app_errors.ErrTodoAlreadyDone) {
 			return utils.HandleError(h.logger, c, err, http.StatusBadRequest)
 		}
+		if errors.Is(err, app_errors.ErrTodoListPermissionDenied) {
+			return utils.HandleError(h.logger, c, err, http.StatusForbidden)
+		}
 		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
 	}
+	if req.Title != nil || req.Description != nil {
+		h.embeddingService.IndexTodos(ctx, todo)
+	}
 
 	res := dto.EntityToTodoDto(todo)
 
@@ -246,6 +516,9 @@ func (h *TodoHandler) DeleteTodo(c *echo.Context) error {
 		if ent.IsNotFound(err) {
 			return c.NoContent(http.StatusNoContent)
 		}
+		if errors.Is(err, app_errors.ErrTodoListPermissionDenied) {
+			return utils.HandleError(h.logger, c, err, http.StatusForbidden)
+		}
 		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
 	}
 
@@ -278,9 +551,187 @@ func (h *TodoHandler) UpdateDoneStatus(c *echo.Context) error {
 		if ent.IsNotFound(err) {
 			return utils.HandleError(h.logger, c, errors.New("todo not found"), http.StatusNotFound)
 		}
+		if errors.Is(err, app_errors.ErrTodoListPermissionDenied) {
+			return utils.HandleError(h.logger, c, err, http.StatusForbidden)
+		}
 		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
 	}
 
 	res := dto.EntityToTodoDto(todo)
 	return c.JSON(http.StatusOK, res)
 }
+
+func (h *TodoHandler) BreakdownTodoByAI(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	id, err := echo.PathParam[int](c, "id")
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid idParam"), http.StatusBadRequest)
+	}
+
+	ctx := c.Request().Context()
+	aiClient, err := h.aiFactory.GetGeminiClient(ctx)
+	if err != nil {
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	breakdown, err := h.breakdownService.GenerateBreakdown(ctx, aiClient, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return utils.HandleError(h.logger, c, errors.New("todo not found"), http.StatusNotFound)
+		}
+		if errors.Is(err, app_errors.ErrTodoAlreadyDone) {
+			return utils.HandleError(h.logger, c, err, http.StatusBadRequest)
+		}
+		if errors.Is(err, app_errors.ErrNoBreakdownSteps) {
+			return utils.HandleError(h.logger, c, err, http.StatusUnprocessableEntity)
+		}
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	res := dto.EntityToTodoBreakdownDto(breakdown)
+	// 採用時と同じルールで検証した結果を手順ごとに付与する
+	for i, step := range res.Steps {
+		stepReq := validators.CreateTodoRequest{Title: step.Title, Description: step.Description}
+		res.Steps[i].Errors = stepReq.Validate()
+	}
+
+	return c.JSON(http.StatusCreated, res)
+}
+
+func (h *TodoHandler) AcceptTodoBreakdown(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	id, err := echo.PathParam[int](c, "id")
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid idParam"), http.StatusBadRequest)
+	}
+
+	breakdownID, err := uuid.Parse(c.Param("breakdown_id"))
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid breakdown_id"), http.StatusBadRequest)
+	}
+
+	var req validators.AcceptTodoBreakdownRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, err, http.StatusBadRequest)
+	}
+
+	if errorMessages := req.Validate(); errorMessages != nil {
+		h.logger.Error("validation error", slog.Any("errors", errorMessages))
+		return c.JSON(http.StatusBadRequest, map[string]map[string]string{
+			"error": errorMessages,
+		})
+	}
+
+	ctx := c.Request().Context()
+	breakdown, err := h.breakdownService.GetBreakdown(ctx, id, breakdownID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return utils.HandleError(h.logger, c, errors.New("breakdown not found"), http.StatusNotFound)
+		}
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	errorMessages := map[string]string{}
+	for _, idx := range req.StepIndexes {
+		if idx < 0 || idx >= len(breakdown.Steps) {
+			continue
+		}
+		stepReq := validators.CreateTodoRequest{Title: breakdown.Steps[idx]["title"], Description: breakdown.Steps[idx]["description"]}
+		for field, msg := range stepReq.Validate() {
+			errorMessages[fmt.Sprintf("steps[%d].%s", idx, field)] = msg
+		}
+	}
+	if len(errorMessages) > 0 {
+		h.logger.Error("validation error", slog.Any("errors", errorMessages))
+		return c.JSON(http.StatusBadRequest, map[string]map[string]string{
+			"error": errorMessages,
+		})
+	}
+
+	accepted, todos, err := h.breakdownService.AcceptBreakdown(ctx, id, breakdownID, req.StepIndexes)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return utils.HandleError(h.logger, c, errors.New("breakdown not found"), http.StatusNotFound)
+		}
+		if errors.Is(err, app_errors.ErrInvalidBreakdownStepIndexes) {
+			return utils.HandleError(h.logger, c, err, http.StatusBadRequest)
+		}
+		if errors.Is(err, app_errors.ErrBreakdownAlreadyAccepted) {
+			return utils.HandleError(h.logger, c, err, http.StatusConflict)
+		}
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+	h.embeddingService.IndexTodos(ctx, todos...)
+
+	res := dto.AcceptTodoBreakdownResponseDto{
+		Breakdown: dto.EntityToTodoBreakdownDto(accepted),
+		Todos:     make([]dto.TodoDto, len(todos)),
+	}
+	for i, t := range todos {
+		res.Todos[i] = dto.EntityToTodoDto(t)
+	}
+
+	return c.JSON(http.StatusCreated, res)
+}
+
+func (h *TodoHandler) SemanticSearchTodos(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	var req validators.SemanticSearchRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, err, http.StatusBadRequest)
+	}
+
+	if errorMessages := req.Validate(); errorMessages != nil {
+		h.logger.Error("validation error", slog.Any("errors", errorMessages))
+		return c.JSON(http.StatusBadRequest, map[string]map[string]string{
+			"error": errorMessages,
+		})
+	}
+
+	ctx := c.Request().Context()
+	results, err := h.embeddingService.Search(ctx, req.Q, req.GetLimit())
+	if err != nil {
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	res := dto.SemanticSearchResponseDto{
+		Query:   req.Q,
+		Results: make([]dto.TodoSearchResultDto, len(results)),
+	}
+	for i, r := range results {
+		res.Results[i] = dto.TodoSearchResultDto{
+			Score: r.Score,
+			Todo:  dto.EntityToTodoDto(r.Todo),
+		}
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (h *TodoHandler) SummarizeTodosByAI(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	var req validators.TodoSummaryRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, err, http.StatusBadRequest)
+	}
+
+	if errorMessages := req.Validate(); errorMessages != nil {
+		h.logger.Error("validation error", slog.Any("errors", errorMessages))
+		return c.JSON(http.StatusBadRequest, map[string]map[string]string{
+			"error": errorMessages,
+		})
+	}
+
+	from, to, _ := req.Range()
+	ctx := c.Request().Context()
+	res, err := h.summaryService.Summarize(ctx, from, to, req.Refresh)
+	if err != nil {
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,270 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"todo-app/app_errors"
+	"todo-app/ent"
+	"todo-app/services"
+	"todo-app/utils"
+	"todo-app/validators"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+)
+
+type TodoListHandler struct {
+	logger  *slog.Logger
+	service *services.TodoListService
+}
+
+func NewTodoListHandler(logger *slog.Logger, service *services.TodoListService) *TodoListHandler {
+	return &TodoListHandler{logger: logger, service: service}
+}
+
+// ListTodoLists はログイン中のユーザーが参加しているリストを、自分の権限と合わせて返す
+func (h *TodoListHandler) ListTodoLists(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	res, err := h.service.List(c.Request().Context())
+	if err != nil {
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// CreateTodoList はリストを作成する。作成したユーザーが所有者になる
+func (h *TodoListHandler) CreateTodoList(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	var req validators.CreateTodoListRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	res, err := h.service.Create(c.Request().Context(), req.Name)
+	if err != nil {
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusCreated, res)
+}
+
+// GetTodoList はリストとメンバーの一覧を返す
+func (h *TodoListHandler) GetTodoList(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	id, err := echo.PathParam[int](c, "id")
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid idParam"), http.StatusBadRequest)
+	}
+
+	res, err := h.service.Get(c.Request().Context(), id)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (h *TodoListHandler) UpdateTodoList(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	id, err := echo.PathParam[int](c, "id")
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid idParam"), http.StatusBadRequest)
+	}
+
+	var req validators.UpdateTodoListRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	res, err := h.service.Rename(c.Request().Context(), id, req.Name)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// DeleteTodoList はリストを、リストの Todo と合わせて削除する
+func (h *TodoListHandler) DeleteTodoList(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	id, err := echo.PathParam[int](c, "id")
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid idParam"), http.StatusBadRequest)
+	}
+
+	if err := h.service.Delete(c.Request().Context(), id); err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *TodoListHandler) UpdateMember(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	id, err := echo.PathParam[int](c, "id")
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid idParam"), http.StatusBadRequest)
+	}
+	userID, err := echo.PathParam[int](c, "user_id")
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid user_id"), http.StatusBadRequest)
+	}
+
+	var req validators.UpdateTodoListMemberRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	res, err := h.service.UpdateMemberRole(c.Request().Context(), id, userID, req.Role)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// RemoveMember はメンバーをリストから外す。自分の user_id を指定するとリストから抜ける
+func (h *TodoListHandler) RemoveMember(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	id, err := echo.PathParam[int](c, "id")
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid idParam"), http.StatusBadRequest)
+	}
+	userID, err := echo.PathParam[int](c, "user_id")
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid user_id"), http.StatusBadRequest)
+	}
+
+	if err := h.service.RemoveMember(c.Request().Context(), id, userID); err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// InviteMember はメールアドレス宛てにリストへの招待を送信する
+func (h *TodoListHandler) InviteMember(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	id, err := echo.PathParam[int](c, "id")
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid idParam"), http.StatusBadRequest)
+	}
+
+	var req validators.InviteTodoListMemberRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	res, err := h.service.Invite(c.Request().Context(), id, req.Email, req.Role)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, res)
+}
+
+func (h *TodoListHandler) ListInvites(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	id, err := echo.PathParam[int](c, "id")
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid idParam"), http.StatusBadRequest)
+	}
+
+	res, err := h.service.ListInvites(c.Request().Context(), id)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (h *TodoListHandler) RevokeInvite(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	id, err := echo.PathParam[int](c, "id")
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid idParam"), http.StatusBadRequest)
+	}
+	inviteID, err := uuid.Parse(c.Param("invite_id"))
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid invite_id"), http.StatusBadRequest)
+	}
+
+	if err := h.service.RevokeInvite(c.Request().Context(), id, inviteID); err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// AcceptInvite は招待のメールのトークンで、ログイン中のユーザーをリストのメンバーにする
+func (h *TodoListHandler) AcceptInvite(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	var req validators.AcceptTodoListInviteRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	res, err := h.service.AcceptInvite(c.Request().Context(), req.Token)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// handleError はリストの操作のエラーをステータスコードに変換する。
+// メンバーでないリストは存在を知られないよう 404 を返す
+func (h *TodoListHandler) handleError(c *echo.Context, err error) error {
+	if ent.IsNotFound(err) {
+		return utils.HandleError(h.logger, c, errors.New("not found"), http.StatusNotFound)
+	}
+	if errors.Is(err, app_errors.ErrTodoListPermissionDenied) || errors.Is(err, app_errors.ErrTodoListInviteEmailMismatch) ||
+		errors.Is(err, app_errors.ErrNotWorkspaceMember) {
+		return utils.HandleError(h.logger, c, err, http.StatusForbidden)
+	}
+	if errors.Is(err, app_errors.ErrLastTodoListOwner) || errors.Is(err, app_errors.ErrAlreadyTodoListMember) {
+		return utils.HandleError(h.logger, c, err, http.StatusConflict)
+	}
+	if errors.Is(err, app_errors.ErrInvalidTodoListInvite) {
+		return utils.HandleError(h.logger, c, err, http.StatusBadRequest)
+	}
+	return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+}
@@ -0,0 +1,410 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+	"todo-app/dto"
+	"todo-app/ent"
+	"todo-app/ent/todo"
+	"todo-app/ent/todolist"
+	"todo-app/ent/todolistinvite"
+	"todo-app/ent/todolistmember"
+	"todo-app/ent/user"
+	"todo-app/repositories"
+	"todo-app/utils"
+
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupTodoListTestApp は LogMailer が招待のメールを保存するディレクトリと合わせてアプリを返す
+func setupTodoListTestApp(t *testing.T) (*echo.Echo, string) {
+	t.Setenv("TODO_LIST_INVITE_URL", "http://localhost:3000/accept-invite")
+	return setupWorkspaceTestApp(t)
+}
+
+func createTodoList(t *testing.T, e *echo.Echo, token string, workspaceID int, name string) int {
+	rec := serveJSONWithToken(e, http.MethodPost, workspacePath(workspaceID, "/lists"), `{"name":"`+name+`"}`, token)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var res dto.TodoListDto
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	return res.ID
+}
+
+func todoListPath(workspaceID int, listID int, rest string) string {
+	return workspacePath(workspaceID, "/lists/"+strconv.Itoa(listID)+rest)
+}
+
+// shareTodoList はユーザーを作成してワークスペースに追加したうえでリストに招待し、招待を承諾したユーザーのアクセストークンを返す。
+// ownerToken のユーザーはワークスペースの管理者である必要がある
+func shareTodoList(t *testing.T, e *echo.Echo, dir string, ownerToken string, workspaceID int, listID int, email string, role string) string {
+	createUserWithPassword(t, email, "password123")
+	addWorkspaceMember(t, e, dir, ownerToken, workspaceID, email, repositories.WorkspaceRoleMember)
+	rec := serveJSONWithToken(e, http.MethodPost, todoListPath(workspaceID, listID, "/invites"), `{"email":"`+email+`","role":"`+role+`"}`, ownerToken)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+	tokens := readResetTokens(t, dir)
+	require.NotEmpty(t, tokens)
+	token := login(t, e, email, "password123", "")
+	rec = serveJSONWithToken(e, http.MethodPost, "/lists/invites/accept", `{"token":"`+tokens[len(tokens)-1]+`"}`, token)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	return token
+}
+
+func TestTodoListHandler_Invite_Integration(t *testing.T) {
+	t.Run("招待されたメールアドレスのユーザーが承諾するとメンバーになること", func(t *testing.T) {
+		e, dir := setupTodoListTestApp(t)
+		createUserWithPassword(t, "owner@example.com", "password123")
+		ownerToken := login(t, e, "owner@example.com", "password123", "")
+		wsID := createWorkspace(t, e, ownerToken, "Acme")
+		listID := createTodoList(t, e, ownerToken, wsID, "Household")
+
+		createUserWithPassword(t, "other@example.com", "password123")
+		otherToken := login(t, e, "other@example.com", "password123", "")
+		addWorkspaceMember(t, e, dir, ownerToken, wsID, "other@example.com", repositories.WorkspaceRoleMember)
+
+		// ワークスペースのメンバーでないメールアドレスには招待できない
+		rec := serveJSONWithToken(e, http.MethodPost, todoListPath(wsID, listID, "/invites"), `{"email":"member@example.com","role":"editor"}`, ownerToken)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+
+		createUserWithPassword(t, "member@example.com", "password123")
+		memberToken := login(t, e, "member@example.com", "password123", "")
+		addWorkspaceMember(t, e, dir, ownerToken, wsID, "member@example.com", repositories.WorkspaceRoleMember)
+		rec = serveJSONWithToken(e, http.MethodPost, todoListPath(wsID, listID, "/invites"), `{"email":"member@example.com","role":"editor"}`, ownerToken)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		// ワークスペースへの招待の後に送信した、リストへの招待のメールのトークン
+		tokens := readResetTokens(t, dir)
+		inviteToken := tokens[len(tokens)-1]
+
+		// 招待されていないユーザーは承諾できず、招待も使用済みにならない
+		rec = serveJSONWithToken(e, http.MethodPost, "/lists/invites/accept", `{"token":"`+inviteToken+`"}`, otherToken)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+
+		rec = serveJSONWithToken(e, http.MethodPost, "/lists/invites/accept", `{"token":"`+inviteToken+`"}`, memberToken)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		// 招待は 1 回だけ使用できる
+		rec = serveJSONWithToken(e, http.MethodPost, "/lists/invites/accept", `{"token":"`+inviteToken+`"}`, memberToken)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = serveWithToken(e, http.MethodGet, todoListPath(wsID, listID, ""), memberToken)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var detail dto.TodoListDetailDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &detail))
+		assert.Equal(t, repositories.TodoListRoleEditor, detail.Role)
+		require.Len(t, detail.Members, 2)
+		assert.Equal(t, "member@example.com", detail.Members[1].Email)
+
+		rec = serveWithToken(e, http.MethodGet, workspacePath(wsID, "/lists"), memberToken)
+		var lists dto.ListTodoListsResponseDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &lists))
+		require.Len(t, lists.Data, 1)
+		assert.Equal(t, "Household", lists.Data[0].Name)
+
+		// メンバーでないリストは存在も分からない
+		assert.Equal(t, http.StatusNotFound, serveWithToken(e, http.MethodGet, todoListPath(wsID, listID, ""), otherToken).Code)
+	})
+
+	t.Run("所有者以外は招待できず、取り消した招待は使えないこと", func(t *testing.T) {
+		e, dir := setupTodoListTestApp(t)
+		createUserWithPassword(t, "owner@example.com", "password123")
+		ownerToken := login(t, e, "owner@example.com", "password123", "")
+		wsID := createWorkspace(t, e, ownerToken, "Acme")
+		listID := createTodoList(t, e, ownerToken, wsID, "Team")
+		editorToken := shareTodoList(t, e, dir, ownerToken, wsID, listID, "editor@example.com", repositories.TodoListRoleEditor)
+
+		createUserWithPassword(t, "new@example.com", "password123")
+		newToken := login(t, e, "new@example.com", "password123", "")
+		addWorkspaceMember(t, e, dir, ownerToken, wsID, "new@example.com", repositories.WorkspaceRoleMember)
+
+		rec := serveJSONWithToken(e, http.MethodPost, todoListPath(wsID, listID, "/invites"), `{"email":"new@example.com","role":"viewer"}`, editorToken)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+
+		// 既にメンバーのメールアドレスには招待できない
+		rec = serveJSONWithToken(e, http.MethodPost, todoListPath(wsID, listID, "/invites"), `{"email":"editor@example.com","role":"viewer"}`, ownerToken)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+
+		rec = serveJSONWithToken(e, http.MethodPost, todoListPath(wsID, listID, "/invites"), `{"email":"new@example.com","role":"viewer"}`, ownerToken)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		var invite dto.TodoListInviteDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &invite))
+
+		rec = serveWithToken(e, http.MethodGet, todoListPath(wsID, listID, "/invites"), ownerToken)
+		var invites dto.ListTodoListInvitesResponseDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &invites))
+		require.Len(t, invites.Data, 1)
+
+		rec = serveWithToken(e, http.MethodDelete, todoListPath(wsID, listID, "/invites/"+invite.ID), ownerToken)
+		require.Equal(t, http.StatusNoContent, rec.Code)
+
+		tokens := readResetTokens(t, dir)
+		rec = serveJSONWithToken(e, http.MethodPost, "/lists/invites/accept", `{"token":"`+tokens[len(tokens)-1]+`"}`, newToken)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestTodoListHandler_Isolation_Integration(t *testing.T) {
+	t.Run("リポジトリは対象のワークスペースで参加していないリストを変更せず NotFoundError を返すこと", func(t *testing.T) {
+		e, dir := setupTodoListTestApp(t)
+		ownerID := createUserWithPassword(t, "owner@example.com", "password123")
+		ownerToken := login(t, e, "owner@example.com", "password123", "")
+		wsID := createWorkspace(t, e, ownerToken, "Acme")
+		listID := createTodoList(t, e, ownerToken, wsID, "Household")
+		shareTodoList(t, e, dir, ownerToken, wsID, listID, "editor@example.com", repositories.TodoListRoleEditor)
+		createUserWithPassword(t, "viewer@example.com", "password123")
+		addWorkspaceMember(t, e, dir, ownerToken, wsID, "viewer@example.com", repositories.WorkspaceRoleMember)
+		rec := serveJSONWithToken(e, http.MethodPost, todoListPath(wsID, listID, "/invites"), `{"email":"viewer@example.com","role":"viewer"}`, ownerToken)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		ctx := context.Background()
+		editorID := testClient.User.Query().Where(user.Email("editor@example.com")).OnlyIDX(ctx)
+		inviteID := testClient.TodoListInvite.Query().Where(todolistinvite.UsedAtIsNil()).OnlyIDX(ctx)
+		otherID := createUserWithPassword(t, "other@example.com", "password123")
+		owner := testClient.User.GetX(ctx, ownerID)
+		other := testClient.User.GetX(ctx, otherID)
+		repo := repositories.NewTodoListRepository(testClient)
+		inviteRepo := repositories.NewTodoListInviteRepository(testClient)
+
+		principals := map[string]context.Context{
+			// リストの所有者でも、リストの属さないワークスペースを対象にしたリクエストでは変更できない
+			"別のワークスペースの所有者":   utils.WithWorkspace(utils.WithUser(ctx, owner), personalWorkspaceID(t, ownerID), repositories.WorkspaceRoleAdmin),
+			"リストのメンバーでないユーザー": utils.WithWorkspace(utils.WithUser(ctx, other), wsID, repositories.WorkspaceRoleMember),
+		}
+		for name, pctx := range principals {
+			_, renameErr := repo.Rename(pctx, listID, "Hijacked")
+			deleteErr := repo.Delete(pctx, listID)
+			_, listMembersErr := repo.ListMembers(pctx, listID)
+			_, setRoleErr := repo.SetMemberRole(pctx, listID, editorID, repositories.TodoListRoleOwner)
+			removeErr := repo.RemoveMember(pctx, listID, editorID)
+			_, listInvitesErr := inviteRepo.ListPending(pctx, listID, time.Now())
+			revokeErr := inviteRepo.Revoke(pctx, listID, inviteID)
+
+			for _, err := range []error{renameErr, deleteErr, listMembersErr, setRoleErr, removeErr, listInvitesErr, revokeErr} {
+				assert.True(t, ent.IsNotFound(err), "%s: %v", name, err)
+			}
+		}
+		assert.Equal(t, "Household", testClient.TodoList.GetX(ctx, listID).Name)
+		m := testClient.TodoListMember.Query().Where(todolistmember.ListID(listID), todolistmember.UserID(editorID)).OnlyX(ctx)
+		assert.Equal(t, repositories.TodoListRoleEditor, m.Role)
+		assert.Nil(t, testClient.TodoListInvite.GetX(ctx, inviteID).UsedAt)
+
+		// ワークスペースのメンバーでないユーザーはリストに追加できない
+		_, err := repo.AddMember(ctx, listID, otherID, repositories.TodoListRoleViewer)
+		assert.True(t, ent.IsNotFound(err), err)
+	})
+}
+
+func TestTodoListHandler_UpdateTodo_Integration(t *testing.T) {
+	if os.Getenv("TEST_WITH_REAL_DB") == "" {
+		t.Skip("TEST_WITH_REAL_DB is not set. Skipping integration test that requires real DB (e.g. MySQL) for SELECT FOR UPDATE.")
+	}
+
+	t.Run("viewer はリストの Todo を更新できず、editor 以上は更新できること", func(t *testing.T) {
+		e, dir := setupTodoListTestApp(t)
+		createUserWithPassword(t, "owner@example.com", "password123")
+		ownerToken := login(t, e, "owner@example.com", "password123", "")
+		wsID := createWorkspace(t, e, ownerToken, "Acme")
+		listID := createTodoList(t, e, ownerToken, wsID, "Household")
+		viewerToken := shareTodoList(t, e, dir, ownerToken, wsID, listID, "viewer@example.com", repositories.TodoListRoleViewer)
+		editorToken := shareTodoList(t, e, dir, ownerToken, wsID, listID, "editor@example.com", repositories.TodoListRoleEditor)
+
+		rec := serveJSONWithToken(e, http.MethodPost, workspacePath(wsID, "/todo"), `{"title":"buy milk","description":"desc","list_id":`+strconv.Itoa(listID)+`}`, ownerToken)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		var created dto.TodoDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+
+		todoPath := workspacePath(wsID, "/todo/") + strconv.Itoa(created.ID)
+		assert.Equal(t, http.StatusForbidden, serveJSONWithToken(e, http.MethodPatch, todoPath, `{"title":"changed"}`, viewerToken).Code)
+		assert.Equal(t, http.StatusForbidden, serveJSONWithToken(e, http.MethodPut, todoPath+"/done", `{"is_done":true}`, viewerToken).Code)
+
+		rec = serveJSONWithToken(e, http.MethodPatch, todoPath, `{"title":"buy oat milk"}`, editorToken)
+		assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		rec = serveJSONWithToken(e, http.MethodPut, todoPath+"/done", `{"is_done":true}`, editorToken)
+		assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	})
+}
+
+func TestTodoListHandler_Todos_Integration(t *testing.T) {
+	t.Run("権限に応じてリストの Todo を閲覧・変更できること", func(t *testing.T) {
+		e, dir := setupTodoListTestApp(t)
+		ownerID := createUserWithPassword(t, "owner@example.com", "password123")
+		ownerToken := login(t, e, "owner@example.com", "password123", "")
+		wsID := createWorkspace(t, e, ownerToken, "Acme")
+		listID := createTodoList(t, e, ownerToken, wsID, "Household")
+		viewerToken := shareTodoList(t, e, dir, ownerToken, wsID, listID, "viewer@example.com", repositories.TodoListRoleViewer)
+		editorToken := shareTodoList(t, e, dir, ownerToken, wsID, listID, "editor@example.com", repositories.TodoListRoleEditor)
+		createUserWithPassword(t, "other@example.com", "password123")
+		otherToken := login(t, e, "other@example.com", "password123", "")
+		addWorkspaceMember(t, e, dir, ownerToken, wsID, "other@example.com", repositories.WorkspaceRoleMember)
+
+		// 所有者の個人の Todo はリストのメンバーにも見えない
+		testClient.Todo.Create().SetTitle("private").SetDescription("desc").SetUserID(ownerID).SetWorkspaceID(personalWorkspaceID(t, ownerID)).SaveX(context.Background())
+
+		body := `{"title":"buy milk","description":"desc","list_id":` + strconv.Itoa(listID) + `}`
+		assert.Equal(t, http.StatusForbidden, serveJSONWithToken(e, http.MethodPost, workspacePath(wsID, "/todo"), body, viewerToken).Code)
+		assert.Equal(t, http.StatusNotFound, serveJSONWithToken(e, http.MethodPost, workspacePath(wsID, "/todo"), body, otherToken).Code)
+		rec := serveJSONWithToken(e, http.MethodPost, workspacePath(wsID, "/todo"), body, editorToken)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		var created dto.TodoDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+		require.NotNil(t, created.ListID)
+		assert.Equal(t, listID, *created.ListID)
+
+		for _, token := range []string{ownerToken, viewerToken, editorToken} {
+			rec = serveWithToken(e, http.MethodGet, workspacePath(wsID, "/todo?list_id=")+strconv.Itoa(listID), token)
+			require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+			var res dto.ListTodoResponseDto
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+			require.Len(t, res.Data, 1)
+			assert.Equal(t, "buy milk", res.Data[0].Title)
+		}
+		rec = serveWithToken(e, http.MethodGet, workspacePath(wsID, "/todo"), viewerToken)
+		var res dto.ListTodoResponseDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		assert.Len(t, res.Data, 1)
+		rec = serveWithToken(e, http.MethodGet, workspacePath(wsID, "/todo?list_id=")+strconv.Itoa(listID), otherToken)
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		assert.Empty(t, res.Data)
+
+		todoPath := workspacePath(wsID, "/todo/") + strconv.Itoa(created.ID)
+		assert.Equal(t, http.StatusForbidden, serveWithToken(e, http.MethodDelete, todoPath, viewerToken).Code)
+		assert.Equal(t, http.StatusNoContent, serveWithToken(e, http.MethodDelete, todoPath, editorToken).Code)
+		assert.False(t, testClient.Todo.Query().Where(todo.ID(created.ID)).ExistX(context.Background()))
+	})
+
+	t.Run("リストから外れたメンバーは Todo にアクセスできず、リストを削除すると Todo も削除されること", func(t *testing.T) {
+		e, dir := setupTodoListTestApp(t)
+		createUserWithPassword(t, "owner@example.com", "password123")
+		ownerToken := login(t, e, "owner@example.com", "password123", "")
+		wsID := createWorkspace(t, e, ownerToken, "Acme")
+		listID := createTodoList(t, e, ownerToken, wsID, "Team")
+		editorToken := shareTodoList(t, e, dir, ownerToken, wsID, listID, "editor@example.com", repositories.TodoListRoleEditor)
+
+		rec := serveJSONWithToken(e, http.MethodPost, workspacePath(wsID, "/todo"), `{"title":"task","description":"desc","list_id":`+strconv.Itoa(listID)+`}`, editorToken)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		var created dto.TodoDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+
+		rec = serveWithToken(e, http.MethodGet, todoListPath(wsID, listID, ""), editorToken)
+		var detail dto.TodoListDetailDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &detail))
+		editorID := detail.Members[1].UserID
+		ownerID := detail.Members[0].UserID
+
+		// 最後の所有者は抜けることも、権限を下げることもできない
+		assert.Equal(t, http.StatusConflict, serveWithToken(e, http.MethodDelete, todoListPath(wsID, listID, "/members/"+strconv.Itoa(ownerID)), ownerToken).Code)
+		assert.Equal(t, http.StatusConflict, serveJSONWithToken(e, http.MethodPatch, todoListPath(wsID, listID, "/members/"+strconv.Itoa(ownerID)), `{"role":"editor"}`, ownerToken).Code)
+
+		// 所有者以外は他のメンバーを外せないが、自分は抜けられる
+		assert.Equal(t, http.StatusForbidden, serveWithToken(e, http.MethodDelete, todoListPath(wsID, listID, "/members/"+strconv.Itoa(ownerID)), editorToken).Code)
+		assert.Equal(t, http.StatusNoContent, serveWithToken(e, http.MethodDelete, todoListPath(wsID, listID, "/members/"+strconv.Itoa(editorID)), editorToken).Code)
+
+		// 作成したユーザーでも、リストから抜けた後はリストの Todo にアクセスできない
+		rec = serveWithToken(e, http.MethodGet, workspacePath(wsID, "/todo?list_id=")+strconv.Itoa(listID), editorToken)
+		var res dto.ListTodoResponseDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		assert.Empty(t, res.Data)
+		assert.Equal(t, http.StatusNotFound, serveWithToken(e, http.MethodGet, todoListPath(wsID, listID, ""), editorToken).Code)
+
+		assert.Equal(t, http.StatusNoContent, serveWithToken(e, http.MethodDelete, todoListPath(wsID, listID, ""), ownerToken).Code)
+		assert.False(t, testClient.Todo.Query().Where(todo.ID(created.ID)).ExistX(context.Background()))
+	})
+}
+
+func TestTodoListHandler_AccountDeletion_Integration(t *testing.T) {
+	// admin@example.com が作成したワークスペースで、owner@example.com が所有するリストを editor@example.com と共有する
+	setup := func(t *testing.T) (*echo.Echo, int, int, string, string) {
+		e, dir := setupTodoListTestApp(t)
+		createUserWithPassword(t, "admin@example.com", "password123")
+		adminToken := login(t, e, "admin@example.com", "password123", "")
+		wsID := createWorkspace(t, e, adminToken, "Acme")
+		createUserWithPassword(t, "owner@example.com", "password123")
+		createUserWithPassword(t, "editor@example.com", "password123")
+		addWorkspaceMember(t, e, dir, adminToken, wsID, "owner@example.com", repositories.WorkspaceRoleMember)
+		addWorkspaceMember(t, e, dir, adminToken, wsID, "editor@example.com", repositories.WorkspaceRoleMember)
+		ownerToken := login(t, e, "owner@example.com", "password123", "")
+		editorToken := login(t, e, "editor@example.com", "password123", "")
+
+		listID := createTodoList(t, e, ownerToken, wsID, "Household")
+		rec := serveJSONWithToken(e, http.MethodPost, todoListPath(wsID, listID, "/invites"), `{"email":"editor@example.com","role":"editor"}`, ownerToken)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		tokens := readResetTokens(t, dir)
+		rec = serveJSONWithToken(e, http.MethodPost, "/lists/invites/accept", `{"token":"`+tokens[len(tokens)-1]+`"}`, editorToken)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		return e, wsID, listID, ownerToken, editorToken
+	}
+
+	t.Run("退会したユーザーが共有のリストに作成した Todo は残し、他の Todo と 1 人だけのリストは削除すること", func(t *testing.T) {
+		e, wsID, listID, ownerToken, editorToken := setup(t)
+		ctx := context.Background()
+		soloListID := createTodoList(t, e, editorToken, wsID, "Solo")
+		rec := serveJSONWithToken(e, http.MethodPost, workspacePath(wsID, "/todo"), `{"title":"shared","description":"desc","list_id":`+strconv.Itoa(listID)+`}`, editorToken)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		var shared dto.TodoDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &shared))
+		rec = serveJSONWithToken(e, http.MethodPost, workspacePath(wsID, "/todo"), `{"title":"private","description":"desc"}`, editorToken)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		var private dto.TodoDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &private))
+
+		require.Equal(t, http.StatusAccepted, serveJSONWithToken(e, http.MethodDelete, "/me", `{"password":"password123"}`, editorToken).Code)
+		testClient.User.Update().Where(user.Email("editor@example.com")).SetDeletionScheduledAt(time.Now().Add(-time.Minute)).ExecX(ctx)
+		n, err := repositories.NewUserRepository(testClient).DeleteScheduled(ctx, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		// 作成したユーザーを未設定にして残す
+		assert.True(t, testClient.Todo.Query().Where(todo.ID(shared.ID), todo.UserIDIsNil()).ExistX(ctx))
+		assert.False(t, testClient.Todo.Query().Where(todo.ID(private.ID)).ExistX(ctx))
+		assert.False(t, testClient.TodoList.Query().Where(todolist.ID(soloListID)).ExistX(ctx))
+
+		rec = serveWithToken(e, http.MethodGet, workspacePath(wsID, "/todo?list_id=")+strconv.Itoa(listID), ownerToken)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var res dto.ListTodoResponseDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Len(t, res.Data, 1)
+		assert.Equal(t, "shared", res.Data[0].Title)
+	})
+
+	t.Run("他のメンバーがいるリストの唯一の所有者は、所有者を移すまで退会できないこと", func(t *testing.T) {
+		e, wsID, listID, ownerToken, _ := setup(t)
+
+		rec := serveJSONWithToken(e, http.MethodDelete, "/me", `{"password":"password123"}`, ownerToken)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		assert.Equal(t, http.StatusConflict, serveWithToken(e, http.MethodPost, "/me/deletion/request", ownerToken).Code)
+
+		editorID := testClient.User.Query().Where(user.Email("editor@example.com")).OnlyIDX(context.Background())
+		rec = serveJSONWithToken(e, http.MethodPatch, todoListPath(wsID, listID, "/members/"+strconv.Itoa(editorID)), `{"role":"owner"}`, ownerToken)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		rec = serveJSONWithToken(e, http.MethodDelete, "/me", `{"password":"password123"}`, ownerToken)
+		assert.Equal(t, http.StatusAccepted, rec.Code, rec.Body.String())
+	})
+
+	t.Run("申請後に唯一の所有者になった場合、所有者を移すまで削除しないこと", func(t *testing.T) {
+		e, wsID, listID, ownerToken, _ := setup(t)
+		ctx := context.Background()
+		ownerID := testClient.User.Query().Where(user.Email("owner@example.com")).OnlyIDX(ctx)
+		testClient.User.UpdateOneID(ownerID).SetDeletionScheduledAt(time.Now().Add(-time.Minute)).ExecX(ctx)
+		userRepo := repositories.NewUserRepository(testClient)
+
+		n, err := userRepo.DeleteScheduled(ctx, time.Now())
+		require.NoError(t, err)
+		assert.Zero(t, n)
+		assert.True(t, testClient.User.Query().Where(user.ID(ownerID)).ExistX(ctx))
+
+		editorID := testClient.User.Query().Where(user.Email("editor@example.com")).OnlyIDX(ctx)
+		rec := serveJSONWithToken(e, http.MethodPatch, todoListPath(wsID, listID, "/members/"+strconv.Itoa(editorID)), `{"role":"owner"}`, ownerToken)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		n, err = userRepo.DeleteScheduled(ctx, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.True(t, testClient.TodoList.Query().Where(todolist.ID(listID)).ExistX(ctx))
+	})
+}
@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strconv"
 	"testing"
 	"time"
 	"todo-app/di"
 	"todo-app/dto"
 	"todo-app/ent/todo"
+	"todo-app/ent/todosummary"
 	"todo-app/utils"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -62,6 +64,7 @@ func TestTodoHandler_ListTodo_Integration(t *testing.T) {
 				SetCreatedAt(d.CreatedAt).
 				SetUpdatedAt(d.CreatedAt).
 				SetUser(user).
+				SetWorkspaceID(personalWorkspaceID(t, user.ID)).
 				SaveX(context.Background())
 		}
 
@@ -102,6 +105,7 @@ func TestTodoHandler_ListTodo_IncludeDone_Integration(t *testing.T) {
 		SetTitle("Active Todo 1").
 		SetDescription("Desc").
 		SetUser(user).
+		SetWorkspaceID(personalWorkspaceID(t, user.ID)).
 		SaveX(context.Background())
 
 	// 2. Done Todo
@@ -110,6 +114,7 @@ func TestTodoHandler_ListTodo_IncludeDone_Integration(t *testing.T) {
 		SetDescription("Desc").
 		SetDoneAt(time.Now()).
 		SetUser(user).
+		SetWorkspaceID(personalWorkspaceID(t, user.ID)).
 		SaveX(context.Background())
 
 	// 3. Active Todo
@@ -117,6 +122,7 @@ func TestTodoHandler_ListTodo_IncludeDone_Integration(t *testing.T) {
 		SetTitle("Active Todo 2").
 		SetDescription("Desc").
 		SetUser(user).
+		SetWorkspaceID(personalWorkspaceID(t, user.ID)).
 		SaveX(context.Background())
 
 	t.Run("Default returns only active todos", func(t *testing.T) {
@@ -237,6 +243,7 @@ func TestTodoHandler_UpdateTodo_Integration(t *testing.T) {
 			SetTitle("Old Title").
 			SetDescription("Old Description").
 			SetUser(user).
+			SetWorkspaceID(personalWorkspaceID(t, user.ID)).
 			SaveX(context.Background())
 
 		body := `{"title": "Updated Title", "description": "Updated Description"}`
@@ -290,6 +297,7 @@ func TestTodoHandler_UpdateTodo_Integration(t *testing.T) {
 			SetTitle("User1 Todo").
 			SetDescription("User1 Description").
 			SetUser(user1).
+			SetWorkspaceID(personalWorkspaceID(t, user1.ID)).
 			SaveX(context.Background())
 
 		body := `{"title": "Updated Title"}`
@@ -314,6 +322,7 @@ func TestTodoHandler_UpdateTodo_Integration(t *testing.T) {
 			SetDescription("Description").
 			SetDoneAt(time.Now()).
 			SetUser(user).
+			SetWorkspaceID(personalWorkspaceID(t, user.ID)).
 			SaveX(context.Background())
 
 		body := `{"title": "Updated Title", "description": "Updated Description"}`
@@ -342,6 +351,7 @@ func TestTodoHandler_ListTodoFilterHistories_Integration(t *testing.T) {
 			testClient.TodoFilterHistory.Create().
 				SetQuery(fmt.Sprintf("Query %d", i)).
 				SetUserID(user.ID).
+				SetWorkspaceID(personalWorkspaceID(t, user.ID)).
 				SetCreatedAt(time.Now().Add(time.Duration(i) * time.Minute)).
 				SaveX(context.Background())
 		}
@@ -376,6 +386,7 @@ func TestTodoHandler_DeleteTodo_Integration(t *testing.T) {
 			SetTitle("To Be Deleted").
 			SetDescription("Description").
 			SetUser(user).
+			SetWorkspaceID(personalWorkspaceID(t, user.ID)).
 			SaveX(context.Background())
 
 		req, rec := createAuthenticatedRequest(t, http.MethodDelete, fmt.Sprintf("/todo/%d", todo.ID), "", user.ID)
@@ -419,6 +430,7 @@ func TestTodoHandler_DeleteTodo_Integration(t *testing.T) {
 			SetTitle("To Be Deleted").
 			SetDescription("Description").
 			SetUser(user1).
+			SetWorkspaceID(personalWorkspaceID(t, user1.ID)).
 			SaveX(context.Background())
 
 		req, rec := createAuthenticatedRequest(t, http.MethodDelete, fmt.Sprintf("/todo/%d", targetTodo.ID), "", user2.ID)
@@ -450,6 +462,7 @@ func TestTodoHandler_UpdateDoneStatus(t *testing.T) {
 			SetTitle("Todo").
 			SetDescription("Desc").
 			SetUser(user).
+			SetWorkspaceID(personalWorkspaceID(t, user.ID)).
 			SaveX(context.Background())
 
 		reqBody := map[string]interface{}{"is_done": true}
@@ -478,6 +491,7 @@ func TestTodoHandler_UpdateDoneStatus(t *testing.T) {
 			SetTitle("Todo").
 			SetDescription("Desc").
 			SetUser(user).
+			SetWorkspaceID(personalWorkspaceID(t, user.ID)).
 			SaveX(context.Background())
 
 		reqBody := map[string]interface{}{} // is_done missing
@@ -545,6 +559,7 @@ func TestTodoHandler_FilterTodosByQuery_Integration(t *testing.T) {
 			SetDescription("Matching AI filter").
 			SetDoneAt(time.Date(2026, 3, 1, 10, 0, 0, 0, time.Local)).
 			SetUser(user).
+			SetWorkspaceID(personalWorkspaceID(t, user.ID)).
 			SaveX(context.Background())
 
 		// GenAI クライアントをモック
@@ -582,17 +597,221 @@ func TestTodoHandler_FilterTodosByQuery_Integration(t *testing.T) {
 
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		var res []dto.TodoDto
+		var res dto.AIFilterResponseDto
 		err = json.Unmarshal(rec.Body.Bytes(), &res)
 		assert.NoError(t, err)
-		assert.Len(t, res, 1)
-		assert.Equal(t, "Target Todo", res[0].Title)
+		assert.Equal(t, dto.AIFilterStatusMatched, res.Status)
+		assert.Equal(t, "ListTodosByDoneAt", *res.FunctionName)
+		assert.Equal(t, "2026-03-01T00:00:00Z", res.Args["done_from"])
+		// 解釈した範囲はユーザーのタイムゾーン (既定は Asia/Tokyo) で返す
+		assert.Equal(t, "2026-03-02T08:59:59+09:00", res.ResolvedArgs["done_to"])
+		assert.Len(t, res.Todos, 1)
+		assert.Equal(t, "Target Todo", res.Todos[0].Title)
 
 		// 履歴が保存されているか確認
 		history, err := testClient.TodoFilterHistory.Query().First(context.Background())
 		assert.NoError(t, err)
 		assert.Equal(t, "done on March 1st", history.Query)
 		assert.Equal(t, "ListTodosByDoneAt", history.FunctionName)
+		assert.Equal(t, "v1.ja", history.PromptVersion)
+		assert.Equal(t, history.ID.String(), res.QueryID)
+
+		// 表記ゆれのある同じクエリは AI を呼び出さずに履歴の判定結果を使うこと
+		req, rec = createAuthenticatedRequest(t, http.MethodGet, "/todo/ai_filter?query=Done+on+March+1st.", "", user.ID)
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		err = json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.NoError(t, err)
+		assert.Len(t, res.Todos, 1)
+		mClient.AssertNumberOfCalls(t, "GenerateContent", 1)
+		assert.Equal(t, 2, testClient.TodoFilterHistory.Query().CountX(context.Background()))
+	})
+
+	t.Run("Tool が呼び出されなかった場合、モデルの回答を返すこと", func(t *testing.T) {
+		cleanupDatabase(t)
+		e := echo.New()
+
+		user := testClient.User.Create().SetName("test").SetEmail("test").SetPassword("test").SaveX(context.Background())
+
+		mClient := new(mockGenAIClient)
+		mFactory := new(mockAIFactory)
+		mFactory.On("GetGeminiClient", mock.Anything).Return(mClient, nil)
+		mClient.On("GenerateContent", mock.Anything, "gemini-3-flash-preview", mock.Anything, mock.Anything).Return(&genai.GenerateContentResponse{
+			Candidates: []*genai.Candidate{
+				{Content: &genai.Content{Parts: []*genai.Part{{Text: "対応できる Tool がありません"}}}},
+			},
+		}, nil)
+
+		app, err := di.InitializeTestApp(e, testClient, mFactory)
+		assert.NoError(t, err)
+		app.Router.Setup(e)
+
+		req, rec := createAuthenticatedRequest(t, http.MethodGet, "/todo/ai_filter?query=hello", "", user.ID)
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var res dto.AIFilterResponseDto
+		err = json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.NoError(t, err)
+		assert.Equal(t, dto.AIFilterStatusNoTool, res.Status)
+		assert.Nil(t, res.FunctionName)
+		assert.Equal(t, "対応できる Tool がありません", res.Text)
+		assert.Empty(t, res.Todos)
+	})
+
+	t.Run("Tool が呼び出されたが該当が無い場合、解釈した範囲を返すこと", func(t *testing.T) {
+		cleanupDatabase(t)
+		e := echo.New()
+
+		user := testClient.User.Create().SetName("test").SetEmail("test").SetPassword("test").SaveX(context.Background())
+
+		mClient := new(mockGenAIClient)
+		mFactory := new(mockAIFactory)
+		mFactory.On("GetGeminiClient", mock.Anything).Return(mClient, nil)
+		mClient.On("GenerateContent", mock.Anything, "gemini-3-flash-preview", mock.Anything, mock.Anything).Return(&genai.GenerateContentResponse{
+			Candidates: []*genai.Candidate{
+				{Content: &genai.Content{Parts: []*genai.Part{{FunctionCall: &genai.FunctionCall{
+					Name: "ListTodosByDoneAt",
+					Args: map[string]interface{}{"done_from": "2026-03-01T00:00:00Z"},
+				}}}}},
+			},
+		}, nil)
+
+		app, err := di.InitializeTestApp(e, testClient, mFactory)
+		assert.NoError(t, err)
+		app.Router.Setup(e)
+
+		req, rec := createAuthenticatedRequest(t, http.MethodGet, "/todo/ai_filter?query=done+since+March", "", user.ID)
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var res dto.AIFilterResponseDto
+		err = json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.NoError(t, err)
+		assert.Equal(t, dto.AIFilterStatusNoResults, res.Status)
+		assert.NotNil(t, res.ResolvedArgs["done_from"])
+		assert.Nil(t, res.ResolvedArgs["done_to"])
+		assert.Empty(t, res.Todos)
+	})
+}
+
+func TestTodoHandler_FilterTodosByQueryStream_Integration(t *testing.T) {
+	t.Run("途中経過のイベントを送信し、最後に結果を送信すること", func(t *testing.T) {
+		cleanupDatabase(t)
+		e := echo.New()
+
+		user := testClient.User.Create().SetName("test").SetEmail("test").SetPassword("test").SaveX(context.Background())
+		testClient.Todo.Create().
+			SetTitle("Target Todo").
+			SetDescription("Matching AI filter").
+			SetDoneAt(time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)).
+			SetUser(user).
+			SetWorkspaceID(personalWorkspaceID(t, user.ID)).
+			SaveX(context.Background())
+
+		mClient := new(mockGenAIClient)
+		mFactory := new(mockAIFactory)
+		mFactory.On("GetGeminiClient", mock.Anything).Return(mClient, nil)
+		mClient.On("GenerateContent", mock.Anything, "gemini-3-flash-preview", mock.Anything, mock.Anything).Return(&genai.GenerateContentResponse{
+			Candidates: []*genai.Candidate{
+				{Content: &genai.Content{Parts: []*genai.Part{{FunctionCall: &genai.FunctionCall{
+					Name: "ListTodosByDoneAt",
+					Args: map[string]interface{}{"done_from": "2026-03-01T00:00:00Z", "done_to": "2026-03-01T23:59:59Z"},
+				}}}}},
+			},
+		}, nil)
+
+		app, err := di.InitializeTestApp(e, testClient, mFactory)
+		assert.NoError(t, err)
+		app.Router.Setup(e)
+
+		req, rec := createAuthenticatedRequest(t, http.MethodGet, "/todo/ai_filter/stream?query=done+on+March+1st", "", user.ID)
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/event-stream", rec.Header().Get(echo.HeaderContentType))
+
+		events := parseSSEEvents(t, rec.Body.String())
+		assert.Equal(t, []string{"thinking", "tool_chosen", "tool_executed", "partial", "result"}, sseEventNames(events))
+
+		var chosen dto.AIStreamToolChosenDto
+		assert.NoError(t, json.Unmarshal([]byte(events[1].Data), &chosen))
+		assert.Equal(t, "ListTodosByDoneAt", chosen.FunctionName)
+		assert.NotNil(t, chosen.ResolvedArgs["done_from"])
+
+		var executed dto.AIStreamToolExecutedDto
+		assert.NoError(t, json.Unmarshal([]byte(events[2].Data), &executed))
+		assert.Equal(t, 1, executed.Count)
+
+		var res dto.AIFilterResponseDto
+		assert.NoError(t, json.Unmarshal([]byte(events[4].Data), &res))
+		assert.Equal(t, dto.AIFilterStatusMatched, res.Status)
+		assert.Len(t, res.Todos, 1)
+		assert.NotEmpty(t, res.QueryID)
+	})
+
+	t.Run("AI の呼び出しに失敗した場合、error イベントを送信すること", func(t *testing.T) {
+		cleanupDatabase(t)
+		e := echo.New()
+
+		user := testClient.User.Create().SetName("test").SetEmail("test").SetPassword("test").SaveX(context.Background())
+
+		mClient := new(mockGenAIClient)
+		mFactory := new(mockAIFactory)
+		mFactory.On("GetGeminiClient", mock.Anything).Return(mClient, nil)
+		mClient.On("GenerateContent", mock.Anything, "gemini-3-flash-preview", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("model unavailable"))
+
+		app, err := di.InitializeTestApp(e, testClient, mFactory)
+		assert.NoError(t, err)
+		app.Router.Setup(e)
+
+		req, rec := createAuthenticatedRequest(t, http.MethodGet, "/todo/ai_filter/stream?query=hello", "", user.ID)
+		e.ServeHTTP(rec, req)
+
+		events := parseSSEEvents(t, rec.Body.String())
+		assert.Equal(t, []string{"thinking", "error"}, sseEventNames(events))
+		assert.JSONEq(t, `{"error":"model unavailable","status":500}`, events[1].Data)
+		assert.Equal(t, 0, testClient.TodoFilterHistory.Query().CountX(context.Background()))
+	})
+
+	t.Run("クライアントが切断した場合、AI の呼び出しをキャンセルすること", func(t *testing.T) {
+		cleanupDatabase(t)
+		e := echo.New()
+
+		user := testClient.User.Create().SetName("test").SetEmail("test").SetPassword("test").SaveX(context.Background())
+
+		ctx, disconnect := context.WithCancel(context.Background())
+		modelCanceled := make(chan bool, 1)
+
+		mClient := new(mockGenAIClient)
+		mFactory := new(mockAIFactory)
+		mFactory.On("GetGeminiClient", mock.Anything).Return(mClient, nil)
+		mClient.On("GenerateContent", mock.Anything, "gemini-3-flash-preview", mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				disconnect()
+				select {
+				case <-args.Get(0).(context.Context).Done():
+					modelCanceled <- true
+				case <-time.After(time.Second):
+					modelCanceled <- false
+				}
+			}).
+			Return(nil, context.Canceled)
+
+		app, err := di.InitializeTestApp(e, testClient, mFactory)
+		assert.NoError(t, err)
+		app.Router.Setup(e)
+
+		req, rec := createAuthenticatedRequest(t, http.MethodGet, "/todo/ai_filter/stream?query=hello", "", user.ID)
+		e.ServeHTTP(rec, req.WithContext(ctx))
+
+		assert.True(t, <-modelCanceled)
+		events := parseSSEEvents(t, rec.Body.String())
+		assert.Equal(t, []string{"thinking"}, sseEventNames(events))
+		assert.Equal(t, 0, testClient.TodoFilterHistory.Query().CountX(context.Background()))
 	})
 }
 
@@ -611,12 +830,14 @@ func TestTodoHandler_FilterTodosByQueryID_Integration(t *testing.T) {
 			SetTitle("Todo 1").
 			SetDescription("Desc 1").
 			SetUser(user).
+			SetWorkspaceID(personalWorkspaceID(t, user.ID)).
 			SaveX(context.Background())
 
 		// 履歴を作成
 		history := testClient.TodoFilterHistory.Create().
 			SetQuery("my query").
 			SetUserID(user.ID).
+			SetWorkspaceID(personalWorkspaceID(t, user.ID)).
 			SetResultTodoIds([]int{todo1.ID}).
 			SaveX(context.Background())
 
@@ -662,3 +883,540 @@ func TestTodoHandler_FilterTodosByQueryID_Integration(t *testing.T) {
 		assert.Equal(t, http.StatusNotFound, rec.Code)
 	})
 }
+
+func TestTodoHandler_CreateTodosByAI_Integration(t *testing.T) {
+	newCreateTodosResponse := func(todos ...map[string]interface{}) *genai.GenerateContentResponse {
+		items := make([]interface{}, len(todos))
+		for i, t := range todos {
+			items[i] = t
+		}
+		return &genai.GenerateContentResponse{
+			Candidates: []*genai.Candidate{
+				{
+					Content: &genai.Content{
+						Parts: []*genai.Part{
+							{
+								FunctionCall: &genai.FunctionCall{
+									Name: "CreateTodos",
+									Args: map[string]interface{}{"todos": items},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	setup := func(t *testing.T, response *genai.GenerateContentResponse) (*echo.Echo, *mockGenAIClient) {
+		cleanupDatabase(t)
+		e := echo.New()
+
+		mClient := new(mockGenAIClient)
+		mFactory := new(mockAIFactory)
+		mFactory.On("GetGeminiClient", mock.Anything).Return(mClient, nil)
+		if response != nil {
+			mClient.On("GenerateContent", mock.Anything, "gemini-3-flash-preview", mock.Anything, mock.Anything).Return(response, nil)
+		}
+
+		app, err := di.InitializeTestApp(e, testClient, mFactory)
+		assert.NoError(t, err)
+		app.Router.Setup(e)
+		return e, mClient
+	}
+
+	t.Run("dry_run では下書きを返し、ToDo は作成しないこと", func(t *testing.T) {
+		e, _ := setup(t, newCreateTodosResponse(
+			map[string]interface{}{"title": "歯医者に電話する", "description": "2026-10-27"},
+			map[string]interface{}{"title": "パスポートを更新する", "description": "2026-10-31 まで"},
+		))
+		user := testClient.User.Create().SetName("test").SetEmail("test").SetPassword("test").SaveX(context.Background())
+
+		body := `{"text": "来週火曜に歯医者に電話して、月末までにパスポートを更新する", "mode": "dry_run"}`
+		req, rec := createAuthenticatedRequest(t, http.MethodPost, "/todo/ai_create", body, user.ID)
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var res dto.AICreateTodoResponseDto
+		err := json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.NoError(t, err)
+		assert.Equal(t, "dry_run", res.Mode)
+		assert.Len(t, res.Drafts, 2)
+		assert.Equal(t, "歯医者に電話する", res.Drafts[0].Title)
+		assert.Empty(t, res.Todos)
+
+		count := testClient.Todo.Query().CountX(context.Background())
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("dry_run で不正な下書きにはエラー内容を付与すること", func(t *testing.T) {
+		e, _ := setup(t, newCreateTodosResponse(
+			map[string]interface{}{"title": "", "description": "no title"},
+		))
+		user := testClient.User.Create().SetName("test").SetEmail("test").SetPassword("test").SaveX(context.Background())
+
+		body := `{"text": "something"}`
+		req, rec := createAuthenticatedRequest(t, http.MethodPost, "/todo/ai_create", body, user.ID)
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var res dto.AICreateTodoResponseDto
+		err := json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.NoError(t, err)
+		assert.Len(t, res.Drafts, 1)
+		assert.Equal(t, "titleは必須フィールドです", res.Drafts[0].Errors["title"])
+	})
+
+	t.Run("commit では ToDo を作成すること", func(t *testing.T) {
+		e, _ := setup(t, newCreateTodosResponse(
+			map[string]interface{}{"title": "歯医者に電話する", "description": "2026-10-27"},
+			map[string]interface{}{"title": "パスポートを更新する", "description": "2026-10-31 まで"},
+		))
+		user := testClient.User.Create().SetName("test").SetEmail("test").SetPassword("test").SaveX(context.Background())
+
+		body := `{"text": "来週火曜に歯医者に電話して、月末までにパスポートを更新する", "mode": "commit"}`
+		req, rec := createAuthenticatedRequest(t, http.MethodPost, "/todo/ai_create", body, user.ID)
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		var res dto.AICreateTodoResponseDto
+		err := json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.NoError(t, err)
+		assert.Equal(t, "commit", res.Mode)
+		assert.Len(t, res.Todos, 2)
+
+		count := testClient.Todo.Query().Where(todo.UserID(user.ID)).CountX(context.Background())
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("commit で確認済みの下書きを指定した場合、AI を呼び出さずに作成すること", func(t *testing.T) {
+		e, mClient := setup(t, nil)
+		user := testClient.User.Create().SetName("test").SetEmail("test").SetPassword("test").SaveX(context.Background())
+
+		body := `{"mode": "commit", "drafts": [{"title": "歯医者に電話する", "description": "2026-10-27"}]}`
+		req, rec := createAuthenticatedRequest(t, http.MethodPost, "/todo/ai_create", body, user.ID)
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		mClient.AssertNotCalled(t, "GenerateContent", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+		count := testClient.Todo.Query().CountX(context.Background())
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("commit で不正な下書きがある場合、何も作成せずバリデーションエラーを返すこと", func(t *testing.T) {
+		e, _ := setup(t, nil)
+		user := testClient.User.Create().SetName("test").SetEmail("test").SetPassword("test").SaveX(context.Background())
+
+		body := `{"mode": "commit", "drafts": [{"title": "ok"}, {"title": ""}]}`
+		req, rec := createAuthenticatedRequest(t, http.MethodPost, "/todo/ai_create", body, user.ID)
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.JSONEq(t, `{"error":{"drafts[1].title":"titleは必須フィールドです"}}`, rec.Body.String())
+
+		count := testClient.Todo.Query().CountX(context.Background())
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("text も drafts も無い場合、バリデーションエラーを返すこと", func(t *testing.T) {
+		e, _ := setup(t, nil)
+		user := testClient.User.Create().SetName("test").SetEmail("test").SetPassword("test").SaveX(context.Background())
+
+		req, rec := createAuthenticatedRequest(t, http.MethodPost, "/todo/ai_create", `{"mode": "commit"}`, user.ID)
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.JSONEq(t, `{"error":{"text":"textは必須フィールドです"}}`, rec.Body.String())
+	})
+}
+
+func TestTodoHandler_CreateTodosByAIStream_Integration(t *testing.T) {
+	setup := func(t *testing.T, response *genai.GenerateContentResponse) *echo.Echo {
+		cleanupDatabase(t)
+		e := echo.New()
+
+		mClient := new(mockGenAIClient)
+		mFactory := new(mockAIFactory)
+		mFactory.On("GetGeminiClient", mock.Anything).Return(mClient, nil)
+		if response != nil {
+			mClient.On("GenerateContent", mock.Anything, "gemini-3-flash-preview", mock.Anything, mock.Anything).Return(response, nil)
+		}
+
+		app, err := di.InitializeTestApp(e, testClient, mFactory)
+		assert.NoError(t, err)
+		app.Router.Setup(e)
+		return e
+	}
+
+	t.Run("commit では下書きと作成した ToDo を順に送信すること", func(t *testing.T) {
+		e := setup(t, &genai.GenerateContentResponse{
+			Candidates: []*genai.Candidate{
+				{Content: &genai.Content{Parts: []*genai.Part{{FunctionCall: &genai.FunctionCall{
+					Name: "CreateTodos",
+					Args: map[string]interface{}{"todos": []interface{}{
+						map[string]interface{}{"title": "歯医者に電話する", "description": "2026-10-27"},
+					}},
+				}}}}},
+			},
+		})
+		user := testClient.User.Create().SetName("test").SetEmail("test").SetPassword("test").SaveX(context.Background())
+
+		body := `{"text": "来週火曜に歯医者に電話する", "mode": "commit"}`
+		req, rec := createAuthenticatedRequest(t, http.MethodPost, "/todo/ai_create/stream", body, user.ID)
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		events := parseSSEEvents(t, rec.Body.String())
+		assert.Equal(t, []string{"thinking", "partial", "tool_executed", "partial", "result"}, sseEventNames(events))
+
+		var drafts dto.AIStreamPartialDto
+		assert.NoError(t, json.Unmarshal([]byte(events[1].Data), &drafts))
+		assert.Len(t, drafts.Drafts, 1)
+		assert.Equal(t, "歯医者に電話する", drafts.Drafts[0].Title)
+
+		var res dto.AICreateTodoResponseDto
+		assert.NoError(t, json.Unmarshal([]byte(events[4].Data), &res))
+		assert.Equal(t, "commit", res.Mode)
+		assert.Len(t, res.Todos, 1)
+		assert.Equal(t, 1, testClient.Todo.Query().Where(todo.UserID(user.ID)).CountX(context.Background()))
+	})
+
+	t.Run("commit で不正な下書きがある場合、error イベントでバリデーションエラーを送信すること", func(t *testing.T) {
+		e := setup(t, nil)
+		user := testClient.User.Create().SetName("test").SetEmail("test").SetPassword("test").SaveX(context.Background())
+
+		body := `{"mode": "commit", "drafts": [{"title": "ok"}, {"title": ""}]}`
+		req, rec := createAuthenticatedRequest(t, http.MethodPost, "/todo/ai_create/stream", body, user.ID)
+		e.ServeHTTP(rec, req)
+
+		events := parseSSEEvents(t, rec.Body.String())
+		assert.Equal(t, []string{"partial", "error"}, sseEventNames(events))
+		assert.JSONEq(t, `{"error":{"drafts[1].title":"titleは必須フィールドです"},"status":400}`, events[1].Data)
+		assert.Equal(t, 0, testClient.Todo.Query().CountX(context.Background()))
+	})
+
+	t.Run("リクエストが不正な場合、ストリームを開始せずにバリデーションエラーを返すこと", func(t *testing.T) {
+		e := setup(t, nil)
+		user := testClient.User.Create().SetName("test").SetEmail("test").SetPassword("test").SaveX(context.Background())
+
+		req, rec := createAuthenticatedRequest(t, http.MethodPost, "/todo/ai_create/stream", `{"mode": "commit"}`, user.ID)
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.JSONEq(t, `{"error":{"text":"textは必須フィールドです"}}`, rec.Body.String())
+	})
+}
+
+func TestTodoHandler_TodoBreakdown_Integration(t *testing.T) {
+	breakdownResponse := &genai.GenerateContentResponse{
+		Candidates: []*genai.Candidate{
+			{
+				Content: &genai.Content{
+					Parts: []*genai.Part{
+						{Text: `{"steps":[{"title":"見積もりを取る","description":"3社に依頼"},{"title":"荷造りする","description":"段ボールを用意"},{"title":"住所変更する","description":"役所で手続き"}]}`},
+					},
+				},
+			},
+		},
+	}
+
+	setup := func(t *testing.T) *echo.Echo {
+		cleanupDatabase(t)
+		e := echo.New()
+
+		mClient := new(mockGenAIClient)
+		mFactory := new(mockAIFactory)
+		mFactory.On("GetGeminiClient", mock.Anything).Return(mClient, nil)
+		mClient.On("GenerateContent", mock.Anything, "gemini-3-flash-preview", mock.Anything, mock.Anything).Return(breakdownResponse, nil)
+
+		app, err := di.InitializeTestApp(e, testClient, mFactory)
+		assert.NoError(t, err)
+		app.Router.Setup(e)
+		return e
+	}
+
+	t.Run("分解結果を記録し、一部の手順のみ子 ToDo として採用できること", func(t *testing.T) {
+		e := setup(t)
+		user := testClient.User.Create().SetName("test").SetEmail("test").SetPassword("test").SaveX(context.Background())
+		parent := testClient.Todo.Create().SetTitle("引っ越し").SetDescription("来月末").SetUser(user).SetWorkspaceID(personalWorkspaceID(t, user.ID)).SaveX(context.Background())
+
+		req, rec := createAuthenticatedRequest(t, http.MethodPost, fmt.Sprintf("/todo/%d/ai_breakdown", parent.ID), "", user.ID)
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		var breakdown dto.TodoBreakdownDto
+		err := json.Unmarshal(rec.Body.Bytes(), &breakdown)
+		assert.NoError(t, err)
+		assert.Equal(t, parent.ID, breakdown.TodoID)
+		assert.Len(t, breakdown.Steps, 3)
+		assert.Empty(t, breakdown.AcceptedStepIndexes)
+
+		// 生成結果が記録されていること
+		assert.Equal(t, 1, testClient.TodoBreakdown.Query().CountX(context.Background()))
+
+		body := `{"step_indexes": [0, 2]}`
+		req, rec = createAuthenticatedRequest(t, http.MethodPost, fmt.Sprintf("/todo/%d/ai_breakdown/%s/accept", parent.ID, breakdown.ID), body, user.ID)
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		var res dto.AcceptTodoBreakdownResponseDto
+		err = json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{0, 2}, res.Breakdown.AcceptedStepIndexes)
+		assert.NotNil(t, res.Breakdown.AcceptedAt)
+		assert.Len(t, res.Todos, 2)
+		assert.Equal(t, "見積もりを取る", res.Todos[0].Title)
+		assert.Equal(t, "住所変更する", res.Todos[1].Title)
+		assert.Equal(t, parent.ID, *res.Todos[0].ParentID)
+
+		children := testClient.Todo.Query().Where(todo.ParentID(parent.ID)).CountX(context.Background())
+		assert.Equal(t, 2, children)
+
+		// 2回目の採用は競合とする
+		req, rec = createAuthenticatedRequest(t, http.MethodPost, fmt.Sprintf("/todo/%d/ai_breakdown/%s/accept", parent.ID, breakdown.ID), body, user.ID)
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("範囲外の手順を指定した場合", func(t *testing.T) {
+		e := setup(t)
+		user := testClient.User.Create().SetName("test").SetEmail("test").SetPassword("test").SaveX(context.Background())
+		parent := testClient.Todo.Create().SetTitle("引っ越し").SetDescription("来月末").SetUser(user).SetWorkspaceID(personalWorkspaceID(t, user.ID)).SaveX(context.Background())
+
+		req, rec := createAuthenticatedRequest(t, http.MethodPost, fmt.Sprintf("/todo/%d/ai_breakdown", parent.ID), "", user.ID)
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		var breakdown dto.TodoBreakdownDto
+		_ = json.Unmarshal(rec.Body.Bytes(), &breakdown)
+
+		req, rec = createAuthenticatedRequest(t, http.MethodPost, fmt.Sprintf("/todo/%d/ai_breakdown/%s/accept", parent.ID, breakdown.ID), `{"step_indexes": [5]}`, user.ID)
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, 0, testClient.Todo.Query().Where(todo.ParentID(parent.ID)).CountX(context.Background()))
+	})
+
+	t.Run("他人の ToDo は分解できないこと", func(t *testing.T) {
+		e := setup(t)
+		user1 := testClient.User.Create().SetName("user1").SetEmail("user1").SetPassword("user1").SaveX(context.Background())
+		user2 := testClient.User.Create().SetName("user2").SetEmail("user2").SetPassword("user2").SaveX(context.Background())
+		parent := testClient.Todo.Create().SetTitle("引っ越し").SetDescription("来月末").SetUser(user1).SetWorkspaceID(personalWorkspaceID(t, user1.ID)).SaveX(context.Background())
+
+		req, rec := createAuthenticatedRequest(t, http.MethodPost, fmt.Sprintf("/todo/%d/ai_breakdown", parent.ID), "", user2.ID)
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestTodoHandler_SemanticSearchTodos_Integration(t *testing.T) {
+	setup := func(t *testing.T) *echo.Echo {
+		cleanupDatabase(t)
+		e := echo.New()
+
+		app, err := di.InitializeTestApp(e, testClient, new(mockAIFactory))
+		assert.NoError(t, err)
+		app.Router.Setup(e)
+		return e
+	}
+
+	t.Run("作成した ToDo をベクトル化し、意味の近い順に返すこと", func(t *testing.T) {
+		e := setup(t)
+		user := testClient.User.Create().SetName("test").SetEmail("test").SetPassword("test").SaveX(context.Background())
+		other := testClient.User.Create().SetName("other").SetEmail("other").SetPassword("other").SaveX(context.Background())
+
+		for _, body := range []string{
+			`{"title": "スーパーで牛乳を買う", "description": "低脂肪のもの"}`,
+			`{"title": "請求書を支払う", "description": "電気代"}`,
+		} {
+			req, rec := createAuthenticatedRequest(t, http.MethodPost, "/todo", body, user.ID)
+			e.ServeHTTP(rec, req)
+			assert.Equal(t, http.StatusCreated, rec.Code)
+		}
+		req, rec := createAuthenticatedRequest(t, http.MethodPost, "/todo", `{"title": "牛乳を買う", "description": "他のユーザー"}`, other.ID)
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		// ベクトル化はバックグラウンドで行う
+		assert.Eventually(t, func() bool {
+			return testClient.TodoEmbedding.Query().CountX(context.Background()) == 3
+		}, 5*time.Second, 10*time.Millisecond)
+
+		req, rec = createAuthenticatedRequest(t, http.MethodGet, "/todo/semantic_search?q=牛乳の買い物", "", user.ID)
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var res dto.SemanticSearchResponseDto
+		err := json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.NoError(t, err)
+		assert.Equal(t, "牛乳の買い物", res.Query)
+		if assert.NotEmpty(t, res.Results) {
+			assert.Equal(t, "スーパーで牛乳を買う", res.Results[0].Todo.Title)
+			assert.Greater(t, res.Results[0].Score, 0.0)
+		}
+		// 他のユーザーの ToDo は含まない
+		for _, r := range res.Results {
+			assert.NotEqual(t, "牛乳を買う", r.Todo.Title)
+		}
+	})
+
+	t.Run("ベクトルが無い ToDo は検索対象から除き、バックグラウンドでベクトル化すること", func(t *testing.T) {
+		e := setup(t)
+		user := testClient.User.Create().SetName("test").SetEmail("test").SetPassword("test").SaveX(context.Background())
+		testClient.Todo.Create().SetTitle("牛乳を買う").SetDescription("低脂肪").SetUser(user).SetWorkspaceID(personalWorkspaceID(t, user.ID)).SaveX(context.Background())
+
+		req, rec := createAuthenticatedRequest(t, http.MethodGet, "/todo/semantic_search?q=牛乳", "", user.ID)
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var res dto.SemanticSearchResponseDto
+		err := json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.NoError(t, err)
+		assert.Empty(t, res.Results)
+		assert.Eventually(t, func() bool {
+			return testClient.TodoEmbedding.Query().CountX(context.Background()) == 1
+		}, 5*time.Second, 10*time.Millisecond)
+
+		req, rec = createAuthenticatedRequest(t, http.MethodGet, "/todo/semantic_search?q=牛乳", "", user.ID)
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		err = json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.NoError(t, err)
+		assert.Len(t, res.Results, 1)
+	})
+
+	t.Run("ai スコープの無いパーソナルアクセストークンで作成した ToDo はベクトル化しないこと", func(t *testing.T) {
+		e := setup(t)
+		user := testClient.User.Create().SetName("test").SetEmail("test").SetPassword("test").SaveX(context.Background())
+		pat := createPersonalAccessToken(t, e, user.ID, `{"name": "cli", "scopes": ["read", "write"]}`)
+
+		rec := serveWithBearer(e, http.MethodPost, "/todo", `{"title": "牛乳を買う", "description": "低脂肪"}`, pat.Token)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		assert.Never(t, func() bool {
+			return testClient.TodoEmbedding.Query().CountX(context.Background()) > 0
+		}, 100*time.Millisecond, 10*time.Millisecond)
+	})
+
+	t.Run("クエリが無い場合、バリデーションエラーを返すこと", func(t *testing.T) {
+		e := setup(t)
+		user := testClient.User.Create().SetName("test").SetEmail("test").SetPassword("test").SaveX(context.Background())
+
+		req, rec := createAuthenticatedRequest(t, http.MethodGet, "/todo/semantic_search", "", user.ID)
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.JSONEq(t, `{"error":{"q":"qは必須フィールドです"}}`, rec.Body.String())
+	})
+}
+
+func TestTodoHandler_SummarizeTodosByAI_Integration(t *testing.T) {
+	summaryResponse := &genai.GenerateContentResponse{
+		Candidates: []*genai.Candidate{
+			{
+				Content: &genai.Content{
+					Parts: []*genai.Part{
+						{Text: `{"overview":"資料作成を進めた","highlights":["週次報告を提出"],"sections":[{"heading":"ドキュメント","summary":"資料を作成","todo_ids":[]}]}`},
+					},
+				},
+			},
+		},
+	}
+
+	setup := func(t *testing.T) (*echo.Echo, *mockGenAIClient) {
+		cleanupDatabase(t)
+		e := echo.New()
+
+		mClient := new(mockGenAIClient)
+		mFactory := new(mockAIFactory)
+		mFactory.On("GetGeminiClient", mock.Anything).Return(mClient, nil)
+		mClient.On("GenerateContent", mock.Anything, "gemini-3-flash-preview", mock.Anything, mock.Anything).Return(summaryResponse, nil)
+
+		app, err := di.InitializeTestApp(e, testClient, mFactory)
+		assert.NoError(t, err)
+		app.Router.Setup(e)
+		return e, mClient
+	}
+
+	t.Run("要約を返し、同じ期間の再取得ではキャッシュを返すこと", func(t *testing.T) {
+		e, mClient := setup(t)
+		user := testClient.User.Create().SetName("test").SetEmail("test").SetPassword("test").SaveX(context.Background())
+		doneAt := time.Date(2026, 10, 14, 10, 0, 0, 0, time.UTC)
+		testClient.Todo.Create().SetTitle("資料を作る").SetDescription("週次報告").SetDoneAt(doneAt).SetUser(user).SetWorkspaceID(personalWorkspaceID(t, user.ID)).SaveX(context.Background())
+
+		path := "/todo/ai_summary?from=2026-10-12T00:00:00Z&to=2026-10-18T23:59:59Z"
+		req, rec := createAuthenticatedRequest(t, http.MethodGet, path, "", user.ID)
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var res dto.TodoSummaryResponseDto
+		err := json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.NoError(t, err)
+		assert.False(t, res.Cached)
+		assert.Equal(t, 1, res.TodoCount)
+		assert.Equal(t, "資料作成を進めた", res.Overview)
+		assert.Contains(t, res.Markdown, "### ハイライト")
+
+		req, rec = createAuthenticatedRequest(t, http.MethodGet, path, "", user.ID)
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		err = json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.NoError(t, err)
+		assert.True(t, res.Cached)
+		mClient.AssertNumberOfCalls(t, "GenerateContent", 1)
+	})
+
+	t.Run("別のワークスペースでは同じ期間でもキャッシュを共有しないこと", func(t *testing.T) {
+		e, mClient := setup(t)
+		user := testClient.User.Create().SetName("test").SetEmail("test").SetPassword("test").SaveX(context.Background())
+		token := createToken(t, user.ID)
+		teamID := createWorkspace(t, e, token, "Acme")
+		doneAt := time.Date(2026, 10, 14, 10, 0, 0, 0, time.UTC)
+		testClient.Todo.Create().SetTitle("資料を作る").SetDescription("週次報告").SetDoneAt(doneAt).SetUser(user).SetWorkspaceID(personalWorkspaceID(t, user.ID)).SaveX(context.Background())
+		testClient.Todo.Create().SetTitle("資料を作る").SetDescription("週次報告").SetDoneAt(doneAt).SetUser(user).SetWorkspaceID(teamID).SaveX(context.Background())
+
+		path := "/todo/ai_summary?from=2026-10-12T00:00:00Z&to=2026-10-18T23:59:59Z"
+		rec := serveWithToken(e, http.MethodGet, path, token)
+		assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		rec = serveWithWorkspaceHeader(e, http.MethodGet, path, token, strconv.Itoa(teamID))
+
+		assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var res dto.TodoSummaryResponseDto
+		err := json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.NoError(t, err)
+		assert.False(t, res.Cached)
+		mClient.AssertNumberOfCalls(t, "GenerateContent", 2)
+		assert.Equal(t, 1, testClient.TodoSummary.Query().Where(todosummary.WorkspaceID(teamID)).CountX(context.Background()))
+	})
+
+	t.Run("期間が指定されていない場合、バリデーションエラーを返すこと", func(t *testing.T) {
+		e, _ := setup(t)
+		user := testClient.User.Create().SetName("test").SetEmail("test").SetPassword("test").SaveX(context.Background())
+
+		req, rec := createAuthenticatedRequest(t, http.MethodGet, "/todo/ai_summary?to=2026-10-18", "", user.ID)
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.JSONEq(t, `{"error":{"from":"fromは必須フィールドです"}}`, rec.Body.String())
+	})
+
+	t.Run("from が to より後の場合、バリデーションエラーを返すこと", func(t *testing.T) {
+		e, _ := setup(t)
+		user := testClient.User.Create().SetName("test").SetEmail("test").SetPassword("test").SaveX(context.Background())
+
+		req, rec := createAuthenticatedRequest(t, http.MethodGet, "/todo/ai_summary?from=2026-10-19&to=2026-10-18", "", user.ID)
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
@@ -0,0 +1,269 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"todo-app/app_errors"
+	"todo-app/ent"
+	"todo-app/services"
+	"todo-app/utils"
+	"todo-app/validators"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+)
+
+type WorkspaceHandler struct {
+	logger  *slog.Logger
+	service *services.WorkspaceService
+}
+
+func NewWorkspaceHandler(logger *slog.Logger, service *services.WorkspaceService) *WorkspaceHandler {
+	return &WorkspaceHandler{logger: logger, service: service}
+}
+
+// ListWorkspaces はログイン中のユーザーが参加しているワークスペースを、自分の権限と合わせて返す
+func (h *WorkspaceHandler) ListWorkspaces(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	res, err := h.service.List(c.Request().Context())
+	if err != nil {
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// CreateWorkspace はチームのワークスペースを作成する。作成したユーザーが管理者になる
+func (h *WorkspaceHandler) CreateWorkspace(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	var req validators.CreateWorkspaceRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	res, err := h.service.Create(c.Request().Context(), req.Name)
+	if err != nil {
+		return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusCreated, res)
+}
+
+// GetWorkspace はワークスペースとメンバーの一覧を返す
+func (h *WorkspaceHandler) GetWorkspace(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	id, err := echo.PathParam[int](c, "id")
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid idParam"), http.StatusBadRequest)
+	}
+
+	res, err := h.service.Get(c.Request().Context(), id)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (h *WorkspaceHandler) UpdateWorkspace(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	id, err := echo.PathParam[int](c, "id")
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid idParam"), http.StatusBadRequest)
+	}
+
+	var req validators.UpdateWorkspaceRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	res, err := h.service.Rename(c.Request().Context(), id, req.Name)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// DeleteWorkspace はワークスペースを、ワークスペースの Todo やリストと合わせて削除する
+func (h *WorkspaceHandler) DeleteWorkspace(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	id, err := echo.PathParam[int](c, "id")
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid idParam"), http.StatusBadRequest)
+	}
+
+	if err := h.service.Delete(c.Request().Context(), id); err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// InviteMember はメールアドレス宛てにワークスペースへの招待を送信する
+func (h *WorkspaceHandler) InviteMember(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	id, err := echo.PathParam[int](c, "id")
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid idParam"), http.StatusBadRequest)
+	}
+
+	var req validators.InviteWorkspaceMemberRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	res, err := h.service.Invite(c.Request().Context(), id, req.Email, req.Role)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, res)
+}
+
+func (h *WorkspaceHandler) ListInvites(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	id, err := echo.PathParam[int](c, "id")
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid idParam"), http.StatusBadRequest)
+	}
+
+	res, err := h.service.ListInvites(c.Request().Context(), id)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (h *WorkspaceHandler) RevokeInvite(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	id, err := echo.PathParam[int](c, "id")
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid idParam"), http.StatusBadRequest)
+	}
+	inviteID, err := uuid.Parse(c.Param("invite_id"))
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid invite_id"), http.StatusBadRequest)
+	}
+
+	if err := h.service.RevokeInvite(c.Request().Context(), id, inviteID); err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// AcceptInvite は招待のメールのトークンで、ログイン中のユーザーをワークスペースのメンバーにする
+func (h *WorkspaceHandler) AcceptInvite(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	var req validators.AcceptWorkspaceInviteRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	res, err := h.service.AcceptInvite(c.Request().Context(), req.Token)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (h *WorkspaceHandler) UpdateMember(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	id, err := echo.PathParam[int](c, "id")
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid idParam"), http.StatusBadRequest)
+	}
+	userID, err := echo.PathParam[int](c, "user_id")
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid user_id"), http.StatusBadRequest)
+	}
+
+	var req validators.UpdateWorkspaceMemberRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid request"), http.StatusBadRequest)
+	}
+
+	if errs := req.Validate(); errs != nil {
+		h.logger.Error("validation error", slog.Any("errors", errs))
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": errs})
+	}
+
+	res, err := h.service.UpdateMemberRole(c.Request().Context(), id, userID, req.Role)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// RemoveMember はメンバーをワークスペースから外す。自分の user_id を指定するとワークスペースから抜ける
+func (h *WorkspaceHandler) RemoveMember(c *echo.Context) error {
+	utils.LogRequest(h.logger, c)
+
+	id, err := echo.PathParam[int](c, "id")
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid idParam"), http.StatusBadRequest)
+	}
+	userID, err := echo.PathParam[int](c, "user_id")
+	if err != nil {
+		return utils.HandleError(h.logger, c, errors.New("invalid user_id"), http.StatusBadRequest)
+	}
+
+	if err := h.service.RemoveMember(c.Request().Context(), id, userID); err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// handleError はワークスペースの操作のエラーをステータスコードに変換する。
+// メンバーでないワークスペースは存在を知られないよう 404 を返す
+func (h *WorkspaceHandler) handleError(c *echo.Context, err error) error {
+	if ent.IsNotFound(err) {
+		return utils.HandleError(h.logger, c, errors.New("not found"), http.StatusNotFound)
+	}
+	if errors.Is(err, app_errors.ErrWorkspacePermissionDenied) || errors.Is(err, app_errors.ErrWorkspaceInviteEmailMismatch) {
+		return utils.HandleError(h.logger, c, err, http.StatusForbidden)
+	}
+	if errors.Is(err, app_errors.ErrLastWorkspaceAdmin) || errors.Is(err, app_errors.ErrAlreadyWorkspaceMember) {
+		return utils.HandleError(h.logger, c, err, http.StatusConflict)
+	}
+	if errors.Is(err, app_errors.ErrPersonalWorkspace) || errors.Is(err, app_errors.ErrInvalidWorkspaceInvite) {
+		return utils.HandleError(h.logger, c, err, http.StatusBadRequest)
+	}
+	return utils.HandleError(h.logger, c, err, http.StatusInternalServerError)
+}
@@ -0,0 +1,365 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+	"todo-app/dto"
+	"todo-app/ent"
+	"todo-app/ent/todo"
+	"todo-app/ent/user"
+	"todo-app/ent/workspace"
+	"todo-app/ent/workspaceinvite"
+	"todo-app/ent/workspacemember"
+	"todo-app/repositories"
+	"todo-app/utils"
+
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createWorkspace(t *testing.T, e *echo.Echo, token string, name string) int {
+	rec := serveJSONWithToken(e, http.MethodPost, "/workspaces", `{"name":"`+name+`"}`, token)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var res dto.WorkspaceDto
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	return res.ID
+}
+
+// workspacePath はワークスペースをパスで指定する形式のパスを返す
+func workspacePath(workspaceID int, rest string) string {
+	return "/workspaces/" + strconv.Itoa(workspaceID) + rest
+}
+
+// setupWorkspaceTestApp は招待のメールを dir に保存するようにしてアプリを起動し、dir と合わせて返す
+func setupWorkspaceTestApp(t *testing.T) (*echo.Echo, string) {
+	dir := t.TempDir()
+	t.Setenv("MAIL_DRIVER", "log")
+	t.Setenv("MAIL_DIR", dir)
+	t.Setenv("WORKSPACE_INVITE_URL", "http://localhost:3000/accept-workspace-invite")
+	return setupAuthTestApp(t), dir
+}
+
+// addWorkspaceMember はワークスペースに招待し、招待されたユーザーが承諾してメンバーになる。
+// 招待されるユーザーは password123 のパスワードで作成しておく
+func addWorkspaceMember(t *testing.T, e *echo.Echo, dir string, adminToken string, workspaceID int, email string, role string) {
+	rec := serveJSONWithToken(e, http.MethodPost, workspacePath(workspaceID, "/invites"), `{"email":"`+email+`","role":"`+role+`"}`, adminToken)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+	tokens := readResetTokens(t, dir)
+	require.NotEmpty(t, tokens)
+	token := login(t, e, email, "password123", "")
+	rec = serveJSONWithToken(e, http.MethodPost, "/workspaces/invites/accept", `{"token":"`+tokens[len(tokens)-1]+`"}`, token)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+}
+
+// serveWithWorkspaceHeader は X-Workspace-ID でワークスペースを指定してリクエストする
+func serveWithWorkspaceHeader(e *echo.Echo, method string, target string, token string, workspaceID string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, nil)
+	req.Header.Set("X-Workspace-ID", workspaceID)
+	req.AddCookie(&http.Cookie{Name: "token", Value: token})
+	csrf := avoidCSRF{}
+	csrf.SetCSRFCookie(req)
+	csrf.SetCSRFHeader(req)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func listTodoTitles(t *testing.T, rec *httptest.ResponseRecorder) []string {
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var res dto.ListTodoResponseDto
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	titles := make([]string, 0, len(res.Data))
+	for _, d := range res.Data {
+		titles = append(titles, d.Title)
+	}
+	return titles
+}
+
+func TestWorkspaceHandler_Isolation_Integration(t *testing.T) {
+	t.Run("ワークスペースの Todo は他のワークスペースから見えず、ヘッダーでもパスでも切り替えられること", func(t *testing.T) {
+		e, dir := setupWorkspaceTestApp(t)
+		createUserWithPassword(t, "admin@example.com", "password123")
+		adminToken := login(t, e, "admin@example.com", "password123", "")
+		createUserWithPassword(t, "member@example.com", "password123")
+		memberToken := login(t, e, "member@example.com", "password123", "")
+
+		wsID := createWorkspace(t, e, adminToken, "Acme")
+		addWorkspaceMember(t, e, dir, adminToken, wsID, "member@example.com", repositories.WorkspaceRoleMember)
+
+		rec := serveJSONWithToken(e, http.MethodPost, "/todo", `{"title":"personal","description":"desc"}`, adminToken)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		rec = serveJSONWithToken(e, http.MethodPost, workspacePath(wsID, "/todo"), `{"title":"team","description":"desc"}`, adminToken)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+		assert.Equal(t, []string{"personal"}, listTodoTitles(t, serveWithToken(e, http.MethodGet, "/todo", adminToken)))
+		assert.Equal(t, []string{"team"}, listTodoTitles(t, serveWithToken(e, http.MethodGet, workspacePath(wsID, "/todo"), adminToken)))
+		assert.Equal(t, []string{"team"}, listTodoTitles(t, serveWithWorkspaceHeader(e, http.MethodGet, "/todo", adminToken, strconv.Itoa(wsID))))
+
+		// リストに属さない Todo は同じワークスペースのメンバーにも見えない
+		assert.Empty(t, listTodoTitles(t, serveWithToken(e, http.MethodGet, workspacePath(wsID, "/todo"), memberToken)))
+
+		rec = serveWithToken(e, http.MethodGet, "/workspaces", memberToken)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var list dto.ListWorkspacesResponseDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+		require.Len(t, list.Data, 2)
+		roles := map[string]string{}
+		for _, ws := range list.Data {
+			if ws.Personal {
+				roles["personal"] = ws.Role
+			} else {
+				roles[ws.Name] = ws.Role
+			}
+		}
+		assert.Equal(t, map[string]string{"personal": repositories.WorkspaceRoleAdmin, "Acme": repositories.WorkspaceRoleMember}, roles)
+	})
+
+	t.Run("メンバーでないワークスペースは 404、不正なワークスペースの ID は 400 を返すこと", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		createUserWithPassword(t, "admin@example.com", "password123")
+		adminToken := login(t, e, "admin@example.com", "password123", "")
+		createUserWithPassword(t, "other@example.com", "password123")
+		otherToken := login(t, e, "other@example.com", "password123", "")
+		wsID := createWorkspace(t, e, adminToken, "Acme")
+		rec := serveJSONWithToken(e, http.MethodPost, workspacePath(wsID, "/todo"), `{"title":"team","description":"desc"}`, adminToken)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		var created dto.TodoDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+
+		assert.Equal(t, http.StatusNotFound, serveWithToken(e, http.MethodGet, workspacePath(wsID, "/todo"), otherToken).Code)
+		assert.Equal(t, http.StatusNotFound, serveWithWorkspaceHeader(e, http.MethodGet, "/todo", otherToken, strconv.Itoa(wsID)).Code)
+		assert.Equal(t, http.StatusNotFound, serveWithToken(e, http.MethodGet, workspacePath(wsID, ""), otherToken).Code)
+		assert.Equal(t, http.StatusBadRequest, serveWithWorkspaceHeader(e, http.MethodGet, "/todo", adminToken, "abc").Code)
+
+		// 作成したユーザーでも、別のワークスペースを指定すると Todo は見つからず削除されない
+		assert.Equal(t, http.StatusNoContent, serveWithToken(e, http.MethodDelete, "/todo/"+strconv.Itoa(created.ID), adminToken).Code)
+		assert.True(t, testClient.Todo.Query().Where(todo.ID(created.ID)).ExistX(context.Background()))
+	})
+
+	t.Run("リポジトリはメンバーでないワークスペースを変更せず NotFoundError を返すこと", func(t *testing.T) {
+		e, dir := setupWorkspaceTestApp(t)
+		createUserWithPassword(t, "admin@example.com", "password123")
+		adminToken := login(t, e, "admin@example.com", "password123", "")
+		memberID := createUserWithPassword(t, "member@example.com", "password123")
+		wsID := createWorkspace(t, e, adminToken, "Acme")
+		addWorkspaceMember(t, e, dir, adminToken, wsID, "member@example.com", repositories.WorkspaceRoleMember)
+		rec := serveJSONWithToken(e, http.MethodPost, workspacePath(wsID, "/invites"), `{"email":"new@example.com","role":"member"}`, adminToken)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		ctx := context.Background()
+		inviteID := testClient.WorkspaceInvite.Query().Where(workspaceinvite.Email("new@example.com")).OnlyIDX(ctx)
+		otherID := createUserWithPassword(t, "other@example.com", "password123")
+		otherCtx := utils.WithUser(ctx, testClient.User.GetX(ctx, otherID))
+		repo := repositories.NewWorkspaceRepository(testClient)
+		inviteRepo := repositories.NewWorkspaceInviteRepository(testClient)
+
+		_, renameErr := repo.Rename(otherCtx, wsID, "Hijacked")
+		deleteErr := repo.Delete(otherCtx, wsID)
+		_, setRoleErr := repo.SetMemberRole(otherCtx, wsID, memberID, repositories.WorkspaceRoleAdmin)
+		removeErr := repo.RemoveMember(otherCtx, wsID, memberID)
+		_, listInvitesErr := inviteRepo.ListPending(otherCtx, wsID, time.Now())
+		revokeErr := inviteRepo.Revoke(otherCtx, wsID, inviteID)
+
+		for _, err := range []error{renameErr, deleteErr, setRoleErr, removeErr, listInvitesErr, revokeErr} {
+			assert.True(t, ent.IsNotFound(err), err)
+		}
+		assert.Equal(t, "Acme", testClient.Workspace.GetX(ctx, wsID).Name)
+		m := testClient.WorkspaceMember.Query().Where(workspacemember.WorkspaceID(wsID), workspacemember.UserID(memberID)).OnlyX(ctx)
+		assert.Equal(t, repositories.WorkspaceRoleMember, m.Role)
+		assert.Nil(t, testClient.WorkspaceInvite.GetX(ctx, inviteID).UsedAt)
+	})
+}
+
+func TestWorkspaceHandler_Members_Integration(t *testing.T) {
+	t.Run("管理者以外はメンバーを管理できず、最後の管理者は抜けられないこと", func(t *testing.T) {
+		e, dir := setupWorkspaceTestApp(t)
+		adminID := createUserWithPassword(t, "admin@example.com", "password123")
+		adminToken := login(t, e, "admin@example.com", "password123", "")
+		memberID := createUserWithPassword(t, "member@example.com", "password123")
+		memberToken := login(t, e, "member@example.com", "password123", "")
+		wsID := createWorkspace(t, e, adminToken, "Acme")
+		addWorkspaceMember(t, e, dir, adminToken, wsID, "member@example.com", repositories.WorkspaceRoleMember)
+
+		rec := serveJSONWithToken(e, http.MethodPost, workspacePath(wsID, "/invites"), `{"email":"new@example.com","role":"member"}`, memberToken)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		rec = serveJSONWithToken(e, http.MethodPost, workspacePath(wsID, "/invites"), `{"email":"member@example.com","role":"member"}`, adminToken)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		assert.Equal(t, http.StatusForbidden, serveWithToken(e, http.MethodGet, workspacePath(wsID, "/invites"), memberToken).Code)
+
+		adminPath := workspacePath(wsID, "/members/"+strconv.Itoa(adminID))
+		assert.Equal(t, http.StatusConflict, serveWithToken(e, http.MethodDelete, adminPath, adminToken).Code)
+		assert.Equal(t, http.StatusConflict, serveJSONWithToken(e, http.MethodPatch, adminPath, `{"role":"member"}`, adminToken).Code)
+		assert.Equal(t, http.StatusForbidden, serveWithToken(e, http.MethodDelete, adminPath, memberToken).Code)
+
+		// メンバーは自分で抜けられ、抜けた後はワークスペースを指定できない
+		assert.Equal(t, http.StatusNoContent, serveWithToken(e, http.MethodDelete, workspacePath(wsID, "/members/"+strconv.Itoa(memberID)), memberToken).Code)
+		assert.Equal(t, http.StatusNotFound, serveWithToken(e, http.MethodGet, workspacePath(wsID, "/todo"), memberToken).Code)
+	})
+
+	t.Run("個人のワークスペースは削除できず、チームのワークスペースを削除すると Todo も削除されること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		userID := createUserWithPassword(t, "admin@example.com", "password123")
+		token := login(t, e, "admin@example.com", "password123", "")
+		personalID := personalWorkspaceID(t, userID)
+		assert.Equal(t, http.StatusBadRequest, serveWithToken(e, http.MethodDelete, workspacePath(personalID, ""), token).Code)
+
+		wsID := createWorkspace(t, e, token, "Acme")
+		rec := serveJSONWithToken(e, http.MethodPost, workspacePath(wsID, "/todo"), `{"title":"team","description":"desc"}`, token)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		var created dto.TodoDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+
+		assert.Equal(t, http.StatusNoContent, serveWithToken(e, http.MethodDelete, workspacePath(wsID, ""), token).Code)
+		assert.False(t, testClient.Todo.Query().Where(todo.ID(created.ID)).ExistX(context.Background()))
+		assert.Equal(t, http.StatusNotFound, serveWithToken(e, http.MethodGet, workspacePath(wsID, "/todo"), token).Code)
+	})
+}
+
+func TestWorkspaceHandler_Invite_Integration(t *testing.T) {
+	t.Run("招待されたユーザーは、承諾するまでワークスペースのメンバーにならないこと", func(t *testing.T) {
+		e, dir := setupWorkspaceTestApp(t)
+		createUserWithPassword(t, "admin@example.com", "password123")
+		adminToken := login(t, e, "admin@example.com", "password123", "")
+		createUserWithPassword(t, "new@example.com", "password123")
+		newToken := login(t, e, "new@example.com", "password123", "")
+		wsID := createWorkspace(t, e, adminToken, "Acme")
+
+		rec := serveJSONWithToken(e, http.MethodPost, workspacePath(wsID, "/invites"), `{"email":"new@example.com","role":"admin"}`, adminToken)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		assert.Equal(t, http.StatusNotFound, serveWithToken(e, http.MethodGet, workspacePath(wsID, ""), newToken).Code)
+
+		rec = serveWithToken(e, http.MethodGet, workspacePath(wsID, "/invites"), adminToken)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var invites dto.ListWorkspaceInvitesResponseDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &invites))
+		require.Len(t, invites.Data, 1)
+		assert.Equal(t, "new@example.com", invites.Data[0].Email)
+
+		tokens := readResetTokens(t, dir)
+		require.Len(t, tokens, 1)
+		body := `{"token":"` + tokens[0] + `"}`
+		rec = serveJSONWithToken(e, http.MethodPost, "/workspaces/invites/accept", body, newToken)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var ws dto.WorkspaceDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &ws))
+		assert.Equal(t, wsID, ws.ID)
+		assert.Equal(t, repositories.WorkspaceRoleAdmin, ws.Role)
+		assert.Equal(t, http.StatusOK, serveWithToken(e, http.MethodGet, workspacePath(wsID, ""), newToken).Code)
+
+		// 招待は 1 回だけ使用できる
+		assert.Equal(t, http.StatusBadRequest, serveJSONWithToken(e, http.MethodPost, "/workspaces/invites/accept", body, newToken).Code)
+	})
+
+	t.Run("招待されたメールアドレス以外のユーザーは承諾できないこと", func(t *testing.T) {
+		e, dir := setupWorkspaceTestApp(t)
+		createUserWithPassword(t, "admin@example.com", "password123")
+		adminToken := login(t, e, "admin@example.com", "password123", "")
+		createUserWithPassword(t, "other@example.com", "password123")
+		otherToken := login(t, e, "other@example.com", "password123", "")
+		wsID := createWorkspace(t, e, adminToken, "Acme")
+
+		rec := serveJSONWithToken(e, http.MethodPost, workspacePath(wsID, "/invites"), `{"email":"new@example.com","role":"member"}`, adminToken)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		tokens := readResetTokens(t, dir)
+		require.Len(t, tokens, 1)
+
+		rec = serveJSONWithToken(e, http.MethodPost, "/workspaces/invites/accept", `{"token":"`+tokens[0]+`"}`, otherToken)
+
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Equal(t, http.StatusNotFound, serveWithToken(e, http.MethodGet, workspacePath(wsID, ""), otherToken).Code)
+	})
+
+	t.Run("取り消した招待は承諾できないこと", func(t *testing.T) {
+		e, dir := setupWorkspaceTestApp(t)
+		createUserWithPassword(t, "admin@example.com", "password123")
+		adminToken := login(t, e, "admin@example.com", "password123", "")
+		createUserWithPassword(t, "new@example.com", "password123")
+		newToken := login(t, e, "new@example.com", "password123", "")
+		wsID := createWorkspace(t, e, adminToken, "Acme")
+
+		rec := serveJSONWithToken(e, http.MethodPost, workspacePath(wsID, "/invites"), `{"email":"new@example.com","role":"member"}`, adminToken)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		var invite dto.WorkspaceInviteDto
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &invite))
+		assert.Equal(t, http.StatusNoContent, serveWithToken(e, http.MethodDelete, workspacePath(wsID, "/invites/"+invite.ID), adminToken).Code)
+		tokens := readResetTokens(t, dir)
+		require.Len(t, tokens, 1)
+
+		rec = serveJSONWithToken(e, http.MethodPost, "/workspaces/invites/accept", `{"token":"`+tokens[0]+`"}`, newToken)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, http.StatusNotFound, serveWithToken(e, http.MethodGet, workspacePath(wsID, ""), newToken).Code)
+	})
+}
+
+func TestWorkspaceHandler_AccountDeletion_Integration(t *testing.T) {
+	// admin@example.com が作成したワークスペースに member@example.com が参加する
+	setup := func(t *testing.T) (*echo.Echo, int, string) {
+		e, dir := setupWorkspaceTestApp(t)
+		createUserWithPassword(t, "admin@example.com", "password123")
+		adminToken := login(t, e, "admin@example.com", "password123", "")
+		createUserWithPassword(t, "member@example.com", "password123")
+		wsID := createWorkspace(t, e, adminToken, "Acme")
+		addWorkspaceMember(t, e, dir, adminToken, wsID, "member@example.com", repositories.WorkspaceRoleMember)
+		return e, wsID, adminToken
+	}
+
+	t.Run("他のメンバーがいるワークスペースの唯一の管理者は、管理者を移すまで退会できないこと", func(t *testing.T) {
+		e, wsID, adminToken := setup(t)
+
+		rec := serveJSONWithToken(e, http.MethodDelete, "/me", `{"password":"password123"}`, adminToken)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		assert.Equal(t, http.StatusConflict, serveWithToken(e, http.MethodPost, "/me/deletion/request", adminToken).Code)
+
+		memberID := testClient.User.Query().Where(user.Email("member@example.com")).OnlyIDX(context.Background())
+		rec = serveJSONWithToken(e, http.MethodPatch, workspacePath(wsID, "/members/"+strconv.Itoa(memberID)), `{"role":"admin"}`, adminToken)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		rec = serveJSONWithToken(e, http.MethodDelete, "/me", `{"password":"password123"}`, adminToken)
+		assert.Equal(t, http.StatusAccepted, rec.Code, rec.Body.String())
+	})
+
+	t.Run("申請後に唯一の管理者になった場合、管理者を移すまで削除しないこと", func(t *testing.T) {
+		e, wsID, adminToken := setup(t)
+		ctx := context.Background()
+		adminID := testClient.User.Query().Where(user.Email("admin@example.com")).OnlyIDX(ctx)
+		testClient.User.UpdateOneID(adminID).SetDeletionScheduledAt(time.Now().Add(-time.Minute)).ExecX(ctx)
+		userRepo := repositories.NewUserRepository(testClient)
+
+		n, err := userRepo.DeleteScheduled(ctx, time.Now())
+		require.NoError(t, err)
+		assert.Zero(t, n)
+		assert.True(t, testClient.User.Query().Where(user.ID(adminID)).ExistX(ctx))
+
+		memberID := testClient.User.Query().Where(user.Email("member@example.com")).OnlyIDX(ctx)
+		rec := serveJSONWithToken(e, http.MethodPatch, workspacePath(wsID, "/members/"+strconv.Itoa(memberID)), `{"role":"admin"}`, adminToken)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		n, err = userRepo.DeleteScheduled(ctx, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.True(t, testClient.Workspace.Query().Where(workspace.ID(wsID)).ExistX(ctx))
+	})
+
+	t.Run("退会したユーザーだけが参加するワークスペースは削除すること", func(t *testing.T) {
+		e := setupAuthTestApp(t)
+		ctx := context.Background()
+		createUserWithPassword(t, "admin@example.com", "password123")
+		adminToken := login(t, e, "admin@example.com", "password123", "")
+		wsID := createWorkspace(t, e, adminToken, "Solo")
+
+		require.Equal(t, http.StatusAccepted, serveJSONWithToken(e, http.MethodDelete, "/me", `{"password":"password123"}`, adminToken).Code)
+		testClient.User.Update().Where(user.Email("admin@example.com")).SetDeletionScheduledAt(time.Now().Add(-time.Minute)).ExecX(ctx)
+		n, err := repositories.NewUserRepository(testClient).DeleteScheduled(ctx, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		assert.False(t, testClient.Workspace.Query().Where(workspace.ID(wsID)).ExistX(ctx))
+	})
+}
@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"net/http"
+
+	"todo-app/repositories"
+	"todo-app/utils"
+
+	"github.com/labstack/echo/v5"
+)
+
+// RequireAdmin は管理者のみ呼び出せるようにする。AuthMiddleware.Authenticate の後に使う。
+// 漏洩したトークンやなりすまし中のセッションで管理者の操作を行えないよう、管理者本人のセッション (Cookie) に限定する
+func RequireAdmin(next echo.HandlerFunc) echo.HandlerFunc {
+	return RequireSession(func(c *echo.Context) error {
+		u, ok := utils.UserFromContext(c.Request().Context())
+		if !ok || u.Role != repositories.UserRoleAdmin {
+			return c.JSON(http.StatusForbidden, map[string]string{"error": "admin role required"})
+		}
+		return next(c)
+	})
+}
+
+// AdminAuditMiddleware は管理者がなりすましているセッションで行った操作を監査記録に残す
+type AdminAuditMiddleware struct {
+	auditRepo repositories.IAdminAuditLogRepository
+}
+
+func NewAdminAuditMiddleware(auditRepo repositories.IAdminAuditLogRepository) *AdminAuditMiddleware {
+	return &AdminAuditMiddleware{auditRepo: auditRepo}
+}
+
+// AuditImpersonation はなりすまし中の更新のリクエスト (GET / HEAD / OPTIONS 以外) を、処理する前に記録する。
+// 記録できなかった場合は操作を行わずにエラーを返す。AuthMiddleware.Authenticate の後に使う
+func (m *AdminAuditMiddleware) AuditImpersonation(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c *echo.Context) error {
+		ctx := c.Request().Context()
+		impersonatorID, ok := utils.ImpersonatorIDFromContext(ctx)
+		method := c.Request().Method
+		if !ok || method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions {
+			return next(c)
+		}
+
+		u, _ := utils.UserFromContext(ctx)
+		err := m.auditRepo.Create(ctx, repositories.AdminAuditLogEntry{
+			ActorID:      impersonatorID,
+			TargetUserID: &u.ID,
+			Action:       repositories.AdminActionImpersonatedRequest,
+			Detail: map[string]interface{}{
+				"method": method,
+				"path":   c.Request().URL.Path,
+			},
+			IPAddress: c.RealIP(),
+		})
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "internal server error"})
+		}
+		return next(c)
+	}
+}
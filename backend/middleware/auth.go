@@ -1,67 +1,157 @@
 package middleware
 
 import (
-	"context"
-	"fmt"
 	"net/http"
-	"os"
+	"slices"
+	"strings"
 	"time"
 
 	"todo-app/ent"
 	"todo-app/repositories"
+	"todo-app/services"
+	"todo-app/utils"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v5"
 )
 
+// 認証せずに呼び出せるパス。
+// /auth/refresh はアクセストークンの期限切れ後に呼び出すため認証しないが、CSRF の検証は行う
+var publicPaths = map[string]bool{
+	"/auth/login":           true,
+	"/auth/login/mfa":       true,
+	"/auth/register":        true,
+	"/auth/refresh":         true,
+	"/auth/password/forgot": true,
+	"/auth/password/reset":  true,
+	"/auth/email/confirm":   true,
+	"/auth/email/cancel":    true,
+	"/auth/oidc/providers":  true,
+	// c.Path() はルートの定義に一致するパスを返すため、パラメータを含む形で指定する
+	"/auth/oidc/:provider/login":    true,
+	"/auth/oidc/:provider/callback": true,
+}
+
+// ログイン前に呼び出すため、CSRF トークンの Cookie を持っていない可能性があるパス
+var csrfExemptPaths = map[string]bool{
+	"/auth/login":           true,
+	"/auth/login/mfa":       true,
+	"/auth/register":        true,
+	"/auth/password/forgot": true,
+	"/auth/password/reset":  true,
+	"/auth/email/confirm":   true,
+	"/auth/email/cancel":    true,
+}
+
+// IsPublicPath は path が認証不要のパスであれば true を返す
+func IsPublicPath(path string) bool {
+	return publicPaths[path]
+}
+
+// IsCSRFExempt は path が CSRF の検証を行わないパスであれば true を返す
+func IsCSRFExempt(path string) bool {
+	return csrfExemptPaths[path]
+}
+
+// セッションの最終アクセス日時を更新する間隔。リクエストごとに書き込まないようにする
+const sessionTouchInterval = time.Minute
+
+// パーソナルアクセストークンで認証したことを echo.Context に記録するキー
+const bearerAuthenticatedKey = "bearer_authenticated"
+
+// IsBearerAuthenticated はリクエストをパーソナルアクセストークン (Authorization: Bearer) で認証した場合に true を返す。
+// ブラウザはクロスサイトのリクエストに Authorization ヘッダーを自動で付与しないため、CSRF の検証は不要になる
+func IsBearerAuthenticated(c *echo.Context) bool {
+	ok, _ := c.Get(bearerAuthenticatedKey).(bool)
+	return ok
+}
+
+// RequireScope はパーソナルアクセストークンで認証したリクエストに scope を要求する。Cookie で認証したリクエストは制限しない
+func RequireScope(scope string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c *echo.Context) error {
+			scopes, ok := utils.TokenScopesFromContext(c.Request().Context())
+			if ok && !slices.Contains(scopes, scope) {
+				return c.JSON(http.StatusForbidden, map[string]string{"error": "insufficient scope"})
+			}
+			return next(c)
+		}
+	}
+}
+
+// RequireSession はログインしたセッション (Cookie) でのみ呼び出せるようにする。
+// トークンの管理やパスワードの変更など、漏洩したトークンで権限を広げられる操作に使う。
+// 管理者がなりすましているセッションでも、同じ理由で呼び出せない
+func RequireSession(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c *echo.Context) error {
+		if IsBearerAuthenticated(c) {
+			return c.JSON(http.StatusForbidden, map[string]string{"error": "personal access tokens cannot be used for this endpoint"})
+		}
+		if _, ok := utils.ImpersonatorIDFromContext(c.Request().Context()); ok {
+			return c.JSON(http.StatusForbidden, map[string]string{"error": "this endpoint cannot be used while impersonating"})
+		}
+		return next(c)
+	}
+}
+
 type AuthMiddleware struct {
-	userRepo repositories.IUserRepository
+	userRepo    repositories.IUserRepository
+	sessionRepo repositories.ISessionRepository
+	patService  *services.PersonalAccessTokenService
+	keys        *utils.JWTKeyManager
 }
 
-func NewAuthMiddleware(userRepo repositories.IUserRepository) *AuthMiddleware {
-	return &AuthMiddleware{userRepo: userRepo}
+func NewAuthMiddleware(userRepo repositories.IUserRepository, sessionRepo repositories.ISessionRepository, patService *services.PersonalAccessTokenService, keys *utils.JWTKeyManager) *AuthMiddleware {
+	return &AuthMiddleware{userRepo: userRepo, sessionRepo: sessionRepo, patService: patService, keys: keys}
 }
 
 func (m *AuthMiddleware) Authenticate(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c *echo.Context) error {
-		// Skip authentication for login and options requests
-		if c.Path() == "/auth/login" || c.Request().Method == http.MethodOptions {
+		// Skip authentication for public paths and options requests
+		if IsPublicPath(c.Path()) || c.Request().Method == http.MethodOptions {
 			return next(c)
 		}
 
+		if authorization := c.Request().Header.Get(echo.HeaderAuthorization); authorization != "" {
+			return m.authenticateBearer(c, next, authorization)
+		}
+
 		cookie, err := c.Cookie("token")
 		if err != nil {
 			return c.JSON(http.StatusUnauthorized, map[string]string{"error": "missing token"})
 		}
 
-		tokenString := cookie.Value
-		secret := os.Getenv("JWT_SECRET")
-		if secret == "" {
-			secret = "secret"
-		}
-
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-			}
-			return []byte(secret), nil
-		})
-
+		claims := jwt.MapClaims{}
+		token, err := m.keys.Parse(cookie.Value, claims)
 		if err != nil || !token.Valid {
 			return c.JSON(http.StatusUnauthorized, map[string]string{"error": "invalid token"})
 		}
 
-		claims, ok := token.Claims.(jwt.MapClaims)
-		if !ok {
-			return c.JSON(http.StatusUnauthorized, map[string]string{"error": "invalid token claims"})
-		}
-
 		userIDFloat, ok := claims["user_id"].(float64)
 		if !ok {
 			return c.JSON(http.StatusUnauthorized, map[string]string{"error": "invalid user id"})
 		}
 		userID := int(userIDFloat)
 
+		jti, _ := claims["jti"].(string)
+		sessionID, err := uuid.Parse(jti)
+		if err != nil {
+			return c.JSON(http.StatusUnauthorized, map[string]string{"error": "invalid session"})
+		}
+
+		// ログアウトや失効させたセッションのトークンは、有効期限内でも受け付けない
+		session, err := m.sessionRepo.FindActive(c.Request().Context(), sessionID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "session revoked"})
+			}
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "internal server error"})
+		}
+		if session.UserID != userID {
+			return c.JSON(http.StatusUnauthorized, map[string]string{"error": "invalid session"})
+		}
+
 		user, err := m.userRepo.FindById(c.Request().Context(), userID)
 		if err != nil {
 			if ent.IsNotFound(err) {
@@ -69,28 +159,69 @@ func (m *AuthMiddleware) Authenticate(next echo.HandlerFunc) echo.HandlerFunc {
 			}
 			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "internal server error"})
 		}
-
-		// Update token expiration
-		newClaims := jwt.MapClaims{
-			"user_id": user.ID,
-			"exp":     time.Now().Add(time.Hour).Unix(),
+		if user.DisabledAt != nil {
+			return c.JSON(http.StatusForbidden, map[string]string{"error": "account disabled"})
 		}
-		newToken := jwt.NewWithClaims(jwt.SigningMethodHS256, newClaims)
-		newTokenString, err := newToken.SignedString([]byte(secret))
-		if err == nil {
-			newCookie := new(http.Cookie)
-			newCookie.Name = "token"
-			newCookie.Value = newTokenString
-			newCookie.Path = "/"
-			newCookie.HttpOnly = true
-			newCookie.SameSite = http.SameSiteStrictMode
-			c.SetCookie(newCookie)
+
+		ipAddress, userAgent := c.RealIP(), c.Request().UserAgent()
+		if time.Since(session.LastSeenAt) >= sessionTouchInterval || session.IPAddress != ipAddress || session.UserAgent != userAgent {
+			if err := m.sessionRepo.Touch(c.Request().Context(), session.ID, ipAddress, userAgent); err != nil {
+				return c.JSON(http.StatusInternalServerError, map[string]string{"error": "internal server error"})
+			}
 		}
 
-		//nolint:staticcheck
-		ctx := context.WithValue(c.Request().Context(), "user", user)
+		// アクセストークンの有効期限はここでは延長しない。期限が切れたら /auth/refresh で再発行する
+
+		ctx := utils.WithUser(c.Request().Context(), user)
+		ctx = utils.WithSessionID(ctx, session.ID)
+		if session.ImpersonatorID != nil {
+			ctx = utils.WithImpersonatorID(ctx, *session.ImpersonatorID)
+		}
 		c.SetRequest(c.Request().WithContext(ctx))
 
 		return next(c)
 	}
 }
+
+// authenticateBearer はパーソナルアクセストークンで認証する。
+// 参照 (GET / HEAD) には read、それ以外には write のスコープが必要
+func (m *AuthMiddleware) authenticateBearer(c *echo.Context, next echo.HandlerFunc, authorization string) error {
+	scheme, token, ok := strings.Cut(authorization, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "invalid authorization header"})
+	}
+
+	pat, err := m.patService.Authenticate(c.Request().Context(), token)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusUnauthorized, map[string]string{"error": "invalid token"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "internal server error"})
+	}
+
+	scope := services.TokenScopeWrite
+	if method := c.Request().Method; method == http.MethodGet || method == http.MethodHead {
+		scope = services.TokenScopeRead
+	}
+	if !slices.Contains(pat.Scopes, scope) {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "insufficient scope"})
+	}
+
+	user, err := m.userRepo.FindById(c.Request().Context(), pat.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusUnauthorized, map[string]string{"error": "user not found"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "internal server error"})
+	}
+	if user.DisabledAt != nil {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "account disabled"})
+	}
+
+	ctx := utils.WithUser(c.Request().Context(), user)
+	ctx = utils.WithTokenScopes(ctx, pat.Scopes)
+	c.SetRequest(c.Request().WithContext(ctx))
+	c.Set(bearerAuthenticatedKey, true)
+
+	return next(c)
+}
@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+
+	"todo-app/ent"
+	"todo-app/repositories"
+	"todo-app/utils"
+
+	"github.com/labstack/echo/v5"
+)
+
+// HeaderWorkspaceID はリクエストの対象のワークスペースを指定するヘッダー
+const HeaderWorkspaceID = "X-Workspace-ID"
+
+// ParamWorkspaceID は /workspaces/:workspace_id/... の形式でワークスペースを指定するパスパラメータ
+const ParamWorkspaceID = "workspace_id"
+
+// WorkspaceMiddleware はリクエストの対象のワークスペースを決定する
+type WorkspaceMiddleware struct {
+	repo repositories.IWorkspaceRepository
+}
+
+func NewWorkspaceMiddleware(repo repositories.IWorkspaceRepository) *WorkspaceMiddleware {
+	return &WorkspaceMiddleware{repo: repo}
+}
+
+// ResolveWorkspace はパスまたは X-Workspace-ID で指定したワークスペースを context に保存する。AuthMiddleware.Authenticate の後に使う。
+// 両方を指定した場合はパスを優先する。指定しない場合は個人のワークスペースを使い、まだ作成していない場合は作成する。
+// メンバーでないワークスペースを指定した場合は、存在を知られないよう 404 を返す
+func (m *WorkspaceMiddleware) ResolveWorkspace(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c *echo.Context) error {
+		ctx := c.Request().Context()
+		u, ok := utils.UserFromContext(ctx)
+		if !ok {
+			return next(c)
+		}
+
+		var member *ent.WorkspaceMember
+		var err error
+		raw := c.Param(ParamWorkspaceID)
+		if raw == "" {
+			raw = c.Request().Header.Get(HeaderWorkspaceID)
+		}
+		if raw != "" {
+			id, convErr := strconv.Atoi(raw)
+			if convErr != nil || id < 1 {
+				return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid workspace id"})
+			}
+			member, err = m.repo.FindMembership(ctx, id)
+		} else {
+			member, err = m.repo.EnsurePersonal(ctx, u.ID)
+		}
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return c.JSON(http.StatusNotFound, map[string]string{"error": "workspace not found"})
+			}
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "internal server error"})
+		}
+
+		c.SetRequest(c.Request().WithContext(utils.WithWorkspace(ctx, member.WorkspaceID, member.Role)))
+		return next(c)
+	}
+}
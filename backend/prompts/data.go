@@ -0,0 +1,35 @@
+package prompts
+
+import "time"
+
+// テンプレートに渡す値。時刻はユーザーのタイムゾーンに変換してから渡す
+
+type FilterTodosData struct {
+	Now      time.Time
+	TimeZone string
+	Query    string
+}
+
+type DraftTodosData struct {
+	Now      time.Time
+	TimeZone string
+	Text     string
+}
+
+type BreakdownTodoData struct {
+	Title       string
+	Description string
+}
+
+type SummarizeTodosData struct {
+	From  time.Time
+	To    time.Time
+	Todos []SummaryTodo
+}
+
+type SummaryTodo struct {
+	ID          int
+	Title       string
+	Description string
+	DoneAt      time.Time
+}
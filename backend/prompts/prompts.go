@@ -0,0 +1,152 @@
+// Package prompts は AI に渡すプロンプトをバージョン付きのテンプレートとして管理する。
+//
+// テンプレートは templates/<name>/<version>.<locale>.tmpl に置き、"---" だけの行で Part を区切る。
+// 既存のテンプレートは書き換えず、新しいバージョンを追加して currentVersions を更新する。
+// 過去のバージョンを残しておくことで、保存済みのクエリに対して変更前後の結果を比較できる。
+package prompts
+
+import (
+	"embed"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"text/template"
+
+	"google.golang.org/genai"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+const (
+	FilterTodos    = "filter_todos"
+	DraftTodos     = "draft_todos"
+	BreakdownTodo  = "breakdown_todo"
+	SummarizeTodos = "summarize_todos"
+)
+
+const (
+	LocaleJa      = "ja"
+	LocaleEn      = "en"
+	DefaultLocale = LocaleJa
+)
+
+// currentVersions は各プロンプトで現在使用するテンプレートのバージョン
+var currentVersions = map[string]string{
+	FilterTodos:    "v1",
+	DraftTodos:     "v1",
+	BreakdownTodo:  "v1",
+	SummarizeTodos: "v1",
+}
+
+const partSeparator = "\n---\n"
+
+// Prompt はテンプレートから生成したプロンプト
+type Prompt struct {
+	Name    string
+	Version string
+	Locale  string
+	Parts   []string
+}
+
+// ID は生成に使用したテンプレートを表す文字列を返す (例: v1.ja)
+func (p *Prompt) ID() string {
+	return p.Version + "." + p.Locale
+}
+
+// Contents は GenerateContent に渡す形式に変換する
+func (p *Prompt) Contents() []*genai.Content {
+	parts := make([]*genai.Part, len(p.Parts))
+	for i, text := range p.Parts {
+		parts[i] = &genai.Part{Text: text}
+	}
+	return []*genai.Content{{Parts: parts}}
+}
+
+// NormalizeLocale はユーザーの言語設定を対応しているロケールに変換する。
+// "en-US" のような地域付きの指定は言語部分のみを使い、対応していない言語は DefaultLocale とする
+func NormalizeLocale(locale string) string {
+	l := strings.ToLower(locale)
+	if i := strings.IndexAny(l, "-_"); i >= 0 {
+		l = l[:i]
+	}
+	switch l {
+	case LocaleJa, LocaleEn:
+		return l
+	}
+	return DefaultLocale
+}
+
+// CurrentVersion はプロンプトで現在使用するテンプレートのバージョンを返す
+func CurrentVersion(name string) string {
+	return currentVersions[name]
+}
+
+// CurrentID は現在のテンプレートでプロンプトを生成した場合の Prompt.ID を返す
+func CurrentID(name string, locale string) string {
+	return CurrentVersion(name) + "." + NormalizeLocale(locale)
+}
+
+// Render は現在のバージョンのテンプレートからプロンプトを生成する
+func Render(name string, locale string, data any) (*Prompt, error) {
+	version := CurrentVersion(name)
+	if version == "" {
+		return nil, fmt.Errorf("unknown prompt: %s", name)
+	}
+	return RenderVersion(name, version, locale, data)
+}
+
+// RenderVersion は指定したバージョンのテンプレートからプロンプトを生成する
+func RenderVersion(name string, version string, locale string, data any) (*Prompt, error) {
+	locale = NormalizeLocale(locale)
+	tmpls, err := loadTemplates(name, version, locale)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := make([]string, len(tmpls))
+	for i, tmpl := range tmpls {
+		var b strings.Builder
+		if err := tmpl.Execute(&b, data); err != nil {
+			return nil, fmt.Errorf("failed to render prompt %s/%s.%s: %w", name, version, locale, err)
+		}
+		parts[i] = b.String()
+	}
+
+	return &Prompt{
+		Name:    name,
+		Version: version,
+		Locale:  locale,
+		Parts:   parts,
+	}, nil
+}
+
+var templateCache sync.Map
+
+// loadTemplates はテンプレートを Part ごとに分けて解析する。
+// 利用者の入力に区切り文字が含まれていても Part が分かれないよう、描画前に分割する
+func loadTemplates(name string, version string, locale string) ([]*template.Template, error) {
+	file := path.Join("templates", name, version+"."+locale+".tmpl")
+	if cached, ok := templateCache.Load(file); ok {
+		return cached.([]*template.Template), nil
+	}
+
+	src, err := templateFS.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("prompt template not found: %s/%s.%s", name, version, locale)
+	}
+
+	sources := strings.Split(strings.ReplaceAll(string(src), "\r\n", "\n"), partSeparator)
+	tmpls := make([]*template.Template, len(sources))
+	for i, s := range sources {
+		tmpl, err := template.New(file).Option("missingkey=error").Parse(strings.TrimSpace(s))
+		if err != nil {
+			return nil, fmt.Errorf("invalid prompt template %s: %w", file, err)
+		}
+		tmpls[i] = tmpl
+	}
+
+	templateCache.Store(file, tmpls)
+	return tmpls, nil
+}
@@ -0,0 +1,73 @@
+package prompts
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	now := time.Date(2026, 10, 19, 9, 30, 0, 0, time.FixedZone("JST", 9*60*60))
+	samples := map[string]any{
+		FilterTodos:   FilterTodosData{Now: now, TimeZone: "Asia/Tokyo", Query: "先週完了したタスク"},
+		DraftTodos:    DraftTodosData{Now: now, TimeZone: "Asia/Tokyo", Text: "明日歯医者に電話する"},
+		BreakdownTodo: BreakdownTodoData{Title: "引っ越し", Description: "来月"},
+		SummarizeTodos: SummarizeTodosData{From: now.AddDate(0, 0, -7), To: now, Todos: []SummaryTodo{
+			{ID: 1, Title: "資料作成", Description: "週報", DoneAt: now},
+			{ID: 2, Title: "請求書", Description: "支払い", DoneAt: now},
+		}},
+	}
+
+	t.Run("全てのプロンプトに日本語と英語のテンプレートがあること", func(t *testing.T) {
+		for name := range currentVersions {
+			for _, locale := range []string{LocaleJa, LocaleEn} {
+				prompt, err := Render(name, locale, samples[name])
+				if assert.NoError(t, err, "%s.%s", name, locale) {
+					assert.NotEmpty(t, prompt.Parts)
+					assert.Equal(t, CurrentID(name, locale), prompt.ID())
+				}
+			}
+		}
+	})
+
+	t.Run("現在時刻とタイムゾーンを埋め込むこと", func(t *testing.T) {
+		prompt, err := Render(FilterTodos, LocaleJa, samples[FilterTodos])
+		assert.NoError(t, err)
+		assert.Equal(t, "現在2026年10月19日09:30:00です。タイムゾーンは Asia/Tokyo (UTC+09:00) です。", prompt.Parts[0])
+		assert.Equal(t, "先週完了したタスク", prompt.Parts[len(prompt.Parts)-1])
+	})
+
+	t.Run("一覧は1件ずつ改行して埋め込むこと", func(t *testing.T) {
+		prompt, err := Render(SummarizeTodos, LocaleJa, samples[SummarizeTodos])
+		assert.NoError(t, err)
+		assert.Equal(t, "- [ID:1] 資料作成: 週報 (完了: 2026-10-19 09:30)\n- [ID:2] 請求書: 支払い (完了: 2026-10-19 09:30)", prompt.Parts[2])
+	})
+
+	t.Run("入力に区切り文字が含まれていても Part を分けないこと", func(t *testing.T) {
+		query := "a\n---\nb"
+		prompt, err := Render(FilterTodos, LocaleJa, FilterTodosData{Now: now, TimeZone: "Asia/Tokyo", Query: query})
+		assert.NoError(t, err)
+		assert.Len(t, prompt.Parts, 3)
+		assert.Equal(t, query, prompt.Parts[2])
+	})
+
+	t.Run("存在しないバージョンはエラーを返すこと", func(t *testing.T) {
+		_, err := RenderVersion(FilterTodos, "v0", LocaleJa, samples[FilterTodos])
+		assert.Error(t, err)
+	})
+
+	t.Run("値が足りない場合はエラーを返すこと", func(t *testing.T) {
+		_, err := Render(FilterTodos, LocaleJa, map[string]any{"Query": "x"})
+		assert.Error(t, err)
+	})
+}
+
+func TestNormalizeLocale(t *testing.T) {
+	assert.Equal(t, LocaleEn, NormalizeLocale("en-US"))
+	assert.Equal(t, LocaleJa, NormalizeLocale("ja_JP"))
+	assert.Equal(t, DefaultLocale, NormalizeLocale("fr"))
+	assert.Equal(t, DefaultLocale, NormalizeLocale(""))
+	assert.True(t, strings.HasPrefix(CurrentID(FilterTodos, "EN"), CurrentVersion(FilterTodos)+"."))
+}
@@ -0,0 +1,61 @@
+package repositories
+
+import (
+	"context"
+	"time"
+	"todo-app/ent"
+	"todo-app/ent/accountdeletiontoken"
+)
+
+type IAccountDeletionTokenRepository interface {
+	Create(ctx context.Context, userID int, tokenHash string, expiresAt time.Time) (*ent.AccountDeletionToken, error)
+	Consume(ctx context.Context, tokenHash string) (*ent.AccountDeletionToken, bool, error)
+}
+
+type AccountDeletionTokenRepository struct {
+	base *BaseRepository
+}
+
+func NewAccountDeletionTokenRepository(client *ent.Client) *AccountDeletionTokenRepository {
+	return &AccountDeletionTokenRepository{
+		base: NewBaseRepository(client),
+	}
+}
+
+// Create は退会の確認用のトークンを作成する。
+// 最後に送信したメールのリンクだけが有効になるよう、未使用の古いトークンは使用済みにする
+func (r *AccountDeletionTokenRepository) Create(ctx context.Context, userID int, tokenHash string, expiresAt time.Time) (*ent.AccountDeletionToken, error) {
+	client := r.base.getClient(ctx)
+	if err := client.AccountDeletionToken.Update().
+		Where(accountdeletiontoken.UserID(userID), accountdeletiontoken.UsedAtIsNil()).
+		SetUsedAt(time.Now()).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+	return client.AccountDeletionToken.Create().
+		SetUserID(userID).
+		SetTokenHash(tokenHash).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+}
+
+// Consume はトークンを使用済みにして返す。
+// 2 つ目の戻り値は今回の呼び出しで使用済みにした場合に true、既に使用済みだった場合に false となる
+func (r *AccountDeletionTokenRepository) Consume(ctx context.Context, tokenHash string) (*ent.AccountDeletionToken, bool, error) {
+	client := r.base.getClient(ctx)
+	n, err := client.AccountDeletionToken.Update().
+		Where(accountdeletiontoken.TokenHash(tokenHash), accountdeletiontoken.UsedAtIsNil()).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	adt, err := client.AccountDeletionToken.Query().
+		Where(accountdeletiontoken.TokenHash(tokenHash)).
+		Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return adt, n == 1, nil
+}
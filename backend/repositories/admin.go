@@ -0,0 +1,83 @@
+package repositories
+
+import (
+	"context"
+	"time"
+	"todo-app/ent"
+	"todo-app/ent/aiusage"
+	"todo-app/ent/todo"
+	"todo-app/ent/user"
+)
+
+// IAdminRepository は管理者の画面で使う、ユーザーを横断した参照を行う
+type IAdminRepository interface {
+	SearchUsers(ctx context.Context, query string, offset int, limit int) ([]*ent.User, int, error)
+	CountTodos(ctx context.Context, userID int) (int, int, error)
+	SumAIUsage(ctx context.Context, userID int, since time.Time) (int, int, error)
+}
+
+type AdminRepository struct {
+	base *BaseRepository
+}
+
+func NewAdminRepository(client *ent.Client) *AdminRepository {
+	return &AdminRepository{
+		base: NewBaseRepository(client),
+	}
+}
+
+// SearchUsers はメールアドレスか名前に query を含むユーザーを ID の順に返す。query が空の場合は全てのユーザーを返す。
+// 2 つ目の戻り値は該当するユーザーの総数
+func (r *AdminRepository) SearchUsers(ctx context.Context, query string, offset int, limit int) ([]*ent.User, int, error) {
+	q := r.base.getClient(ctx).User.Query()
+	if query != "" {
+		q = q.Where(user.Or(user.EmailContainsFold(query), user.NameContainsFold(query)))
+	}
+
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	users, err := q.Order(ent.Asc(user.FieldID)).Offset(offset).Limit(limit).All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return users, total, nil
+}
+
+// CountTodos はユーザーの Todo の件数と、そのうち完了した件数を返す
+func (r *AdminRepository) CountTodos(ctx context.Context, userID int) (int, int, error) {
+	client := r.base.getClient(ctx)
+	total, err := client.Todo.Query().Where(todo.UserID(userID)).Count(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	done, err := client.Todo.Query().Where(todo.UserID(userID), todo.DoneAtNotNil()).Count(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	return total, done, nil
+}
+
+// SumAIUsage は since 以降のユーザーの AI のリクエスト数と合計トークン数を返す
+func (r *AdminRepository) SumAIUsage(ctx context.Context, userID int, since time.Time) (int, int, error) {
+	var v []struct {
+		Count int  `json:"count"`
+		Sum   *int `json:"sum"`
+	}
+	err := r.base.getClient(ctx).AIUsage.Query().
+		Where(aiusage.UserID(userID), aiusage.CreatedAtGTE(since)).
+		Aggregate(ent.Count(), ent.Sum(aiusage.FieldTotalTokens)).
+		Scan(ctx, &v)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(v) == 0 {
+		return 0, 0, nil
+	}
+	tokens := 0
+	if v[0].Sum != nil {
+		tokens = *v[0].Sum
+	}
+	return v[0].Count, tokens, nil
+}
@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"context"
+	"todo-app/ent"
+	"todo-app/ent/adminauditlog"
+)
+
+// 管理者の操作の種類
+const (
+	AdminActionDisableUser   = "user.disable"
+	AdminActionEnableUser    = "user.enable"
+	AdminActionResetPassword = "user.password_reset"
+	AdminActionImpersonate   = "impersonation.start"
+	// なりすまし中のセッションで行った更新のリクエスト
+	AdminActionImpersonatedRequest = "impersonation.request"
+)
+
+// AdminAuditLogEntry は監査記録に残す管理者の操作
+type AdminAuditLogEntry struct {
+	ActorID      int
+	TargetUserID *int
+	Action       string
+	Detail       map[string]interface{}
+	IPAddress    string
+}
+
+type IAdminAuditLogRepository interface {
+	Create(ctx context.Context, entry AdminAuditLogEntry) error
+	List(ctx context.Context, targetUserID *int, offset int, limit int) ([]*ent.AdminAuditLog, int, error)
+}
+
+type AdminAuditLogRepository struct {
+	base *BaseRepository
+}
+
+func NewAdminAuditLogRepository(client *ent.Client) *AdminAuditLogRepository {
+	return &AdminAuditLogRepository{
+		base: NewBaseRepository(client),
+	}
+}
+
+func (r *AdminAuditLogRepository) Create(ctx context.Context, entry AdminAuditLogEntry) error {
+	return r.base.getClient(ctx).AdminAuditLog.Create().
+		SetActorID(entry.ActorID).
+		SetNillableTargetUserID(entry.TargetUserID).
+		SetAction(entry.Action).
+		SetDetail(entry.Detail).
+		SetIPAddress(truncate(entry.IPAddress, 64)).
+		Exec(ctx)
+}
+
+// List は監査記録を新しい順に返す。targetUserID を指定した場合はそのユーザーに対する操作に絞り込む。
+// 2 つ目の戻り値は該当する記録の総数
+func (r *AdminAuditLogRepository) List(ctx context.Context, targetUserID *int, offset int, limit int) ([]*ent.AdminAuditLog, int, error) {
+	q := r.base.getClient(ctx).AdminAuditLog.Query()
+	if targetUserID != nil {
+		q = q.Where(adminauditlog.TargetUserID(*targetUserID))
+	}
+
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	logs, err := q.Order(ent.Desc(adminauditlog.FieldCreatedAt), ent.Desc(adminauditlog.FieldID)).Offset(offset).Limit(limit).All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return logs, total, nil
+}
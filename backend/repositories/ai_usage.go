@@ -0,0 +1,102 @@
+package repositories
+
+import (
+	"context"
+	"time"
+	"todo-app/ent"
+	"todo-app/ent/aiusage"
+	"todo-app/ent/user"
+
+	"github.com/google/uuid"
+)
+
+type IAIUsageRepository interface {
+	Create(ctx context.Context, model string) (uuid.UUID, error)
+	UpdateTokens(ctx context.Context, id uuid.UUID, promptTokens int, candidatesTokens int, totalTokens int) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	SumUsage(ctx context.Context, since time.Time, excludeID uuid.UUID) (int, int, error)
+}
+
+type AIUsageRepository struct {
+	base *BaseRepository
+}
+
+func NewAIUsageRepository(client *ent.Client) *AIUsageRepository {
+	return &AIUsageRepository{
+		base: NewBaseRepository(client),
+	}
+}
+
+// Create はトークン数が 0 の利用記録を作成し、その ID を返す。トークン数は応答を受け取ってから UpdateTokens で記録する
+func (r *AIUsageRepository) Create(ctx context.Context, model string) (uuid.UUID, error) {
+	u, err := r.base.getUser(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	client := r.base.getClient(ctx)
+	usage, err := client.AIUsage.Create().
+		SetUserID(u.ID).
+		SetModel(model).
+		Save(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return usage.ID, nil
+}
+
+func (r *AIUsageRepository) UpdateTokens(ctx context.Context, id uuid.UUID, promptTokens int, candidatesTokens int, totalTokens int) error {
+	u, err := r.base.getUser(ctx)
+	if err != nil {
+		return err
+	}
+	client := r.base.getClient(ctx)
+	return client.AIUsage.Update().
+		Where(aiusage.ID(id), aiusage.UserID(u.ID)).
+		SetPromptTokens(promptTokens).
+		SetCandidatesTokens(candidatesTokens).
+		SetTotalTokens(totalTokens).
+		Exec(ctx)
+}
+
+func (r *AIUsageRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	u, err := r.base.getUser(ctx)
+	if err != nil {
+		return err
+	}
+	client := r.base.getClient(ctx)
+	_, err = client.AIUsage.Delete().
+		Where(aiusage.ID(id), aiusage.UserID(u.ID)).
+		Exec(ctx)
+	return err
+}
+
+// SumUsage は since 以降のリクエスト数と合計トークン数を返す。ID が excludeID の記録は数えない
+func (r *AIUsageRepository) SumUsage(ctx context.Context, since time.Time, excludeID uuid.UUID) (int, int, error) {
+	u, err := r.base.getUser(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	client := r.base.getClient(ctx)
+
+	var v []struct {
+		Count int  `json:"count"`
+		Sum   *int `json:"sum"`
+	}
+	err = client.AIUsage.Query().
+		Where(aiusage.HasUserWith(user.ID(u.ID))).
+		Where(aiusage.CreatedAtGTE(since), aiusage.IDNEQ(excludeID)).
+		Aggregate(ent.Count(), ent.Sum(aiusage.FieldTotalTokens)).
+		Scan(ctx, &v)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(v) == 0 {
+		return 0, 0, nil
+	}
+
+	tokens := 0
+	if v[0].Sum != nil {
+		tokens = *v[0].Sum
+	}
+	return v[0].Count, tokens, nil
+}
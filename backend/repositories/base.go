@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 	"todo-app/ent"
+	"todo-app/utils"
 )
 
 type BaseRepository struct {
@@ -22,9 +23,19 @@ func (r *BaseRepository) getClient(ctx context.Context) *ent.Client {
 }
 
 func (r *BaseRepository) getUser(ctx context.Context) (*ent.User, error) {
-	u := ctx.Value("user")
-	if u == nil {
+	u, ok := utils.UserFromContext(ctx)
+	if !ok {
 		return nil, &ent.NotFoundError{}
 	}
-	return u.(*ent.User), nil
+	return u, nil
+}
+
+// getPrincipal はリクエストしたユーザーとワークスペースを返す。
+// ワークスペースに属するデータを扱うメソッドは、必ずこのワークスペースで絞り込む
+func (r *BaseRepository) getPrincipal(ctx context.Context) (utils.Principal, error) {
+	p, ok := utils.PrincipalFromContext(ctx)
+	if !ok {
+		return utils.Principal{}, &ent.NotFoundError{}
+	}
+	return p, nil
 }
@@ -0,0 +1,138 @@
+package repositories
+
+import (
+	"context"
+	"time"
+	"todo-app/ent"
+	"todo-app/ent/emailchangetoken"
+)
+
+// メールアドレスの変更のトークンの用途
+const (
+	// 新しいメールアドレスに送信し、変更を確認するトークン
+	EmailChangePurposeConfirm = "confirm"
+	// パスワードの代わりに変更前のメールアドレスに送信し、本人であることを確認するトークン
+	EmailChangePurposeReauthenticate = "reauthenticate"
+)
+
+type IEmailChangeTokenRepository interface {
+	Create(ctx context.Context, userID int, oldEmail string, newEmail string, tokenHash string, cancelTokenHash string, expiresAt time.Time) (*ent.EmailChangeToken, error)
+	CreateReauthentication(ctx context.Context, userID int, oldEmail string, newEmail string, tokenHash string, expiresAt time.Time) (*ent.EmailChangeToken, error)
+	FindPending(ctx context.Context, userID int, now time.Time) (*ent.EmailChangeToken, error)
+	Consume(ctx context.Context, tokenHash string, purpose string) (*ent.EmailChangeToken, bool, error)
+	Cancel(ctx context.Context, cancelTokenHash string) (*ent.EmailChangeToken, bool, error)
+}
+
+type EmailChangeTokenRepository struct {
+	base *BaseRepository
+}
+
+func NewEmailChangeTokenRepository(client *ent.Client) *EmailChangeTokenRepository {
+	return &EmailChangeTokenRepository{
+		base: NewBaseRepository(client),
+	}
+}
+
+// Create はメールアドレスの変更を確認するトークンを作成する。
+// 最後に送信したメールのリンクだけが有効になるよう、未使用の古いトークンは使用済みにする
+func (r *EmailChangeTokenRepository) Create(ctx context.Context, userID int, oldEmail string, newEmail string, tokenHash string, cancelTokenHash string, expiresAt time.Time) (*ent.EmailChangeToken, error) {
+	if err := r.invalidateUnused(ctx, userID); err != nil {
+		return nil, err
+	}
+	return r.base.getClient(ctx).EmailChangeToken.Create().
+		SetUserID(userID).
+		SetOldEmail(oldEmail).
+		SetNewEmail(newEmail).
+		SetTokenHash(tokenHash).
+		SetCancelTokenHash(cancelTokenHash).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+}
+
+// CreateReauthentication は変更前のメールアドレスで本人であることを確認するトークンを作成する。
+// Create と同じく、未使用の古いトークンは使用済みにする
+func (r *EmailChangeTokenRepository) CreateReauthentication(ctx context.Context, userID int, oldEmail string, newEmail string, tokenHash string, expiresAt time.Time) (*ent.EmailChangeToken, error) {
+	if err := r.invalidateUnused(ctx, userID); err != nil {
+		return nil, err
+	}
+	return r.base.getClient(ctx).EmailChangeToken.Create().
+		SetUserID(userID).
+		SetOldEmail(oldEmail).
+		SetNewEmail(newEmail).
+		SetTokenHash(tokenHash).
+		SetPurpose(EmailChangePurposeReauthenticate).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+}
+
+func (r *EmailChangeTokenRepository) invalidateUnused(ctx context.Context, userID int) error {
+	return r.base.getClient(ctx).EmailChangeToken.Update().
+		Where(emailchangetoken.UserID(userID), emailchangetoken.UsedAtIsNil()).
+		SetUsedAt(time.Now()).
+		Exec(ctx)
+}
+
+// FindPending は新しいメールアドレスでの確認待ちの (未使用で有効期限内の) トークンを返す
+func (r *EmailChangeTokenRepository) FindPending(ctx context.Context, userID int, now time.Time) (*ent.EmailChangeToken, error) {
+	return r.base.getClient(ctx).EmailChangeToken.Query().
+		Where(
+			emailchangetoken.UserID(userID),
+			emailchangetoken.Purpose(EmailChangePurposeConfirm),
+			emailchangetoken.UsedAtIsNil(),
+			emailchangetoken.ExpiresAtGT(now),
+		).
+		Order(ent.Desc(emailchangetoken.FieldCreatedAt)).
+		First(ctx)
+}
+
+// Consume は用途が purpose のトークンを使用済みにして返す。用途が異なる場合は NotFound を返す。
+// 2 つ目の戻り値は今回の呼び出しで使用済みにした場合に true、既に使用済みだった場合に false となる
+func (r *EmailChangeTokenRepository) Consume(ctx context.Context, tokenHash string, purpose string) (*ent.EmailChangeToken, bool, error) {
+	client := r.base.getClient(ctx)
+	n, err := client.EmailChangeToken.Update().
+		Where(emailchangetoken.TokenHash(tokenHash), emailchangetoken.Purpose(purpose), emailchangetoken.UsedAtIsNil()).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	ect, err := client.EmailChangeToken.Query().
+		Where(emailchangetoken.TokenHash(tokenHash), emailchangetoken.Purpose(purpose)).
+		Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return ect, n == 1, nil
+}
+
+// Cancel は変更前のメールアドレスに送信したトークンで変更を取り消し、トークンを返す。
+// 確認前であれば確認のリンクも使用できなくする。
+// 2 つ目の戻り値は今回の呼び出しで取り消した場合に true、既に取り消していた場合に false となる
+func (r *EmailChangeTokenRepository) Cancel(ctx context.Context, cancelTokenHash string) (*ent.EmailChangeToken, bool, error) {
+	client := r.base.getClient(ctx)
+	now := time.Now()
+	n, err := client.EmailChangeToken.Update().
+		Where(emailchangetoken.CancelTokenHash(cancelTokenHash), emailchangetoken.CancelledAtIsNil()).
+		SetCancelledAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if n == 1 {
+		if err := client.EmailChangeToken.Update().
+			Where(emailchangetoken.CancelTokenHash(cancelTokenHash), emailchangetoken.UsedAtIsNil()).
+			SetUsedAt(now).
+			Exec(ctx); err != nil {
+			return nil, false, err
+		}
+	}
+
+	ect, err := client.EmailChangeToken.Query().
+		Where(emailchangetoken.CancelTokenHash(cancelTokenHash)).
+		Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return ect, n == 1, nil
+}
@@ -0,0 +1,117 @@
+package repositories
+
+import (
+	"context"
+	"time"
+	"todo-app/ent"
+	"todo-app/ent/loginattempt"
+	"todo-app/ent/predicate"
+)
+
+// ログインの試行の結果
+const (
+	LoginResultSucceeded          = "succeeded"
+	LoginResultInvalidCredentials = "invalid_credentials"
+	LoginResultInvalidMFACode     = "invalid_mfa_code"
+	// パスワードは正しく、2 段階認証のコードの入力待ち。成功・失敗のどちらとしても数えない
+	LoginResultMFARequired = "mfa_required"
+	// 試行の制限中のため、パスワードを確認せずに拒否した。失敗の回数には数えない
+	LoginResultThrottled = "throttled"
+	// パスワードは正しいが、管理者がアカウントを無効にしている。失敗の回数には数えない
+	LoginResultAccountDisabled = "account_disabled"
+	// パスワードやコードの確認中。結果が確定するまでは失敗として数え、同時に試行しても制限を回避できないようにする
+	LoginResultPending = "pending"
+)
+
+// 試行の制限に数える失敗の結果
+var loginFailureResults = []string{LoginResultInvalidCredentials, LoginResultInvalidMFACode, LoginResultPending}
+
+type ILoginAttemptRepository interface {
+	Create(ctx context.Context, email string, ipAddress string, userAgent string, userID *int, result string) (int, error)
+	UpdateResult(ctx context.Context, id int, userID *int, result string) error
+	FailuresByEmail(ctx context.Context, email string, since time.Time, excludeID int) (int, *time.Time, error)
+	FailuresByIP(ctx context.Context, ipAddress string, since time.Time, excludeID int) (int, *time.Time, error)
+}
+
+// LoginAttemptRepository はログインの試行の監査記録を扱う。ログイン前に呼び出すため、context のユーザーでは絞り込まない
+type LoginAttemptRepository struct {
+	base *BaseRepository
+}
+
+func NewLoginAttemptRepository(client *ent.Client) *LoginAttemptRepository {
+	return &LoginAttemptRepository{
+		base: NewBaseRepository(client),
+	}
+}
+
+// Create は試行を記録し、記録の ID を返す
+func (r *LoginAttemptRepository) Create(ctx context.Context, email string, ipAddress string, userAgent string, userID *int, result string) (int, error) {
+	client := r.base.getClient(ctx)
+	a, err := client.LoginAttempt.Create().
+		SetEmail(truncate(email, 255)).
+		SetIPAddress(truncate(ipAddress, 64)).
+		SetUserAgent(truncate(userAgent, 512)).
+		SetNillableUserID(userID).
+		SetResult(result).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return a.ID, nil
+}
+
+// UpdateResult は確認中として記録した試行の結果を確定する。userID が nil の場合は記録済みのユーザーを変更しない
+func (r *LoginAttemptRepository) UpdateResult(ctx context.Context, id int, userID *int, result string) error {
+	client := r.base.getClient(ctx)
+	return client.LoginAttempt.UpdateOneID(id).
+		SetNillableUserID(userID).
+		SetResult(result).
+		Exec(ctx)
+}
+
+// FailuresByEmail は since 以降、最後に成功してからのメールアドレスへの失敗の回数と、最後に失敗した日時を返す。
+// ID が excludeID の記録 (確認中の試行自身) は数えない
+func (r *LoginAttemptRepository) FailuresByEmail(ctx context.Context, email string, since time.Time, excludeID int) (int, *time.Time, error) {
+	client := r.base.getClient(ctx)
+	lastSuccess, err := client.LoginAttempt.Query().
+		Where(
+			loginattempt.Email(email),
+			loginattempt.Result(LoginResultSucceeded),
+			loginattempt.CreatedAtGTE(since),
+		).
+		Order(ent.Desc(loginattempt.FieldCreatedAt)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return 0, nil, err
+	}
+	if lastSuccess != nil {
+		since = lastSuccess.CreatedAt
+	}
+	return r.failures(ctx, loginattempt.Email(email), since, excludeID)
+}
+
+// FailuresByIP は since 以降の IP アドレスからの失敗の回数と、最後に失敗した日時を返す。
+// 攻撃者が自分のアカウントでログインして回数を戻せないよう、成功してもリセットしない
+func (r *LoginAttemptRepository) FailuresByIP(ctx context.Context, ipAddress string, since time.Time, excludeID int) (int, *time.Time, error) {
+	return r.failures(ctx, loginattempt.IPAddress(truncate(ipAddress, 64)), since, excludeID)
+}
+
+func (r *LoginAttemptRepository) failures(ctx context.Context, where predicate.LoginAttempt, since time.Time, excludeID int) (int, *time.Time, error) {
+	client := r.base.getClient(ctx)
+	query := client.LoginAttempt.Query().
+		Where(
+			where,
+			loginattempt.ResultIn(loginFailureResults...),
+			loginattempt.CreatedAtGT(since),
+			loginattempt.IDNEQ(excludeID),
+		)
+	n, err := query.Clone().Count(ctx)
+	if err != nil || n == 0 {
+		return 0, nil, err
+	}
+	last, err := query.Order(ent.Desc(loginattempt.FieldCreatedAt)).First(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	return n, &last.CreatedAt, nil
+}
@@ -0,0 +1,156 @@
+package repositories
+
+import (
+	"context"
+	"time"
+	"todo-app/ent"
+	"todo-app/ent/recoverycode"
+	"todo-app/ent/totpcredential"
+	"todo-app/ent/usedmfatoken"
+
+	"github.com/google/uuid"
+)
+
+type IMFARepository interface {
+	FindTOTP(ctx context.Context, userID int) (*ent.TOTPCredential, error)
+	SavePendingTOTP(ctx context.Context, userID int, secret string) (*ent.TOTPCredential, error)
+	ConfirmTOTP(ctx context.Context, id int, step int64) error
+	UseTOTPStep(ctx context.Context, id int, step int64) (bool, error)
+	DeleteTOTP(ctx context.Context, userID int) error
+	ReplaceRecoveryCodes(ctx context.Context, userID int, codeHashes []string) error
+	ConsumeRecoveryCode(ctx context.Context, userID int, codeHash string) (bool, error)
+	CountRecoveryCodes(ctx context.Context, userID int) (int, error)
+	UseMFAToken(ctx context.Context, userID int, jti uuid.UUID, expiresAt time.Time) (bool, error)
+}
+
+// MFARepository は 2 段階認証の TOTP のシークレットとリカバリーコードを扱う。
+// ログインの 2 段階目でも呼び出すため、context のユーザーではなく userID を明示的に受け取る
+type MFARepository struct {
+	base *BaseRepository
+}
+
+func NewMFARepository(client *ent.Client) *MFARepository {
+	return &MFARepository{
+		base: NewBaseRepository(client),
+	}
+}
+
+func (r *MFARepository) FindTOTP(ctx context.Context, userID int) (*ent.TOTPCredential, error) {
+	client := r.base.getClient(ctx)
+	return client.TOTPCredential.Query().
+		Where(totpcredential.UserID(userID)).
+		Only(ctx)
+}
+
+// SavePendingTOTP は未確認のシークレットを保存する。登録をやり直せるよう、未確認の古いシークレットは削除する
+func (r *MFARepository) SavePendingTOTP(ctx context.Context, userID int, secret string) (*ent.TOTPCredential, error) {
+	client := r.base.getClient(ctx)
+	if _, err := client.TOTPCredential.Delete().
+		Where(totpcredential.UserID(userID), totpcredential.ConfirmedAtIsNil()).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+	return client.TOTPCredential.Create().
+		SetUserID(userID).
+		SetSecret(secret).
+		Save(ctx)
+}
+
+// ConfirmTOTP は登録を確認済みにする。確認に使ったコードは再利用できないよう、そのステップを使用済みにする
+func (r *MFARepository) ConfirmTOTP(ctx context.Context, id int, step int64) error {
+	client := r.base.getClient(ctx)
+	return client.TOTPCredential.UpdateOneID(id).
+		SetConfirmedAt(time.Now()).
+		SetLastUsedStep(step).
+		Exec(ctx)
+}
+
+// UseTOTPStep はコードの時間ステップを使用済みにする。
+// 条件付きの UPDATE で判定するため、同じコードで同時に呼び出しても true になるのは 1 回だけ
+func (r *MFARepository) UseTOTPStep(ctx context.Context, id int, step int64) (bool, error) {
+	client := r.base.getClient(ctx)
+	n, err := client.TOTPCredential.Update().
+		Where(totpcredential.ID(id), totpcredential.LastUsedStepLT(step)).
+		SetLastUsedStep(step).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// DeleteTOTP は 2 段階認証を無効にする。リカバリーコードも削除する
+func (r *MFARepository) DeleteTOTP(ctx context.Context, userID int) error {
+	client := r.base.getClient(ctx)
+	if _, err := client.RecoveryCode.Delete().
+		Where(recoverycode.UserID(userID)).
+		Exec(ctx); err != nil {
+		return err
+	}
+	_, err := client.TOTPCredential.Delete().
+		Where(totpcredential.UserID(userID)).
+		Exec(ctx)
+	return err
+}
+
+// ReplaceRecoveryCodes はリカバリーコードを新しいものに置き換える。古いコードは使用できなくなる
+func (r *MFARepository) ReplaceRecoveryCodes(ctx context.Context, userID int, codeHashes []string) error {
+	client := r.base.getClient(ctx)
+	if _, err := client.RecoveryCode.Delete().
+		Where(recoverycode.UserID(userID)).
+		Exec(ctx); err != nil {
+		return err
+	}
+	builders := make([]*ent.RecoveryCodeCreate, len(codeHashes))
+	for i, h := range codeHashes {
+		builders[i] = client.RecoveryCode.Create().
+			SetUserID(userID).
+			SetCodeHash(h)
+	}
+	return client.RecoveryCode.CreateBulk(builders...).Exec(ctx)
+}
+
+// ConsumeRecoveryCode は未使用のリカバリーコードを使用済みにし、使用済みにできた場合に true を返す
+func (r *MFARepository) ConsumeRecoveryCode(ctx context.Context, userID int, codeHash string) (bool, error) {
+	client := r.base.getClient(ctx)
+	n, err := client.RecoveryCode.Update().
+		Where(recoverycode.UserID(userID), recoverycode.CodeHash(codeHash), recoverycode.UsedAtIsNil()).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// CountRecoveryCodes は未使用のリカバリーコードの数を返す
+func (r *MFARepository) CountRecoveryCodes(ctx context.Context, userID int) (int, error) {
+	client := r.base.getClient(ctx)
+	return client.RecoveryCode.Query().
+		Where(recoverycode.UserID(userID), recoverycode.UsedAtIsNil()).
+		Count(ctx)
+}
+
+// UseMFAToken は 2 段階認証のログインに使ったトークンの jti を使用済みにし、使用済みにできた場合に true を返す。
+// jti を主キーとして挿入するため、同じトークンで同時に呼び出しても true になるのは 1 回だけ
+func (r *MFARepository) UseMFAToken(ctx context.Context, userID int, jti uuid.UUID, expiresAt time.Time) (bool, error) {
+	client := r.base.getClient(ctx)
+	// 期限切れのトークンは jti を照合しなくても使用できないため、記録を残さない
+	if _, err := client.UsedMFAToken.Delete().
+		Where(usedmfatoken.UserID(userID), usedmfatoken.ExpiresAtLT(time.Now())).
+		Exec(ctx); err != nil {
+		return false, err
+	}
+	err := client.UsedMFAToken.Create().
+		SetID(jti).
+		SetUserID(userID).
+		SetExpiresAt(expiresAt).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
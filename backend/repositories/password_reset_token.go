@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"context"
+	"time"
+	"todo-app/ent"
+	"todo-app/ent/passwordresettoken"
+)
+
+type IPasswordResetTokenRepository interface {
+	Create(ctx context.Context, userID int, tokenHash string, expiresAt time.Time) (*ent.PasswordResetToken, error)
+	Consume(ctx context.Context, tokenHash string) (*ent.PasswordResetToken, bool, error)
+}
+
+type PasswordResetTokenRepository struct {
+	base *BaseRepository
+}
+
+func NewPasswordResetTokenRepository(client *ent.Client) *PasswordResetTokenRepository {
+	return &PasswordResetTokenRepository{
+		base: NewBaseRepository(client),
+	}
+}
+
+// Create はパスワード再設定用のトークンを作成する。
+// 最後に送信したメールのリンクだけが有効になるよう、未使用の古いトークンは使用済みにする。
+// ログイン前に呼び出すため、userID を明示的に受け取る
+func (r *PasswordResetTokenRepository) Create(ctx context.Context, userID int, tokenHash string, expiresAt time.Time) (*ent.PasswordResetToken, error) {
+	client := r.base.getClient(ctx)
+	if err := client.PasswordResetToken.Update().
+		Where(passwordresettoken.UserID(userID), passwordresettoken.UsedAtIsNil()).
+		SetUsedAt(time.Now()).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+	return client.PasswordResetToken.Create().
+		SetUserID(userID).
+		SetTokenHash(tokenHash).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+}
+
+// Consume はトークンを使用済みにして返す。
+// 2 つ目の戻り値は今回の呼び出しで使用済みにした場合に true、既に使用済みだった場合に false となる。
+// 条件付きの UPDATE で判定するため、同じトークンで同時に呼び出しても true になるのは 1 回だけ
+func (r *PasswordResetTokenRepository) Consume(ctx context.Context, tokenHash string) (*ent.PasswordResetToken, bool, error) {
+	client := r.base.getClient(ctx)
+	n, err := client.PasswordResetToken.Update().
+		Where(passwordresettoken.TokenHash(tokenHash), passwordresettoken.UsedAtIsNil()).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	prt, err := client.PasswordResetToken.Query().
+		Where(passwordresettoken.TokenHash(tokenHash)).
+		Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return prt, n == 1, nil
+}
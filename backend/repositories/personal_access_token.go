@@ -0,0 +1,109 @@
+package repositories
+
+import (
+	"context"
+	"time"
+	"todo-app/ent"
+	"todo-app/ent/personalaccesstoken"
+	"todo-app/ent/user"
+
+	"github.com/google/uuid"
+)
+
+type IPersonalAccessTokenRepository interface {
+	Create(ctx context.Context, name string, tokenHash string, tokenPrefix string, scopes []string, expiresAt *time.Time) (*ent.PersonalAccessToken, error)
+	FindActiveByHash(ctx context.Context, tokenHash string) (*ent.PersonalAccessToken, error)
+	Touch(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context) ([]*ent.PersonalAccessToken, error)
+	Revoke(ctx context.Context, id uuid.UUID) error
+	RevokeAllByUserID(ctx context.Context, userID int) (int, error)
+}
+
+type PersonalAccessTokenRepository struct {
+	base *BaseRepository
+}
+
+func NewPersonalAccessTokenRepository(client *ent.Client) *PersonalAccessTokenRepository {
+	return &PersonalAccessTokenRepository{
+		base: NewBaseRepository(client),
+	}
+}
+
+func (r *PersonalAccessTokenRepository) Create(ctx context.Context, name string, tokenHash string, tokenPrefix string, scopes []string, expiresAt *time.Time) (*ent.PersonalAccessToken, error) {
+	u, err := r.base.getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := r.base.getClient(ctx)
+	return client.PersonalAccessToken.Create().
+		SetUserID(u.ID).
+		SetName(name).
+		SetTokenHash(tokenHash).
+		SetTokenPrefix(tokenPrefix).
+		SetScopes(scopes).
+		SetNillableExpiresAt(expiresAt).
+		Save(ctx)
+}
+
+// FindActiveByHash は失効しておらず、期限切れでもないトークンを返す。認証前に呼び出すため、ユーザーでは絞り込まない
+func (r *PersonalAccessTokenRepository) FindActiveByHash(ctx context.Context, tokenHash string) (*ent.PersonalAccessToken, error) {
+	client := r.base.getClient(ctx)
+	return client.PersonalAccessToken.Query().
+		Where(
+			personalaccesstoken.TokenHash(tokenHash),
+			personalaccesstoken.RevokedAtIsNil(),
+			personalaccesstoken.Or(personalaccesstoken.ExpiresAtIsNil(), personalaccesstoken.ExpiresAtGT(time.Now())),
+		).
+		Only(ctx)
+}
+
+// Touch は最後に使用した日時を更新する
+func (r *PersonalAccessTokenRepository) Touch(ctx context.Context, id uuid.UUID) error {
+	client := r.base.getClient(ctx)
+	return client.PersonalAccessToken.UpdateOneID(id).
+		SetLastUsedAt(time.Now()).
+		Exec(ctx)
+}
+
+// List はユーザーの失効していないトークンを新しい順に返す。期限切れのトークンも含む
+func (r *PersonalAccessTokenRepository) List(ctx context.Context) ([]*ent.PersonalAccessToken, error) {
+	u, err := r.base.getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := r.base.getClient(ctx)
+	return client.PersonalAccessToken.Query().
+		Where(personalaccesstoken.HasUserWith(user.ID(u.ID)), personalaccesstoken.RevokedAtIsNil()).
+		Order(ent.Desc(personalaccesstoken.FieldCreatedAt)).
+		All(ctx)
+}
+
+// Revoke はユーザーのトークンを失効させる。該当するトークンが無い場合は NotFoundError を返す
+func (r *PersonalAccessTokenRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	u, err := r.base.getUser(ctx)
+	if err != nil {
+		return err
+	}
+	client := r.base.getClient(ctx)
+	n, err := client.PersonalAccessToken.Update().
+		Where(personalaccesstoken.ID(id), personalaccesstoken.HasUserWith(user.ID(u.ID)), personalaccesstoken.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+	return nil
+}
+
+// RevokeAllByUserID はユーザーの全てのトークンを失効させ、失効させた件数を返す。
+// パスワードの再設定などログイン前にも呼び出すため、userID を明示的に受け取る
+func (r *PersonalAccessTokenRepository) RevokeAllByUserID(ctx context.Context, userID int) (int, error) {
+	client := r.base.getClient(ctx)
+	return client.PersonalAccessToken.Update().
+		Where(personalaccesstoken.HasUserWith(user.ID(userID)), personalaccesstoken.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+}
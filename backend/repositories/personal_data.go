@@ -0,0 +1,119 @@
+package repositories
+
+import (
+	"context"
+	"todo-app/ent"
+	"todo-app/ent/aiusage"
+	"todo-app/ent/loginattempt"
+	"todo-app/ent/personalaccesstoken"
+	"todo-app/ent/session"
+	"todo-app/ent/todo"
+	"todo-app/ent/todobreakdown"
+	"todo-app/ent/todofilterhistory"
+	"todo-app/ent/todosummary"
+	"todo-app/ent/useridentity"
+)
+
+// IPersonalDataRepository はデータのエクスポートのため、ユーザーに紐づくデータを offset から limit 件ずつ古い順に返す
+type IPersonalDataRepository interface {
+	ListTodos(ctx context.Context, userID int, offset int, limit int) ([]*ent.Todo, error)
+	ListTodoFilterHistories(ctx context.Context, userID int, offset int, limit int) ([]*ent.TodoFilterHistory, error)
+	ListTodoBreakdowns(ctx context.Context, userID int, offset int, limit int) ([]*ent.TodoBreakdown, error)
+	ListTodoSummaries(ctx context.Context, userID int, offset int, limit int) ([]*ent.TodoSummary, error)
+	ListSessions(ctx context.Context, userID int, offset int, limit int) ([]*ent.Session, error)
+	ListAIUsages(ctx context.Context, userID int, offset int, limit int) ([]*ent.AIUsage, error)
+	ListPersonalAccessTokens(ctx context.Context, userID int, offset int, limit int) ([]*ent.PersonalAccessToken, error)
+	ListIdentities(ctx context.Context, userID int, offset int, limit int) ([]*ent.UserIdentity, error)
+	ListLoginAttempts(ctx context.Context, userID int, offset int, limit int) ([]*ent.LoginAttempt, error)
+}
+
+type PersonalDataRepository struct {
+	base *BaseRepository
+}
+
+func NewPersonalDataRepository(client *ent.Client) *PersonalDataRepository {
+	return &PersonalDataRepository{
+		base: NewBaseRepository(client),
+	}
+}
+
+func (r *PersonalDataRepository) ListTodos(ctx context.Context, userID int, offset int, limit int) ([]*ent.Todo, error) {
+	return r.base.getClient(ctx).Todo.Query().
+		Where(todo.UserID(userID)).
+		Order(ent.Asc(todo.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+}
+
+func (r *PersonalDataRepository) ListTodoFilterHistories(ctx context.Context, userID int, offset int, limit int) ([]*ent.TodoFilterHistory, error) {
+	return r.base.getClient(ctx).TodoFilterHistory.Query().
+		Where(todofilterhistory.UserID(userID)).
+		Order(ent.Asc(todofilterhistory.FieldCreatedAt), ent.Asc(todofilterhistory.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+}
+
+func (r *PersonalDataRepository) ListTodoBreakdowns(ctx context.Context, userID int, offset int, limit int) ([]*ent.TodoBreakdown, error) {
+	return r.base.getClient(ctx).TodoBreakdown.Query().
+		Where(todobreakdown.UserID(userID)).
+		Order(ent.Asc(todobreakdown.FieldCreatedAt), ent.Asc(todobreakdown.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+}
+
+func (r *PersonalDataRepository) ListTodoSummaries(ctx context.Context, userID int, offset int, limit int) ([]*ent.TodoSummary, error) {
+	return r.base.getClient(ctx).TodoSummary.Query().
+		Where(todosummary.UserID(userID)).
+		Order(ent.Asc(todosummary.FieldCreatedAt), ent.Asc(todosummary.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+}
+
+func (r *PersonalDataRepository) ListSessions(ctx context.Context, userID int, offset int, limit int) ([]*ent.Session, error) {
+	return r.base.getClient(ctx).Session.Query().
+		Where(session.UserID(userID)).
+		Order(ent.Asc(session.FieldCreatedAt), ent.Asc(session.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+}
+
+func (r *PersonalDataRepository) ListAIUsages(ctx context.Context, userID int, offset int, limit int) ([]*ent.AIUsage, error) {
+	return r.base.getClient(ctx).AIUsage.Query().
+		Where(aiusage.UserID(userID)).
+		Order(ent.Asc(aiusage.FieldCreatedAt), ent.Asc(aiusage.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+}
+
+func (r *PersonalDataRepository) ListPersonalAccessTokens(ctx context.Context, userID int, offset int, limit int) ([]*ent.PersonalAccessToken, error) {
+	return r.base.getClient(ctx).PersonalAccessToken.Query().
+		Where(personalaccesstoken.UserID(userID)).
+		Order(ent.Asc(personalaccesstoken.FieldCreatedAt), ent.Asc(personalaccesstoken.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+}
+
+func (r *PersonalDataRepository) ListIdentities(ctx context.Context, userID int, offset int, limit int) ([]*ent.UserIdentity, error) {
+	return r.base.getClient(ctx).UserIdentity.Query().
+		Where(useridentity.UserID(userID)).
+		Order(ent.Asc(useridentity.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+}
+
+func (r *PersonalDataRepository) ListLoginAttempts(ctx context.Context, userID int, offset int, limit int) ([]*ent.LoginAttempt, error) {
+	return r.base.getClient(ctx).LoginAttempt.Query().
+		Where(loginattempt.UserID(userID)).
+		Order(ent.Asc(loginattempt.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+}
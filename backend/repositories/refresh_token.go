@@ -0,0 +1,57 @@
+package repositories
+
+import (
+	"context"
+	"time"
+	"todo-app/ent"
+	"todo-app/ent/refreshtoken"
+
+	"github.com/google/uuid"
+)
+
+type IRefreshTokenRepository interface {
+	Create(ctx context.Context, sessionID uuid.UUID, tokenHash string, expiresAt time.Time) (*ent.RefreshToken, error)
+	Consume(ctx context.Context, tokenHash string) (*ent.RefreshToken, bool, error)
+}
+
+type RefreshTokenRepository struct {
+	base *BaseRepository
+}
+
+func NewRefreshTokenRepository(client *ent.Client) *RefreshTokenRepository {
+	return &RefreshTokenRepository{
+		base: NewBaseRepository(client),
+	}
+}
+
+func (r *RefreshTokenRepository) Create(ctx context.Context, sessionID uuid.UUID, tokenHash string, expiresAt time.Time) (*ent.RefreshToken, error) {
+	client := r.base.getClient(ctx)
+	return client.RefreshToken.Create().
+		SetSessionID(sessionID).
+		SetTokenHash(tokenHash).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+}
+
+// Consume はトークンを使用済みにし、セッションと合わせて返す。
+// 2 つ目の戻り値は今回の呼び出しで使用済みにした場合に true、既に使用済みだった場合に false となる。
+// 条件付きの UPDATE で判定するため、同じトークンで同時に呼び出しても true になるのは 1 回だけ
+func (r *RefreshTokenRepository) Consume(ctx context.Context, tokenHash string) (*ent.RefreshToken, bool, error) {
+	client := r.base.getClient(ctx)
+	n, err := client.RefreshToken.Update().
+		Where(refreshtoken.TokenHash(tokenHash), refreshtoken.UsedAtIsNil()).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	rt, err := client.RefreshToken.Query().
+		Where(refreshtoken.TokenHash(tokenHash)).
+		WithSession().
+		Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return rt, n == 1, nil
+}
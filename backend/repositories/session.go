@@ -0,0 +1,153 @@
+package repositories
+
+import (
+	"context"
+	"strings"
+	"time"
+	"todo-app/ent"
+	"todo-app/ent/session"
+	"todo-app/ent/user"
+
+	"github.com/google/uuid"
+)
+
+type ISessionRepository interface {
+	Create(ctx context.Context, userID int, ipAddress string, userAgent string, expiresAt time.Time) (*ent.Session, error)
+	FindActive(ctx context.Context, id uuid.UUID) (*ent.Session, error)
+	RevokeByID(ctx context.Context, id uuid.UUID) error
+	Touch(ctx context.Context, id uuid.UUID, ipAddress string, userAgent string) error
+	ListActive(ctx context.Context, seenSince time.Time) ([]*ent.Session, error)
+	Revoke(ctx context.Context, id uuid.UUID) error
+	RevokeAll(ctx context.Context) (int, error)
+	RevokeAllByUserID(ctx context.Context, userID int, except *uuid.UUID) (int, error)
+	CreateImpersonation(ctx context.Context, userID int, impersonatorID int, ipAddress string, userAgent string, expiresAt time.Time) (*ent.Session, error)
+}
+
+type SessionRepository struct {
+	base *BaseRepository
+}
+
+func NewSessionRepository(client *ent.Client) *SessionRepository {
+	return &SessionRepository{
+		base: NewBaseRepository(client),
+	}
+}
+
+// Create はログインしたユーザーのセッションを作成する。
+// ログイン時は context にユーザーが無いため、userID を明示的に受け取る
+func (r *SessionRepository) Create(ctx context.Context, userID int, ipAddress string, userAgent string, expiresAt time.Time) (*ent.Session, error) {
+	client := r.base.getClient(ctx)
+	return client.Session.Create().
+		SetUserID(userID).
+		SetIPAddress(truncate(ipAddress, 64)).
+		SetUserAgent(truncate(userAgent, 512)).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+}
+
+// CreateImpersonation は管理者 impersonatorID が userID になりすますためのセッションを作成する
+func (r *SessionRepository) CreateImpersonation(ctx context.Context, userID int, impersonatorID int, ipAddress string, userAgent string, expiresAt time.Time) (*ent.Session, error) {
+	client := r.base.getClient(ctx)
+	return client.Session.Create().
+		SetUserID(userID).
+		SetImpersonatorID(impersonatorID).
+		SetIPAddress(truncate(ipAddress, 64)).
+		SetUserAgent(truncate(userAgent, 512)).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+}
+
+// FindActive は失効しておらず、期限切れでもないセッションを返す。認証前に呼び出すため、ユーザーでは絞り込まない
+func (r *SessionRepository) FindActive(ctx context.Context, id uuid.UUID) (*ent.Session, error) {
+	client := r.base.getClient(ctx)
+	return client.Session.Query().
+		Where(session.ID(id), session.RevokedAtIsNil(), session.ExpiresAtGT(time.Now())).
+		Only(ctx)
+}
+
+// RevokeByID はセッションを失効させる。
+// リフレッシュトークンの再利用を検知した場合など、認証前に呼び出すためユーザーでは絞り込まない
+func (r *SessionRepository) RevokeByID(ctx context.Context, id uuid.UUID) error {
+	client := r.base.getClient(ctx)
+	return client.Session.Update().
+		Where(session.ID(id), session.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Exec(ctx)
+}
+
+// Touch は最後にアクセスした日時と端末の情報を更新する
+func (r *SessionRepository) Touch(ctx context.Context, id uuid.UUID, ipAddress string, userAgent string) error {
+	client := r.base.getClient(ctx)
+	return client.Session.UpdateOneID(id).
+		SetLastSeenAt(time.Now()).
+		SetIPAddress(truncate(ipAddress, 64)).
+		SetUserAgent(truncate(userAgent, 512)).
+		Exec(ctx)
+}
+
+// ListActive は seenSince 以降にアクセスがあり、失効も期限切れもしていないセッションを新しい順に返す
+func (r *SessionRepository) ListActive(ctx context.Context, seenSince time.Time) ([]*ent.Session, error) {
+	u, err := r.base.getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := r.base.getClient(ctx)
+	return client.Session.Query().
+		Where(session.HasUserWith(user.ID(u.ID))).
+		Where(session.RevokedAtIsNil(), session.ExpiresAtGT(time.Now()), session.LastSeenAtGTE(seenSince)).
+		Order(ent.Desc(session.FieldLastSeenAt)).
+		All(ctx)
+}
+
+// Revoke はユーザーのセッションを失効させる。該当するセッションが無い場合は NotFoundError を返す
+func (r *SessionRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	u, err := r.base.getUser(ctx)
+	if err != nil {
+		return err
+	}
+	client := r.base.getClient(ctx)
+	n, err := client.Session.Update().
+		Where(session.ID(id), session.HasUserWith(user.ID(u.ID)), session.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+	return nil
+}
+
+// RevokeAll はユーザーの全てのセッションを失効させ、失効させた件数を返す
+func (r *SessionRepository) RevokeAll(ctx context.Context) (int, error) {
+	u, err := r.base.getUser(ctx)
+	if err != nil {
+		return 0, err
+	}
+	client := r.base.getClient(ctx)
+	return client.Session.Update().
+		Where(session.HasUserWith(user.ID(u.ID)), session.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+}
+
+// RevokeAllByUserID は except を除くユーザーの全てのセッションを失効させ、失効させた件数を返す。
+// パスワードの再設定などログイン前にも呼び出すため、userID を明示的に受け取る
+func (r *SessionRepository) RevokeAllByUserID(ctx context.Context, userID int, except *uuid.UUID) (int, error) {
+	client := r.base.getClient(ctx)
+	q := client.Session.Update().
+		Where(session.UserID(userID), session.RevokedAtIsNil())
+	if except != nil {
+		q = q.Where(session.IDNEQ(*except))
+	}
+	return q.SetRevokedAt(time.Now()).Save(ctx)
+}
+
+// truncate は s を maxLen バイト以内に切り詰める。マルチバイト文字の途中で切れた部分は取り除く
+func truncate(s string, maxLen int) string {
+	if len(s) <= maxLen {
+		return s
+	}
+	return strings.ToValidUTF8(s[:maxLen], "")
+}
@@ -6,15 +6,15 @@ import (
 	"time"
 	"todo-app/ent"
 	"todo-app/ent/todo"
-	"todo-app/ent/user"
 )
 
 type ITodoRepository interface {
-	FetchTodos(ctx context.Context, limit int, offset int, includeDone bool) ([]*ent.Todo, error)
-	GetTodoCount(ctx context.Context, includeDone bool) (int, error)
+	FetchTodos(ctx context.Context, limit int, offset int, includeDone bool, listID *int) ([]*ent.Todo, error)
+	GetTodoCount(ctx context.Context, includeDone bool, listID *int) (int, error)
 	FindTodo(ctx context.Context, id int) (*ent.Todo, error)
 	GetTodoForUpdate(ctx context.Context, id int) (*ent.Todo, error)
-	CreateTodo(ctx context.Context, title string, description string) (*ent.Todo, error)
+	CreateTodo(ctx context.Context, title string, description string, listID *int) (*ent.Todo, error)
+	CreateChildTodo(ctx context.Context, parentID int, title string, description string) (*ent.Todo, error)
 	UpdateTodo(ctx context.Context, id int, title *string, description *string) (*ent.Todo, error)
 	UpdateDoneStatus(ctx context.Context, id int, isDone bool) (*ent.Todo, error)
 	DeleteTodo(ctx context.Context, id int) error
@@ -34,15 +34,19 @@ func NewTodoRepository(client *ent.Client, logger *slog.Logger) *TodoRepository
 	}
 }
 
-func (r *TodoRepository) FetchTodos(ctx context.Context, limit int, offset int, includeDone bool) ([]*ent.Todo, error) {
-	u, err := r.base.getUser(ctx)
+// FetchTodos はログイン中のユーザーが閲覧できる Todo を返す。listID を指定した場合はそのリストの Todo に絞り込む
+func (r *TodoRepository) FetchTodos(ctx context.Context, limit int, offset int, includeDone bool, listID *int) ([]*ent.Todo, error) {
+	p, err := r.base.getPrincipal(ctx)
 	if err != nil {
 		return nil, err
 	}
 	client := r.base.getClient(ctx)
 	query := client.Todo.Query().
-		Where(todo.HasUserWith(user.ID(u.ID)))
+		Where(todoReadableBy(p))
 
+	if listID != nil {
+		query.Where(todo.ListID(*listID))
+	}
 	if !includeDone {
 		query.Where(todo.DoneAtIsNil())
 	}
@@ -54,15 +58,18 @@ func (r *TodoRepository) FetchTodos(ctx context.Context, limit int, offset int,
 		All(ctx)
 }
 
-func (r *TodoRepository) GetTodoCount(ctx context.Context, includeDone bool) (int, error) {
-	u, err := r.base.getUser(ctx)
+func (r *TodoRepository) GetTodoCount(ctx context.Context, includeDone bool, listID *int) (int, error) {
+	p, err := r.base.getPrincipal(ctx)
 	if err != nil {
 		return 0, err
 	}
 	client := r.base.getClient(ctx)
 	query := client.Todo.Query().
-		Where(todo.HasUserWith(user.ID(u.ID)))
+		Where(todoReadableBy(p))
 
+	if listID != nil {
+		query.Where(todo.ListID(*listID))
+	}
 	if !includeDone {
 		query.Where(todo.DoneAtIsNil())
 	}
@@ -71,53 +78,80 @@ func (r *TodoRepository) GetTodoCount(ctx context.Context, includeDone bool) (in
 }
 
 func (r *TodoRepository) FindTodo(ctx context.Context, id int) (*ent.Todo, error) {
-	u, err := r.base.getUser(ctx)
+	p, err := r.base.getPrincipal(ctx)
 	if err != nil {
 		return nil, err
 	}
 	client := r.base.getClient(ctx)
 	return client.Todo.Query().
 		Where(todo.ID(id)).
-		Where(todo.HasUserWith(user.ID(u.ID))).
+		Where(todoReadableBy(p)).
 		Only(ctx)
 }
 
-func (r *TodoRepository) CreateTodo(ctx context.Context, title string, description string) (*ent.Todo, error) {
-	u, err := r.base.getUser(ctx)
+// CreateTodo は Todo を作成する。listID を指定した場合はそのリストの Todo として作成する。
+// リストの権限は呼び出し元で確認する
+func (r *TodoRepository) CreateTodo(ctx context.Context, title string, description string, listID *int) (*ent.Todo, error) {
+	p, err := r.base.getPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := r.base.getClient(ctx)
+	return client.Todo.Create().
+		SetTitle(title).
+		SetDescription(description).
+		SetNillableListID(listID).
+		SetUser(p.User).
+		SetWorkspaceID(p.WorkspaceID).
+		Save(ctx)
+}
+
+// CreateChildTodo は親の Todo と同じリストに子の Todo を作成する。親の Todo を変更できない場合は NotFoundError を返す
+func (r *TodoRepository) CreateChildTodo(ctx context.Context, parentID int, title string, description string) (*ent.Todo, error) {
+	p, err := r.base.getPrincipal(ctx)
 	if err != nil {
 		return nil, err
 	}
 	client := r.base.getClient(ctx)
+	parent, err := client.Todo.Query().
+		Where(todo.ID(parentID), todoWritableBy(p)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
 	return client.Todo.Create().
 		SetTitle(title).
 		SetDescription(description).
-		SetUser(u).
+		SetParentID(parentID).
+		SetNillableListID(parent.ListID).
+		SetUser(p.User).
+		SetWorkspaceID(p.WorkspaceID).
 		Save(ctx)
 }
 
 func (r *TodoRepository) UpdateTodo(ctx context.Context, id int, title *string, description *string) (*ent.Todo, error) {
-	u, err := r.base.getUser(ctx)
+	p, err := r.base.getPrincipal(ctx)
 	if err != nil {
 		return nil, err
 	}
 	client := r.base.getClient(ctx)
 
 	return client.Todo.UpdateOneID(id).
-		Where(todo.HasUserWith(user.ID(u.ID))).
+		Where(todoWritableBy(p)).
 		SetNillableTitle(title).
 		SetNillableDescription(description).
 		Save(ctx)
 }
 
 func (r *TodoRepository) UpdateDoneStatus(ctx context.Context, id int, isDone bool) (*ent.Todo, error) {
-	u, err := r.base.getUser(ctx)
+	p, err := r.base.getPrincipal(ctx)
 	if err != nil {
 		return nil, err
 	}
 	client := r.base.getClient(ctx)
 
 	update := client.Todo.UpdateOneID(id).
-		Where(todo.HasUserWith(user.ID(u.ID)))
+		Where(todoWritableBy(p))
 
 	if isDone {
 		update.SetDoneAt(time.Now())
@@ -129,7 +163,7 @@ func (r *TodoRepository) UpdateDoneStatus(ctx context.Context, id int, isDone bo
 }
 
 func (r *TodoRepository) GetTodoForUpdate(ctx context.Context, id int) (*ent.Todo, error) {
-	u, err := r.base.getUser(ctx)
+	p, err := r.base.getPrincipal(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -137,7 +171,7 @@ func (r *TodoRepository) GetTodoForUpdate(ctx context.Context, id int) (*ent.Tod
 
 	query := client.Todo.Query().
 		Where(todo.ID(id)).
-		Where(todo.HasUserWith(user.ID(u.ID))).
+		Where(todoWritableBy(p)).
 		ForUpdate()
 
 	res, err := query.Only(ctx)
@@ -148,13 +182,13 @@ func (r *TodoRepository) GetTodoForUpdate(ctx context.Context, id int) (*ent.Tod
 }
 
 func (r *TodoRepository) FetchTodosByDoneAt(ctx context.Context, doneFrom *time.Time, doneTo *time.Time) ([]*ent.Todo, error) {
-	u, err := r.base.getUser(ctx)
+	p, err := r.base.getPrincipal(ctx)
 	if err != nil {
 		return nil, err
 	}
 	client := r.base.getClient(ctx)
 	query := client.Todo.Query().
-		Where(todo.HasUserWith(user.ID(u.ID)))
+		Where(todoReadableBy(p))
 
 	if doneFrom != nil {
 		query.Where(todo.DoneAtGTE(*doneFrom))
@@ -169,27 +203,27 @@ func (r *TodoRepository) FetchTodosByDoneAt(ctx context.Context, doneFrom *time.
 }
 
 func (r *TodoRepository) FetchTodosByIds(ctx context.Context, ids []int) ([]*ent.Todo, error) {
-	u, err := r.base.getUser(ctx)
+	p, err := r.base.getPrincipal(ctx)
 	if err != nil {
 		return nil, err
 	}
 	client := r.base.getClient(ctx)
 	return client.Todo.Query().
-		Where(todo.HasUserWith(user.ID(u.ID))).
+		Where(todoReadableBy(p)).
 		Where(todo.IDIn(ids...)).
 		All(ctx)
 }
 
 func (r *TodoRepository) DeleteTodo(ctx context.Context, id int) error {
-	u, err := r.base.getUser(ctx)
+	p, err := r.base.getPrincipal(ctx)
 	if err != nil {
 		return err
 	}
 	client := r.base.getClient(ctx)
-	// Verify ownership and delete
+	// 変更できる Todo のみ削除する
 	n, err := client.Todo.Delete().
 		Where(todo.ID(id)).
-		Where(todo.HasUserWith(user.ID(u.ID))).
+		Where(todoWritableBy(p)).
 		Exec(ctx)
 	if err != nil {
 		return err
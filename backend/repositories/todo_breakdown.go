@@ -0,0 +1,72 @@
+package repositories
+
+import (
+	"context"
+	"time"
+	"todo-app/ent"
+	"todo-app/ent/todobreakdown"
+
+	"github.com/google/uuid"
+)
+
+type ITodoBreakdownRepository interface {
+	CreateBreakdown(ctx context.Context, todoID int, model string, steps []map[string]string) (*ent.TodoBreakdown, error)
+	FindBreakdown(ctx context.Context, todoID int, id uuid.UUID) (*ent.TodoBreakdown, error)
+	MarkAccepted(ctx context.Context, id uuid.UUID, stepIndexes []int) (int, error)
+}
+
+type TodoBreakdownRepository struct {
+	base *BaseRepository
+}
+
+func NewTodoBreakdownRepository(client *ent.Client) *TodoBreakdownRepository {
+	return &TodoBreakdownRepository{
+		base: NewBaseRepository(client),
+	}
+}
+
+// CreateBreakdown はリクエストの対象のワークスペースの Todo の分解結果を記録する
+func (r *TodoBreakdownRepository) CreateBreakdown(ctx context.Context, todoID int, model string, steps []map[string]string) (*ent.TodoBreakdown, error) {
+	p, err := r.base.getPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := r.base.getClient(ctx)
+	return client.TodoBreakdown.Create().
+		SetUserID(p.User.ID).
+		SetWorkspaceID(p.WorkspaceID).
+		SetTodoID(todoID).
+		SetModel(model).
+		SetSteps(steps).
+		Save(ctx)
+}
+
+// FindBreakdown はリクエストの対象のワークスペースで、ログイン中のユーザーが作成した分解結果を返す
+func (r *TodoBreakdownRepository) FindBreakdown(ctx context.Context, todoID int, id uuid.UUID) (*ent.TodoBreakdown, error) {
+	p, err := r.base.getPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := r.base.getClient(ctx)
+	return client.TodoBreakdown.Query().
+		Where(todobreakdown.IDEQ(id)).
+		Where(todobreakdown.TodoIDEQ(todoID)).
+		Where(todobreakdown.UserID(p.User.ID), todobreakdown.WorkspaceID(p.WorkspaceID)).
+		Only(ctx)
+}
+
+// MarkAccepted は未採用の分解結果のみを更新し、更新件数を返す
+func (r *TodoBreakdownRepository) MarkAccepted(ctx context.Context, id uuid.UUID, stepIndexes []int) (int, error) {
+	p, err := r.base.getPrincipal(ctx)
+	if err != nil {
+		return 0, err
+	}
+	client := r.base.getClient(ctx)
+	return client.TodoBreakdown.Update().
+		Where(todobreakdown.IDEQ(id)).
+		Where(todobreakdown.UserID(p.User.ID), todobreakdown.WorkspaceID(p.WorkspaceID)).
+		Where(todobreakdown.AcceptedAtIsNil()).
+		SetAcceptedStepIndexes(stepIndexes).
+		SetAcceptedAt(time.Now()).
+		Save(ctx)
+}
@@ -0,0 +1,88 @@
+package repositories
+
+import (
+	"context"
+	"todo-app/ent"
+	"todo-app/ent/todo"
+	"todo-app/ent/todoembedding"
+)
+
+type ITodoEmbeddingRepository interface {
+	FetchTodosWithEmbedding(ctx context.Context) ([]*ent.Todo, error)
+	SaveEmbedding(ctx context.Context, todoID int, model string, contentHash string, vector []float32) (*ent.TodoEmbedding, error)
+}
+
+type TodoEmbeddingRepository struct {
+	base *BaseRepository
+}
+
+func NewTodoEmbeddingRepository(client *ent.Client) *TodoEmbeddingRepository {
+	return &TodoEmbeddingRepository{
+		base: NewBaseRepository(client),
+	}
+}
+
+// FetchTodosWithEmbedding はユーザーが閲覧できる全ての ToDo を、ベクトルがあれば合わせて取得する
+func (r *TodoEmbeddingRepository) FetchTodosWithEmbedding(ctx context.Context) ([]*ent.Todo, error) {
+	p, err := r.base.getPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := r.base.getClient(ctx)
+	return client.Todo.Query().
+		Where(todoReadableBy(p)).
+		WithEmbedding().
+		Order(ent.Desc(todo.FieldUpdatedAt), ent.Desc(todo.FieldID)).
+		All(ctx)
+}
+
+// SaveEmbedding は ToDo のベクトルが既にあれば上書きし、無ければ作成する
+func (r *TodoEmbeddingRepository) SaveEmbedding(ctx context.Context, todoID int, model string, contentHash string, vector []float32) (*ent.TodoEmbedding, error) {
+	p, err := r.base.getPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := r.base.getClient(ctx)
+
+	exists, err := client.Todo.Query().
+		Where(todo.ID(todoID)).
+		Where(todoReadableBy(p)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, &ent.NotFoundError{}
+	}
+
+	existing, err := client.TodoEmbedding.Query().
+		Where(todoembedding.TodoID(todoID)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if existing == nil {
+		created, err := client.TodoEmbedding.Create().
+			SetTodoID(todoID).
+			SetModel(model).
+			SetContentHash(contentHash).
+			SetVector(vector).
+			Save(ctx)
+		if err == nil || !ent.IsConstraintError(err) {
+			return created, err
+		}
+		// 同時に作成された場合は作成済みのものを更新する
+		existing, err = client.TodoEmbedding.Query().
+			Where(todoembedding.TodoID(todoID)).
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return client.TodoEmbedding.UpdateOneID(existing.ID).
+		SetModel(model).
+		SetContentHash(contentHash).
+		SetVector(vector).
+		Save(ctx)
+}
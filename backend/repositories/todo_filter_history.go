@@ -4,15 +4,15 @@ import (
 	"context"
 	"todo-app/ent"
 	"todo-app/ent/todofilterhistory"
-	"todo-app/ent/user"
 
 	"github.com/google/uuid"
 )
 
 type ITodoFilterHistoryRepository interface {
 	FetchLatestFilters(ctx context.Context, limit int) ([]*ent.TodoFilterHistory, error)
-	SaveFilterHistory(ctx context.Context, query string, functionName *string, args map[string]interface{}, resultTodoIds []int) (*ent.TodoFilterHistory, error)
+	SaveFilterHistory(ctx context.Context, query string, normalizedQuery string, dateBucket string, promptVersion string, functionName *string, args map[string]interface{}, resultTodoIds []int) (*ent.TodoFilterHistory, error)
 	GetFilterHistoryByQueryID(ctx context.Context, queryID uuid.UUID) (*ent.TodoFilterHistory, error)
+	FindCachedFilter(ctx context.Context, normalizedQuery string, dateBucket string, promptVersion string) (*ent.TodoFilterHistory, error)
 }
 
 type TodoFilterHistoryRepository struct {
@@ -26,27 +26,31 @@ func NewTodoFilterHistoryRepository(client *ent.Client) *TodoFilterHistoryReposi
 }
 
 func (r *TodoFilterHistoryRepository) FetchLatestFilters(ctx context.Context, limit int) ([]*ent.TodoFilterHistory, error) {
-	u, err := r.base.getUser(ctx)
+	p, err := r.base.getPrincipal(ctx)
 	if err != nil {
 		return nil, err
 	}
 	client := r.base.getClient(ctx)
 	return client.TodoFilterHistory.Query().
-		Where(todofilterhistory.HasUserWith(user.ID(u.ID))).
+		Where(todofilterhistory.UserID(p.User.ID), todofilterhistory.WorkspaceID(p.WorkspaceID)).
 		Order(ent.Desc(todofilterhistory.FieldCreatedAt)).
 		Limit(limit).
 		All(ctx)
 }
 
-func (r *TodoFilterHistoryRepository) SaveFilterHistory(ctx context.Context, query string, functionName *string, args map[string]interface{}, resultTodoIds []int) (*ent.TodoFilterHistory, error) {
-	u, err := r.base.getUser(ctx)
+func (r *TodoFilterHistoryRepository) SaveFilterHistory(ctx context.Context, query string, normalizedQuery string, dateBucket string, promptVersion string, functionName *string, args map[string]interface{}, resultTodoIds []int) (*ent.TodoFilterHistory, error) {
+	p, err := r.base.getPrincipal(ctx)
 	if err != nil {
 		return nil, err
 	}
 	client := r.base.getClient(ctx)
 	create := client.TodoFilterHistory.Create().
-		SetUserID(u.ID).
+		SetUserID(p.User.ID).
+		SetWorkspaceID(p.WorkspaceID).
 		SetQuery(query).
+		SetNormalizedQuery(normalizedQuery).
+		SetDateBucket(dateBucket).
+		SetPromptVersion(promptVersion).
 		SetNillableFunctionName(functionName).
 		SetArgs(args).
 		SetResultTodoIds(resultTodoIds)
@@ -55,13 +59,30 @@ func (r *TodoFilterHistoryRepository) SaveFilterHistory(ctx context.Context, que
 }
 
 func (r *TodoFilterHistoryRepository) GetFilterHistoryByQueryID(ctx context.Context, queryID uuid.UUID) (*ent.TodoFilterHistory, error) {
-	u, err := r.base.getUser(ctx)
+	p, err := r.base.getPrincipal(ctx)
 	if err != nil {
 		return nil, err
 	}
 	client := r.base.getClient(ctx)
 	return client.TodoFilterHistory.Query().
 		Where(todofilterhistory.IDEQ(queryID)).
-		Where(todofilterhistory.HasUserWith(user.ID(u.ID))).
+		Where(todofilterhistory.UserID(p.User.ID), todofilterhistory.WorkspaceID(p.WorkspaceID)).
 		Only(ctx)
 }
+
+// FindCachedFilter は同じクエリ・同じ期間・同じプロンプトで AI が判定した最新の履歴を返す
+func (r *TodoFilterHistoryRepository) FindCachedFilter(ctx context.Context, normalizedQuery string, dateBucket string, promptVersion string) (*ent.TodoFilterHistory, error) {
+	p, err := r.base.getPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := r.base.getClient(ctx)
+	return client.TodoFilterHistory.Query().
+		Where(todofilterhistory.UserID(p.User.ID), todofilterhistory.WorkspaceID(p.WorkspaceID)).
+		Where(todofilterhistory.NormalizedQueryEQ(normalizedQuery)).
+		Where(todofilterhistory.DateBucketEQ(dateBucket)).
+		Where(todofilterhistory.PromptVersionEQ(promptVersion)).
+		Where(todofilterhistory.FunctionNameNotNil()).
+		Order(ent.Desc(todofilterhistory.FieldCreatedAt)).
+		First(ctx)
+}
@@ -0,0 +1,312 @@
+package repositories
+
+import (
+	"context"
+	"slices"
+	"todo-app/ent"
+	"todo-app/ent/predicate"
+	"todo-app/ent/todo"
+	"todo-app/ent/todolist"
+	"todo-app/ent/todolistmember"
+	"todo-app/ent/workspace"
+	"todo-app/ent/workspacemember"
+	"todo-app/utils"
+)
+
+// 共有のリストのメンバーの権限
+const (
+	TodoListRoleViewer = "viewer"
+	TodoListRoleEditor = "editor"
+	TodoListRoleOwner  = "owner"
+)
+
+// todoListWriteRoles はリストの Todo を追加・変更・削除できる権限
+var todoListWriteRoles = []string{TodoListRoleEditor, TodoListRoleOwner}
+
+// CanEditTodoList は権限がリストの Todo を追加・変更・削除できるかを返す
+func CanEditTodoList(role string) bool {
+	return slices.Contains(todoListWriteRoles, role)
+}
+
+// todoReadableBy はユーザーが閲覧できる Todo に絞り込む。
+// リクエストの対象のワークスペースの Todo のうち、リストに属さない Todo は作成したユーザーだけが、
+// リストに属する Todo はリストのメンバーが閲覧できる
+func todoReadableBy(p utils.Principal) predicate.Todo {
+	return todo.And(
+		todo.WorkspaceID(p.WorkspaceID),
+		todo.Or(
+			todo.And(todo.ListIDIsNil(), todo.UserID(p.User.ID)),
+			todo.HasListWith(todolist.HasMembersWith(todolistmember.UserID(p.User.ID))),
+		),
+	)
+}
+
+// todoWritableBy はユーザーが変更できる Todo に絞り込む。
+// リストに属する Todo は editor 以上の権限のメンバーが変更できる
+func todoWritableBy(p utils.Principal) predicate.Todo {
+	return todo.And(
+		todo.WorkspaceID(p.WorkspaceID),
+		todo.Or(
+			todo.And(todo.ListIDIsNil(), todo.UserID(p.User.ID)),
+			todo.HasListWith(todolist.HasMembersWith(
+				todolistmember.UserID(p.User.ID),
+				todolistmember.RoleIn(todoListWriteRoles...),
+			)),
+		),
+	)
+}
+
+// todoListJoinedBy はリクエストの対象のワークスペースのリストのうち、ユーザーが参加しているリストに絞り込む
+func todoListJoinedBy(p utils.Principal) predicate.TodoList {
+	return todolist.And(
+		todolist.WorkspaceID(p.WorkspaceID),
+		todolist.HasMembersWith(todolistmember.UserID(p.User.ID)),
+	)
+}
+
+// ensureTodoListJoined はユーザーが参加しているリストであることを確認し、そうでない場合は NotFoundError を返す
+func ensureTodoListJoined(ctx context.Context, client *ent.Client, p utils.Principal, listID int) error {
+	ok, err := client.TodoList.Query().
+		Where(todolist.ID(listID), todoListJoinedBy(p)).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return &ent.NotFoundError{}
+	}
+	return nil
+}
+
+type ITodoListRepository interface {
+	ListMemberships(ctx context.Context) ([]*ent.TodoListMember, error)
+	FindMembership(ctx context.Context, listID int) (*ent.TodoListMember, error)
+	Create(ctx context.Context, name string) (*ent.TodoList, error)
+	Rename(ctx context.Context, listID int, name string) (*ent.TodoList, error)
+	Delete(ctx context.Context, listID int) error
+	ListMembers(ctx context.Context, listID int) ([]*ent.TodoListMember, error)
+	AddMember(ctx context.Context, listID int, userID int, role string) (*ent.TodoListMember, error)
+	SetMemberRole(ctx context.Context, listID int, userID int, role string) (*ent.TodoListMember, error)
+	RemoveMember(ctx context.Context, listID int, userID int) error
+	CountOwners(ctx context.Context, listID int) (int, error)
+	CountLastOwnedByUser(ctx context.Context, userID int) (int, error)
+}
+
+type TodoListRepository struct {
+	base *BaseRepository
+}
+
+func NewTodoListRepository(client *ent.Client) *TodoListRepository {
+	return &TodoListRepository{
+		base: NewBaseRepository(client),
+	}
+}
+
+// ListMemberships はリクエストの対象のワークスペースで、ログイン中のユーザーが参加しているリストを、リストと合わせて作成順に返す
+func (r *TodoListRepository) ListMemberships(ctx context.Context) ([]*ent.TodoListMember, error) {
+	p, err := r.base.getPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.base.getClient(ctx).TodoListMember.Query().
+		Where(
+			todolistmember.UserID(p.User.ID),
+			todolistmember.HasListWith(todolist.WorkspaceID(p.WorkspaceID)),
+		).
+		WithList().
+		Order(ent.Asc(todolistmember.FieldListID)).
+		All(ctx)
+}
+
+// FindMembership はログイン中のユーザーのリストでの権限を返す。
+// メンバーでない場合や、リストがリクエストの対象のワークスペースに属さない場合は NotFoundError を返す
+func (r *TodoListRepository) FindMembership(ctx context.Context, listID int) (*ent.TodoListMember, error) {
+	p, err := r.base.getPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.base.getClient(ctx).TodoListMember.Query().
+		Where(
+			todolistmember.ListID(listID),
+			todolistmember.UserID(p.User.ID),
+			todolistmember.HasListWith(todolist.WorkspaceID(p.WorkspaceID)),
+		).
+		WithList().
+		Only(ctx)
+}
+
+// Create はリクエストの対象のワークスペースにリストを作成し、ログイン中のユーザーを所有者として追加する。
+// 呼び出し元でトランザクションを開始する
+func (r *TodoListRepository) Create(ctx context.Context, name string) (*ent.TodoList, error) {
+	p, err := r.base.getPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := r.base.getClient(ctx)
+	l, err := client.TodoList.Create().
+		SetName(name).
+		SetWorkspaceID(p.WorkspaceID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := client.TodoListMember.Create().
+		SetListID(l.ID).
+		SetUserID(p.User.ID).
+		SetRole(TodoListRoleOwner).
+		Save(ctx); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (r *TodoListRepository) Rename(ctx context.Context, listID int, name string) (*ent.TodoList, error) {
+	p, err := r.base.getPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.base.getClient(ctx).TodoList.UpdateOneID(listID).
+		Where(todoListJoinedBy(p)).
+		SetName(name).
+		Save(ctx)
+}
+
+// Delete はリストを削除する。リストの Todo、メンバー、招待も削除される
+func (r *TodoListRepository) Delete(ctx context.Context, listID int) error {
+	p, err := r.base.getPrincipal(ctx)
+	if err != nil {
+		return err
+	}
+	return r.base.getClient(ctx).TodoList.DeleteOneID(listID).
+		Where(todoListJoinedBy(p)).
+		Exec(ctx)
+}
+
+// ListMembers はリストのメンバーを、ユーザーと合わせて参加順に返す
+func (r *TodoListRepository) ListMembers(ctx context.Context, listID int) ([]*ent.TodoListMember, error) {
+	p, err := r.base.getPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	members, err := r.base.getClient(ctx).TodoListMember.Query().
+		Where(
+			todolistmember.ListID(listID),
+			todolistmember.HasListWith(todoListJoinedBy(p)),
+		).
+		WithUser().
+		Order(ent.Asc(todolistmember.FieldCreatedAt), ent.Asc(todolistmember.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 参加しているリストには少なくともログイン中のユーザーがいるため、空の場合は参照できないリスト
+	if len(members) == 0 {
+		return nil, &ent.NotFoundError{}
+	}
+	return members, nil
+}
+
+// AddMember はユーザーをリストのメンバーに追加する。既にメンバーの場合は ConstraintError を返す。
+// 招待はリクエストの対象と異なるワークスペースのリストでも承諾できるため、
+// 追加するユーザーがリストのワークスペースのメンバーであることで絞り込み、そうでない場合は NotFoundError を返す
+func (r *TodoListRepository) AddMember(ctx context.Context, listID int, userID int, role string) (*ent.TodoListMember, error) {
+	client := r.base.getClient(ctx)
+	ok, err := client.TodoList.Query().
+		Where(
+			todolist.ID(listID),
+			todolist.HasWorkspaceWith(workspace.HasMembersWith(workspacemember.UserID(userID))),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, &ent.NotFoundError{}
+	}
+	return client.TodoListMember.Create().
+		SetListID(listID).
+		SetUserID(userID).
+		SetRole(role).
+		Save(ctx)
+}
+
+func (r *TodoListRepository) SetMemberRole(ctx context.Context, listID int, userID int, role string) (*ent.TodoListMember, error) {
+	if err := r.ensureJoined(ctx, listID); err != nil {
+		return nil, err
+	}
+	client := r.base.getClient(ctx)
+	n, err := client.TodoListMember.Update().
+		Where(todolistmember.ListID(listID), todolistmember.UserID(userID)).
+		SetRole(role).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, &ent.NotFoundError{}
+	}
+	return client.TodoListMember.Query().
+		Where(todolistmember.ListID(listID), todolistmember.UserID(userID)).
+		WithUser().
+		Only(ctx)
+}
+
+func (r *TodoListRepository) RemoveMember(ctx context.Context, listID int, userID int) error {
+	if err := r.ensureJoined(ctx, listID); err != nil {
+		return err
+	}
+	n, err := r.base.getClient(ctx).TodoListMember.Delete().
+		Where(todolistmember.ListID(listID), todolistmember.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+	return nil
+}
+
+// CountOwners はリストの所有者の人数を返す。最後の所有者を外さないよう確認するために使う。
+// ログイン中のユーザーが自分を外した後にも呼ぶため、メンバーであることではなくワークスペースで絞り込む
+func (r *TodoListRepository) CountOwners(ctx context.Context, listID int) (int, error) {
+	p, err := r.base.getPrincipal(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return r.base.getClient(ctx).TodoListMember.Query().
+		Where(
+			todolistmember.ListID(listID),
+			todolistmember.Role(TodoListRoleOwner),
+			todolistmember.HasListWith(todolist.WorkspaceID(p.WorkspaceID)),
+		).
+		Count(ctx)
+}
+
+// ensureJoined はリストがリクエストの対象のワークスペースに属し、ログイン中のユーザーがメンバーであることを確認する。
+// そうでない場合は NotFoundError を返す。
+// MySQL は更新・削除するテーブルをサブクエリで参照できないため、メンバーを変更する前に確認する
+func (r *TodoListRepository) ensureJoined(ctx context.Context, listID int) error {
+	p, err := r.base.getPrincipal(ctx)
+	if err != nil {
+		return err
+	}
+	return ensureTodoListJoined(ctx, r.base.getClient(ctx), p, listID)
+}
+
+// CountLastOwnedByUser はユーザーが唯一の所有者で、他のメンバーもいるリストの件数を返す。
+// 退会で所有者のいないリストが残らないよう確認するために使う。ワークスペースに関係なく数える
+func (r *TodoListRepository) CountLastOwnedByUser(ctx context.Context, userID int) (int, error) {
+	return r.base.getClient(ctx).TodoList.Query().
+		Where(lastOwnedTodoListsOf(userID)).
+		Count(ctx)
+}
+
+// lastOwnedTodoListsOf はユーザーが唯一の所有者で、他のメンバーもいるリストに絞り込む
+func lastOwnedTodoListsOf(userID int) predicate.TodoList {
+	return todolist.And(
+		todolist.HasMembersWith(todolistmember.UserID(userID), todolistmember.Role(TodoListRoleOwner)),
+		todolist.Not(todolist.HasMembersWith(todolistmember.UserIDNEQ(userID), todolistmember.Role(TodoListRoleOwner))),
+		todolist.HasMembersWith(todolistmember.UserIDNEQ(userID)),
+	)
+}
@@ -0,0 +1,112 @@
+package repositories
+
+import (
+	"context"
+	"time"
+	"todo-app/ent"
+	"todo-app/ent/todolistinvite"
+
+	"github.com/google/uuid"
+)
+
+type ITodoListInviteRepository interface {
+	Create(ctx context.Context, listID int, email string, role string, tokenHash string, invitedBy int, expiresAt time.Time) (*ent.TodoListInvite, error)
+	ListPending(ctx context.Context, listID int, now time.Time) ([]*ent.TodoListInvite, error)
+	Revoke(ctx context.Context, listID int, id uuid.UUID) error
+	Consume(ctx context.Context, tokenHash string) (*ent.TodoListInvite, bool, error)
+}
+
+type TodoListInviteRepository struct {
+	base *BaseRepository
+}
+
+func NewTodoListInviteRepository(client *ent.Client) *TodoListInviteRepository {
+	return &TodoListInviteRepository{
+		base: NewBaseRepository(client),
+	}
+}
+
+// Create は招待を作成する。
+// 最後に送信したメールのリンクだけが有効になるよう、同じメールアドレスへの未使用の古い招待は使用済みにする
+func (r *TodoListInviteRepository) Create(ctx context.Context, listID int, email string, role string, tokenHash string, invitedBy int, expiresAt time.Time) (*ent.TodoListInvite, error) {
+	client := r.base.getClient(ctx)
+	if err := client.TodoListInvite.Update().
+		Where(todolistinvite.ListID(listID), todolistinvite.EmailEqualFold(email), todolistinvite.UsedAtIsNil()).
+		SetUsedAt(time.Now()).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+	return client.TodoListInvite.Create().
+		SetListID(listID).
+		SetEmail(email).
+		SetRole(role).
+		SetTokenHash(tokenHash).
+		SetInvitedBy(invitedBy).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+}
+
+// ListPending は承諾待ちの (未使用で有効期限内の) 招待を新しい順に返す。参加していないリストの場合は NotFoundError を返す
+func (r *TodoListInviteRepository) ListPending(ctx context.Context, listID int, now time.Time) ([]*ent.TodoListInvite, error) {
+	p, err := r.base.getPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := r.base.getClient(ctx)
+	if err := ensureTodoListJoined(ctx, client, p, listID); err != nil {
+		return nil, err
+	}
+	return client.TodoListInvite.Query().
+		Where(
+			todolistinvite.ListID(listID),
+			todolistinvite.UsedAtIsNil(),
+			todolistinvite.ExpiresAtGT(now),
+		).
+		Order(ent.Desc(todolistinvite.FieldCreatedAt)).
+		All(ctx)
+}
+
+// Revoke は承諾待ちの招待を取り消す。該当する招待が無い場合や、参加していないリストの場合は NotFoundError を返す
+func (r *TodoListInviteRepository) Revoke(ctx context.Context, listID int, id uuid.UUID) error {
+	p, err := r.base.getPrincipal(ctx)
+	if err != nil {
+		return err
+	}
+	client := r.base.getClient(ctx)
+	if err := ensureTodoListJoined(ctx, client, p, listID); err != nil {
+		return err
+	}
+	n, err := client.TodoListInvite.Update().
+		Where(todolistinvite.ID(id), todolistinvite.ListID(listID), todolistinvite.UsedAtIsNil()).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+	return nil
+}
+
+// Consume は招待を使用済みにして、リストと合わせて返す。
+// 2 つ目の戻り値は今回の呼び出しで使用済みにした場合に true、既に使用済みだった場合に false となる
+func (r *TodoListInviteRepository) Consume(ctx context.Context, tokenHash string) (*ent.TodoListInvite, bool, error) {
+	client := r.base.getClient(ctx)
+	n, err := client.TodoListInvite.Update().
+		Where(todolistinvite.TokenHash(tokenHash), todolistinvite.UsedAtIsNil()).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	invite, err := client.TodoListInvite.Query().
+		Where(todolistinvite.TokenHash(tokenHash)).
+		WithList().
+		Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return invite, n == 1, nil
+}
@@ -0,0 +1,79 @@
+package repositories
+
+import (
+	"context"
+	"time"
+	"todo-app/ent"
+	"todo-app/ent/todosummary"
+)
+
+type ITodoSummaryRepository interface {
+	FindSummary(ctx context.Context, from time.Time, to time.Time) (*ent.TodoSummary, error)
+	SaveSummary(ctx context.Context, from time.Time, to time.Time, sourceHash string, model string, content string, markdown string) (*ent.TodoSummary, error)
+}
+
+type TodoSummaryRepository struct {
+	base *BaseRepository
+}
+
+func NewTodoSummaryRepository(client *ent.Client) *TodoSummaryRepository {
+	return &TodoSummaryRepository{
+		base: NewBaseRepository(client),
+	}
+}
+
+// FindSummary はリクエストの対象のワークスペースで、ログイン中のユーザーが作成した期間のサマリーを返す
+func (r *TodoSummaryRepository) FindSummary(ctx context.Context, from time.Time, to time.Time) (*ent.TodoSummary, error) {
+	p, err := r.base.getPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := r.base.getClient(ctx)
+	return client.TodoSummary.Query().
+		Where(todosummary.UserID(p.User.ID), todosummary.WorkspaceID(p.WorkspaceID)).
+		Where(todosummary.RangeFromEQ(from)).
+		Where(todosummary.RangeToEQ(to)).
+		Only(ctx)
+}
+
+// SaveSummary は同じ期間のサマリーが既にあれば上書きし、無ければ作成する
+func (r *TodoSummaryRepository) SaveSummary(ctx context.Context, from time.Time, to time.Time, sourceHash string, model string, content string, markdown string) (*ent.TodoSummary, error) {
+	p, err := r.base.getPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := r.base.getClient(ctx)
+
+	existing, err := r.FindSummary(ctx, from, to)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if existing == nil {
+		created, err := client.TodoSummary.Create().
+			SetUserID(p.User.ID).
+			SetWorkspaceID(p.WorkspaceID).
+			SetRangeFrom(from).
+			SetRangeTo(to).
+			SetSourceHash(sourceHash).
+			SetModel(model).
+			SetContent(content).
+			SetMarkdown(markdown).
+			Save(ctx)
+		if err == nil || !ent.IsConstraintError(err) {
+			return created, err
+		}
+		// 同時に作成された場合は作成済みのものを更新する
+		existing, err = r.FindSummary(ctx, from, to)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return client.TodoSummary.UpdateOneID(existing.ID).
+		Where(todosummary.UserID(p.User.ID), todosummary.WorkspaceID(p.WorkspaceID)).
+		SetSourceHash(sourceHash).
+		SetModel(model).
+		SetContent(content).
+		SetMarkdown(markdown).
+		Save(ctx)
+}
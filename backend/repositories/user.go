@@ -2,13 +2,45 @@ package repositories
 
 import (
 	"context"
+	"strings"
+	"time"
 	"todo-app/ent"
+	"todo-app/ent/todo"
+	"todo-app/ent/todolist"
+	"todo-app/ent/todolistmember"
 	"todo-app/ent/user"
+	"todo-app/ent/workspace"
+	"todo-app/ent/workspacemember"
 )
 
 type IUserRepository interface {
 	FindByEmail(ctx context.Context, email string) (*ent.User, error)
 	FindById(ctx context.Context, id int) (*ent.User, error)
+	Create(ctx context.Context, name string, email string, passwordHash string) (*ent.User, error)
+	UpdatePassword(ctx context.Context, id int, passwordHash string) error
+	UpdateProfile(ctx context.Context, id int, update UserProfileUpdate) (*ent.User, error)
+	UpdateEmail(ctx context.Context, id int, email string) error
+	MarkEmailVerified(ctx context.Context, id int) error
+	ScheduleDeletion(ctx context.Context, id int, at time.Time) (*ent.User, error)
+	CancelDeletion(ctx context.Context, id int) (*ent.User, error)
+	DeleteScheduled(ctx context.Context, now time.Time) (int, error)
+	SetDisabledAt(ctx context.Context, id int, at *time.Time) (*ent.User, error)
+	SetRole(ctx context.Context, id int, role string) (*ent.User, error)
+}
+
+// ユーザーの権限
+const (
+	UserRoleUser  = "user"
+	UserRoleAdmin = "admin"
+)
+
+// UserProfileUpdate はプロフィールの変更内容。nil のフィールドは変更しない
+type UserProfileUpdate struct {
+	Name            *string
+	Locale          *string
+	TimeZone        *string
+	TodoPageSize    *int
+	TodoIncludeDone *bool
 }
 
 type UserRepository struct {
@@ -19,12 +51,154 @@ func NewUserRepository(client *ent.Client) *UserRepository {
 	return &UserRepository{client: client}
 }
 
+// FindByEmail は大文字・小文字を区別せずにメールアドレスでユーザーを検索する
 func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*ent.User, error) {
 	return r.client.User.Query().
-		Where(user.EmailEQ(email)).
+		Where(user.EmailEqualFold(normalizeEmail(email))).
 		Only(ctx)
 }
 
 func (r *UserRepository) FindById(ctx context.Context, id int) (*ent.User, error) {
 	return r.client.User.Get(ctx, id)
 }
+
+func (r *UserRepository) Create(ctx context.Context, name string, email string, passwordHash string) (*ent.User, error) {
+	return r.client.User.Create().
+		SetName(name).
+		SetEmail(normalizeEmail(email)).
+		SetPassword(passwordHash).
+		Save(ctx)
+}
+
+func (r *UserRepository) UpdatePassword(ctx context.Context, id int, passwordHash string) error {
+	return r.client.User.UpdateOneID(id).
+		SetPassword(passwordHash).
+		Exec(ctx)
+}
+
+func (r *UserRepository) UpdateProfile(ctx context.Context, id int, update UserProfileUpdate) (*ent.User, error) {
+	return r.client.User.UpdateOneID(id).
+		SetNillableName(update.Name).
+		SetNillableLocale(update.Locale).
+		SetNillableTimeZone(update.TimeZone).
+		SetNillableTodoPageSize(update.TodoPageSize).
+		SetNillableTodoIncludeDone(update.TodoIncludeDone).
+		Save(ctx)
+}
+
+// UpdateEmail は確認済みのメールアドレスに変更する。email は一意制約があるため、登録済みの場合は制約エラーを返す
+func (r *UserRepository) UpdateEmail(ctx context.Context, id int, email string) error {
+	return r.client.User.UpdateOneID(id).
+		SetEmail(normalizeEmail(email)).
+		SetEmailVerifiedAt(time.Now()).
+		Exec(ctx)
+}
+
+// MarkEmailVerified は現在のメールアドレスを確認済みにする
+func (r *UserRepository) MarkEmailVerified(ctx context.Context, id int) error {
+	return r.client.User.UpdateOneID(id).
+		SetEmailVerifiedAt(time.Now()).
+		Exec(ctx)
+}
+
+// ScheduleDeletion は at を過ぎたら完全に削除するよう、ユーザーの退会を予約する
+func (r *UserRepository) ScheduleDeletion(ctx context.Context, id int, at time.Time) (*ent.User, error) {
+	return r.client.User.UpdateOneID(id).
+		SetDeletionScheduledAt(at).
+		Save(ctx)
+}
+
+func (r *UserRepository) CancelDeletion(ctx context.Context, id int) (*ent.User, error) {
+	return r.client.User.UpdateOneID(id).
+		ClearDeletionScheduledAt().
+		Save(ctx)
+}
+
+// DeleteScheduled は退会の予約日時を過ぎたユーザーを削除し、削除した件数を返す。
+// ユーザーに紐づくデータは外部キーの ON DELETE CASCADE で、個人のワークスペースの Todo はワークスペースごと削除される。
+// 共有のリストの Todo は作成したユーザーを未設定にして残し、ユーザーだけが参加するリスト、チームのワークスペースとリストに属さない Todo は削除する。
+// 申請後に他のメンバーの所有者や管理者がいなくなったリストやワークスペースがあるユーザーは、権限を移すまで削除しない
+func (r *UserRepository) DeleteScheduled(ctx context.Context, now time.Time) (int, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	n, err := deleteScheduledUsers(ctx, tx.Client(), now)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+	return n, tx.Commit()
+}
+
+func deleteScheduledUsers(ctx context.Context, client *ent.Client, now time.Time) (int, error) {
+	candidates, err := client.User.Query().
+		Where(user.DeletionScheduledAtLTE(now)).
+		IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var ids []int
+	for _, id := range candidates {
+		owned, err := client.TodoList.Query().Where(lastOwnedTodoListsOf(id)).Exist(ctx)
+		if err != nil {
+			return 0, err
+		}
+		admined, err := client.Workspace.Query().Where(lastAdminedWorkspacesOf(id)).Exist(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if !owned && !admined {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	if _, err := client.Workspace.Delete().
+		Where(
+			workspace.PersonalOwnerIDIsNil(),
+			workspace.Not(workspace.HasMembersWith(workspacemember.UserIDNotIn(ids...))),
+		).
+		Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	if _, err := client.TodoList.Delete().
+		Where(todolist.Not(todolist.HasMembersWith(todolistmember.UserIDNotIn(ids...)))).
+		Exec(ctx); err != nil {
+		return 0, err
+	}
+	if _, err := client.Todo.Delete().
+		Where(todo.UserIDIn(ids...), todo.ListIDIsNil()).
+		Exec(ctx); err != nil {
+		return 0, err
+	}
+	return client.User.Delete().
+		Where(user.IDIn(ids...)).
+		Exec(ctx)
+}
+
+// SetDisabledAt はユーザーを無効にする。at が nil の場合は有効に戻す
+func (r *UserRepository) SetDisabledAt(ctx context.Context, id int, at *time.Time) (*ent.User, error) {
+	q := r.client.User.UpdateOneID(id)
+	if at == nil {
+		q.ClearDisabledAt()
+	} else {
+		q.SetDisabledAt(*at)
+	}
+	return q.Save(ctx)
+}
+
+func (r *UserRepository) SetRole(ctx context.Context, id int, role string) (*ent.User, error) {
+	return r.client.User.UpdateOneID(id).
+		SetRole(role).
+		Save(ctx)
+}
+
+// normalizeEmail は大文字・小文字だけが異なるメールアドレスを別のアカウントとして登録させないよう、小文字にする
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"context"
+	"time"
+	"todo-app/ent"
+	"todo-app/ent/useridentity"
+)
+
+type IUserIdentityRepository interface {
+	FindByProviderSubject(ctx context.Context, provider string, subject string) (*ent.UserIdentity, error)
+	Create(ctx context.Context, userID int, provider string, subject string, email string) (*ent.UserIdentity, error)
+	TouchLogin(ctx context.Context, id int) error
+}
+
+// UserIdentityRepository は OIDC のアカウントとユーザーの紐付けを扱う。
+// ログイン前に呼び出すため、context のユーザーでは絞り込まない
+type UserIdentityRepository struct {
+	base *BaseRepository
+}
+
+func NewUserIdentityRepository(client *ent.Client) *UserIdentityRepository {
+	return &UserIdentityRepository{
+		base: NewBaseRepository(client),
+	}
+}
+
+func (r *UserIdentityRepository) FindByProviderSubject(ctx context.Context, provider string, subject string) (*ent.UserIdentity, error) {
+	client := r.base.getClient(ctx)
+	return client.UserIdentity.Query().
+		Where(useridentity.Provider(provider), useridentity.Subject(subject)).
+		Only(ctx)
+}
+
+func (r *UserIdentityRepository) Create(ctx context.Context, userID int, provider string, subject string, email string) (*ent.UserIdentity, error) {
+	client := r.base.getClient(ctx)
+	return client.UserIdentity.Create().
+		SetUserID(userID).
+		SetProvider(provider).
+		SetSubject(subject).
+		SetEmail(truncate(email, 255)).
+		Save(ctx)
+}
+
+func (r *UserIdentityRepository) TouchLogin(ctx context.Context, id int) error {
+	client := r.base.getClient(ctx)
+	return client.UserIdentity.UpdateOneID(id).
+		SetLastLoginAt(time.Now()).
+		Exec(ctx)
+}
@@ -0,0 +1,292 @@
+package repositories
+
+import (
+	"context"
+	"todo-app/ent"
+	"todo-app/ent/predicate"
+	"todo-app/ent/todolist"
+	"todo-app/ent/todolistmember"
+	"todo-app/ent/user"
+	"todo-app/ent/workspace"
+	"todo-app/ent/workspacemember"
+)
+
+// ワークスペースのメンバーの権限
+const (
+	WorkspaceRoleMember = "member"
+	WorkspaceRoleAdmin  = "admin"
+)
+
+// PersonalWorkspaceName は個人のワークスペースを作成する際の名前
+const PersonalWorkspaceName = "Personal"
+
+// workspaceJoinedBy はユーザーが参加しているワークスペースに絞り込む
+func workspaceJoinedBy(userID int) predicate.Workspace {
+	return workspace.HasMembersWith(workspacemember.UserID(userID))
+}
+
+// ensureWorkspaceJoined はユーザーが参加しているワークスペースであることを確認し、そうでない場合は NotFoundError を返す
+func ensureWorkspaceJoined(ctx context.Context, client *ent.Client, userID int, workspaceID int) error {
+	ok, err := client.Workspace.Query().
+		Where(workspace.ID(workspaceID), workspaceJoinedBy(userID)).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return &ent.NotFoundError{}
+	}
+	return nil
+}
+
+type IWorkspaceRepository interface {
+	EnsurePersonal(ctx context.Context, userID int) (*ent.WorkspaceMember, error)
+	ListMemberships(ctx context.Context) ([]*ent.WorkspaceMember, error)
+	FindMembership(ctx context.Context, workspaceID int) (*ent.WorkspaceMember, error)
+	Create(ctx context.Context, name string) (*ent.Workspace, error)
+	Rename(ctx context.Context, workspaceID int, name string) (*ent.Workspace, error)
+	Delete(ctx context.Context, workspaceID int) error
+	ListMembers(ctx context.Context, workspaceID int) ([]*ent.WorkspaceMember, error)
+	IsMember(ctx context.Context, workspaceID int, userID int) (bool, error)
+	AddMember(ctx context.Context, workspaceID int, userID int, role string) (*ent.WorkspaceMember, error)
+	SetMemberRole(ctx context.Context, workspaceID int, userID int, role string) (*ent.WorkspaceMember, error)
+	RemoveMember(ctx context.Context, workspaceID int, userID int) error
+	CountAdmins(ctx context.Context, workspaceID int) (int, error)
+	CountLastAdminedByUser(ctx context.Context, userID int) (int, error)
+}
+
+type WorkspaceRepository struct {
+	base *BaseRepository
+}
+
+func NewWorkspaceRepository(client *ent.Client) *WorkspaceRepository {
+	return &WorkspaceRepository{
+		base: NewBaseRepository(client),
+	}
+}
+
+// EnsurePersonal はユーザーの個人のワークスペースでのメンバーの情報を、ワークスペースと合わせて返す。
+// まだ作成していない場合は作成する
+func (r *WorkspaceRepository) EnsurePersonal(ctx context.Context, userID int) (*ent.WorkspaceMember, error) {
+	client := r.base.getClient(ctx)
+	find := func() (*ent.WorkspaceMember, error) {
+		return client.WorkspaceMember.Query().
+			Where(
+				workspacemember.UserID(userID),
+				workspacemember.HasWorkspaceWith(workspace.PersonalOwnerID(userID)),
+			).
+			WithWorkspace().
+			Only(ctx)
+	}
+
+	m, err := find()
+	if err == nil || !ent.IsNotFound(err) {
+		return m, err
+	}
+
+	ws, err := client.Workspace.Create().
+		SetName(PersonalWorkspaceName).
+		SetPersonalOwnerID(userID).
+		Save(ctx)
+	if err != nil {
+		// 同時に作成された場合は作成済みのものを返す
+		if ent.IsConstraintError(err) {
+			return find()
+		}
+		return nil, err
+	}
+	m, err = client.WorkspaceMember.Create().
+		SetWorkspaceID(ws.ID).
+		SetUserID(userID).
+		SetRole(WorkspaceRoleAdmin).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m.Edges.Workspace = ws
+	return m, nil
+}
+
+// ListMemberships はログイン中のユーザーが参加しているワークスペースを、ワークスペースと合わせて作成順に返す
+func (r *WorkspaceRepository) ListMemberships(ctx context.Context) ([]*ent.WorkspaceMember, error) {
+	u, err := r.base.getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.base.getClient(ctx).WorkspaceMember.Query().
+		Where(workspacemember.UserID(u.ID)).
+		WithWorkspace().
+		Order(ent.Asc(workspacemember.FieldWorkspaceID)).
+		All(ctx)
+}
+
+// FindMembership はログイン中のユーザーのワークスペースでの権限を返す。メンバーでない場合は NotFoundError を返す
+func (r *WorkspaceRepository) FindMembership(ctx context.Context, workspaceID int) (*ent.WorkspaceMember, error) {
+	u, err := r.base.getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.base.getClient(ctx).WorkspaceMember.Query().
+		Where(workspacemember.WorkspaceID(workspaceID), workspacemember.UserID(u.ID)).
+		WithWorkspace().
+		Only(ctx)
+}
+
+// Create はチームのワークスペースを作成し、ログイン中のユーザーを管理者として追加する。呼び出し元でトランザクションを開始する
+func (r *WorkspaceRepository) Create(ctx context.Context, name string) (*ent.Workspace, error) {
+	u, err := r.base.getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := r.base.getClient(ctx)
+	ws, err := client.Workspace.Create().
+		SetName(name).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := client.WorkspaceMember.Create().
+		SetWorkspaceID(ws.ID).
+		SetUserID(u.ID).
+		SetRole(WorkspaceRoleAdmin).
+		Save(ctx); err != nil {
+		return nil, err
+	}
+	return ws, nil
+}
+
+func (r *WorkspaceRepository) Rename(ctx context.Context, workspaceID int, name string) (*ent.Workspace, error) {
+	u, err := r.base.getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.base.getClient(ctx).Workspace.UpdateOneID(workspaceID).
+		Where(workspaceJoinedBy(u.ID)).
+		SetName(name).
+		Save(ctx)
+}
+
+// Delete はワークスペースを削除する。ワークスペースの Todo、フィルタの履歴、リスト、メンバーも削除される
+func (r *WorkspaceRepository) Delete(ctx context.Context, workspaceID int) error {
+	u, err := r.base.getUser(ctx)
+	if err != nil {
+		return err
+	}
+	return r.base.getClient(ctx).Workspace.DeleteOneID(workspaceID).
+		Where(workspaceJoinedBy(u.ID)).
+		Exec(ctx)
+}
+
+// ListMembers はワークスペースのメンバーを、ユーザーと合わせて参加順に返す
+func (r *WorkspaceRepository) ListMembers(ctx context.Context, workspaceID int) ([]*ent.WorkspaceMember, error) {
+	return r.base.getClient(ctx).WorkspaceMember.Query().
+		Where(workspacemember.WorkspaceID(workspaceID)).
+		WithUser().
+		Order(ent.Asc(workspacemember.FieldCreatedAt), ent.Asc(workspacemember.FieldID)).
+		All(ctx)
+}
+
+func (r *WorkspaceRepository) IsMember(ctx context.Context, workspaceID int, userID int) (bool, error) {
+	return r.base.getClient(ctx).WorkspaceMember.Query().
+		Where(workspacemember.WorkspaceID(workspaceID), workspacemember.UserID(userID)).
+		Exist(ctx)
+}
+
+// AddMember はユーザーをワークスペースのメンバーに追加し、ユーザーと合わせて返す。既にメンバーの場合は ConstraintError を返す
+func (r *WorkspaceRepository) AddMember(ctx context.Context, workspaceID int, userID int, role string) (*ent.WorkspaceMember, error) {
+	client := r.base.getClient(ctx)
+	m, err := client.WorkspaceMember.Create().
+		SetWorkspaceID(workspaceID).
+		SetUserID(userID).
+		SetRole(role).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m.Edges.User, err = client.User.Query().Where(user.ID(userID)).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (r *WorkspaceRepository) SetMemberRole(ctx context.Context, workspaceID int, userID int, role string) (*ent.WorkspaceMember, error) {
+	if err := r.ensureJoined(ctx, workspaceID); err != nil {
+		return nil, err
+	}
+	client := r.base.getClient(ctx)
+	n, err := client.WorkspaceMember.Update().
+		Where(workspacemember.WorkspaceID(workspaceID), workspacemember.UserID(userID)).
+		SetRole(role).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, &ent.NotFoundError{}
+	}
+	return client.WorkspaceMember.Query().
+		Where(workspacemember.WorkspaceID(workspaceID), workspacemember.UserID(userID)).
+		WithUser().
+		Only(ctx)
+}
+
+// RemoveMember はユーザーをワークスペースから外す。
+// ワークスペースの外から共有のリストを参照できないよう、ワークスペースのリストのメンバーからも外す
+func (r *WorkspaceRepository) RemoveMember(ctx context.Context, workspaceID int, userID int) error {
+	if err := r.ensureJoined(ctx, workspaceID); err != nil {
+		return err
+	}
+	client := r.base.getClient(ctx)
+	n, err := client.WorkspaceMember.Delete().
+		Where(workspacemember.WorkspaceID(workspaceID), workspacemember.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+	_, err = client.TodoListMember.Delete().
+		Where(
+			todolistmember.UserID(userID),
+			todolistmember.HasListWith(todolist.WorkspaceID(workspaceID)),
+		).
+		Exec(ctx)
+	return err
+}
+
+// ensureJoined はログイン中のユーザーがワークスペースのメンバーであることを確認し、そうでない場合は NotFoundError を返す。
+// MySQL は更新・削除するテーブルをサブクエリで参照できないため、メンバーを変更する前に確認する
+func (r *WorkspaceRepository) ensureJoined(ctx context.Context, workspaceID int) error {
+	u, err := r.base.getUser(ctx)
+	if err != nil {
+		return err
+	}
+	return ensureWorkspaceJoined(ctx, r.base.getClient(ctx), u.ID, workspaceID)
+}
+
+// CountAdmins はワークスペースの管理者の人数を返す。最後の管理者を外さないよう確認するために使う
+func (r *WorkspaceRepository) CountAdmins(ctx context.Context, workspaceID int) (int, error) {
+	return r.base.getClient(ctx).WorkspaceMember.Query().
+		Where(workspacemember.WorkspaceID(workspaceID), workspacemember.Role(WorkspaceRoleAdmin)).
+		Count(ctx)
+}
+
+// CountLastAdminedByUser はユーザーが唯一の管理者で、他のメンバーもいるチームのワークスペースの件数を返す。
+// 退会で管理者のいないワークスペースが残らないよう確認するために使う
+func (r *WorkspaceRepository) CountLastAdminedByUser(ctx context.Context, userID int) (int, error) {
+	return r.base.getClient(ctx).Workspace.Query().
+		Where(lastAdminedWorkspacesOf(userID)).
+		Count(ctx)
+}
+
+// lastAdminedWorkspacesOf はユーザーが唯一の管理者で、他のメンバーもいるチームのワークスペースに絞り込む
+func lastAdminedWorkspacesOf(userID int) predicate.Workspace {
+	return workspace.And(
+		workspace.PersonalOwnerIDIsNil(),
+		workspace.HasMembersWith(workspacemember.UserID(userID), workspacemember.Role(WorkspaceRoleAdmin)),
+		workspace.Not(workspace.HasMembersWith(workspacemember.UserIDNEQ(userID), workspacemember.Role(WorkspaceRoleAdmin))),
+		workspace.HasMembersWith(workspacemember.UserIDNEQ(userID)),
+	)
+}
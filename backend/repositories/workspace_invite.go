@@ -0,0 +1,112 @@
+package repositories
+
+import (
+	"context"
+	"time"
+	"todo-app/ent"
+	"todo-app/ent/workspaceinvite"
+
+	"github.com/google/uuid"
+)
+
+type IWorkspaceInviteRepository interface {
+	Create(ctx context.Context, workspaceID int, email string, role string, tokenHash string, invitedBy int, expiresAt time.Time) (*ent.WorkspaceInvite, error)
+	ListPending(ctx context.Context, workspaceID int, now time.Time) ([]*ent.WorkspaceInvite, error)
+	Revoke(ctx context.Context, workspaceID int, id uuid.UUID) error
+	Consume(ctx context.Context, tokenHash string) (*ent.WorkspaceInvite, bool, error)
+}
+
+type WorkspaceInviteRepository struct {
+	base *BaseRepository
+}
+
+func NewWorkspaceInviteRepository(client *ent.Client) *WorkspaceInviteRepository {
+	return &WorkspaceInviteRepository{
+		base: NewBaseRepository(client),
+	}
+}
+
+// Create は招待を作成する。
+// 最後に送信したメールのリンクだけが有効になるよう、同じメールアドレスへの未使用の古い招待は使用済みにする
+func (r *WorkspaceInviteRepository) Create(ctx context.Context, workspaceID int, email string, role string, tokenHash string, invitedBy int, expiresAt time.Time) (*ent.WorkspaceInvite, error) {
+	client := r.base.getClient(ctx)
+	if err := client.WorkspaceInvite.Update().
+		Where(workspaceinvite.WorkspaceID(workspaceID), workspaceinvite.EmailEqualFold(email), workspaceinvite.UsedAtIsNil()).
+		SetUsedAt(time.Now()).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+	return client.WorkspaceInvite.Create().
+		SetWorkspaceID(workspaceID).
+		SetEmail(email).
+		SetRole(role).
+		SetTokenHash(tokenHash).
+		SetInvitedBy(invitedBy).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+}
+
+// ListPending は承諾待ちの (未使用で有効期限内の) 招待を新しい順に返す。参加していないワークスペースの場合は NotFoundError を返す
+func (r *WorkspaceInviteRepository) ListPending(ctx context.Context, workspaceID int, now time.Time) ([]*ent.WorkspaceInvite, error) {
+	u, err := r.base.getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := r.base.getClient(ctx)
+	if err := ensureWorkspaceJoined(ctx, client, u.ID, workspaceID); err != nil {
+		return nil, err
+	}
+	return client.WorkspaceInvite.Query().
+		Where(
+			workspaceinvite.WorkspaceID(workspaceID),
+			workspaceinvite.UsedAtIsNil(),
+			workspaceinvite.ExpiresAtGT(now),
+		).
+		Order(ent.Desc(workspaceinvite.FieldCreatedAt)).
+		All(ctx)
+}
+
+// Revoke は承諾待ちの招待を取り消す。該当する招待が無い場合や、参加していないワークスペースの場合は NotFoundError を返す
+func (r *WorkspaceInviteRepository) Revoke(ctx context.Context, workspaceID int, id uuid.UUID) error {
+	u, err := r.base.getUser(ctx)
+	if err != nil {
+		return err
+	}
+	client := r.base.getClient(ctx)
+	if err := ensureWorkspaceJoined(ctx, client, u.ID, workspaceID); err != nil {
+		return err
+	}
+	n, err := client.WorkspaceInvite.Update().
+		Where(workspaceinvite.ID(id), workspaceinvite.WorkspaceID(workspaceID), workspaceinvite.UsedAtIsNil()).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+	return nil
+}
+
+// Consume は招待を使用済みにして、ワークスペースと合わせて返す。
+// 2 つ目の戻り値は今回の呼び出しで使用済みにした場合に true、既に使用済みだった場合に false となる
+func (r *WorkspaceInviteRepository) Consume(ctx context.Context, tokenHash string) (*ent.WorkspaceInvite, bool, error) {
+	client := r.base.getClient(ctx)
+	n, err := client.WorkspaceInvite.Update().
+		Where(workspaceinvite.TokenHash(tokenHash), workspaceinvite.UsedAtIsNil()).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	invite, err := client.WorkspaceInvite.Query().
+		Where(workspaceinvite.TokenHash(tokenHash)).
+		WithWorkspace().
+		Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return invite, n == 1, nil
+}
@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"todo-app/handlers"
+	"todo-app/middleware"
+
+	"github.com/labstack/echo/v5"
+)
+
+type AdminRouter struct {
+	handler *handlers.AdminHandler
+}
+
+func NewAdminRouter(handler *handlers.AdminHandler) *AdminRouter {
+	return &AdminRouter{handler: handler}
+}
+
+func (r *AdminRouter) SetupAdminRoute(g *echo.Group) {
+	g.Use(middleware.RequireAdmin)
+	g.GET("/users", r.handler.ListUsers)
+	g.GET("/users/:id", r.handler.GetUser)
+	g.POST("/users/:id/disable", r.handler.DisableUser)
+	g.POST("/users/:id/enable", r.handler.EnableUser)
+	g.POST("/users/:id/password_reset", r.handler.ResetPassword)
+	g.POST("/users/:id/impersonate", r.handler.Impersonate)
+	g.GET("/audit_logs", r.handler.ListAuditLogs)
+}
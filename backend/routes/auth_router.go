@@ -2,18 +2,34 @@ package routes
 
 import (
 	"todo-app/handlers"
+	"todo-app/middleware"
 
 	"github.com/labstack/echo/v5"
 )
 
 type AuthRouter struct {
-	handler *handlers.AuthHandler
+	handler     *handlers.AuthHandler
+	oidcHandler *handlers.OIDCHandler
 }
 
-func NewAuthRouter(handler *handlers.AuthHandler) *AuthRouter {
-	return &AuthRouter{handler: handler}
+func NewAuthRouter(handler *handlers.AuthHandler, oidcHandler *handlers.OIDCHandler) *AuthRouter {
+	return &AuthRouter{handler: handler, oidcHandler: oidcHandler}
 }
 
 func (r *AuthRouter) SetupAuthRoute(g *echo.Group) {
 	g.POST("/login", r.handler.Login)
+	g.POST("/login/mfa", r.handler.LoginMFA)
+	g.POST("/register", r.handler.Register)
+	g.POST("/refresh", r.handler.Refresh)
+	g.POST("/logout", r.handler.Logout, middleware.RequireSession)
+	g.POST("/password/forgot", r.handler.ForgotPassword)
+	g.POST("/password/reset", r.handler.ResetPassword)
+	g.POST("/email/confirm", r.handler.ConfirmEmailChange)
+	g.POST("/email/cancel", r.handler.CancelEmailChange)
+	g.GET("/sessions", r.handler.ListSessions, middleware.RequireSession)
+	g.DELETE("/sessions", r.handler.RevokeAllSessions, middleware.RequireSession)
+	g.DELETE("/sessions/:id", r.handler.RevokeSession, middleware.RequireSession)
+	g.GET("/oidc/providers", r.oidcHandler.ListProviders)
+	g.GET("/oidc/:provider/login", r.oidcHandler.Login)
+	g.GET("/oidc/:provider/callback", r.oidcHandler.Callback)
 }
@@ -0,0 +1,40 @@
+package routes
+
+import (
+	"todo-app/handlers"
+	"todo-app/middleware"
+
+	"github.com/labstack/echo/v5"
+)
+
+type MeRouter struct {
+	handler *handlers.MeHandler
+}
+
+func NewMeRouter(handler *handlers.MeHandler) *MeRouter {
+	return &MeRouter{handler: handler}
+}
+
+func (r *MeRouter) SetupMeRoute(g *echo.Group) {
+	g.GET("", r.handler.GetMe)
+	// メールアドレスを変更できるため、漏洩したトークンでアカウントを乗っ取られないようセッションに限定する
+	g.PATCH("", r.handler.UpdateMe, middleware.RequireSession)
+	g.POST("/email/request", r.handler.RequestEmailChange, middleware.RequireSession)
+	g.POST("/email/authorize", r.handler.AuthorizeEmailChange, middleware.RequireSession)
+	g.DELETE("", r.handler.DeleteMe, middleware.RequireSession)
+	g.POST("/deletion/request", r.handler.RequestDeletion, middleware.RequireSession)
+	g.POST("/deletion/confirm", r.handler.ConfirmDeletion, middleware.RequireSession)
+	g.POST("/deletion/cancel", r.handler.CancelDeletion, middleware.RequireSession)
+	// 全てのデータを含むため、漏洩したトークンで持ち出されないようセッションに限定する
+	g.GET("/export", r.handler.ExportMe, middleware.RequireSession)
+	g.GET("/ai_usage", r.handler.GetAIUsage)
+	g.PUT("/password", r.handler.ChangePassword, middleware.RequireSession)
+	g.GET("/tokens", r.handler.ListTokens, middleware.RequireSession)
+	g.POST("/tokens", r.handler.CreateToken, middleware.RequireSession)
+	g.DELETE("/tokens/:id", r.handler.RevokeToken, middleware.RequireSession)
+	g.GET("/mfa", r.handler.GetMFAStatus, middleware.RequireSession)
+	g.POST("/mfa/totp", r.handler.EnrollTOTP, middleware.RequireSession)
+	g.POST("/mfa/totp/confirm", r.handler.ConfirmTOTP, middleware.RequireSession)
+	g.DELETE("/mfa/totp", r.handler.DisableTOTP, middleware.RequireSession)
+	g.POST("/mfa/recovery_codes", r.handler.RegenerateRecoveryCodes, middleware.RequireSession)
+}
@@ -13,21 +13,37 @@ import (
 	echoMiddleware "github.com/labstack/echo/v5/middleware"
 )
 
-func NewRouter(todoR *TodoRouter, authR *AuthRouter, authM *middleware.AuthMiddleware) *Router {
+func NewRouter(todoR *TodoRouter, listR *TodoListRouter, workspaceR *WorkspaceRouter, authR *AuthRouter, meR *MeRouter, adminR *AdminRouter, authM *middleware.AuthMiddleware, auditM *middleware.AdminAuditMiddleware, workspaceM *middleware.WorkspaceMiddleware) *Router {
 	return &Router{
-		todo:  todoR,
-		auth:  authR,
-		authM: authM,
+		todo:       todoR,
+		list:       listR,
+		workspace:  workspaceR,
+		auth:       authR,
+		me:         meR,
+		admin:      adminR,
+		authM:      authM,
+		auditM:     auditM,
+		workspaceM: workspaceM,
 	}
 }
 
 type Router struct {
-	todo  *TodoRouter
-	auth  *AuthRouter
-	authM *middleware.AuthMiddleware
+	todo       *TodoRouter
+	list       *TodoListRouter
+	workspace  *WorkspaceRouter
+	auth       *AuthRouter
+	me         *MeRouter
+	admin      *AdminRouter
+	authM      *middleware.AuthMiddleware
+	auditM     *middleware.AdminAuditMiddleware
+	workspaceM *middleware.WorkspaceMiddleware
 }
 
 func (r *Router) Setup(e *echo.Echo) {
+	// クライアントの IP アドレスはログインの試行の制限に使うため、偽装できないよう
+	// 直接の接続元がループバック・プライベートネットワークのプロキシの場合に限り X-Forwarded-For を信頼する
+	e.IPExtractor = echo.ExtractIPFromXFFHeader()
+
 	origins := os.Getenv("CORS_ALLOWED_ORIGINS")
 	if origins == "" {
 		origins = os.Getenv("FRONTEND_ORIGIN")
@@ -53,13 +69,16 @@ func (r *Router) Setup(e *echo.Echo) {
 			echo.HeaderAccept,
 			echo.HeaderAuthorization,
 			"X-CSRF-Token",
+			middleware.HeaderWorkspaceID,
 		},
 		AllowCredentials: true,
 	}))
 
 	e.Use(r.authM.Authenticate)
+	// 管理者がなりすましている間の更新の操作は全て監査記録に残す
+	e.Use(r.auditM.AuditImpersonation)
 	skipper := func(c *echo.Context) bool {
-		return c.Request().URL.Path == "/auth/login"
+		return middleware.IsCSRFExempt(c.Request().URL.Path) || middleware.IsBearerAuthenticated(c)
 	}
 	e.Use(echoMiddleware.CSRFWithConfig(echoMiddleware.CSRFConfig{
 		Skipper:        skipper,
@@ -101,5 +120,12 @@ func (r *Router) Setup(e *echo.Echo) {
 	})
 
 	r.auth.SetupAuthRoute(e.Group("/auth"))
-	r.todo.SetupTodoRoute(e.Group("/todo"))
+	// Todo とリストは、パスまたは X-Workspace-ID で指定したワークスペースの範囲で扱う
+	r.todo.SetupTodoRoute(e.Group("/todo", r.workspaceM.ResolveWorkspace))
+	r.list.SetupTodoListRoute(e.Group("/lists", r.workspaceM.ResolveWorkspace))
+	r.todo.SetupTodoRoute(e.Group("/workspaces/:workspace_id/todo", r.workspaceM.ResolveWorkspace))
+	r.list.SetupTodoListRoute(e.Group("/workspaces/:workspace_id/lists", r.workspaceM.ResolveWorkspace))
+	r.workspace.SetupWorkspaceRoute(e.Group("/workspaces"))
+	r.me.SetupMeRoute(e.Group("/me"))
+	r.admin.SetupAdminRoute(e.Group("/admin"))
 }
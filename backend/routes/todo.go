@@ -2,6 +2,8 @@ package routes
 
 import (
 	"todo-app/handlers"
+	"todo-app/middleware"
+	"todo-app/services"
 
 	"github.com/labstack/echo/v5"
 )
@@ -17,12 +19,22 @@ type TodoRouter struct {
 }
 
 func (r *TodoRouter) SetupTodoRoute(eg *echo.Group) {
+	// AI を呼び出すエンドポイントは、パーソナルアクセストークンに ai のスコープも必要
+	aiScope := middleware.RequireScope(services.TokenScopeAI)
+
 	eg.GET("", r.TodoHandler.ListTodo)
 	eg.POST("", r.TodoHandler.CreateTodo)
+	eg.POST("/ai_create", r.TodoHandler.CreateTodosByAI, aiScope)
+	eg.POST("/ai_create/stream", r.TodoHandler.CreateTodosByAIStream, aiScope)
 	eg.GET("/filter_histories", r.TodoHandler.ListTodoFilterHistories)
-	eg.GET("/ai_filter", r.TodoHandler.FilterTodosByQuery)
+	eg.GET("/ai_filter", r.TodoHandler.FilterTodosByQuery, aiScope)
+	eg.GET("/ai_filter/stream", r.TodoHandler.FilterTodosByQueryStream, aiScope)
 	eg.GET("/filter_by_query_id", r.TodoHandler.FilterTodosByQueryID)
+	eg.GET("/ai_summary", r.TodoHandler.SummarizeTodosByAI, aiScope)
+	eg.GET("/semantic_search", r.TodoHandler.SemanticSearchTodos, aiScope)
 	eg.PATCH("/:id", r.TodoHandler.UpdateTodo)
 	eg.PUT("/:id/done", r.TodoHandler.UpdateDoneStatus)
+	eg.POST("/:id/ai_breakdown", r.TodoHandler.BreakdownTodoByAI, aiScope)
+	eg.POST("/:id/ai_breakdown/:breakdown_id/accept", r.TodoHandler.AcceptTodoBreakdown)
 	eg.DELETE("/:id", r.TodoHandler.DeleteTodo)
 }
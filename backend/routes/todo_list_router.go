@@ -0,0 +1,29 @@
+package routes
+
+import (
+	"todo-app/handlers"
+
+	"github.com/labstack/echo/v5"
+)
+
+type TodoListRouter struct {
+	handler *handlers.TodoListHandler
+}
+
+func NewTodoListRouter(handler *handlers.TodoListHandler) *TodoListRouter {
+	return &TodoListRouter{handler: handler}
+}
+
+func (r *TodoListRouter) SetupTodoListRoute(g *echo.Group) {
+	g.GET("", r.handler.ListTodoLists)
+	g.POST("", r.handler.CreateTodoList)
+	g.POST("/invites/accept", r.handler.AcceptInvite)
+	g.GET("/:id", r.handler.GetTodoList)
+	g.PATCH("/:id", r.handler.UpdateTodoList)
+	g.DELETE("/:id", r.handler.DeleteTodoList)
+	g.PATCH("/:id/members/:user_id", r.handler.UpdateMember)
+	g.DELETE("/:id/members/:user_id", r.handler.RemoveMember)
+	g.GET("/:id/invites", r.handler.ListInvites)
+	g.POST("/:id/invites", r.handler.InviteMember)
+	g.DELETE("/:id/invites/:invite_id", r.handler.RevokeInvite)
+}
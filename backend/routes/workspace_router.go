@@ -0,0 +1,29 @@
+package routes
+
+import (
+	"todo-app/handlers"
+
+	"github.com/labstack/echo/v5"
+)
+
+type WorkspaceRouter struct {
+	handler *handlers.WorkspaceHandler
+}
+
+func NewWorkspaceRouter(handler *handlers.WorkspaceHandler) *WorkspaceRouter {
+	return &WorkspaceRouter{handler: handler}
+}
+
+func (r *WorkspaceRouter) SetupWorkspaceRoute(g *echo.Group) {
+	g.GET("", r.handler.ListWorkspaces)
+	g.POST("", r.handler.CreateWorkspace)
+	g.POST("/invites/accept", r.handler.AcceptInvite)
+	g.GET("/:id", r.handler.GetWorkspace)
+	g.PATCH("/:id", r.handler.UpdateWorkspace)
+	g.DELETE("/:id", r.handler.DeleteWorkspace)
+	g.PATCH("/:id/members/:user_id", r.handler.UpdateMember)
+	g.DELETE("/:id/members/:user_id", r.handler.RemoveMember)
+	g.GET("/:id/invites", r.handler.ListInvites)
+	g.POST("/:id/invites", r.handler.InviteMember)
+	g.DELETE("/:id/invites/:invite_id", r.handler.RevokeInvite)
+}
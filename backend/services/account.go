@@ -0,0 +1,464 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"todo-app/app_errors"
+	"todo-app/dto"
+	"todo-app/ent"
+	"todo-app/prompts"
+	"todo-app/repositories"
+	"todo-app/utils"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 退会を申請してから完全に削除するまでの既定の日数 (ACCOUNT_DELETION_GRACE_DAYS で変更できる)
+const defaultAccountDeletionGraceDays = 30
+
+// エクスポートの際に 1 回のクエリで取得する件数
+const personalDataExportBatchSize = 500
+
+// AccountDeletionTokenLifetime は退会の確認メールで送信するリンクの有効期間
+const AccountDeletionTokenLifetime = time.Hour
+
+// AccountService は退会と、ユーザーに紐づくデータのエクスポートを扱う
+type AccountService struct {
+	logger         *slog.Logger
+	userRepo       repositories.IUserRepository
+	sessionRepo    repositories.ISessionRepository
+	dataRepo       repositories.IPersonalDataRepository
+	deletionRepo   repositories.IAccountDeletionTokenRepository
+	listRepo       repositories.ITodoListRepository
+	workspaceRepo  repositories.IWorkspaceRepository
+	profileService *ProfileService
+	mailer         utils.IMailer
+}
+
+func NewAccountService(logger *slog.Logger, userRepo repositories.IUserRepository, sessionRepo repositories.ISessionRepository, dataRepo repositories.IPersonalDataRepository, deletionRepo repositories.IAccountDeletionTokenRepository, listRepo repositories.ITodoListRepository, workspaceRepo repositories.IWorkspaceRepository, profileService *ProfileService, mailer utils.IMailer) *AccountService {
+	return &AccountService{
+		logger:         logger,
+		userRepo:       userRepo,
+		sessionRepo:    sessionRepo,
+		dataRepo:       dataRepo,
+		deletionRepo:   deletionRepo,
+		listRepo:       listRepo,
+		workspaceRepo:  workspaceRepo,
+		profileService: profileService,
+		mailer:         mailer,
+	}
+}
+
+// AccountDeletionGracePeriod は退会を申請してから完全に削除するまでの期間を返す
+func AccountDeletionGracePeriod() time.Duration {
+	days, err := strconv.Atoi(os.Getenv("ACCOUNT_DELETION_GRACE_DAYS"))
+	if err != nil || days < 0 {
+		days = defaultAccountDeletionGraceDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// ScheduleDeletion はパスワードを確認してから退会を申請する。
+// 猶予期間が過ぎるまではログインして申請を取り消せるが、リクエストに使ったセッション以外は失効させる。
+// パスワードを持たないユーザーは RequestDeletion でメールを受け取り、ConfirmDeletion で申請する
+func (s *AccountService) ScheduleDeletion(ctx context.Context, password string) (*dto.AccountDeletionDto, error) {
+	u, ok := utils.UserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("user not found in context")
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(password)); err != nil {
+		return nil, app_errors.ErrInvalidCurrentPassword
+	}
+	if u.DeletionScheduledAt != nil {
+		return nil, app_errors.ErrAccountDeletionScheduled
+	}
+	return s.scheduleDeletion(ctx, u)
+}
+
+// RequestDeletion は退会を確認するリンクをメールで送信する。
+// OpenID Connect で登録したユーザーはパスワードを知らないため、パスワードの代わりにメールを受信できることで本人であることを確認する
+func (s *AccountService) RequestDeletion(ctx context.Context) error {
+	u, ok := utils.UserFromContext(ctx)
+	if !ok {
+		return errors.New("user not found in context")
+	}
+	if u.DeletionScheduledAt != nil {
+		return app_errors.ErrAccountDeletionScheduled
+	}
+	if err := s.ensureNoLastOwnership(ctx, u.ID); err != nil {
+		return err
+	}
+
+	token, err := newRandomToken()
+	if err != nil {
+		return err
+	}
+	if _, err := s.deletionRepo.Create(ctx, u.ID, hashToken(token), time.Now().Add(AccountDeletionTokenLifetime)); err != nil {
+		return err
+	}
+	return s.mailer.Send(ctx, accountDeletionConfirmMail(u, token))
+}
+
+// ConfirmDeletion はメールで送信したトークンを確認してから退会を申請する。
+// 漏洩したリンクだけで退会させられないよう、トークンを発行したユーザー本人のセッションからのみ受け付ける
+func (s *AccountService) ConfirmDeletion(ctx context.Context, token string) (*dto.AccountDeletionDto, error) {
+	u, ok := utils.UserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("user not found in context")
+	}
+	if token == "" {
+		return nil, app_errors.ErrInvalidAccountDeletionToken
+	}
+
+	adt, consumed, err := s.deletionRepo.Consume(ctx, hashToken(token))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, app_errors.ErrInvalidAccountDeletionToken
+		}
+		return nil, err
+	}
+	if !consumed || !time.Now().Before(adt.ExpiresAt) || adt.UserID != u.ID {
+		return nil, app_errors.ErrInvalidAccountDeletionToken
+	}
+	if u.DeletionScheduledAt != nil {
+		return nil, app_errors.ErrAccountDeletionScheduled
+	}
+	return s.scheduleDeletion(ctx, u)
+}
+
+// scheduleDeletion は猶予期間が過ぎるまでの退会を申請し、リクエストに使ったセッション以外を失効させる
+func (s *AccountService) scheduleDeletion(ctx context.Context, u *ent.User) (*dto.AccountDeletionDto, error) {
+	if err := s.ensureNoLastOwnership(ctx, u.ID); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.userRepo.ScheduleDeletion(ctx, u.ID, time.Now().Add(AccountDeletionGracePeriod()))
+	if err != nil {
+		return nil, err
+	}
+
+	var except *uuid.UUID
+	if id, ok := CurrentSessionID(ctx); ok {
+		except = &id
+	}
+	if _, err := s.sessionRepo.RevokeAllByUserID(ctx, u.ID, except); err != nil {
+		return nil, err
+	}
+
+	if err := s.mailer.Send(ctx, accountDeletionMail(updated)); err != nil {
+		s.logger.Error("failed to send account deletion mail", slog.Int("user_id", u.ID), slog.String("error", err.Error()))
+	}
+	return &dto.AccountDeletionDto{DeletionScheduledAt: *updated.DeletionScheduledAt}, nil
+}
+
+// ensureNoLastOwnership はユーザーが退会しても、他のメンバーがいるリストに所有者が、チームのワークスペースに管理者が残ることを確認する
+func (s *AccountService) ensureNoLastOwnership(ctx context.Context, userID int) error {
+	n, err := s.listRepo.CountLastOwnedByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return app_errors.ErrAccountOwnsTodoLists
+	}
+	n, err = s.workspaceRepo.CountLastAdminedByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return app_errors.ErrAccountAdminsWorkspaces
+	}
+	return nil
+}
+
+// CancelDeletion は退会の申請を取り消す
+func (s *AccountService) CancelDeletion(ctx context.Context) error {
+	u, ok := utils.UserFromContext(ctx)
+	if !ok {
+		return errors.New("user not found in context")
+	}
+	if u.DeletionScheduledAt == nil {
+		return app_errors.ErrAccountDeletionNotScheduled
+	}
+	_, err := s.userRepo.CancelDeletion(ctx, u.ID)
+	return err
+}
+
+// Export はログイン中のユーザーに紐づくデータを 1 つの JSON として w に書き込む。
+// 全件をメモリに載せないよう、種類ごとに personalDataExportBatchSize 件ずつ取得して書き込む
+func (s *AccountService) Export(ctx context.Context, w io.Writer) error {
+	u, ok := utils.UserFromContext(ctx)
+	if !ok {
+		return errors.New("user not found in context")
+	}
+	// プロフィールを取得できるまでは何も書き込まず、呼び出し元でエラーのレスポンスを返せるようにする
+	profile, err := s.profileService.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	ew := &exportWriter{w: w}
+	ew.write("{")
+	ew.field("version", dto.PersonalDataExportVersion)
+	ew.field("exported_at", time.Now())
+	ew.field("profile", profile)
+	if ew.err != nil {
+		return ew.err
+	}
+
+	sections := []func() error{
+		func() error {
+			return exportList(ctx, ew, "todos", u.ID, s.dataRepo.ListTodos, dto.EntityToTodoDto)
+		},
+		func() error {
+			return exportList(ctx, ew, "todo_filter_histories", u.ID, s.dataRepo.ListTodoFilterHistories, toExportTodoFilterHistoryDto)
+		},
+		func() error {
+			return exportList(ctx, ew, "todo_breakdowns", u.ID, s.dataRepo.ListTodoBreakdowns, toExportTodoBreakdownDto)
+		},
+		func() error {
+			return exportList(ctx, ew, "todo_summaries", u.ID, s.dataRepo.ListTodoSummaries, toExportTodoSummaryDto)
+		},
+		func() error {
+			return exportList(ctx, ew, "sessions", u.ID, s.dataRepo.ListSessions, toExportSessionDto)
+		},
+		func() error {
+			return exportList(ctx, ew, "ai_usages", u.ID, s.dataRepo.ListAIUsages, toExportAIUsageDto)
+		},
+		func() error {
+			return exportList(ctx, ew, "personal_access_tokens", u.ID, s.dataRepo.ListPersonalAccessTokens, toExportPersonalAccessTokenDto)
+		},
+		func() error {
+			return exportList(ctx, ew, "identities", u.ID, s.dataRepo.ListIdentities, toExportIdentityDto)
+		},
+		func() error {
+			return exportList(ctx, ew, "login_attempts", u.ID, s.dataRepo.ListLoginAttempts, toExportLoginAttemptDto)
+		},
+	}
+	for _, section := range sections {
+		if err := section(); err != nil {
+			return err
+		}
+	}
+
+	ew.write("}\n")
+	return ew.err
+}
+
+// exportWriter は JSON のオブジェクトを先頭から順に書き込む。最初に発生したエラーを保持し、以降の書き込みは行わない
+type exportWriter struct {
+	w      io.Writer
+	fields int
+	err    error
+}
+
+func (ew *exportWriter) write(s string) {
+	if ew.err != nil {
+		return
+	}
+	_, ew.err = io.WriteString(ew.w, s)
+}
+
+func (ew *exportWriter) value(v interface{}) {
+	if ew.err != nil {
+		return
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		ew.err = err
+		return
+	}
+	_, ew.err = ew.w.Write(b)
+}
+
+func (ew *exportWriter) key(name string) {
+	if ew.fields > 0 {
+		ew.write(",")
+	}
+	ew.fields++
+	ew.value(name)
+	ew.write(":")
+}
+
+func (ew *exportWriter) field(name string, v interface{}) {
+	ew.key(name)
+	ew.value(v)
+}
+
+// exportList は list で取得した要素を toDto で変換し、name をキーとする配列として書き込む
+func exportList[T any, D any](ctx context.Context, ew *exportWriter, name string, userID int, list func(context.Context, int, int, int) ([]T, error), toDto func(T) D) error {
+	ew.key(name)
+	ew.write("[")
+	for offset := 0; ; offset += personalDataExportBatchSize {
+		// クライアントが切断した場合は中断する
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		items, err := list(ctx, userID, offset, personalDataExportBatchSize)
+		if err != nil {
+			return err
+		}
+		for i, item := range items {
+			if offset+i > 0 {
+				ew.write(",")
+			}
+			ew.value(toDto(item))
+		}
+		if ew.err != nil {
+			return ew.err
+		}
+		if len(items) < personalDataExportBatchSize {
+			break
+		}
+	}
+	ew.write("]")
+	return ew.err
+}
+
+func toExportTodoFilterHistoryDto(h *ent.TodoFilterHistory) dto.ExportTodoFilterHistoryDto {
+	return dto.ExportTodoFilterHistoryDto{
+		ID:            h.ID.String(),
+		Query:         h.Query,
+		PromptVersion: h.PromptVersion,
+		FunctionName:  h.FunctionName,
+		Args:          h.Args,
+		ResultTodoIDs: h.ResultTodoIds,
+		CreatedAt:     h.CreatedAt,
+	}
+}
+
+func toExportTodoBreakdownDto(b *ent.TodoBreakdown) dto.ExportTodoBreakdownDto {
+	return dto.ExportTodoBreakdownDto{
+		ID:                  b.ID.String(),
+		TodoID:              b.TodoID,
+		Model:               b.Model,
+		Steps:               b.Steps,
+		AcceptedStepIndexes: b.AcceptedStepIndexes,
+		AcceptedAt:          b.AcceptedAt,
+		CreatedAt:           b.CreatedAt,
+	}
+}
+
+func toExportTodoSummaryDto(s *ent.TodoSummary) dto.ExportTodoSummaryDto {
+	res := dto.ExportTodoSummaryDto{
+		ID:        s.ID.String(),
+		From:      s.RangeFrom,
+		To:        s.RangeTo,
+		Model:     s.Model,
+		Markdown:  s.Markdown,
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+	}
+	// content は保存時に JSON にしているが、壊れている場合は文字列として出力する
+	if json.Valid([]byte(s.Content)) {
+		res.Content = json.RawMessage(s.Content)
+	} else {
+		res.Content, _ = json.Marshal(s.Content)
+	}
+	return res
+}
+
+func toExportSessionDto(s *ent.Session) dto.ExportSessionDto {
+	return dto.ExportSessionDto{
+		ID:         s.ID.String(),
+		IPAddress:  s.IPAddress,
+		UserAgent:  s.UserAgent,
+		LastSeenAt: s.LastSeenAt,
+		ExpiresAt:  s.ExpiresAt,
+		RevokedAt:  s.RevokedAt,
+		CreatedAt:  s.CreatedAt,
+	}
+}
+
+func toExportAIUsageDto(u *ent.AIUsage) dto.ExportAIUsageDto {
+	return dto.ExportAIUsageDto{
+		Model:            u.Model,
+		PromptTokens:     u.PromptTokens,
+		CandidatesTokens: u.CandidatesTokens,
+		TotalTokens:      u.TotalTokens,
+		CreatedAt:        u.CreatedAt,
+	}
+}
+
+func toExportPersonalAccessTokenDto(pat *ent.PersonalAccessToken) dto.ExportPersonalAccessTokenDto {
+	return dto.ExportPersonalAccessTokenDto{
+		PersonalAccessTokenDto: toPersonalAccessTokenDto(pat),
+		RevokedAt:              pat.RevokedAt,
+	}
+}
+
+func toExportIdentityDto(i *ent.UserIdentity) dto.ExportIdentityDto {
+	return dto.ExportIdentityDto{
+		Provider:    i.Provider,
+		Subject:     i.Subject,
+		Email:       i.Email,
+		LastLoginAt: i.LastLoginAt,
+		CreatedAt:   i.CreatedAt,
+	}
+}
+
+func toExportLoginAttemptDto(a *ent.LoginAttempt) dto.ExportLoginAttemptDto {
+	return dto.ExportLoginAttemptDto{
+		Email:     a.Email,
+		IPAddress: a.IPAddress,
+		UserAgent: a.UserAgent,
+		Result:    a.Result,
+		CreatedAt: a.CreatedAt,
+	}
+}
+
+func accountDeletionMail(u *ent.User) utils.Mail {
+	if prompts.NormalizeLocale(u.Locale) == prompts.LocaleEn {
+		return utils.Mail{
+			To:      u.Email,
+			Subject: "Your account is scheduled for deletion",
+			Body: fmt.Sprintf("Hi %s,\n\nWe received a request to delete your account. Your account and all of its data will be permanently deleted after %s (UTC).\n\nUntil then, you can log in and cancel the deletion. If you did not request this, log in, cancel the deletion and change your password.\n",
+				u.Name, u.DeletionScheduledAt.UTC().Format("2006-01-02 15:04")),
+		}
+	}
+	return utils.Mail{
+		To:      u.Email,
+		Subject: "退会の申請を受け付けました",
+		Body: fmt.Sprintf("%s さん\n\n退会の申請を受け付けました。%s (UTC) を過ぎると、アカウントと全てのデータを完全に削除します。\n\nそれまではログインして申請を取り消せます。お心当たりが無い場合は、ログインして申請を取り消し、パスワードを変更してください。\n",
+			u.Name, u.DeletionScheduledAt.UTC().Format("2006-01-02 15:04")),
+	}
+}
+
+// accountDeletionConfirmURL は退会の確認画面の URL にトークンを付与して返す。
+// ACCOUNT_DELETION_CONFIRM_URL が未指定の場合は FRONTEND_ORIGIN の /confirm-account-deletion を使う
+func accountDeletionConfirmURL(token string) string {
+	base := os.Getenv("ACCOUNT_DELETION_CONFIRM_URL")
+	if base == "" {
+		base = os.Getenv("FRONTEND_ORIGIN") + "/confirm-account-deletion"
+	}
+	return base + "?token=" + url.QueryEscape(token)
+}
+
+func accountDeletionConfirmMail(u *ent.User, token string) utils.Mail {
+	link := accountDeletionConfirmURL(token)
+	minutes := int(AccountDeletionTokenLifetime.Minutes())
+
+	if prompts.NormalizeLocale(u.Locale) == prompts.LocaleEn {
+		return utils.Mail{
+			To:      u.Email,
+			Subject: "Confirm your account deletion",
+			Body: fmt.Sprintf("Hi %s,\n\nOpen the link below while logged in to confirm that you want to delete your account. The link expires in %d minutes and can be used only once.\n\n%s\n\nIf you did not request this, you can ignore this email.\n",
+				u.Name, minutes, link),
+		}
+	}
+	return utils.Mail{
+		To:      u.Email,
+		Subject: "退会の確認",
+		Body: fmt.Sprintf("%s さん\n\n退会する場合は、ログインした状態で下記のリンクを開いてください。リンクの有効期限は %d 分で、1 回だけ使用できます。\n\n%s\n\nお心当たりが無い場合は、このメールを破棄してください。\n",
+			u.Name, minutes, link),
+	}
+}
@@ -0,0 +1,373 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"todo-app/app_errors"
+	"todo-app/ent"
+	"todo-app/testutils"
+	"todo-app/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var accountDeletionConfirmURLPattern = regexp.MustCompile(`https://example\.com/confirm-account-deletion\?token=(\S+)`)
+
+func TestAccountService_ScheduleDeletion(t *testing.T) {
+	t.Setenv("ACCOUNT_DELETION_GRACE_DAYS", "7")
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	hash, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	user := &ent.User{ID: 1, Name: "test", Email: "user@example.com", Password: string(hash)}
+	sessionID := uuid.New()
+	ctx := utils.WithSessionID(utils.WithUser(context.Background(), user), sessionID)
+
+	t.Run("パスワードを確認して退会を申請し、現在のセッション以外を失効させること", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		sessionRepo := new(testutils.MockSessionRepository)
+		mailer := new(testutils.MockMailer)
+		scheduledAt := time.Now().Add(7 * 24 * time.Hour)
+		userRepo.On("ScheduleDeletion", mock.Anything, 1, mock.MatchedBy(func(at time.Time) bool {
+			return at.Sub(scheduledAt).Abs() < time.Minute
+		})).Return(&ent.User{ID: 1, Email: "user@example.com", DeletionScheduledAt: &scheduledAt}, nil).Once()
+		sessionRepo.On("RevokeAllByUserID", mock.Anything, 1, &sessionID).Return(2, nil).Once()
+		mailer.On("Send", mock.Anything, mock.Anything).Return(nil).Once()
+		listRepo := new(testutils.MockTodoListRepository)
+		listRepo.On("CountLastOwnedByUser", mock.Anything, 1).Return(0, nil).Once()
+		workspaceRepo := new(testutils.MockWorkspaceRepository)
+		workspaceRepo.On("CountLastAdminedByUser", mock.Anything, 1).Return(0, nil).Once()
+		service := NewAccountService(logger, userRepo, sessionRepo, new(testutils.MockPersonalDataRepository), new(testutils.MockAccountDeletionTokenRepository), listRepo, workspaceRepo, nil, mailer)
+
+		res, err := service.ScheduleDeletion(ctx, "password123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, scheduledAt, res.DeletionScheduledAt)
+		userRepo.AssertExpectations(t)
+		sessionRepo.AssertExpectations(t)
+		assert.Equal(t, "user@example.com", mailer.Calls[0].Arguments.Get(1).(utils.Mail).To)
+	})
+
+	t.Run("通知メールの送信に失敗しても退会を申請できること", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		sessionRepo := new(testutils.MockSessionRepository)
+		mailer := new(testutils.MockMailer)
+		scheduledAt := time.Now().Add(7 * 24 * time.Hour)
+		userRepo.On("ScheduleDeletion", mock.Anything, 1, mock.Anything).Return(&ent.User{ID: 1, DeletionScheduledAt: &scheduledAt}, nil).Once()
+		sessionRepo.On("RevokeAllByUserID", mock.Anything, 1, &sessionID).Return(0, nil).Once()
+		mailer.On("Send", mock.Anything, mock.Anything).Return(errors.New("smtp error")).Once()
+		listRepo := new(testutils.MockTodoListRepository)
+		listRepo.On("CountLastOwnedByUser", mock.Anything, 1).Return(0, nil).Once()
+		workspaceRepo := new(testutils.MockWorkspaceRepository)
+		workspaceRepo.On("CountLastAdminedByUser", mock.Anything, 1).Return(0, nil).Once()
+		service := NewAccountService(logger, userRepo, sessionRepo, new(testutils.MockPersonalDataRepository), new(testutils.MockAccountDeletionTokenRepository), listRepo, workspaceRepo, nil, mailer)
+
+		_, err := service.ScheduleDeletion(ctx, "password123")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("パスワードが誤っている場合、退会を申請しないこと", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		sessionRepo := new(testutils.MockSessionRepository)
+		service := NewAccountService(logger, userRepo, sessionRepo, new(testutils.MockPersonalDataRepository), new(testutils.MockAccountDeletionTokenRepository), new(testutils.MockTodoListRepository), new(testutils.MockWorkspaceRepository), nil, new(testutils.MockMailer))
+
+		_, err := service.ScheduleDeletion(ctx, "wrong-password")
+
+		assert.ErrorIs(t, err, app_errors.ErrInvalidCurrentPassword)
+		userRepo.AssertNotCalled(t, "ScheduleDeletion")
+		sessionRepo.AssertNotCalled(t, "RevokeAllByUserID")
+	})
+
+	t.Run("他のメンバーがいるリストの唯一の所有者である場合、退会を申請しないこと", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		sessionRepo := new(testutils.MockSessionRepository)
+		listRepo := new(testutils.MockTodoListRepository)
+		listRepo.On("CountLastOwnedByUser", mock.Anything, 1).Return(1, nil).Once()
+		service := NewAccountService(logger, userRepo, sessionRepo, new(testutils.MockPersonalDataRepository), new(testutils.MockAccountDeletionTokenRepository), listRepo, new(testutils.MockWorkspaceRepository), nil, new(testutils.MockMailer))
+
+		_, err := service.ScheduleDeletion(ctx, "password123")
+
+		assert.ErrorIs(t, err, app_errors.ErrAccountOwnsTodoLists)
+		userRepo.AssertNotCalled(t, "ScheduleDeletion")
+		sessionRepo.AssertNotCalled(t, "RevokeAllByUserID")
+	})
+
+	t.Run("他のメンバーがいるチームのワークスペースの唯一の管理者である場合、退会を申請しないこと", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		sessionRepo := new(testutils.MockSessionRepository)
+		listRepo := new(testutils.MockTodoListRepository)
+		workspaceRepo := new(testutils.MockWorkspaceRepository)
+		listRepo.On("CountLastOwnedByUser", mock.Anything, 1).Return(0, nil).Once()
+		workspaceRepo.On("CountLastAdminedByUser", mock.Anything, 1).Return(1, nil).Once()
+		service := NewAccountService(logger, userRepo, sessionRepo, new(testutils.MockPersonalDataRepository), new(testutils.MockAccountDeletionTokenRepository), listRepo, workspaceRepo, nil, new(testutils.MockMailer))
+
+		_, err := service.ScheduleDeletion(ctx, "password123")
+
+		assert.ErrorIs(t, err, app_errors.ErrAccountAdminsWorkspaces)
+		userRepo.AssertNotCalled(t, "ScheduleDeletion")
+		sessionRepo.AssertNotCalled(t, "RevokeAllByUserID")
+	})
+
+	t.Run("既に退会を申請している場合、エラーを返すこと", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		service := NewAccountService(logger, userRepo, new(testutils.MockSessionRepository), new(testutils.MockPersonalDataRepository), new(testutils.MockAccountDeletionTokenRepository), new(testutils.MockTodoListRepository), new(testutils.MockWorkspaceRepository), nil, new(testutils.MockMailer))
+		scheduledAt := time.Now().Add(time.Hour)
+		scheduled := *user
+		scheduled.DeletionScheduledAt = &scheduledAt
+
+		_, err := service.ScheduleDeletion(utils.WithUser(context.Background(), &scheduled), "password123")
+
+		assert.ErrorIs(t, err, app_errors.ErrAccountDeletionScheduled)
+		userRepo.AssertNotCalled(t, "ScheduleDeletion")
+	})
+}
+
+func TestAccountService_RequestDeletion(t *testing.T) {
+	t.Setenv("ACCOUNT_DELETION_CONFIRM_URL", "https://example.com/confirm-account-deletion")
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	user := &ent.User{ID: 1, Name: "test", Email: "user@example.com", Locale: "en"}
+	ctx := utils.WithUser(context.Background(), user)
+
+	t.Run("退会を確認するリンクをメールで送信し、トークンのハッシュを保存すること", func(t *testing.T) {
+		deletionRepo := new(testutils.MockAccountDeletionTokenRepository)
+		mailer := new(testutils.MockMailer)
+		deletionRepo.On("Create", mock.Anything, 1, mock.Anything, mock.Anything).Return(&ent.AccountDeletionToken{}, nil).Once()
+		mailer.On("Send", mock.Anything, mock.Anything).Return(nil).Once()
+		listRepo := new(testutils.MockTodoListRepository)
+		listRepo.On("CountLastOwnedByUser", mock.Anything, 1).Return(0, nil).Once()
+		workspaceRepo := new(testutils.MockWorkspaceRepository)
+		workspaceRepo.On("CountLastAdminedByUser", mock.Anything, 1).Return(0, nil).Once()
+		service := NewAccountService(logger, new(MockUserRepository), new(testutils.MockSessionRepository), new(testutils.MockPersonalDataRepository), deletionRepo, listRepo, workspaceRepo, nil, mailer)
+
+		err := service.RequestDeletion(ctx)
+
+		assert.NoError(t, err)
+		mail := mailer.Calls[0].Arguments.Get(1).(utils.Mail)
+		assert.Equal(t, "user@example.com", mail.To)
+		assert.Equal(t, "Confirm your account deletion", mail.Subject)
+		match := accountDeletionConfirmURLPattern.FindStringSubmatch(mail.Body)
+		if assert.NotNil(t, match) {
+			deletionRepo.AssertCalled(t, "Create", mock.Anything, 1, hashToken(match[1]), mock.Anything)
+		}
+		expiresAt := deletionRepo.Calls[0].Arguments.Get(3).(time.Time)
+		assert.WithinDuration(t, time.Now().Add(AccountDeletionTokenLifetime), expiresAt, time.Minute)
+	})
+
+	t.Run("他のメンバーがいるリストの唯一の所有者である場合、メールを送信しないこと", func(t *testing.T) {
+		deletionRepo := new(testutils.MockAccountDeletionTokenRepository)
+		listRepo := new(testutils.MockTodoListRepository)
+		mailer := new(testutils.MockMailer)
+		listRepo.On("CountLastOwnedByUser", mock.Anything, 1).Return(2, nil).Once()
+		service := NewAccountService(logger, new(MockUserRepository), new(testutils.MockSessionRepository), new(testutils.MockPersonalDataRepository), deletionRepo, listRepo, new(testutils.MockWorkspaceRepository), nil, mailer)
+
+		err := service.RequestDeletion(ctx)
+
+		assert.ErrorIs(t, err, app_errors.ErrAccountOwnsTodoLists)
+		deletionRepo.AssertNotCalled(t, "Create")
+		mailer.AssertNotCalled(t, "Send")
+	})
+
+	t.Run("他のメンバーがいるチームのワークスペースの唯一の管理者である場合、メールを送信しないこと", func(t *testing.T) {
+		deletionRepo := new(testutils.MockAccountDeletionTokenRepository)
+		listRepo := new(testutils.MockTodoListRepository)
+		workspaceRepo := new(testutils.MockWorkspaceRepository)
+		mailer := new(testutils.MockMailer)
+		listRepo.On("CountLastOwnedByUser", mock.Anything, 1).Return(0, nil).Once()
+		workspaceRepo.On("CountLastAdminedByUser", mock.Anything, 1).Return(1, nil).Once()
+		service := NewAccountService(logger, new(MockUserRepository), new(testutils.MockSessionRepository), new(testutils.MockPersonalDataRepository), deletionRepo, listRepo, workspaceRepo, nil, mailer)
+
+		err := service.RequestDeletion(ctx)
+
+		assert.ErrorIs(t, err, app_errors.ErrAccountAdminsWorkspaces)
+		deletionRepo.AssertNotCalled(t, "Create")
+		mailer.AssertNotCalled(t, "Send")
+	})
+
+	t.Run("既に退会を申請している場合、メールを送信しないこと", func(t *testing.T) {
+		deletionRepo := new(testutils.MockAccountDeletionTokenRepository)
+		mailer := new(testutils.MockMailer)
+		service := NewAccountService(logger, new(MockUserRepository), new(testutils.MockSessionRepository), new(testutils.MockPersonalDataRepository), deletionRepo, new(testutils.MockTodoListRepository), new(testutils.MockWorkspaceRepository), nil, mailer)
+		scheduledAt := time.Now().Add(time.Hour)
+		scheduled := *user
+		scheduled.DeletionScheduledAt = &scheduledAt
+
+		err := service.RequestDeletion(utils.WithUser(context.Background(), &scheduled))
+
+		assert.ErrorIs(t, err, app_errors.ErrAccountDeletionScheduled)
+		deletionRepo.AssertNotCalled(t, "Create")
+		mailer.AssertNotCalled(t, "Send")
+	})
+}
+
+func TestAccountService_ConfirmDeletion(t *testing.T) {
+	t.Setenv("ACCOUNT_DELETION_GRACE_DAYS", "7")
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	user := &ent.User{ID: 1, Name: "test", Email: "user@example.com"}
+	sessionID := uuid.New()
+	ctx := utils.WithSessionID(utils.WithUser(context.Background(), user), sessionID)
+
+	t.Run("トークンを確認して退会を申請し、現在のセッション以外を失効させること", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		sessionRepo := new(testutils.MockSessionRepository)
+		deletionRepo := new(testutils.MockAccountDeletionTokenRepository)
+		mailer := new(testutils.MockMailer)
+		scheduledAt := time.Now().Add(7 * 24 * time.Hour)
+		deletionRepo.On("Consume", mock.Anything, hashToken("valid-token")).Return(&ent.AccountDeletionToken{UserID: 1, ExpiresAt: time.Now().Add(time.Minute)}, true, nil).Once()
+		userRepo.On("ScheduleDeletion", mock.Anything, 1, mock.Anything).Return(&ent.User{ID: 1, Email: "user@example.com", DeletionScheduledAt: &scheduledAt}, nil).Once()
+		sessionRepo.On("RevokeAllByUserID", mock.Anything, 1, &sessionID).Return(1, nil).Once()
+		mailer.On("Send", mock.Anything, mock.Anything).Return(nil).Once()
+		listRepo := new(testutils.MockTodoListRepository)
+		listRepo.On("CountLastOwnedByUser", mock.Anything, 1).Return(0, nil).Once()
+		workspaceRepo := new(testutils.MockWorkspaceRepository)
+		workspaceRepo.On("CountLastAdminedByUser", mock.Anything, 1).Return(0, nil).Once()
+		service := NewAccountService(logger, userRepo, sessionRepo, new(testutils.MockPersonalDataRepository), deletionRepo, listRepo, workspaceRepo, nil, mailer)
+
+		res, err := service.ConfirmDeletion(ctx, "valid-token")
+
+		assert.NoError(t, err)
+		assert.Equal(t, scheduledAt, res.DeletionScheduledAt)
+		userRepo.AssertExpectations(t)
+		sessionRepo.AssertExpectations(t)
+	})
+
+	t.Run("トークンが無効な場合、退会を申請しないこと", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			token    *ent.AccountDeletionToken
+			consumed bool
+			err      error
+		}{
+			{name: "存在しないトークンの場合", err: &ent.NotFoundError{}},
+			{name: "使用済みのトークンの場合", token: &ent.AccountDeletionToken{UserID: 1, ExpiresAt: time.Now().Add(time.Minute)}, consumed: false},
+			{name: "有効期限が切れたトークンの場合", token: &ent.AccountDeletionToken{UserID: 1, ExpiresAt: time.Now().Add(-time.Minute)}, consumed: true},
+			{name: "他のユーザーに発行したトークンの場合", token: &ent.AccountDeletionToken{UserID: 2, ExpiresAt: time.Now().Add(time.Minute)}, consumed: true},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				userRepo := new(MockUserRepository)
+				deletionRepo := new(testutils.MockAccountDeletionTokenRepository)
+				if tt.token != nil {
+					deletionRepo.On("Consume", mock.Anything, hashToken("token")).Return(tt.token, tt.consumed, nil).Once()
+				} else {
+					deletionRepo.On("Consume", mock.Anything, hashToken("token")).Return(nil, false, tt.err).Once()
+				}
+				service := NewAccountService(logger, userRepo, new(testutils.MockSessionRepository), new(testutils.MockPersonalDataRepository), deletionRepo, new(testutils.MockTodoListRepository), new(testutils.MockWorkspaceRepository), nil, new(testutils.MockMailer))
+
+				_, err := service.ConfirmDeletion(ctx, "token")
+
+				assert.ErrorIs(t, err, app_errors.ErrInvalidAccountDeletionToken)
+				userRepo.AssertNotCalled(t, "ScheduleDeletion")
+			})
+		}
+	})
+}
+
+func TestAccountService_CancelDeletion(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("退会の申請を取り消せること", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		scheduledAt := time.Now().Add(time.Hour)
+		ctx := utils.WithUser(context.Background(), &ent.User{ID: 1, DeletionScheduledAt: &scheduledAt})
+		userRepo.On("CancelDeletion", mock.Anything, 1).Return(&ent.User{ID: 1}, nil).Once()
+		service := NewAccountService(logger, userRepo, new(testutils.MockSessionRepository), new(testutils.MockPersonalDataRepository), new(testutils.MockAccountDeletionTokenRepository), new(testutils.MockTodoListRepository), new(testutils.MockWorkspaceRepository), nil, new(testutils.MockMailer))
+
+		err := service.CancelDeletion(ctx)
+
+		assert.NoError(t, err)
+		userRepo.AssertExpectations(t)
+	})
+
+	t.Run("退会を申請していない場合、エラーを返すこと", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		ctx := utils.WithUser(context.Background(), &ent.User{ID: 1})
+		service := NewAccountService(logger, userRepo, new(testutils.MockSessionRepository), new(testutils.MockPersonalDataRepository), new(testutils.MockAccountDeletionTokenRepository), new(testutils.MockTodoListRepository), new(testutils.MockWorkspaceRepository), nil, new(testutils.MockMailer))
+
+		err := service.CancelDeletion(ctx)
+
+		assert.ErrorIs(t, err, app_errors.ErrAccountDeletionNotScheduled)
+		userRepo.AssertNotCalled(t, "CancelDeletion")
+	})
+}
+
+func TestAccountService_Export(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	user := &ent.User{ID: 1, Name: "test", Email: "user@example.com", Password: "hashed-password"}
+	ctx := utils.WithUser(context.Background(), user)
+
+	t.Run("種類ごとに分割して取得し、認証に使う値を含めずに書き込むこと", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		dataRepo := new(testutils.MockPersonalDataRepository)
+		emailChangeRepo := new(testutils.MockEmailChangeTokenRepository)
+		emailChangeRepo.On("FindPending", mock.Anything, 1, mock.Anything).Return(nil, &ent.NotFoundError{}).Once()
+		firstBatch := make([]*ent.Todo, personalDataExportBatchSize)
+		for i := range firstBatch {
+			firstBatch[i] = &ent.Todo{ID: i + 1, Title: "todo"}
+		}
+		dataRepo.On("ListTodos", mock.Anything, 1, 0, personalDataExportBatchSize).Return(firstBatch, nil).Once()
+		dataRepo.On("ListTodos", mock.Anything, 1, personalDataExportBatchSize, personalDataExportBatchSize).Return([]*ent.Todo{{ID: 999, Title: "last"}}, nil).Once()
+		dataRepo.On("ListTodoFilterHistories", mock.Anything, 1, 0, personalDataExportBatchSize).Return([]*ent.TodoFilterHistory{}, nil)
+		dataRepo.On("ListTodoBreakdowns", mock.Anything, 1, 0, personalDataExportBatchSize).Return([]*ent.TodoBreakdown{}, nil)
+		dataRepo.On("ListTodoSummaries", mock.Anything, 1, 0, personalDataExportBatchSize).Return([]*ent.TodoSummary{}, nil)
+		dataRepo.On("ListSessions", mock.Anything, 1, 0, personalDataExportBatchSize).Return([]*ent.Session{}, nil)
+		dataRepo.On("ListAIUsages", mock.Anything, 1, 0, personalDataExportBatchSize).Return([]*ent.AIUsage{}, nil)
+		dataRepo.On("ListPersonalAccessTokens", mock.Anything, 1, 0, personalDataExportBatchSize).Return([]*ent.PersonalAccessToken{
+			{ID: uuid.New(), Name: "cli", TokenHash: "secret-token-hash", TokenPrefix: "tdp_abcd", Scopes: []string{"read"}},
+		}, nil)
+		dataRepo.On("ListIdentities", mock.Anything, 1, 0, personalDataExportBatchSize).Return([]*ent.UserIdentity{}, nil)
+		dataRepo.On("ListLoginAttempts", mock.Anything, 1, 0, personalDataExportBatchSize).Return([]*ent.LoginAttempt{}, nil)
+		mailer := new(testutils.MockMailer)
+		profileService := NewProfileService(userRepo, new(testutils.MockSessionRepository), new(testutils.MockPersonalAccessTokenRepository), emailChangeRepo, mailer)
+		service := NewAccountService(logger, userRepo, new(testutils.MockSessionRepository), dataRepo, new(testutils.MockAccountDeletionTokenRepository), new(testutils.MockTodoListRepository), new(testutils.MockWorkspaceRepository), profileService, mailer)
+
+		var buf strings.Builder
+		err := service.Export(ctx, &buf)
+
+		require.NoError(t, err)
+		var res struct {
+			Version int `json:"version"`
+			Profile struct {
+				Email string `json:"email"`
+			} `json:"profile"`
+			Todos               []map[string]interface{} `json:"todos"`
+			TodoFilterHistories []interface{}            `json:"todo_filter_histories"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(buf.String()), &res), buf.String())
+		assert.Equal(t, 1, res.Version)
+		assert.Equal(t, "user@example.com", res.Profile.Email)
+		assert.Len(t, res.Todos, personalDataExportBatchSize+1)
+		assert.Equal(t, "last", res.Todos[personalDataExportBatchSize]["title"])
+		assert.NotNil(t, res.TodoFilterHistories)
+		dataRepo.AssertExpectations(t)
+		assert.NotContains(t, buf.String(), "hashed-password")
+		assert.NotContains(t, buf.String(), "secret-token-hash")
+	})
+
+	t.Run("プロフィールを取得できない場合、何も書き込まずにエラーを返すこと", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		dataRepo := new(testutils.MockPersonalDataRepository)
+		emailChangeRepo := new(testutils.MockEmailChangeTokenRepository)
+		emailChangeRepo.On("FindPending", mock.Anything, 1, mock.Anything).Return(nil, errors.New("db error")).Once()
+		mailer := new(testutils.MockMailer)
+		profileService := NewProfileService(userRepo, new(testutils.MockSessionRepository), new(testutils.MockPersonalAccessTokenRepository), emailChangeRepo, mailer)
+		service := NewAccountService(logger, userRepo, new(testutils.MockSessionRepository), dataRepo, new(testutils.MockAccountDeletionTokenRepository), new(testutils.MockTodoListRepository), new(testutils.MockWorkspaceRepository), profileService, mailer)
+
+		var buf strings.Builder
+		err := service.Export(ctx, &buf)
+
+		assert.Error(t, err)
+		assert.Empty(t, buf.String())
+		dataRepo.AssertNotCalled(t, "ListTodos")
+	})
+}
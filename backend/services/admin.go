@@ -0,0 +1,273 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"todo-app/app_errors"
+	"todo-app/dto"
+	"todo-app/ent"
+	"todo-app/repositories"
+	"todo-app/utils"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// AdminService は管理者によるユーザーの管理を扱う。変更を伴う操作は監査記録に残す
+type AdminService struct {
+	logger          *slog.Logger
+	userRepo        repositories.IUserRepository
+	adminRepo       repositories.IAdminRepository
+	auditRepo       repositories.IAdminAuditLogRepository
+	sessionRepo     repositories.ISessionRepository
+	passwordService *PasswordService
+	authService     *AuthService
+	quota           AIQuota
+}
+
+func NewAdminService(logger *slog.Logger, userRepo repositories.IUserRepository, adminRepo repositories.IAdminRepository, auditRepo repositories.IAdminAuditLogRepository, sessionRepo repositories.ISessionRepository, passwordService *PasswordService, authService *AuthService) *AdminService {
+	return &AdminService{
+		logger:          logger,
+		userRepo:        userRepo,
+		adminRepo:       adminRepo,
+		auditRepo:       auditRepo,
+		sessionRepo:     sessionRepo,
+		passwordService: passwordService,
+		authService:     authService,
+		quota:           LoadAIQuotaFromEnv(),
+	}
+}
+
+// ListUsers はメールアドレスか名前に query を含むユーザーを返す
+func (s *AdminService) ListUsers(ctx context.Context, query string, page int, limit int) (*dto.AdminListUsersResponseDto, error) {
+	users, total, err := s.adminRepo.SearchUsers(ctx, query, (page-1)*limit, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &dto.AdminListUsersResponseDto{
+		Data:       make([]dto.AdminUserDto, len(users)),
+		Pagination: newPaginationDto(total, page, limit),
+	}
+	for i, u := range users {
+		res.Data[i] = toAdminUserDto(u)
+	}
+	return res, nil
+}
+
+// GetUser はユーザーの情報と Todo の件数、AI の利用状況を返す
+func (s *AdminService) GetUser(ctx context.Context, id int) (*dto.AdminUserDetailDto, error) {
+	u, err := s.userRepo.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	total, done, err := s.adminRepo.CountTodos(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().In(usageLocation(u))
+	dayStart, dayEnd := dailyPeriod(now)
+	monthStart, monthEnd := monthlyPeriod(now)
+	dailyRequests, dailyTokens, err := s.adminRepo.SumAIUsage(ctx, id, dayStart)
+	if err != nil {
+		return nil, err
+	}
+	monthlyRequests, monthlyTokens, err := s.adminRepo.SumAIUsage(ctx, id, monthStart)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.AdminUserDetailDto{
+		AdminUserDto: toAdminUserDto(u),
+		Todos:        dto.AdminTodoCountsDto{Total: total, Done: done, Open: total - done},
+		AIUsage: dto.AIUsageResponseDto{
+			Daily:   *newAIUsagePeriodDto(dailyRequests, dailyTokens, dayEnd, s.quota.DailyRequests, s.quota.DailyTokens),
+			Monthly: *newAIUsagePeriodDto(monthlyRequests, monthlyTokens, monthEnd, s.quota.MonthlyRequests, s.quota.MonthlyTokens),
+		},
+	}, nil
+}
+
+// SetDisabled はユーザーを無効または有効にする。
+// 無効にした場合はログイン中のセッションを全て失効させる。パーソナルアクセストークンは認証の時点で拒否する
+func (s *AdminService) SetDisabled(ctx context.Context, id int, disabled bool, ipAddress string) (*dto.AdminUserDto, error) {
+	admin, err := s.currentAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if admin.ID == id {
+		return nil, app_errors.ErrCannotModifySelf
+	}
+
+	u, err := s.userRepo.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	// 既に同じ状態の場合は何もしない
+	if (u.DisabledAt != nil) == disabled {
+		res := toAdminUserDto(u)
+		return &res, nil
+	}
+
+	action := repositories.AdminActionEnableUser
+	var disabledAt *time.Time
+	if disabled {
+		action = repositories.AdminActionDisableUser
+		now := time.Now()
+		disabledAt = &now
+	}
+	if err := s.audit(ctx, admin.ID, id, action, ipAddress); err != nil {
+		return nil, err
+	}
+
+	u, err = s.userRepo.SetDisabledAt(ctx, id, disabledAt)
+	if err != nil {
+		return nil, err
+	}
+	if disabled {
+		if _, err := s.sessionRepo.RevokeAllByUserID(ctx, id, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	res := toAdminUserDto(u)
+	return &res, nil
+}
+
+// ResetPassword はユーザーのパスワードを使用できないものに置き換え、全てのセッションを失効させてから、
+// パスワード再設定のリンクをユーザーのメールアドレスに送信する
+func (s *AdminService) ResetPassword(ctx context.Context, id int, ipAddress string) error {
+	admin, err := s.currentAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	if admin.ID == id {
+		return app_errors.ErrCannotModifySelf
+	}
+
+	u, err := s.userRepo.FindById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.audit(ctx, admin.ID, id, repositories.AdminActionResetPassword, ipAddress); err != nil {
+		return err
+	}
+
+	// 誰も知らないランダムなパスワードにし、再設定するまではログインできないようにする
+	random, err := newRandomToken()
+	if err != nil {
+		return err
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(random), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	if err := s.userRepo.UpdatePassword(ctx, id, string(hash)); err != nil {
+		return err
+	}
+	if _, err := s.sessionRepo.RevokeAllByUserID(ctx, id, nil); err != nil {
+		return err
+	}
+	return s.passwordService.RequestReset(ctx, u.Email)
+}
+
+// Impersonate は管理者がユーザーとしてログインするためのトークンを発行する。
+// 管理者や無効にしたユーザーにはなりすませない
+func (s *AdminService) Impersonate(ctx context.Context, id int, ipAddress string, userAgent string) (*dto.AuthTokens, error) {
+	admin, err := s.currentAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if admin.ID == id {
+		return nil, app_errors.ErrCannotModifySelf
+	}
+
+	u, err := s.userRepo.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if u.Role == repositories.UserRoleAdmin {
+		return nil, app_errors.ErrCannotImpersonateAdmin
+	}
+	if u.DisabledAt != nil {
+		return nil, app_errors.ErrAccountDisabled
+	}
+
+	if err := s.audit(ctx, admin.ID, id, repositories.AdminActionImpersonate, ipAddress); err != nil {
+		return nil, err
+	}
+	return s.authService.StartImpersonation(ctx, id, admin.ID, ipAddress, userAgent)
+}
+
+// ListAuditLogs は監査記録を新しい順に返す。targetUserID を指定した場合はそのユーザーに対する操作に絞り込む
+func (s *AdminService) ListAuditLogs(ctx context.Context, targetUserID *int, page int, limit int) (*dto.AdminListAuditLogsResponseDto, error) {
+	logs, total, err := s.auditRepo.List(ctx, targetUserID, (page-1)*limit, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &dto.AdminListAuditLogsResponseDto{
+		Data:       make([]dto.AdminAuditLogDto, len(logs)),
+		Pagination: newPaginationDto(total, page, limit),
+	}
+	for i, l := range logs {
+		res.Data[i] = dto.AdminAuditLogDto{
+			ID:           l.ID.String(),
+			ActorID:      l.ActorID,
+			TargetUserID: l.TargetUserID,
+			Action:       l.Action,
+			Detail:       l.Detail,
+			IPAddress:    l.IPAddress,
+			CreatedAt:    l.CreatedAt,
+		}
+	}
+	return res, nil
+}
+
+func (s *AdminService) currentAdmin(ctx context.Context) (*ent.User, error) {
+	u, ok := utils.UserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("user not found in context")
+	}
+	return u, nil
+}
+
+// audit は操作の前に監査記録を残す。記録できなかった場合は操作を行わない
+func (s *AdminService) audit(ctx context.Context, actorID int, targetUserID int, action string, ipAddress string) error {
+	return s.auditRepo.Create(ctx, repositories.AdminAuditLogEntry{
+		ActorID:      actorID,
+		TargetUserID: &targetUserID,
+		Action:       action,
+		IPAddress:    ipAddress,
+	})
+}
+
+func toAdminUserDto(u *ent.User) dto.AdminUserDto {
+	return dto.AdminUserDto{
+		ID:                  u.ID,
+		Name:                u.Name,
+		Email:               u.Email,
+		Role:                u.Role,
+		DisabledAt:          u.DisabledAt,
+		DeletionScheduledAt: u.DeletionScheduledAt,
+		CreatedAt:           u.CreatedAt,
+	}
+}
+
+// newPaginationDto は total 件を limit 件ずつに分けた場合のページの情報を返す
+func newPaginationDto(total int, page int, limit int) *dto.PaginationDto {
+	totalPages := (total + limit - 1) / limit
+	if totalPages == 0 {
+		totalPages = 1
+	}
+	return &dto.PaginationDto{
+		TotalPages:  totalPages,
+		CurrentPage: page,
+		HasNext:     page < totalPages,
+		HasPrev:     page > 1,
+		Limit:       limit,
+	}
+}
@@ -0,0 +1,184 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"todo-app/app_errors"
+	"todo-app/ent"
+	"todo-app/repositories"
+	"todo-app/testutils"
+	"todo-app/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var adminCtx = utils.WithUser(context.Background(), &ent.User{ID: 1, Role: repositories.UserRoleAdmin})
+
+func TestAdminService_ListUsers(t *testing.T) {
+	t.Run("検索結果とページネーションを返すこと", func(t *testing.T) {
+		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+		adminRepo := new(testutils.MockAdminRepository)
+		adminRepo.On("SearchUsers", mock.Anything, "example", 20, 20).
+			Return([]*ent.User{{ID: 2, Email: "user@example.com", Role: repositories.UserRoleUser}}, 41, nil).Once()
+		service := NewAdminService(logger, new(MockUserRepository), adminRepo, new(testutils.MockAdminAuditLogRepository), new(testutils.MockSessionRepository), nil, nil)
+
+		res, err := service.ListUsers(adminCtx, "example", 2, 20)
+
+		assert.NoError(t, err)
+		if assert.Len(t, res.Data, 1) {
+			assert.Equal(t, "user@example.com", res.Data[0].Email)
+		}
+		assert.Equal(t, 3, res.Pagination.TotalPages)
+		assert.True(t, res.Pagination.HasNext)
+		assert.True(t, res.Pagination.HasPrev)
+	})
+}
+
+func TestAdminService_GetUser(t *testing.T) {
+	t.Run("Todo の件数と AI の利用状況を含めて返すこと", func(t *testing.T) {
+		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+		userRepo := new(MockUserRepository)
+		adminRepo := new(testutils.MockAdminRepository)
+		userRepo.On("FindById", mock.Anything, 2).Return(&ent.User{ID: 2, Email: "user@example.com"}, nil).Once()
+		adminRepo.On("CountTodos", mock.Anything, 2).Return(5, 2, nil).Once()
+		adminRepo.On("SumAIUsage", mock.Anything, 2, mock.Anything).Return(3, 300, nil).Once()
+		adminRepo.On("SumAIUsage", mock.Anything, 2, mock.Anything).Return(10, 1000, nil).Once()
+		service := NewAdminService(logger, userRepo, adminRepo, new(testutils.MockAdminAuditLogRepository), new(testutils.MockSessionRepository), nil, nil)
+
+		res, err := service.GetUser(adminCtx, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 5, res.Todos.Total)
+		assert.Equal(t, 3, res.Todos.Open)
+		assert.Equal(t, 3, res.AIUsage.Daily.Requests)
+		assert.Equal(t, 1000, res.AIUsage.Monthly.Tokens)
+	})
+}
+
+func TestAdminService_SetDisabled(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("無効にする場合、監査ログを記録して全てのセッションを失効させること", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		auditRepo := new(testutils.MockAdminAuditLogRepository)
+		sessionRepo := new(testutils.MockSessionRepository)
+		disabledAt := time.Now()
+		userRepo.On("FindById", mock.Anything, 2).Return(&ent.User{ID: 2}, nil).Once()
+		auditRepo.On("Create", mock.Anything, mock.MatchedBy(func(e repositories.AdminAuditLogEntry) bool {
+			return e.ActorID == 1 && *e.TargetUserID == 2 && e.Action == repositories.AdminActionDisableUser && e.IPAddress == "192.0.2.1"
+		})).Return(nil).Once()
+		userRepo.On("SetDisabledAt", mock.Anything, 2, mock.AnythingOfType("*time.Time")).Return(&ent.User{ID: 2, DisabledAt: &disabledAt}, nil).Once()
+		sessionRepo.On("RevokeAllByUserID", mock.Anything, 2, (*uuid.UUID)(nil)).Return(1, nil).Once()
+		service := NewAdminService(logger, userRepo, new(testutils.MockAdminRepository), auditRepo, sessionRepo, nil, nil)
+
+		res, err := service.SetDisabled(adminCtx, 2, true, "192.0.2.1")
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res.DisabledAt)
+		auditRepo.AssertExpectations(t)
+		sessionRepo.AssertExpectations(t)
+	})
+
+	t.Run("有効にする場合、セッションは失効させないこと", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		auditRepo := new(testutils.MockAdminAuditLogRepository)
+		sessionRepo := new(testutils.MockSessionRepository)
+		disabledAt := time.Now()
+		userRepo.On("FindById", mock.Anything, 2).Return(&ent.User{ID: 2, DisabledAt: &disabledAt}, nil).Once()
+		auditRepo.On("Create", mock.Anything, mock.MatchedBy(func(e repositories.AdminAuditLogEntry) bool {
+			return e.Action == repositories.AdminActionEnableUser
+		})).Return(nil).Once()
+		userRepo.On("SetDisabledAt", mock.Anything, 2, (*time.Time)(nil)).Return(&ent.User{ID: 2}, nil).Once()
+		service := NewAdminService(logger, userRepo, new(testutils.MockAdminRepository), auditRepo, sessionRepo, nil, nil)
+
+		res, err := service.SetDisabled(adminCtx, 2, false, "192.0.2.1")
+
+		assert.NoError(t, err)
+		assert.Nil(t, res.DisabledAt)
+		sessionRepo.AssertNotCalled(t, "RevokeAllByUserID")
+	})
+
+	t.Run("既に指定した状態の場合、監査ログを記録せず更新もしないこと", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		auditRepo := new(testutils.MockAdminAuditLogRepository)
+		userRepo.On("FindById", mock.Anything, 2).Return(&ent.User{ID: 2}, nil).Once()
+		service := NewAdminService(logger, userRepo, new(testutils.MockAdminRepository), auditRepo, new(testutils.MockSessionRepository), nil, nil)
+
+		_, err := service.SetDisabled(adminCtx, 2, false, "192.0.2.1")
+
+		assert.NoError(t, err)
+		auditRepo.AssertNotCalled(t, "Create")
+		userRepo.AssertNotCalled(t, "SetDisabledAt")
+	})
+
+	t.Run("自分自身は無効にできないこと", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		service := NewAdminService(logger, userRepo, new(testutils.MockAdminRepository), new(testutils.MockAdminAuditLogRepository), new(testutils.MockSessionRepository), nil, nil)
+
+		_, err := service.SetDisabled(adminCtx, 1, true, "192.0.2.1")
+
+		assert.ErrorIs(t, err, app_errors.ErrCannotModifySelf)
+		userRepo.AssertNotCalled(t, "SetDisabledAt")
+	})
+
+	t.Run("監査ログの記録に失敗した場合、無効にしないこと", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		auditRepo := new(testutils.MockAdminAuditLogRepository)
+		sessionRepo := new(testutils.MockSessionRepository)
+		userRepo.On("FindById", mock.Anything, 2).Return(&ent.User{ID: 2}, nil).Once()
+		auditRepo.On("Create", mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+		service := NewAdminService(logger, userRepo, new(testutils.MockAdminRepository), auditRepo, sessionRepo, nil, nil)
+
+		_, err := service.SetDisabled(adminCtx, 2, true, "192.0.2.1")
+
+		assert.Error(t, err)
+		userRepo.AssertNotCalled(t, "SetDisabledAt")
+		sessionRepo.AssertNotCalled(t, "RevokeAllByUserID")
+	})
+}
+
+func TestAdminService_Impersonate(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("管理者にはなりすませないこと", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		auditRepo := new(testutils.MockAdminAuditLogRepository)
+		userRepo.On("FindById", mock.Anything, 2).Return(&ent.User{ID: 2, Role: repositories.UserRoleAdmin}, nil).Once()
+		service := NewAdminService(logger, userRepo, new(testutils.MockAdminRepository), auditRepo, new(testutils.MockSessionRepository), nil, nil)
+
+		_, err := service.Impersonate(adminCtx, 2, "192.0.2.1", "test-agent")
+
+		assert.ErrorIs(t, err, app_errors.ErrCannotImpersonateAdmin)
+		auditRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("無効にしたユーザーにはなりすませないこと", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		sessionRepo := new(testutils.MockSessionRepository)
+		disabledAt := time.Now()
+		userRepo.On("FindById", mock.Anything, 2).Return(&ent.User{ID: 2, Role: repositories.UserRoleUser, DisabledAt: &disabledAt}, nil).Once()
+		service := NewAdminService(logger, userRepo, new(testutils.MockAdminRepository), new(testutils.MockAdminAuditLogRepository), sessionRepo, nil, nil)
+
+		_, err := service.Impersonate(adminCtx, 2, "192.0.2.1", "test-agent")
+
+		assert.ErrorIs(t, err, app_errors.ErrAccountDisabled)
+		sessionRepo.AssertNotCalled(t, "CreateImpersonation")
+	})
+
+	t.Run("自分自身にはなりすませないこと", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		service := NewAdminService(logger, userRepo, new(testutils.MockAdminRepository), new(testutils.MockAdminAuditLogRepository), new(testutils.MockSessionRepository), nil, nil)
+
+		_, err := service.Impersonate(adminCtx, 1, "192.0.2.1", "test-agent")
+
+		assert.ErrorIs(t, err, app_errors.ErrCannotModifySelf)
+		userRepo.AssertNotCalled(t, "FindById")
+	})
+}
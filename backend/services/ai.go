@@ -2,17 +2,85 @@ package services
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 	"todo-app/dto"
 	"todo-app/ent"
 	function_declerations "todo-app/function_declarations"
+	"todo-app/prompts"
 	"todo-app/repositories"
 	"todo-app/utils"
 
 	"google.golang.org/genai"
 )
 
+const geminiModel = "gemini-3-flash-preview"
+
+var todoBreakdownSchema = &genai.Schema{
+	Type: genai.TypeObject,
+	Properties: map[string]*genai.Schema{
+		"steps": {
+			Type:        genai.TypeArray,
+			Description: "ToDo を完了するための具体的な手順 (実行順)",
+			Items: &genai.Schema{
+				Type: genai.TypeObject,
+				Properties: map[string]*genai.Schema{
+					"title": {
+						Type:        genai.TypeString,
+						Description: "手順のタイトル (64文字以内)",
+					},
+					"description": {
+						Type:        genai.TypeString,
+						Description: "手順の補足 (200文字以内)",
+					},
+				},
+				Required: []string{"title", "description"},
+			},
+		},
+	},
+	Required: []string{"steps"},
+}
+
+var todoSummarySchema = &genai.Schema{
+	Type: genai.TypeObject,
+	Properties: map[string]*genai.Schema{
+		"overview": {
+			Type:        genai.TypeString,
+			Description: "期間全体の振り返り (300文字以内)",
+		},
+		"highlights": {
+			Type:        genai.TypeArray,
+			Description: "特筆すべき成果",
+			Items:       &genai.Schema{Type: genai.TypeString},
+		},
+		"sections": {
+			Type:        genai.TypeArray,
+			Description: "内容ごとにグループ化した要約",
+			Items: &genai.Schema{
+				Type: genai.TypeObject,
+				Properties: map[string]*genai.Schema{
+					"heading": {
+						Type:        genai.TypeString,
+						Description: "グループの見出し",
+					},
+					"summary": {
+						Type:        genai.TypeString,
+						Description: "グループの要約",
+					},
+					"todo_ids": {
+						Type:        genai.TypeArray,
+						Description: "グループに含まれる ToDo の ID",
+						Items:       &genai.Schema{Type: genai.TypeInteger},
+					},
+				},
+				Required: []string{"heading", "summary", "todo_ids"},
+			},
+		},
+	},
+	Required: []string{"overview", "highlights", "sections"},
+}
+
 type AIService struct {
 	repo repositories.ITodoRepository
 }
@@ -21,16 +89,22 @@ func NewAIService(repo repositories.ITodoRepository) *AIService {
 	return &AIService{repo: repo}
 }
 
+// DecideFilterTodosFunction はクエリに対応する Tool と引数を AI に判定させる。
+// プロンプトはユーザーの言語で生成し、現在時刻はユーザーのタイムゾーンで伝える。
 func (s *AIService) DecideFilterTodosFunction(ctx context.Context, aiClient utils.IGenAIClient, query string) (*dto.AIFilterDto, error) {
-	parts := []*genai.Part{
-		{Text: time.Now().Format("現在2006年1月2日15:04:05です。")},
-		{Text: "使用できる Tool が無い場合は「対応できる Tool がありません」とだけ回答するようにしてください。"},
-		{Text: query},
+	settings := PromptSettingsFromContext(ctx)
+	prompt, err := prompts.Render(prompts.FilterTodos, settings.Locale, prompts.FilterTodosData{
+		Now:      settings.Now(),
+		TimeZone: settings.Location.String(),
+		Query:    query,
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	result, err := aiClient.GenerateContent(ctx,
-		"gemini-3-flash-preview",
-		[]*genai.Content{{Parts: parts}},
+		geminiModel,
+		prompt.Contents(),
 		&genai.GenerateContentConfig{
 			Tools: []*genai.Tool{
 				{
@@ -45,13 +119,14 @@ func (s *AIService) DecideFilterTodosFunction(ctx context.Context, aiClient util
 		return nil, err
 	}
 
-	if len(result.Candidates) == 0 || len(result.Candidates[0].Content.Parts) == 0 {
+	if len(result.Candidates) == 0 || result.Candidates[0].Content == nil || len(result.Candidates[0].Content.Parts) == 0 {
 		return nil, nil
 	}
 
 	fc := result.Candidates[0].Content.Parts[0].FunctionCall
 	if fc == nil {
-		return nil, nil
+		// Tool が呼び出されなかった理由を利用者に示すため、モデルの回答を返す
+		return &dto.AIFilterDto{Text: result.Text()}, nil
 	}
 
 	return &dto.AIFilterDto{
@@ -62,21 +137,218 @@ func (s *AIService) DecideFilterTodosFunction(ctx context.Context, aiClient util
 
 func (s *AIService) FilterTodos(ctx context.Context, functionName string, args interface{}) ([]*ent.Todo, error) {
 	if functionName == "ListTodosByDoneAt" {
-		m, ok := args.(map[string]interface{})
-		if !ok {
-			return nil, fmt.Errorf("invalid args type")
+		listArgs, err := parseListTodosByDoneAtArgs(args)
+		if err != nil {
+			return nil, err
 		}
 
-		listArgs := function_declerations.ListTodosByDoneAtArgs{}
-		if v, ok := m["done_from"].(string); ok {
-			listArgs.DoneFrom = v
+		return function_declerations.ListTodosByDoneAt(ctx, s.repo, listArgs)
+	}
+
+	return nil, fmt.Errorf("unknown function: %s", functionName)
+}
+
+// ResolveFilterArgs はモデルが指定した引数を、実際に検索に使用する値に変換して返す。
+// 日時はユーザーのタイムゾーンの RFC3339 形式に揃え、指定されていない項目は nil とする。
+func (s *AIService) ResolveFilterArgs(ctx context.Context, functionName string, args interface{}) (map[string]interface{}, error) {
+	if functionName == "ListTodosByDoneAt" {
+		listArgs, err := parseListTodosByDoneAtArgs(args)
+		if err != nil {
+			return nil, err
 		}
-		if v, ok := m["done_to"].(string); ok {
-			listArgs.DoneTo = v
+
+		doneFrom, doneTo, err := listArgs.Resolve()
+		if err != nil {
+			return nil, err
 		}
 
-		return function_declerations.ListTodosByDoneAt(ctx, s.repo, listArgs)
+		loc := PromptSettingsFromContext(ctx).Location
+		resolved := map[string]interface{}{"done_from": nil, "done_to": nil}
+		if doneFrom != nil {
+			resolved["done_from"] = doneFrom.In(loc).Format(time.RFC3339)
+		}
+		if doneTo != nil {
+			resolved["done_to"] = doneTo.In(loc).Format(time.RFC3339)
+		}
+		return resolved, nil
 	}
 
 	return nil, fmt.Errorf("unknown function: %s", functionName)
 }
+
+func parseListTodosByDoneAtArgs(args interface{}) (function_declerations.ListTodosByDoneAtArgs, error) {
+	listArgs := function_declerations.ListTodosByDoneAtArgs{}
+	m, ok := args.(map[string]interface{})
+	if !ok {
+		return listArgs, fmt.Errorf("invalid args type")
+	}
+
+	if v, ok := m["done_from"].(string); ok {
+		listArgs.DoneFrom = v
+	}
+	if v, ok := m["done_to"].(string); ok {
+		listArgs.DoneTo = v
+	}
+	return listArgs, nil
+}
+
+func (s *AIService) DraftTodos(ctx context.Context, aiClient utils.IGenAIClient, text string) ([]dto.TodoDraftDto, error) {
+	settings := PromptSettingsFromContext(ctx)
+	prompt, err := prompts.Render(prompts.DraftTodos, settings.Locale, prompts.DraftTodosData{
+		Now:      settings.Now(),
+		TimeZone: settings.Location.String(),
+		Text:     text,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := aiClient.GenerateContent(ctx,
+		geminiModel,
+		prompt.Contents(),
+		&genai.GenerateContentConfig{
+			Tools: []*genai.Tool{
+				{
+					FunctionDeclarations: []*genai.FunctionDeclaration{
+						function_declerations.CreateTodosDeclaration,
+					},
+				},
+			},
+			ToolConfig: &genai.ToolConfig{
+				FunctionCallingConfig: &genai.FunctionCallingConfig{
+					Mode:                 genai.FunctionCallingConfigModeAny,
+					AllowedFunctionNames: []string{function_declerations.CreateTodosDeclaration.Name},
+				},
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	drafts := []dto.TodoDraftDto{}
+	if len(result.Candidates) == 0 || result.Candidates[0].Content == nil {
+		return drafts, nil
+	}
+
+	// 複数回に分けて呼び出される場合もあるため、すべての FunctionCall を集約する
+	for _, part := range result.Candidates[0].Content.Parts {
+		fc := part.FunctionCall
+		if fc == nil || fc.Name != function_declerations.CreateTodosDeclaration.Name {
+			continue
+		}
+		parsed, err := function_declerations.ParseCreateTodosArgs(fc.Args)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range parsed {
+			drafts = append(drafts, dto.TodoDraftDto{
+				Title:       d.Title,
+				Description: d.Description,
+			})
+		}
+	}
+
+	return drafts, nil
+}
+
+func (s *AIService) BreakdownTodo(ctx context.Context, aiClient utils.IGenAIClient, todo *ent.Todo) ([]dto.TodoDraftDto, error) {
+	prompt, err := prompts.Render(prompts.BreakdownTodo, PromptSettingsFromContext(ctx).Locale, prompts.BreakdownTodoData{
+		Title:       todo.Title,
+		Description: todo.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := aiClient.GenerateContent(ctx,
+		geminiModel,
+		prompt.Contents(),
+		&genai.GenerateContentConfig{
+			ResponseMIMEType: "application/json",
+			ResponseSchema:   todoBreakdownSchema,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	text := result.Text()
+	if text == "" {
+		return []dto.TodoDraftDto{}, nil
+	}
+
+	var parsed struct {
+		Steps []dto.TodoDraftDto `json:"steps"`
+	}
+	if err := json.Unmarshal([]byte(text), &parsed); err != nil {
+		return nil, fmt.Errorf("invalid breakdown response: %w", err)
+	}
+	if parsed.Steps == nil {
+		return []dto.TodoDraftDto{}, nil
+	}
+
+	return parsed.Steps, nil
+}
+
+func (s *AIService) SummarizeTodos(ctx context.Context, aiClient utils.IGenAIClient, todos []*ent.Todo, from time.Time, to time.Time) (*dto.TodoSummaryContentDto, error) {
+	settings := PromptSettingsFromContext(ctx)
+	data := prompts.SummarizeTodosData{
+		From:  from.In(settings.Location),
+		To:    to.In(settings.Location),
+		Todos: make([]prompts.SummaryTodo, len(todos)),
+	}
+	for i, t := range todos {
+		data.Todos[i] = prompts.SummaryTodo{
+			ID:          t.ID,
+			Title:       t.Title,
+			Description: t.Description,
+			DoneAt:      t.DoneAt.In(settings.Location),
+		}
+	}
+
+	prompt, err := prompts.Render(prompts.SummarizeTodos, settings.Locale, data)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := aiClient.GenerateContent(ctx,
+		geminiModel,
+		prompt.Contents(),
+		&genai.GenerateContentConfig{
+			ResponseMIMEType: "application/json",
+			ResponseSchema:   todoSummarySchema,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var content dto.TodoSummaryContentDto
+	if err := json.Unmarshal([]byte(result.Text()), &content); err != nil {
+		return nil, fmt.Errorf("invalid summary response: %w", err)
+	}
+
+	// 一覧に存在しない ID が返ってきた場合は取り除く
+	known := make(map[int]bool, len(todos))
+	for _, t := range todos {
+		known[t.ID] = true
+	}
+	for i, section := range content.Sections {
+		ids := make([]int, 0, len(section.TodoIDs))
+		for _, id := range section.TodoIDs {
+			if known[id] {
+				ids = append(ids, id)
+			}
+		}
+		content.Sections[i].TodoIDs = ids
+	}
+	if content.Highlights == nil {
+		content.Highlights = []string{}
+	}
+	if content.Sections == nil {
+		content.Sections = []dto.TodoSummarySectionDto{}
+	}
+
+	return &content, nil
+}
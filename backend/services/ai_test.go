@@ -2,10 +2,12 @@ package services
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 	"todo-app/ent"
 	"todo-app/testutils"
+	"todo-app/utils"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -77,6 +79,78 @@ func TestDecideFilterTodosFunction(t *testing.T) {
 
 		res, err := s.DecideFilterTodosFunction(ctx, mockClient, "hello")
 		assert.NoError(t, err)
+		assert.NotNil(t, res)
+		assert.Equal(t, "", res.FunctionName)
+		assert.Equal(t, "対応できる Tool がありません", res.Text)
+	})
+
+	t.Run("success - empty response", func(t *testing.T) {
+		mockClient.On("GenerateContent", ctx, "gemini-3-flash-preview", mock.Anything, mock.Anything).Return(&genai.GenerateContentResponse{}, nil).Once()
+
+		res, err := s.DecideFilterTodosFunction(ctx, mockClient, "hello")
+		assert.NoError(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success - prompt follows the user's locale and time zone", func(t *testing.T) {
+		userCtx := utils.WithUser(ctx, &ent.User{ID: 1, Locale: "en-US", TimeZone: "America/New_York"})
+		client := new(MockGenAIClient)
+		client.On("GenerateContent", userCtx, "gemini-3-flash-preview", mock.MatchedBy(func(contents []*genai.Content) bool {
+			parts := contents[0].Parts
+			return strings.HasPrefix(parts[0].Text, "The current time is ") &&
+				strings.Contains(parts[0].Text, "America/New_York") &&
+				parts[len(parts)-1].Text == "tasks I finished last week"
+		}), mock.Anything).Return(&genai.GenerateContentResponse{}, nil).Once()
+
+		_, err := s.DecideFilterTodosFunction(userCtx, client, "tasks I finished last week")
+		assert.NoError(t, err)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("success - fixed now is converted to the user's time zone", func(t *testing.T) {
+		userCtx := utils.WithUser(ctx, &ent.User{ID: 1, Locale: "ja", TimeZone: "Asia/Tokyo"})
+		userCtx = WithPromptNow(userCtx, time.Date(2026, 3, 1, 15, 30, 0, 0, time.UTC))
+		client := new(MockGenAIClient)
+		client.On("GenerateContent", userCtx, "gemini-3-flash-preview", mock.MatchedBy(func(contents []*genai.Content) bool {
+			return strings.HasPrefix(contents[0].Parts[0].Text, "現在2026年3月2日00:30:00です。")
+		}), mock.Anything).Return(&genai.GenerateContentResponse{}, nil).Once()
+
+		_, err := s.DecideFilterTodosFunction(userCtx, client, "昨日完了したタスク")
+		assert.NoError(t, err)
+		client.AssertExpectations(t)
+	})
+}
+
+func TestResolveFilterArgs(t *testing.T) {
+	s := NewAIService(new(testutils.MockTodoRepository))
+
+	t.Run("success - bounds are converted to local RFC3339", func(t *testing.T) {
+		res, err := s.ResolveFilterArgs(context.Background(), "ListTodosByDoneAt", map[string]interface{}{
+			"done_from": "2026-10-12T00:00:00Z",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC).Local().Format(time.RFC3339), res["done_from"])
+		assert.Nil(t, res["done_to"])
+	})
+
+	t.Run("success - bounds are converted to the user's time zone", func(t *testing.T) {
+		ctx := utils.WithUser(context.Background(), &ent.User{ID: 1, Locale: "en", TimeZone: "America/New_York"})
+		res, err := s.ResolveFilterArgs(ctx, "ListTodosByDoneAt", map[string]interface{}{
+			"done_from": "2026-10-12T00:00:00Z",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "2026-10-11T20:00:00-04:00", res["done_from"])
+	})
+
+	t.Run("error - invalid date", func(t *testing.T) {
+		res, err := s.ResolveFilterArgs(context.Background(), "ListTodosByDoneAt", map[string]interface{}{"done_to": "yesterday"})
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("error - unknown function", func(t *testing.T) {
+		res, err := s.ResolveFilterArgs(context.Background(), "Unknown", map[string]interface{}{})
+		assert.Error(t, err)
 		assert.Nil(t, res)
 	})
 }
@@ -108,3 +182,164 @@ func TestFilterTodos(t *testing.T) {
 		assert.Nil(t, res)
 	})
 }
+
+func TestDraftTodos(t *testing.T) {
+	mockClient := new(MockGenAIClient)
+	repo := new(testutils.MockTodoRepository)
+	s := NewAIService(repo)
+	ctx := context.Background()
+
+	t.Run("success - function calls", func(t *testing.T) {
+		expectedResponse := &genai.GenerateContentResponse{
+			Candidates: []*genai.Candidate{
+				{
+					Content: &genai.Content{
+						Parts: []*genai.Part{
+							{
+								FunctionCall: &genai.FunctionCall{
+									Name: "CreateTodos",
+									Args: map[string]interface{}{
+										"todos": []interface{}{
+											map[string]interface{}{"title": "call the dentist", "description": "2026-10-27"},
+										},
+									},
+								},
+							},
+							{
+								FunctionCall: &genai.FunctionCall{
+									Name: "CreateTodos",
+									Args: map[string]interface{}{
+										"todos": []interface{}{
+											map[string]interface{}{"title": "renew passport", "description": "by 2026-10-31"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		mockClient.On("GenerateContent", ctx, "gemini-3-flash-preview", mock.Anything, mock.MatchedBy(func(config *genai.GenerateContentConfig) bool {
+			return config.ToolConfig.FunctionCallingConfig.Mode == genai.FunctionCallingConfigModeAny
+		})).Return(expectedResponse, nil).Once()
+
+		res, err := s.DraftTodos(ctx, mockClient, "call the dentist next Tuesday and renew passport by end of month")
+		assert.NoError(t, err)
+		assert.Len(t, res, 2)
+		assert.Equal(t, "call the dentist", res[0].Title)
+		assert.Equal(t, "renew passport", res[1].Title)
+		assert.Equal(t, "by 2026-10-31", res[1].Description)
+	})
+
+	t.Run("success - no candidates", func(t *testing.T) {
+		mockClient.On("GenerateContent", ctx, "gemini-3-flash-preview", mock.Anything, mock.Anything).Return(&genai.GenerateContentResponse{}, nil).Once()
+
+		res, err := s.DraftTodos(ctx, mockClient, "hello")
+		assert.NoError(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("error - invalid args", func(t *testing.T) {
+		expectedResponse := &genai.GenerateContentResponse{
+			Candidates: []*genai.Candidate{
+				{
+					Content: &genai.Content{
+						Parts: []*genai.Part{
+							{FunctionCall: &genai.FunctionCall{Name: "CreateTodos", Args: map[string]interface{}{}}},
+						},
+					},
+				},
+			},
+		}
+		mockClient.On("GenerateContent", ctx, "gemini-3-flash-preview", mock.Anything, mock.Anything).Return(expectedResponse, nil).Once()
+
+		res, err := s.DraftTodos(ctx, mockClient, "hello")
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+}
+
+func TestBreakdownTodo(t *testing.T) {
+	mockClient := new(MockGenAIClient)
+	repo := new(testutils.MockTodoRepository)
+	s := NewAIService(repo)
+	ctx := context.Background()
+	todo := &ent.Todo{ID: 1, Title: "引っ越し", Description: "来月末"}
+
+	newTextResponse := func(text string) *genai.GenerateContentResponse {
+		return &genai.GenerateContentResponse{
+			Candidates: []*genai.Candidate{
+				{Content: &genai.Content{Parts: []*genai.Part{{Text: text}}}},
+			},
+		}
+	}
+
+	t.Run("success - structured steps", func(t *testing.T) {
+		mockClient.On("GenerateContent", ctx, "gemini-3-flash-preview", mock.Anything, mock.MatchedBy(func(config *genai.GenerateContentConfig) bool {
+			return config.ResponseMIMEType == "application/json" && config.ResponseSchema != nil
+		})).Return(newTextResponse(`{"steps":[{"title":"見積もりを取る","description":"3社"},{"title":"荷造りする"}]}`), nil).Once()
+
+		res, err := s.BreakdownTodo(ctx, mockClient, todo)
+		assert.NoError(t, err)
+		assert.Len(t, res, 2)
+		assert.Equal(t, "見積もりを取る", res[0].Title)
+		assert.Equal(t, "3社", res[0].Description)
+		assert.Equal(t, "荷造りする", res[1].Title)
+	})
+
+	t.Run("success - empty response", func(t *testing.T) {
+		mockClient.On("GenerateContent", ctx, "gemini-3-flash-preview", mock.Anything, mock.Anything).Return(&genai.GenerateContentResponse{}, nil).Once()
+
+		res, err := s.BreakdownTodo(ctx, mockClient, todo)
+		assert.NoError(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("error - invalid json", func(t *testing.T) {
+		mockClient.On("GenerateContent", ctx, "gemini-3-flash-preview", mock.Anything, mock.Anything).Return(newTextResponse("not json"), nil).Once()
+
+		res, err := s.BreakdownTodo(ctx, mockClient, todo)
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+}
+
+func TestSummarizeTodos(t *testing.T) {
+	mockClient := new(MockGenAIClient)
+	repo := new(testutils.MockTodoRepository)
+	s := NewAIService(repo)
+	ctx := context.Background()
+	doneAt := time.Date(2026, 10, 14, 10, 0, 0, 0, time.UTC)
+	todos := []*ent.Todo{{ID: 1, Title: "資料を作る", DoneAt: &doneAt}}
+	from := time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 10, 18, 23, 59, 59, 0, time.UTC)
+
+	t.Run("success - unknown todo ids are dropped", func(t *testing.T) {
+		mockClient.On("GenerateContent", ctx, "gemini-3-flash-preview", mock.Anything, mock.MatchedBy(func(config *genai.GenerateContentConfig) bool {
+			return config.ResponseMIMEType == "application/json" && config.ResponseSchema != nil
+		})).Return(&genai.GenerateContentResponse{
+			Candidates: []*genai.Candidate{
+				{Content: &genai.Content{Parts: []*genai.Part{{Text: `{"overview":"概要","sections":[{"heading":"資料","summary":"作成","todo_ids":[1,99]}]}`}}}},
+			},
+		}, nil).Once()
+
+		res, err := s.SummarizeTodos(ctx, mockClient, todos, from, to)
+		assert.NoError(t, err)
+		assert.Equal(t, "概要", res.Overview)
+		assert.Equal(t, []string{}, res.Highlights)
+		assert.Equal(t, []int{1}, res.Sections[0].TodoIDs)
+	})
+
+	t.Run("error - invalid json", func(t *testing.T) {
+		mockClient.On("GenerateContent", ctx, "gemini-3-flash-preview", mock.Anything, mock.Anything).Return(&genai.GenerateContentResponse{
+			Candidates: []*genai.Candidate{
+				{Content: &genai.Content{Parts: []*genai.Part{{Text: "not json"}}}},
+			},
+		}, nil).Once()
+
+		res, err := s.SummarizeTodos(ctx, mockClient, todos, from, to)
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+}
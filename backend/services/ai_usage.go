@@ -0,0 +1,302 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"time"
+	"todo-app/app_errors"
+	"todo-app/dto"
+	"todo-app/ent"
+	"todo-app/repositories"
+	"todo-app/utils"
+
+	"github.com/google/uuid"
+	"google.golang.org/genai"
+)
+
+// AIQuota はユーザーごとの AI 利用上限。0 の場合は無制限
+type AIQuota struct {
+	DailyRequests   int
+	DailyTokens     int
+	MonthlyRequests int
+	MonthlyTokens   int
+}
+
+func LoadAIQuotaFromEnv() AIQuota {
+	return AIQuota{
+		DailyRequests:   quotaFromEnv("AI_DAILY_REQUEST_QUOTA"),
+		DailyTokens:     quotaFromEnv("AI_DAILY_TOKEN_QUOTA"),
+		MonthlyRequests: quotaFromEnv("AI_MONTHLY_REQUEST_QUOTA"),
+		MonthlyTokens:   quotaFromEnv("AI_MONTHLY_TOKEN_QUOTA"),
+	}
+}
+
+func quotaFromEnv(key string) int {
+	v, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || v < 0 {
+		return 0
+	}
+	return v
+}
+
+type AIUsageService struct {
+	logger *slog.Logger
+	repo   repositories.IAIUsageRepository
+	quota  AIQuota
+}
+
+func NewAIUsageService(logger *slog.Logger, repo repositories.IAIUsageRepository) *AIUsageService {
+	return &AIUsageService{
+		logger: logger,
+		repo:   repo,
+		quota:  LoadAIQuotaFromEnv(),
+	}
+}
+
+func (s *AIUsageService) GetUsage(ctx context.Context) (*dto.AIUsageResponseDto, error) {
+	return s.usage(ctx, uuid.Nil)
+}
+
+// Reserve は AI の呼び出しを利用記録として先に記録してから、日次・月次の上限に達していないか確認し、記録の ID を返す。
+// 確認してから記録するのではなく、先に記録してから数えるため、並行した呼び出しでも上限を超えない。
+// 上限に達している場合は記録を削除して ErrAIQuotaExceeded を返す。
+// それ以外の場合は呼び出しの後に Finish でトークン数を記録するか、呼び出しに失敗した場合は Cancel で記録を削除する
+func (s *AIUsageService) Reserve(ctx context.Context, model string) (uuid.UUID, error) {
+	id, err := s.repo.Create(ctx, model)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if s.quota == (AIQuota{}) {
+		return id, nil
+	}
+
+	if err := s.check(ctx, id); err != nil {
+		s.Cancel(ctx, id)
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+
+// check は ID が usageID の記録自身を除いた利用量から、上限に達していないか確認する
+func (s *AIUsageService) check(ctx context.Context, usageID uuid.UUID) error {
+	usage, err := s.usage(ctx, usageID)
+	if err != nil {
+		return err
+	}
+	if err := quotaError("daily", &usage.Daily); err != nil {
+		return err
+	}
+	return quotaError("monthly", &usage.Monthly)
+}
+
+// Finish は Reserve で記録した利用記録に、応答の UsageMetadata からトークン使用量を記録する。
+// 記録に失敗しても AI の応答は利用できるため、エラーはログに留める。
+func (s *AIUsageService) Finish(ctx context.Context, usageID uuid.UUID, res *genai.GenerateContentResponse) {
+	var promptTokens, candidatesTokens, totalTokens int
+	if res != nil && res.UsageMetadata != nil {
+		promptTokens = int(res.UsageMetadata.PromptTokenCount)
+		candidatesTokens = int(res.UsageMetadata.CandidatesTokenCount)
+		totalTokens = int(res.UsageMetadata.TotalTokenCount)
+	}
+
+	if err := s.repo.UpdateTokens(ctx, usageID, promptTokens, candidatesTokens, totalTokens); err != nil {
+		s.logger.Error("failed to record ai usage", slog.String("error", err.Error()))
+	}
+}
+
+// Cancel は呼び出さなかった、または呼び出しに失敗した AI の利用記録を削除する
+func (s *AIUsageService) Cancel(ctx context.Context, usageID uuid.UUID) {
+	if err := s.repo.Delete(ctx, usageID); err != nil {
+		s.logger.Error("failed to cancel ai usage", slog.String("error", err.Error()))
+	}
+}
+
+// usage は ID が excludeID の記録を除いた日次・月次の利用状況を返す。期間はユーザーのタイムゾーンで区切る
+func (s *AIUsageService) usage(ctx context.Context, excludeID uuid.UUID) (*dto.AIUsageResponseDto, error) {
+	u, _ := utils.UserFromContext(ctx)
+	now := time.Now().In(usageLocation(u))
+	dayStart, dayEnd := dailyPeriod(now)
+	monthStart, monthEnd := monthlyPeriod(now)
+
+	daily, err := s.usageSince(ctx, dayStart, dayEnd, excludeID, s.quota.DailyRequests, s.quota.DailyTokens)
+	if err != nil {
+		return nil, err
+	}
+	monthly, err := s.usageSince(ctx, monthStart, monthEnd, excludeID, s.quota.MonthlyRequests, s.quota.MonthlyTokens)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.AIUsageResponseDto{Daily: *daily, Monthly: *monthly}, nil
+}
+
+func (s *AIUsageService) usageSince(ctx context.Context, since time.Time, resetsAt time.Time, excludeID uuid.UUID, requestLimit int, tokenLimit int) (*dto.AIUsagePeriodDto, error) {
+	requests, tokens, err := s.repo.SumUsage(ctx, since, excludeID)
+	if err != nil {
+		return nil, err
+	}
+	return newAIUsagePeriodDto(requests, tokens, resetsAt, requestLimit, tokenLimit), nil
+}
+
+// newAIUsagePeriodDto は期間内の利用状況を返す。上限が 0 の場合は null にする
+func newAIUsagePeriodDto(requests int, tokens int, resetsAt time.Time, requestLimit int, tokenLimit int) *dto.AIUsagePeriodDto {
+	usage := &dto.AIUsagePeriodDto{
+		Requests: requests,
+		Tokens:   tokens,
+		ResetsAt: resetsAt,
+	}
+	if requestLimit > 0 {
+		usage.RequestLimit = &requestLimit
+	}
+	if tokenLimit > 0 {
+		usage.TokenLimit = &tokenLimit
+	}
+	return usage
+}
+
+func quotaError(period string, usage *dto.AIUsagePeriodDto) error {
+	if usage.RequestLimit != nil && usage.Requests >= *usage.RequestLimit {
+		return fmt.Errorf("%w: %s request limit of %d reached, resets at %s", app_errors.ErrAIQuotaExceeded, period, *usage.RequestLimit, usage.ResetsAt.Format(time.RFC3339))
+	}
+	if usage.TokenLimit != nil && usage.Tokens >= *usage.TokenLimit {
+		return fmt.Errorf("%w: %s token limit of %d reached, resets at %s", app_errors.ErrAIQuotaExceeded, period, *usage.TokenLimit, usage.ResetsAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// usageLocation は利用量の期間を区切るタイムゾーンを返す。ユーザーが無い、または設定が不正な場合は UTC を使う
+func usageLocation(u *ent.User) *time.Location {
+	if u != nil && u.TimeZone != "" {
+		if loc, err := time.LoadLocation(u.TimeZone); err == nil {
+			return loc
+		}
+	}
+	return time.UTC
+}
+
+// dailyPeriod は now のタイムゾーンでの、now を含む日の始まりと終わりを返す
+func dailyPeriod(now time.Time) (time.Time, time.Time) {
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	return start, start.AddDate(0, 0, 1)
+}
+
+// monthlyPeriod は now のタイムゾーンでの、now を含む月の始まりと終わりを返す
+func monthlyPeriod(now time.Time) (time.Time, time.Time) {
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	return start, start.AddDate(0, 1, 0)
+}
+
+// MeteredAIFactory は利用量の記録と上限の確認を行うクライアントを返す IAIFactory
+type MeteredAIFactory struct {
+	factory      utils.IGenAIClientFactory
+	usageService *AIUsageService
+}
+
+func NewMeteredAIFactory(factory utils.IGenAIClientFactory, usageService *AIUsageService) utils.IAIFactory {
+	return &MeteredAIFactory{
+		factory:      factory,
+		usageService: usageService,
+	}
+}
+
+func (f *MeteredAIFactory) GetGeminiClient(ctx context.Context) (utils.IGenAIClient, error) {
+	client, err := f.factory.GetGeminiClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &meteredGenAIClient{client: client, usageService: f.usageService}, nil
+}
+
+type meteredGenAIClient struct {
+	client       utils.IGenAIClient
+	usageService *AIUsageService
+}
+
+// GenerateContent implements IGenAIClient
+func (c *meteredGenAIClient) GenerateContent(ctx context.Context, model string, contents []*genai.Content, config *genai.GenerateContentConfig) (*genai.GenerateContentResponse, error) {
+	usageID, err := c.usageService.Reserve(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.client.GenerateContent(ctx, model, contents, config)
+	if err != nil {
+		c.usageService.Cancel(ctx, usageID)
+		return nil, err
+	}
+	c.usageService.Finish(ctx, usageID, res)
+	return res, nil
+}
+
+// MeteredEmbedderFactory は利用量の記録と上限の確認を行う Embedder を返す IEmbedderFactory
+type MeteredEmbedderFactory struct {
+	factory      utils.IEmbeddingClientFactory
+	usageService *AIUsageService
+}
+
+func NewMeteredEmbedderFactory(factory utils.IEmbeddingClientFactory, usageService *AIUsageService) utils.IEmbedderFactory {
+	return &MeteredEmbedderFactory{
+		factory:      factory,
+		usageService: usageService,
+	}
+}
+
+func (f *MeteredEmbedderFactory) GetEmbedder(ctx context.Context) (utils.IEmbedder, error) {
+	embedder, err := f.factory.GetEmbedder(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// LocalEmbedder は外部 API を使わないため、利用量として数えない
+	if _, ok := embedder.(*utils.LocalEmbedder); ok {
+		return embedder, nil
+	}
+	return &meteredEmbedder{embedder: embedder, usageService: f.usageService}, nil
+}
+
+// meteredEmbedder は Embedding API の呼び出しを1回のリクエストとして記録する。
+// 応答にトークン数が含まれないため、トークン数は 0 のままにする
+type meteredEmbedder struct {
+	embedder     utils.IEmbedder
+	usageService *AIUsageService
+}
+
+// Model implements IEmbedder
+func (e *meteredEmbedder) Model() string {
+	return e.embedder.Model()
+}
+
+// EmbedDocuments implements IEmbedder
+func (e *meteredEmbedder) EmbedDocuments(ctx context.Context, texts []string) ([][]float32, error) {
+	var vectors [][]float32
+	err := e.meter(ctx, func() error {
+		var err error
+		vectors, err = e.embedder.EmbedDocuments(ctx, texts)
+		return err
+	})
+	return vectors, err
+}
+
+// EmbedQuery implements IEmbedder
+func (e *meteredEmbedder) EmbedQuery(ctx context.Context, text string) ([]float32, error) {
+	var vector []float32
+	err := e.meter(ctx, func() error {
+		var err error
+		vector, err = e.embedder.EmbedQuery(ctx, text)
+		return err
+	})
+	return vector, err
+}
+
+func (e *meteredEmbedder) meter(ctx context.Context, embed func() error) error {
+	usageID, err := e.usageService.Reserve(ctx, e.embedder.Model())
+	if err != nil {
+		return err
+	}
+	if err := embed(); err != nil {
+		e.usageService.Cancel(ctx, usageID)
+		return err
+	}
+	return nil
+}
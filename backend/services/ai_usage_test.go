@@ -0,0 +1,264 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+	"todo-app/app_errors"
+	"todo-app/ent"
+	"todo-app/testutils"
+	"todo-app/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/genai"
+)
+
+func TestAIUsageService_Reserve(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	usageID := uuid.Must(uuid.NewV7())
+
+	t.Run("上限が設定されていない場合は記録だけして利用量を参照しないこと", func(t *testing.T) {
+		repo := new(testutils.MockAIUsageRepository)
+		s := &AIUsageService{logger: logger, repo: repo}
+		repo.On("Create", mock.Anything, "gemini-3-flash-preview").Return(usageID, nil)
+
+		id, err := s.Reserve(ctx, "gemini-3-flash-preview")
+
+		assert.NoError(t, err)
+		assert.Equal(t, usageID, id)
+		repo.AssertNotCalled(t, "SumUsage", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("先に記録してから自身の記録を除いて利用量を数えること", func(t *testing.T) {
+		repo := new(testutils.MockAIUsageRepository)
+		s := &AIUsageService{logger: logger, repo: repo, quota: AIQuota{DailyRequests: 10, MonthlyTokens: 1000}}
+		create := repo.On("Create", mock.Anything, "gemini-3-flash-preview").Return(usageID, nil)
+		repo.On("SumUsage", mock.Anything, mock.Anything, usageID).Return(9, 999, nil).NotBefore(create)
+
+		id, err := s.Reserve(ctx, "gemini-3-flash-preview")
+
+		assert.NoError(t, err)
+		assert.Equal(t, usageID, id)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("日次のリクエスト数が上限に達した場合は記録を削除してエラーを返すこと", func(t *testing.T) {
+		repo := new(testutils.MockAIUsageRepository)
+		s := &AIUsageService{logger: logger, repo: repo, quota: AIQuota{DailyRequests: 10}}
+		repo.On("Create", mock.Anything, "gemini-3-flash-preview").Return(usageID, nil)
+		repo.On("SumUsage", mock.Anything, mock.Anything, usageID).Return(10, 500, nil)
+		repo.On("Delete", mock.Anything, usageID).Return(nil)
+
+		_, err := s.Reserve(ctx, "gemini-3-flash-preview")
+
+		assert.ErrorIs(t, err, app_errors.ErrAIQuotaExceeded)
+		assert.Contains(t, err.Error(), "daily request limit of 10 reached")
+		repo.AssertCalled(t, "Delete", mock.Anything, usageID)
+	})
+
+	t.Run("月次のトークン数が上限に達した場合はエラーを返すこと", func(t *testing.T) {
+		repo := new(testutils.MockAIUsageRepository)
+		s := &AIUsageService{logger: logger, repo: repo, quota: AIQuota{DailyRequests: 10, MonthlyTokens: 1000}}
+		repo.On("Create", mock.Anything, "gemini-3-flash-preview").Return(usageID, nil)
+		repo.On("SumUsage", mock.Anything, mock.Anything, usageID).Return(1, 1000, nil)
+		repo.On("Delete", mock.Anything, usageID).Return(nil)
+
+		_, err := s.Reserve(ctx, "gemini-3-flash-preview")
+
+		assert.ErrorIs(t, err, app_errors.ErrAIQuotaExceeded)
+		assert.Contains(t, err.Error(), "monthly token limit of 1000 reached")
+	})
+}
+
+func TestAIUsageService_GetUsage(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("ユーザーのタイムゾーンの日付の始まりから数えること", func(t *testing.T) {
+		repo := new(testutils.MockAIUsageRepository)
+		s := &AIUsageService{logger: logger, repo: repo}
+		ctx := utils.WithUser(context.Background(), &ent.User{ID: 1, TimeZone: "Asia/Tokyo"})
+		repo.On("SumUsage", mock.Anything, mock.Anything, uuid.Nil).Return(1, 100, nil)
+
+		res, err := s.GetUsage(ctx)
+
+		assert.NoError(t, err)
+		dayStart := repo.Calls[0].Arguments.Get(1).(time.Time)
+		assert.Equal(t, "Asia/Tokyo", dayStart.Location().String())
+		assert.Equal(t, 0, dayStart.Hour())
+		assert.Equal(t, dayStart.AddDate(0, 0, 1), res.Daily.ResetsAt)
+		assert.Equal(t, 1, res.Monthly.ResetsAt.In(dayStart.Location()).Day())
+	})
+
+	t.Run("タイムゾーンが設定されていない場合は UTC で区切ること", func(t *testing.T) {
+		repo := new(testutils.MockAIUsageRepository)
+		s := &AIUsageService{logger: logger, repo: repo}
+		ctx := utils.WithUser(context.Background(), &ent.User{ID: 1})
+		repo.On("SumUsage", mock.Anything, mock.Anything, uuid.Nil).Return(0, 0, nil)
+
+		res, err := s.GetUsage(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, time.UTC, res.Daily.ResetsAt.Location())
+		assert.Equal(t, 0, res.Daily.ResetsAt.Hour())
+	})
+}
+
+func TestMeteredAIFactory(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	usageID := uuid.Must(uuid.NewV7())
+
+	t.Run("応答の UsageMetadata からトークン使用量を記録すること", func(t *testing.T) {
+		repo := new(testutils.MockAIUsageRepository)
+		mockClient := new(MockGenAIClient)
+		factory := NewMeteredAIFactory(&fakeAIFactory{client: mockClient}, &AIUsageService{logger: logger, repo: repo})
+		mockClient.On("GenerateContent", ctx, "gemini-3-flash-preview", mock.Anything, mock.Anything).Return(&genai.GenerateContentResponse{
+			UsageMetadata: &genai.GenerateContentResponseUsageMetadata{PromptTokenCount: 120, CandidatesTokenCount: 30, TotalTokenCount: 150},
+		}, nil)
+		repo.On("Create", mock.Anything, "gemini-3-flash-preview").Return(usageID, nil)
+		repo.On("UpdateTokens", mock.Anything, usageID, 120, 30, 150).Return(nil)
+
+		client, err := factory.GetGeminiClient(ctx)
+		assert.NoError(t, err)
+		_, err = client.GenerateContent(ctx, "gemini-3-flash-preview", nil, nil)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("上限に達している場合は API を呼び出さないこと", func(t *testing.T) {
+		repo := new(testutils.MockAIUsageRepository)
+		mockClient := new(MockGenAIClient)
+		factory := NewMeteredAIFactory(&fakeAIFactory{client: mockClient}, &AIUsageService{logger: logger, repo: repo, quota: AIQuota{DailyRequests: 1}})
+		repo.On("Create", mock.Anything, "gemini-3-flash-preview").Return(usageID, nil)
+		repo.On("SumUsage", mock.Anything, mock.Anything, usageID).Return(1, 0, nil)
+		repo.On("Delete", mock.Anything, usageID).Return(nil)
+
+		client, err := factory.GetGeminiClient(ctx)
+		assert.NoError(t, err)
+		res, err := client.GenerateContent(ctx, "gemini-3-flash-preview", nil, nil)
+
+		assert.ErrorIs(t, err, app_errors.ErrAIQuotaExceeded)
+		assert.Nil(t, res)
+		mockClient.AssertNotCalled(t, "GenerateContent", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		repo.AssertNotCalled(t, "UpdateTokens", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("API の呼び出しに失敗した場合は記録を削除すること", func(t *testing.T) {
+		repo := new(testutils.MockAIUsageRepository)
+		mockClient := new(MockGenAIClient)
+		factory := NewMeteredAIFactory(&fakeAIFactory{client: mockClient}, &AIUsageService{logger: logger, repo: repo})
+		mockClient.On("GenerateContent", ctx, "gemini-3-flash-preview", mock.Anything, mock.Anything).Return(nil, errors.New("unavailable"))
+		repo.On("Create", mock.Anything, "gemini-3-flash-preview").Return(usageID, nil)
+		repo.On("Delete", mock.Anything, usageID).Return(nil)
+
+		client, err := factory.GetGeminiClient(ctx)
+		assert.NoError(t, err)
+		_, err = client.GenerateContent(ctx, "gemini-3-flash-preview", nil, nil)
+
+		assert.Error(t, err)
+		repo.AssertCalled(t, "Delete", mock.Anything, usageID)
+	})
+}
+
+type fakeEmbedder struct {
+	err error
+}
+
+func (e *fakeEmbedder) Model() string {
+	return "fake-embedding/3"
+}
+
+func (e *fakeEmbedder) EmbedDocuments(ctx context.Context, texts []string) ([][]float32, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	vectors := make([][]float32, len(texts))
+	for i := range texts {
+		vectors[i] = []float32{1, 0, 0}
+	}
+	return vectors, nil
+}
+
+func (e *fakeEmbedder) EmbedQuery(ctx context.Context, text string) ([]float32, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	return []float32{1, 0, 0}, nil
+}
+
+type fakeEmbedderFactory struct {
+	embedder utils.IEmbedder
+}
+
+func (f *fakeEmbedderFactory) GetEmbedder(ctx context.Context) (utils.IEmbedder, error) {
+	return f.embedder, nil
+}
+
+func TestMeteredEmbedderFactory(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	usageID := uuid.Must(uuid.NewV7())
+
+	t.Run("ベクトル化の呼び出しを利用量として記録すること", func(t *testing.T) {
+		repo := new(testutils.MockAIUsageRepository)
+		factory := NewMeteredEmbedderFactory(&fakeEmbedderFactory{embedder: &fakeEmbedder{}}, &AIUsageService{logger: logger, repo: repo})
+		repo.On("Create", mock.Anything, "fake-embedding/3").Return(usageID, nil).Twice()
+
+		embedder, err := factory.GetEmbedder(ctx)
+		assert.NoError(t, err)
+		_, err = embedder.EmbedDocuments(ctx, []string{"牛乳を買う"})
+		assert.NoError(t, err)
+		_, err = embedder.EmbedQuery(ctx, "牛乳")
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+		repo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("上限に達している場合はベクトル化しないこと", func(t *testing.T) {
+		repo := new(testutils.MockAIUsageRepository)
+		factory := NewMeteredEmbedderFactory(&fakeEmbedderFactory{embedder: &fakeEmbedder{err: errors.New("must not be called")}}, &AIUsageService{logger: logger, repo: repo, quota: AIQuota{DailyRequests: 1}})
+		repo.On("Create", mock.Anything, "fake-embedding/3").Return(usageID, nil)
+		repo.On("SumUsage", mock.Anything, mock.Anything, usageID).Return(1, 0, nil)
+		repo.On("Delete", mock.Anything, usageID).Return(nil)
+
+		embedder, err := factory.GetEmbedder(ctx)
+		assert.NoError(t, err)
+		_, err = embedder.EmbedQuery(ctx, "牛乳")
+
+		assert.ErrorIs(t, err, app_errors.ErrAIQuotaExceeded)
+	})
+
+	t.Run("ベクトル化に失敗した場合は記録を削除すること", func(t *testing.T) {
+		repo := new(testutils.MockAIUsageRepository)
+		factory := NewMeteredEmbedderFactory(&fakeEmbedderFactory{embedder: &fakeEmbedder{err: errors.New("unavailable")}}, &AIUsageService{logger: logger, repo: repo})
+		repo.On("Create", mock.Anything, "fake-embedding/3").Return(usageID, nil)
+		repo.On("Delete", mock.Anything, usageID).Return(nil)
+
+		embedder, err := factory.GetEmbedder(ctx)
+		assert.NoError(t, err)
+		_, err = embedder.EmbedDocuments(ctx, []string{"牛乳を買う"})
+
+		assert.Error(t, err)
+		repo.AssertCalled(t, "Delete", mock.Anything, usageID)
+	})
+
+	t.Run("外部 API を使わない Embedder は記録しないこと", func(t *testing.T) {
+		repo := new(testutils.MockAIUsageRepository)
+		factory := NewMeteredEmbedderFactory(utils.NewLocalEmbedderFactory(), &AIUsageService{logger: logger, repo: repo, quota: AIQuota{DailyRequests: 1}})
+
+		embedder, err := factory.GetEmbedder(ctx)
+		assert.NoError(t, err)
+		_, err = embedder.EmbedQuery(ctx, "牛乳")
+
+		assert.NoError(t, err)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
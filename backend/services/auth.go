@@ -2,57 +2,350 @@ package services
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"os"
+	"strings"
+	"sync"
 	"time"
 
+	"todo-app/app_errors"
 	"todo-app/dto"
 	"todo-app/ent"
 	"todo-app/repositories"
+	"todo-app/utils"
 
 	"github.com/golang-jwt/jwt/v5"
 	"golang.org/x/crypto/bcrypt"
 )
 
 type IAuthService interface {
-	Login(ctx context.Context, req *dto.LoginInput) (string, error)
+	Login(ctx context.Context, req *dto.LoginInput) (*dto.LoginResult, error)
+	CompleteMFALogin(ctx context.Context, req *dto.MFALoginInput) (*dto.AuthTokens, error)
+	Refresh(ctx context.Context, req *dto.RefreshInput) (*dto.AuthTokens, error)
+	Register(ctx context.Context, req *dto.RegisterInput) (*ent.User, error)
 }
 
+// ユーザー登録の受付方法 (REGISTRATION_MODE)
+const (
+	// 誰でも登録できる (デフォルト)
+	RegistrationModeOpen = "open"
+	// REGISTRATION_INVITE_CODES のいずれかの招待コードが必要
+	RegistrationModeInvite = "invite"
+	// 登録を受け付けない
+	RegistrationModeClosed = "closed"
+)
+
+const (
+	// アクセストークンの有効期限。期限が切れたらリフレッシュトークンで再発行する
+	AccessTokenLifetime = 15 * time.Minute
+	// リフレッシュトークンの有効期限。この期間使われなかったセッションは再ログインが必要
+	RefreshTokenLifetime = 14 * 24 * time.Hour
+	// ログインしてからの最大の期間。リフレッシュトークンを更新し続けてもこれを超えることはできない
+	SessionMaxLifetime = 30 * 24 * time.Hour
+	// 管理者がなりすましのために作成するセッションの有効期限
+	ImpersonationSessionLifetime = time.Hour
+)
+
 type AuthService struct {
-	repo repositories.IUserRepository
+	repo             repositories.IUserRepository
+	sessionRepo      repositories.ISessionRepository
+	refreshTokenRepo repositories.IRefreshTokenRepository
+	mfaRepo          repositories.IMFARepository
+	throttle         *LoginThrottle
+	keys             *utils.JWTKeyManager
 }
 
-func NewAuthService(repo repositories.IUserRepository) *AuthService {
-	return &AuthService{repo: repo}
+func NewAuthService(repo repositories.IUserRepository, sessionRepo repositories.ISessionRepository, refreshTokenRepo repositories.IRefreshTokenRepository, mfaRepo repositories.IMFARepository, throttle *LoginThrottle, keys *utils.JWTKeyManager) *AuthService {
+	return &AuthService{repo: repo, sessionRepo: sessionRepo, refreshTokenRepo: refreshTokenRepo, mfaRepo: mfaRepo, throttle: throttle, keys: keys}
 }
 
-func (s *AuthService) Login(ctx context.Context, req *dto.LoginInput) (string, error) {
+// メールアドレスとパスワードのどちらが誤っているかは区別しない
+var errInvalidCredentials = errors.New("invalid email or password")
+
+// dummyPasswordHash は未登録のメールアドレスでログインを試行された場合に照合するハッシュ。
+// 登録済みのユーザーと同じコストで bcrypt を実行し、応答時間から登録の有無を推測されないようにする
+var dummyPasswordHash = sync.OnceValue(func() []byte {
+	hash, err := bcrypt.GenerateFromPassword([]byte("dummy password for timing"), bcrypt.DefaultCost)
+	if err != nil {
+		panic(err)
+	}
+	return hash
+})
+
+// Login はパスワードを確認してログインする。
+// 2 段階認証を有効にしている場合はセッションを作成せず、CompleteMFALogin に渡すトークンを返す
+// 失敗が続くメールアドレスや IP アドレスからの試行は、パスワードを確認せずに *app_errors.LoginThrottledError を返す
+func (s *AuthService) Login(ctx context.Context, req *dto.LoginInput) (*dto.LoginResult, error) {
+	attemptID, err := s.throttle.Reserve(ctx, req.Email, req.IPAddress, req.UserAgent, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	u, err := s.repo.FindByEmail(ctx, req.Email)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return "", errors.New("invalid email or password")
+			_ = bcrypt.CompareHashAndPassword(dummyPasswordHash(), []byte(req.Password))
+			if err := s.throttle.Finish(ctx, attemptID, nil, repositories.LoginResultInvalidCredentials); err != nil {
+				return nil, err
+			}
+			return nil, errInvalidCredentials
 		}
-		return "", err
+		return nil, err
 	}
 
 	if err := bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(req.Password)); err != nil {
-		return "", errors.New("invalid email or password")
+		if err := s.throttle.Finish(ctx, attemptID, &u.ID, repositories.LoginResultInvalidCredentials); err != nil {
+			return nil, err
+		}
+		return nil, errInvalidCredentials
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"user_id": u.ID,
-		"exp":     time.Now().Add(time.Hour).Unix(),
-	})
+	res, err := s.completeFirstFactor(ctx, u, req.IPAddress, req.UserAgent)
+	if err != nil {
+		if errors.Is(err, app_errors.ErrAccountDisabled) {
+			if err := s.throttle.Finish(ctx, attemptID, &u.ID, repositories.LoginResultAccountDisabled); err != nil {
+				return nil, err
+			}
+		}
+		return nil, err
+	}
+	result := repositories.LoginResultSucceeded
+	if res.MFARequired {
+		// 2 段階認証が完了するまでは成功として数えず、失敗の回数もリセットしない
+		result = repositories.LoginResultMFARequired
+	}
+	if err := s.throttle.Finish(ctx, attemptID, &u.ID, result); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// completeFirstFactor はパスワードなど 1 段階目の認証を終えたユーザーのセッションを作成する。
+// 2 段階認証を有効にしている場合はセッションを作成せず、CompleteMFALogin に渡すトークンを返す。
+// 管理者が無効にしたユーザーの場合は ErrAccountDisabled を返す
+func (s *AuthService) completeFirstFactor(ctx context.Context, u *ent.User, ipAddress string, userAgent string) (*dto.LoginResult, error) {
+	if u.DisabledAt != nil {
+		return nil, app_errors.ErrAccountDisabled
+	}
+
+	cred, err := s.mfaRepo.FindTOTP(ctx, u.ID)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if cred != nil && cred.ConfirmedAt != nil {
+		mfaToken, err := signMFAToken(s.keys, u.ID)
+		if err != nil {
+			return nil, err
+		}
+		return &dto.LoginResult{MFARequired: true, MFAToken: mfaToken}, nil
+	}
+
+	tokens, err := s.startSession(ctx, u.ID, ipAddress, userAgent)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.LoginResult{Tokens: tokens}, nil
+}
+
+// CompleteMFALogin は Login で発行したトークンと 2 段階認証のコードを確認してログインする
+func (s *AuthService) CompleteMFALogin(ctx context.Context, req *dto.MFALoginInput) (*dto.AuthTokens, error) {
+	claims, err := parseMFAToken(s.keys, req.MFAToken)
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.repo.FindById(ctx, claims.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, app_errors.ErrInvalidMFAToken
+		}
+		return nil, err
+	}
+	if u.DisabledAt != nil {
+		return nil, app_errors.ErrAccountDisabled
+	}
+
+	// パスワードでのログインと同じメールアドレスの失敗として数え、コードの総当たりを防ぐ
+	attemptID, err := s.throttle.Reserve(ctx, u.Email, req.IPAddress, req.UserAgent, &u.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyMFACode(ctx, s.mfaRepo, u.ID, req.Code); err != nil {
+		if errors.Is(err, app_errors.ErrInvalidMFACode) {
+			if err := s.throttle.Finish(ctx, attemptID, nil, repositories.LoginResultInvalidMFACode); err != nil {
+				return nil, err
+			}
+		}
+		return nil, err
+	}
+	// 漏洩したトークンを有効期限まで使い回せないよう、ログインに使ったトークンは使用済みにする
+	used, err := s.mfaRepo.UseMFAToken(ctx, u.ID, claims.JTI, claims.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	if !used {
+		return nil, app_errors.ErrInvalidMFAToken
+	}
+
+	tokens, err := s.startSession(ctx, u.ID, req.IPAddress, req.UserAgent)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.throttle.Finish(ctx, attemptID, nil, repositories.LoginResultSucceeded); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// startSession はログインしたユーザーのセッションを作成し、トークンを発行する
+func (s *AuthService) startSession(ctx context.Context, userID int, ipAddress string, userAgent string) (*dto.AuthTokens, error) {
+	// トークンの jti をセッションの ID とし、ログアウトなどで失効させられるようにする
+	se, err := s.sessionRepo.Create(ctx, userID, ipAddress, userAgent, time.Now().Add(SessionMaxLifetime))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issueTokens(ctx, se)
+}
+
+// StartImpersonation は管理者 impersonatorID が userID としてログインするためのセッションを作成し、トークンを発行する。
+// 通常のログインより短い ImpersonationSessionLifetime で期限が切れる
+func (s *AuthService) StartImpersonation(ctx context.Context, userID int, impersonatorID int, ipAddress string, userAgent string) (*dto.AuthTokens, error) {
+	se, err := s.sessionRepo.CreateImpersonation(ctx, userID, impersonatorID, ipAddress, userAgent, time.Now().Add(ImpersonationSessionLifetime))
+	if err != nil {
+		return nil, err
+	}
+	return s.issueTokens(ctx, se)
+}
+
+// Refresh はリフレッシュトークンを新しいものに置き換え、アクセストークンを再発行する。
+// 使用済みのリフレッシュトークンが再び使われた場合は、漏洩したとみなしてセッションごと失効させる
+func (s *AuthService) Refresh(ctx context.Context, req *dto.RefreshInput) (*dto.AuthTokens, error) {
+	if req.RefreshToken == "" {
+		return nil, app_errors.ErrInvalidRefreshToken
+	}
+
+	rt, consumed, err := s.refreshTokenRepo.Consume(ctx, hashToken(req.RefreshToken))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, app_errors.ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+
+	se := rt.Edges.Session
+	now := time.Now()
+	if se == nil || se.RevokedAt != nil || !now.Before(se.ExpiresAt) {
+		return nil, app_errors.ErrInvalidRefreshToken
+	}
+	if !consumed {
+		if err := s.sessionRepo.RevokeByID(ctx, se.ID); err != nil {
+			return nil, err
+		}
+		return nil, app_errors.ErrRefreshTokenReused
+	}
+	if !now.Before(rt.ExpiresAt) {
+		return nil, app_errors.ErrInvalidRefreshToken
+	}
+
+	if err := s.sessionRepo.Touch(ctx, se.ID, req.IPAddress, req.UserAgent); err != nil {
+		return nil, err
+	}
+	return s.issueTokens(ctx, se)
+}
 
-	secret := os.Getenv("JWT_SECRET")
-	if secret == "" {
-		secret = "secret" // default for development/test if env not set
+// issueTokens はセッションのアクセストークンと、新しいリフレッシュトークンを発行する
+func (s *AuthService) issueTokens(ctx context.Context, se *ent.Session) (*dto.AuthTokens, error) {
+	now := time.Now()
+	accessExpiresAt := now.Add(AccessTokenLifetime)
+	accessToken, err := signAccessToken(s.keys, se.UserID, se.ID.String(), accessExpiresAt)
+	if err != nil {
+		return nil, err
 	}
 
-	tokenString, err := token.SignedString([]byte(secret))
+	refreshToken, err := newRandomToken()
 	if err != nil {
+		return nil, err
+	}
+	refreshExpiresAt := now.Add(RefreshTokenLifetime)
+	if se.ExpiresAt.Before(refreshExpiresAt) {
+		refreshExpiresAt = se.ExpiresAt
+	}
+	if _, err := s.refreshTokenRepo.Create(ctx, se.ID, hashToken(refreshToken), refreshExpiresAt); err != nil {
+		return nil, err
+	}
+
+	return &dto.AuthTokens{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessExpiresAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: refreshExpiresAt,
+	}, nil
+}
+
+func signAccessToken(keys *utils.JWTKeyManager, userID int, sessionID string, expiresAt time.Time) (string, error) {
+	return keys.Sign(jwt.MapClaims{
+		"user_id": userID,
+		"jti":     sessionID,
+		"exp":     expiresAt.Unix(),
+	})
+}
+
+// newRandomToken は推測できないランダムなトークンを生成する
+func newRandomToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
 		return "", err
 	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashToken はトークンを保存・検索するための SHA-256 ハッシュを返す
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
 
-	return tokenString, nil
+// Register はユーザーを登録する。パスワードは cmds/create_user と同様に bcrypt でハッシュ化して保存する
+func (s *AuthService) Register(ctx context.Context, req *dto.RegisterInput) (*ent.User, error) {
+	if err := checkRegistrationAllowed(req.InviteCode); err != nil {
+		return nil, err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.repo.Create(ctx, req.Name, req.Email, string(hash))
+	if err != nil {
+		// email は一意制約があるため、同時に登録された場合もここで検知できる
+		if ent.IsConstraintError(err) {
+			return nil, app_errors.ErrEmailAlreadyRegistered
+		}
+		return nil, err
+	}
+	return u, nil
+}
+
+func checkRegistrationAllowed(inviteCode string) error {
+	switch mode := os.Getenv("REGISTRATION_MODE"); mode {
+	case "", RegistrationModeOpen:
+		return nil
+	case RegistrationModeInvite:
+		for _, code := range strings.Split(os.Getenv("REGISTRATION_INVITE_CODES"), ",") {
+			code = strings.TrimSpace(code)
+			if code != "" && subtle.ConstantTimeCompare([]byte(code), []byte(inviteCode)) == 1 {
+				return nil
+			}
+		}
+		return app_errors.ErrInvalidInviteCode
+	default:
+		// 不明な値の場合も、意図せず登録を受け付けないよう closed として扱う
+		return app_errors.ErrRegistrationClosed
+	}
 }
@@ -3,10 +3,17 @@ package services
 import (
 	"context"
 	"testing"
+	"time"
 
+	"todo-app/app_errors"
 	"todo-app/dto"
 	"todo-app/ent"
+	"todo-app/repositories"
+	"todo-app/testutils"
+	"todo-app/utils"
 
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"golang.org/x/crypto/bcrypt"
@@ -32,6 +39,91 @@ func (m *MockUserRepository) FindById(ctx context.Context, id int) (*ent.User, e
 	return args.Get(0).(*ent.User), args.Error(1)
 }
 
+func (m *MockUserRepository) Create(ctx context.Context, name string, email string, passwordHash string) (*ent.User, error) {
+	args := m.Called(ctx, name, email, passwordHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.User), args.Error(1)
+}
+
+func (m *MockUserRepository) UpdatePassword(ctx context.Context, id int, passwordHash string) error {
+	args := m.Called(ctx, id, passwordHash)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) UpdateProfile(ctx context.Context, id int, update repositories.UserProfileUpdate) (*ent.User, error) {
+	args := m.Called(ctx, id, update)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.User), args.Error(1)
+}
+
+func (m *MockUserRepository) UpdateEmail(ctx context.Context, id int, email string) error {
+	args := m.Called(ctx, id, email)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) MarkEmailVerified(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) ScheduleDeletion(ctx context.Context, id int, at time.Time) (*ent.User, error) {
+	args := m.Called(ctx, id, at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.User), args.Error(1)
+}
+
+func (m *MockUserRepository) CancelDeletion(ctx context.Context, id int) (*ent.User, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.User), args.Error(1)
+}
+
+func (m *MockUserRepository) DeleteScheduled(ctx context.Context, now time.Time) (int, error) {
+	args := m.Called(ctx, now)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockUserRepository) SetDisabledAt(ctx context.Context, id int, at *time.Time) (*ent.User, error) {
+	args := m.Called(ctx, id, at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.User), args.Error(1)
+}
+
+func (m *MockUserRepository) SetRole(ctx context.Context, id int, role string) (*ent.User, error) {
+	args := m.Called(ctx, id, role)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.User), args.Error(1)
+}
+
+var testJWTKeys = utils.NewDevJWTKeyManager()
+
+// newAllowingLoginThrottle は失敗の記録が無く、ログインを制限しない LoginThrottle を返す
+func newAllowingLoginThrottle() (*LoginThrottle, *testutils.MockLoginAttemptRepository) {
+	repo := new(testutils.MockLoginAttemptRepository)
+	repo.On("FailuresByEmail", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(0, nil, nil).Maybe()
+	repo.On("FailuresByIP", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(0, nil, nil).Maybe()
+	repo.On("Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(1, nil).Maybe()
+	repo.On("UpdateResult", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	return NewLoginThrottle(repo), repo
+}
+
+func allowingLoginThrottle() *LoginThrottle {
+	throttle, _ := newAllowingLoginThrottle()
+	return throttle
+}
+
 func TestAuthService_Login(t *testing.T) {
 	password := "password123"
 	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
@@ -47,8 +139,10 @@ func TestAuthService_Login(t *testing.T) {
 		{
 			name: "Success",
 			req: &dto.LoginInput{
-				Email:    "test@example.com",
-				Password: password,
+				Email:     "test@example.com",
+				Password:  password,
+				IPAddress: "192.0.2.1",
+				UserAgent: "test-agent",
 			},
 			mockUser: &ent.User{
 				ID:       1,
@@ -90,20 +184,346 @@ func TestAuthService_Login(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo := new(MockUserRepository)
-			authService := NewAuthService(mockRepo)
+			sessionRepo := new(testutils.MockSessionRepository)
+			refreshTokenRepo := new(testutils.MockRefreshTokenRepository)
+			mfaRepo := new(testutils.MockMFARepository)
+			throttle, attemptRepo := newAllowingLoginThrottle()
+			authService := NewAuthService(mockRepo, sessionRepo, refreshTokenRepo, mfaRepo, throttle, testJWTKeys)
 
 			mockRepo.On("FindByEmail", mock.Anything, tt.req.Email).Return(tt.mockUser, tt.mockError)
+			session := &ent.Session{ID: uuid.Must(uuid.NewV7()), ExpiresAt: time.Now().Add(SessionMaxLifetime)}
+			if tt.expectedToken {
+				mfaRepo.On("FindTOTP", mock.Anything, tt.mockUser.ID).Return(nil, &ent.NotFoundError{}).Once()
+				session.UserID = tt.mockUser.ID
+				sessionRepo.On("Create", mock.Anything, tt.mockUser.ID, tt.req.IPAddress, tt.req.UserAgent, mock.Anything).
+					Return(session, nil).Once()
+				refreshTokenRepo.On("Create", mock.Anything, session.ID, mock.Anything, mock.Anything).
+					Return(&ent.RefreshToken{}, nil).Once()
+			}
 
-			token, err := authService.Login(context.Background(), tt.req)
+			res, err := authService.Login(context.Background(), tt.req)
 
 			if tt.expectedError != "" {
 				assert.Error(t, err)
 				assert.Equal(t, tt.expectedError, err.Error())
-				assert.Empty(t, token)
+				assert.Nil(t, res)
+				sessionRepo.AssertNotCalled(t, "Create")
+				attemptRepo.AssertCalled(t, "Create", mock.Anything, tt.req.Email, tt.req.IPAddress, tt.req.UserAgent, (*int)(nil), repositories.LoginResultPending)
+				attemptRepo.AssertCalled(t, "UpdateResult", mock.Anything, 1, mock.Anything, repositories.LoginResultInvalidCredentials)
 			} else {
 				assert.NoError(t, err)
-				assert.NotEmpty(t, token)
+				assert.False(t, res.MFARequired)
+				tokens := res.Tokens
+				assert.NotEmpty(t, tokens.RefreshToken)
+				assert.WithinDuration(t, time.Now().Add(AccessTokenLifetime), tokens.AccessTokenExpiresAt, time.Minute)
+				assert.WithinDuration(t, time.Now().Add(RefreshTokenLifetime), tokens.RefreshTokenExpiresAt, time.Minute)
+
+				claims := jwt.MapClaims{}
+				_, _, err := jwt.NewParser().ParseUnverified(tokens.AccessToken, claims)
+				assert.NoError(t, err)
+				assert.Equal(t, session.ID.String(), claims["jti"])
+
+				// リフレッシュトークンはハッシュ化して保存する
+				refreshTokenRepo.AssertCalled(t, "Create", mock.Anything, session.ID, hashToken(tokens.RefreshToken), tokens.RefreshTokenExpiresAt)
+				attemptRepo.AssertCalled(t, "UpdateResult", mock.Anything, 1, &tt.mockUser.ID, repositories.LoginResultSucceeded)
 			}
+			sessionRepo.AssertExpectations(t)
+			refreshTokenRepo.AssertExpectations(t)
+			mfaRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthService_LoginWithMFA(t *testing.T) {
+	password := "password123"
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	u := &ent.User{ID: 1, Email: "test@example.com", Password: string(hashedPassword)}
+	secret, _ := utils.GenerateTOTPSecret()
+	confirmedAt := time.Now()
+	cred := &ent.TOTPCredential{ID: 10, UserID: 1, Secret: secret, ConfirmedAt: &confirmedAt}
+
+	setup := func() (*AuthService, *MockUserRepository, *testutils.MockSessionRepository, *testutils.MockRefreshTokenRepository, *testutils.MockMFARepository) {
+		userRepo := new(MockUserRepository)
+		sessionRepo := new(testutils.MockSessionRepository)
+		refreshTokenRepo := new(testutils.MockRefreshTokenRepository)
+		mfaRepo := new(testutils.MockMFARepository)
+		return NewAuthService(userRepo, sessionRepo, refreshTokenRepo, mfaRepo, allowingLoginThrottle(), testJWTKeys), userRepo, sessionRepo, refreshTokenRepo, mfaRepo
+	}
+	expectSession := func(sessionRepo *testutils.MockSessionRepository, refreshTokenRepo *testutils.MockRefreshTokenRepository) {
+		session := &ent.Session{ID: uuid.Must(uuid.NewV7()), UserID: 1, ExpiresAt: time.Now().Add(SessionMaxLifetime)}
+		sessionRepo.On("Create", mock.Anything, 1, "192.0.2.1", "test-agent", mock.Anything).Return(session, nil).Once()
+		refreshTokenRepo.On("Create", mock.Anything, session.ID, mock.Anything, mock.Anything).Return(&ent.RefreshToken{}, nil).Once()
+	}
+	loginInput := &dto.LoginInput{Email: "test@example.com", Password: password, IPAddress: "192.0.2.1", UserAgent: "test-agent"}
+
+	t.Run("RequiresMFA", func(t *testing.T) {
+		authService, userRepo, sessionRepo, _, mfaRepo := setup()
+		userRepo.On("FindByEmail", mock.Anything, "test@example.com").Return(u, nil).Once()
+		mfaRepo.On("FindTOTP", mock.Anything, 1).Return(cred, nil).Once()
+
+		res, err := authService.Login(context.Background(), loginInput)
+		assert.NoError(t, err)
+		assert.True(t, res.MFARequired)
+		assert.Nil(t, res.Tokens)
+		assert.NotEmpty(t, res.MFAToken)
+		sessionRepo.AssertNotCalled(t, "Create")
+
+		// 2 段階認証のトークンはアクセストークンとして使えないよう、user_id を含まない
+		claims := jwt.MapClaims{}
+		_, _, err = jwt.NewParser().ParseUnverified(res.MFAToken, claims)
+		assert.NoError(t, err)
+		assert.NotContains(t, claims, "user_id")
+	})
+
+	t.Run("PendingEnrollmentDoesNotRequireMFA", func(t *testing.T) {
+		authService, userRepo, sessionRepo, refreshTokenRepo, mfaRepo := setup()
+		userRepo.On("FindByEmail", mock.Anything, "test@example.com").Return(u, nil).Once()
+		mfaRepo.On("FindTOTP", mock.Anything, 1).Return(&ent.TOTPCredential{ID: 10, UserID: 1, Secret: secret}, nil).Once()
+		expectSession(sessionRepo, refreshTokenRepo)
+
+		res, err := authService.Login(context.Background(), loginInput)
+		assert.NoError(t, err)
+		assert.False(t, res.MFARequired)
+		assert.NotNil(t, res.Tokens)
+	})
+
+	t.Run("CompleteWithTOTP", func(t *testing.T) {
+		authService, userRepo, sessionRepo, refreshTokenRepo, mfaRepo := setup()
+		userRepo.On("FindById", mock.Anything, 1).Return(u, nil).Once()
+		mfaToken, _ := signMFAToken(testJWTKeys, 1)
+		step := utils.TOTPStep(time.Now())
+		code, _ := utils.TOTPCode(secret, step)
+		mfaRepo.On("FindTOTP", mock.Anything, 1).Return(cred, nil).Once()
+		mfaRepo.On("UseTOTPStep", mock.Anything, 10, step).Return(true, nil).Once()
+		mfaRepo.On("UseMFAToken", mock.Anything, 1, mock.Anything, mock.Anything).Return(true, nil).Once()
+		expectSession(sessionRepo, refreshTokenRepo)
+
+		tokens, err := authService.CompleteMFALogin(context.Background(), &dto.MFALoginInput{MFAToken: mfaToken, Code: code, IPAddress: "192.0.2.1", UserAgent: "test-agent"})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, tokens.AccessToken)
+		mfaRepo.AssertExpectations(t)
+	})
+
+	t.Run("ReusedTOTPCode", func(t *testing.T) {
+		authService, userRepo, sessionRepo, _, mfaRepo := setup()
+		userRepo.On("FindById", mock.Anything, 1).Return(u, nil).Once()
+		mfaToken, _ := signMFAToken(testJWTKeys, 1)
+		step := utils.TOTPStep(time.Now())
+		code, _ := utils.TOTPCode(secret, step)
+		mfaRepo.On("FindTOTP", mock.Anything, 1).Return(cred, nil).Once()
+		mfaRepo.On("UseTOTPStep", mock.Anything, 10, step).Return(false, nil).Once()
+
+		_, err := authService.CompleteMFALogin(context.Background(), &dto.MFALoginInput{MFAToken: mfaToken, Code: code})
+		assert.ErrorIs(t, err, app_errors.ErrInvalidMFACode)
+		sessionRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("ReusedMFAToken", func(t *testing.T) {
+		authService, userRepo, sessionRepo, _, mfaRepo := setup()
+		userRepo.On("FindById", mock.Anything, 1).Return(u, nil).Once()
+		mfaToken, _ := signMFAToken(testJWTKeys, 1)
+		claims, _ := parseMFAToken(testJWTKeys, mfaToken)
+		step := utils.TOTPStep(time.Now())
+		code, _ := utils.TOTPCode(secret, step)
+		mfaRepo.On("FindTOTP", mock.Anything, 1).Return(cred, nil).Once()
+		mfaRepo.On("UseTOTPStep", mock.Anything, 10, step).Return(true, nil).Once()
+		// ログインに使用済みのトークンは、有効期限内でも受け付けない
+		mfaRepo.On("UseMFAToken", mock.Anything, 1, claims.JTI, claims.ExpiresAt).Return(false, nil).Once()
+
+		_, err := authService.CompleteMFALogin(context.Background(), &dto.MFALoginInput{MFAToken: mfaToken, Code: code})
+		assert.ErrorIs(t, err, app_errors.ErrInvalidMFAToken)
+		sessionRepo.AssertNotCalled(t, "Create")
+		mfaRepo.AssertExpectations(t)
+	})
+
+	t.Run("CompleteWithRecoveryCode", func(t *testing.T) {
+		authService, userRepo, sessionRepo, refreshTokenRepo, mfaRepo := setup()
+		userRepo.On("FindById", mock.Anything, 1).Return(u, nil).Once()
+		mfaToken, _ := signMFAToken(testJWTKeys, 1)
+		mfaRepo.On("FindTOTP", mock.Anything, 1).Return(cred, nil).Once()
+		// 大文字・小文字や区切りの有無にかかわらず照合する
+		mfaRepo.On("ConsumeRecoveryCode", mock.Anything, 1, hashToken("abcdefghij")).Return(true, nil).Once()
+		mfaRepo.On("UseMFAToken", mock.Anything, 1, mock.Anything, mock.Anything).Return(true, nil).Once()
+		expectSession(sessionRepo, refreshTokenRepo)
+
+		_, err := authService.CompleteMFALogin(context.Background(), &dto.MFALoginInput{MFAToken: mfaToken, Code: "ABCDE-FGHIJ", IPAddress: "192.0.2.1", UserAgent: "test-agent"})
+		assert.NoError(t, err)
+		mfaRepo.AssertExpectations(t)
+	})
+
+	t.Run("InvalidMFAToken", func(t *testing.T) {
+		authService, _, _, _, mfaRepo := setup()
+		// アクセストークンなど、別の用途の JWT は受け付けない
+		accessToken, _ := signAccessToken(testJWTKeys, 1, uuid.NewString(), time.Now().Add(time.Minute))
+
+		for _, token := range []string{"", "invalid", accessToken} {
+			_, err := authService.CompleteMFALogin(context.Background(), &dto.MFALoginInput{MFAToken: token, Code: "123456"})
+			assert.ErrorIs(t, err, app_errors.ErrInvalidMFAToken)
+		}
+		mfaRepo.AssertNotCalled(t, "FindTOTP")
+	})
+}
+
+func TestAuthService_Register(t *testing.T) {
+	input := &dto.RegisterInput{
+		Name:     "test",
+		Email:    "test@example.com",
+		Password: "password123",
+	}
+	hashedBy := func(password string) interface{} {
+		return mock.MatchedBy(func(hash string) bool {
+			return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
 		})
 	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockUserRepository)
+		authService := NewAuthService(mockRepo, new(testutils.MockSessionRepository), new(testutils.MockRefreshTokenRepository), new(testutils.MockMFARepository), allowingLoginThrottle(), testJWTKeys)
+		mockRepo.On("Create", mock.Anything, "test", "test@example.com", hashedBy("password123")).
+			Return(&ent.User{ID: 1, Name: "test", Email: "test@example.com"}, nil).Once()
+
+		u, err := authService.Register(context.Background(), input)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, u.ID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("DuplicateEmail", func(t *testing.T) {
+		mockRepo := new(MockUserRepository)
+		authService := NewAuthService(mockRepo, new(testutils.MockSessionRepository), new(testutils.MockRefreshTokenRepository), new(testutils.MockMFARepository), allowingLoginThrottle(), testJWTKeys)
+		mockRepo.On("Create", mock.Anything, "test", "test@example.com", mock.Anything).
+			Return(nil, &ent.ConstraintError{}).Once()
+
+		u, err := authService.Register(context.Background(), input)
+		assert.ErrorIs(t, err, app_errors.ErrEmailAlreadyRegistered)
+		assert.Nil(t, u)
+	})
+
+	t.Run("Closed", func(t *testing.T) {
+		t.Setenv("REGISTRATION_MODE", RegistrationModeClosed)
+		mockRepo := new(MockUserRepository)
+		authService := NewAuthService(mockRepo, new(testutils.MockSessionRepository), new(testutils.MockRefreshTokenRepository), new(testutils.MockMFARepository), allowingLoginThrottle(), testJWTKeys)
+
+		_, err := authService.Register(context.Background(), input)
+		assert.ErrorIs(t, err, app_errors.ErrRegistrationClosed)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("UnknownModeIsClosed", func(t *testing.T) {
+		t.Setenv("REGISTRATION_MODE", "invite_only")
+		mockRepo := new(MockUserRepository)
+		authService := NewAuthService(mockRepo, new(testutils.MockSessionRepository), new(testutils.MockRefreshTokenRepository), new(testutils.MockMFARepository), allowingLoginThrottle(), testJWTKeys)
+
+		_, err := authService.Register(context.Background(), input)
+		assert.ErrorIs(t, err, app_errors.ErrRegistrationClosed)
+	})
+
+	t.Run("Invite", func(t *testing.T) {
+		t.Setenv("REGISTRATION_MODE", RegistrationModeInvite)
+		t.Setenv("REGISTRATION_INVITE_CODES", "alpha, beta")
+
+		mockRepo := new(MockUserRepository)
+		authService := NewAuthService(mockRepo, new(testutils.MockSessionRepository), new(testutils.MockRefreshTokenRepository), new(testutils.MockMFARepository), allowingLoginThrottle(), testJWTKeys)
+		mockRepo.On("Create", mock.Anything, "test", "test@example.com", mock.Anything).
+			Return(&ent.User{ID: 1}, nil).Once()
+
+		_, err := authService.Register(context.Background(), &dto.RegisterInput{
+			Name: "test", Email: "test@example.com", Password: "password123", InviteCode: "wrong",
+		})
+		assert.ErrorIs(t, err, app_errors.ErrInvalidInviteCode)
+
+		_, err = authService.Register(context.Background(), &dto.RegisterInput{
+			Name: "test", Email: "test@example.com", Password: "password123",
+		})
+		assert.ErrorIs(t, err, app_errors.ErrInvalidInviteCode)
+
+		_, err = authService.Register(context.Background(), &dto.RegisterInput{
+			Name: "test", Email: "test@example.com", Password: "password123", InviteCode: "beta",
+		})
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestAuthService_Refresh(t *testing.T) {
+	input := &dto.RefreshInput{RefreshToken: "refresh-token", IPAddress: "192.0.2.1", UserAgent: "test-agent"}
+	newSession := func(expiresAt time.Time) *ent.Session {
+		return &ent.Session{ID: uuid.Must(uuid.NewV7()), UserID: 1, ExpiresAt: expiresAt}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		sessionRepo := new(testutils.MockSessionRepository)
+		refreshTokenRepo := new(testutils.MockRefreshTokenRepository)
+		authService := NewAuthService(new(MockUserRepository), sessionRepo, refreshTokenRepo, new(testutils.MockMFARepository), allowingLoginThrottle(), testJWTKeys)
+
+		// 最大期間の終了が近い場合、新しいリフレッシュトークンの期限はセッションの期限までとなる
+		session := newSession(time.Now().Add(time.Hour))
+		refreshTokenRepo.On("Consume", mock.Anything, hashToken("refresh-token")).
+			Return(&ent.RefreshToken{ExpiresAt: time.Now().Add(time.Hour), Edges: ent.RefreshTokenEdges{Session: session}}, true, nil).Once()
+		sessionRepo.On("Touch", mock.Anything, session.ID, "192.0.2.1", "test-agent").Return(nil).Once()
+		refreshTokenRepo.On("Create", mock.Anything, session.ID, mock.Anything, session.ExpiresAt).Return(&ent.RefreshToken{}, nil).Once()
+
+		tokens, err := authService.Refresh(context.Background(), input)
+		assert.NoError(t, err)
+		assert.NotEqual(t, "refresh-token", tokens.RefreshToken)
+		assert.Equal(t, session.ExpiresAt, tokens.RefreshTokenExpiresAt)
+		sessionRepo.AssertExpectations(t)
+		refreshTokenRepo.AssertExpectations(t)
+	})
+
+	t.Run("Reused", func(t *testing.T) {
+		sessionRepo := new(testutils.MockSessionRepository)
+		refreshTokenRepo := new(testutils.MockRefreshTokenRepository)
+		authService := NewAuthService(new(MockUserRepository), sessionRepo, refreshTokenRepo, new(testutils.MockMFARepository), allowingLoginThrottle(), testJWTKeys)
+
+		session := newSession(time.Now().Add(time.Hour))
+		refreshTokenRepo.On("Consume", mock.Anything, hashToken("refresh-token")).
+			Return(&ent.RefreshToken{ExpiresAt: time.Now().Add(time.Hour), Edges: ent.RefreshTokenEdges{Session: session}}, false, nil).Once()
+		sessionRepo.On("RevokeByID", mock.Anything, session.ID).Return(nil).Once()
+
+		tokens, err := authService.Refresh(context.Background(), input)
+		assert.ErrorIs(t, err, app_errors.ErrRefreshTokenReused)
+		assert.Nil(t, tokens)
+		sessionRepo.AssertExpectations(t)
+		refreshTokenRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("SessionExpired", func(t *testing.T) {
+		sessionRepo := new(testutils.MockSessionRepository)
+		refreshTokenRepo := new(testutils.MockRefreshTokenRepository)
+		authService := NewAuthService(new(MockUserRepository), sessionRepo, refreshTokenRepo, new(testutils.MockMFARepository), allowingLoginThrottle(), testJWTKeys)
+
+		session := newSession(time.Now().Add(-time.Second))
+		refreshTokenRepo.On("Consume", mock.Anything, hashToken("refresh-token")).
+			Return(&ent.RefreshToken{ExpiresAt: time.Now().Add(time.Hour), Edges: ent.RefreshTokenEdges{Session: session}}, true, nil).Once()
+
+		_, err := authService.Refresh(context.Background(), input)
+		assert.ErrorIs(t, err, app_errors.ErrInvalidRefreshToken)
+		refreshTokenRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("TokenExpired", func(t *testing.T) {
+		sessionRepo := new(testutils.MockSessionRepository)
+		refreshTokenRepo := new(testutils.MockRefreshTokenRepository)
+		authService := NewAuthService(new(MockUserRepository), sessionRepo, refreshTokenRepo, new(testutils.MockMFARepository), allowingLoginThrottle(), testJWTKeys)
+
+		session := newSession(time.Now().Add(time.Hour))
+		refreshTokenRepo.On("Consume", mock.Anything, hashToken("refresh-token")).
+			Return(&ent.RefreshToken{ExpiresAt: time.Now().Add(-time.Second), Edges: ent.RefreshTokenEdges{Session: session}}, true, nil).Once()
+
+		_, err := authService.Refresh(context.Background(), input)
+		assert.ErrorIs(t, err, app_errors.ErrInvalidRefreshToken)
+	})
+
+	t.Run("UnknownToken", func(t *testing.T) {
+		refreshTokenRepo := new(testutils.MockRefreshTokenRepository)
+		authService := NewAuthService(new(MockUserRepository), new(testutils.MockSessionRepository), refreshTokenRepo, new(testutils.MockMFARepository), allowingLoginThrottle(), testJWTKeys)
+		refreshTokenRepo.On("Consume", mock.Anything, hashToken("refresh-token")).Return(nil, false, &ent.NotFoundError{}).Once()
+
+		_, err := authService.Refresh(context.Background(), input)
+		assert.ErrorIs(t, err, app_errors.ErrInvalidRefreshToken)
+
+		_, err = authService.Refresh(context.Background(), &dto.RefreshInput{})
+		assert.ErrorIs(t, err, app_errors.ErrInvalidRefreshToken)
+	})
 }
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"todo-app/app_errors"
+	"todo-app/repositories"
+)
+
+// loginThrottlePolicy は失敗の回数に応じたログインの試行の制限
+type loginThrottlePolicy struct {
+	// この回数までは待たずに再試行できる
+	freeAttempts int
+	// freeAttempts を超えた後の待ち時間。失敗するごとに倍になり、maxDelay で頭打ちになる
+	baseDelay time.Duration
+	maxDelay  time.Duration
+	// この回数失敗すると、最後の失敗から lockoutDuration の間ロックする。失敗はこの期間内のものを数える
+	lockoutAfter    int
+	lockoutDuration time.Duration
+}
+
+var (
+	// メールアドレスごとの制限。未登録のメールアドレスも同じように制限し、登録の有無を推測されないようにする
+	accountLoginThrottlePolicy = loginThrottlePolicy{
+		freeAttempts:    3,
+		baseDelay:       time.Second,
+		maxDelay:        time.Minute,
+		lockoutAfter:    10,
+		lockoutDuration: 15 * time.Minute,
+	}
+	// IP アドレスごとの制限。多数のアカウントを順に試す攻撃を防ぐ。NAT などで共有される場合を考慮して緩めにする
+	ipLoginThrottlePolicy = loginThrottlePolicy{
+		freeAttempts:    20,
+		baseDelay:       time.Second,
+		maxDelay:        time.Minute,
+		lockoutAfter:    100,
+		lockoutDuration: 15 * time.Minute,
+	}
+)
+
+// retryAfter は failures 回失敗し、最後の失敗が lastFailedAt の場合に、now から再試行できるまでの時間を返す
+func (p loginThrottlePolicy) retryAfter(failures int, lastFailedAt time.Time, now time.Time) time.Duration {
+	var wait time.Duration
+	switch {
+	case failures >= p.lockoutAfter:
+		wait = p.lockoutDuration
+	case failures > p.freeAttempts:
+		wait = p.maxDelay
+		if shift := failures - p.freeAttempts - 1; shift < 30 && p.baseDelay<<shift < p.maxDelay {
+			wait = p.baseDelay << shift
+		}
+	default:
+		return 0
+	}
+	return max(lastFailedAt.Add(wait).Sub(now), 0)
+}
+
+// LoginThrottle はログインの試行を監査記録に残し、失敗が続くメールアドレスや IP アドレスからの試行を制限する。
+// 記録は DB に保存するため、複数のサーバーで動かしても同じように制限できる。
+// 確認してから記録するのではなく、先に記録してから数えるため、並行したリクエストでも制限を回避できない
+type LoginThrottle struct {
+	repo repositories.ILoginAttemptRepository
+	now  func() time.Time
+}
+
+func NewLoginThrottle(repo repositories.ILoginAttemptRepository) *LoginThrottle {
+	return &LoginThrottle{repo: repo, now: time.Now}
+}
+
+// Reserve はログインの試行を確認中として記録してから、試行できるか確認し、記録の ID を返す。
+// 確認中の試行も失敗として数えるため、同時に試行しても、先に記録された試行の分だけ制限がかかる。
+// 制限中の場合は記録を throttled に確定して *app_errors.LoginThrottledError を返す。
+// それ以外の場合は Finish で結果を確定する。確定しなかった試行は失敗として数える
+func (t *LoginThrottle) Reserve(ctx context.Context, email string, ipAddress string, userAgent string, userID *int) (int, error) {
+	email = normalizeLoginEmail(email)
+	id, err := t.repo.Create(ctx, email, ipAddress, userAgent, userID, repositories.LoginResultPending)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := t.check(ctx, email, ipAddress, id); err != nil {
+		if errors.Is(err, app_errors.ErrLoginThrottled) {
+			if finishErr := t.Finish(ctx, id, nil, repositories.LoginResultThrottled); finishErr != nil {
+				return 0, finishErr
+			}
+		}
+		return 0, err
+	}
+	return id, nil
+}
+
+// check は ID が attemptID の試行自身を除いた失敗の回数から、ログインを試行できるか確認する
+func (t *LoginThrottle) check(ctx context.Context, email string, ipAddress string, attemptID int) error {
+	now := t.now()
+
+	n, last, err := t.repo.FailuresByEmail(ctx, email, now.Add(-accountLoginThrottlePolicy.lockoutDuration), attemptID)
+	if err != nil {
+		return err
+	}
+	wait := time.Duration(0)
+	if last != nil {
+		wait = accountLoginThrottlePolicy.retryAfter(n, *last, now)
+	}
+
+	n, last, err = t.repo.FailuresByIP(ctx, ipAddress, now.Add(-ipLoginThrottlePolicy.lockoutDuration), attemptID)
+	if err != nil {
+		return err
+	}
+	if last != nil {
+		wait = max(wait, ipLoginThrottlePolicy.retryAfter(n, *last, now))
+	}
+
+	if wait > 0 {
+		return &app_errors.LoginThrottledError{RetryAfter: wait}
+	}
+	return nil
+}
+
+// Finish は Reserve で記録した試行の結果を確定する。userID が nil の場合は Reserve で記録したユーザーのままにする
+func (t *LoginThrottle) Finish(ctx context.Context, attemptID int, userID *int, result string) error {
+	return t.repo.UpdateResult(ctx, attemptID, userID, result)
+}
+
+// normalizeLoginEmail は大文字・小文字を変えて制限を回避されないよう、メールアドレスを正規化する
+func normalizeLoginEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
@@ -0,0 +1,239 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"todo-app/app_errors"
+	"todo-app/dto"
+	"todo-app/ent"
+	"todo-app/repositories"
+	"todo-app/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestLoginThrottlePolicy_RetryAfter(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		policy   loginThrottlePolicy
+		failures int
+		lastAgo  time.Duration
+		want     time.Duration
+	}{
+		{name: "FreeAttempts", policy: accountLoginThrottlePolicy, failures: 3, lastAgo: 0, want: 0},
+		{name: "FirstBackoff", policy: accountLoginThrottlePolicy, failures: 4, lastAgo: 0, want: time.Second},
+		{name: "Doubles", policy: accountLoginThrottlePolicy, failures: 6, lastAgo: 0, want: 4 * time.Second},
+		{name: "Elapsed", policy: accountLoginThrottlePolicy, failures: 6, lastAgo: 5 * time.Second, want: 0},
+		{name: "Capped", policy: ipLoginThrottlePolicy, failures: 60, lastAgo: 0, want: time.Minute},
+		{name: "Lockout", policy: accountLoginThrottlePolicy, failures: 10, lastAgo: time.Minute, want: 14 * time.Minute},
+		{name: "LockoutExpired", policy: accountLoginThrottlePolicy, failures: 12, lastAgo: 15 * time.Minute, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.retryAfter(tt.failures, now.Add(-tt.lastAgo), now))
+		})
+	}
+}
+
+func TestLoginThrottle_Reserve(t *testing.T) {
+	now := time.Now()
+	newThrottle := func(emailFailures int, ipFailures int) (*LoginThrottle, *testutils.MockLoginAttemptRepository) {
+		repo := new(testutils.MockLoginAttemptRepository)
+		throttle := NewLoginThrottle(repo)
+		throttle.now = func() time.Time { return now }
+		last := now
+		emailLast, ipLast := &last, &last
+		if emailFailures == 0 {
+			emailLast = nil
+		}
+		if ipFailures == 0 {
+			ipLast = nil
+		}
+		// メールアドレスは大文字・小文字を区別せずに数える
+		repo.On("Create", mock.Anything, "user@example.com", "192.0.2.1", "test-agent", (*int)(nil), repositories.LoginResultPending).Return(42, nil).Once()
+		// 確認中として記録した試行自身は数えない
+		repo.On("FailuresByEmail", mock.Anything, "user@example.com", now.Add(-15*time.Minute), 42).Return(emailFailures, emailLast, nil)
+		repo.On("FailuresByIP", mock.Anything, "192.0.2.1", now.Add(-15*time.Minute), 42).Return(ipFailures, ipLast, nil)
+		return throttle, repo
+	}
+
+	t.Run("Allowed", func(t *testing.T) {
+		throttle, repo := newThrottle(0, 0)
+		id, err := throttle.Reserve(context.Background(), " User@Example.com ", "192.0.2.1", "test-agent", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 42, id)
+		repo.AssertNotCalled(t, "UpdateResult")
+	})
+
+	t.Run("AccountLocked", func(t *testing.T) {
+		throttle, repo := newThrottle(10, 0)
+		repo.On("UpdateResult", mock.Anything, 42, (*int)(nil), repositories.LoginResultThrottled).Return(nil).Once()
+		_, err := throttle.Reserve(context.Background(), "user@example.com", "192.0.2.1", "test-agent", nil)
+		var throttled *app_errors.LoginThrottledError
+		assert.True(t, errors.As(err, &throttled))
+		assert.Equal(t, 15*time.Minute, throttled.RetryAfter)
+		assert.ErrorIs(t, err, app_errors.ErrLoginThrottled)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("IPThrottled", func(t *testing.T) {
+		throttle, repo := newThrottle(0, 21)
+		repo.On("UpdateResult", mock.Anything, 42, (*int)(nil), repositories.LoginResultThrottled).Return(nil).Once()
+		_, err := throttle.Reserve(context.Background(), "user@example.com", "192.0.2.1", "test-agent", nil)
+		var throttled *app_errors.LoginThrottledError
+		assert.True(t, errors.As(err, &throttled))
+		assert.Equal(t, time.Second, throttled.RetryAfter)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestAuthService_LoginThrottled(t *testing.T) {
+	userRepo := new(MockUserRepository)
+	attemptRepo := new(testutils.MockLoginAttemptRepository)
+	authService := NewAuthService(userRepo, new(testutils.MockSessionRepository), new(testutils.MockRefreshTokenRepository), new(testutils.MockMFARepository), NewLoginThrottle(attemptRepo), testJWTKeys)
+
+	last := time.Now()
+	attemptRepo.On("Create", mock.Anything, "user@example.com", "192.0.2.1", "test-agent", (*int)(nil), repositories.LoginResultPending).Return(1, nil).Once()
+	attemptRepo.On("FailuresByEmail", mock.Anything, "user@example.com", mock.Anything, 1).Return(10, &last, nil)
+	attemptRepo.On("FailuresByIP", mock.Anything, "192.0.2.1", mock.Anything, 1).Return(0, nil, nil)
+	attemptRepo.On("UpdateResult", mock.Anything, 1, (*int)(nil), repositories.LoginResultThrottled).Return(nil).Once()
+
+	// 制限中はパスワードが正しくてもログインできず、パスワードの確認も行わない
+	_, err := authService.Login(context.Background(), &dto.LoginInput{Email: "user@example.com", Password: "password123", IPAddress: "192.0.2.1", UserAgent: "test-agent"})
+	assert.ErrorIs(t, err, app_errors.ErrLoginThrottled)
+	userRepo.AssertNotCalled(t, "FindByEmail")
+	attemptRepo.AssertExpectations(t)
+}
+
+// memoryLoginAttemptRepository は DB と同様に、各操作が記録の追加・更新の直後から他の呼び出しに見える ILoginAttemptRepository
+type memoryLoginAttemptRepository struct {
+	mu       sync.Mutex
+	attempts []*ent.LoginAttempt
+}
+
+func (r *memoryLoginAttemptRepository) Create(_ context.Context, email string, ipAddress string, userAgent string, userID *int, result string) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	a := &ent.LoginAttempt{ID: len(r.attempts) + 1, Email: email, IPAddress: ipAddress, UserAgent: userAgent, UserID: userID, Result: result, CreatedAt: time.Now()}
+	r.attempts = append(r.attempts, a)
+	return a.ID, nil
+}
+
+func (r *memoryLoginAttemptRepository) UpdateResult(_ context.Context, id int, userID *int, result string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	a := r.attempts[id-1]
+	if userID != nil {
+		a.UserID = userID
+	}
+	a.Result = result
+	return nil
+}
+
+func (r *memoryLoginAttemptRepository) FailuresByEmail(_ context.Context, email string, since time.Time, excludeID int) (int, *time.Time, error) {
+	return r.failures(func(a *ent.LoginAttempt) bool { return a.Email == email }, since, excludeID)
+}
+
+func (r *memoryLoginAttemptRepository) FailuresByIP(_ context.Context, ipAddress string, since time.Time, excludeID int) (int, *time.Time, error) {
+	return r.failures(func(a *ent.LoginAttempt) bool { return a.IPAddress == ipAddress }, since, excludeID)
+}
+
+func (r *memoryLoginAttemptRepository) failures(match func(*ent.LoginAttempt) bool, since time.Time, excludeID int) (int, *time.Time, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := 0
+	var last *time.Time
+	for _, a := range r.attempts {
+		if !match(a) || a.ID == excludeID || !a.CreatedAt.After(since) {
+			continue
+		}
+		if a.Result == repositories.LoginResultInvalidCredentials || a.Result == repositories.LoginResultPending {
+			n++
+			last = &a.CreatedAt
+		}
+	}
+	return n, last, nil
+}
+
+func (r *memoryLoginAttemptRepository) count(result string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := 0
+	for _, a := range r.attempts {
+		if a.Result == result {
+			n++
+		}
+	}
+	return n
+}
+
+func TestAuthService_LoginThrottle_Concurrent(t *testing.T) {
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	u := &ent.User{ID: 1, Email: "user@example.com", Password: string(hashedPassword)}
+
+	t.Run("ParallelAttemptsCannotExceedFreeAttempts", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		userRepo.On("FindByEmail", mock.Anything, "user@example.com").Return(u, nil)
+		attemptRepo := &memoryLoginAttemptRepository{}
+		authService := NewAuthService(userRepo, new(testutils.MockSessionRepository), new(testutils.MockRefreshTokenRepository), new(testutils.MockMFARepository), NewLoginThrottle(attemptRepo), testJWTKeys)
+
+		const n = 30
+		var wg sync.WaitGroup
+		start := make(chan struct{})
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				<-start
+				_, _ = authService.Login(context.Background(), &dto.LoginInput{Email: "user@example.com", Password: "wrong-password", IPAddress: "192.0.2.1"})
+			}()
+		}
+		close(start)
+		wg.Wait()
+
+		// 同時に試行しても、パスワードを確認できるのは待たずに再試行できる回数 + 1 回まで
+		verified := attemptRepo.count(repositories.LoginResultInvalidCredentials)
+		assert.GreaterOrEqual(t, verified, 1)
+		assert.LessOrEqual(t, verified, accountLoginThrottlePolicy.freeAttempts+1)
+		assert.Equal(t, n-verified, attemptRepo.count(repositories.LoginResultThrottled))
+		assert.Zero(t, attemptRepo.count(repositories.LoginResultPending))
+	})
+
+	t.Run("ParallelAttemptsCannotExceedLockout", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		userRepo.On("FindByEmail", mock.Anything, "user@example.com").Return(u, nil)
+		attemptRepo := &memoryLoginAttemptRepository{}
+		// ロックまで残り 1 回で、前回の失敗からの待ち時間は過ぎている
+		for i := 0; i < accountLoginThrottlePolicy.lockoutAfter-1; i++ {
+			id, _ := attemptRepo.Create(context.Background(), "user@example.com", "198.51.100.1", "", &u.ID, repositories.LoginResultInvalidCredentials)
+			attemptRepo.attempts[id-1].CreatedAt = time.Now().Add(-5 * time.Minute)
+		}
+		authService := NewAuthService(userRepo, new(testutils.MockSessionRepository), new(testutils.MockRefreshTokenRepository), new(testutils.MockMFARepository), NewLoginThrottle(attemptRepo), testJWTKeys)
+
+		const n = 30
+		var wg sync.WaitGroup
+		start := make(chan struct{})
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				<-start
+				_, _ = authService.Login(context.Background(), &dto.LoginInput{Email: "user@example.com", Password: "wrong-password", IPAddress: "192.0.2.1"})
+			}()
+		}
+		close(start)
+		wg.Wait()
+
+		// 残りの 1 回を超えてパスワードを確認しない
+		assert.LessOrEqual(t, attemptRepo.count(repositories.LoginResultInvalidCredentials), accountLoginThrottlePolicy.lockoutAfter)
+		assert.GreaterOrEqual(t, attemptRepo.count(repositories.LoginResultThrottled), n-1)
+	})
+}
@@ -0,0 +1,338 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"todo-app/app_errors"
+	"todo-app/dto"
+	"todo-app/ent"
+	"todo-app/repositories"
+	"todo-app/utils"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// パスワードを確認してから、2 段階認証のコードを入力するまでの有効期限
+const MFATokenLifetime = 5 * time.Minute
+
+// 2 段階認証の待ちであることを示す JWT の audience。アクセストークンなど他の用途の JWT と取り違えないようにする
+const mfaTokenAudience = "mfa-login"
+
+// 発行するリカバリーコードの数
+const recoveryCodeCount = 10
+
+// 認証アプリに表示する発行者の名前 (TOTP_ISSUER が未指定の場合)
+const defaultTOTPIssuer = "Todo App"
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// MFAService はログイン中のユーザーの 2 段階認証 (TOTP) の登録・解除を行う
+type MFAService struct {
+	client  *ent.Client
+	mfaRepo repositories.IMFARepository
+}
+
+func NewMFAService(client *ent.Client, mfaRepo repositories.IMFARepository) *MFAService {
+	return &MFAService{client: client, mfaRepo: mfaRepo}
+}
+
+func (s *MFAService) GetStatus(ctx context.Context) (*dto.MFAStatusDto, error) {
+	u, ok := utils.UserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("user not found in context")
+	}
+
+	cred, err := s.mfaRepo.FindTOTP(ctx, u.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &dto.MFAStatusDto{}, nil
+		}
+		return nil, err
+	}
+	if cred.ConfirmedAt == nil {
+		return &dto.MFAStatusDto{}, nil
+	}
+
+	n, err := s.mfaRepo.CountRecoveryCodes(ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.MFAStatusDto{TOTPEnabled: true, RecoveryCodesRemaining: n}, nil
+}
+
+// StartTOTPEnrollment は新しいシークレットを発行する。ConfirmTOTPEnrollment でコードを確認するまで有効にはならない
+func (s *MFAService) StartTOTPEnrollment(ctx context.Context) (*dto.TOTPEnrollmentDto, error) {
+	u, ok := utils.UserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("user not found in context")
+	}
+
+	cred, err := s.mfaRepo.FindTOTP(ctx, u.ID)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if cred != nil && cred.ConfirmedAt != nil {
+		return nil, app_errors.ErrMFAAlreadyEnabled
+	}
+
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.mfaRepo.SavePendingTOTP(ctx, u.ID, secret); err != nil {
+		return nil, err
+	}
+	return &dto.TOTPEnrollmentDto{
+		Secret:          secret,
+		ProvisioningURI: utils.TOTPProvisioningURI(totpIssuer(), u.Email, secret),
+	}, nil
+}
+
+// ConfirmTOTPEnrollment は認証アプリのコードを確認して 2 段階認証を有効にし、リカバリーコードを発行する
+func (s *MFAService) ConfirmTOTPEnrollment(ctx context.Context, code string) (*dto.RecoveryCodesDto, error) {
+	u, ok := utils.UserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("user not found in context")
+	}
+
+	cred, err := s.mfaRepo.FindTOTP(ctx, u.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, app_errors.ErrMFANotEnabled
+		}
+		return nil, err
+	}
+	if cred.ConfirmedAt != nil {
+		return nil, app_errors.ErrMFAAlreadyEnabled
+	}
+	step, ok := utils.ValidateTOTP(cred.Secret, code, time.Now(), cred.LastUsedStep)
+	if !ok {
+		return nil, app_errors.ErrInvalidMFACode
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	txCtx, tx, err := utils.WithTx(ctx, s.client)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.mfaRepo.ConfirmTOTP(txCtx, cred.ID, step); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err := s.mfaRepo.ReplaceRecoveryCodes(txCtx, u.ID, hashes); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &dto.RecoveryCodesDto{RecoveryCodes: codes}, nil
+}
+
+// RegenerateRecoveryCodes は認証アプリのコードを確認してから、リカバリーコードを新しいものに置き換える
+func (s *MFAService) RegenerateRecoveryCodes(ctx context.Context, code string) (*dto.RecoveryCodesDto, error) {
+	u, ok := utils.UserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("user not found in context")
+	}
+
+	cred, err := s.findConfirmedTOTP(ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := useTOTPCode(ctx, s.mfaRepo, cred, code); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	txCtx, tx, err := utils.WithTx(ctx, s.client)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.mfaRepo.ReplaceRecoveryCodes(txCtx, u.ID, hashes); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &dto.RecoveryCodesDto{RecoveryCodes: codes}, nil
+}
+
+// DisableTOTP はパスワードを確認してから 2 段階認証を無効にする。リカバリーコードも削除する
+func (s *MFAService) DisableTOTP(ctx context.Context, password string) error {
+	u, ok := utils.UserFromContext(ctx)
+	if !ok {
+		return errors.New("user not found in context")
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(password)); err != nil {
+		return app_errors.ErrInvalidCurrentPassword
+	}
+
+	if _, err := s.findConfirmedTOTP(ctx, u.ID); err != nil {
+		return err
+	}
+
+	txCtx, tx, err := utils.WithTx(ctx, s.client)
+	if err != nil {
+		return err
+	}
+	if err := s.mfaRepo.DeleteTOTP(txCtx, u.ID); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *MFAService) findConfirmedTOTP(ctx context.Context, userID int) (*ent.TOTPCredential, error) {
+	cred, err := s.mfaRepo.FindTOTP(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, app_errors.ErrMFANotEnabled
+		}
+		return nil, err
+	}
+	if cred.ConfirmedAt == nil {
+		return nil, app_errors.ErrMFANotEnabled
+	}
+	return cred, nil
+}
+
+// verifyMFACode はログインの 2 段階目で入力されたコードを確認する。
+// 6 桁の数字は認証アプリのコード、それ以外はリカバリーコードとして扱い、どちらも 1 回だけ使用できる
+func verifyMFACode(ctx context.Context, repo repositories.IMFARepository, userID int, code string) error {
+	cred, err := repo.FindTOTP(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return app_errors.ErrInvalidMFACode
+		}
+		return err
+	}
+	if cred.ConfirmedAt == nil {
+		return app_errors.ErrInvalidMFACode
+	}
+
+	code = strings.TrimSpace(code)
+	if _, err := strconv.Atoi(code); err == nil && len(code) == utils.TOTPDigits {
+		return useTOTPCode(ctx, repo, cred, code)
+	}
+
+	ok, err := repo.ConsumeRecoveryCode(ctx, userID, hashToken(normalizeRecoveryCode(code)))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return app_errors.ErrInvalidMFACode
+	}
+	return nil
+}
+
+// useTOTPCode は認証アプリのコードを確認し、そのコードを使用済みにする
+func useTOTPCode(ctx context.Context, repo repositories.IMFARepository, cred *ent.TOTPCredential, code string) error {
+	step, ok := utils.ValidateTOTP(cred.Secret, code, time.Now(), cred.LastUsedStep)
+	if !ok {
+		return app_errors.ErrInvalidMFACode
+	}
+	used, err := repo.UseTOTPStep(ctx, cred.ID, step)
+	if err != nil {
+		return err
+	}
+	if !used {
+		return app_errors.ErrInvalidMFACode
+	}
+	return nil
+}
+
+// newRecoveryCodes はリカバリーコードと、保存するためのハッシュを返す。
+// コードは読み間違えにくいよう Base32 の 10 文字 (50 ビット) を xxxxx-xxxxx の形式にする
+func newRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		b := make([]byte, 8)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		raw := strings.ToLower(recoveryCodeEncoding.EncodeToString(b))[:10]
+		codes[i] = raw[:5] + "-" + raw[5:]
+		hashes[i] = hashToken(raw)
+	}
+	return codes, hashes, nil
+}
+
+// normalizeRecoveryCode は大文字・小文字や区切りの有無にかかわらず照合できるよう、入力されたリカバリーコードを正規化する
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+func totpIssuer() string {
+	if issuer := os.Getenv("TOTP_ISSUER"); issuer != "" {
+		return issuer
+	}
+	return defaultTOTPIssuer
+}
+
+// signMFAToken はパスワードを確認したユーザーに、2 段階認証のコードを入力するまでの間だけ有効なトークンを発行する
+func signMFAToken(keys *utils.JWTKeyManager, userID int) (string, error) {
+	now := time.Now()
+	// jti はトークンを 1 回だけ使用できるよう、ログインを完了した時点で使用済みとして記録する
+	jti, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+	return keys.Sign(jwt.RegisteredClaims{
+		ID:        jti.String(),
+		Subject:   strconv.Itoa(userID),
+		Audience:  jwt.ClaimStrings{mfaTokenAudience},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(MFATokenLifetime)),
+	})
+}
+
+// mfaTokenClaims は検証済みの 2 段階認証のトークンの内容
+type mfaTokenClaims struct {
+	UserID    int
+	JTI       uuid.UUID
+	ExpiresAt time.Time
+}
+
+func parseMFAToken(keys *utils.JWTKeyManager, value string) (*mfaTokenClaims, error) {
+	var claims jwt.RegisteredClaims
+	_, err := keys.Parse(value, &claims,
+		jwt.WithAudience(mfaTokenAudience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, app_errors.ErrInvalidMFAToken
+	}
+	userID, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		return nil, app_errors.ErrInvalidMFAToken
+	}
+	// jti のないトークンは使用済みかどうかを判定できないため受け付けない
+	jti, err := uuid.Parse(claims.ID)
+	if err != nil {
+		return nil, app_errors.ErrInvalidMFAToken
+	}
+	return &mfaTokenClaims{UserID: userID, JTI: jti, ExpiresAt: claims.ExpiresAt.Time}, nil
+}
@@ -0,0 +1,271 @@
+package services
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"todo-app/app_errors"
+	"todo-app/dto"
+	"todo-app/ent"
+	"todo-app/repositories"
+	"todo-app/utils"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// OIDC のログインを開始してからコールバックまでの有効期限
+const OIDCLoginStateLifetime = 10 * time.Minute
+
+// ログイン中の状態を署名した値の audience。アクセストークンなど他の用途の JWT と取り違えないようにする
+const oidcLoginStateAudience = "oidc-login-state"
+
+type oidcLoginStateClaims struct {
+	jwt.RegisteredClaims
+	Provider     string `json:"provider"`
+	State        string `json:"state"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"code_verifier"`
+}
+
+// OIDCService は OpenID Connect の認可コードフロー (PKCE) でログインさせる
+type OIDCService struct {
+	logger       *slog.Logger
+	registry     *utils.OIDCRegistry
+	userRepo     repositories.IUserRepository
+	identityRepo repositories.IUserIdentityRepository
+	sessionRepo  repositories.ISessionRepository
+	patRepo      repositories.IPersonalAccessTokenRepository
+	mfaRepo      repositories.IMFARepository
+	authService  *AuthService
+	keys         *utils.JWTKeyManager
+}
+
+func NewOIDCService(logger *slog.Logger, registry *utils.OIDCRegistry, userRepo repositories.IUserRepository, identityRepo repositories.IUserIdentityRepository, sessionRepo repositories.ISessionRepository, patRepo repositories.IPersonalAccessTokenRepository, mfaRepo repositories.IMFARepository, authService *AuthService, keys *utils.JWTKeyManager) *OIDCService {
+	return &OIDCService{
+		logger:       logger,
+		registry:     registry,
+		userRepo:     userRepo,
+		identityRepo: identityRepo,
+		sessionRepo:  sessionRepo,
+		patRepo:      patRepo,
+		mfaRepo:      mfaRepo,
+		authService:  authService,
+		keys:         keys,
+	}
+}
+
+// Providers はログイン画面に表示するプロバイダの一覧を返す
+func (s *OIDCService) Providers() []dto.OIDCProviderDto {
+	providers := s.registry.Providers()
+	res := make([]dto.OIDCProviderDto, len(providers))
+	for i, p := range providers {
+		res[i] = dto.OIDCProviderDto{
+			Name:        p.Config.Name,
+			DisplayName: p.Config.DisplayName,
+			LoginURL:    "/auth/oidc/" + p.Config.Name + "/login",
+		}
+	}
+	return res
+}
+
+// Begin はプロバイダの認可エンドポイントの URL と、コールバックまでブラウザに保存させる署名済みの状態を返す。
+// state・nonce・code_verifier はサーバーに保存せず、署名した値を Cookie に持たせる
+func (s *OIDCService) Begin(ctx context.Context, providerName string) (string, string, error) {
+	p, err := s.registry.Get(providerName)
+	if err != nil {
+		return "", "", err
+	}
+
+	var values [3]string
+	for i := range values {
+		if values[i], err = newRandomToken(); err != nil {
+			return "", "", err
+		}
+	}
+	state, nonce, codeVerifier := values[0], values[1], values[2]
+
+	authURL, err := p.AuthCodeURL(ctx, state, nonce, codeVerifier)
+	if err != nil {
+		return "", "", err
+	}
+
+	now := time.Now()
+	loginState, err := s.keys.Sign(oidcLoginStateClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Audience:  jwt.ClaimStrings{oidcLoginStateAudience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(OIDCLoginStateLifetime)),
+		},
+		Provider:     providerName,
+		State:        state,
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	return authURL, loginState, nil
+}
+
+// Complete はコールバックで受け取った認可コードを ID トークンに交換し、紐付いたユーザーでログインする。
+// 2 段階認証を有効にしている場合は、パスワードでのログインと同様に POST /auth/login/mfa に渡すトークンを返す
+func (s *OIDCService) Complete(ctx context.Context, req *dto.OIDCCallbackInput) (*dto.LoginResult, error) {
+	p, err := s.registry.Get(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	loginState, err := parseOIDCLoginState(s.keys, req.LoginState)
+	if err != nil || loginState.Provider != req.Provider || req.State == "" ||
+		subtle.ConstantTimeCompare([]byte(loginState.State), []byte(req.State)) != 1 {
+		return nil, app_errors.ErrOIDCInvalidState
+	}
+
+	rawIDToken, err := p.Exchange(ctx, req.Code, loginState.CodeVerifier)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := p.VerifyIDToken(ctx, rawIDToken, loginState.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := s.resolveUser(ctx, req.Provider, claims)
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.userRepo.FindById(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.authService.completeFirstFactor(ctx, u, req.IPAddress, req.UserAgent)
+}
+
+func parseOIDCLoginState(keys *utils.JWTKeyManager, value string) (*oidcLoginStateClaims, error) {
+	var claims oidcLoginStateClaims
+	_, err := keys.Parse(value, &claims,
+		jwt.WithAudience(oidcLoginStateAudience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &claims, nil
+}
+
+// resolveUser は ID トークンのアカウントに紐付いたユーザーを返す。
+// 紐付けが無い場合は、プロバイダが確認済みのメールアドレスで既存のユーザーに紐付ける。
+// 既存のユーザーのメールアドレスが未確認の場合は、紐付ける前に takeOverUnverifiedUser で認証の手段を無効にする。
+// 該当するユーザーもいない場合は、登録を受け付けている (REGISTRATION_MODE=open) 場合に限りユーザーを作成する
+func (s *OIDCService) resolveUser(ctx context.Context, provider string, claims *utils.OIDCClaims) (int, error) {
+	identity, err := s.identityRepo.FindByProviderSubject(ctx, provider, claims.Subject)
+	if err == nil {
+		if err := s.identityRepo.TouchLogin(ctx, identity.ID); err != nil {
+			return 0, err
+		}
+		return identity.UserID, nil
+	}
+	if !ent.IsNotFound(err) {
+		return 0, err
+	}
+
+	// 未確認のメールアドレスで紐付けると、他人のアカウントを乗っ取れてしまう
+	if claims.Email == "" || !claims.EmailVerified {
+		return 0, app_errors.ErrOIDCEmailNotVerified
+	}
+
+	u, err := s.userRepo.FindByEmail(ctx, claims.Email)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return 0, err
+		}
+		if u, err = s.createUser(ctx, claims); err != nil {
+			return 0, err
+		}
+	} else if u.EmailVerifiedAt == nil {
+		if err := s.takeOverUnverifiedUser(ctx, u.ID); err != nil {
+			return 0, err
+		}
+	}
+
+	if _, err := s.identityRepo.Create(ctx, u.ID, provider, claims.Subject, claims.Email); err != nil {
+		return 0, err
+	}
+	s.logger.Info("linked oidc identity", slog.Int("user_id", u.ID), slog.String("provider", provider))
+	return u.ID, nil
+}
+
+// takeOverUnverifiedUser はメールアドレスを確認していないユーザーを、プロバイダが確認したメールアドレスの所有者に引き継ぐ。
+// 登録時にはメールアドレスを確認しないため、第三者が先に登録したアカウントの可能性がある。
+// 登録した人がログインし続けられないよう、パスワードを推測できない値に変え、セッション・アクセストークン・2 段階認証を無効にする
+func (s *OIDCService) takeOverUnverifiedUser(ctx context.Context, userID int) error {
+	hash, err := randomPasswordHash()
+	if err != nil {
+		return err
+	}
+	if err := s.userRepo.UpdatePassword(ctx, userID, hash); err != nil {
+		return err
+	}
+	if _, err := s.sessionRepo.RevokeAllByUserID(ctx, userID, nil); err != nil {
+		return err
+	}
+	if _, err := s.patRepo.RevokeAllByUserID(ctx, userID); err != nil {
+		return err
+	}
+	if err := s.mfaRepo.DeleteTOTP(ctx, userID); err != nil {
+		return err
+	}
+	// 途中で失敗した場合は、次のログインで再度引き継ぐよう最後に確認済みにする
+	if err := s.userRepo.MarkEmailVerified(ctx, userID); err != nil {
+		return err
+	}
+	s.logger.Warn("took over unverified account on oidc login", slog.Int("user_id", userID))
+	return nil
+}
+
+func (s *OIDCService) createUser(ctx context.Context, claims *utils.OIDCClaims) (*ent.User, error) {
+	if err := checkRegistrationAllowed(""); err != nil {
+		return nil, app_errors.ErrOIDCAccountNotFound
+	}
+
+	name := claims.Name
+	if name == "" {
+		name, _, _ = strings.Cut(claims.Email, "@")
+	}
+	// パスワードではログインさせないため、推測できないランダムな値のハッシュを保存する。
+	// 必要になった場合はパスワードの再設定で設定できる
+	hash, err := randomPasswordHash()
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.userRepo.Create(ctx, name, claims.Email, hash)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("create user for oidc login: %w", app_errors.ErrEmailAlreadyRegistered)
+		}
+		return nil, err
+	}
+	// プロバイダが確認済みのメールアドレスで作成したため、確認済みにする
+	if err := s.userRepo.MarkEmailVerified(ctx, u.ID); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// randomPasswordHash はパスワードではログインさせないユーザーに保存する、推測できないランダムな値のハッシュを返す
+func randomPasswordHash() (string, error) {
+	password, err := newRandomToken()
+	if err != nil {
+		return "", err
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
@@ -0,0 +1,165 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+	"time"
+
+	"todo-app/app_errors"
+	"todo-app/ent"
+	"todo-app/prompts"
+	"todo-app/repositories"
+	"todo-app/utils"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// パスワード再設定用のトークンの有効期限
+const PasswordResetTokenLifetime = 30 * time.Minute
+
+type PasswordService struct {
+	logger      *slog.Logger
+	userRepo    repositories.IUserRepository
+	sessionRepo repositories.ISessionRepository
+	patRepo     repositories.IPersonalAccessTokenRepository
+	resetRepo   repositories.IPasswordResetTokenRepository
+	mailer      utils.IMailer
+}
+
+func NewPasswordService(logger *slog.Logger, userRepo repositories.IUserRepository, sessionRepo repositories.ISessionRepository, patRepo repositories.IPersonalAccessTokenRepository, resetRepo repositories.IPasswordResetTokenRepository, mailer utils.IMailer) *PasswordService {
+	return &PasswordService{
+		logger:      logger,
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+		patRepo:     patRepo,
+		resetRepo:   resetRepo,
+		mailer:      mailer,
+	}
+}
+
+// ChangePassword はログイン中のユーザーのパスワードを変更する。
+// 変更後はリクエストに使ったセッション以外を失効させ、他の端末では再ログインが必要になる
+func (s *PasswordService) ChangePassword(ctx context.Context, currentPassword string, newPassword string) error {
+	u, ok := utils.UserFromContext(ctx)
+	if !ok {
+		return errors.New("user not found in context")
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(currentPassword)); err != nil {
+		return app_errors.ErrInvalidCurrentPassword
+	}
+
+	if err := s.updatePassword(ctx, u.ID, newPassword); err != nil {
+		return err
+	}
+
+	var except *uuid.UUID
+	if id, ok := CurrentSessionID(ctx); ok {
+		except = &id
+	}
+	_, err := s.sessionRepo.RevokeAllByUserID(ctx, u.ID, except)
+	return err
+}
+
+// RequestReset はパスワード再設定用のリンクをメールで送信する。
+// 登録済みのメールアドレスかどうかを推測されないよう、未登録の場合やメールの送信に失敗した場合もエラーを返さない。
+// 送信にかかる時間からも推測されないよう、メールは応答を返した後に非同期で送信する
+func (s *PasswordService) RequestReset(ctx context.Context, email string) error {
+	u, err := s.userRepo.FindByEmail(ctx, email)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	token, err := newRandomToken()
+	if err != nil {
+		return err
+	}
+	if _, err := s.resetRepo.Create(ctx, u.ID, hashToken(token), time.Now().Add(PasswordResetTokenLifetime)); err != nil {
+		return err
+	}
+
+	mail := passwordResetMail(u, token)
+	sendCtx := context.WithoutCancel(ctx)
+	go func() {
+		if err := s.mailer.Send(sendCtx, mail); err != nil {
+			s.logger.Error("failed to send password reset mail", slog.Int("user_id", u.ID), slog.String("error", err.Error()))
+		}
+	}()
+	return nil
+}
+
+// ResetPassword はメールで送信したトークンを使ってパスワードを再設定し、ユーザーの全てのセッションとアクセストークンを失効させる。
+// メールのリンクを開けたことで、メールアドレスも確認済みにする
+func (s *PasswordService) ResetPassword(ctx context.Context, token string, newPassword string) error {
+	if token == "" {
+		return app_errors.ErrInvalidResetToken
+	}
+
+	prt, consumed, err := s.resetRepo.Consume(ctx, hashToken(token))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return app_errors.ErrInvalidResetToken
+		}
+		return err
+	}
+	if !consumed || !time.Now().Before(prt.ExpiresAt) {
+		return app_errors.ErrInvalidResetToken
+	}
+
+	if err := s.updatePassword(ctx, prt.UserID, newPassword); err != nil {
+		return err
+	}
+	if err := s.userRepo.MarkEmailVerified(ctx, prt.UserID); err != nil {
+		return err
+	}
+	if _, err := s.sessionRepo.RevokeAllByUserID(ctx, prt.UserID, nil); err != nil {
+		return err
+	}
+	_, err = s.patRepo.RevokeAllByUserID(ctx, prt.UserID)
+	return err
+}
+
+func (s *PasswordService) updatePassword(ctx context.Context, userID int, password string) error {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	return s.userRepo.UpdatePassword(ctx, userID, string(hash))
+}
+
+// passwordResetURL は再設定画面の URL にトークンを付与して返す。
+// PASSWORD_RESET_URL が未指定の場合は FRONTEND_ORIGIN の /reset-password を使う
+func passwordResetURL(token string) string {
+	base := os.Getenv("PASSWORD_RESET_URL")
+	if base == "" {
+		base = os.Getenv("FRONTEND_ORIGIN") + "/reset-password"
+	}
+	return base + "?token=" + url.QueryEscape(token)
+}
+
+func passwordResetMail(u *ent.User, token string) utils.Mail {
+	link := passwordResetURL(token)
+	minutes := int(PasswordResetTokenLifetime.Minutes())
+
+	if prompts.NormalizeLocale(u.Locale) == prompts.LocaleEn {
+		return utils.Mail{
+			To:      u.Email,
+			Subject: "Reset your password",
+			Body: fmt.Sprintf("Hi %s,\n\nOpen the link below to set a new password. The link expires in %d minutes and can be used only once.\n\n%s\n\nIf you did not request this, you can ignore this email.\n",
+				u.Name, minutes, link),
+		}
+	}
+	return utils.Mail{
+		To:      u.Email,
+		Subject: "パスワードの再設定",
+		Body: fmt.Sprintf("%s さん\n\n下記のリンクから新しいパスワードを設定してください。リンクの有効期限は %d 分で、1 回だけ使用できます。\n\n%s\n\nお心当たりが無い場合は、このメールを破棄してください。\n",
+			u.Name, minutes, link),
+	}
+}
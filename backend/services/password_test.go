@@ -0,0 +1,192 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"regexp"
+	"testing"
+	"time"
+
+	"todo-app/app_errors"
+	"todo-app/ent"
+	"todo-app/testutils"
+	"todo-app/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var resetURLPattern = regexp.MustCompile(`https://example\.com/reset-password\?token=(\S+)`)
+
+func isBcryptOf(password string) interface{} {
+	return mock.MatchedBy(func(hash string) bool {
+		return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+	})
+}
+
+func TestPasswordService_ChangePassword(t *testing.T) {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	user := &ent.User{ID: 1, Password: string(hash)}
+	sessionID := uuid.Must(uuid.NewV7())
+	ctx := utils.WithSessionID(utils.WithUser(context.Background(), user), sessionID)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("パスワードを変更し、リクエストに使ったセッション以外を失効させること", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		sessionRepo := new(testutils.MockSessionRepository)
+		userRepo.On("UpdatePassword", mock.Anything, 1, isBcryptOf("newpassword456")).Return(nil).Once()
+		sessionRepo.On("RevokeAllByUserID", mock.Anything, 1, &sessionID).Return(2, nil).Once()
+		service := NewPasswordService(logger, userRepo, sessionRepo, new(testutils.MockPersonalAccessTokenRepository), new(testutils.MockPasswordResetTokenRepository), new(testutils.MockMailer))
+
+		err := service.ChangePassword(ctx, "password123", "newpassword456")
+
+		assert.NoError(t, err)
+		userRepo.AssertExpectations(t)
+		sessionRepo.AssertExpectations(t)
+	})
+
+	t.Run("現在のパスワードが誤っている場合、変更せずエラーを返すこと", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		sessionRepo := new(testutils.MockSessionRepository)
+		service := NewPasswordService(logger, userRepo, sessionRepo, new(testutils.MockPersonalAccessTokenRepository), new(testutils.MockPasswordResetTokenRepository), new(testutils.MockMailer))
+
+		err := service.ChangePassword(ctx, "wrong", "newpassword456")
+
+		assert.ErrorIs(t, err, app_errors.ErrInvalidCurrentPassword)
+		userRepo.AssertNotCalled(t, "UpdatePassword")
+		sessionRepo.AssertNotCalled(t, "RevokeAllByUserID")
+	})
+}
+
+func TestPasswordService_RequestReset(t *testing.T) {
+	t.Setenv("PASSWORD_RESET_URL", "https://example.com/reset-password")
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("トークンのハッシュを保存し、再設定用のリンクをメールで送信すること", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		resetRepo := new(testutils.MockPasswordResetTokenRepository)
+		mailer := new(testutils.MockMailer)
+		user := &ent.User{ID: 1, Name: "test", Email: "user@example.com", Locale: "en"}
+		userRepo.On("FindByEmail", mock.Anything, "user@example.com").Return(user, nil).Once()
+		resetRepo.On("Create", mock.Anything, 1, mock.Anything, mock.Anything).Return(&ent.PasswordResetToken{}, nil).Once()
+		sent := make(chan utils.Mail, 1)
+		mailer.On("Send", mock.Anything, mock.Anything).Return(nil).Once().Run(func(args mock.Arguments) {
+			sent <- args.Get(1).(utils.Mail)
+		})
+		service := NewPasswordService(logger, userRepo, new(testutils.MockSessionRepository), new(testutils.MockPersonalAccessTokenRepository), resetRepo, mailer)
+
+		err := service.RequestReset(context.Background(), "user@example.com")
+
+		assert.NoError(t, err)
+		// メールは応答を返した後に非同期で送信する
+		var mail utils.Mail
+		select {
+		case mail = <-sent:
+		case <-time.After(time.Second):
+			t.Fatal("password reset mail was not sent")
+		}
+		assert.Equal(t, "user@example.com", mail.To)
+		assert.Equal(t, "Reset your password", mail.Subject)
+		match := resetURLPattern.FindStringSubmatch(mail.Body)
+		if assert.NotNil(t, match) {
+			resetRepo.AssertCalled(t, "Create", mock.Anything, 1, hashToken(match[1]), mock.Anything)
+		}
+		expiresAt := resetRepo.Calls[0].Arguments.Get(3).(time.Time)
+		assert.WithinDuration(t, time.Now().Add(PasswordResetTokenLifetime), expiresAt, time.Minute)
+	})
+
+	t.Run("未登録のメールアドレスの場合、エラーを返さずメールも送信しないこと", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		resetRepo := new(testutils.MockPasswordResetTokenRepository)
+		mailer := new(testutils.MockMailer)
+		userRepo.On("FindByEmail", mock.Anything, "unknown@example.com").Return(nil, &ent.NotFoundError{}).Once()
+		service := NewPasswordService(logger, userRepo, new(testutils.MockSessionRepository), new(testutils.MockPersonalAccessTokenRepository), resetRepo, mailer)
+
+		err := service.RequestReset(context.Background(), "unknown@example.com")
+
+		assert.NoError(t, err)
+		resetRepo.AssertNotCalled(t, "Create")
+		mailer.AssertNotCalled(t, "Send")
+	})
+
+	t.Run("メールの送信に失敗した場合も、登録済みと推測されないようエラーを返さないこと", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		resetRepo := new(testutils.MockPasswordResetTokenRepository)
+		mailer := new(testutils.MockMailer)
+		userRepo.On("FindByEmail", mock.Anything, "user@example.com").Return(&ent.User{ID: 1, Email: "user@example.com"}, nil).Once()
+		resetRepo.On("Create", mock.Anything, 1, mock.Anything, mock.Anything).Return(&ent.PasswordResetToken{}, nil).Once()
+		done := make(chan struct{})
+		mailer.On("Send", mock.Anything, mock.Anything).Return(errors.New("smtp unavailable")).Once().Run(func(mock.Arguments) {
+			close(done)
+		})
+		service := NewPasswordService(logger, userRepo, new(testutils.MockSessionRepository), new(testutils.MockPersonalAccessTokenRepository), resetRepo, mailer)
+
+		err := service.RequestReset(context.Background(), "user@example.com")
+
+		assert.NoError(t, err)
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("password reset mail was not sent")
+		}
+	})
+}
+
+func TestPasswordService_ResetPassword(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("パスワードを再設定し、全てのセッションとアクセストークンを失効させること", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		sessionRepo := new(testutils.MockSessionRepository)
+		patRepo := new(testutils.MockPersonalAccessTokenRepository)
+		resetRepo := new(testutils.MockPasswordResetTokenRepository)
+		resetRepo.On("Consume", mock.Anything, hashToken("reset-token")).
+			Return(&ent.PasswordResetToken{UserID: 1, ExpiresAt: time.Now().Add(time.Minute)}, true, nil).Once()
+		userRepo.On("UpdatePassword", mock.Anything, 1, isBcryptOf("newpassword456")).Return(nil).Once()
+		userRepo.On("MarkEmailVerified", mock.Anything, 1).Return(nil).Once()
+		sessionRepo.On("RevokeAllByUserID", mock.Anything, 1, (*uuid.UUID)(nil)).Return(3, nil).Once()
+		patRepo.On("RevokeAllByUserID", mock.Anything, 1).Return(1, nil).Once()
+		service := NewPasswordService(logger, userRepo, sessionRepo, patRepo, resetRepo, new(testutils.MockMailer))
+
+		err := service.ResetPassword(context.Background(), "reset-token", "newpassword456")
+
+		assert.NoError(t, err)
+		userRepo.AssertExpectations(t)
+		sessionRepo.AssertExpectations(t)
+		patRepo.AssertExpectations(t)
+	})
+
+	tests := []struct {
+		name     string
+		token    *ent.PasswordResetToken
+		consumed bool
+		err      error
+	}{
+		{name: "使用済みのトークンの場合、エラーを返すこと", token: &ent.PasswordResetToken{UserID: 1, ExpiresAt: time.Now().Add(time.Minute)}, consumed: false},
+		{name: "期限切れのトークンの場合、エラーを返すこと", token: &ent.PasswordResetToken{UserID: 1, ExpiresAt: time.Now().Add(-time.Second)}, consumed: true},
+		{name: "存在しないトークンの場合、エラーを返すこと", err: &ent.NotFoundError{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := new(MockUserRepository)
+			sessionRepo := new(testutils.MockSessionRepository)
+			resetRepo := new(testutils.MockPasswordResetTokenRepository)
+			if tt.token != nil {
+				resetRepo.On("Consume", mock.Anything, hashToken("reset-token")).Return(tt.token, tt.consumed, tt.err).Once()
+			} else {
+				resetRepo.On("Consume", mock.Anything, hashToken("reset-token")).Return(nil, false, tt.err).Once()
+			}
+			service := NewPasswordService(logger, userRepo, sessionRepo, new(testutils.MockPersonalAccessTokenRepository), resetRepo, new(testutils.MockMailer))
+
+			err := service.ResetPassword(context.Background(), "reset-token", "newpassword456")
+
+			assert.ErrorIs(t, err, app_errors.ErrInvalidResetToken)
+			userRepo.AssertNotCalled(t, "UpdatePassword")
+			sessionRepo.AssertNotCalled(t, "RevokeAllByUserID")
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"todo-app/dto"
+	"todo-app/ent"
+	"todo-app/repositories"
+	"todo-app/utils"
+
+	"github.com/google/uuid"
+)
+
+// パーソナルアクセストークンで許可する操作
+const (
+	// GET など参照のみのリクエスト
+	TokenScopeRead = "read"
+	// 作成・更新・削除のリクエスト
+	TokenScopeWrite = "write"
+	// AI を呼び出すリクエスト。read / write に加えて必要
+	TokenScopeAI = "ai"
+)
+
+// 発行するトークンの接頭辞。漏洩した場合にシークレットスキャンなどで検出しやすくする
+const personalAccessTokenPrefix = "todo_pat_"
+
+// 一覧で表示するトークンの先頭部分の長さ (接頭辞を含む)
+const personalAccessTokenDisplayLength = 16
+
+// パーソナルアクセストークンの最終使用日時を更新する間隔。リクエストごとに書き込まないようにする
+const personalAccessTokenTouchInterval = time.Minute
+
+type PersonalAccessTokenService struct {
+	repo repositories.IPersonalAccessTokenRepository
+}
+
+func NewPersonalAccessTokenService(repo repositories.IPersonalAccessTokenRepository) *PersonalAccessTokenService {
+	return &PersonalAccessTokenService{repo: repo}
+}
+
+// Create はトークンを発行する。expiresIn が nil の場合は無期限
+func (s *PersonalAccessTokenService) Create(ctx context.Context, name string, scopes []string, expiresIn *time.Duration) (*dto.CreatedPersonalAccessTokenDto, error) {
+	random, err := newRandomToken()
+	if err != nil {
+		return nil, err
+	}
+	token := personalAccessTokenPrefix + random
+
+	var expiresAt *time.Time
+	if expiresIn != nil {
+		t := time.Now().Add(*expiresIn)
+		expiresAt = &t
+	}
+
+	pat, err := s.repo.Create(ctx, name, hashToken(token), token[:personalAccessTokenDisplayLength], scopes, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.CreatedPersonalAccessTokenDto{
+		PersonalAccessTokenDto: toPersonalAccessTokenDto(pat),
+		Token:                  token,
+	}, nil
+}
+
+func (s *PersonalAccessTokenService) List(ctx context.Context) ([]dto.PersonalAccessTokenDto, error) {
+	pats, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]dto.PersonalAccessTokenDto, len(pats))
+	for i, pat := range pats {
+		res[i] = toPersonalAccessTokenDto(pat)
+	}
+	return res, nil
+}
+
+// Revoke はトークンを失効させる。他のユーザーのトークンは NotFoundError になる
+func (s *PersonalAccessTokenService) Revoke(ctx context.Context, id uuid.UUID) error {
+	return s.repo.Revoke(ctx, id)
+}
+
+// Authenticate は Authorization ヘッダーのトークンを検証する。
+// 存在しない、失効した、または期限切れのトークンの場合は NotFoundError を返す
+func (s *PersonalAccessTokenService) Authenticate(ctx context.Context, token string) (*ent.PersonalAccessToken, error) {
+	pat, err := s.repo.FindActiveByHash(ctx, hashToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if pat.LastUsedAt == nil || time.Since(*pat.LastUsedAt) >= personalAccessTokenTouchInterval {
+		if err := s.repo.Touch(ctx, pat.ID); err != nil {
+			return nil, err
+		}
+	}
+	return pat, nil
+}
+
+func toPersonalAccessTokenDto(pat *ent.PersonalAccessToken) dto.PersonalAccessTokenDto {
+	return dto.PersonalAccessTokenDto{
+		ID:          pat.ID.String(),
+		Name:        pat.Name,
+		TokenPrefix: pat.TokenPrefix,
+		Scopes:      pat.Scopes,
+		ExpiresAt:   pat.ExpiresAt,
+		LastUsedAt:  pat.LastUsedAt,
+		CreatedAt:   pat.CreatedAt,
+	}
+}
+
+// hasTokenScope はパーソナルアクセストークンで認証したリクエストが scope を持つか返す。Cookie で認証した場合は常に true を返す
+func hasTokenScope(ctx context.Context, scope string) bool {
+	scopes, ok := utils.TokenScopesFromContext(ctx)
+	return !ok || slices.Contains(scopes, scope)
+}
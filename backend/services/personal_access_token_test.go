@@ -0,0 +1,92 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"todo-app/ent"
+	"todo-app/testutils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPersonalAccessTokenService_Create(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		repo := new(testutils.MockPersonalAccessTokenRepository)
+		s := NewPersonalAccessTokenService(repo)
+		expiresIn := 7 * 24 * time.Hour
+		repo.On("Create", mock.Anything, "script", mock.Anything, mock.Anything, []string{TokenScopeRead}, mock.Anything).
+			Return(&ent.PersonalAccessToken{ID: uuid.Must(uuid.NewV7()), Name: "script"}, nil).Once()
+
+		res, err := s.Create(context.Background(), "script", []string{TokenScopeRead}, &expiresIn)
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(res.Token, "todo_pat_"))
+
+		// トークン自体ではなくハッシュと先頭部分を保存すること
+		args := repo.Calls[0].Arguments
+		assert.Equal(t, hashToken(res.Token), args.String(2))
+		assert.Equal(t, res.Token[:16], args.String(3))
+		expiresAt := args.Get(5).(*time.Time)
+		if assert.NotNil(t, expiresAt) {
+			assert.WithinDuration(t, time.Now().Add(expiresIn), *expiresAt, time.Minute)
+		}
+	})
+
+	t.Run("NoExpiry", func(t *testing.T) {
+		repo := new(testutils.MockPersonalAccessTokenRepository)
+		s := NewPersonalAccessTokenService(repo)
+		repo.On("Create", mock.Anything, "script", mock.Anything, mock.Anything, []string{TokenScopeRead}, (*time.Time)(nil)).
+			Return(&ent.PersonalAccessToken{ID: uuid.Must(uuid.NewV7())}, nil).Once()
+
+		_, err := s.Create(context.Background(), "script", []string{TokenScopeRead}, nil)
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestPersonalAccessTokenService_Authenticate(t *testing.T) {
+	ago := func(d time.Duration) *time.Time {
+		t := time.Now().Add(-d)
+		return &t
+	}
+	tests := []struct {
+		name       string
+		lastUsedAt *time.Time
+		touch      bool
+	}{
+		{name: "FirstUse", lastUsedAt: nil, touch: true},
+		{name: "RecentlyUsed", lastUsedAt: ago(10 * time.Second), touch: false},
+		{name: "UsedLongAgo", lastUsedAt: ago(time.Hour), touch: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(testutils.MockPersonalAccessTokenRepository)
+			s := NewPersonalAccessTokenService(repo)
+			pat := &ent.PersonalAccessToken{ID: uuid.Must(uuid.NewV7()), LastUsedAt: tt.lastUsedAt}
+			repo.On("FindActiveByHash", mock.Anything, hashToken("todo_pat_token")).Return(pat, nil).Once()
+			repo.On("Touch", mock.Anything, pat.ID).Return(nil).Maybe()
+
+			res, err := s.Authenticate(context.Background(), "todo_pat_token")
+			assert.NoError(t, err)
+			assert.Equal(t, pat, res)
+			if tt.touch {
+				repo.AssertCalled(t, "Touch", mock.Anything, pat.ID)
+			} else {
+				repo.AssertNotCalled(t, "Touch", mock.Anything, mock.Anything)
+			}
+		})
+	}
+
+	t.Run("NotFound", func(t *testing.T) {
+		repo := new(testutils.MockPersonalAccessTokenRepository)
+		s := NewPersonalAccessTokenService(repo)
+		repo.On("FindActiveByHash", mock.Anything, mock.Anything).Return(nil, &ent.NotFoundError{}).Once()
+
+		_, err := s.Authenticate(context.Background(), "todo_pat_unknown")
+		assert.True(t, ent.IsNotFound(err))
+	})
+}
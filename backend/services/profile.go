@@ -0,0 +1,370 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"todo-app/app_errors"
+	"todo-app/dto"
+	"todo-app/ent"
+	"todo-app/prompts"
+	"todo-app/repositories"
+	"todo-app/utils"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// メールアドレスの変更を確認するトークンの有効期限
+const EmailChangeTokenLifetime = 24 * time.Hour
+
+// 変更前のメールアドレスに送信したリンクから、変更を取り消せる期間。確認した後も取り消せるよう、確認の有効期限より長くする
+const EmailChangeCancelLifetime = 7 * 24 * time.Hour
+
+// パスワードを持たないユーザーが、変更前のメールアドレスで本人であることを確認するトークンの有効期限
+const EmailChangeReauthenticationTokenLifetime = time.Hour
+
+// ProfileService はログイン中のユーザーのプロフィールと設定を扱う
+type ProfileService struct {
+	userRepo        repositories.IUserRepository
+	sessionRepo     repositories.ISessionRepository
+	patRepo         repositories.IPersonalAccessTokenRepository
+	emailChangeRepo repositories.IEmailChangeTokenRepository
+	mailer          utils.IMailer
+}
+
+func NewProfileService(userRepo repositories.IUserRepository, sessionRepo repositories.ISessionRepository, patRepo repositories.IPersonalAccessTokenRepository, emailChangeRepo repositories.IEmailChangeTokenRepository, mailer utils.IMailer) *ProfileService {
+	return &ProfileService{
+		userRepo:        userRepo,
+		sessionRepo:     sessionRepo,
+		patRepo:         patRepo,
+		emailChangeRepo: emailChangeRepo,
+		mailer:          mailer,
+	}
+}
+
+// Get はログイン中のユーザーのプロフィールを返す
+func (s *ProfileService) Get(ctx context.Context) (*dto.MeDto, error) {
+	u, ok := utils.UserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("user not found in context")
+	}
+	return s.toDto(ctx, u)
+}
+
+// Update はプロフィールを変更する。
+// メールアドレスはすぐには変更せず、新しいメールアドレスに確認のメールを送信し、ConfirmEmailChange で確認した時点で変更する。
+// メールアドレスを変更する場合は、セッションを盗まれただけでアカウントを乗っ取られないよう、現在のパスワードを確認する。
+// パスワードを持たないユーザーは RequestEmailChange でメールを受け取り、AuthorizeEmailChange で申請する
+func (s *ProfileService) Update(ctx context.Context, input *dto.UpdateProfileInput) (*dto.MeDto, error) {
+	u, ok := utils.UserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("user not found in context")
+	}
+
+	// パスワードが誤っている場合や登録済みのメールアドレスの場合は、他の項目も変更せずにエラーを返す
+	if input.Email != nil {
+		if email := strings.TrimSpace(*input.Email); !strings.EqualFold(email, u.Email) {
+			if err := bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(input.CurrentPassword)); err != nil {
+				return nil, app_errors.ErrInvalidCurrentPassword
+			}
+			if err := s.ensureEmailAvailable(ctx, email); err != nil {
+				return nil, err
+			}
+			// メールを送信できなかった場合も他の項目を変更しないよう、プロフィールを保存する前に送信する
+			if err := s.requestEmailChange(ctx, u, email); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	updated, err := s.userRepo.UpdateProfile(ctx, u.ID, repositories.UserProfileUpdate{
+		Name:            input.Name,
+		Locale:          input.Locale,
+		TimeZone:        input.TimeZone,
+		TodoPageSize:    input.TodoPageSize,
+		TodoIncludeDone: input.TodoIncludeDone,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.toDto(ctx, updated)
+}
+
+// RequestEmailChange は変更前のメールアドレスに、メールアドレスの変更を確認するリンクを送信する。
+// OpenID Connect で登録したユーザーはパスワードを知らないため、パスワードの代わりにメールを受信できることで本人であることを確認する
+func (s *ProfileService) RequestEmailChange(ctx context.Context, email string) error {
+	u, ok := utils.UserFromContext(ctx)
+	if !ok {
+		return errors.New("user not found in context")
+	}
+	email = strings.TrimSpace(email)
+	if strings.EqualFold(email, u.Email) {
+		return nil
+	}
+	if err := s.ensureEmailAvailable(ctx, email); err != nil {
+		return err
+	}
+
+	token, err := newRandomToken()
+	if err != nil {
+		return err
+	}
+	if _, err := s.emailChangeRepo.CreateReauthentication(ctx, u.ID, u.Email, email, hashToken(token), time.Now().Add(EmailChangeReauthenticationTokenLifetime)); err != nil {
+		return err
+	}
+	return s.mailer.Send(ctx, emailChangeReauthenticationMail(u, email, token))
+}
+
+// AuthorizeEmailChange は変更前のメールアドレスに送信したトークンを確認してから、新しいメールアドレスに確認のメールを送信する。
+// 漏洩したリンクだけで変更を申請させられないよう、トークンを発行したユーザー本人のセッションからのみ受け付ける
+func (s *ProfileService) AuthorizeEmailChange(ctx context.Context, token string) (*dto.MeDto, error) {
+	u, ok := utils.UserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("user not found in context")
+	}
+	if token == "" {
+		return nil, app_errors.ErrInvalidEmailChangeToken
+	}
+
+	ect, consumed, err := s.emailChangeRepo.Consume(ctx, hashToken(token), repositories.EmailChangePurposeReauthenticate)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, app_errors.ErrInvalidEmailChangeToken
+		}
+		return nil, err
+	}
+	if !consumed || !time.Now().Before(ect.ExpiresAt) || ect.UserID != u.ID || !strings.EqualFold(ect.OldEmail, u.Email) {
+		return nil, app_errors.ErrInvalidEmailChangeToken
+	}
+	if err := s.ensureEmailAvailable(ctx, ect.NewEmail); err != nil {
+		return nil, err
+	}
+
+	if err := s.requestEmailChange(ctx, u, ect.NewEmail); err != nil {
+		return nil, err
+	}
+	return s.toDto(ctx, u)
+}
+
+// ensureEmailAvailable は他のユーザーが登録していないメールアドレスであることを確認する
+func (s *ProfileService) ensureEmailAvailable(ctx context.Context, email string) error {
+	if _, err := s.userRepo.FindByEmail(ctx, email); err == nil {
+		return app_errors.ErrEmailAlreadyRegistered
+	} else if !ent.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// requestEmailChange は新しいメールアドレスに確認のメールを送信する。
+// 変更前のメールアドレスにも、本人が申請していない場合に取り消すためのリンクを送信する。
+// 確認のリンクだけが届いて取り消しのリンクが届かないことが無いよう、取り消しのリンクを先に送信する
+func (s *ProfileService) requestEmailChange(ctx context.Context, u *ent.User, email string) error {
+	token, err := newRandomToken()
+	if err != nil {
+		return err
+	}
+	cancelToken, err := newRandomToken()
+	if err != nil {
+		return err
+	}
+	if _, err := s.emailChangeRepo.Create(ctx, u.ID, u.Email, email, hashToken(token), hashToken(cancelToken), time.Now().Add(EmailChangeTokenLifetime)); err != nil {
+		return err
+	}
+	if err := s.mailer.Send(ctx, emailChangeNoticeMail(u, email, cancelToken)); err != nil {
+		return err
+	}
+	return s.mailer.Send(ctx, emailChangeMail(u, email, token))
+}
+
+// ConfirmEmailChange はメールで送信したトークンを確認し、メールアドレスを変更する。
+// メールのリンクはログインしていない端末で開かれる場合もあるため、トークンだけで確認する
+func (s *ProfileService) ConfirmEmailChange(ctx context.Context, token string) error {
+	if token == "" {
+		return app_errors.ErrInvalidEmailChangeToken
+	}
+
+	ect, consumed, err := s.emailChangeRepo.Consume(ctx, hashToken(token), repositories.EmailChangePurposeConfirm)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return app_errors.ErrInvalidEmailChangeToken
+		}
+		return err
+	}
+	if !consumed || !time.Now().Before(ect.ExpiresAt) {
+		return app_errors.ErrInvalidEmailChangeToken
+	}
+
+	if err := s.userRepo.UpdateEmail(ctx, ect.UserID, ect.NewEmail); err != nil {
+		// 確認までの間に他のユーザーが同じメールアドレスで登録した場合
+		if ent.IsConstraintError(err) {
+			return app_errors.ErrEmailAlreadyRegistered
+		}
+		return err
+	}
+	return nil
+}
+
+// CancelEmailChange は変更前のメールアドレスに送信したトークンを確認し、メールアドレスの変更を取り消す。
+// 確認済みの場合は変更前のメールアドレスに戻す。本人が申請していない変更のため、アカウントを乗っ取られたとみなし、
+// 全てのセッションとパーソナルアクセストークンを失効させる
+func (s *ProfileService) CancelEmailChange(ctx context.Context, token string) error {
+	if token == "" {
+		return app_errors.ErrInvalidEmailChangeToken
+	}
+
+	ect, cancelled, err := s.emailChangeRepo.Cancel(ctx, hashToken(token))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return app_errors.ErrInvalidEmailChangeToken
+		}
+		return err
+	}
+	if !cancelled || !time.Now().Before(ect.CreatedAt.Add(EmailChangeCancelLifetime)) {
+		return app_errors.ErrInvalidEmailChangeToken
+	}
+
+	u, err := s.userRepo.FindById(ctx, ect.UserID)
+	if err != nil {
+		return err
+	}
+	if ect.OldEmail != "" && strings.EqualFold(u.Email, ect.NewEmail) {
+		if err := s.userRepo.UpdateEmail(ctx, u.ID, ect.OldEmail); err != nil {
+			// 取り消すまでの間に他のユーザーが変更前のメールアドレスで登録した場合
+			if ent.IsConstraintError(err) {
+				return app_errors.ErrEmailAlreadyRegistered
+			}
+			return err
+		}
+	}
+
+	if _, err := s.sessionRepo.RevokeAllByUserID(ctx, u.ID, nil); err != nil {
+		return err
+	}
+	if _, err := s.patRepo.RevokeAllByUserID(ctx, u.ID); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *ProfileService) toDto(ctx context.Context, u *ent.User) (*dto.MeDto, error) {
+	res := &dto.MeDto{
+		ID:       u.ID,
+		Name:     u.Name,
+		Email:    u.Email,
+		Locale:   u.Locale,
+		TimeZone: u.TimeZone,
+		Preferences: dto.UserPreferencesDto{
+			TodoPageSize:    u.TodoPageSize,
+			TodoIncludeDone: u.TodoIncludeDone,
+		},
+		DeletionScheduledAt: u.DeletionScheduledAt,
+		CreatedAt:           u.CreatedAt,
+	}
+
+	pending, err := s.emailChangeRepo.FindPending(ctx, u.ID, time.Now())
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if pending != nil {
+		res.PendingEmail = &pending.NewEmail
+	}
+	return res, nil
+}
+
+// emailChangeURL は確認画面の URL にトークンを付与して返す。
+// EMAIL_CHANGE_URL が未指定の場合は FRONTEND_ORIGIN の /confirm-email を使う
+func emailChangeURL(token string) string {
+	base := os.Getenv("EMAIL_CHANGE_URL")
+	if base == "" {
+		base = os.Getenv("FRONTEND_ORIGIN") + "/confirm-email"
+	}
+	return base + "?token=" + url.QueryEscape(token)
+}
+
+func emailChangeMail(u *ent.User, newEmail string, token string) utils.Mail {
+	link := emailChangeURL(token)
+	hours := int(EmailChangeTokenLifetime.Hours())
+
+	if prompts.NormalizeLocale(u.Locale) == prompts.LocaleEn {
+		return utils.Mail{
+			To:      newEmail,
+			Subject: "Confirm your new email address",
+			Body: fmt.Sprintf("Hi %s,\n\nOpen the link below to confirm that you want to use this address for your account. The link expires in %d hours and can be used only once.\n\n%s\n\nIf you did not request this, you can ignore this email.\n",
+				u.Name, hours, link),
+		}
+	}
+	return utils.Mail{
+		To:      newEmail,
+		Subject: "メールアドレスの確認",
+		Body: fmt.Sprintf("%s さん\n\n下記のリンクを開いて、このメールアドレスをアカウントで使用することを確認してください。リンクの有効期限は %d 時間で、1 回だけ使用できます。\n\n%s\n\nお心当たりが無い場合は、このメールを破棄してください。\n",
+			u.Name, hours, link),
+	}
+}
+
+// emailChangeCancelURL は取り消し画面の URL にトークンを付与して返す。
+// EMAIL_CHANGE_CANCEL_URL が未指定の場合は FRONTEND_ORIGIN の /cancel-email-change を使う
+func emailChangeCancelURL(token string) string {
+	base := os.Getenv("EMAIL_CHANGE_CANCEL_URL")
+	if base == "" {
+		base = os.Getenv("FRONTEND_ORIGIN") + "/cancel-email-change"
+	}
+	return base + "?token=" + url.QueryEscape(token)
+}
+
+// emailChangeNoticeMail は変更前のメールアドレスに送信する、メールアドレスの変更の通知
+func emailChangeNoticeMail(u *ent.User, newEmail string, cancelToken string) utils.Mail {
+	link := emailChangeCancelURL(cancelToken)
+	days := int(EmailChangeCancelLifetime.Hours() / 24)
+
+	if prompts.NormalizeLocale(u.Locale) == prompts.LocaleEn {
+		return utils.Mail{
+			To:      u.Email,
+			Subject: "Your email address is being changed",
+			Body: fmt.Sprintf("Hi %s,\n\nA request was made to change the email address of your account to %s.\n\nIf you did not make this request, open the link below within %d days. The change will be cancelled (or reverted if already confirmed) and all devices will be signed out. We also recommend resetting your password.\n\n%s\n\nIf you made this request, you can ignore this email.\n",
+				u.Name, newEmail, days, link),
+		}
+	}
+	return utils.Mail{
+		To:      u.Email,
+		Subject: "メールアドレスの変更のお知らせ",
+		Body: fmt.Sprintf("%s さん\n\nアカウントのメールアドレスを %s に変更する申請がありました。\n\nお心当たりが無い場合は、%d 日以内に下記のリンクを開いてください。変更を取り消し (確認済みの場合は元に戻し)、全ての端末からログアウトします。あわせてパスワードの再設定をお勧めします。\n\n%s\n\nご自身で申請した場合は、このメールを破棄してください。\n",
+			u.Name, newEmail, days, link),
+	}
+}
+
+// emailChangeAuthorizeURL は本人確認の画面の URL にトークンを付与して返す。
+// EMAIL_CHANGE_AUTHORIZE_URL が未指定の場合は FRONTEND_ORIGIN の /authorize-email-change を使う
+func emailChangeAuthorizeURL(token string) string {
+	base := os.Getenv("EMAIL_CHANGE_AUTHORIZE_URL")
+	if base == "" {
+		base = os.Getenv("FRONTEND_ORIGIN") + "/authorize-email-change"
+	}
+	return base + "?token=" + url.QueryEscape(token)
+}
+
+// emailChangeReauthenticationMail は変更前のメールアドレスに送信する、メールアドレスの変更の本人確認
+func emailChangeReauthenticationMail(u *ent.User, newEmail string, token string) utils.Mail {
+	link := emailChangeAuthorizeURL(token)
+	minutes := int(EmailChangeReauthenticationTokenLifetime.Minutes())
+
+	if prompts.NormalizeLocale(u.Locale) == prompts.LocaleEn {
+		return utils.Mail{
+			To:      u.Email,
+			Subject: "Confirm your email address change",
+			Body: fmt.Sprintf("Hi %s,\n\nOpen the link below while logged in to confirm that you want to change the email address of your account to %s. We will then send a confirmation email to the new address. The link expires in %d minutes and can be used only once.\n\n%s\n\nIf you did not request this, you can ignore this email.\n",
+				u.Name, newEmail, minutes, link),
+		}
+	}
+	return utils.Mail{
+		To:      u.Email,
+		Subject: "メールアドレスの変更の確認",
+		Body: fmt.Sprintf("%s さん\n\nアカウントのメールアドレスを %s に変更する場合は、ログインした状態で下記のリンクを開いてください。その後、新しいメールアドレスに確認のメールを送信します。リンクの有効期限は %d 分で、1 回だけ使用できます。\n\n%s\n\nお心当たりが無い場合は、このメールを破棄してください。\n",
+			u.Name, newEmail, minutes, link),
+	}
+}
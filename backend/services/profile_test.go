@@ -0,0 +1,397 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"todo-app/app_errors"
+	"todo-app/dto"
+	"todo-app/ent"
+	"todo-app/repositories"
+	"todo-app/testutils"
+	"todo-app/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	emailChangeURLPattern       = regexp.MustCompile(`https://example\.com/confirm-email\?token=(\S+)`)
+	emailChangeCancelURLPattern = regexp.MustCompile(`https://example\.com/cancel-email-change\?token=(\S+)`)
+)
+
+func TestProfileService_Update(t *testing.T) {
+	t.Setenv("EMAIL_CHANGE_URL", "https://example.com/confirm-email")
+	t.Setenv("EMAIL_CHANGE_CANCEL_URL", "https://example.com/cancel-email-change")
+	hash, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	user := &ent.User{ID: 1, Name: "test", Email: "user@example.com", Password: string(hash), Locale: "en", TodoPageSize: 20}
+	ctx := utils.WithUser(context.Background(), user)
+
+	t.Run("メールアドレス以外の項目は、パスワードを確認せずに変更すること", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		emailChangeRepo := new(testutils.MockEmailChangeTokenRepository)
+		mailer := new(testutils.MockMailer)
+		name, pageSize := "renamed", 50
+		updated := &ent.User{ID: 1, Name: name, Email: "user@example.com", Locale: "en", TodoPageSize: pageSize}
+		userRepo.On("UpdateProfile", mock.Anything, 1, repositories.UserProfileUpdate{Name: &name, TodoPageSize: &pageSize}).Return(updated, nil).Once()
+		emailChangeRepo.On("FindPending", mock.Anything, 1, mock.Anything).Return(nil, &ent.NotFoundError{}).Once()
+		service := NewProfileService(userRepo, new(testutils.MockSessionRepository), new(testutils.MockPersonalAccessTokenRepository), emailChangeRepo, mailer)
+
+		res, err := service.Update(ctx, &dto.UpdateProfileInput{Name: &name, TodoPageSize: &pageSize})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "renamed", res.Name)
+		assert.Equal(t, 50, res.Preferences.TodoPageSize)
+		assert.Nil(t, res.PendingEmail)
+		mailer.AssertNotCalled(t, "Send")
+	})
+
+	t.Run("メールアドレスを変更する場合、変更前のメールアドレスに取り消しを、新しいメールアドレスに確認のリンクを送信すること", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		emailChangeRepo := new(testutils.MockEmailChangeTokenRepository)
+		mailer := new(testutils.MockMailer)
+		newEmail := "new@example.com"
+		userRepo.On("FindByEmail", mock.Anything, newEmail).Return(nil, &ent.NotFoundError{}).Once()
+		userRepo.On("UpdateProfile", mock.Anything, 1, repositories.UserProfileUpdate{}).Return(user, nil).Once()
+		emailChangeRepo.On("Create", mock.Anything, 1, "user@example.com", newEmail, mock.Anything, mock.Anything, mock.Anything).Return(&ent.EmailChangeToken{}, nil).Once()
+		emailChangeRepo.On("FindPending", mock.Anything, 1, mock.Anything).Return(&ent.EmailChangeToken{NewEmail: newEmail}, nil).Once()
+		mailer.On("Send", mock.Anything, mock.Anything).Return(nil).Twice()
+		service := NewProfileService(userRepo, new(testutils.MockSessionRepository), new(testutils.MockPersonalAccessTokenRepository), emailChangeRepo, mailer)
+
+		res, err := service.Update(ctx, &dto.UpdateProfileInput{Email: &newEmail, CurrentPassword: "password123"})
+
+		assert.NoError(t, err)
+		// 確認されるまではメールアドレスを変更しない
+		assert.Equal(t, "user@example.com", res.Email)
+		assert.Equal(t, &newEmail, res.PendingEmail)
+		userRepo.AssertNotCalled(t, "UpdateEmail")
+		// 確認のリンクだけが届くことが無いよう、取り消しのリンクを先に送信していること
+		noticeMail := mailer.Calls[0].Arguments.Get(1).(utils.Mail)
+		assert.Equal(t, "user@example.com", noticeMail.To)
+		// 確認のメールは新しいメールアドレスに送信し、リンクに含まれるトークンのハッシュを保存していること
+		confirmMail := mailer.Calls[1].Arguments.Get(1).(utils.Mail)
+		assert.Equal(t, newEmail, confirmMail.To)
+		assert.Contains(t, noticeMail.Body, newEmail)
+		confirmMatch := emailChangeURLPattern.FindStringSubmatch(confirmMail.Body)
+		cancelMatch := emailChangeCancelURLPattern.FindStringSubmatch(noticeMail.Body)
+		if assert.NotNil(t, confirmMatch) && assert.NotNil(t, cancelMatch) {
+			emailChangeRepo.AssertCalled(t, "Create", mock.Anything, 1, "user@example.com", newEmail, hashToken(confirmMatch[1]), hashToken(cancelMatch[1]), mock.Anything)
+		}
+		expiresAt := emailChangeRepo.Calls[0].Arguments.Get(6).(time.Time)
+		assert.WithinDuration(t, time.Now().Add(EmailChangeTokenLifetime), expiresAt, time.Minute)
+	})
+
+	t.Run("メールアドレスを変更する場合、現在のパスワードが誤っていれば他の項目も変更しないこと", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			password string
+		}{
+			{name: "パスワードが誤っている場合", password: "wrong"},
+			{name: "パスワードを指定しない場合", password: ""},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				userRepo := new(MockUserRepository)
+				emailChangeRepo := new(testutils.MockEmailChangeTokenRepository)
+				mailer := new(testutils.MockMailer)
+				name, newEmail := "renamed", "new@example.com"
+				service := NewProfileService(userRepo, new(testutils.MockSessionRepository), new(testutils.MockPersonalAccessTokenRepository), emailChangeRepo, mailer)
+
+				_, err := service.Update(ctx, &dto.UpdateProfileInput{Name: &name, Email: &newEmail, CurrentPassword: tt.password})
+
+				assert.ErrorIs(t, err, app_errors.ErrInvalidCurrentPassword)
+				userRepo.AssertNotCalled(t, "FindByEmail")
+				userRepo.AssertNotCalled(t, "UpdateProfile")
+				emailChangeRepo.AssertNotCalled(t, "Create")
+				mailer.AssertNotCalled(t, "Send")
+			})
+		}
+	})
+
+	t.Run("現在と同じメールアドレスの場合、パスワードを確認せず変更もしないこと", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		emailChangeRepo := new(testutils.MockEmailChangeTokenRepository)
+		mailer := new(testutils.MockMailer)
+		email := "User@Example.com"
+		userRepo.On("UpdateProfile", mock.Anything, 1, repositories.UserProfileUpdate{}).Return(user, nil).Once()
+		emailChangeRepo.On("FindPending", mock.Anything, 1, mock.Anything).Return(nil, &ent.NotFoundError{}).Once()
+		service := NewProfileService(userRepo, new(testutils.MockSessionRepository), new(testutils.MockPersonalAccessTokenRepository), emailChangeRepo, mailer)
+
+		_, err := service.Update(ctx, &dto.UpdateProfileInput{Email: &email})
+
+		assert.NoError(t, err)
+		emailChangeRepo.AssertNotCalled(t, "Create")
+		mailer.AssertNotCalled(t, "Send")
+	})
+
+	t.Run("メールを送信できなかった場合、他の項目も変更しないこと", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			failAfter int
+		}{
+			{name: "取り消しのメールを送信できなかった場合", failAfter: 0},
+			{name: "確認のメールを送信できなかった場合", failAfter: 1},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				userRepo := new(MockUserRepository)
+				emailChangeRepo := new(testutils.MockEmailChangeTokenRepository)
+				mailer := new(testutils.MockMailer)
+				name, newEmail := "renamed", "new@example.com"
+				userRepo.On("FindByEmail", mock.Anything, newEmail).Return(nil, &ent.NotFoundError{}).Once()
+				emailChangeRepo.On("Create", mock.Anything, 1, "user@example.com", newEmail, mock.Anything, mock.Anything, mock.Anything).Return(&ent.EmailChangeToken{}, nil).Once()
+				if tt.failAfter > 0 {
+					mailer.On("Send", mock.Anything, mock.Anything).Return(nil).Times(tt.failAfter)
+				}
+				mailer.On("Send", mock.Anything, mock.Anything).Return(errors.New("smtp unavailable")).Once()
+				service := NewProfileService(userRepo, new(testutils.MockSessionRepository), new(testutils.MockPersonalAccessTokenRepository), emailChangeRepo, mailer)
+
+				_, err := service.Update(ctx, &dto.UpdateProfileInput{Name: &name, Email: &newEmail, CurrentPassword: "password123"})
+
+				assert.Error(t, err)
+				userRepo.AssertNotCalled(t, "UpdateProfile")
+				// 取り消しのメールを送信できなかった場合は、確認のメールも送信しない
+				mailer.AssertNumberOfCalls(t, "Send", tt.failAfter+1)
+			})
+		}
+	})
+
+	t.Run("登録済みのメールアドレスの場合、他の項目も変更せずエラーを返すこと", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		mailer := new(testutils.MockMailer)
+		name, taken := "renamed", "taken@example.com"
+		userRepo.On("FindByEmail", mock.Anything, taken).Return(&ent.User{ID: 2, Email: taken}, nil).Once()
+		service := NewProfileService(userRepo, new(testutils.MockSessionRepository), new(testutils.MockPersonalAccessTokenRepository), new(testutils.MockEmailChangeTokenRepository), mailer)
+
+		_, err := service.Update(ctx, &dto.UpdateProfileInput{Name: &name, Email: &taken, CurrentPassword: "password123"})
+
+		assert.ErrorIs(t, err, app_errors.ErrEmailAlreadyRegistered)
+		userRepo.AssertNotCalled(t, "UpdateProfile")
+		mailer.AssertNotCalled(t, "Send")
+	})
+}
+
+func TestProfileService_ConfirmEmailChange(t *testing.T) {
+	t.Run("トークンを使用済みにしてメールアドレスを変更すること", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		emailChangeRepo := new(testutils.MockEmailChangeTokenRepository)
+		ect := &ent.EmailChangeToken{UserID: 1, NewEmail: "new@example.com", ExpiresAt: time.Now().Add(time.Hour)}
+		emailChangeRepo.On("Consume", mock.Anything, hashToken("token"), repositories.EmailChangePurposeConfirm).Return(ect, true, nil).Once()
+		userRepo.On("UpdateEmail", mock.Anything, 1, "new@example.com").Return(nil).Once()
+		service := NewProfileService(userRepo, new(testutils.MockSessionRepository), new(testutils.MockPersonalAccessTokenRepository), emailChangeRepo, new(testutils.MockMailer))
+
+		err := service.ConfirmEmailChange(context.Background(), "token")
+
+		assert.NoError(t, err)
+		userRepo.AssertExpectations(t)
+	})
+
+	tests := []struct {
+		name     string
+		ect      *ent.EmailChangeToken
+		consumed bool
+		err      error
+	}{
+		{name: "存在しないトークンの場合、エラーを返すこと", err: &ent.NotFoundError{}},
+		{name: "使用済みのトークンの場合、エラーを返すこと", ect: &ent.EmailChangeToken{UserID: 1, ExpiresAt: time.Now().Add(time.Hour)}},
+		{name: "期限切れのトークンの場合、エラーを返すこと", ect: &ent.EmailChangeToken{UserID: 1, ExpiresAt: time.Now().Add(-time.Second)}, consumed: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := new(MockUserRepository)
+			emailChangeRepo := new(testutils.MockEmailChangeTokenRepository)
+			emailChangeRepo.On("Consume", mock.Anything, hashToken("token"), repositories.EmailChangePurposeConfirm).Return(tt.ect, tt.consumed, tt.err).Once()
+			service := NewProfileService(userRepo, new(testutils.MockSessionRepository), new(testutils.MockPersonalAccessTokenRepository), emailChangeRepo, new(testutils.MockMailer))
+
+			err := service.ConfirmEmailChange(context.Background(), "token")
+
+			assert.ErrorIs(t, err, app_errors.ErrInvalidEmailChangeToken)
+			userRepo.AssertNotCalled(t, "UpdateEmail")
+		})
+	}
+
+	t.Run("確認までの間に他のユーザーが登録したメールアドレスの場合、エラーを返すこと", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		emailChangeRepo := new(testutils.MockEmailChangeTokenRepository)
+		ect := &ent.EmailChangeToken{UserID: 1, NewEmail: "new@example.com", ExpiresAt: time.Now().Add(time.Hour)}
+		emailChangeRepo.On("Consume", mock.Anything, hashToken("token"), repositories.EmailChangePurposeConfirm).Return(ect, true, nil).Once()
+		userRepo.On("UpdateEmail", mock.Anything, 1, "new@example.com").Return(&ent.ConstraintError{}).Once()
+		service := NewProfileService(userRepo, new(testutils.MockSessionRepository), new(testutils.MockPersonalAccessTokenRepository), emailChangeRepo, new(testutils.MockMailer))
+
+		err := service.ConfirmEmailChange(context.Background(), "token")
+
+		assert.ErrorIs(t, err, app_errors.ErrEmailAlreadyRegistered)
+	})
+}
+
+func TestProfileService_RequestEmailChange(t *testing.T) {
+	t.Setenv("EMAIL_CHANGE_AUTHORIZE_URL", "https://example.com/authorize-email-change")
+	user := &ent.User{ID: 1, Name: "test", Email: "user@example.com", Locale: "en"}
+	ctx := utils.WithUser(context.Background(), user)
+
+	t.Run("変更前のメールアドレスに本人確認のリンクを送信し、トークンのハッシュを保存すること", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		emailChangeRepo := new(testutils.MockEmailChangeTokenRepository)
+		mailer := new(testutils.MockMailer)
+		newEmail := "new@example.com"
+		userRepo.On("FindByEmail", mock.Anything, newEmail).Return(nil, &ent.NotFoundError{}).Once()
+		emailChangeRepo.On("CreateReauthentication", mock.Anything, 1, "user@example.com", newEmail, mock.Anything, mock.Anything).Return(&ent.EmailChangeToken{}, nil).Once()
+		mailer.On("Send", mock.Anything, mock.Anything).Return(nil).Once()
+		service := NewProfileService(userRepo, new(testutils.MockSessionRepository), new(testutils.MockPersonalAccessTokenRepository), emailChangeRepo, mailer)
+
+		err := service.RequestEmailChange(ctx, newEmail)
+
+		assert.NoError(t, err)
+		mail := mailer.Calls[0].Arguments.Get(1).(utils.Mail)
+		assert.Equal(t, "user@example.com", mail.To)
+		assert.Contains(t, mail.Body, newEmail)
+		match := regexp.MustCompile(`https://example\.com/authorize-email-change\?token=(\S+)`).FindStringSubmatch(mail.Body)
+		if assert.NotNil(t, match) {
+			emailChangeRepo.AssertCalled(t, "CreateReauthentication", mock.Anything, 1, "user@example.com", newEmail, hashToken(match[1]), mock.Anything)
+		}
+		expiresAt := emailChangeRepo.Calls[0].Arguments.Get(5).(time.Time)
+		assert.WithinDuration(t, time.Now().Add(EmailChangeReauthenticationTokenLifetime), expiresAt, time.Minute)
+	})
+
+	t.Run("登録済みのメールアドレスの場合、エラーを返すこと", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		emailChangeRepo := new(testutils.MockEmailChangeTokenRepository)
+		mailer := new(testutils.MockMailer)
+		userRepo.On("FindByEmail", mock.Anything, "taken@example.com").Return(&ent.User{ID: 2}, nil).Once()
+		service := NewProfileService(userRepo, new(testutils.MockSessionRepository), new(testutils.MockPersonalAccessTokenRepository), emailChangeRepo, mailer)
+
+		err := service.RequestEmailChange(ctx, "taken@example.com")
+
+		assert.ErrorIs(t, err, app_errors.ErrEmailAlreadyRegistered)
+		emailChangeRepo.AssertNotCalled(t, "CreateReauthentication")
+		mailer.AssertNotCalled(t, "Send")
+	})
+}
+
+func TestProfileService_AuthorizeEmailChange(t *testing.T) {
+	user := &ent.User{ID: 1, Name: "test", Email: "user@example.com", Locale: "en"}
+	ctx := utils.WithUser(context.Background(), user)
+
+	t.Run("本人確認のトークンを使用済みにして、新しいメールアドレスに確認のメールを送信すること", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		emailChangeRepo := new(testutils.MockEmailChangeTokenRepository)
+		mailer := new(testutils.MockMailer)
+		ect := &ent.EmailChangeToken{UserID: 1, OldEmail: "user@example.com", NewEmail: "new@example.com", ExpiresAt: time.Now().Add(time.Hour)}
+		emailChangeRepo.On("Consume", mock.Anything, hashToken("token"), repositories.EmailChangePurposeReauthenticate).Return(ect, true, nil).Once()
+		userRepo.On("FindByEmail", mock.Anything, "new@example.com").Return(nil, &ent.NotFoundError{}).Once()
+		emailChangeRepo.On("Create", mock.Anything, 1, "user@example.com", "new@example.com", mock.Anything, mock.Anything, mock.Anything).Return(&ent.EmailChangeToken{}, nil).Once()
+		emailChangeRepo.On("FindPending", mock.Anything, 1, mock.Anything).Return(&ent.EmailChangeToken{NewEmail: "new@example.com"}, nil).Once()
+		mailer.On("Send", mock.Anything, mock.Anything).Return(nil).Twice()
+		service := NewProfileService(userRepo, new(testutils.MockSessionRepository), new(testutils.MockPersonalAccessTokenRepository), emailChangeRepo, mailer)
+
+		res, err := service.AuthorizeEmailChange(ctx, "token")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "user@example.com", res.Email)
+		if assert.NotNil(t, res.PendingEmail) {
+			assert.Equal(t, "new@example.com", *res.PendingEmail)
+		}
+		assert.Equal(t, "new@example.com", mailer.Calls[1].Arguments.Get(1).(utils.Mail).To)
+		userRepo.AssertNotCalled(t, "UpdateEmail")
+	})
+
+	tests := []struct {
+		name     string
+		ect      *ent.EmailChangeToken
+		consumed bool
+		err      error
+	}{
+		{name: "存在しないトークンの場合、エラーを返すこと", err: &ent.NotFoundError{}},
+		{name: "使用済みのトークンの場合、エラーを返すこと", ect: &ent.EmailChangeToken{UserID: 1, OldEmail: "user@example.com", ExpiresAt: time.Now().Add(time.Hour)}},
+		{name: "期限切れのトークンの場合、エラーを返すこと", ect: &ent.EmailChangeToken{UserID: 1, OldEmail: "user@example.com", ExpiresAt: time.Now().Add(-time.Second)}, consumed: true},
+		{name: "他のユーザーのトークンの場合、エラーを返すこと", ect: &ent.EmailChangeToken{UserID: 2, OldEmail: "user@example.com", ExpiresAt: time.Now().Add(time.Hour)}, consumed: true},
+		{name: "申請後にメールアドレスが変わっている場合、エラーを返すこと", ect: &ent.EmailChangeToken{UserID: 1, OldEmail: "old@example.com", ExpiresAt: time.Now().Add(time.Hour)}, consumed: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			emailChangeRepo := new(testutils.MockEmailChangeTokenRepository)
+			mailer := new(testutils.MockMailer)
+			emailChangeRepo.On("Consume", mock.Anything, hashToken("token"), repositories.EmailChangePurposeReauthenticate).Return(tt.ect, tt.consumed, tt.err).Once()
+			service := NewProfileService(new(MockUserRepository), new(testutils.MockSessionRepository), new(testutils.MockPersonalAccessTokenRepository), emailChangeRepo, mailer)
+
+			_, err := service.AuthorizeEmailChange(ctx, "token")
+
+			assert.ErrorIs(t, err, app_errors.ErrInvalidEmailChangeToken)
+			emailChangeRepo.AssertNotCalled(t, "Create")
+			mailer.AssertNotCalled(t, "Send")
+		})
+	}
+}
+
+func TestProfileService_CancelEmailChange(t *testing.T) {
+	t.Run("確認前の変更を取り消し、全てのセッションとアクセストークンを失効させること", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		sessionRepo := new(testutils.MockSessionRepository)
+		patRepo := new(testutils.MockPersonalAccessTokenRepository)
+		emailChangeRepo := new(testutils.MockEmailChangeTokenRepository)
+		ect := &ent.EmailChangeToken{UserID: 1, OldEmail: "user@example.com", NewEmail: "new@example.com", CreatedAt: time.Now()}
+		emailChangeRepo.On("Cancel", mock.Anything, hashToken("cancel-token")).Return(ect, true, nil).Once()
+		userRepo.On("FindById", mock.Anything, 1).Return(&ent.User{ID: 1, Email: "user@example.com"}, nil).Once()
+		sessionRepo.On("RevokeAllByUserID", mock.Anything, 1, (*uuid.UUID)(nil)).Return(2, nil).Once()
+		patRepo.On("RevokeAllByUserID", mock.Anything, 1).Return(1, nil).Once()
+		service := NewProfileService(userRepo, sessionRepo, patRepo, emailChangeRepo, new(testutils.MockMailer))
+
+		err := service.CancelEmailChange(context.Background(), "cancel-token")
+
+		assert.NoError(t, err)
+		userRepo.AssertNotCalled(t, "UpdateEmail")
+		sessionRepo.AssertExpectations(t)
+		patRepo.AssertExpectations(t)
+	})
+
+	t.Run("確認済みの変更は、変更前のメールアドレスに戻すこと", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		sessionRepo := new(testutils.MockSessionRepository)
+		patRepo := new(testutils.MockPersonalAccessTokenRepository)
+		emailChangeRepo := new(testutils.MockEmailChangeTokenRepository)
+		usedAt := time.Now()
+		ect := &ent.EmailChangeToken{UserID: 1, OldEmail: "user@example.com", NewEmail: "new@example.com", UsedAt: &usedAt, CreatedAt: time.Now().Add(-time.Hour)}
+		emailChangeRepo.On("Cancel", mock.Anything, hashToken("cancel-token")).Return(ect, true, nil).Once()
+		userRepo.On("FindById", mock.Anything, 1).Return(&ent.User{ID: 1, Email: "new@example.com"}, nil).Once()
+		userRepo.On("UpdateEmail", mock.Anything, 1, "user@example.com").Return(nil).Once()
+		sessionRepo.On("RevokeAllByUserID", mock.Anything, 1, (*uuid.UUID)(nil)).Return(1, nil).Once()
+		patRepo.On("RevokeAllByUserID", mock.Anything, 1).Return(0, nil).Once()
+		service := NewProfileService(userRepo, sessionRepo, patRepo, emailChangeRepo, new(testutils.MockMailer))
+
+		err := service.CancelEmailChange(context.Background(), "cancel-token")
+
+		assert.NoError(t, err)
+		userRepo.AssertExpectations(t)
+	})
+
+	tests := []struct {
+		name      string
+		ect       *ent.EmailChangeToken
+		cancelled bool
+		err       error
+	}{
+		{name: "存在しないトークンの場合、エラーを返すこと", err: &ent.NotFoundError{}},
+		{name: "取り消し済みのトークンの場合、エラーを返すこと", ect: &ent.EmailChangeToken{UserID: 1, CreatedAt: time.Now()}},
+		{name: "取り消せる期間を過ぎたトークンの場合、エラーを返すこと", ect: &ent.EmailChangeToken{UserID: 1, CreatedAt: time.Now().Add(-EmailChangeCancelLifetime)}, cancelled: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := new(MockUserRepository)
+			sessionRepo := new(testutils.MockSessionRepository)
+			emailChangeRepo := new(testutils.MockEmailChangeTokenRepository)
+			emailChangeRepo.On("Cancel", mock.Anything, hashToken("cancel-token")).Return(tt.ect, tt.cancelled, tt.err).Once()
+			service := NewProfileService(userRepo, sessionRepo, new(testutils.MockPersonalAccessTokenRepository), emailChangeRepo, new(testutils.MockMailer))
+
+			err := service.CancelEmailChange(context.Background(), "cancel-token")
+
+			assert.ErrorIs(t, err, app_errors.ErrInvalidEmailChangeToken)
+			userRepo.AssertNotCalled(t, "UpdateEmail")
+			sessionRepo.AssertNotCalled(t, "RevokeAllByUserID")
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package services
+
+import (
+	"context"
+	"time"
+	"todo-app/prompts"
+	"todo-app/utils"
+
+	// 実行環境にタイムゾーンのデータが無くてもユーザーのタイムゾーンを扱えるようにする
+	_ "time/tzdata"
+)
+
+// PromptSettings はユーザーの設定から決まるプロンプトの言語とタイムゾーン
+type PromptSettings struct {
+	Locale   string
+	Location *time.Location
+	// 指定した場合、現在時刻の代わりに使用する
+	FixedNow time.Time
+}
+
+type promptNowKey struct{}
+
+// WithPromptNow はプロンプトに埋め込む現在時刻を now に固定した context を返す。
+// 評価用のデータセットのように、基準時刻を固定してモデルの応答を比較する場合に使う
+func WithPromptNow(ctx context.Context, now time.Time) context.Context {
+	return context.WithValue(ctx, promptNowKey{}, now)
+}
+
+// PromptSettingsFromContext はリクエストしたユーザーの設定を返す。
+// ユーザーが無い、または設定が不正な場合は既定の言語とサーバーのタイムゾーンを使う
+func PromptSettingsFromContext(ctx context.Context) PromptSettings {
+	settings := PromptSettings{Locale: prompts.DefaultLocale, Location: time.Local}
+	if now, ok := ctx.Value(promptNowKey{}).(time.Time); ok {
+		settings.FixedNow = now
+	}
+	u, ok := utils.UserFromContext(ctx)
+	if !ok {
+		return settings
+	}
+
+	settings.Locale = prompts.NormalizeLocale(u.Locale)
+	if u.TimeZone != "" {
+		if loc, err := time.LoadLocation(u.TimeZone); err == nil {
+			settings.Location = loc
+		}
+	}
+	return settings
+}
+
+// Now はユーザーのタイムゾーンでの現在時刻を返す
+func (s PromptSettings) Now() time.Time {
+	if !s.FixedNow.IsZero() {
+		return s.FixedNow.In(s.Location)
+	}
+	return time.Now().In(s.Location)
+}
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"time"
+	"todo-app/dto"
+	"todo-app/repositories"
+	"todo-app/utils"
+
+	"github.com/google/uuid"
+)
+
+type SessionService struct {
+	repo repositories.ISessionRepository
+}
+
+func NewSessionService(repo repositories.ISessionRepository) *SessionService {
+	return &SessionService{repo: repo}
+}
+
+// CurrentSessionID はリクエストに使われたトークンのセッション ID を返す
+func CurrentSessionID(ctx context.Context) (uuid.UUID, bool) {
+	return utils.SessionIDFromContext(ctx)
+}
+
+func (s *SessionService) List(ctx context.Context) ([]dto.SessionDto, error) {
+	// リフレッシュトークンの有効期限より長く使われていないセッションは、もう再開できないため表示しない
+	sessions, err := s.repo.ListActive(ctx, time.Now().Add(-RefreshTokenLifetime))
+	if err != nil {
+		return nil, err
+	}
+
+	currentID, _ := CurrentSessionID(ctx)
+	res := make([]dto.SessionDto, len(sessions))
+	for i, se := range sessions {
+		res[i] = dto.SessionDto{
+			ID:         se.ID.String(),
+			IPAddress:  se.IPAddress,
+			UserAgent:  se.UserAgent,
+			CreatedAt:  se.CreatedAt,
+			LastSeenAt: se.LastSeenAt,
+			Current:    se.ID == currentID,
+		}
+	}
+	return res, nil
+}
+
+// Revoke は指定したセッションを失効させる。他のユーザーのセッションは NotFoundError になる
+func (s *SessionService) Revoke(ctx context.Context, id uuid.UUID) error {
+	return s.repo.Revoke(ctx, id)
+}
+
+// RevokeCurrent はリクエストに使われたセッションを失効させる (ログアウト)
+func (s *SessionService) RevokeCurrent(ctx context.Context) error {
+	id, ok := CurrentSessionID(ctx)
+	if !ok {
+		return nil
+	}
+	return s.repo.Revoke(ctx, id)
+}
+
+// RevokeAll はリクエストに使われたセッションを含め、ユーザーの全てのセッションを失効させる
+func (s *SessionService) RevokeAll(ctx context.Context) (int, error) {
+	return s.repo.RevokeAll(ctx)
+}
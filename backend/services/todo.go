@@ -10,23 +10,26 @@ import (
 	"todo-app/utils"
 )
 
-func NewTodoService(client *ent.Client, logger *slog.Logger, repo repositories.ITodoRepository) *TodoService {
+func NewTodoService(client *ent.Client, logger *slog.Logger, repo repositories.ITodoRepository, listRepo repositories.ITodoListRepository) *TodoService {
 	return &TodoService{
-		client: client,
-		logger: logger,
-		repo:   repo,
+		client:   client,
+		logger:   logger,
+		repo:     repo,
+		listRepo: listRepo,
 	}
 }
 
 type TodoService struct {
-	client *ent.Client
-	logger *slog.Logger
-	repo   repositories.ITodoRepository
+	client   *ent.Client
+	logger   *slog.Logger
+	repo     repositories.ITodoRepository
+	listRepo repositories.ITodoListRepository
 }
 
-func (s *TodoService) GetTodoSlice(ctx context.Context, currentPage int, limit int, includeDone bool) ([]dto.TodoDto, error) {
+// GetTodoSlice は閲覧できる Todo を返す。listID を指定した場合はそのリストの Todo に絞り込む
+func (s *TodoService) GetTodoSlice(ctx context.Context, currentPage int, limit int, includeDone bool, listID *int) ([]dto.TodoDto, error) {
 	offset := (currentPage - 1) * limit
-	todos, err := s.repo.FetchTodos(ctx, limit, offset, includeDone)
+	todos, err := s.repo.FetchTodos(ctx, limit, offset, includeDone, listID)
 	if err != nil {
 		return nil, err
 	}
@@ -38,8 +41,8 @@ func (s *TodoService) GetTodoSlice(ctx context.Context, currentPage int, limit i
 	return todoDtos, nil
 }
 
-func (s *TodoService) CalculatePagination(ctx context.Context, currentPage int, limit int, includeDone bool) (*dto.PaginationDto, error) {
-	count, err := s.repo.GetTodoCount(ctx, includeDone)
+func (s *TodoService) CalculatePagination(ctx context.Context, currentPage int, limit int, includeDone bool, listID *int) (*dto.PaginationDto, error) {
+	count, err := s.repo.GetTodoCount(ctx, includeDone, listID)
 	if err != nil {
 		return nil, err
 	}
@@ -58,8 +61,42 @@ func (s *TodoService) CalculatePagination(ctx context.Context, currentPage int,
 	}, nil
 }
 
-func (s *TodoService) CreateTodo(ctx context.Context, title string, description string) (*ent.Todo, error) {
-	return s.repo.CreateTodo(ctx, title, description)
+// CreateTodo は Todo を作成する。listID を指定した場合は、editor 以上の権限があるリストにのみ作成できる
+func (s *TodoService) CreateTodo(ctx context.Context, title string, description string, listID *int) (*ent.Todo, error) {
+	if listID != nil {
+		member, err := s.listRepo.FindMembership(ctx, *listID)
+		if err != nil {
+			return nil, err
+		}
+		if !repositories.CanEditTodoList(member.Role) {
+			return nil, app_errors.ErrTodoListPermissionDenied
+		}
+	}
+	return s.repo.CreateTodo(ctx, title, description, listID)
+}
+
+// CreateTodos は下書きを1トランザクションでまとめて作成する
+func (s *TodoService) CreateTodos(ctx context.Context, drafts []dto.TodoDraftDto) ([]*ent.Todo, error) {
+	txCtx, tx, err := utils.WithTx(ctx, s.client)
+	if err != nil {
+		return nil, err
+	}
+
+	todos := make([]*ent.Todo, 0, len(drafts))
+	for _, d := range drafts {
+		todo, err := s.CreateTodo(txCtx, d.Title, d.Description, nil)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		todos = append(todos, todo)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return todos, nil
 }
 
 func (s *TodoService) UpdateTodo(ctx context.Context, id int, title *string, description *string) (*ent.Todo, error) {
@@ -82,7 +119,7 @@ func (s *TodoService) UpdateTodo(ctx context.Context, id int, title *string, des
 	_, err = s.repo.GetTodoForUpdate(txCtx, id)
 	if err != nil {
 		_ = tx.Rollback()
-		return nil, err
+		return nil, s.writeError(ctx, id, err)
 	}
 
 	updatedTodo, err := s.repo.UpdateTodo(txCtx, id, title, description)
@@ -99,7 +136,10 @@ func (s *TodoService) UpdateTodo(ctx context.Context, id int, title *string, des
 }
 
 func (s *TodoService) DeleteTodo(ctx context.Context, id int) error {
-	return s.repo.DeleteTodo(ctx, id)
+	if err := s.repo.DeleteTodo(ctx, id); err != nil {
+		return s.writeError(ctx, id, err)
+	}
+	return nil
 }
 
 func (s *TodoService) FetchTodosByIds(ctx context.Context, ids []int) ([]*ent.Todo, error) {
@@ -115,7 +155,7 @@ func (s *TodoService) UpdateDoneStatus(ctx context.Context, id int, isDone bool)
 	todo, err := s.repo.GetTodoForUpdate(txCtx, id)
 	if err != nil {
 		_ = tx.Rollback()
-		return nil, err
+		return nil, s.writeError(ctx, id, err)
 	}
 
 	// Check if update is needed
@@ -142,3 +182,15 @@ func (s *TodoService) UpdateDoneStatus(ctx context.Context, id int, isDone bool)
 
 	return updatedTodo, nil
 }
+
+// writeError は変更できなかった Todo が閲覧はできる (viewer の権限で参加しているリストの Todo の) 場合に、
+// 権限が無いことを示すエラーに置き換える
+func (s *TodoService) writeError(ctx context.Context, id int, err error) error {
+	if !ent.IsNotFound(err) {
+		return err
+	}
+	if _, ferr := s.repo.FindTodo(ctx, id); ferr == nil {
+		return app_errors.ErrTodoListPermissionDenied
+	}
+	return err
+}
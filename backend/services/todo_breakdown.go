@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"todo-app/app_errors"
+	"todo-app/ent"
+	"todo-app/repositories"
+	"todo-app/utils"
+
+	"github.com/google/uuid"
+)
+
+type TodoBreakdownService struct {
+	client    *ent.Client
+	logger    *slog.Logger
+	todoRepo  repositories.ITodoRepository
+	repo      repositories.ITodoBreakdownRepository
+	aiService *AIService
+}
+
+func NewTodoBreakdownService(client *ent.Client, logger *slog.Logger, todoRepo repositories.ITodoRepository, repo repositories.ITodoBreakdownRepository, aiService *AIService) *TodoBreakdownService {
+	return &TodoBreakdownService{
+		client:    client,
+		logger:    logger,
+		todoRepo:  todoRepo,
+		repo:      repo,
+		aiService: aiService,
+	}
+}
+
+// GenerateBreakdown は ToDo を AI で手順に分解し、結果を記録する
+func (s *TodoBreakdownService) GenerateBreakdown(ctx context.Context, aiClient utils.IGenAIClient, todoID int) (*ent.TodoBreakdown, error) {
+	todo, err := s.todoRepo.FindTodo(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+	if todo.DoneAt != nil {
+		return nil, app_errors.ErrTodoAlreadyDone
+	}
+
+	steps, err := s.aiService.BreakdownTodo(ctx, aiClient, todo)
+	if err != nil {
+		return nil, err
+	}
+	if len(steps) == 0 {
+		return nil, app_errors.ErrNoBreakdownSteps
+	}
+
+	records := make([]map[string]string, len(steps))
+	for i, step := range steps {
+		records[i] = map[string]string{
+			"title":       step.Title,
+			"description": step.Description,
+		}
+	}
+
+	return s.repo.CreateBreakdown(ctx, todo.ID, geminiModel, records)
+}
+
+func (s *TodoBreakdownService) GetBreakdown(ctx context.Context, todoID int, id uuid.UUID) (*ent.TodoBreakdown, error) {
+	return s.repo.FindBreakdown(ctx, todoID, id)
+}
+
+// AcceptBreakdown は指定された手順のみを子 ToDo として登録する
+func (s *TodoBreakdownService) AcceptBreakdown(ctx context.Context, todoID int, id uuid.UUID, stepIndexes []int) (*ent.TodoBreakdown, []*ent.Todo, error) {
+	txCtx, tx, err := utils.WithTx(ctx, s.client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	breakdown, err := s.repo.FindBreakdown(txCtx, todoID, id)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, nil, err
+	}
+
+	seen := make(map[int]bool, len(stepIndexes))
+	for _, idx := range stepIndexes {
+		if idx < 0 || idx >= len(breakdown.Steps) || seen[idx] {
+			_ = tx.Rollback()
+			return nil, nil, app_errors.ErrInvalidBreakdownStepIndexes
+		}
+		seen[idx] = true
+	}
+
+	// 同時に採用された場合に備え、未採用の場合のみ更新できたものを正とする
+	n, err := s.repo.MarkAccepted(txCtx, id, stepIndexes)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, nil, err
+	}
+	if n == 0 {
+		_ = tx.Rollback()
+		return nil, nil, app_errors.ErrBreakdownAlreadyAccepted
+	}
+
+	todos := make([]*ent.Todo, 0, len(stepIndexes))
+	for _, idx := range stepIndexes {
+		step := breakdown.Steps[idx]
+		child, err := s.todoRepo.CreateChildTodo(txCtx, todoID, step["title"], step["description"])
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, nil, err
+		}
+		todos = append(todos, child)
+	}
+
+	accepted, err := s.repo.FindBreakdown(txCtx, todoID, id)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	return accepted, todos, nil
+}
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+	"todo-app/app_errors"
+	"todo-app/ent"
+	"todo-app/ent/enttest"
+	"todo-app/testutils"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/genai"
+)
+
+func TestTodoBreakdownService_GenerateBreakdown(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("分解結果を記録して返すこと", func(t *testing.T) {
+		todoRepo := new(testutils.MockTodoRepository)
+		repo := new(testutils.MockTodoBreakdownRepository)
+		mockClient := new(MockGenAIClient)
+		s := NewTodoBreakdownService(nil, logger, todoRepo, repo, NewAIService(todoRepo))
+
+		todoRepo.On("FindTodo", mock.Anything, 1).Return(&ent.Todo{ID: 1, Title: "引っ越し"}, nil)
+		mockClient.On("GenerateContent", ctx, "gemini-3-flash-preview", mock.Anything, mock.Anything).Return(&genai.GenerateContentResponse{
+			Candidates: []*genai.Candidate{
+				{Content: &genai.Content{Parts: []*genai.Part{{Text: `{"steps":[{"title":"荷造りする","description":"段ボール"}]}`}}}},
+			},
+		}, nil)
+		expected := &ent.TodoBreakdown{ID: uuid.New(), TodoID: 1}
+		repo.On("CreateBreakdown", mock.Anything, 1, "gemini-3-flash-preview", []map[string]string{
+			{"title": "荷造りする", "description": "段ボール"},
+		}).Return(expected, nil)
+
+		res, err := s.GenerateBreakdown(ctx, mockClient, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, res)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("完了済みの ToDo はエラーを返すこと", func(t *testing.T) {
+		todoRepo := new(testutils.MockTodoRepository)
+		repo := new(testutils.MockTodoBreakdownRepository)
+		mockClient := new(MockGenAIClient)
+		s := NewTodoBreakdownService(nil, logger, todoRepo, repo, NewAIService(todoRepo))
+
+		now := time.Now()
+		todoRepo.On("FindTodo", mock.Anything, 1).Return(&ent.Todo{ID: 1, DoneAt: &now}, nil)
+
+		res, err := s.GenerateBreakdown(ctx, mockClient, 1)
+		assert.ErrorIs(t, err, app_errors.ErrTodoAlreadyDone)
+		assert.Nil(t, res)
+		mockClient.AssertNotCalled(t, "GenerateContent", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("手順が生成されなかった場合はエラーを返すこと", func(t *testing.T) {
+		todoRepo := new(testutils.MockTodoRepository)
+		repo := new(testutils.MockTodoBreakdownRepository)
+		mockClient := new(MockGenAIClient)
+		s := NewTodoBreakdownService(nil, logger, todoRepo, repo, NewAIService(todoRepo))
+
+		todoRepo.On("FindTodo", mock.Anything, 1).Return(&ent.Todo{ID: 1}, nil)
+		mockClient.On("GenerateContent", ctx, "gemini-3-flash-preview", mock.Anything, mock.Anything).Return(&genai.GenerateContentResponse{}, nil)
+
+		res, err := s.GenerateBreakdown(ctx, mockClient, 1)
+		assert.ErrorIs(t, err, app_errors.ErrNoBreakdownSteps)
+		assert.Nil(t, res)
+		repo.AssertNotCalled(t, "CreateBreakdown", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestTodoBreakdownService_AcceptBreakdown(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		if err := client.Close(); err != nil {
+			t.Errorf("failed to close client: %v", err)
+		}
+	}()
+
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	id := uuid.New()
+	breakdown := &ent.TodoBreakdown{
+		ID:     id,
+		TodoID: 1,
+		Steps: []map[string]string{
+			{"title": "Step 1", "description": ""},
+			{"title": "Step 2", "description": "Desc 2"},
+			{"title": "Step 3", "description": ""},
+		},
+	}
+
+	t.Run("指定した手順のみ子 ToDo として登録すること", func(t *testing.T) {
+		todoRepo := new(testutils.MockTodoRepository)
+		repo := new(testutils.MockTodoBreakdownRepository)
+		s := NewTodoBreakdownService(client, logger, todoRepo, repo, NewAIService(todoRepo))
+
+		repo.On("FindBreakdown", mock.Anything, 1, id).Return(breakdown, nil)
+		repo.On("MarkAccepted", mock.Anything, id, []int{1, 2}).Return(1, nil)
+		todoRepo.On("CreateChildTodo", mock.Anything, 1, "Step 2", "Desc 2").Return(&ent.Todo{ID: 10}, nil)
+		todoRepo.On("CreateChildTodo", mock.Anything, 1, "Step 3", "").Return(&ent.Todo{ID: 11}, nil)
+
+		accepted, todos, err := s.AcceptBreakdown(ctx, 1, id, []int{1, 2})
+		assert.NoError(t, err)
+		assert.Equal(t, breakdown, accepted)
+		assert.Len(t, todos, 2)
+		todoRepo.AssertNotCalled(t, "CreateChildTodo", mock.Anything, 1, "Step 1", "")
+	})
+
+	t.Run("範囲外や重複した手順を指定した場合はエラーを返すこと", func(t *testing.T) {
+		for _, indexes := range [][]int{{3}, {0, 0}} {
+			todoRepo := new(testutils.MockTodoRepository)
+			repo := new(testutils.MockTodoBreakdownRepository)
+			s := NewTodoBreakdownService(client, logger, todoRepo, repo, NewAIService(todoRepo))
+
+			repo.On("FindBreakdown", mock.Anything, 1, id).Return(breakdown, nil)
+
+			_, _, err := s.AcceptBreakdown(ctx, 1, id, indexes)
+			assert.ErrorIs(t, err, app_errors.ErrInvalidBreakdownStepIndexes)
+			repo.AssertNotCalled(t, "MarkAccepted", mock.Anything, mock.Anything, mock.Anything)
+		}
+	})
+
+	t.Run("採用済みの場合はエラーを返すこと", func(t *testing.T) {
+		todoRepo := new(testutils.MockTodoRepository)
+		repo := new(testutils.MockTodoBreakdownRepository)
+		s := NewTodoBreakdownService(client, logger, todoRepo, repo, NewAIService(todoRepo))
+
+		repo.On("FindBreakdown", mock.Anything, 1, id).Return(breakdown, nil)
+		repo.On("MarkAccepted", mock.Anything, id, []int{0}).Return(0, nil)
+
+		_, _, err := s.AcceptBreakdown(ctx, 1, id, []int{0})
+		assert.ErrorIs(t, err, app_errors.ErrBreakdownAlreadyAccepted)
+		todoRepo.AssertNotCalled(t, "CreateChildTodo", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -76,12 +76,12 @@ func TestTodoService_CalculatePagination(t *testing.T) {
 			tt := tt
 			t.Run(tt.name, func(t *testing.T) {
 				repo := new(testutils.MockTodoRepository)
-				repo.On("GetTodoCount", mock.Anything, false).Return(tt.count, nil)
+				repo.On("GetTodoCount", mock.Anything, false, (*int)(nil)).Return(tt.count, nil)
 
 				ctx := context.Background()
-				service := services.NewTodoService(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), repo)
+				service := services.NewTodoService(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), repo, new(testutils.MockTodoListRepository))
 
-				pagination, err := service.CalculatePagination(ctx, tt.currentPage, tt.limit, false)
+				pagination, err := service.CalculatePagination(ctx, tt.currentPage, tt.limit, false, nil)
 
 				assert.NoError(t, err)
 				if err != nil || pagination == nil {
@@ -98,12 +98,12 @@ func TestTodoService_CalculatePagination(t *testing.T) {
 
 	t.Run("カウント取得でエラーが発生した場合、エラーを返すこと", func(t *testing.T) {
 		repo := new(testutils.MockTodoRepository)
-		repo.On("GetTodoCount", mock.Anything, false).Return(0, errors.New("db error"))
+		repo.On("GetTodoCount", mock.Anything, false, (*int)(nil)).Return(0, errors.New("db error"))
 
 		ctx := context.Background()
-		service := services.NewTodoService(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), repo)
+		service := services.NewTodoService(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), repo, new(testutils.MockTodoListRepository))
 
-		pagination, err := service.CalculatePagination(ctx, 1, 20, false)
+		pagination, err := service.CalculatePagination(ctx, 1, 20, false, nil)
 
 		assert.Error(t, err)
 		assert.Nil(t, pagination)
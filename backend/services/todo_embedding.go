@@ -0,0 +1,207 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"sort"
+	"sync"
+	"todo-app/ent"
+	"todo-app/repositories"
+	"todo-app/utils"
+)
+
+const (
+	// todoEmbeddingBatchSize は1回のベクトル化でまとめて送る ToDo の件数
+	todoEmbeddingBatchSize = 100
+	// todoEmbeddingWorkers はバックグラウンドで同時にベクトル化する処理の数
+	todoEmbeddingWorkers = 2
+)
+
+// TodoSearchResult は意味検索で見つかった ToDo とクエリとの類似度
+type TodoSearchResult struct {
+	Todo  *ent.Todo
+	Score float64
+}
+
+type TodoEmbeddingService struct {
+	logger          *slog.Logger
+	repo            repositories.ITodoEmbeddingRepository
+	embedderFactory utils.IEmbedderFactory
+
+	// mu は queued を保護する
+	mu sync.Mutex
+	// queued はベクトル化を待っている、またはベクトル化している ToDo の ID
+	queued map[int]struct{}
+	// workers は同時にベクトル化する処理の数を制限する
+	workers chan struct{}
+	wg      sync.WaitGroup
+}
+
+func NewTodoEmbeddingService(logger *slog.Logger, repo repositories.ITodoEmbeddingRepository, embedderFactory utils.IEmbedderFactory) *TodoEmbeddingService {
+	return &TodoEmbeddingService{
+		logger:          logger,
+		repo:            repo,
+		embedderFactory: embedderFactory,
+		queued:          map[int]struct{}{},
+		workers:         make(chan struct{}, todoEmbeddingWorkers),
+	}
+}
+
+// IndexTodos は ToDo のタイトルと説明のベクトル化をバックグラウンドで行い、保存する。
+// 外部 API の応答を待たずに ToDo の作成・更新を返すため、失敗してもログに残すだけにする。
+// パーソナルアクセストークンに ai スコープが無い場合はベクトル化しない。
+// 保存できなかったベクトルは次回の検索時にベクトル化を待つ ToDo に加える。
+func (s *TodoEmbeddingService) IndexTodos(ctx context.Context, todos ...*ent.Todo) {
+	if !hasTokenScope(ctx, TokenScopeAI) {
+		return
+	}
+	s.enqueue(ctx, todos)
+}
+
+// Wait はバックグラウンドで行っているベクトル化が全て終わるまで待つ。テストで利用する
+func (s *TodoEmbeddingService) Wait() {
+	s.wg.Wait()
+}
+
+// enqueue はベクトル化を待っていない ToDo をバックグラウンドでベクトル化する。
+// 呼び出し元のリクエストが終わっても続けられるよう、context のキャンセルは引き継がない
+func (s *TodoEmbeddingService) enqueue(ctx context.Context, todos []*ent.Todo) {
+	s.mu.Lock()
+	pending := make([]*ent.Todo, 0, len(todos))
+	for _, t := range todos {
+		if _, ok := s.queued[t.ID]; ok {
+			continue
+		}
+		s.queued[t.ID] = struct{}{}
+		// 呼び出し元が使い続ける ToDo を書き換えないよう複製する
+		copied := *t
+		pending = append(pending, &copied)
+	}
+	s.mu.Unlock()
+	if len(pending) == 0 {
+		return
+	}
+
+	indexCtx := context.WithoutCancel(ctx)
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.workers <- struct{}{}
+		defer func() { <-s.workers }()
+		defer s.dequeue(pending)
+
+		embedder, err := s.embedderFactory.GetEmbedder(indexCtx)
+		if err == nil {
+			err = s.index(indexCtx, embedder, pending)
+		}
+		if err != nil {
+			s.logger.Warn("failed to index todos", slog.String("error", err.Error()), slog.Int("count", len(pending)))
+		}
+	}()
+}
+
+func (s *TodoEmbeddingService) dequeue(todos []*ent.Todo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, t := range todos {
+		delete(s.queued, t.ID)
+	}
+}
+
+// Search はクエリと意味の近い ToDo を類似度の高い順に返す。
+// 外部 API の呼び出しはクエリのベクトル化の1回だけにするため、ベクトルが無い、または内容が変わった ToDo は
+// 検索対象から除き、バックグラウンドでのベクトル化を待つ ToDo に加える。
+func (s *TodoEmbeddingService) Search(ctx context.Context, query string, limit int) ([]TodoSearchResult, error) {
+	embedder, err := s.embedderFactory.GetEmbedder(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	todos, err := s.repo.FetchTodosWithEmbedding(ctx)
+	if err != nil {
+		return nil, err
+	}
+	indexed := make([]*ent.Todo, 0, len(todos))
+	stale := make([]*ent.Todo, 0)
+	for _, t := range todos {
+		if isTodoEmbeddingStale(embedder, t) {
+			stale = append(stale, t)
+			continue
+		}
+		indexed = append(indexed, t)
+	}
+	s.enqueue(ctx, stale)
+
+	queryVector, err := embedder.EmbedQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []TodoSearchResult{}
+	for _, t := range indexed {
+		score := utils.CosineSimilarity(queryVector, t.Edges.Embedding.Vector)
+		if score <= 0 {
+			continue
+		}
+		results = append(results, TodoSearchResult{Todo: t, Score: score})
+	}
+	// 類似度が同じ場合は更新日時の新しい順を保つ
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+// index はベクトルが無い、またはモデルや内容が変わった ToDo をベクトル化し、t.Edges.Embedding に反映する
+func (s *TodoEmbeddingService) index(ctx context.Context, embedder utils.IEmbedder, todos []*ent.Todo) error {
+	stale := make([]*ent.Todo, 0, len(todos))
+	for _, t := range todos {
+		if isTodoEmbeddingStale(embedder, t) {
+			stale = append(stale, t)
+		}
+	}
+
+	for start := 0; start < len(stale); start += todoEmbeddingBatchSize {
+		batch := stale[start:min(start+todoEmbeddingBatchSize, len(stale))]
+		contents := make([]string, len(batch))
+		for i, t := range batch {
+			contents[i] = todoEmbeddingContent(t)
+		}
+
+		vectors, err := embedder.EmbedDocuments(ctx, contents)
+		if err != nil {
+			return err
+		}
+		for i, t := range batch {
+			saved, err := s.repo.SaveEmbedding(ctx, t.ID, embedder.Model(), todoEmbeddingHash(t), vectors[i])
+			if err != nil {
+				return err
+			}
+			t.Edges.Embedding = saved
+		}
+	}
+	return nil
+}
+
+// isTodoEmbeddingStale は ToDo のベクトルが無い、またはモデルや内容が変わった場合に true を返す
+func isTodoEmbeddingStale(embedder utils.IEmbedder, t *ent.Todo) bool {
+	e := t.Edges.Embedding
+	return e == nil || e.Model != embedder.Model() || e.ContentHash != todoEmbeddingHash(t)
+}
+
+func todoEmbeddingContent(t *ent.Todo) string {
+	if t.Description == "" {
+		return t.Title
+	}
+	return t.Title + "\n" + t.Description
+}
+
+func todoEmbeddingHash(t *ent.Todo) string {
+	sum := sha256.Sum256([]byte(todoEmbeddingContent(t)))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"todo-app/ent"
+	"todo-app/testutils"
+	"todo-app/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type errorEmbedderFactory struct{}
+
+func (f *errorEmbedderFactory) GetEmbedder(ctx context.Context) (utils.IEmbedder, error) {
+	return nil, errors.New("embedding provider is not configured")
+}
+
+func TestTodoEmbeddingService_Search(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	embedder := utils.NewLocalEmbedder()
+
+	embeddingOf := func(todo *ent.Todo) *ent.TodoEmbedding {
+		vectors, _ := embedder.EmbedDocuments(ctx, []string{todoEmbeddingContent(todo)})
+		return &ent.TodoEmbedding{
+			TodoID:      todo.ID,
+			Model:       embedder.Model(),
+			ContentHash: todoEmbeddingHash(todo),
+			Vector:      vectors[0],
+		}
+	}
+
+	t.Run("ベクトルが無い ToDo を検索対象から除いてバックグラウンドでベクトル化すること", func(t *testing.T) {
+		milk := &ent.Todo{ID: 1, Title: "スーパーで牛乳を買う"}
+		bill := &ent.Todo{ID: 2, Title: "請求書を支払う"}
+		bill.Edges.Embedding = embeddingOf(bill)
+
+		repo := new(testutils.MockTodoEmbeddingRepository)
+		repo.On("FetchTodosWithEmbedding", mock.Anything).Return([]*ent.Todo{milk, bill}, nil)
+		repo.On("SaveEmbedding", mock.Anything, 1, embedder.Model(), todoEmbeddingHash(milk), mock.Anything).
+			Return(embeddingOf(milk), nil)
+		service := NewTodoEmbeddingService(logger, repo, utils.NewLocalEmbedderFactory())
+
+		results, err := service.Search(ctx, "牛乳の買い物", 10)
+		service.Wait()
+
+		assert.NoError(t, err)
+		for _, r := range results {
+			assert.NotEqual(t, 1, r.Todo.ID)
+		}
+		// 内容の変わっていない ToDo は再度ベクトル化しない
+		repo.AssertNumberOfCalls(t, "SaveEmbedding", 1)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("内容が変わった ToDo は古いベクトルで検索せず、再度ベクトル化すること", func(t *testing.T) {
+		todo := &ent.Todo{ID: 1, Title: "牛乳を買う"}
+		todo.Edges.Embedding = embeddingOf(&ent.Todo{ID: 1, Title: "パンを買う"})
+
+		repo := new(testutils.MockTodoEmbeddingRepository)
+		repo.On("FetchTodosWithEmbedding", mock.Anything).Return([]*ent.Todo{todo}, nil)
+		repo.On("SaveEmbedding", mock.Anything, 1, embedder.Model(), todoEmbeddingHash(todo), mock.Anything).
+			Return(embeddingOf(todo), nil)
+		service := NewTodoEmbeddingService(logger, repo, utils.NewLocalEmbedderFactory())
+
+		results, err := service.Search(ctx, "パン", 10)
+		service.Wait()
+
+		assert.NoError(t, err)
+		assert.Empty(t, results)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ベクトル化済みの ToDo を類似度の高い順に返すこと", func(t *testing.T) {
+		milk := &ent.Todo{ID: 1, Title: "スーパーで牛乳を買う"}
+		bill := &ent.Todo{ID: 2, Title: "請求書を支払う"}
+		milk.Edges.Embedding = embeddingOf(milk)
+		bill.Edges.Embedding = embeddingOf(bill)
+
+		repo := new(testutils.MockTodoEmbeddingRepository)
+		repo.On("FetchTodosWithEmbedding", mock.Anything).Return([]*ent.Todo{bill, milk}, nil)
+		service := NewTodoEmbeddingService(logger, repo, utils.NewLocalEmbedderFactory())
+
+		results, err := service.Search(ctx, "牛乳の買い物", 10)
+		service.Wait()
+
+		assert.NoError(t, err)
+		if assert.NotEmpty(t, results) {
+			assert.Equal(t, 1, results[0].Todo.ID)
+		}
+		for i := 1; i < len(results); i++ {
+			assert.GreaterOrEqual(t, results[i-1].Score, results[i].Score)
+		}
+		repo.AssertNotCalled(t, "SaveEmbedding", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("指定した件数までに絞り込むこと", func(t *testing.T) {
+		todos := []*ent.Todo{
+			{ID: 1, Title: "牛乳を買う"},
+			{ID: 2, Title: "牛乳を飲む"},
+			{ID: 3, Title: "牛乳を温める"},
+		}
+		for _, todo := range todos {
+			todo.Edges.Embedding = embeddingOf(todo)
+		}
+
+		repo := new(testutils.MockTodoEmbeddingRepository)
+		repo.On("FetchTodosWithEmbedding", mock.Anything).Return(todos, nil)
+
+		service := NewTodoEmbeddingService(logger, repo, utils.NewLocalEmbedderFactory())
+		results, err := service.Search(ctx, "牛乳", 2)
+
+		assert.NoError(t, err)
+		assert.Len(t, results, 2)
+	})
+}
+
+func TestTodoEmbeddingService_IndexTodos(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("ToDo をバックグラウンドでベクトル化して保存すること", func(t *testing.T) {
+		todo := &ent.Todo{ID: 1, Title: "牛乳を買う", Description: "低脂肪"}
+		repo := new(testutils.MockTodoEmbeddingRepository)
+		repo.On("SaveEmbedding", mock.Anything, 1, utils.NewLocalEmbedder().Model(), todoEmbeddingHash(todo), mock.Anything).
+			Return(&ent.TodoEmbedding{TodoID: 1}, nil)
+		service := NewTodoEmbeddingService(logger, repo, utils.NewLocalEmbedderFactory())
+
+		service.IndexTodos(ctx, todo)
+		service.Wait()
+
+		repo.AssertExpectations(t)
+		// 呼び出し元の ToDo は書き換えない
+		assert.Nil(t, todo.Edges.Embedding)
+	})
+
+	t.Run("呼び出し元の context がキャンセルされてもベクトル化を続けること", func(t *testing.T) {
+		todo := &ent.Todo{ID: 1, Title: "牛乳を買う"}
+		repo := new(testutils.MockTodoEmbeddingRepository)
+		repo.On("SaveEmbedding", mock.Anything, 1, mock.Anything, mock.Anything, mock.Anything).
+			Return(&ent.TodoEmbedding{TodoID: 1}, nil)
+		service := NewTodoEmbeddingService(logger, repo, utils.NewLocalEmbedderFactory())
+		canceledCtx, cancel := context.WithCancel(ctx)
+
+		service.IndexTodos(canceledCtx, todo)
+		cancel()
+		service.Wait()
+
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("パーソナルアクセストークンに ai スコープが無い場合はベクトル化しないこと", func(t *testing.T) {
+		repo := new(testutils.MockTodoEmbeddingRepository)
+		service := NewTodoEmbeddingService(logger, repo, utils.NewLocalEmbedderFactory())
+		tokenCtx := utils.WithTokenScopes(ctx, []string{TokenScopeRead, TokenScopeWrite})
+
+		service.IndexTodos(tokenCtx, &ent.Todo{ID: 1, Title: "牛乳を買う"})
+		service.Wait()
+
+		repo.AssertNotCalled(t, "SaveEmbedding", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("ベクトル化に失敗しても処理を止めないこと", func(t *testing.T) {
+		repo := new(testutils.MockTodoEmbeddingRepository)
+		service := NewTodoEmbeddingService(logger, repo, &errorEmbedderFactory{})
+
+		assert.NotPanics(t, func() {
+			service.IndexTodos(ctx, &ent.Todo{ID: 1, Title: "牛乳を買う"})
+			service.Wait()
+		})
+		repo.AssertNotCalled(t, "SaveEmbedding", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
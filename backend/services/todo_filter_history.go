@@ -2,28 +2,75 @@ package services
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
+	"regexp"
+	"strings"
+	"time"
+	"todo-app/dto"
 	"todo-app/ent"
 	"todo-app/repositories"
+	"todo-app/utils"
 
 	"github.com/google/uuid"
+	"golang.org/x/text/unicode/norm"
 )
 
 type ITodoFilterHistoryService interface {
 	FetchLatestFilters(ctx context.Context) ([]*ent.TodoFilterHistory, error)
-	SaveFilterHistory(ctx context.Context, query string, functionName *string, args map[string]interface{}, resultTodoIds []int) (*ent.TodoFilterHistory, error)
+	DecideFilter(ctx context.Context, key TodoFilterCacheKey, query string) (*dto.AIFilterDto, error)
+	SaveFilterHistory(ctx context.Context, query string, key TodoFilterCacheKey, functionName *string, args map[string]interface{}, resultTodoIds []int) (*ent.TodoFilterHistory, error)
 	GetFilterHistoryByQueryID(ctx context.Context, queryID uuid.UUID) (*ent.TodoFilterHistory, error)
 }
 
+// TodoFilterCacheKey は AI による絞り込み判定をキャッシュする際のキー。
+// DateBucket が空の場合はキャッシュしない。
+// プロンプトのテンプレートが変わった場合は別のキーになる。
+type TodoFilterCacheKey struct {
+	NormalizedQuery string
+	DateBucket      string
+	PromptVersion   string
+}
+
+var (
+	// 分単位で結果が変わる相対表現はキャッシュしない
+	minuteRelativeQueryPattern = regexp.MustCompile(`分|秒|さっき|たった今|直近|minute|second|just now`)
+	// 時間単位で結果が変わる相対表現は1時間ごとにキャッシュする
+	hourRelativeQueryPattern = regexp.MustCompile(`時間|今朝|午前|午後|夕方|夜|hour|morning|afternoon|evening|tonight`)
+)
+
+// NewTodoFilterCacheKey はクエリを正規化し、相対的な日時表現が同じ期間を指す範囲を DateBucket として返す。
+// 「先週」のようなクエリも日付が変われば別のキーになる。日付の区切りは now のタイムゾーンに従う。
+func NewTodoFilterCacheKey(query string, now time.Time, promptVersion string) TodoFilterCacheKey {
+	normalized := strings.ToLower(norm.NFKC.String(query))
+	normalized = strings.Join(strings.Fields(normalized), " ")
+	normalized = strings.TrimRight(normalized, "。.?!")
+
+	bucket := now.Format("2006-01-02")
+	switch {
+	case minuteRelativeQueryPattern.MatchString(normalized):
+		bucket = ""
+	case hourRelativeQueryPattern.MatchString(normalized):
+		bucket = now.Format("2006-01-02T15")
+	}
+
+	return TodoFilterCacheKey{NormalizedQuery: normalized, DateBucket: bucket, PromptVersion: promptVersion}
+}
+
 type TodoFilterHistoryService struct {
-	repo   repositories.ITodoFilterHistoryRepository
-	logger *slog.Logger
+	repo      repositories.ITodoFilterHistoryRepository
+	logger    *slog.Logger
+	aiService *AIService
+	aiFactory utils.IAIFactory
+	group     utils.SharedCallGroup
 }
 
-func NewTodoFilterHistoryService(repo repositories.ITodoFilterHistoryRepository, logger *slog.Logger) *TodoFilterHistoryService {
+func NewTodoFilterHistoryService(repo repositories.ITodoFilterHistoryRepository, logger *slog.Logger, aiService *AIService, aiFactory utils.IAIFactory) *TodoFilterHistoryService {
 	return &TodoFilterHistoryService{
-		repo:   repo,
-		logger: logger,
+		repo:      repo,
+		logger:    logger,
+		aiService: aiService,
+		aiFactory: aiFactory,
 	}
 }
 
@@ -31,8 +78,45 @@ func (s *TodoFilterHistoryService) FetchLatestFilters(ctx context.Context) ([]*e
 	return s.repo.FetchLatestFilters(ctx, 5)
 }
 
-func (s *TodoFilterHistoryService) SaveFilterHistory(ctx context.Context, query string, functionName *string, args map[string]interface{}, resultTodoIds []int) (*ent.TodoFilterHistory, error) {
-	return s.repo.SaveFilterHistory(ctx, query, functionName, args, resultTodoIds)
+// DecideFilter は AI で絞り込み方法を判定する。
+// 同じキーで判定済みの場合は履歴の結果を返し、同時に来た同じリクエストは1回の呼び出しを共有する。
+// 待っているリクエストが全て切断された場合は AI の呼び出しもキャンセルされる。
+func (s *TodoFilterHistoryService) DecideFilter(ctx context.Context, key TodoFilterCacheKey, query string) (*dto.AIFilterDto, error) {
+	if key.DateBucket != "" {
+		cached, err := s.repo.FindCachedFilter(ctx, key.NormalizedQuery, key.DateBucket, key.PromptVersion)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		}
+		if cached != nil {
+			s.logger.Info("ai filter cache hit", slog.String("query", key.NormalizedQuery), slog.String("date_bucket", key.DateBucket))
+			return &dto.AIFilterDto{
+				FunctionName: cached.FunctionName,
+				Args:         cached.Args,
+			}, nil
+		}
+	}
+
+	userID := 0
+	if u, ok := utils.UserFromContext(ctx); ok {
+		userID = u.ID
+	}
+	flightKey := fmt.Sprintf("%d\x00%s\x00%s\x00%s", userID, key.PromptVersion, key.DateBucket, key.NormalizedQuery)
+
+	v, err := s.group.Do(ctx, flightKey, func(ctx context.Context) (interface{}, error) {
+		aiClient, err := s.aiFactory.GetGeminiClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return s.aiService.DecideFilterTodosFunction(ctx, aiClient, query)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*dto.AIFilterDto), nil
+}
+
+func (s *TodoFilterHistoryService) SaveFilterHistory(ctx context.Context, query string, key TodoFilterCacheKey, functionName *string, args map[string]interface{}, resultTodoIds []int) (*ent.TodoFilterHistory, error) {
+	return s.repo.SaveFilterHistory(ctx, query, key.NormalizedQuery, key.DateBucket, key.PromptVersion, functionName, args, resultTodoIds)
 }
 
 func (s *TodoFilterHistoryService) GetFilterHistoryByQueryID(ctx context.Context, queryID uuid.UUID) (*ent.TodoFilterHistory, error) {
@@ -4,14 +4,19 @@ import (
 	"context"
 	"io"
 	"log/slog"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 	"todo-app/ent"
 	"todo-app/services"
 	"todo-app/testutils"
+	"todo-app/utils"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/genai"
 )
 
 // ...
@@ -24,7 +29,7 @@ func TestTodoFilterHistoryService_GetFilterHistoryByQueryID(t *testing.T) {
 		repo.On("GetFilterHistoryByQueryID", mock.Anything, queryID).Return(expectedHistory, nil)
 
 		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
-		service := services.NewTodoFilterHistoryService(repo, logger)
+		service := services.NewTodoFilterHistoryService(repo, logger, nil, nil)
 
 		result, err := service.GetFilterHistoryByQueryID(context.Background(), queryID)
 
@@ -39,7 +44,7 @@ func TestTodoFilterHistoryService_GetFilterHistoryByQueryID(t *testing.T) {
 		repo.On("GetFilterHistoryByQueryID", mock.Anything, queryID).Return((*ent.TodoFilterHistory)(nil), assert.AnError)
 
 		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
-		service := services.NewTodoFilterHistoryService(repo, logger)
+		service := services.NewTodoFilterHistoryService(repo, logger, nil, nil)
 
 		result, err := service.GetFilterHistoryByQueryID(context.Background(), queryID)
 
@@ -62,7 +67,7 @@ func TestTodoFilterHistoryService_FetchLatestFilters(t *testing.T) {
 		repo.On("FetchLatestFilters", mock.Anything, 5).Return(expectedHistories, nil)
 
 		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
-		service := services.NewTodoFilterHistoryService(repo, logger)
+		service := services.NewTodoFilterHistoryService(repo, logger, nil, nil)
 
 		result, err := service.FetchLatestFilters(context.Background())
 
@@ -76,7 +81,7 @@ func TestTodoFilterHistoryService_FetchLatestFilters(t *testing.T) {
 		repo.On("FetchLatestFilters", mock.Anything, 5).Return([]*ent.TodoFilterHistory(nil), assert.AnError)
 
 		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
-		service := services.NewTodoFilterHistoryService(repo, logger)
+		service := services.NewTodoFilterHistoryService(repo, logger, nil, nil)
 
 		result, err := service.FetchLatestFilters(context.Background())
 
@@ -99,12 +104,13 @@ func TestTodoFilterHistoryService_SaveFilterHistory(t *testing.T) {
 			Args:         args,
 		}
 
-		repo.On("SaveFilterHistory", mock.Anything, query, &functionName, args, resultTodoIds).Return(expectedHistory, nil)
+		key := services.TodoFilterCacheKey{NormalizedQuery: query, DateBucket: "2026-10-19", PromptVersion: "v1.ja"}
+		repo.On("SaveFilterHistory", mock.Anything, query, query, "2026-10-19", "v1.ja", &functionName, args, resultTodoIds).Return(expectedHistory, nil)
 
 		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
-		service := services.NewTodoFilterHistoryService(repo, logger)
+		service := services.NewTodoFilterHistoryService(repo, logger, nil, nil)
 
-		result, err := service.SaveFilterHistory(context.Background(), query, &functionName, args, resultTodoIds)
+		result, err := service.SaveFilterHistory(context.Background(), query, key, &functionName, args, resultTodoIds)
 
 		assert.NoError(t, err)
 		assert.Equal(t, expectedHistory, result)
@@ -113,16 +119,154 @@ func TestTodoFilterHistoryService_SaveFilterHistory(t *testing.T) {
 
 	t.Run("リポジトリがエラーを返した場合、そのままエラーを返すこと", func(t *testing.T) {
 		repo := new(testutils.MockTodoFilterHistoryRepository)
-		repo.On("SaveFilterHistory", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		repo.On("SaveFilterHistory", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return((*ent.TodoFilterHistory)(nil), assert.AnError)
 
 		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
-		service := services.NewTodoFilterHistoryService(repo, logger)
+		service := services.NewTodoFilterHistoryService(repo, logger, nil, nil)
 
-		result, err := service.SaveFilterHistory(context.Background(), "", nil, nil, nil)
+		result, err := service.SaveFilterHistory(context.Background(), "", services.TodoFilterCacheKey{}, nil, nil, nil)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
 		repo.AssertExpectations(t)
 	})
 }
+
+type blockingGenAIClient struct {
+	calls   atomic.Int32
+	release chan struct{}
+}
+
+func (c *blockingGenAIClient) GenerateContent(ctx context.Context, model string, contents []*genai.Content, config *genai.GenerateContentConfig) (*genai.GenerateContentResponse, error) {
+	c.calls.Add(1)
+	if c.release != nil {
+		<-c.release
+	}
+	return &genai.GenerateContentResponse{
+		Candidates: []*genai.Candidate{
+			{Content: &genai.Content{Parts: []*genai.Part{{FunctionCall: &genai.FunctionCall{Name: "ListTodosByDoneAt", Args: map[string]any{}}}}}},
+		},
+	}, nil
+}
+
+type stubAIFactory struct {
+	client utils.IGenAIClient
+}
+
+func (f *stubAIFactory) GetGeminiClient(ctx context.Context) (utils.IGenAIClient, error) {
+	return f.client, nil
+}
+
+func TestNewTodoFilterCacheKey(t *testing.T) {
+	now := time.Date(2026, 10, 19, 14, 30, 0, 0, time.Local)
+
+	t.Run("表記ゆれを正規化し、日付単位のキーを返すこと", func(t *testing.T) {
+		a := services.NewTodoFilterCacheKey(" 先週完了した　タスク？ ", now, "v1.ja")
+		b := services.NewTodoFilterCacheKey("先週完了した タスク", now, "v1.ja")
+		assert.Equal(t, a, b)
+		assert.Equal(t, "2026-10-19", a.DateBucket)
+	})
+
+	t.Run("日付が変わると別のキーになること", func(t *testing.T) {
+		a := services.NewTodoFilterCacheKey("先週完了したタスク", now, "v1.ja")
+		b := services.NewTodoFilterCacheKey("先週完了したタスク", now.AddDate(0, 0, 1), "v1.ja")
+		assert.NotEqual(t, a, b)
+	})
+
+	t.Run("時間単位の表現は1時間ごとのキーになること", func(t *testing.T) {
+		key := services.NewTodoFilterCacheKey("3時間以内に完了したタスク", now, "v1.ja")
+		assert.Equal(t, "2026-10-19T14", key.DateBucket)
+	})
+
+	t.Run("プロンプトのテンプレートが変わると別のキーになること", func(t *testing.T) {
+		a := services.NewTodoFilterCacheKey("先週完了したタスク", now, "v1.ja")
+		b := services.NewTodoFilterCacheKey("先週完了したタスク", now, "v1.en")
+		assert.NotEqual(t, a, b)
+	})
+
+	t.Run("日付の区切りは指定した時刻のタイムゾーンに従うこと", func(t *testing.T) {
+		utc := time.Date(2026, 10, 19, 20, 0, 0, 0, time.UTC)
+		tokyo, _ := time.LoadLocation("Asia/Tokyo")
+		assert.Equal(t, "2026-10-19", services.NewTodoFilterCacheKey("先週完了したタスク", utc, "v1.ja").DateBucket)
+		assert.Equal(t, "2026-10-20", services.NewTodoFilterCacheKey("先週完了したタスク", utc.In(tokyo), "v1.ja").DateBucket)
+	})
+
+	t.Run("分単位の表現はキャッシュしないこと", func(t *testing.T) {
+		key := services.NewTodoFilterCacheKey("30分前に完了したタスク", now, "v1.ja")
+		assert.Equal(t, "", key.DateBucket)
+	})
+}
+
+func TestTodoFilterHistoryService_DecideFilter(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	key := services.TodoFilterCacheKey{NormalizedQuery: "先週完了したタスク", DateBucket: "2026-10-19", PromptVersion: "v1.ja"}
+
+	t.Run("判定済みの履歴がある場合は AI を呼び出さずに返すこと", func(t *testing.T) {
+		repo := new(testutils.MockTodoFilterHistoryRepository)
+		aiClient := &blockingGenAIClient{}
+		service := services.NewTodoFilterHistoryService(repo, logger, services.NewAIService(nil), &stubAIFactory{client: aiClient})
+
+		repo.On("FindCachedFilter", mock.Anything, key.NormalizedQuery, key.DateBucket, key.PromptVersion).Return(&ent.TodoFilterHistory{
+			FunctionName: "ListTodosByDoneAt",
+			Args:         map[string]interface{}{"done_from": "2026-10-12T00:00:00+09:00"},
+		}, nil)
+
+		result, err := service.DecideFilter(context.Background(), key, "先週完了したタスク")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ListTodosByDoneAt", result.FunctionName)
+		assert.Equal(t, "2026-10-12T00:00:00+09:00", result.Args["done_from"])
+		assert.Equal(t, int32(0), aiClient.calls.Load())
+	})
+
+	t.Run("キャッシュしないキーの場合は履歴を参照せずに AI を呼び出すこと", func(t *testing.T) {
+		repo := new(testutils.MockTodoFilterHistoryRepository)
+		aiClient := &blockingGenAIClient{}
+		service := services.NewTodoFilterHistoryService(repo, logger, services.NewAIService(nil), &stubAIFactory{client: aiClient})
+
+		result, err := service.DecideFilter(context.Background(), services.TodoFilterCacheKey{NormalizedQuery: "30分前", PromptVersion: "v1.ja"}, "30分前")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ListTodosByDoneAt", result.FunctionName)
+		assert.Equal(t, int32(1), aiClient.calls.Load())
+		repo.AssertNotCalled(t, "FindCachedFilter", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("同時に来た同じリクエストは1回の呼び出しを共有すること", func(t *testing.T) {
+		repo := new(testutils.MockTodoFilterHistoryRepository)
+		aiClient := &blockingGenAIClient{release: make(chan struct{})}
+		service := services.NewTodoFilterHistoryService(repo, logger, services.NewAIService(nil), &stubAIFactory{client: aiClient})
+
+		const n = 5
+		var looked sync.WaitGroup
+		looked.Add(n)
+		repo.On("FindCachedFilter", mock.Anything, key.NormalizedQuery, key.DateBucket, key.PromptVersion).
+			Run(func(mock.Arguments) { looked.Done() }).
+			Return(nil, &ent.NotFoundError{})
+
+		var wg sync.WaitGroup
+		results := make([]string, n)
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				result, err := service.DecideFilter(context.Background(), key, "先週完了したタスク")
+				if assert.NoError(t, err) {
+					results[i] = result.FunctionName
+				}
+			}(i)
+		}
+
+		looked.Wait()
+		// 全てのリクエストが AI の応答待ちに合流するまで待つ
+		time.Sleep(50 * time.Millisecond)
+		close(aiClient.release)
+		wg.Wait()
+
+		assert.Equal(t, int32(1), aiClient.calls.Load())
+		for _, name := range results {
+			assert.Equal(t, "ListTodosByDoneAt", name)
+		}
+	})
+}
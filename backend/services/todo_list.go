@@ -0,0 +1,406 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+	"todo-app/app_errors"
+	"todo-app/dto"
+	"todo-app/ent"
+	"todo-app/prompts"
+	"todo-app/repositories"
+	"todo-app/utils"
+
+	"github.com/google/uuid"
+)
+
+// TodoListInviteLifetime はリストへの招待の有効期間
+const TodoListInviteLifetime = 7 * 24 * time.Hour
+
+// TodoListService は共有のリストとメンバー、招待を扱う。
+// リストの閲覧は全てのメンバーが、リストの変更とメンバーの管理は owner の権限のメンバーだけが行える。
+// リストはワークスペースに属し、ワークスペースのメンバーだけをリストのメンバーにできる
+type TodoListService struct {
+	client        *ent.Client
+	logger        *slog.Logger
+	listRepo      repositories.ITodoListRepository
+	inviteRepo    repositories.ITodoListInviteRepository
+	workspaceRepo repositories.IWorkspaceRepository
+	mailer        utils.IMailer
+}
+
+func NewTodoListService(client *ent.Client, logger *slog.Logger, listRepo repositories.ITodoListRepository, inviteRepo repositories.ITodoListInviteRepository, workspaceRepo repositories.IWorkspaceRepository, mailer utils.IMailer) *TodoListService {
+	return &TodoListService{
+		client:        client,
+		logger:        logger,
+		listRepo:      listRepo,
+		inviteRepo:    inviteRepo,
+		workspaceRepo: workspaceRepo,
+		mailer:        mailer,
+	}
+}
+
+// List はログイン中のユーザーが参加しているリストを返す
+func (s *TodoListService) List(ctx context.Context) (*dto.ListTodoListsResponseDto, error) {
+	members, err := s.listRepo.ListMemberships(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &dto.ListTodoListsResponseDto{Data: make([]dto.TodoListDto, len(members))}
+	for i, m := range members {
+		res.Data[i] = toTodoListDto(m.Edges.List, m.Role)
+	}
+	return res, nil
+}
+
+// Create はリストを作成する。作成したユーザーが最初の所有者になる
+func (s *TodoListService) Create(ctx context.Context, name string) (*dto.TodoListDto, error) {
+	txCtx, tx, err := utils.WithTx(ctx, s.client)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := s.listRepo.Create(txCtx, name)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	res := toTodoListDto(l, repositories.TodoListRoleOwner)
+	return &res, nil
+}
+
+// Get はリストとメンバーの一覧を返す
+func (s *TodoListService) Get(ctx context.Context, listID int) (*dto.TodoListDetailDto, error) {
+	member, err := s.listRepo.FindMembership(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.listRepo.ListMembers(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &dto.TodoListDetailDto{
+		TodoListDto: toTodoListDto(member.Edges.List, member.Role),
+		Members:     make([]dto.TodoListMemberDto, len(members)),
+	}
+	for i, m := range members {
+		res.Members[i] = toTodoListMemberDto(m)
+	}
+	return res, nil
+}
+
+func (s *TodoListService) Rename(ctx context.Context, listID int, name string) (*dto.TodoListDto, error) {
+	if _, err := s.requireOwner(ctx, listID); err != nil {
+		return nil, err
+	}
+
+	l, err := s.listRepo.Rename(ctx, listID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	res := toTodoListDto(l, repositories.TodoListRoleOwner)
+	return &res, nil
+}
+
+// Delete はリストを、リストの Todo と合わせて削除する
+func (s *TodoListService) Delete(ctx context.Context, listID int) error {
+	if _, err := s.requireOwner(ctx, listID); err != nil {
+		return err
+	}
+	return s.listRepo.Delete(ctx, listID)
+}
+
+// UpdateMemberRole はメンバーの権限を変更する。最後の所有者は他の権限に変更できない
+func (s *TodoListService) UpdateMemberRole(ctx context.Context, listID int, userID int, role string) (*dto.TodoListMemberDto, error) {
+	if _, err := s.requireOwner(ctx, listID); err != nil {
+		return nil, err
+	}
+
+	txCtx, tx, err := utils.WithTx(ctx, s.client)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := s.listRepo.SetMemberRole(txCtx, listID, userID, role)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err := s.ensureOwnerRemains(txCtx, listID); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	res := toTodoListMemberDto(m)
+	return &res, nil
+}
+
+// RemoveMember はメンバーをリストから外す。所有者は全てのメンバーを、それ以外のメンバーは自分だけを外せる。
+// 最後の所有者は外せないため、リストを削除するか他のメンバーを所有者にしてから外す
+func (s *TodoListService) RemoveMember(ctx context.Context, listID int, userID int) error {
+	member, err := s.listRepo.FindMembership(ctx, listID)
+	if err != nil {
+		return err
+	}
+	if member.UserID != userID && member.Role != repositories.TodoListRoleOwner {
+		return app_errors.ErrTodoListPermissionDenied
+	}
+
+	txCtx, tx, err := utils.WithTx(ctx, s.client)
+	if err != nil {
+		return err
+	}
+
+	if err := s.listRepo.RemoveMember(txCtx, listID, userID); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := s.ensureOwnerRemains(txCtx, listID); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Invite はメールアドレス宛てにリストへの招待を送信する。
+// 招待されたメールアドレスでログインしたユーザーが、メールのリンクから承諾するとメンバーになる。
+// リストのワークスペースのメンバーでないユーザーは招待できない
+func (s *TodoListService) Invite(ctx context.Context, listID int, email string, role string) (*dto.TodoListInviteDto, error) {
+	owner, err := s.requireOwner(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+	u, ok := utils.UserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("user not found in context")
+	}
+
+	members, err := s.listRepo.ListMembers(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		if m.Edges.User != nil && strings.EqualFold(m.Edges.User.Email, email) {
+			return nil, app_errors.ErrAlreadyTodoListMember
+		}
+	}
+	workspaceMembers, err := s.workspaceRepo.ListMembers(ctx, owner.Edges.List.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.ContainsFunc(workspaceMembers, func(m *ent.WorkspaceMember) bool {
+		return m.Edges.User != nil && strings.EqualFold(m.Edges.User.Email, email)
+	}) {
+		return nil, app_errors.ErrNotWorkspaceMember
+	}
+
+	token, err := newRandomToken()
+	if err != nil {
+		return nil, err
+	}
+	invite, err := s.inviteRepo.Create(ctx, listID, email, role, hashToken(token), u.ID, time.Now().Add(TodoListInviteLifetime))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.mailer.Send(ctx, todoListInviteMail(u, owner.Edges.List, email, token)); err != nil {
+		return nil, err
+	}
+
+	res := toTodoListInviteDto(invite)
+	return &res, nil
+}
+
+// ListInvites は承諾待ちの招待を返す
+func (s *TodoListService) ListInvites(ctx context.Context, listID int) (*dto.ListTodoListInvitesResponseDto, error) {
+	if _, err := s.requireOwner(ctx, listID); err != nil {
+		return nil, err
+	}
+
+	invites, err := s.inviteRepo.ListPending(ctx, listID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &dto.ListTodoListInvitesResponseDto{Data: make([]dto.TodoListInviteDto, len(invites))}
+	for i, invite := range invites {
+		res.Data[i] = toTodoListInviteDto(invite)
+	}
+	return res, nil
+}
+
+func (s *TodoListService) RevokeInvite(ctx context.Context, listID int, inviteID uuid.UUID) error {
+	if _, err := s.requireOwner(ctx, listID); err != nil {
+		return err
+	}
+	return s.inviteRepo.Revoke(ctx, listID, inviteID)
+}
+
+// AcceptInvite はメールで送信した招待を承諾し、ログイン中のユーザーをリストのメンバーにする。
+// 招待を転送されても参加できないよう、招待されたメールアドレスのユーザーだけが承諾できる
+func (s *TodoListService) AcceptInvite(ctx context.Context, token string) (*dto.TodoListDto, error) {
+	if token == "" {
+		return nil, app_errors.ErrInvalidTodoListInvite
+	}
+	u, ok := utils.UserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("user not found in context")
+	}
+
+	// 承諾できなかった場合は招待を使用済みにしないよう、トランザクション内で確認する
+	txCtx, tx, err := utils.WithTx(ctx, s.client)
+	if err != nil {
+		return nil, err
+	}
+
+	invite, consumed, err := s.inviteRepo.Consume(txCtx, hashToken(token))
+	if err != nil {
+		_ = tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, app_errors.ErrInvalidTodoListInvite
+		}
+		return nil, err
+	}
+	if !consumed || !time.Now().Before(invite.ExpiresAt) {
+		_ = tx.Rollback()
+		return nil, app_errors.ErrInvalidTodoListInvite
+	}
+	if !strings.EqualFold(invite.Email, u.Email) {
+		_ = tx.Rollback()
+		return nil, app_errors.ErrTodoListInviteEmailMismatch
+	}
+	// 招待した後にワークスペースから外れた場合は承諾できない
+	isMember, err := s.workspaceRepo.IsMember(txCtx, invite.Edges.List.WorkspaceID, u.ID)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if !isMember {
+		_ = tx.Rollback()
+		return nil, app_errors.ErrNotWorkspaceMember
+	}
+
+	if _, err := s.listRepo.AddMember(txCtx, invite.ListID, u.ID, invite.Role); err != nil {
+		_ = tx.Rollback()
+		if ent.IsConstraintError(err) {
+			return nil, app_errors.ErrAlreadyTodoListMember
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	res := toTodoListDto(invite.Edges.List, invite.Role)
+	return &res, nil
+}
+
+// requireOwner はログイン中のユーザーがリストの所有者であることを確認する。
+// メンバーでない場合は NotFoundError を、所有者でない場合は ErrTodoListPermissionDenied を返す
+func (s *TodoListService) requireOwner(ctx context.Context, listID int) (*ent.TodoListMember, error) {
+	member, err := s.listRepo.FindMembership(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+	if member.Role != repositories.TodoListRoleOwner {
+		return nil, app_errors.ErrTodoListPermissionDenied
+	}
+	return member, nil
+}
+
+// ensureOwnerRemains はメンバーの変更後もリストに所有者が残っていることを確認する
+func (s *TodoListService) ensureOwnerRemains(ctx context.Context, listID int) error {
+	n, err := s.listRepo.CountOwners(ctx, listID)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return app_errors.ErrLastTodoListOwner
+	}
+	return nil
+}
+
+func toTodoListDto(l *ent.TodoList, role string) dto.TodoListDto {
+	return dto.TodoListDto{
+		ID:        l.ID,
+		Name:      l.Name,
+		Role:      role,
+		CreatedAt: l.CreatedAt,
+		UpdatedAt: l.UpdatedAt,
+	}
+}
+
+func toTodoListMemberDto(m *ent.TodoListMember) dto.TodoListMemberDto {
+	res := dto.TodoListMemberDto{
+		UserID:   m.UserID,
+		Role:     m.Role,
+		JoinedAt: m.CreatedAt,
+	}
+	if m.Edges.User != nil {
+		res.Name = m.Edges.User.Name
+		res.Email = m.Edges.User.Email
+	}
+	return res
+}
+
+func toTodoListInviteDto(invite *ent.TodoListInvite) dto.TodoListInviteDto {
+	return dto.TodoListInviteDto{
+		ID:        invite.ID.String(),
+		Email:     invite.Email,
+		Role:      invite.Role,
+		ExpiresAt: invite.ExpiresAt,
+		CreatedAt: invite.CreatedAt,
+	}
+}
+
+// todoListInviteURL は招待を承諾する画面の URL にトークンを付与して返す。
+// TODO_LIST_INVITE_URL が未指定の場合は FRONTEND_ORIGIN の /accept-invite を使う
+func todoListInviteURL(token string) string {
+	base := os.Getenv("TODO_LIST_INVITE_URL")
+	if base == "" {
+		base = os.Getenv("FRONTEND_ORIGIN") + "/accept-invite"
+	}
+	return base + "?token=" + url.QueryEscape(token)
+}
+
+// todoListInviteMail は招待のメールを作成する。招待されたユーザーの言語は分からないため、招待したユーザーの言語で送信する
+func todoListInviteMail(inviter *ent.User, l *ent.TodoList, email string, token string) utils.Mail {
+	link := todoListInviteURL(token)
+	days := int(TodoListInviteLifetime.Hours() / 24)
+
+	if prompts.NormalizeLocale(inviter.Locale) == prompts.LocaleEn {
+		return utils.Mail{
+			To:      email,
+			Subject: fmt.Sprintf("%s invited you to \"%s\"", inviter.Name, l.Name),
+			Body: fmt.Sprintf("%s invited you to the shared list \"%s\".\n\nLog in with this email address and open the link below to accept. The link expires in %d days and can be used only once.\n\n%s\n\nIf you do not know the sender, you can ignore this email.\n",
+				inviter.Name, l.Name, days, link),
+		}
+	}
+	return utils.Mail{
+		To:      email,
+		Subject: fmt.Sprintf("%s さんから「%s」に招待されました", inviter.Name, l.Name),
+		Body: fmt.Sprintf("%s さんから共有のリスト「%s」に招待されました。\n\nこのメールアドレスでログインしてから、下記のリンクを開いて承諾してください。リンクの有効期限は %d 日で、1 回だけ使用できます。\n\n%s\n\nお心当たりが無い場合は、このメールを破棄してください。\n",
+			inviter.Name, l.Name, days, link),
+	}
+}
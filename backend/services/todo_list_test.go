@@ -0,0 +1,260 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"todo-app/app_errors"
+	"todo-app/ent"
+	"todo-app/ent/enttest"
+	"todo-app/repositories"
+	"todo-app/testutils"
+	"todo-app/utils"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var todoListCtx = utils.WithUser(context.Background(), &ent.User{ID: 1, Name: "Alice", Email: "alice@example.com", Locale: "en"})
+
+func todoListMembership(userID int, role string) *ent.TodoListMember {
+	return &ent.TodoListMember{
+		ListID: 10,
+		UserID: userID,
+		Role:   role,
+		Edges:  ent.TodoListMemberEdges{List: &ent.TodoList{ID: 10, Name: "Household", WorkspaceID: 5}},
+	}
+}
+
+func TestTodoListService_UpdateMemberRole(t *testing.T) {
+	// トランザクションを開始できるよう、インメモリの SQLite を使う
+	client := enttest.Open(t, "sqlite3", "file:todolist_role?mode=memory&_fk=1")
+	defer func() {
+		if err := client.Close(); err != nil {
+			t.Errorf("failed to close client: %v", err)
+		}
+	}()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("最後の所有者の権限を変更した場合、エラーを返すこと", func(t *testing.T) {
+		listRepo := new(testutils.MockTodoListRepository)
+		listRepo.On("FindMembership", mock.Anything, 10).Return(todoListMembership(1, repositories.TodoListRoleOwner), nil).Once()
+		listRepo.On("SetMemberRole", mock.Anything, 10, 1, repositories.TodoListRoleEditor).Return(&ent.TodoListMember{UserID: 1}, nil).Once()
+		listRepo.On("CountOwners", mock.Anything, 10).Return(0, nil).Once()
+		service := NewTodoListService(client, logger, listRepo, new(testutils.MockTodoListInviteRepository), new(testutils.MockWorkspaceRepository), new(testutils.MockMailer))
+
+		_, err := service.UpdateMemberRole(todoListCtx, 10, 1, repositories.TodoListRoleEditor)
+
+		assert.ErrorIs(t, err, app_errors.ErrLastTodoListOwner)
+	})
+
+	t.Run("所有者以外は権限を変更できないこと", func(t *testing.T) {
+		listRepo := new(testutils.MockTodoListRepository)
+		listRepo.On("FindMembership", mock.Anything, 10).Return(todoListMembership(1, repositories.TodoListRoleEditor), nil).Once()
+		service := NewTodoListService(client, logger, listRepo, new(testutils.MockTodoListInviteRepository), new(testutils.MockWorkspaceRepository), new(testutils.MockMailer))
+
+		_, err := service.UpdateMemberRole(todoListCtx, 10, 2, repositories.TodoListRoleOwner)
+
+		assert.ErrorIs(t, err, app_errors.ErrTodoListPermissionDenied)
+		listRepo.AssertNotCalled(t, "SetMemberRole")
+	})
+}
+
+func TestTodoListService_RemoveMember(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:todolist_remove?mode=memory&_fk=1")
+	defer func() {
+		if err := client.Close(); err != nil {
+			t.Errorf("failed to close client: %v", err)
+		}
+	}()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("所有者以外のメンバーは自分でリストから抜けられること", func(t *testing.T) {
+		listRepo := new(testutils.MockTodoListRepository)
+		listRepo.On("FindMembership", mock.Anything, 10).Return(todoListMembership(1, repositories.TodoListRoleViewer), nil).Once()
+		listRepo.On("RemoveMember", mock.Anything, 10, 1).Return(nil).Once()
+		listRepo.On("CountOwners", mock.Anything, 10).Return(1, nil).Once()
+		service := NewTodoListService(client, logger, listRepo, new(testutils.MockTodoListInviteRepository), new(testutils.MockWorkspaceRepository), new(testutils.MockMailer))
+
+		err := service.RemoveMember(todoListCtx, 10, 1)
+
+		assert.NoError(t, err)
+		listRepo.AssertExpectations(t)
+	})
+
+	t.Run("閲覧者は他のメンバーを外せないこと", func(t *testing.T) {
+		listRepo := new(testutils.MockTodoListRepository)
+		listRepo.On("FindMembership", mock.Anything, 10).Return(todoListMembership(1, repositories.TodoListRoleViewer), nil).Once()
+		service := NewTodoListService(client, logger, listRepo, new(testutils.MockTodoListInviteRepository), new(testutils.MockWorkspaceRepository), new(testutils.MockMailer))
+
+		err := service.RemoveMember(todoListCtx, 10, 2)
+
+		assert.ErrorIs(t, err, app_errors.ErrTodoListPermissionDenied)
+		listRepo.AssertNotCalled(t, "RemoveMember")
+	})
+}
+
+func TestTodoListService_Invite(t *testing.T) {
+	t.Setenv("TODO_LIST_INVITE_URL", "http://localhost:3000/accept-invite")
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("ワークスペースのメンバーに、トークンを記載した招待メールを送信すること", func(t *testing.T) {
+		listRepo := new(testutils.MockTodoListRepository)
+		inviteRepo := new(testutils.MockTodoListInviteRepository)
+		workspaceRepo := new(testutils.MockWorkspaceRepository)
+		mailer := new(testutils.MockMailer)
+		listRepo.On("FindMembership", mock.Anything, 10).Return(todoListMembership(1, repositories.TodoListRoleOwner), nil).Once()
+		listRepo.On("ListMembers", mock.Anything, 10).Return([]*ent.TodoListMember{
+			{UserID: 1, Role: repositories.TodoListRoleOwner, Edges: ent.TodoListMemberEdges{User: &ent.User{ID: 1, Email: "alice@example.com"}}},
+		}, nil).Once()
+		workspaceRepo.On("ListMembers", mock.Anything, 5).Return([]*ent.WorkspaceMember{
+			{UserID: 2, Edges: ent.WorkspaceMemberEdges{User: &ent.User{ID: 2, Email: "bob@example.com"}}},
+		}, nil).Once()
+		inviteRepo.On("Create", mock.Anything, 10, "bob@example.com", repositories.TodoListRoleEditor, mock.AnythingOfType("string"), 1, mock.AnythingOfType("time.Time")).
+			Return(&ent.TodoListInvite{Email: "bob@example.com", Role: repositories.TodoListRoleEditor}, nil).Once()
+		mailer.On("Send", mock.Anything, mock.Anything).Return(nil).Once()
+		service := NewTodoListService(nil, logger, listRepo, inviteRepo, workspaceRepo, mailer)
+
+		res, err := service.Invite(todoListCtx, 10, "bob@example.com", repositories.TodoListRoleEditor)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "bob@example.com", res.Email)
+		sent := mailer.Calls[0].Arguments.Get(1).(utils.Mail)
+		assert.Equal(t, "bob@example.com", sent.To)
+		assert.Contains(t, sent.Subject, "Household")
+		// メールにはハッシュではなく元のトークンを記載する
+		_, token, ok := strings.Cut(sent.Body, "http://localhost:3000/accept-invite?token=")
+		if assert.True(t, ok) {
+			token, _, _ = strings.Cut(token, "\n")
+			assert.Equal(t, hashToken(token), inviteRepo.Calls[0].Arguments.String(4))
+		}
+	})
+
+	t.Run("既にメンバーの場合、招待しないこと", func(t *testing.T) {
+		listRepo := new(testutils.MockTodoListRepository)
+		inviteRepo := new(testutils.MockTodoListInviteRepository)
+		mailer := new(testutils.MockMailer)
+		listRepo.On("FindMembership", mock.Anything, 10).Return(todoListMembership(1, repositories.TodoListRoleOwner), nil).Once()
+		listRepo.On("ListMembers", mock.Anything, 10).Return([]*ent.TodoListMember{
+			{UserID: 2, Role: repositories.TodoListRoleViewer, Edges: ent.TodoListMemberEdges{User: &ent.User{ID: 2, Email: "bob@example.com"}}},
+		}, nil).Once()
+		service := NewTodoListService(nil, logger, listRepo, inviteRepo, new(testutils.MockWorkspaceRepository), mailer)
+
+		_, err := service.Invite(todoListCtx, 10, "BOB@example.com", repositories.TodoListRoleEditor)
+
+		assert.ErrorIs(t, err, app_errors.ErrAlreadyTodoListMember)
+		inviteRepo.AssertNotCalled(t, "Create")
+		mailer.AssertNotCalled(t, "Send")
+	})
+
+	t.Run("ワークスペースのメンバーでない場合、招待しないこと", func(t *testing.T) {
+		listRepo := new(testutils.MockTodoListRepository)
+		inviteRepo := new(testutils.MockTodoListInviteRepository)
+		workspaceRepo := new(testutils.MockWorkspaceRepository)
+		listRepo.On("FindMembership", mock.Anything, 10).Return(todoListMembership(1, repositories.TodoListRoleOwner), nil).Once()
+		listRepo.On("ListMembers", mock.Anything, 10).Return([]*ent.TodoListMember{}, nil).Once()
+		workspaceRepo.On("ListMembers", mock.Anything, 5).Return([]*ent.WorkspaceMember{}, nil).Once()
+		service := NewTodoListService(nil, logger, listRepo, inviteRepo, workspaceRepo, new(testutils.MockMailer))
+
+		_, err := service.Invite(todoListCtx, 10, "bob@example.com", repositories.TodoListRoleEditor)
+
+		assert.ErrorIs(t, err, app_errors.ErrNotWorkspaceMember)
+		inviteRepo.AssertNotCalled(t, "Create")
+	})
+}
+
+func TestTodoListService_AcceptInvite(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:todolist_accept?mode=memory&_fk=1")
+	defer func() {
+		if err := client.Close(); err != nil {
+			t.Errorf("failed to close client: %v", err)
+		}
+	}()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	bobCtx := utils.WithUser(context.Background(), &ent.User{ID: 2, Email: "bob@example.com"})
+	invite := func(expiresAt time.Time) *ent.TodoListInvite {
+		return &ent.TodoListInvite{
+			ListID:    10,
+			Email:     "Bob@example.com",
+			Role:      repositories.TodoListRoleEditor,
+			ExpiresAt: expiresAt,
+			Edges:     ent.TodoListInviteEdges{List: &ent.TodoList{ID: 10, Name: "Household", WorkspaceID: 5}},
+		}
+	}
+
+	t.Run("招待されたメールアドレスのユーザーが、招待された権限でメンバーになること", func(t *testing.T) {
+		listRepo := new(testutils.MockTodoListRepository)
+		inviteRepo := new(testutils.MockTodoListInviteRepository)
+		workspaceRepo := new(testutils.MockWorkspaceRepository)
+		inviteRepo.On("Consume", mock.Anything, hashToken("token")).Return(invite(time.Now().Add(time.Hour)), true, nil).Once()
+		workspaceRepo.On("IsMember", mock.Anything, 5, 2).Return(true, nil).Once()
+		listRepo.On("AddMember", mock.Anything, 10, 2, repositories.TodoListRoleEditor).Return(&ent.TodoListMember{}, nil).Once()
+		service := NewTodoListService(client, logger, listRepo, inviteRepo, workspaceRepo, new(testutils.MockMailer))
+
+		res, err := service.AcceptInvite(bobCtx, "token")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 10, res.ID)
+		assert.Equal(t, repositories.TodoListRoleEditor, res.Role)
+	})
+
+	t.Run("ワークスペースから抜けている場合、メンバーにしないこと", func(t *testing.T) {
+		listRepo := new(testutils.MockTodoListRepository)
+		inviteRepo := new(testutils.MockTodoListInviteRepository)
+		workspaceRepo := new(testutils.MockWorkspaceRepository)
+		inviteRepo.On("Consume", mock.Anything, hashToken("token")).Return(invite(time.Now().Add(time.Hour)), true, nil).Once()
+		workspaceRepo.On("IsMember", mock.Anything, 5, 2).Return(false, nil).Once()
+		service := NewTodoListService(client, logger, listRepo, inviteRepo, workspaceRepo, new(testutils.MockMailer))
+
+		_, err := service.AcceptInvite(bobCtx, "token")
+
+		assert.ErrorIs(t, err, app_errors.ErrNotWorkspaceMember)
+		listRepo.AssertNotCalled(t, "AddMember")
+	})
+
+	t.Run("招待されたメールアドレス以外のユーザーは承諾できないこと", func(t *testing.T) {
+		listRepo := new(testutils.MockTodoListRepository)
+		inviteRepo := new(testutils.MockTodoListInviteRepository)
+		inviteRepo.On("Consume", mock.Anything, hashToken("token")).Return(invite(time.Now().Add(time.Hour)), true, nil).Once()
+		service := NewTodoListService(client, logger, listRepo, inviteRepo, new(testutils.MockWorkspaceRepository), new(testutils.MockMailer))
+
+		_, err := service.AcceptInvite(todoListCtx, "token")
+
+		assert.ErrorIs(t, err, app_errors.ErrTodoListInviteEmailMismatch)
+		listRepo.AssertNotCalled(t, "AddMember")
+	})
+
+	t.Run("招待が無効な場合、エラーを返すこと", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			invite   *ent.TodoListInvite
+			consumed bool
+		}{
+			{name: "有効期限が切れた招待の場合", invite: invite(time.Now().Add(-time.Hour)), consumed: true},
+			{name: "使用済みの招待の場合", invite: invite(time.Now().Add(time.Hour)), consumed: false},
+			{name: "存在しない招待の場合", invite: nil},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				listRepo := new(testutils.MockTodoListRepository)
+				inviteRepo := new(testutils.MockTodoListInviteRepository)
+				if tt.invite != nil {
+					inviteRepo.On("Consume", mock.Anything, hashToken("token")).Return(tt.invite, tt.consumed, nil).Once()
+				} else {
+					inviteRepo.On("Consume", mock.Anything, hashToken("token")).Return(nil, false, &ent.NotFoundError{}).Once()
+				}
+				service := NewTodoListService(client, logger, listRepo, inviteRepo, new(testutils.MockWorkspaceRepository), new(testutils.MockMailer))
+
+				_, err := service.AcceptInvite(bobCtx, "token")
+
+				assert.ErrorIs(t, err, app_errors.ErrInvalidTodoListInvite)
+				listRepo.AssertNotCalled(t, "AddMember")
+			})
+		}
+	})
+}
@@ -0,0 +1,193 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+	"todo-app/dto"
+	"todo-app/ent"
+	"todo-app/prompts"
+	"todo-app/repositories"
+	"todo-app/utils"
+)
+
+type TodoSummaryService struct {
+	logger    *slog.Logger
+	todoRepo  repositories.ITodoRepository
+	repo      repositories.ITodoSummaryRepository
+	aiService *AIService
+	aiFactory utils.IAIFactory
+}
+
+func NewTodoSummaryService(logger *slog.Logger, todoRepo repositories.ITodoRepository, repo repositories.ITodoSummaryRepository, aiService *AIService, aiFactory utils.IAIFactory) *TodoSummaryService {
+	return &TodoSummaryService{
+		logger:    logger,
+		todoRepo:  todoRepo,
+		repo:      repo,
+		aiService: aiService,
+		aiFactory: aiFactory,
+	}
+}
+
+// Summarize は期間内に完了した ToDo のサマリーを返す。
+// 対象の ToDo に変化が無ければキャッシュを返し、AI は呼び出さない。
+func (s *TodoSummaryService) Summarize(ctx context.Context, from time.Time, to time.Time, refresh bool) (*dto.TodoSummaryResponseDto, error) {
+	// DB の精度に合わせてキャッシュのキーを揃える
+	from = from.UTC().Truncate(time.Second)
+	to = to.UTC().Truncate(time.Second)
+
+	todos, err := s.todoRepo.FetchTodosByDoneAt(ctx, &from, &to)
+	if err != nil {
+		return nil, err
+	}
+	settings := PromptSettingsFromContext(ctx)
+	sourceHash := todoSummarySourceHash(todos, settings)
+
+	if !refresh {
+		cached, err := s.repo.FindSummary(ctx, from, to)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		}
+		if cached != nil && cached.SourceHash == sourceHash {
+			var content dto.TodoSummaryContentDto
+			if err := json.Unmarshal([]byte(cached.Content), &content); err != nil {
+				return nil, err
+			}
+			return &dto.TodoSummaryResponseDto{
+				From:                  from,
+				To:                    to,
+				TodoCount:             len(todos),
+				Cached:                true,
+				GeneratedAt:           cached.UpdatedAt,
+				Markdown:              cached.Markdown,
+				TodoSummaryContentDto: content,
+			}, nil
+		}
+	}
+
+	if len(todos) == 0 {
+		content := dto.TodoSummaryContentDto{
+			Overview:   todoSummaryTextFor(settings.Locale).empty,
+			Highlights: []string{},
+			Sections:   []dto.TodoSummarySectionDto{},
+		}
+		return &dto.TodoSummaryResponseDto{
+			From:                  from,
+			To:                    to,
+			TodoCount:             0,
+			GeneratedAt:           time.Now(),
+			Markdown:              renderTodoSummaryMarkdown(ctx, from, to, &content, todos),
+			TodoSummaryContentDto: content,
+		}, nil
+	}
+
+	aiClient, err := s.aiFactory.GetGeminiClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := s.aiService.SummarizeTodos(ctx, aiClient, todos, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	contentJSON, err := json.Marshal(content)
+	if err != nil {
+		return nil, err
+	}
+	markdown := renderTodoSummaryMarkdown(ctx, from, to, content, todos)
+
+	saved, err := s.repo.SaveSummary(ctx, from, to, sourceHash, geminiModel, string(contentJSON), markdown)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.TodoSummaryResponseDto{
+		From:                  from,
+		To:                    to,
+		TodoCount:             len(todos),
+		GeneratedAt:           saved.UpdatedAt,
+		Markdown:              markdown,
+		TodoSummaryContentDto: *content,
+	}, nil
+}
+
+// todoSummarySourceHash は ToDo の追加・更新・完了取り消しを検知するためのハッシュを返す。
+// 言語やプロンプトのバージョン、Markdown の日付に使うタイムゾーンが変わった場合も作り直すため、ハッシュに含める
+func todoSummarySourceHash(todos []*ent.Todo, settings PromptSettings) string {
+	keys := make([]string, len(todos), len(todos)+1)
+	for i, t := range todos {
+		doneAt := ""
+		if t.DoneAt != nil {
+			doneAt = t.DoneAt.UTC().Format(time.RFC3339)
+		}
+		keys[i] = fmt.Sprintf("%d:%s:%s", t.ID, t.UpdatedAt.UTC().Format(time.RFC3339), doneAt)
+	}
+	sort.Strings(keys)
+	keys = append(keys, fmt.Sprintf("prompt:%s:%s", prompts.CurrentID(prompts.SummarizeTodos, settings.Locale), settings.Location))
+
+	sum := sha256.Sum256([]byte(strings.Join(keys, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// todoSummaryText はユーザーの言語に合わせたサマリーの定型文
+type todoSummaryText struct {
+	title      string
+	highlights string
+	empty      string
+}
+
+func todoSummaryTextFor(locale string) todoSummaryText {
+	if prompts.NormalizeLocale(locale) == prompts.LocaleEn {
+		return todoSummaryText{
+			title:      "Retrospective",
+			highlights: "Highlights",
+			empty:      "No todos were completed in this period.",
+		}
+	}
+	return todoSummaryText{
+		title:      "振り返り",
+		highlights: "ハイライト",
+		empty:      "対象期間に完了した ToDo はありません。",
+	}
+}
+
+func renderTodoSummaryMarkdown(ctx context.Context, from time.Time, to time.Time, content *dto.TodoSummaryContentDto, todos []*ent.Todo) string {
+	settings := PromptSettingsFromContext(ctx)
+	loc := settings.Location
+	text := todoSummaryTextFor(settings.Locale)
+
+	titles := make(map[int]string, len(todos))
+	for _, t := range todos {
+		titles[t.ID] = t.Title
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "## %s (%s 〜 %s)\n\n", text.title, from.In(loc).Format("2006-01-02"), to.In(loc).Format("2006-01-02"))
+	fmt.Fprintf(&b, "%s\n", content.Overview)
+
+	if len(content.Highlights) > 0 {
+		fmt.Fprintf(&b, "\n### %s\n\n", text.highlights)
+		for _, h := range content.Highlights {
+			fmt.Fprintf(&b, "- %s\n", h)
+		}
+	}
+
+	for _, section := range content.Sections {
+		fmt.Fprintf(&b, "\n### %s\n\n%s\n", section.Heading, section.Summary)
+		if len(section.TodoIDs) > 0 {
+			b.WriteString("\n")
+			for _, id := range section.TodoIDs {
+				fmt.Fprintf(&b, "- %s\n", titles[id])
+			}
+		}
+	}
+
+	return b.String()
+}
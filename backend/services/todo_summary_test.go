@@ -0,0 +1,155 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+	"todo-app/ent"
+	"todo-app/testutils"
+	"todo-app/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/genai"
+)
+
+type fakeAIFactory struct {
+	client utils.IGenAIClient
+}
+
+func (f *fakeAIFactory) GetGeminiClient(ctx context.Context) (utils.IGenAIClient, error) {
+	return f.client, nil
+}
+
+func TestTodoSummaryService_Summarize(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	from := time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 10, 18, 23, 59, 59, 0, time.UTC)
+	doneAt := time.Date(2026, 10, 14, 10, 0, 0, 0, time.UTC)
+	todos := []*ent.Todo{
+		{ID: 1, Title: "資料を作る", Description: "週次報告", DoneAt: &doneAt, UpdatedAt: doneAt},
+		{ID: 2, Title: "レビューする", Description: "PR", DoneAt: &doneAt, UpdatedAt: doneAt},
+	}
+	summaryResponse := &genai.GenerateContentResponse{
+		Candidates: []*genai.Candidate{
+			{Content: &genai.Content{Parts: []*genai.Part{{Text: `{"overview":"資料作成とレビューを進めた","highlights":["週次報告を提出"],"sections":[{"heading":"ドキュメント","summary":"資料を作成","todo_ids":[1]}]}`}}}},
+		},
+	}
+
+	t.Run("要約を生成して保存すること", func(t *testing.T) {
+		todoRepo := new(testutils.MockTodoRepository)
+		repo := new(testutils.MockTodoSummaryRepository)
+		mockClient := new(MockGenAIClient)
+		s := NewTodoSummaryService(logger, todoRepo, repo, NewAIService(todoRepo), &fakeAIFactory{client: mockClient})
+
+		todoRepo.On("FetchTodosByDoneAt", mock.Anything, &from, &to).Return(todos, nil)
+		repo.On("FindSummary", mock.Anything, from, to).Return(nil, &ent.NotFoundError{})
+		mockClient.On("GenerateContent", ctx, "gemini-3-flash-preview", mock.Anything, mock.Anything).Return(summaryResponse, nil).Once()
+		repo.On("SaveSummary", mock.Anything, from, to, todoSummarySourceHash(todos, PromptSettingsFromContext(ctx)), "gemini-3-flash-preview", mock.Anything, mock.MatchedBy(func(markdown string) bool {
+			return assert.Contains(t, markdown, "### ドキュメント") && assert.Contains(t, markdown, "- 資料を作る")
+		})).Return(&ent.TodoSummary{UpdatedAt: time.Now()}, nil)
+
+		res, err := s.Summarize(ctx, from, to, false)
+		assert.NoError(t, err)
+		assert.False(t, res.Cached)
+		assert.Equal(t, 2, res.TodoCount)
+		assert.Equal(t, "資料作成とレビューを進めた", res.Overview)
+		assert.Equal(t, []int{1}, res.Sections[0].TodoIDs)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ToDo に変化が無ければキャッシュを返すこと", func(t *testing.T) {
+		todoRepo := new(testutils.MockTodoRepository)
+		repo := new(testutils.MockTodoSummaryRepository)
+		mockClient := new(MockGenAIClient)
+		s := NewTodoSummaryService(logger, todoRepo, repo, NewAIService(todoRepo), &fakeAIFactory{client: mockClient})
+
+		todoRepo.On("FetchTodosByDoneAt", mock.Anything, &from, &to).Return(todos, nil)
+		repo.On("FindSummary", mock.Anything, from, to).Return(&ent.TodoSummary{
+			SourceHash: todoSummarySourceHash(todos, PromptSettingsFromContext(ctx)),
+			Content:    `{"overview":"キャッシュ","highlights":[],"sections":[]}`,
+			Markdown:   "## cached",
+		}, nil)
+
+		res, err := s.Summarize(ctx, from, to, false)
+		assert.NoError(t, err)
+		assert.True(t, res.Cached)
+		assert.Equal(t, "キャッシュ", res.Overview)
+		assert.Equal(t, "## cached", res.Markdown)
+		mockClient.AssertNotCalled(t, "GenerateContent", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("ToDo が更新されていればキャッシュを使わず再生成すること", func(t *testing.T) {
+		todoRepo := new(testutils.MockTodoRepository)
+		repo := new(testutils.MockTodoSummaryRepository)
+		mockClient := new(MockGenAIClient)
+		s := NewTodoSummaryService(logger, todoRepo, repo, NewAIService(todoRepo), &fakeAIFactory{client: mockClient})
+
+		todoRepo.On("FetchTodosByDoneAt", mock.Anything, &from, &to).Return(todos, nil)
+		repo.On("FindSummary", mock.Anything, from, to).Return(&ent.TodoSummary{SourceHash: "stale"}, nil)
+		mockClient.On("GenerateContent", ctx, "gemini-3-flash-preview", mock.Anything, mock.Anything).Return(summaryResponse, nil).Once()
+		repo.On("SaveSummary", mock.Anything, from, to, todoSummarySourceHash(todos, PromptSettingsFromContext(ctx)), "gemini-3-flash-preview", mock.Anything, mock.Anything).Return(&ent.TodoSummary{}, nil)
+
+		res, err := s.Summarize(ctx, from, to, false)
+		assert.NoError(t, err)
+		assert.False(t, res.Cached)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("完了した ToDo が無い場合は AI を呼び出さないこと", func(t *testing.T) {
+		todoRepo := new(testutils.MockTodoRepository)
+		repo := new(testutils.MockTodoSummaryRepository)
+		mockClient := new(MockGenAIClient)
+		s := NewTodoSummaryService(logger, todoRepo, repo, NewAIService(todoRepo), &fakeAIFactory{client: mockClient})
+
+		todoRepo.On("FetchTodosByDoneAt", mock.Anything, &from, &to).Return([]*ent.Todo{}, nil)
+		repo.On("FindSummary", mock.Anything, from, to).Return(nil, &ent.NotFoundError{})
+
+		res, err := s.Summarize(ctx, from, to, false)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, res.TodoCount)
+		assert.Empty(t, res.Sections)
+		mockClient.AssertNotCalled(t, "GenerateContent", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		repo.AssertNotCalled(t, "SaveSummary", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("言語が変わった場合はキャッシュを使わず再生成すること", func(t *testing.T) {
+		todoRepo := new(testutils.MockTodoRepository)
+		repo := new(testutils.MockTodoSummaryRepository)
+		mockClient := new(MockGenAIClient)
+		s := NewTodoSummaryService(logger, todoRepo, repo, NewAIService(todoRepo), &fakeAIFactory{client: mockClient})
+		jaCtx := utils.WithUser(ctx, &ent.User{ID: 1, Locale: "ja", TimeZone: "Asia/Tokyo"})
+		enCtx := utils.WithUser(ctx, &ent.User{ID: 1, Locale: "en", TimeZone: "Asia/Tokyo"})
+		todoRepo.On("FetchTodosByDoneAt", mock.Anything, &from, &to).Return(todos, nil)
+		repo.On("FindSummary", mock.Anything, from, to).Return(&ent.TodoSummary{SourceHash: todoSummarySourceHash(todos, PromptSettingsFromContext(jaCtx))}, nil)
+		mockClient.On("GenerateContent", enCtx, "gemini-3-flash-preview", mock.Anything, mock.Anything).Return(summaryResponse, nil).Once()
+		repo.On("SaveSummary", mock.Anything, from, to, todoSummarySourceHash(todos, PromptSettingsFromContext(enCtx)), "gemini-3-flash-preview", mock.Anything, mock.MatchedBy(func(markdown string) bool {
+			return assert.Contains(t, markdown, "## Retrospective") && assert.Contains(t, markdown, "### Highlights")
+		})).Return(&ent.TodoSummary{}, nil)
+
+		res, err := s.Summarize(enCtx, from, to, false)
+
+		assert.NoError(t, err)
+		assert.False(t, res.Cached)
+		mockClient.AssertExpectations(t)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("完了した ToDo が無い場合はユーザーの言語で概要を返すこと", func(t *testing.T) {
+		todoRepo := new(testutils.MockTodoRepository)
+		repo := new(testutils.MockTodoSummaryRepository)
+		s := NewTodoSummaryService(logger, todoRepo, repo, NewAIService(todoRepo), &fakeAIFactory{client: new(MockGenAIClient)})
+		enCtx := utils.WithUser(ctx, &ent.User{ID: 1, Locale: "en"})
+		todoRepo.On("FetchTodosByDoneAt", mock.Anything, &from, &to).Return([]*ent.Todo{}, nil)
+		repo.On("FindSummary", mock.Anything, from, to).Return(nil, &ent.NotFoundError{})
+
+		res, err := s.Summarize(enCtx, from, to, false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "No todos were completed in this period.", res.Overview)
+		assert.Contains(t, res.Markdown, "## Retrospective")
+	})
+}
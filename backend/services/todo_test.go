@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"testing"
 	"time"
+	"todo-app/dto"
 	"todo-app/ent"
 	"todo-app/ent/enttest"
 	"todo-app/services"
@@ -43,7 +44,7 @@ func TestTodoService_UpdateTodo(t *testing.T) {
 
 		ctx := context.Background()
 		ctx = ent.NewContext(ctx, client)
-		service := services.NewTodoService(client, slog.New(slog.NewTextHandler(io.Discard, nil)), repo)
+		service := services.NewTodoService(client, slog.New(slog.NewTextHandler(io.Discard, nil)), repo, new(testutils.MockTodoListRepository))
 
 		result, err := service.UpdateTodo(ctx, 1, &title, &desc)
 
@@ -65,7 +66,7 @@ func TestTodoService_UpdateTodo(t *testing.T) {
 
 		ctx := context.Background()
 		ctx = ent.NewContext(ctx, client)
-		service := services.NewTodoService(client, slog.New(slog.NewTextHandler(io.Discard, nil)), repo)
+		service := services.NewTodoService(client, slog.New(slog.NewTextHandler(io.Discard, nil)), repo, new(testutils.MockTodoListRepository))
 
 		result, err := service.UpdateTodo(ctx, 1, &title, nil)
 
@@ -87,7 +88,7 @@ func TestTodoService_UpdateTodo(t *testing.T) {
 
 		ctx := context.Background()
 		ctx = ent.NewContext(ctx, client)
-		service := services.NewTodoService(client, slog.New(slog.NewTextHandler(io.Discard, nil)), repo)
+		service := services.NewTodoService(client, slog.New(slog.NewTextHandler(io.Discard, nil)), repo, new(testutils.MockTodoListRepository))
 
 		result, err := service.UpdateTodo(ctx, 1, nil, nil)
 
@@ -124,7 +125,7 @@ func TestTodoService_UpdateDoneStatus(t *testing.T) {
 
 		ctx := context.Background()
 		ctx = ent.NewContext(ctx, client)
-		service := services.NewTodoService(client, slog.New(slog.NewTextHandler(io.Discard, nil)), repo)
+		service := services.NewTodoService(client, slog.New(slog.NewTextHandler(io.Discard, nil)), repo, new(testutils.MockTodoListRepository))
 
 		result, err := service.UpdateDoneStatus(ctx, 1, true)
 
@@ -146,7 +147,7 @@ func TestTodoService_UpdateDoneStatus(t *testing.T) {
 
 		ctx := context.Background()
 		ctx = ent.NewContext(ctx, client)
-		service := services.NewTodoService(client, slog.New(slog.NewTextHandler(io.Discard, nil)), repo)
+		service := services.NewTodoService(client, slog.New(slog.NewTextHandler(io.Discard, nil)), repo, new(testutils.MockTodoListRepository))
 
 		result, err := service.UpdateDoneStatus(ctx, 1, true)
 
@@ -168,7 +169,7 @@ func TestTodoService_DeleteTodo(t *testing.T) {
 		repo := new(testutils.MockTodoRepository)
 		repo.On("DeleteTodo", mock.Anything, 1).Return(nil)
 		ctx := context.Background()
-		service := services.NewTodoService(client, slog.New(slog.NewTextHandler(io.Discard, nil)), repo)
+		service := services.NewTodoService(client, slog.New(slog.NewTextHandler(io.Discard, nil)), repo, new(testutils.MockTodoListRepository))
 
 		err := service.DeleteTodo(ctx, 1)
 
@@ -179,7 +180,7 @@ func TestTodoService_DeleteTodo(t *testing.T) {
 		repo := new(testutils.MockTodoRepository)
 		repo.On("DeleteTodo", mock.Anything, 1).Return(errors.New("db error"))
 		ctx := context.Background()
-		service := services.NewTodoService(client, slog.New(slog.NewTextHandler(io.Discard, nil)), repo)
+		service := services.NewTodoService(client, slog.New(slog.NewTextHandler(io.Discard, nil)), repo, new(testutils.MockTodoListRepository))
 
 		err := service.DeleteTodo(ctx, 1)
 
@@ -203,7 +204,7 @@ func TestTodoService_FetchTodosByIds(t *testing.T) {
 		repo.On("FetchTodosByIds", mock.Anything, ids).Return(expectedTodos, nil)
 
 		ctx := context.Background()
-		service := services.NewTodoService(client, slog.New(slog.NewTextHandler(io.Discard, nil)), repo)
+		service := services.NewTodoService(client, slog.New(slog.NewTextHandler(io.Discard, nil)), repo, new(testutils.MockTodoListRepository))
 
 		result, err := service.FetchTodosByIds(ctx, ids)
 
@@ -212,3 +213,49 @@ func TestTodoService_FetchTodosByIds(t *testing.T) {
 		repo.AssertExpectations(t)
 	})
 }
+
+func TestTodoService_CreateTodos(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		if err := client.Close(); err != nil {
+			t.Errorf("failed to close client: %v", err)
+		}
+	}()
+
+	drafts := []dto.TodoDraftDto{
+		{Title: "Todo 1", Description: "Desc 1"},
+		{Title: "Todo 2", Description: "Desc 2"},
+	}
+
+	t.Run("下書きごとに CreateTodo を呼び出し、作成結果を返すこと", func(t *testing.T) {
+		repo := new(testutils.MockTodoRepository)
+		repo.On("CreateTodo", mock.Anything, "Todo 1", "Desc 1", (*int)(nil)).Return(&ent.Todo{ID: 1, Title: "Todo 1"}, nil)
+		repo.On("CreateTodo", mock.Anything, "Todo 2", "Desc 2", (*int)(nil)).Return(&ent.Todo{ID: 2, Title: "Todo 2"}, nil)
+
+		ctx := context.Background()
+		service := services.NewTodoService(client, slog.New(slog.NewTextHandler(io.Discard, nil)), repo, new(testutils.MockTodoListRepository))
+
+		result, err := service.CreateTodos(ctx, drafts)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, 1, result[0].ID)
+		assert.Equal(t, 2, result[1].ID)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("途中でエラーが発生した場合、エラーを返すこと", func(t *testing.T) {
+		repo := new(testutils.MockTodoRepository)
+		repo.On("CreateTodo", mock.Anything, "Todo 1", "Desc 1", (*int)(nil)).Return(&ent.Todo{ID: 1, Title: "Todo 1"}, nil)
+		repo.On("CreateTodo", mock.Anything, "Todo 2", "Desc 2", (*int)(nil)).Return((*ent.Todo)(nil), errors.New("db error"))
+
+		ctx := context.Background()
+		service := services.NewTodoService(client, slog.New(slog.NewTextHandler(io.Discard, nil)), repo, new(testutils.MockTodoListRepository))
+
+		result, err := service.CreateTodos(ctx, drafts)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Equal(t, "db error", err.Error())
+	})
+}
@@ -0,0 +1,411 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+	"todo-app/app_errors"
+	"todo-app/dto"
+	"todo-app/ent"
+	"todo-app/prompts"
+	"todo-app/repositories"
+	"todo-app/utils"
+
+	"github.com/google/uuid"
+)
+
+// WorkspaceInviteLifetime はワークスペースへの招待の有効期間
+const WorkspaceInviteLifetime = 7 * 24 * time.Hour
+
+// WorkspaceService はワークスペースとメンバー、招待を扱う。
+// ワークスペースの閲覧は全てのメンバーが、変更とメンバーの管理は admin の権限のメンバーだけが行える
+type WorkspaceService struct {
+	client     *ent.Client
+	logger     *slog.Logger
+	repo       repositories.IWorkspaceRepository
+	inviteRepo repositories.IWorkspaceInviteRepository
+	mailer     utils.IMailer
+}
+
+func NewWorkspaceService(client *ent.Client, logger *slog.Logger, repo repositories.IWorkspaceRepository, inviteRepo repositories.IWorkspaceInviteRepository, mailer utils.IMailer) *WorkspaceService {
+	return &WorkspaceService{
+		client:     client,
+		logger:     logger,
+		repo:       repo,
+		inviteRepo: inviteRepo,
+		mailer:     mailer,
+	}
+}
+
+// List はログイン中のユーザーが参加しているワークスペースを返す。
+// 個人のワークスペースは Todo を初めて扱う際に作成するため、まだ作成していない場合はここで作成して一覧に含める
+func (s *WorkspaceService) List(ctx context.Context) (*dto.ListWorkspacesResponseDto, error) {
+	u, ok := utils.UserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("user not found in context")
+	}
+	if _, err := s.repo.EnsurePersonal(ctx, u.ID); err != nil {
+		return nil, err
+	}
+
+	members, err := s.repo.ListMemberships(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &dto.ListWorkspacesResponseDto{Data: make([]dto.WorkspaceDto, len(members))}
+	for i, m := range members {
+		res.Data[i] = toWorkspaceDto(m.Edges.Workspace, m.Role)
+	}
+	return res, nil
+}
+
+// Create はチームのワークスペースを作成する。作成したユーザーが最初の管理者になる
+func (s *WorkspaceService) Create(ctx context.Context, name string) (*dto.WorkspaceDto, error) {
+	txCtx, tx, err := utils.WithTx(ctx, s.client)
+	if err != nil {
+		return nil, err
+	}
+
+	ws, err := s.repo.Create(txCtx, name)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	res := toWorkspaceDto(ws, repositories.WorkspaceRoleAdmin)
+	return &res, nil
+}
+
+// Get はワークスペースとメンバーの一覧を返す
+func (s *WorkspaceService) Get(ctx context.Context, workspaceID int) (*dto.WorkspaceDetailDto, error) {
+	member, err := s.repo.FindMembership(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.repo.ListMembers(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &dto.WorkspaceDetailDto{
+		WorkspaceDto: toWorkspaceDto(member.Edges.Workspace, member.Role),
+		Members:      make([]dto.WorkspaceMemberDto, len(members)),
+	}
+	for i, m := range members {
+		res.Members[i] = toWorkspaceMemberDto(m)
+	}
+	return res, nil
+}
+
+func (s *WorkspaceService) Rename(ctx context.Context, workspaceID int, name string) (*dto.WorkspaceDto, error) {
+	if _, err := s.requireAdmin(ctx, workspaceID); err != nil {
+		return nil, err
+	}
+
+	ws, err := s.repo.Rename(ctx, workspaceID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	res := toWorkspaceDto(ws, repositories.WorkspaceRoleAdmin)
+	return &res, nil
+}
+
+// Delete はワークスペースを、ワークスペースの Todo やリストと合わせて削除する。個人のワークスペースは削除できない
+func (s *WorkspaceService) Delete(ctx context.Context, workspaceID int) error {
+	member, err := s.requireAdmin(ctx, workspaceID)
+	if err != nil {
+		return err
+	}
+	if member.Edges.Workspace.PersonalOwnerID != nil {
+		return app_errors.ErrPersonalWorkspace
+	}
+	return s.repo.Delete(ctx, workspaceID)
+}
+
+// Invite はメールアドレス宛てにワークスペースへの招待を送信する。
+// 本人の同意なくメンバーにしないよう、招待されたメールアドレスでログインしたユーザーが、メールのリンクから承諾するとメンバーになる
+func (s *WorkspaceService) Invite(ctx context.Context, workspaceID int, email string, role string) (*dto.WorkspaceInviteDto, error) {
+	admin, err := s.requireTeamAdmin(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	u, ok := utils.UserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("user not found in context")
+	}
+
+	members, err := s.repo.ListMembers(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		if m.Edges.User != nil && strings.EqualFold(m.Edges.User.Email, email) {
+			return nil, app_errors.ErrAlreadyWorkspaceMember
+		}
+	}
+
+	token, err := newRandomToken()
+	if err != nil {
+		return nil, err
+	}
+	invite, err := s.inviteRepo.Create(ctx, workspaceID, email, role, hashToken(token), u.ID, time.Now().Add(WorkspaceInviteLifetime))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.mailer.Send(ctx, workspaceInviteMail(u, admin.Edges.Workspace, email, token)); err != nil {
+		return nil, err
+	}
+
+	res := toWorkspaceInviteDto(invite)
+	return &res, nil
+}
+
+// ListInvites は承諾待ちの招待を返す
+func (s *WorkspaceService) ListInvites(ctx context.Context, workspaceID int) (*dto.ListWorkspaceInvitesResponseDto, error) {
+	if _, err := s.requireTeamAdmin(ctx, workspaceID); err != nil {
+		return nil, err
+	}
+
+	invites, err := s.inviteRepo.ListPending(ctx, workspaceID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &dto.ListWorkspaceInvitesResponseDto{Data: make([]dto.WorkspaceInviteDto, len(invites))}
+	for i, invite := range invites {
+		res.Data[i] = toWorkspaceInviteDto(invite)
+	}
+	return res, nil
+}
+
+func (s *WorkspaceService) RevokeInvite(ctx context.Context, workspaceID int, inviteID uuid.UUID) error {
+	if _, err := s.requireTeamAdmin(ctx, workspaceID); err != nil {
+		return err
+	}
+	return s.inviteRepo.Revoke(ctx, workspaceID, inviteID)
+}
+
+// AcceptInvite はメールで送信した招待を承諾し、ログイン中のユーザーをワークスペースのメンバーにする。
+// 招待を転送されても参加できないよう、招待されたメールアドレスのユーザーだけが承諾できる
+func (s *WorkspaceService) AcceptInvite(ctx context.Context, token string) (*dto.WorkspaceDto, error) {
+	if token == "" {
+		return nil, app_errors.ErrInvalidWorkspaceInvite
+	}
+	u, ok := utils.UserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("user not found in context")
+	}
+
+	// 承諾できなかった場合は招待を使用済みにしないよう、トランザクション内で確認する
+	txCtx, tx, err := utils.WithTx(ctx, s.client)
+	if err != nil {
+		return nil, err
+	}
+
+	invite, consumed, err := s.inviteRepo.Consume(txCtx, hashToken(token))
+	if err != nil {
+		_ = tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, app_errors.ErrInvalidWorkspaceInvite
+		}
+		return nil, err
+	}
+	if !consumed || !time.Now().Before(invite.ExpiresAt) {
+		_ = tx.Rollback()
+		return nil, app_errors.ErrInvalidWorkspaceInvite
+	}
+	if !strings.EqualFold(invite.Email, u.Email) {
+		_ = tx.Rollback()
+		return nil, app_errors.ErrWorkspaceInviteEmailMismatch
+	}
+
+	if _, err := s.repo.AddMember(txCtx, invite.WorkspaceID, u.ID, invite.Role); err != nil {
+		_ = tx.Rollback()
+		if ent.IsConstraintError(err) {
+			return nil, app_errors.ErrAlreadyWorkspaceMember
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	res := toWorkspaceDto(invite.Edges.Workspace, invite.Role)
+	return &res, nil
+}
+
+// UpdateMemberRole はメンバーの権限を変更する。最後の管理者は他の権限に変更できない
+func (s *WorkspaceService) UpdateMemberRole(ctx context.Context, workspaceID int, userID int, role string) (*dto.WorkspaceMemberDto, error) {
+	if _, err := s.requireTeamAdmin(ctx, workspaceID); err != nil {
+		return nil, err
+	}
+
+	txCtx, tx, err := utils.WithTx(ctx, s.client)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := s.repo.SetMemberRole(txCtx, workspaceID, userID, role)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err := s.ensureAdminRemains(txCtx, workspaceID); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	res := toWorkspaceMemberDto(m)
+	return &res, nil
+}
+
+// RemoveMember はメンバーをワークスペースから外す。管理者は全てのメンバーを、それ以外のメンバーは自分だけを外せる。
+// 最後の管理者は外せないため、ワークスペースを削除するか他のメンバーを管理者にしてから外す
+func (s *WorkspaceService) RemoveMember(ctx context.Context, workspaceID int, userID int) error {
+	member, err := s.repo.FindMembership(ctx, workspaceID)
+	if err != nil {
+		return err
+	}
+	if member.Edges.Workspace.PersonalOwnerID != nil {
+		return app_errors.ErrPersonalWorkspace
+	}
+	if member.UserID != userID && member.Role != repositories.WorkspaceRoleAdmin {
+		return app_errors.ErrWorkspacePermissionDenied
+	}
+
+	txCtx, tx, err := utils.WithTx(ctx, s.client)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.RemoveMember(txCtx, workspaceID, userID); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := s.ensureAdminRemains(txCtx, workspaceID); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// requireAdmin はログイン中のユーザーがワークスペースの管理者であることを確認する。
+// メンバーでない場合は NotFoundError を、管理者でない場合は ErrWorkspacePermissionDenied を返す
+func (s *WorkspaceService) requireAdmin(ctx context.Context, workspaceID int) (*ent.WorkspaceMember, error) {
+	member, err := s.repo.FindMembership(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if member.Role != repositories.WorkspaceRoleAdmin {
+		return nil, app_errors.ErrWorkspacePermissionDenied
+	}
+	return member, nil
+}
+
+// requireTeamAdmin は requireAdmin に加えて、メンバーを管理できるチームのワークスペースであることを確認する
+func (s *WorkspaceService) requireTeamAdmin(ctx context.Context, workspaceID int) (*ent.WorkspaceMember, error) {
+	member, err := s.requireAdmin(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if member.Edges.Workspace.PersonalOwnerID != nil {
+		return nil, app_errors.ErrPersonalWorkspace
+	}
+	return member, nil
+}
+
+// ensureAdminRemains はメンバーの変更後もワークスペースに管理者が残っていることを確認する
+func (s *WorkspaceService) ensureAdminRemains(ctx context.Context, workspaceID int) error {
+	n, err := s.repo.CountAdmins(ctx, workspaceID)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return app_errors.ErrLastWorkspaceAdmin
+	}
+	return nil
+}
+
+func toWorkspaceDto(ws *ent.Workspace, role string) dto.WorkspaceDto {
+	return dto.WorkspaceDto{
+		ID:        ws.ID,
+		Name:      ws.Name,
+		Role:      role,
+		Personal:  ws.PersonalOwnerID != nil,
+		CreatedAt: ws.CreatedAt,
+		UpdatedAt: ws.UpdatedAt,
+	}
+}
+
+func toWorkspaceMemberDto(m *ent.WorkspaceMember) dto.WorkspaceMemberDto {
+	res := dto.WorkspaceMemberDto{
+		UserID:   m.UserID,
+		Role:     m.Role,
+		JoinedAt: m.CreatedAt,
+	}
+	if m.Edges.User != nil {
+		res.Name = m.Edges.User.Name
+		res.Email = m.Edges.User.Email
+	}
+	return res
+}
+
+func toWorkspaceInviteDto(invite *ent.WorkspaceInvite) dto.WorkspaceInviteDto {
+	return dto.WorkspaceInviteDto{
+		ID:        invite.ID.String(),
+		Email:     invite.Email,
+		Role:      invite.Role,
+		ExpiresAt: invite.ExpiresAt,
+		CreatedAt: invite.CreatedAt,
+	}
+}
+
+// workspaceInviteURL は招待を承諾する画面の URL にトークンを付与して返す。
+// WORKSPACE_INVITE_URL が未指定の場合は FRONTEND_ORIGIN の /accept-workspace-invite を使う
+func workspaceInviteURL(token string) string {
+	base := os.Getenv("WORKSPACE_INVITE_URL")
+	if base == "" {
+		base = os.Getenv("FRONTEND_ORIGIN") + "/accept-workspace-invite"
+	}
+	return base + "?token=" + url.QueryEscape(token)
+}
+
+// workspaceInviteMail は招待のメールを作成する。招待されたユーザーの言語は分からないため、招待したユーザーの言語で送信する
+func workspaceInviteMail(inviter *ent.User, ws *ent.Workspace, email string, token string) utils.Mail {
+	link := workspaceInviteURL(token)
+	days := int(WorkspaceInviteLifetime.Hours() / 24)
+
+	if prompts.NormalizeLocale(inviter.Locale) == prompts.LocaleEn {
+		return utils.Mail{
+			To:      email,
+			Subject: fmt.Sprintf("%s invited you to the workspace \"%s\"", inviter.Name, ws.Name),
+			Body: fmt.Sprintf("%s invited you to the workspace \"%s\".\n\nLog in with this email address and open the link below to accept. The link expires in %d days and can be used only once.\n\n%s\n\nIf you do not know the sender, you can ignore this email.\n",
+				inviter.Name, ws.Name, days, link),
+		}
+	}
+	return utils.Mail{
+		To:      email,
+		Subject: fmt.Sprintf("%s さんからワークスペース「%s」に招待されました", inviter.Name, ws.Name),
+		Body: fmt.Sprintf("%s さんからワークスペース「%s」に招待されました。\n\nこのメールアドレスでログインしてから、下記のリンクを開いて承諾してください。リンクの有効期限は %d 日で、1 回だけ使用できます。\n\n%s\n\nお心当たりが無い場合は、このメールを破棄してください。\n",
+			inviter.Name, ws.Name, days, link),
+	}
+}
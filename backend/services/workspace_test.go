@@ -0,0 +1,335 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"todo-app/app_errors"
+	"todo-app/ent"
+	"todo-app/ent/enttest"
+	"todo-app/repositories"
+	"todo-app/testutils"
+	"todo-app/utils"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func workspaceMembership(userID int, role string, personal bool) *ent.WorkspaceMember {
+	ws := &ent.Workspace{ID: 5, Name: "Acme"}
+	if personal {
+		ws.PersonalOwnerID = &userID
+	}
+	return &ent.WorkspaceMember{WorkspaceID: 5, UserID: userID, Role: role, Edges: ent.WorkspaceMemberEdges{Workspace: ws}}
+}
+
+func TestWorkspaceService_Invite(t *testing.T) {
+	t.Setenv("WORKSPACE_INVITE_URL", "http://localhost:3000/accept-workspace-invite")
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("トークンを記載した招待メールを送信し、メンバーには追加しないこと", func(t *testing.T) {
+		repo := new(testutils.MockWorkspaceRepository)
+		inviteRepo := new(testutils.MockWorkspaceInviteRepository)
+		mailer := new(testutils.MockMailer)
+		repo.On("FindMembership", mock.Anything, 5).Return(workspaceMembership(1, repositories.WorkspaceRoleAdmin, false), nil).Once()
+		repo.On("ListMembers", mock.Anything, 5).Return([]*ent.WorkspaceMember{
+			{UserID: 1, Role: repositories.WorkspaceRoleAdmin, Edges: ent.WorkspaceMemberEdges{User: &ent.User{ID: 1, Email: "alice@example.com"}}},
+		}, nil).Once()
+		inviteRepo.On("Create", mock.Anything, 5, "bob@example.com", repositories.WorkspaceRoleMember, mock.AnythingOfType("string"), 1, mock.AnythingOfType("time.Time")).
+			Return(&ent.WorkspaceInvite{Email: "bob@example.com", Role: repositories.WorkspaceRoleMember}, nil).Once()
+		mailer.On("Send", mock.Anything, mock.Anything).Return(nil).Once()
+		service := NewWorkspaceService(nil, logger, repo, inviteRepo, mailer)
+
+		res, err := service.Invite(todoListCtx, 5, "bob@example.com", repositories.WorkspaceRoleMember)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "bob@example.com", res.Email)
+		repo.AssertNotCalled(t, "AddMember")
+		sent := mailer.Calls[0].Arguments.Get(1).(utils.Mail)
+		assert.Equal(t, "bob@example.com", sent.To)
+		assert.Contains(t, sent.Subject, "Acme")
+		// メールにはハッシュではなく元のトークンを記載する
+		_, token, ok := strings.Cut(sent.Body, "http://localhost:3000/accept-workspace-invite?token=")
+		if assert.True(t, ok) {
+			token, _, _ = strings.Cut(token, "\n")
+			assert.Equal(t, hashToken(token), inviteRepo.Calls[0].Arguments.String(4))
+		}
+	})
+
+	t.Run("既にメンバーの場合、招待しないこと", func(t *testing.T) {
+		repo := new(testutils.MockWorkspaceRepository)
+		inviteRepo := new(testutils.MockWorkspaceInviteRepository)
+		mailer := new(testutils.MockMailer)
+		repo.On("FindMembership", mock.Anything, 5).Return(workspaceMembership(1, repositories.WorkspaceRoleAdmin, false), nil).Once()
+		repo.On("ListMembers", mock.Anything, 5).Return([]*ent.WorkspaceMember{
+			{UserID: 2, Role: repositories.WorkspaceRoleMember, Edges: ent.WorkspaceMemberEdges{User: &ent.User{ID: 2, Email: "bob@example.com"}}},
+		}, nil).Once()
+		service := NewWorkspaceService(nil, logger, repo, inviteRepo, mailer)
+
+		_, err := service.Invite(todoListCtx, 5, "BOB@example.com", repositories.WorkspaceRoleMember)
+
+		assert.ErrorIs(t, err, app_errors.ErrAlreadyWorkspaceMember)
+		inviteRepo.AssertNotCalled(t, "Create")
+		mailer.AssertNotCalled(t, "Send")
+	})
+
+	t.Run("管理者でない場合、招待しないこと", func(t *testing.T) {
+		repo := new(testutils.MockWorkspaceRepository)
+		inviteRepo := new(testutils.MockWorkspaceInviteRepository)
+		repo.On("FindMembership", mock.Anything, 5).Return(workspaceMembership(1, repositories.WorkspaceRoleMember, false), nil).Once()
+		service := NewWorkspaceService(nil, logger, repo, inviteRepo, new(testutils.MockMailer))
+
+		_, err := service.Invite(todoListCtx, 5, "bob@example.com", repositories.WorkspaceRoleMember)
+
+		assert.ErrorIs(t, err, app_errors.ErrWorkspacePermissionDenied)
+		inviteRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("個人のワークスペースには招待しないこと", func(t *testing.T) {
+		repo := new(testutils.MockWorkspaceRepository)
+		inviteRepo := new(testutils.MockWorkspaceInviteRepository)
+		repo.On("FindMembership", mock.Anything, 5).Return(workspaceMembership(1, repositories.WorkspaceRoleAdmin, true), nil).Once()
+		service := NewWorkspaceService(nil, logger, repo, inviteRepo, new(testutils.MockMailer))
+
+		_, err := service.Invite(todoListCtx, 5, "bob@example.com", repositories.WorkspaceRoleMember)
+
+		assert.ErrorIs(t, err, app_errors.ErrPersonalWorkspace)
+		inviteRepo.AssertNotCalled(t, "Create")
+	})
+}
+
+func TestWorkspaceService_AcceptInvite(t *testing.T) {
+	// トランザクションを開始できるよう、インメモリの SQLite を使う
+	client := enttest.Open(t, "sqlite3", "file:workspace_accept?mode=memory&_fk=1")
+	defer func() {
+		if err := client.Close(); err != nil {
+			t.Errorf("failed to close client: %v", err)
+		}
+	}()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	bobCtx := utils.WithUser(context.Background(), &ent.User{ID: 2, Email: "bob@example.com"})
+	invite := func(expiresAt time.Time) *ent.WorkspaceInvite {
+		return &ent.WorkspaceInvite{
+			WorkspaceID: 5,
+			Email:       "Bob@example.com",
+			Role:        repositories.WorkspaceRoleMember,
+			ExpiresAt:   expiresAt,
+			Edges:       ent.WorkspaceInviteEdges{Workspace: &ent.Workspace{ID: 5, Name: "Acme"}},
+		}
+	}
+
+	t.Run("招待されたメールアドレスのユーザーが、招待された権限でメンバーになること", func(t *testing.T) {
+		repo := new(testutils.MockWorkspaceRepository)
+		inviteRepo := new(testutils.MockWorkspaceInviteRepository)
+		inviteRepo.On("Consume", mock.Anything, hashToken("token")).Return(invite(time.Now().Add(time.Hour)), true, nil).Once()
+		repo.On("AddMember", mock.Anything, 5, 2, repositories.WorkspaceRoleMember).Return(&ent.WorkspaceMember{}, nil).Once()
+		service := NewWorkspaceService(client, logger, repo, inviteRepo, new(testutils.MockMailer))
+
+		res, err := service.AcceptInvite(bobCtx, "token")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 5, res.ID)
+		assert.Equal(t, repositories.WorkspaceRoleMember, res.Role)
+	})
+
+	t.Run("既にメンバーの場合、エラーを返すこと", func(t *testing.T) {
+		repo := new(testutils.MockWorkspaceRepository)
+		inviteRepo := new(testutils.MockWorkspaceInviteRepository)
+		inviteRepo.On("Consume", mock.Anything, hashToken("token")).Return(invite(time.Now().Add(time.Hour)), true, nil).Once()
+		repo.On("AddMember", mock.Anything, 5, 2, repositories.WorkspaceRoleMember).Return(nil, &ent.ConstraintError{}).Once()
+		service := NewWorkspaceService(client, logger, repo, inviteRepo, new(testutils.MockMailer))
+
+		_, err := service.AcceptInvite(bobCtx, "token")
+
+		assert.ErrorIs(t, err, app_errors.ErrAlreadyWorkspaceMember)
+	})
+
+	t.Run("招待されたメールアドレス以外のユーザーは承諾できないこと", func(t *testing.T) {
+		repo := new(testutils.MockWorkspaceRepository)
+		inviteRepo := new(testutils.MockWorkspaceInviteRepository)
+		inviteRepo.On("Consume", mock.Anything, hashToken("token")).Return(invite(time.Now().Add(time.Hour)), true, nil).Once()
+		service := NewWorkspaceService(client, logger, repo, inviteRepo, new(testutils.MockMailer))
+
+		_, err := service.AcceptInvite(todoListCtx, "token")
+
+		assert.ErrorIs(t, err, app_errors.ErrWorkspaceInviteEmailMismatch)
+		repo.AssertNotCalled(t, "AddMember")
+	})
+
+	t.Run("招待が無効な場合、エラーを返すこと", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			invite   *ent.WorkspaceInvite
+			consumed bool
+		}{
+			{name: "有効期限が切れた招待の場合", invite: invite(time.Now().Add(-time.Hour)), consumed: true},
+			{name: "使用済みの招待の場合", invite: invite(time.Now().Add(time.Hour)), consumed: false},
+			{name: "存在しない招待の場合", invite: nil},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				repo := new(testutils.MockWorkspaceRepository)
+				inviteRepo := new(testutils.MockWorkspaceInviteRepository)
+				if tt.invite != nil {
+					inviteRepo.On("Consume", mock.Anything, hashToken("token")).Return(tt.invite, tt.consumed, nil).Once()
+				} else {
+					inviteRepo.On("Consume", mock.Anything, hashToken("token")).Return(nil, false, &ent.NotFoundError{}).Once()
+				}
+				service := NewWorkspaceService(client, logger, repo, inviteRepo, new(testutils.MockMailer))
+
+				_, err := service.AcceptInvite(bobCtx, "token")
+
+				assert.ErrorIs(t, err, app_errors.ErrInvalidWorkspaceInvite)
+				repo.AssertNotCalled(t, "AddMember")
+			})
+		}
+	})
+}
+
+func TestWorkspaceService_UpdateMemberRole(t *testing.T) {
+	// トランザクションを開始できるよう、インメモリの SQLite を使う
+	client := enttest.Open(t, "sqlite3", "file:workspace_role?mode=memory&_fk=1")
+	defer func() {
+		if err := client.Close(); err != nil {
+			t.Errorf("failed to close client: %v", err)
+		}
+	}()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("メンバーの権限を変更すること", func(t *testing.T) {
+		repo := new(testutils.MockWorkspaceRepository)
+		repo.On("FindMembership", mock.Anything, 5).Return(workspaceMembership(1, repositories.WorkspaceRoleAdmin, false), nil).Once()
+		repo.On("SetMemberRole", mock.Anything, 5, 2, repositories.WorkspaceRoleAdmin).Return(&ent.WorkspaceMember{UserID: 2, Role: repositories.WorkspaceRoleAdmin}, nil).Once()
+		repo.On("CountAdmins", mock.Anything, 5).Return(2, nil).Once()
+		service := NewWorkspaceService(client, logger, repo, new(testutils.MockWorkspaceInviteRepository), new(testutils.MockMailer))
+
+		res, err := service.UpdateMemberRole(todoListCtx, 5, 2, repositories.WorkspaceRoleAdmin)
+
+		assert.NoError(t, err)
+		assert.Equal(t, repositories.WorkspaceRoleAdmin, res.Role)
+	})
+
+	t.Run("管理者がいなくなる場合、権限を変更しないこと", func(t *testing.T) {
+		repo := new(testutils.MockWorkspaceRepository)
+		repo.On("FindMembership", mock.Anything, 5).Return(workspaceMembership(1, repositories.WorkspaceRoleAdmin, false), nil).Once()
+		repo.On("SetMemberRole", mock.Anything, 5, 1, repositories.WorkspaceRoleMember).Return(&ent.WorkspaceMember{UserID: 1, Role: repositories.WorkspaceRoleMember}, nil).Once()
+		repo.On("CountAdmins", mock.Anything, 5).Return(0, nil).Once()
+		service := NewWorkspaceService(client, logger, repo, new(testutils.MockWorkspaceInviteRepository), new(testutils.MockMailer))
+
+		_, err := service.UpdateMemberRole(todoListCtx, 5, 1, repositories.WorkspaceRoleMember)
+
+		assert.ErrorIs(t, err, app_errors.ErrLastWorkspaceAdmin)
+	})
+
+	t.Run("管理者でない場合、権限を変更しないこと", func(t *testing.T) {
+		repo := new(testutils.MockWorkspaceRepository)
+		repo.On("FindMembership", mock.Anything, 5).Return(workspaceMembership(1, repositories.WorkspaceRoleMember, false), nil).Once()
+		service := NewWorkspaceService(client, logger, repo, new(testutils.MockWorkspaceInviteRepository), new(testutils.MockMailer))
+
+		_, err := service.UpdateMemberRole(todoListCtx, 5, 1, repositories.WorkspaceRoleAdmin)
+
+		assert.ErrorIs(t, err, app_errors.ErrWorkspacePermissionDenied)
+		repo.AssertNotCalled(t, "SetMemberRole")
+	})
+}
+
+func TestWorkspaceService_RemoveMember(t *testing.T) {
+	// トランザクションを開始できるよう、インメモリの SQLite を使う
+	client := enttest.Open(t, "sqlite3", "file:workspace_remove?mode=memory&_fk=1")
+	defer func() {
+		if err := client.Close(); err != nil {
+			t.Errorf("failed to close client: %v", err)
+		}
+	}()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("管理者以外のメンバーは、自分をワークスペースから外せること", func(t *testing.T) {
+		repo := new(testutils.MockWorkspaceRepository)
+		repo.On("FindMembership", mock.Anything, 5).Return(workspaceMembership(1, repositories.WorkspaceRoleMember, false), nil).Once()
+		repo.On("RemoveMember", mock.Anything, 5, 1).Return(nil).Once()
+		repo.On("CountAdmins", mock.Anything, 5).Return(1, nil).Once()
+		service := NewWorkspaceService(client, logger, repo, new(testutils.MockWorkspaceInviteRepository), new(testutils.MockMailer))
+
+		err := service.RemoveMember(todoListCtx, 5, 1)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("最後の管理者は外せないこと", func(t *testing.T) {
+		repo := new(testutils.MockWorkspaceRepository)
+		repo.On("FindMembership", mock.Anything, 5).Return(workspaceMembership(1, repositories.WorkspaceRoleAdmin, false), nil).Once()
+		repo.On("RemoveMember", mock.Anything, 5, 1).Return(nil).Once()
+		repo.On("CountAdmins", mock.Anything, 5).Return(0, nil).Once()
+		service := NewWorkspaceService(client, logger, repo, new(testutils.MockWorkspaceInviteRepository), new(testutils.MockMailer))
+
+		err := service.RemoveMember(todoListCtx, 5, 1)
+
+		assert.ErrorIs(t, err, app_errors.ErrLastWorkspaceAdmin)
+	})
+
+	t.Run("管理者以外は他のメンバーを外せないこと", func(t *testing.T) {
+		repo := new(testutils.MockWorkspaceRepository)
+		repo.On("FindMembership", mock.Anything, 5).Return(workspaceMembership(1, repositories.WorkspaceRoleMember, false), nil).Once()
+		service := NewWorkspaceService(client, logger, repo, new(testutils.MockWorkspaceInviteRepository), new(testutils.MockMailer))
+
+		err := service.RemoveMember(todoListCtx, 5, 2)
+
+		assert.ErrorIs(t, err, app_errors.ErrWorkspacePermissionDenied)
+		repo.AssertNotCalled(t, "RemoveMember")
+	})
+
+	t.Run("個人のワークスペースからは外せないこと", func(t *testing.T) {
+		repo := new(testutils.MockWorkspaceRepository)
+		repo.On("FindMembership", mock.Anything, 5).Return(workspaceMembership(1, repositories.WorkspaceRoleAdmin, true), nil).Once()
+		service := NewWorkspaceService(client, logger, repo, new(testutils.MockWorkspaceInviteRepository), new(testutils.MockMailer))
+
+		err := service.RemoveMember(todoListCtx, 5, 1)
+
+		assert.ErrorIs(t, err, app_errors.ErrPersonalWorkspace)
+		repo.AssertNotCalled(t, "RemoveMember")
+	})
+}
+
+func TestWorkspaceService_Delete(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("管理者はチームのワークスペースを削除できること", func(t *testing.T) {
+		repo := new(testutils.MockWorkspaceRepository)
+		repo.On("FindMembership", mock.Anything, 5).Return(workspaceMembership(1, repositories.WorkspaceRoleAdmin, false), nil).Once()
+		repo.On("Delete", mock.Anything, 5).Return(nil).Once()
+		service := NewWorkspaceService(nil, logger, repo, new(testutils.MockWorkspaceInviteRepository), new(testutils.MockMailer))
+
+		err := service.Delete(todoListCtx, 5)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("個人のワークスペースは削除しないこと", func(t *testing.T) {
+		repo := new(testutils.MockWorkspaceRepository)
+		repo.On("FindMembership", mock.Anything, 5).Return(workspaceMembership(1, repositories.WorkspaceRoleAdmin, true), nil).Once()
+		service := NewWorkspaceService(nil, logger, repo, new(testutils.MockWorkspaceInviteRepository), new(testutils.MockMailer))
+
+		err := service.Delete(todoListCtx, 5)
+
+		assert.ErrorIs(t, err, app_errors.ErrPersonalWorkspace)
+		repo.AssertNotCalled(t, "Delete")
+	})
+
+	t.Run("管理者でない場合、削除しないこと", func(t *testing.T) {
+		repo := new(testutils.MockWorkspaceRepository)
+		repo.On("FindMembership", mock.Anything, 5).Return(workspaceMembership(1, repositories.WorkspaceRoleMember, false), nil).Once()
+		service := NewWorkspaceService(nil, logger, repo, new(testutils.MockWorkspaceInviteRepository), new(testutils.MockMailer))
+
+		err := service.Delete(todoListCtx, 5)
+
+		assert.ErrorIs(t, err, app_errors.ErrWorkspacePermissionDenied)
+		repo.AssertNotCalled(t, "Delete")
+	})
+}
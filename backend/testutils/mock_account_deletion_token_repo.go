@@ -0,0 +1,29 @@
+package testutils
+
+import (
+	"context"
+	"time"
+	"todo-app/ent"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockAccountDeletionTokenRepository struct {
+	mock.Mock
+}
+
+func (m *MockAccountDeletionTokenRepository) Create(ctx context.Context, userID int, tokenHash string, expiresAt time.Time) (*ent.AccountDeletionToken, error) {
+	args := m.Called(ctx, userID, tokenHash, expiresAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.AccountDeletionToken), args.Error(1)
+}
+
+func (m *MockAccountDeletionTokenRepository) Consume(ctx context.Context, tokenHash string) (*ent.AccountDeletionToken, bool, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Bool(1), args.Error(2)
+	}
+	return args.Get(0).(*ent.AccountDeletionToken), args.Bool(1), args.Error(2)
+}
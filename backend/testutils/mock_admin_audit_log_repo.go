@@ -0,0 +1,26 @@
+package testutils
+
+import (
+	"context"
+	"todo-app/ent"
+	"todo-app/repositories"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockAdminAuditLogRepository struct {
+	mock.Mock
+}
+
+func (m *MockAdminAuditLogRepository) Create(ctx context.Context, entry repositories.AdminAuditLogEntry) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
+func (m *MockAdminAuditLogRepository) List(ctx context.Context, targetUserID *int, offset int, limit int) ([]*ent.AdminAuditLog, int, error) {
+	args := m.Called(ctx, targetUserID, offset, limit)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*ent.AdminAuditLog), args.Int(1), args.Error(2)
+}
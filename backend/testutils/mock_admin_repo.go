@@ -0,0 +1,31 @@
+package testutils
+
+import (
+	"context"
+	"time"
+	"todo-app/ent"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockAdminRepository struct {
+	mock.Mock
+}
+
+func (m *MockAdminRepository) SearchUsers(ctx context.Context, query string, offset int, limit int) ([]*ent.User, int, error) {
+	args := m.Called(ctx, query, offset, limit)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*ent.User), args.Int(1), args.Error(2)
+}
+
+func (m *MockAdminRepository) CountTodos(ctx context.Context, userID int) (int, int, error) {
+	args := m.Called(ctx, userID)
+	return args.Int(0), args.Int(1), args.Error(2)
+}
+
+func (m *MockAdminRepository) SumAIUsage(ctx context.Context, userID int, since time.Time) (int, int, error) {
+	args := m.Called(ctx, userID, since)
+	return args.Int(0), args.Int(1), args.Error(2)
+}
@@ -0,0 +1,33 @@
+package testutils
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockAIUsageRepository struct {
+	mock.Mock
+}
+
+func (m *MockAIUsageRepository) Create(ctx context.Context, model string) (uuid.UUID, error) {
+	args := m.Called(ctx, model)
+	return args.Get(0).(uuid.UUID), args.Error(1)
+}
+
+func (m *MockAIUsageRepository) UpdateTokens(ctx context.Context, id uuid.UUID, promptTokens int, candidatesTokens int, totalTokens int) error {
+	args := m.Called(ctx, id, promptTokens, candidatesTokens, totalTokens)
+	return args.Error(0)
+}
+
+func (m *MockAIUsageRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockAIUsageRepository) SumUsage(ctx context.Context, since time.Time, excludeID uuid.UUID) (int, int, error) {
+	args := m.Called(ctx, since, excludeID)
+	return args.Int(0), args.Int(1), args.Error(2)
+}
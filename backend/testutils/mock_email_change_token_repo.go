@@ -0,0 +1,53 @@
+package testutils
+
+import (
+	"context"
+	"time"
+	"todo-app/ent"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockEmailChangeTokenRepository struct {
+	mock.Mock
+}
+
+func (m *MockEmailChangeTokenRepository) Create(ctx context.Context, userID int, oldEmail string, newEmail string, tokenHash string, cancelTokenHash string, expiresAt time.Time) (*ent.EmailChangeToken, error) {
+	args := m.Called(ctx, userID, oldEmail, newEmail, tokenHash, cancelTokenHash, expiresAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.EmailChangeToken), args.Error(1)
+}
+
+func (m *MockEmailChangeTokenRepository) CreateReauthentication(ctx context.Context, userID int, oldEmail string, newEmail string, tokenHash string, expiresAt time.Time) (*ent.EmailChangeToken, error) {
+	args := m.Called(ctx, userID, oldEmail, newEmail, tokenHash, expiresAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.EmailChangeToken), args.Error(1)
+}
+
+func (m *MockEmailChangeTokenRepository) FindPending(ctx context.Context, userID int, now time.Time) (*ent.EmailChangeToken, error) {
+	args := m.Called(ctx, userID, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.EmailChangeToken), args.Error(1)
+}
+
+func (m *MockEmailChangeTokenRepository) Consume(ctx context.Context, tokenHash string, purpose string) (*ent.EmailChangeToken, bool, error) {
+	args := m.Called(ctx, tokenHash, purpose)
+	if args.Get(0) == nil {
+		return nil, args.Bool(1), args.Error(2)
+	}
+	return args.Get(0).(*ent.EmailChangeToken), args.Bool(1), args.Error(2)
+}
+
+func (m *MockEmailChangeTokenRepository) Cancel(ctx context.Context, cancelTokenHash string) (*ent.EmailChangeToken, bool, error) {
+	args := m.Called(ctx, cancelTokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Bool(1), args.Error(2)
+	}
+	return args.Get(0).(*ent.EmailChangeToken), args.Bool(1), args.Error(2)
+}
@@ -0,0 +1,38 @@
+package testutils
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockLoginAttemptRepository struct {
+	mock.Mock
+}
+
+func (m *MockLoginAttemptRepository) Create(ctx context.Context, email string, ipAddress string, userAgent string, userID *int, result string) (int, error) {
+	args := m.Called(ctx, email, ipAddress, userAgent, userID, result)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockLoginAttemptRepository) UpdateResult(ctx context.Context, id int, userID *int, result string) error {
+	args := m.Called(ctx, id, userID, result)
+	return args.Error(0)
+}
+
+func (m *MockLoginAttemptRepository) FailuresByEmail(ctx context.Context, email string, since time.Time, excludeID int) (int, *time.Time, error) {
+	args := m.Called(ctx, email, since, excludeID)
+	if args.Get(1) == nil {
+		return args.Int(0), nil, args.Error(2)
+	}
+	return args.Int(0), args.Get(1).(*time.Time), args.Error(2)
+}
+
+func (m *MockLoginAttemptRepository) FailuresByIP(ctx context.Context, ipAddress string, since time.Time, excludeID int) (int, *time.Time, error) {
+	args := m.Called(ctx, ipAddress, since, excludeID)
+	if args.Get(1) == nil {
+		return args.Int(0), nil, args.Error(2)
+	}
+	return args.Int(0), args.Get(1).(*time.Time), args.Error(2)
+}
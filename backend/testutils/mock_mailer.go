@@ -0,0 +1,17 @@
+package testutils
+
+import (
+	"context"
+	"todo-app/utils"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockMailer struct {
+	mock.Mock
+}
+
+func (m *MockMailer) Send(ctx context.Context, mail utils.Mail) error {
+	args := m.Called(ctx, mail)
+	return args.Error(0)
+}
@@ -0,0 +1,65 @@
+package testutils
+
+import (
+	"context"
+	"time"
+	"todo-app/ent"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockMFARepository struct {
+	mock.Mock
+}
+
+func (m *MockMFARepository) FindTOTP(ctx context.Context, userID int) (*ent.TOTPCredential, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.TOTPCredential), args.Error(1)
+}
+
+func (m *MockMFARepository) SavePendingTOTP(ctx context.Context, userID int, secret string) (*ent.TOTPCredential, error) {
+	args := m.Called(ctx, userID, secret)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.TOTPCredential), args.Error(1)
+}
+
+func (m *MockMFARepository) ConfirmTOTP(ctx context.Context, id int, step int64) error {
+	args := m.Called(ctx, id, step)
+	return args.Error(0)
+}
+
+func (m *MockMFARepository) UseTOTPStep(ctx context.Context, id int, step int64) (bool, error) {
+	args := m.Called(ctx, id, step)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockMFARepository) DeleteTOTP(ctx context.Context, userID int) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockMFARepository) ReplaceRecoveryCodes(ctx context.Context, userID int, codeHashes []string) error {
+	args := m.Called(ctx, userID, codeHashes)
+	return args.Error(0)
+}
+
+func (m *MockMFARepository) ConsumeRecoveryCode(ctx context.Context, userID int, codeHash string) (bool, error) {
+	args := m.Called(ctx, userID, codeHash)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockMFARepository) CountRecoveryCodes(ctx context.Context, userID int) (int, error) {
+	args := m.Called(ctx, userID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockMFARepository) UseMFAToken(ctx context.Context, userID int, jti uuid.UUID, expiresAt time.Time) (bool, error) {
+	args := m.Called(ctx, userID, jti, expiresAt)
+	return args.Bool(0), args.Error(1)
+}
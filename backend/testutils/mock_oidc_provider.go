@@ -0,0 +1,223 @@
+package testutils
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// MockOIDCUser は MockOIDCProvider の認可エンドポイントでログインしたことにするユーザー
+type MockOIDCUser struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+type mockOIDCCode struct {
+	clientID      string
+	redirectURI   string
+	nonce         string
+	codeChallenge string
+	user          MockOIDCUser
+}
+
+// MockOIDCProvider はテスト用の OpenID Connect プロバイダ。
+// 認可エンドポイントは画面を出さずに SetUser で設定したユーザーでログインしたことにして、すぐにコールバックへリダイレクトする
+type MockOIDCProvider struct {
+	Server       *httptest.Server
+	ClientID     string
+	ClientSecret string
+
+	mu    sync.Mutex
+	user  MockOIDCUser
+	key   *rsa.PrivateKey
+	kid   string
+	codes map[string]mockOIDCCode
+
+	// JWKS の取得回数。キャッシュの確認に使う
+	JWKSRequests atomic.Int32
+}
+
+func NewMockOIDCProvider(clientID string, clientSecret string) *MockOIDCProvider {
+	m := &MockOIDCProvider{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		codes:        map[string]mockOIDCCode{},
+	}
+	m.RotateKey()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", m.discovery)
+	mux.HandleFunc("GET /jwks", m.jwks)
+	mux.HandleFunc("GET /authorize", m.authorize)
+	mux.HandleFunc("POST /token", m.token)
+	m.Server = httptest.NewServer(mux)
+	return m
+}
+
+func (m *MockOIDCProvider) Issuer() string {
+	return m.Server.URL
+}
+
+func (m *MockOIDCProvider) Close() {
+	m.Server.Close()
+}
+
+// SetUser は次にログインするユーザーを設定する
+func (m *MockOIDCProvider) SetUser(user MockOIDCUser) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.user = user
+}
+
+// RotateKey は署名鍵を新しい kid の鍵に入れ替える
+func (m *MockOIDCProvider) RotateKey() {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.key = key
+	m.kid = base64.RawURLEncoding.EncodeToString(key.N.Bytes()[:8])
+}
+
+// SignIDToken は現在の鍵で任意のクレームの ID トークンに署名する
+func (m *MockOIDCProvider) SignIDToken(claims jwt.MapClaims) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = m.kid
+	s, err := token.SignedString(m.key)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// IDTokenClaims は user の ID トークンとして有効なクレームを返す
+func (m *MockOIDCProvider) IDTokenClaims(user MockOIDCUser, nonce string) jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"iss":            m.Issuer(),
+		"sub":            user.Subject,
+		"aud":            m.ClientID,
+		"exp":            now.Add(5 * time.Minute).Unix(),
+		"iat":            now.Unix(),
+		"nonce":          nonce,
+		"email":          user.Email,
+		"email_verified": user.EmailVerified,
+		"name":           user.Name,
+	}
+}
+
+func (m *MockOIDCProvider) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"issuer":                                m.Issuer(),
+		"authorization_endpoint":                m.Issuer() + "/authorize",
+		"token_endpoint":                        m.Issuer() + "/token",
+		"jwks_uri":                              m.Issuer() + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+func (m *MockOIDCProvider) jwks(w http.ResponseWriter, r *http.Request) {
+	m.JWKSRequests.Add(1)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"use": "sig",
+			"alg": "RS256",
+			"kid": m.kid,
+			"n":   base64.RawURLEncoding.EncodeToString(m.key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(m.key.E)).Bytes()),
+		}},
+	})
+}
+
+func (m *MockOIDCProvider) authorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("response_type") != "code" || q.Get("client_id") != m.ClientID || q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" {
+		http.Error(w, "invalid_request", http.StatusBadRequest)
+		return
+	}
+	redirectURI, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil || !redirectURI.IsAbs() {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+
+	code := rand.Text()
+	m.mu.Lock()
+	m.codes[code] = mockOIDCCode{
+		clientID:      q.Get("client_id"),
+		redirectURI:   q.Get("redirect_uri"),
+		nonce:         q.Get("nonce"),
+		codeChallenge: q.Get("code_challenge"),
+		user:          m.user,
+	}
+	m.mu.Unlock()
+
+	callback := redirectURI.Query()
+	callback.Set("code", code)
+	callback.Set("state", q.Get("state"))
+	redirectURI.RawQuery = callback.Encode()
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+func (m *MockOIDCProvider) token(w http.ResponseWriter, r *http.Request) {
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID = r.PostFormValue("client_id")
+	}
+	if clientID != m.ClientID || clientSecret != m.ClientSecret {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	// 認可コードは 1 回だけ使用できる
+	m.mu.Lock()
+	c, ok := m.codes[r.PostFormValue("code")]
+	delete(m.codes, r.PostFormValue("code"))
+	m.mu.Unlock()
+
+	if !ok || r.PostFormValue("grant_type") != "authorization_code" || c.clientID != clientID || c.redirectURI != r.PostFormValue("redirect_uri") {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+	sum := sha256.Sum256([]byte(r.PostFormValue("code_verifier")))
+	if base64.RawURLEncoding.EncodeToString(sum[:]) != c.codeChallenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant", "error_description": "code_verifier mismatch"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": rand.Text(),
+		"token_type":   "Bearer",
+		"expires_in":   300,
+		"id_token":     m.SignIDToken(m.IDTokenClaims(c.user, c.nonce)),
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
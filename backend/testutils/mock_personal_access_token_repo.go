@@ -0,0 +1,53 @@
+package testutils
+
+import (
+	"context"
+	"time"
+	"todo-app/ent"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockPersonalAccessTokenRepository struct {
+	mock.Mock
+}
+
+func (m *MockPersonalAccessTokenRepository) Create(ctx context.Context, name string, tokenHash string, tokenPrefix string, scopes []string, expiresAt *time.Time) (*ent.PersonalAccessToken, error) {
+	args := m.Called(ctx, name, tokenHash, tokenPrefix, scopes, expiresAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.PersonalAccessToken), args.Error(1)
+}
+
+func (m *MockPersonalAccessTokenRepository) FindActiveByHash(ctx context.Context, tokenHash string) (*ent.PersonalAccessToken, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.PersonalAccessToken), args.Error(1)
+}
+
+func (m *MockPersonalAccessTokenRepository) Touch(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockPersonalAccessTokenRepository) List(ctx context.Context) ([]*ent.PersonalAccessToken, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.PersonalAccessToken), args.Error(1)
+}
+
+func (m *MockPersonalAccessTokenRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockPersonalAccessTokenRepository) RevokeAllByUserID(ctx context.Context, userID int) (int, error) {
+	args := m.Called(ctx, userID)
+	return args.Int(0), args.Error(1)
+}
@@ -0,0 +1,84 @@
+package testutils
+
+import (
+	"context"
+	"todo-app/ent"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockPersonalDataRepository struct {
+	mock.Mock
+}
+
+func (m *MockPersonalDataRepository) ListTodos(ctx context.Context, userID int, offset int, limit int) ([]*ent.Todo, error) {
+	args := m.Called(ctx, userID, offset, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.Todo), args.Error(1)
+}
+
+func (m *MockPersonalDataRepository) ListTodoFilterHistories(ctx context.Context, userID int, offset int, limit int) ([]*ent.TodoFilterHistory, error) {
+	args := m.Called(ctx, userID, offset, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.TodoFilterHistory), args.Error(1)
+}
+
+func (m *MockPersonalDataRepository) ListTodoBreakdowns(ctx context.Context, userID int, offset int, limit int) ([]*ent.TodoBreakdown, error) {
+	args := m.Called(ctx, userID, offset, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.TodoBreakdown), args.Error(1)
+}
+
+func (m *MockPersonalDataRepository) ListTodoSummaries(ctx context.Context, userID int, offset int, limit int) ([]*ent.TodoSummary, error) {
+	args := m.Called(ctx, userID, offset, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.TodoSummary), args.Error(1)
+}
+
+func (m *MockPersonalDataRepository) ListSessions(ctx context.Context, userID int, offset int, limit int) ([]*ent.Session, error) {
+	args := m.Called(ctx, userID, offset, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.Session), args.Error(1)
+}
+
+func (m *MockPersonalDataRepository) ListAIUsages(ctx context.Context, userID int, offset int, limit int) ([]*ent.AIUsage, error) {
+	args := m.Called(ctx, userID, offset, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.AIUsage), args.Error(1)
+}
+
+func (m *MockPersonalDataRepository) ListPersonalAccessTokens(ctx context.Context, userID int, offset int, limit int) ([]*ent.PersonalAccessToken, error) {
+	args := m.Called(ctx, userID, offset, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.PersonalAccessToken), args.Error(1)
+}
+
+func (m *MockPersonalDataRepository) ListIdentities(ctx context.Context, userID int, offset int, limit int) ([]*ent.UserIdentity, error) {
+	args := m.Called(ctx, userID, offset, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.UserIdentity), args.Error(1)
+}
+
+func (m *MockPersonalDataRepository) ListLoginAttempts(ctx context.Context, userID int, offset int, limit int) ([]*ent.LoginAttempt, error) {
+	args := m.Called(ctx, userID, offset, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.LoginAttempt), args.Error(1)
+}
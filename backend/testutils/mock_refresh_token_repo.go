@@ -0,0 +1,30 @@
+package testutils
+
+import (
+	"context"
+	"time"
+	"todo-app/ent"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRefreshTokenRepository struct {
+	mock.Mock
+}
+
+func (m *MockRefreshTokenRepository) Create(ctx context.Context, sessionID uuid.UUID, tokenHash string, expiresAt time.Time) (*ent.RefreshToken, error) {
+	args := m.Called(ctx, sessionID, tokenHash, expiresAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.RefreshToken), args.Error(1)
+}
+
+func (m *MockRefreshTokenRepository) Consume(ctx context.Context, tokenHash string) (*ent.RefreshToken, bool, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Bool(1), args.Error(2)
+	}
+	return args.Get(0).(*ent.RefreshToken), args.Bool(1), args.Error(2)
+}
@@ -0,0 +1,71 @@
+package testutils
+
+import (
+	"context"
+	"time"
+	"todo-app/ent"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSessionRepository struct {
+	mock.Mock
+}
+
+func (m *MockSessionRepository) Create(ctx context.Context, userID int, ipAddress string, userAgent string, expiresAt time.Time) (*ent.Session, error) {
+	args := m.Called(ctx, userID, ipAddress, userAgent, expiresAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.Session), args.Error(1)
+}
+
+func (m *MockSessionRepository) FindActive(ctx context.Context, id uuid.UUID) (*ent.Session, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.Session), args.Error(1)
+}
+
+func (m *MockSessionRepository) RevokeByID(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepository) Touch(ctx context.Context, id uuid.UUID, ipAddress string, userAgent string) error {
+	args := m.Called(ctx, id, ipAddress, userAgent)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepository) ListActive(ctx context.Context, seenSince time.Time) ([]*ent.Session, error) {
+	args := m.Called(ctx, seenSince)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.Session), args.Error(1)
+}
+
+func (m *MockSessionRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepository) RevokeAll(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockSessionRepository) RevokeAllByUserID(ctx context.Context, userID int, except *uuid.UUID) (int, error) {
+	args := m.Called(ctx, userID, except)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockSessionRepository) CreateImpersonation(ctx context.Context, userID int, impersonatorID int, ipAddress string, userAgent string, expiresAt time.Time) (*ent.Session, error) {
+	args := m.Called(ctx, userID, impersonatorID, ipAddress, userAgent, expiresAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.Session), args.Error(1)
+}
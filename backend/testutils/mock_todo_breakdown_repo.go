@@ -0,0 +1,34 @@
+package testutils
+
+import (
+	"context"
+	"todo-app/ent"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTodoBreakdownRepository struct {
+	mock.Mock
+}
+
+func (m *MockTodoBreakdownRepository) CreateBreakdown(ctx context.Context, todoID int, model string, steps []map[string]string) (*ent.TodoBreakdown, error) {
+	args := m.Called(ctx, todoID, model, steps)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.TodoBreakdown), args.Error(1)
+}
+
+func (m *MockTodoBreakdownRepository) FindBreakdown(ctx context.Context, todoID int, id uuid.UUID) (*ent.TodoBreakdown, error) {
+	args := m.Called(ctx, todoID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.TodoBreakdown), args.Error(1)
+}
+
+func (m *MockTodoBreakdownRepository) MarkAccepted(ctx context.Context, id uuid.UUID, stepIndexes []int) (int, error) {
+	args := m.Called(ctx, id, stepIndexes)
+	return args.Int(0), args.Error(1)
+}
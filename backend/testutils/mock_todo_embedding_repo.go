@@ -0,0 +1,28 @@
+package testutils
+
+import (
+	"context"
+	"todo-app/ent"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTodoEmbeddingRepository struct {
+	mock.Mock
+}
+
+func (m *MockTodoEmbeddingRepository) FetchTodosWithEmbedding(ctx context.Context) ([]*ent.Todo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.Todo), args.Error(1)
+}
+
+func (m *MockTodoEmbeddingRepository) SaveEmbedding(ctx context.Context, todoID int, model string, contentHash string, vector []float32) (*ent.TodoEmbedding, error) {
+	args := m.Called(ctx, todoID, model, contentHash, vector)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.TodoEmbedding), args.Error(1)
+}
@@ -20,8 +20,8 @@ func (m *MockTodoFilterHistoryRepository) FetchLatestFilters(ctx context.Context
 	return args.Get(0).([]*ent.TodoFilterHistory), args.Error(1)
 }
 
-func (m *MockTodoFilterHistoryRepository) SaveFilterHistory(ctx context.Context, query string, functionName *string, args map[string]interface{}, resultTodoIds []int) (*ent.TodoFilterHistory, error) {
-	callArgs := m.Called(ctx, query, functionName, args, resultTodoIds)
+func (m *MockTodoFilterHistoryRepository) SaveFilterHistory(ctx context.Context, query string, normalizedQuery string, dateBucket string, promptVersion string, functionName *string, args map[string]interface{}, resultTodoIds []int) (*ent.TodoFilterHistory, error) {
+	callArgs := m.Called(ctx, query, normalizedQuery, dateBucket, promptVersion, functionName, args, resultTodoIds)
 	if callArgs.Get(0) == nil {
 		return nil, callArgs.Error(1)
 	}
@@ -35,3 +35,11 @@ func (m *MockTodoFilterHistoryRepository) GetFilterHistoryByQueryID(ctx context.
 	}
 	return args.Get(0).(*ent.TodoFilterHistory), args.Error(1)
 }
+
+func (m *MockTodoFilterHistoryRepository) FindCachedFilter(ctx context.Context, normalizedQuery string, dateBucket string, promptVersion string) (*ent.TodoFilterHistory, error) {
+	args := m.Called(ctx, normalizedQuery, dateBucket, promptVersion)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.TodoFilterHistory), args.Error(1)
+}
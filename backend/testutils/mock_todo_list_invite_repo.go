@@ -0,0 +1,43 @@
+package testutils
+
+import (
+	"context"
+	"time"
+	"todo-app/ent"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTodoListInviteRepository struct {
+	mock.Mock
+}
+
+func (m *MockTodoListInviteRepository) Create(ctx context.Context, listID int, email string, role string, tokenHash string, invitedBy int, expiresAt time.Time) (*ent.TodoListInvite, error) {
+	args := m.Called(ctx, listID, email, role, tokenHash, invitedBy, expiresAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.TodoListInvite), args.Error(1)
+}
+
+func (m *MockTodoListInviteRepository) ListPending(ctx context.Context, listID int, now time.Time) ([]*ent.TodoListInvite, error) {
+	args := m.Called(ctx, listID, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.TodoListInvite), args.Error(1)
+}
+
+func (m *MockTodoListInviteRepository) Revoke(ctx context.Context, listID int, id uuid.UUID) error {
+	args := m.Called(ctx, listID, id)
+	return args.Error(0)
+}
+
+func (m *MockTodoListInviteRepository) Consume(ctx context.Context, tokenHash string) (*ent.TodoListInvite, bool, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Bool(1), args.Error(2)
+	}
+	return args.Get(0).(*ent.TodoListInvite), args.Bool(1), args.Error(2)
+}
@@ -0,0 +1,88 @@
+package testutils
+
+import (
+	"context"
+	"todo-app/ent"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTodoListRepository struct {
+	mock.Mock
+}
+
+func (m *MockTodoListRepository) ListMemberships(ctx context.Context) ([]*ent.TodoListMember, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.TodoListMember), args.Error(1)
+}
+
+func (m *MockTodoListRepository) FindMembership(ctx context.Context, listID int) (*ent.TodoListMember, error) {
+	args := m.Called(ctx, listID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.TodoListMember), args.Error(1)
+}
+
+func (m *MockTodoListRepository) Create(ctx context.Context, name string) (*ent.TodoList, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.TodoList), args.Error(1)
+}
+
+func (m *MockTodoListRepository) Rename(ctx context.Context, listID int, name string) (*ent.TodoList, error) {
+	args := m.Called(ctx, listID, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.TodoList), args.Error(1)
+}
+
+func (m *MockTodoListRepository) Delete(ctx context.Context, listID int) error {
+	args := m.Called(ctx, listID)
+	return args.Error(0)
+}
+
+func (m *MockTodoListRepository) ListMembers(ctx context.Context, listID int) ([]*ent.TodoListMember, error) {
+	args := m.Called(ctx, listID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.TodoListMember), args.Error(1)
+}
+
+func (m *MockTodoListRepository) AddMember(ctx context.Context, listID int, userID int, role string) (*ent.TodoListMember, error) {
+	args := m.Called(ctx, listID, userID, role)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.TodoListMember), args.Error(1)
+}
+
+func (m *MockTodoListRepository) SetMemberRole(ctx context.Context, listID int, userID int, role string) (*ent.TodoListMember, error) {
+	args := m.Called(ctx, listID, userID, role)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.TodoListMember), args.Error(1)
+}
+
+func (m *MockTodoListRepository) RemoveMember(ctx context.Context, listID int, userID int) error {
+	args := m.Called(ctx, listID, userID)
+	return args.Error(0)
+}
+
+func (m *MockTodoListRepository) CountOwners(ctx context.Context, listID int) (int, error) {
+	args := m.Called(ctx, listID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockTodoListRepository) CountLastOwnedByUser(ctx context.Context, userID int) (int, error) {
+	args := m.Called(ctx, userID)
+	return args.Int(0), args.Error(1)
+}
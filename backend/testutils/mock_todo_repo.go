@@ -12,16 +12,16 @@ type MockTodoRepository struct {
 	mock.Mock
 }
 
-func (m *MockTodoRepository) FetchTodos(ctx context.Context, limit int, offset int, includeDone bool) ([]*ent.Todo, error) {
-	args := m.Called(ctx, limit, offset, includeDone)
+func (m *MockTodoRepository) FetchTodos(ctx context.Context, limit int, offset int, includeDone bool, listID *int) ([]*ent.Todo, error) {
+	args := m.Called(ctx, limit, offset, includeDone, listID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*ent.Todo), args.Error(1)
 }
 
-func (m *MockTodoRepository) GetTodoCount(ctx context.Context, includeDone bool) (int, error) {
-	args := m.Called(ctx, includeDone)
+func (m *MockTodoRepository) GetTodoCount(ctx context.Context, includeDone bool, listID *int) (int, error) {
+	args := m.Called(ctx, includeDone, listID)
 	return args.Int(0), args.Error(1)
 }
 
@@ -41,8 +41,16 @@ func (m *MockTodoRepository) GetTodoForUpdate(ctx context.Context, id int) (*ent
 	return args.Get(0).(*ent.Todo), args.Error(1)
 }
 
-func (m *MockTodoRepository) CreateTodo(ctx context.Context, title string, description string) (*ent.Todo, error) {
-	args := m.Called(ctx, title, description)
+func (m *MockTodoRepository) CreateTodo(ctx context.Context, title string, description string, listID *int) (*ent.Todo, error) {
+	args := m.Called(ctx, title, description, listID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.Todo), args.Error(1)
+}
+
+func (m *MockTodoRepository) CreateChildTodo(ctx context.Context, parentID int, title string, description string) (*ent.Todo, error) {
+	args := m.Called(ctx, parentID, title, description)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
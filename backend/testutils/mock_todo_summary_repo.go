@@ -0,0 +1,29 @@
+package testutils
+
+import (
+	"context"
+	"time"
+	"todo-app/ent"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTodoSummaryRepository struct {
+	mock.Mock
+}
+
+func (m *MockTodoSummaryRepository) FindSummary(ctx context.Context, from time.Time, to time.Time) (*ent.TodoSummary, error) {
+	args := m.Called(ctx, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.TodoSummary), args.Error(1)
+}
+
+func (m *MockTodoSummaryRepository) SaveSummary(ctx context.Context, from time.Time, to time.Time, sourceHash string, model string, content string, markdown string) (*ent.TodoSummary, error) {
+	args := m.Called(ctx, from, to, sourceHash, model, content, markdown)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.TodoSummary), args.Error(1)
+}
@@ -0,0 +1,43 @@
+package testutils
+
+import (
+	"context"
+	"time"
+	"todo-app/ent"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockWorkspaceInviteRepository struct {
+	mock.Mock
+}
+
+func (m *MockWorkspaceInviteRepository) Create(ctx context.Context, workspaceID int, email string, role string, tokenHash string, invitedBy int, expiresAt time.Time) (*ent.WorkspaceInvite, error) {
+	args := m.Called(ctx, workspaceID, email, role, tokenHash, invitedBy, expiresAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.WorkspaceInvite), args.Error(1)
+}
+
+func (m *MockWorkspaceInviteRepository) ListPending(ctx context.Context, workspaceID int, now time.Time) ([]*ent.WorkspaceInvite, error) {
+	args := m.Called(ctx, workspaceID, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.WorkspaceInvite), args.Error(1)
+}
+
+func (m *MockWorkspaceInviteRepository) Revoke(ctx context.Context, workspaceID int, id uuid.UUID) error {
+	args := m.Called(ctx, workspaceID, id)
+	return args.Error(0)
+}
+
+func (m *MockWorkspaceInviteRepository) Consume(ctx context.Context, tokenHash string) (*ent.WorkspaceInvite, bool, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Bool(1), args.Error(2)
+	}
+	return args.Get(0).(*ent.WorkspaceInvite), args.Bool(1), args.Error(2)
+}
@@ -0,0 +1,101 @@
+package testutils
+
+import (
+	"context"
+	"todo-app/ent"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockWorkspaceRepository struct {
+	mock.Mock
+}
+
+func (m *MockWorkspaceRepository) EnsurePersonal(ctx context.Context, userID int) (*ent.WorkspaceMember, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.WorkspaceMember), args.Error(1)
+}
+
+func (m *MockWorkspaceRepository) ListMemberships(ctx context.Context) ([]*ent.WorkspaceMember, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.WorkspaceMember), args.Error(1)
+}
+
+func (m *MockWorkspaceRepository) FindMembership(ctx context.Context, workspaceID int) (*ent.WorkspaceMember, error) {
+	args := m.Called(ctx, workspaceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.WorkspaceMember), args.Error(1)
+}
+
+func (m *MockWorkspaceRepository) Create(ctx context.Context, name string) (*ent.Workspace, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.Workspace), args.Error(1)
+}
+
+func (m *MockWorkspaceRepository) Rename(ctx context.Context, workspaceID int, name string) (*ent.Workspace, error) {
+	args := m.Called(ctx, workspaceID, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.Workspace), args.Error(1)
+}
+
+func (m *MockWorkspaceRepository) Delete(ctx context.Context, workspaceID int) error {
+	args := m.Called(ctx, workspaceID)
+	return args.Error(0)
+}
+
+func (m *MockWorkspaceRepository) ListMembers(ctx context.Context, workspaceID int) ([]*ent.WorkspaceMember, error) {
+	args := m.Called(ctx, workspaceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.WorkspaceMember), args.Error(1)
+}
+
+func (m *MockWorkspaceRepository) IsMember(ctx context.Context, workspaceID int, userID int) (bool, error) {
+	args := m.Called(ctx, workspaceID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockWorkspaceRepository) AddMember(ctx context.Context, workspaceID int, userID int, role string) (*ent.WorkspaceMember, error) {
+	args := m.Called(ctx, workspaceID, userID, role)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.WorkspaceMember), args.Error(1)
+}
+
+func (m *MockWorkspaceRepository) SetMemberRole(ctx context.Context, workspaceID int, userID int, role string) (*ent.WorkspaceMember, error) {
+	args := m.Called(ctx, workspaceID, userID, role)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.WorkspaceMember), args.Error(1)
+}
+
+func (m *MockWorkspaceRepository) RemoveMember(ctx context.Context, workspaceID int, userID int) error {
+	args := m.Called(ctx, workspaceID, userID)
+	return args.Error(0)
+}
+
+func (m *MockWorkspaceRepository) CountAdmins(ctx context.Context, workspaceID int) (int, error) {
+	args := m.Called(ctx, workspaceID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockWorkspaceRepository) CountLastAdminedByUser(ctx context.Context, userID int) (int, error) {
+	args := m.Called(ctx, userID)
+	return args.Int(0), args.Error(1)
+}
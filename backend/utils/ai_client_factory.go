@@ -17,13 +17,19 @@ type IAIFactory interface {
 	GetGeminiClient(ctx context.Context) (IGenAIClient, error)
 }
 
+// IGenAIClientFactory は利用量の記録を行わない AI クライアントの生成元。
+// アプリケーションからは利用量を記録する IAIFactory を経由して利用する。
+type IGenAIClientFactory interface {
+	GetGeminiClient(ctx context.Context) (IGenAIClient, error)
+}
+
 type AIFactory struct {
 	geminiClient IGenAIClient
 	geminiOnce   sync.Once
 	geminiErr    error
 }
 
-func NewAIFactory() IAIFactory {
+func NewAIFactory() IGenAIClientFactory {
 	return &AIFactory{}
 }
 
@@ -37,22 +43,63 @@ func (w *genAIClientWrapper) GenerateContent(ctx context.Context, model string,
 	return w.client.Models.GenerateContent(ctx, model, contents, config)
 }
 
+const (
+	AIClientGemini = "gemini"
+	AIClientFake   = "fake"
+	AIClientRecord = "record"
+	AIClientReplay = "replay"
+)
+
+const defaultAIFixturesDir = "testdata/ai_fixtures"
+
+// GetGeminiClient は AI_CLIENT の設定に応じたクライアントを返す
+//   - gemini (デフォルト): Gemini API を呼び出す
+//   - fake: ルール表 (AI_FAKE_RULES, 未指定なら組み込み) に従って応答する
+//   - record: Gemini API を呼び出し、やり取りを AI_FIXTURES_DIR に保存する
+//   - replay: AI_FIXTURES_DIR に保存したやり取りから応答する
 func (f *AIFactory) GetGeminiClient(ctx context.Context) (IGenAIClient, error) {
 	f.geminiOnce.Do(func() {
-		apiKey := os.Getenv("GOOGLE_API_KEY")
-		if apiKey == "" {
-			f.geminiErr = fmt.Errorf("GOOGLE_API_KEY is not set")
-			return
+		fixturesDir := os.Getenv("AI_FIXTURES_DIR")
+		if fixturesDir == "" {
+			fixturesDir = defaultAIFixturesDir
 		}
-		client, err := genai.NewClient(ctx, &genai.ClientConfig{
-			APIKey:  apiKey,
-			Backend: genai.BackendGeminiAPI,
-		})
-		if err != nil {
-			f.geminiErr = err
-			return
+
+		switch mode := os.Getenv("AI_CLIENT"); mode {
+		case "", AIClientGemini:
+			f.geminiClient, f.geminiErr = newGeminiClient(ctx)
+		case AIClientFake:
+			f.geminiClient, f.geminiErr = LoadFakeGenAIClient(os.Getenv("AI_FAKE_RULES"))
+		case AIClientRecord:
+			client, err := newGeminiClient(ctx)
+			if err != nil {
+				f.geminiErr = err
+				return
+			}
+			f.geminiClient = NewRecordingGenAIClient(client, fixturesDir)
+		case AIClientReplay:
+			f.geminiClient = NewReplayGenAIClient(fixturesDir)
+		default:
+			f.geminiErr = fmt.Errorf("unknown AI_CLIENT: %s", mode)
 		}
-		f.geminiClient = &genAIClientWrapper{client: client}
 	})
 	return f.geminiClient, f.geminiErr
 }
+
+func newGeminiClient(ctx context.Context) (IGenAIClient, error) {
+	client, err := newGenAIClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &genAIClientWrapper{client: client}, nil
+}
+
+func newGenAIClient(ctx context.Context) (*genai.Client, error) {
+	apiKey := os.Getenv("GOOGLE_API_KEY")
+	if apiKey == "" {
+		return nil, fmt.Errorf("GOOGLE_API_KEY is not set")
+	}
+	return genai.NewClient(ctx, &genai.ClientConfig{
+		APIKey:  apiKey,
+		Backend: genai.BackendGeminiAPI,
+	})
+}
@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v5"
+)
+
+// AttachmentWriter はレスポンスをダウンロードするファイルとして書き込む。
+// SSEWriter と同様に、最初に書き込むまではヘッダーを書き込まないため、それまでは通常の JSON レスポンスを返せる。
+type AttachmentWriter struct {
+	c           *echo.Context
+	contentType string
+	filename    string
+	started     bool
+}
+
+func NewAttachmentWriter(c *echo.Context, contentType string, filename string) *AttachmentWriter {
+	return &AttachmentWriter{c: c, contentType: contentType, filename: filename}
+}
+
+// Started は書き込みを開始済みかどうかを返す。
+func (w *AttachmentWriter) Started() bool {
+	return w.started
+}
+
+func (w *AttachmentWriter) Write(p []byte) (int, error) {
+	res := w.c.Response()
+	if !w.started {
+		res.Header().Set(echo.HeaderContentType, w.contentType)
+		res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", w.filename))
+		res.Header().Set(echo.HeaderCacheControl, "no-store")
+		res.WriteHeader(http.StatusOK)
+		w.started = true
+	}
+	return res.Write(p)
+}
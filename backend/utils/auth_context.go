@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"context"
+	"todo-app/ent"
+
+	"github.com/google/uuid"
+)
+
+// authContextKey は認証の結果を context に保存するキー。
+// 他のパッケージの値と衝突しないよう、文字列ではなくこのパッケージの型を使う
+type authContextKey int
+
+const (
+	userContextKey authContextKey = iota
+	sessionIDContextKey
+	tokenScopesContextKey
+	impersonatorIDContextKey
+	workspaceContextKey
+)
+
+// WithUser はリクエストしたユーザーを保存した context を返す
+func WithUser(ctx context.Context, u *ent.User) context.Context {
+	return context.WithValue(ctx, userContextKey, u)
+}
+
+// UserFromContext はリクエストしたユーザーを返す。認証していない場合は false を返す
+func UserFromContext(ctx context.Context) (*ent.User, bool) {
+	u, ok := ctx.Value(userContextKey).(*ent.User)
+	return u, ok && u != nil
+}
+
+// WithSessionID はリクエストに使われたトークンのセッション ID を保存した context を返す
+func WithSessionID(ctx context.Context, id uuid.UUID) context.Context {
+	return context.WithValue(ctx, sessionIDContextKey, id)
+}
+
+// SessionIDFromContext はリクエストに使われたトークンのセッション ID を返す。
+// パーソナルアクセストークンで認証した場合はセッションが無いため false を返す
+func SessionIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	id, ok := ctx.Value(sessionIDContextKey).(uuid.UUID)
+	return id, ok
+}
+
+// WithTokenScopes はパーソナルアクセストークンのスコープを保存した context を返す
+func WithTokenScopes(ctx context.Context, scopes []string) context.Context {
+	return context.WithValue(ctx, tokenScopesContextKey, scopes)
+}
+
+// TokenScopesFromContext はパーソナルアクセストークンのスコープを返す。Cookie で認証した場合は false を返す
+func TokenScopesFromContext(ctx context.Context) ([]string, bool) {
+	scopes, ok := ctx.Value(tokenScopesContextKey).([]string)
+	return scopes, ok
+}
+
+// WithImpersonatorID はなりすましている管理者のユーザー ID を保存した context を返す
+func WithImpersonatorID(ctx context.Context, id int) context.Context {
+	return context.WithValue(ctx, impersonatorIDContextKey, id)
+}
+
+// ImpersonatorIDFromContext は管理者がなりすましているセッションの場合に、その管理者のユーザー ID を返す
+func ImpersonatorIDFromContext(ctx context.Context) (int, bool) {
+	id, ok := ctx.Value(impersonatorIDContextKey).(int)
+	return id, ok
+}
+
+// Principal はリクエストしたユーザーと、リクエストの対象のワークスペースでの権限。
+// Todo などワークスペースに属するデータは、このワークスペースの範囲でのみ参照・変更できる
+type Principal struct {
+	User          *ent.User
+	WorkspaceID   int
+	WorkspaceRole string
+}
+
+type workspaceScope struct {
+	id   int
+	role string
+}
+
+// WithWorkspace はリクエストの対象のワークスペースと、そのワークスペースでのユーザーの権限を保存した context を返す
+func WithWorkspace(ctx context.Context, workspaceID int, role string) context.Context {
+	return context.WithValue(ctx, workspaceContextKey, workspaceScope{id: workspaceID, role: role})
+}
+
+// PrincipalFromContext はリクエストしたユーザーとワークスペースを返す。
+// 認証していない場合や、ワークスペースを決定していない場合は false を返す
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	u, ok := UserFromContext(ctx)
+	if !ok {
+		return Principal{}, false
+	}
+	ws, ok := ctx.Value(workspaceContextKey).(workspaceScope)
+	if !ok {
+		return Principal{}, false
+	}
+	return Principal{User: u, WorkspaceID: ws.id, WorkspaceRole: ws.role}, true
+}
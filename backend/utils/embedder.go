@@ -0,0 +1,217 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"os"
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+	"google.golang.org/genai"
+)
+
+// IEmbedder は文章をベクトルに変換する
+type IEmbedder interface {
+	// Model はベクトルを生成したモデルの名前を返す。異なるモデルのベクトル同士は比較できない
+	Model() string
+	// EmbedDocuments は検索対象の文章をベクトルに変換する
+	EmbedDocuments(ctx context.Context, texts []string) ([][]float32, error)
+	// EmbedQuery は検索クエリをベクトルに変換する
+	EmbedQuery(ctx context.Context, text string) ([]float32, error)
+}
+
+type IEmbedderFactory interface {
+	GetEmbedder(ctx context.Context) (IEmbedder, error)
+}
+
+// IEmbeddingClientFactory は利用量の記録を行わない Embedder の生成元。
+// アプリケーションからは利用量を記録する IEmbedderFactory を経由して利用する。
+type IEmbeddingClientFactory interface {
+	GetEmbedder(ctx context.Context) (IEmbedder, error)
+}
+
+const (
+	EmbeddingProviderGemini = "gemini"
+	EmbeddingProviderLocal  = "local"
+)
+
+type EmbedderFactory struct {
+	embedder IEmbedder
+	once     sync.Once
+	err      error
+}
+
+func NewEmbedderFactory() IEmbeddingClientFactory {
+	return &EmbedderFactory{}
+}
+
+// GetEmbedder は EMBEDDING_PROVIDER の設定に応じた Embedder を返す
+//   - gemini (デフォルト): Gemini API でベクトル化する
+//   - local: 外部 API を使わず、文字列から決定的にベクトル化する
+func (f *EmbedderFactory) GetEmbedder(ctx context.Context) (IEmbedder, error) {
+	f.once.Do(func() {
+		switch provider := os.Getenv("EMBEDDING_PROVIDER"); provider {
+		case "", EmbeddingProviderGemini:
+			client, err := newGenAIClient(ctx)
+			if err != nil {
+				f.err = err
+				return
+			}
+			f.embedder = &geminiEmbedder{client: client}
+		case EmbeddingProviderLocal:
+			f.embedder = NewLocalEmbedder()
+		default:
+			f.err = fmt.Errorf("unknown EMBEDDING_PROVIDER: %s", provider)
+		}
+	})
+	return f.embedder, f.err
+}
+
+type localEmbedderFactory struct {
+	embedder *LocalEmbedder
+}
+
+// NewLocalEmbedderFactory は設定に関わらず LocalEmbedder を返す。テストで利用する
+func NewLocalEmbedderFactory() IEmbeddingClientFactory {
+	return &localEmbedderFactory{embedder: NewLocalEmbedder()}
+}
+
+func (f *localEmbedderFactory) GetEmbedder(ctx context.Context) (IEmbedder, error) {
+	return f.embedder, nil
+}
+
+const (
+	geminiEmbeddingModel      = "gemini-embedding-001"
+	geminiEmbeddingDimensions = 768
+)
+
+type geminiEmbedder struct {
+	client *genai.Client
+}
+
+func (e *geminiEmbedder) Model() string {
+	return fmt.Sprintf("%s/%d", geminiEmbeddingModel, geminiEmbeddingDimensions)
+}
+
+func (e *geminiEmbedder) EmbedDocuments(ctx context.Context, texts []string) ([][]float32, error) {
+	return e.embed(ctx, texts, "RETRIEVAL_DOCUMENT")
+}
+
+func (e *geminiEmbedder) EmbedQuery(ctx context.Context, text string) ([]float32, error) {
+	vectors, err := e.embed(ctx, []string{text}, "RETRIEVAL_QUERY")
+	if err != nil {
+		return nil, err
+	}
+	return vectors[0], nil
+}
+
+func (e *geminiEmbedder) embed(ctx context.Context, texts []string, taskType string) ([][]float32, error) {
+	contents := make([]*genai.Content, len(texts))
+	for i, text := range texts {
+		contents[i] = genai.NewContentFromText(text, genai.RoleUser)
+	}
+
+	dimensions := int32(geminiEmbeddingDimensions)
+	res, err := e.client.Models.EmbedContent(ctx, geminiEmbeddingModel, contents, &genai.EmbedContentConfig{
+		TaskType:             taskType,
+		OutputDimensionality: &dimensions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Embeddings) != len(texts) {
+		return nil, fmt.Errorf("unexpected number of embeddings: got %d, want %d", len(res.Embeddings), len(texts))
+	}
+
+	vectors := make([][]float32, len(res.Embeddings))
+	for i, embedding := range res.Embeddings {
+		vectors[i] = embedding.Values
+	}
+	return vectors, nil
+}
+
+const localEmbeddingDimensions = 256
+
+// LocalEmbedder は文字の bigram と単語をハッシュして次元に割り当てる。
+// 意味までは捉えられないが、外部 API を使わずに同じ入力から常に同じベクトルを返す。
+type LocalEmbedder struct{}
+
+func NewLocalEmbedder() *LocalEmbedder {
+	return &LocalEmbedder{}
+}
+
+func (e *LocalEmbedder) Model() string {
+	return fmt.Sprintf("local-hash/%d", localEmbeddingDimensions)
+}
+
+func (e *LocalEmbedder) EmbedDocuments(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		vectors[i] = e.embed(text)
+	}
+	return vectors, nil
+}
+
+func (e *LocalEmbedder) EmbedQuery(ctx context.Context, text string) ([]float32, error) {
+	return e.embed(text), nil
+}
+
+func (e *LocalEmbedder) embed(text string) []float32 {
+	vector := make([]float32, localEmbeddingDimensions)
+	normalized := strings.ToLower(norm.NFKC.String(text))
+
+	addFeature := func(feature string) {
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(feature))
+		sum := h.Sum32()
+		sign := float32(1)
+		if sum&(1<<31) != 0 {
+			sign = -1
+		}
+		vector[sum%localEmbeddingDimensions] += sign
+	}
+
+	for _, word := range strings.FieldsFunc(normalized, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}) {
+		addFeature("w:" + word)
+		runes := []rune(word)
+		for i := 0; i+1 < len(runes); i++ {
+			addFeature("b:" + string(runes[i:i+2]))
+		}
+	}
+
+	var norm2 float64
+	for _, v := range vector {
+		norm2 += float64(v) * float64(v)
+	}
+	if norm2 == 0 {
+		return vector
+	}
+	scale := float32(1 / math.Sqrt(norm2))
+	for i := range vector {
+		vector[i] *= scale
+	}
+	return vector
+}
+
+// CosineSimilarity は2つのベクトルのコサイン類似度を返す。次元が異なる場合やゼロベクトルの場合は 0 を返す
+func CosineSimilarity(a []float32, b []float32) float64 {
+	if len(a) != len(b) {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalEmbedder(t *testing.T) {
+	ctx := context.Background()
+	embedder := NewLocalEmbedder()
+
+	t.Run("同じ文章からは常に同じベクトルを返すこと", func(t *testing.T) {
+		a, err := embedder.EmbedQuery(ctx, "牛乳を買う")
+		assert.NoError(t, err)
+		b, err := embedder.EmbedDocuments(ctx, []string{"牛乳を買う"})
+		assert.NoError(t, err)
+		assert.Equal(t, a, b[0])
+		assert.Len(t, a, localEmbeddingDimensions)
+	})
+
+	t.Run("表現の近い文章ほど類似度が高いこと", func(t *testing.T) {
+		query, _ := embedder.EmbedQuery(ctx, "牛乳の買い物")
+		vectors, _ := embedder.EmbedDocuments(ctx, []string{"スーパーで牛乳を買う", "請求書を支払う"})
+
+		assert.Greater(t, CosineSimilarity(query, vectors[0]), CosineSimilarity(query, vectors[1]))
+	})
+
+	t.Run("全角・半角や大文字・小文字の違いを区別しないこと", func(t *testing.T) {
+		a, _ := embedder.EmbedQuery(ctx, "ＲＥＰＯＲＴ")
+		b, _ := embedder.EmbedQuery(ctx, "report")
+		assert.InDelta(t, 1.0, CosineSimilarity(a, b), 1e-6)
+	})
+}
+
+func TestCosineSimilarity(t *testing.T) {
+	t.Run("同じ向きのベクトルは 1 を返すこと", func(t *testing.T) {
+		assert.InDelta(t, 1.0, CosineSimilarity([]float32{1, 2}, []float32{2, 4}), 1e-6)
+	})
+
+	t.Run("次元が異なる場合やゼロベクトルの場合は 0 を返すこと", func(t *testing.T) {
+		assert.Equal(t, 0.0, CosineSimilarity([]float32{1, 2}, []float32{1, 2, 3}))
+		assert.Equal(t, 0.0, CosineSimilarity([]float32{0, 0}, []float32{1, 2}))
+	})
+}
@@ -0,0 +1,127 @@
+package utils
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"google.golang.org/genai"
+)
+
+//go:embed fake_ai_rules.json
+var defaultFakeAIRules []byte
+
+// FakeAIRule はプロンプトのパターンと、それに対して返す応答の組
+type FakeAIRule struct {
+	// プロンプト (全テキストを改行で連結したもの) に対する正規表現
+	Pattern string `json:"pattern"`
+	// 指定した場合、この FunctionDeclaration が Tool として渡されたリクエストにのみ一致する
+	Tool          string                `json:"tool,omitempty"`
+	FunctionCalls []*genai.FunctionCall `json:"function_calls,omitempty"`
+	Text          string                `json:"text,omitempty"`
+}
+
+type fakeAIRule struct {
+	FakeAIRule
+	pattern *regexp.Regexp
+}
+
+// FakeGenAIClient は API を呼び出さず、ルール表に従って決まった応答を返す IGenAIClient
+type FakeGenAIClient struct {
+	rules []fakeAIRule
+}
+
+func NewFakeGenAIClient(rules []FakeAIRule) (*FakeGenAIClient, error) {
+	compiled := make([]fakeAIRule, len(rules))
+	for i, r := range rules {
+		p, err := regexp.Compile(r.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fake ai rule[%d]: %w", i, err)
+		}
+		compiled[i] = fakeAIRule{FakeAIRule: r, pattern: p}
+	}
+	return &FakeGenAIClient{rules: compiled}, nil
+}
+
+// LoadFakeGenAIClient は JSON のルール表から FakeGenAIClient を作成する。
+// path が空の場合は組み込みのルール表を使用する。
+func LoadFakeGenAIClient(path string) (*FakeGenAIClient, error) {
+	data := defaultFakeAIRules
+	if path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		data = b
+	}
+
+	var rules []FakeAIRule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("invalid fake ai rules: %w", err)
+	}
+	return NewFakeGenAIClient(rules)
+}
+
+// GenerateContent implements IGenAIClient
+// 最初に一致したルールの応答を返す。一致するルールが無い場合は空の応答を返す。
+func (c *FakeGenAIClient) GenerateContent(ctx context.Context, model string, contents []*genai.Content, config *genai.GenerateContentConfig) (*genai.GenerateContentResponse, error) {
+	prompt := promptText(contents)
+	for _, r := range c.rules {
+		if r.Tool != "" && !hasTool(config, r.Tool) {
+			continue
+		}
+		if !r.pattern.MatchString(prompt) {
+			continue
+		}
+
+		parts := make([]*genai.Part, 0, len(r.FunctionCalls)+1)
+		for _, fc := range r.FunctionCalls {
+			parts = append(parts, &genai.Part{FunctionCall: fc})
+		}
+		if r.Text != "" {
+			parts = append(parts, &genai.Part{Text: r.Text})
+		}
+		return &genai.GenerateContentResponse{
+			Candidates: []*genai.Candidate{
+				{Content: &genai.Content{Role: genai.RoleModel, Parts: parts}},
+			},
+		}, nil
+	}
+	return &genai.GenerateContentResponse{}, nil
+}
+
+func promptText(contents []*genai.Content) string {
+	texts := []string{}
+	for _, content := range contents {
+		if content == nil {
+			continue
+		}
+		for _, part := range content.Parts {
+			if part != nil && part.Text != "" {
+				texts = append(texts, part.Text)
+			}
+		}
+	}
+	return strings.Join(texts, "\n")
+}
+
+func hasTool(config *genai.GenerateContentConfig, name string) bool {
+	if config == nil {
+		return false
+	}
+	for _, tool := range config.Tools {
+		if tool == nil {
+			continue
+		}
+		for _, fd := range tool.FunctionDeclarations {
+			if fd != nil && fd.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genai"
+)
+
+type stubGenAIClient struct {
+	response *genai.GenerateContentResponse
+	calls    int
+}
+
+func (s *stubGenAIClient) GenerateContent(ctx context.Context, model string, contents []*genai.Content, config *genai.GenerateContentConfig) (*genai.GenerateContentResponse, error) {
+	s.calls++
+	return s.response, nil
+}
+
+func filterConfig(name string) *genai.GenerateContentConfig {
+	return &genai.GenerateContentConfig{
+		Tools: []*genai.Tool{{FunctionDeclarations: []*genai.FunctionDeclaration{{Name: name}}}},
+	}
+}
+
+func TestFakeGenAIClient_GenerateContent(t *testing.T) {
+	ctx := context.Background()
+	client, err := NewFakeGenAIClient([]FakeAIRule{
+		{
+			Pattern:       "先週",
+			Tool:          "ListTodosByDoneAt",
+			FunctionCalls: []*genai.FunctionCall{{Name: "ListTodosByDoneAt", Args: map[string]any{"done_from": "2026-10-12T00:00:00+09:00"}}},
+		},
+		{Pattern: "分解", Text: `{"steps":[]}`},
+	})
+	assert.NoError(t, err)
+
+	t.Run("パターンと Tool が一致したルールの FunctionCall を返すこと", func(t *testing.T) {
+		contents := []*genai.Content{{Parts: []*genai.Part{{Text: "現在2026年10月19日10:00:00です。"}, {Text: "先週完了したタスク"}}}}
+
+		res, err := client.GenerateContent(ctx, "model", contents, filterConfig("ListTodosByDoneAt"))
+		assert.NoError(t, err)
+		fc := res.Candidates[0].Content.Parts[0].FunctionCall
+		assert.Equal(t, "ListTodosByDoneAt", fc.Name)
+		assert.Equal(t, "2026-10-12T00:00:00+09:00", fc.Args["done_from"])
+	})
+
+	t.Run("Tool が渡されていない場合は一致しないこと", func(t *testing.T) {
+		contents := []*genai.Content{{Parts: []*genai.Part{{Text: "先週完了したタスク"}}}}
+
+		res, err := client.GenerateContent(ctx, "model", contents, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, res.Candidates)
+	})
+
+	t.Run("テキストの応答を返すこと", func(t *testing.T) {
+		contents := []*genai.Content{{Parts: []*genai.Part{{Text: "手順に分解してください"}}}}
+
+		res, err := client.GenerateContent(ctx, "model", contents, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"steps":[]}`, res.Text())
+	})
+
+	t.Run("不正なパターンはエラーになること", func(t *testing.T) {
+		_, err := NewFakeGenAIClient([]FakeAIRule{{Pattern: "("}})
+		assert.Error(t, err)
+	})
+}
+
+func TestLoadFakeGenAIClient(t *testing.T) {
+	client, err := LoadFakeGenAIClient("")
+	assert.NoError(t, err)
+
+	contents := []*genai.Content{{Parts: []*genai.Part{{Text: "完了したタスク"}}}}
+	res, err := client.GenerateContent(context.Background(), "model", contents, filterConfig("ListTodosByDoneAt"))
+	assert.NoError(t, err)
+	assert.Equal(t, "ListTodosByDoneAt", res.Candidates[0].Content.Parts[0].FunctionCall.Name)
+}
+
+func TestRecordAndReplayGenAIClient(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	stub := &stubGenAIClient{response: &genai.GenerateContentResponse{
+		Candidates: []*genai.Candidate{
+			{Content: &genai.Content{Parts: []*genai.Part{{FunctionCall: &genai.FunctionCall{Name: "ListTodosByDoneAt", Args: map[string]any{"done_to": "2026-10-19T00:00:00+09:00"}}}}}},
+		},
+	}}
+
+	recorded := []*genai.Content{{Parts: []*genai.Part{{Text: "現在2026年10月19日10:00:00です。"}, {Text: "昨日までに完了したタスク"}}}}
+	_, err := NewRecordingGenAIClient(stub, dir).GenerateContent(ctx, "model", recorded, nil)
+	assert.NoError(t, err)
+
+	replay := NewReplayGenAIClient(dir)
+
+	t.Run("実行日時が異なっても記録した応答を返すこと", func(t *testing.T) {
+		contents := []*genai.Content{{Parts: []*genai.Part{{Text: "現在2026年12月1日09:30:00です。"}, {Text: "昨日までに完了したタスク"}}}}
+
+		res, err := replay.GenerateContent(ctx, "model", contents, nil)
+		assert.NoError(t, err)
+		fc := res.Candidates[0].Content.Parts[0].FunctionCall
+		assert.Equal(t, "ListTodosByDoneAt", fc.Name)
+		assert.Equal(t, "2026-10-19T00:00:00+09:00", fc.Args["done_to"])
+		assert.Equal(t, 1, stub.calls)
+	})
+
+	t.Run("記録されていないプロンプトはエラーを返すこと", func(t *testing.T) {
+		contents := []*genai.Content{{Parts: []*genai.Part{{Text: "未記録のクエリ"}}}}
+
+		res, err := replay.GenerateContent(ctx, "model", contents, nil)
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+}
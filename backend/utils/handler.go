@@ -1,7 +1,13 @@
 package utils
 
 import (
+	"context"
+	"errors"
 	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"todo-app/app_errors"
 
 	"github.com/labstack/echo/v5"
 )
@@ -15,7 +21,17 @@ func LogRequest(logger *slog.Logger, c *echo.Context) {
 }
 
 // HandleError logs an error and returns a JSON response with the error message and status code.
+// AI の利用上限に達した場合は、呼び出し元に関わらず 429 を返す。
+// ログインの試行を制限している場合は 429 に加えて、再試行できるまでの秒数を Retry-After で返す。
 func HandleError(logger *slog.Logger, c *echo.Context, err error, code int) error {
+	if errors.Is(err, app_errors.ErrAIQuotaExceeded) {
+		code = http.StatusTooManyRequests
+	}
+	var throttled *app_errors.LoginThrottledError
+	if errors.As(err, &throttled) {
+		code = http.StatusTooManyRequests
+		c.Response().Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(throttled.RetryAfter.Seconds()))))
+	}
 	logger.Error(err.Error(),
 		slog.Int("status", code),
 		slog.String("path", c.Request().URL.Path),
@@ -25,3 +41,34 @@ func HandleError(logger *slog.Logger, c *echo.Context, err error, code int) erro
 		"error": err.Error(),
 	})
 }
+
+// HandleStreamError は SSE のストリームを開始済みであれば error イベントとしてエラーを送信する。
+// 開始前であれば HandleError と同様に JSON で返す。クライアントが切断した場合は何も返さない。
+func HandleStreamError(logger *slog.Logger, c *echo.Context, w *SSEWriter, err error, code int) error {
+	if errors.Is(err, context.Canceled) {
+		logger.Info("client disconnected",
+			slog.String("path", c.Request().URL.Path),
+			slog.String("method", c.Request().Method),
+		)
+		return nil
+	}
+	if !w.Started() {
+		return HandleError(logger, c, err, code)
+	}
+
+	if errors.Is(err, app_errors.ErrAIQuotaExceeded) {
+		code = http.StatusTooManyRequests
+	}
+	logger.Error(err.Error(),
+		slog.Int("status", code),
+		slog.String("path", c.Request().URL.Path),
+		slog.String("method", c.Request().Method),
+	)
+	if sendErr := w.Send("error", map[string]interface{}{
+		"error":  err.Error(),
+		"status": code,
+	}); sendErr != nil {
+		logger.Info("failed to send error event", slog.String("error", sendErr.Error()))
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+package utils
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"log/slog"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// JWTAlgHS256 は共有の秘密鍵で署名する。署名と検証に同じ鍵を使う
+	JWTAlgHS256 = "HS256"
+	// JWTAlgRS256 / JWTAlgEdDSA は秘密鍵で署名し、公開鍵で検証する
+	JWTAlgRS256 = "RS256"
+	JWTAlgEdDSA = "EdDSA"
+
+	// minHMACSecretLength は HS256 の秘密鍵に必要な長さ (バイト)
+	minHMACSecretLength = 32
+	minRSAKeyBits       = 2048
+
+	// devJWTKeyID / devJWTSecret はローカル開発とテストでだけ使う鍵
+	devJWTKeyID  = "dev"
+	devJWTSecret = "secret"
+	// legacyJWTKeyID は JWT_SECRET で指定した鍵の ID
+	legacyJWTKeyID = "default"
+)
+
+var jwtKeyIDPattern = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// JWTKey は JWT の署名・検証に使う鍵。ID はトークンのヘッダーの kid に入る
+type JWTKey struct {
+	ID     string
+	method jwt.SigningMethod
+	// signKey は署名に使う鍵。検証にしか使わない鍵では nil
+	signKey   any
+	verifyKey any
+}
+
+// NewHMACJWTKey は HS256 の鍵を作成する
+func NewHMACJWTKey(id string, secret []byte) *JWTKey {
+	return &JWTKey{ID: id, method: jwt.SigningMethodHS256, signKey: secret, verifyKey: secret}
+}
+
+// NewRSAJWTKey は RS256 の鍵を作成する
+func NewRSAJWTKey(id string, key *rsa.PrivateKey) *JWTKey {
+	return &JWTKey{ID: id, method: jwt.SigningMethodRS256, signKey: key, verifyKey: &key.PublicKey}
+}
+
+// NewEdDSAJWTKey は EdDSA (Ed25519) の鍵を作成する
+func NewEdDSAJWTKey(id string, key ed25519.PrivateKey) *JWTKey {
+	return &JWTKey{ID: id, method: jwt.SigningMethodEdDSA, signKey: key, verifyKey: key.Public()}
+}
+
+// JWTKeyManager はアプリケーションが発行する JWT の署名と検証を行う。
+// 署名には 1 つの鍵だけを使い、検証はヘッダーの kid で選んだ鍵で行うため、
+// 鍵を入れ替えても古い鍵を残しておけば、入れ替え前に発行したトークンを有効期限まで検証できる
+type JWTKeyManager struct {
+	signing *JWTKey
+	keys    map[string]*JWTKey
+}
+
+// NewJWTKeyManager は signing で署名し、signing と verifyOnly で検証する JWTKeyManager を作成する
+func NewJWTKeyManager(signing *JWTKey, verifyOnly ...*JWTKey) (*JWTKeyManager, error) {
+	if signing.signKey == nil {
+		return nil, fmt.Errorf("jwt key %q cannot be used for signing", signing.ID)
+	}
+	m := &JWTKeyManager{signing: signing, keys: map[string]*JWTKey{}}
+	for _, key := range append([]*JWTKey{signing}, verifyOnly...) {
+		if !jwtKeyIDPattern.MatchString(key.ID) {
+			return nil, fmt.Errorf("invalid jwt key id: %q", key.ID)
+		}
+		if _, ok := m.keys[key.ID]; ok {
+			return nil, fmt.Errorf("duplicate jwt key id: %q", key.ID)
+		}
+		m.keys[key.ID] = key
+	}
+	return m, nil
+}
+
+// NewDevJWTKeyManager はローカル開発とテスト用の、固定の秘密鍵を使う JWTKeyManager を作成する
+func NewDevJWTKeyManager() *JWTKeyManager {
+	m, err := NewJWTKeyManager(NewHMACJWTKey(devJWTKeyID, []byte(devJWTSecret)))
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// NewJWTKeyManagerFromEnv は環境変数の設定から JWTKeyManager を作成する
+//   - JWT_KEYS: カンマ区切りの鍵の ID。先頭の鍵で署名し、残りは検証にだけ使う。
+//     鍵ごとに JWT_KEY_<ID の大文字>_ALG (HS256 / RS256 / EdDSA) と、
+//     HS256 の場合は _SECRET、RS256 / EdDSA の場合は PEM 形式の _PRIVATE_KEY_FILE (検証にだけ使う鍵は _PUBLIC_KEY_FILE でもよい) を指定する
+//   - JWT_SECRET: JWT_KEYS を指定しない場合に、ID が default の HS256 の鍵として使う
+//
+// ローカル (APP_ENV が空か local) 以外では、鍵が無い場合や HS256 の秘密鍵が短すぎる場合にエラーを返し、起動させない
+func NewJWTKeyManagerFromEnv(logger *slog.Logger) (*JWTKeyManager, error) {
+	appEnv := os.Getenv("APP_ENV")
+	local := appEnv == "" || appEnv == "local"
+
+	var keys []*JWTKey
+	if names := os.Getenv("JWT_KEYS"); names != "" {
+		for _, id := range strings.Split(names, ",") {
+			key, err := loadJWTKeyFromEnv(strings.TrimSpace(id))
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, key)
+		}
+	} else if secret := os.Getenv("JWT_SECRET"); secret != "" {
+		keys = append(keys, NewHMACJWTKey(legacyJWTKeyID, []byte(secret)))
+	}
+
+	if len(keys) == 0 {
+		if !local {
+			return nil, fmt.Errorf("JWT_KEYS or JWT_SECRET is required when APP_ENV is %q", appEnv)
+		}
+		logger.Warn("JWT_KEYS and JWT_SECRET are not set; using an insecure development key")
+		return NewDevJWTKeyManager(), nil
+	}
+
+	for _, key := range keys {
+		if secret, ok := key.signKey.([]byte); ok && len(secret) < minHMACSecretLength {
+			if !local {
+				return nil, fmt.Errorf("jwt key %q: HS256 secret must be at least %d bytes when APP_ENV is %q", key.ID, minHMACSecretLength, appEnv)
+			}
+			logger.Warn("jwt key has a short HS256 secret", "kid", key.ID)
+		}
+	}
+	return NewJWTKeyManager(keys[0], keys[1:]...)
+}
+
+func loadJWTKeyFromEnv(id string) (*JWTKey, error) {
+	if !jwtKeyIDPattern.MatchString(id) {
+		return nil, fmt.Errorf("invalid jwt key id: %q", id)
+	}
+	prefix := "JWT_KEY_" + strings.ToUpper(id) + "_"
+
+	switch alg := os.Getenv(prefix + "ALG"); alg {
+	case JWTAlgHS256:
+		secret := os.Getenv(prefix + "SECRET")
+		if secret == "" {
+			return nil, fmt.Errorf("%sSECRET is required", prefix)
+		}
+		return NewHMACJWTKey(id, []byte(secret)), nil
+	case JWTAlgRS256, JWTAlgEdDSA:
+		path, public := os.Getenv(prefix+"PRIVATE_KEY_FILE"), false
+		if path == "" {
+			path, public = os.Getenv(prefix+"PUBLIC_KEY_FILE"), true
+		}
+		if path == "" {
+			return nil, fmt.Errorf("%sPRIVATE_KEY_FILE or %sPUBLIC_KEY_FILE is required", prefix, prefix)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("jwt key %q: %w", id, err)
+		}
+		key, err := ParseJWTKeyPEM(id, alg, data)
+		if err != nil {
+			return nil, err
+		}
+		if public != (key.signKey == nil) {
+			return nil, fmt.Errorf("jwt key %q: unexpected key type in %s", id, path)
+		}
+		return key, nil
+	default:
+		return nil, fmt.Errorf("%sALG must be one of %s, %s, %s: %q", prefix, JWTAlgHS256, JWTAlgRS256, JWTAlgEdDSA, alg)
+	}
+}
+
+// ParseJWTKeyPEM は PEM 形式の RS256 / EdDSA の鍵を読み込む。
+// 秘密鍵 (PKCS #8 または PKCS #1) の場合は署名にも使え、公開鍵 (PKIX) の場合は検証にだけ使える
+func ParseJWTKeyPEM(id string, alg string, data []byte) (*JWTKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("jwt key %q: no PEM data found", id)
+	}
+
+	var parsed any
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("jwt key %q: unsupported PEM block type %q", id, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("jwt key %q: %w", id, err)
+	}
+
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		if alg == JWTAlgRS256 && k.N.BitLen() >= minRSAKeyBits {
+			return NewRSAJWTKey(id, k), nil
+		}
+	case *rsa.PublicKey:
+		if alg == JWTAlgRS256 && k.N.BitLen() >= minRSAKeyBits {
+			return &JWTKey{ID: id, method: jwt.SigningMethodRS256, verifyKey: k}, nil
+		}
+	case ed25519.PrivateKey:
+		if alg == JWTAlgEdDSA {
+			return NewEdDSAJWTKey(id, k), nil
+		}
+	case ed25519.PublicKey:
+		if alg == JWTAlgEdDSA {
+			return &JWTKey{ID: id, method: jwt.SigningMethodEdDSA, verifyKey: k}, nil
+		}
+	}
+	return nil, fmt.Errorf("jwt key %q: key type %T cannot be used for %s (RSA keys need at least %d bits)", id, parsed, alg, minRSAKeyBits)
+}
+
+// Sign は署名用の鍵で claims に署名し、ヘッダーの kid に鍵の ID を入れる
+func (m *JWTKeyManager) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(m.signing.method, claims)
+	token.Header["kid"] = m.signing.ID
+	return token.SignedString(m.signing.signKey)
+}
+
+// Parse はヘッダーの kid の鍵で署名を検証し、claims に読み込む。
+// kid が無いトークンや、鍵と異なるアルゴリズムで署名されたトークンは受け付けない
+func (m *JWTKeyManager) Parse(tokenString string, claims jwt.Claims, opts ...jwt.ParserOption) (*jwt.Token, error) {
+	return jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := m.keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown jwt key id: %q", kid)
+		}
+		if token.Method.Alg() != key.method.Alg() {
+			return nil, fmt.Errorf("unexpected signing method for jwt key %q: %v", kid, token.Header["alg"])
+		}
+		return key.verifyKey, nil
+	}, opts...)
+}
@@ -0,0 +1,212 @@
+package utils
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testClaims() jwt.MapClaims {
+	return jwt.MapClaims{"sub": "1", "exp": time.Now().Add(time.Minute).Unix()}
+}
+
+// writePEM は鍵を PEM 形式でファイルに保存し、そのパスを返す
+func writePEM(t *testing.T, blockType string, der []byte) string {
+	path := filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600))
+	return path
+}
+
+func TestJWTKeyManager(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	t.Run("署名したトークンのヘッダーに kid が入り、検証できること", func(t *testing.T) {
+		for _, key := range []*JWTKey{
+			NewHMACJWTKey("hs", []byte(strings.Repeat("k", 32))),
+			NewRSAJWTKey("rs", rsaKey),
+			NewEdDSAJWTKey("ed", edKey),
+		} {
+			m, err := NewJWTKeyManager(key)
+			require.NoError(t, err)
+			signed, err := m.Sign(testClaims())
+			require.NoError(t, err)
+
+			claims := jwt.MapClaims{}
+			token, err := m.Parse(signed, claims)
+			require.NoError(t, err, key.ID)
+			assert.Equal(t, key.ID, token.Header["kid"])
+			assert.Equal(t, key.method.Alg(), token.Method.Alg())
+			assert.Equal(t, "1", claims["sub"])
+		}
+	})
+
+	t.Run("鍵を入れ替えた後も、古い鍵で署名したトークンを検証できること", func(t *testing.T) {
+		oldKey := NewHMACJWTKey("old", []byte(strings.Repeat("o", 32)))
+		before, err := NewJWTKeyManager(oldKey)
+		require.NoError(t, err)
+		oldToken, err := before.Sign(testClaims())
+		require.NoError(t, err)
+
+		after, err := NewJWTKeyManager(NewEdDSAJWTKey("new", edKey), oldKey)
+		require.NoError(t, err)
+		_, err = after.Parse(oldToken, jwt.MapClaims{})
+		assert.NoError(t, err)
+
+		newToken, err := after.Sign(testClaims())
+		require.NoError(t, err)
+		token, err := after.Parse(newToken, jwt.MapClaims{})
+		require.NoError(t, err)
+		assert.Equal(t, "new", token.Header["kid"])
+
+		// 古い鍵を取り除くと、その鍵で署名したトークンは受け付けない
+		removed, err := NewJWTKeyManager(NewEdDSAJWTKey("new", edKey))
+		require.NoError(t, err)
+		_, err = removed.Parse(oldToken, jwt.MapClaims{})
+		assert.Error(t, err)
+	})
+
+	t.Run("kid が無い、または鍵と異なるアルゴリズムのトークンを拒否すること", func(t *testing.T) {
+		m, err := NewJWTKeyManager(NewRSAJWTKey("rs", rsaKey))
+		require.NoError(t, err)
+
+		noKid, err := jwt.NewWithClaims(jwt.SigningMethodRS256, testClaims()).SignedString(rsaKey)
+		require.NoError(t, err)
+		_, err = m.Parse(noKid, jwt.MapClaims{})
+		assert.Error(t, err)
+
+		// 公開鍵を HMAC の秘密鍵として使った署名を受け付けないこと
+		publicDER, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+		require.NoError(t, err)
+		forged := jwt.NewWithClaims(jwt.SigningMethodHS256, testClaims())
+		forged.Header["kid"] = "rs"
+		forgedToken, err := forged.SignedString(publicDER)
+		require.NoError(t, err)
+		_, err = m.Parse(forgedToken, jwt.MapClaims{})
+		assert.Error(t, err)
+	})
+
+	t.Run("同じ ID の鍵や検証にしか使えない鍵で署名しようとするとエラーになること", func(t *testing.T) {
+		_, err := NewJWTKeyManager(NewHMACJWTKey("a", []byte("x")), NewHMACJWTKey("a", []byte("y")))
+		assert.Error(t, err)
+
+		publicDER, err := x509.MarshalPKIXPublicKey(edKey.Public())
+		require.NoError(t, err)
+		verifyOnly, err := ParseJWTKeyPEM("ed", JWTAlgEdDSA, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER}))
+		require.NoError(t, err)
+		_, err = NewJWTKeyManager(verifyOnly)
+		assert.Error(t, err)
+	})
+}
+
+func TestNewJWTKeyManagerFromEnv(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	unsetJWTEnv := func(t *testing.T) {
+		t.Setenv("JWT_KEYS", "")
+		t.Setenv("JWT_SECRET", "")
+	}
+
+	t.Run("ローカル以外では鍵が無い場合や秘密鍵が短い場合に起動させないこと", func(t *testing.T) {
+		unsetJWTEnv(t)
+		t.Setenv("APP_ENV", "production")
+		_, err := NewJWTKeyManagerFromEnv(logger)
+		assert.Error(t, err)
+
+		t.Setenv("JWT_SECRET", "secret")
+		_, err = NewJWTKeyManagerFromEnv(logger)
+		assert.Error(t, err)
+
+		t.Setenv("JWT_SECRET", strings.Repeat("s", 32))
+		m, err := NewJWTKeyManagerFromEnv(logger)
+		require.NoError(t, err)
+		signed, err := m.Sign(testClaims())
+		require.NoError(t, err)
+		token, err := m.Parse(signed, jwt.MapClaims{})
+		require.NoError(t, err)
+		assert.Equal(t, "default", token.Header["kid"])
+	})
+
+	t.Run("ローカルでは鍵が無くても開発用の鍵で起動できること", func(t *testing.T) {
+		unsetJWTEnv(t)
+		t.Setenv("APP_ENV", "local")
+		m, err := NewJWTKeyManagerFromEnv(logger)
+		require.NoError(t, err)
+		signed, err := m.Sign(testClaims())
+		require.NoError(t, err)
+		_, err = NewDevJWTKeyManager().Parse(signed, jwt.MapClaims{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("JWT_KEYS の先頭の鍵で署名し、残りの鍵でも検証できること", func(t *testing.T) {
+		unsetJWTEnv(t)
+		t.Setenv("APP_ENV", "production")
+
+		_, edKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		edDER, err := x509.MarshalPKCS8PrivateKey(edKey)
+		require.NoError(t, err)
+		rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		rsaPublicDER, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+		require.NoError(t, err)
+
+		t.Setenv("JWT_KEYS", "key2, key1")
+		t.Setenv("JWT_KEY_KEY2_ALG", "EdDSA")
+		t.Setenv("JWT_KEY_KEY2_PRIVATE_KEY_FILE", writePEM(t, "PRIVATE KEY", edDER))
+		t.Setenv("JWT_KEY_KEY1_ALG", "RS256")
+		t.Setenv("JWT_KEY_KEY1_PUBLIC_KEY_FILE", writePEM(t, "PUBLIC KEY", rsaPublicDER))
+
+		m, err := NewJWTKeyManagerFromEnv(logger)
+		require.NoError(t, err)
+
+		signed, err := m.Sign(testClaims())
+		require.NoError(t, err)
+		token, err := m.Parse(signed, jwt.MapClaims{})
+		require.NoError(t, err)
+		assert.Equal(t, "key2", token.Header["kid"])
+		assert.Equal(t, "EdDSA", token.Method.Alg())
+
+		old := jwt.NewWithClaims(jwt.SigningMethodRS256, testClaims())
+		old.Header["kid"] = "key1"
+		oldToken, err := old.SignedString(rsaKey)
+		require.NoError(t, err)
+		_, err = m.Parse(oldToken, jwt.MapClaims{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("鍵の設定が誤っている場合はエラーになること", func(t *testing.T) {
+		unsetJWTEnv(t)
+		t.Setenv("APP_ENV", "production")
+
+		t.Setenv("JWT_KEYS", "key1")
+		t.Setenv("JWT_KEY_KEY1_ALG", "none")
+		_, err := NewJWTKeyManagerFromEnv(logger)
+		assert.Error(t, err)
+
+		// アルゴリズムと鍵の種類が一致しない
+		_, edKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		edDER, err := x509.MarshalPKCS8PrivateKey(edKey)
+		require.NoError(t, err)
+		t.Setenv("JWT_KEY_KEY1_ALG", "RS256")
+		t.Setenv("JWT_KEY_KEY1_PRIVATE_KEY_FILE", writePEM(t, "PRIVATE KEY", edDER))
+		_, err = NewJWTKeyManagerFromEnv(logger)
+		assert.Error(t, err)
+	})
+}
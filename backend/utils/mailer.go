@@ -0,0 +1,138 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Mail は送信するテキストメール
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// IMailer はメールを送信する
+type IMailer interface {
+	Send(ctx context.Context, mail Mail) error
+}
+
+const (
+	MailDriverSMTP = "smtp"
+	MailDriverLog  = "log"
+)
+
+// NewMailer は MAIL_DRIVER の設定に応じた IMailer を返す
+//   - log (デフォルト): 送信せずにログに出力する。MAIL_DIR を指定した場合はメールをファイルにも保存する
+//   - smtp: SMTP_HOST / SMTP_PORT / SMTP_USERNAME / SMTP_PASSWORD の SMTP サーバーから送信する
+func NewMailer(logger *slog.Logger) (IMailer, error) {
+	switch driver := os.Getenv("MAIL_DRIVER"); driver {
+	case "", MailDriverLog:
+		return NewLogMailer(logger, os.Getenv("MAIL_DIR")), nil
+	case MailDriverSMTP:
+		return NewSMTPMailerFromEnv()
+	default:
+		return nil, fmt.Errorf("unknown MAIL_DRIVER: %s", driver)
+	}
+}
+
+func mailFrom() string {
+	if from := os.Getenv("MAIL_FROM"); from != "" {
+		return from
+	}
+	return "no-reply@localhost"
+}
+
+// buildMessage は RFC 5322 形式のメッセージを組み立てる
+func buildMessage(from string, mail Mail, now time.Time) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", mail.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", mail.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(mail.Body, "\n", "\r\n"))
+	return b.Bytes()
+}
+
+// LogMailer はメールを送信せず、ログ (と任意でファイル) に出力する。ローカル開発用
+type LogMailer struct {
+	logger *slog.Logger
+	dir    string
+}
+
+func NewLogMailer(logger *slog.Logger, dir string) *LogMailer {
+	return &LogMailer{logger: logger, dir: dir}
+}
+
+func (m *LogMailer) Send(ctx context.Context, mail Mail) error {
+	m.logger.Info("mail",
+		slog.String("to", mail.To),
+		slog.String("subject", mail.Subject),
+		slog.String("body", mail.Body),
+	)
+	if m.dir == "" {
+		return nil
+	}
+
+	if err := os.MkdirAll(m.dir, 0o755); err != nil {
+		return err
+	}
+	now := time.Now()
+	name := fmt.Sprintf("%s-%s.eml", now.Format("20060102T150405.000000000"), sanitizeFileName(mail.To))
+	return os.WriteFile(filepath.Join(m.dir, name), buildMessage(mailFrom(), mail, now), 0o644)
+}
+
+func sanitizeFileName(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r == ':' {
+			return '_'
+		}
+		return r
+	}, s)
+}
+
+// SMTPMailer は SMTP サーバーからメールを送信する
+type SMTPMailer struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+func NewSMTPMailerFromEnv() (*SMTPMailer, error) {
+	host := os.Getenv("SMTP_HOST")
+	if host == "" {
+		return nil, fmt.Errorf("SMTP_HOST is not set")
+	}
+	port := os.Getenv("SMTP_PORT")
+	if port == "" {
+		port = "587"
+	}
+
+	var auth smtp.Auth
+	if username := os.Getenv("SMTP_USERNAME"); username != "" {
+		auth = smtp.PlainAuth("", username, os.Getenv("SMTP_PASSWORD"), host)
+	}
+	return &SMTPMailer{addr: net.JoinHostPort(host, port), auth: auth, from: mailFrom()}, nil
+}
+
+// Send implements IMailer
+// net/smtp は context に対応していないため、キャンセルは送信前にのみ確認する
+func (m *SMTPMailer) Send(ctx context.Context, mail Mail) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return smtp.SendMail(m.addr, m.auth, m.from, []string{mail.To}, buildMessage(m.from, mail, time.Now()))
+}
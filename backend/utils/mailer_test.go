@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogMailer_Send(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("MAIL_DIR を指定した場合はメールをファイルに保存すること", func(t *testing.T) {
+		t.Setenv("MAIL_FROM", "todo@example.com")
+		dir := t.TempDir()
+		mailer := NewLogMailer(logger, dir)
+
+		err := mailer.Send(context.Background(), Mail{To: "user@example.com", Subject: "パスワードの再設定", Body: "1行目\n2行目"})
+		require.NoError(t, err)
+
+		files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		b, err := os.ReadFile(files[0])
+		require.NoError(t, err)
+		content := string(b)
+		assert.Contains(t, content, "From: todo@example.com\r\n")
+		assert.Contains(t, content, "To: user@example.com\r\n")
+		assert.Contains(t, content, "Subject: =?UTF-8?b?")
+		assert.Contains(t, content, "\r\n\r\n1行目\r\n2行目")
+	})
+
+	t.Run("MAIL_DIR を指定しない場合はファイルを作成しないこと", func(t *testing.T) {
+		mailer := NewLogMailer(logger, "")
+
+		err := mailer.Send(context.Background(), Mail{To: "user@example.com", Subject: "subject", Body: "body"})
+		assert.NoError(t, err)
+	})
+}
+
+func TestNewMailer(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("未指定の場合は LogMailer を返すこと", func(t *testing.T) {
+		t.Setenv("MAIL_DRIVER", "")
+		mailer, err := NewMailer(logger)
+		require.NoError(t, err)
+		assert.IsType(t, &LogMailer{}, mailer)
+	})
+
+	t.Run("smtp で SMTP_HOST が無い場合はエラーを返すこと", func(t *testing.T) {
+		t.Setenv("MAIL_DRIVER", MailDriverSMTP)
+		t.Setenv("SMTP_HOST", "")
+		_, err := NewMailer(logger)
+		assert.Error(t, err)
+	})
+
+	t.Run("不明なドライバの場合はエラーを返すこと", func(t *testing.T) {
+		t.Setenv("MAIL_DRIVER", "unknown")
+		_, err := NewMailer(logger)
+		assert.EqualError(t, err, "unknown MAIL_DRIVER: unknown")
+	})
+}
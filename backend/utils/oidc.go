@@ -0,0 +1,429 @@
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// OIDCProviderConfig は OpenID Connect のプロバイダの設定
+type OIDCProviderConfig struct {
+	Name         string
+	DisplayName  string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	// プロバイダからのリダイレクト先 (/auth/oidc/:provider/callback の URL)
+	RedirectURL string
+}
+
+// OIDCClaims は ID トークンから取り出したユーザーの情報
+type OIDCClaims struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+var ErrOIDCProviderNotFound = errors.New("oidc provider not found")
+
+// JWKS を再取得する間隔。鍵のローテーションに追従するため、未知の kid の場合はこれより前でも再取得する
+const (
+	oidcJWKSCacheTTL = time.Hour
+	// 未知の kid のトークンを大量に送られても、プロバイダへの問い合わせが増えすぎないようにする
+	oidcJWKSMinRefreshInterval = time.Minute
+	// 検証する ID トークンの時刻のずれの許容範囲
+	oidcClockSkew = time.Minute
+)
+
+// OIDCRegistry は設定されたプロバイダを名前で引けるようにする
+type OIDCRegistry struct {
+	providers map[string]*OIDCProvider
+}
+
+// NewOIDCRegistry は環境変数からプロバイダの設定を読み込む。
+// OIDC_PROVIDERS にカンマ区切りで名前を指定し、名前ごとに次の環境変数を設定する (名前は大文字にする)
+//   - OIDC_<NAME>_ISSUER (必須)
+//   - OIDC_<NAME>_CLIENT_ID (必須)
+//   - OIDC_<NAME>_CLIENT_SECRET (公開クライアントの場合は省略可能)
+//   - OIDC_<NAME>_SCOPES (省略時は "openid email profile")
+//   - OIDC_<NAME>_DISPLAY_NAME (省略時は名前)
+//
+// コールバックの URL は OIDC_CALLBACK_BASE_URL (このサーバーの URL) から組み立てる。
+// ディスカバリーは最初のログイン時に行うため、起動時にプロバイダへは接続しない
+func NewOIDCRegistry() (*OIDCRegistry, error) {
+	r := &OIDCRegistry{providers: map[string]*OIDCProvider{}}
+
+	names := os.Getenv("OIDC_PROVIDERS")
+	if strings.TrimSpace(names) == "" {
+		return r, nil
+	}
+	callbackBaseURL := strings.TrimSuffix(os.Getenv("OIDC_CALLBACK_BASE_URL"), "/")
+	if callbackBaseURL == "" {
+		return nil, fmt.Errorf("OIDC_CALLBACK_BASE_URL is not set")
+	}
+
+	for _, name := range strings.Split(names, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		prefix := "OIDC_" + strings.ToUpper(name) + "_"
+		cfg := OIDCProviderConfig{
+			Name:         name,
+			DisplayName:  os.Getenv(prefix + "DISPLAY_NAME"),
+			Issuer:       strings.TrimSuffix(os.Getenv(prefix+"ISSUER"), "/"),
+			ClientID:     os.Getenv(prefix + "CLIENT_ID"),
+			ClientSecret: os.Getenv(prefix + "CLIENT_SECRET"),
+			Scopes:       strings.Fields(os.Getenv(prefix + "SCOPES")),
+			RedirectURL:  callbackBaseURL + "/auth/oidc/" + url.PathEscape(name) + "/callback",
+		}
+		if cfg.Issuer == "" || cfg.ClientID == "" {
+			return nil, fmt.Errorf("%sISSUER and %sCLIENT_ID are required", prefix, prefix)
+		}
+		if cfg.DisplayName == "" {
+			cfg.DisplayName = name
+		}
+		if len(cfg.Scopes) == 0 {
+			cfg.Scopes = []string{"openid", "email", "profile"}
+		}
+		r.providers[name] = NewOIDCProvider(cfg, &http.Client{Timeout: 10 * time.Second})
+	}
+	return r, nil
+}
+
+// Get は名前に一致するプロバイダを返す。無い場合は ErrOIDCProviderNotFound を返す
+func (r *OIDCRegistry) Get(name string) (*OIDCProvider, error) {
+	p, ok := r.providers[name]
+	if !ok {
+		return nil, ErrOIDCProviderNotFound
+	}
+	return p, nil
+}
+
+// Providers は設定されたプロバイダを名前順に返す
+func (r *OIDCRegistry) Providers() []*OIDCProvider {
+	res := make([]*OIDCProvider, 0, len(r.providers))
+	for _, p := range r.providers {
+		res = append(res, p)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Config.Name < res[j].Config.Name })
+	return res
+}
+
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// OIDCProvider は 1 つのプロバイダとの認可コードフロー (PKCE) と ID トークンの検証を行う
+type OIDCProvider struct {
+	Config     OIDCProviderConfig
+	httpClient *http.Client
+
+	mu            sync.Mutex
+	discovery     *oidcDiscovery
+	keys          map[string]interface{}
+	keysFetchedAt time.Time
+}
+
+func NewOIDCProvider(cfg OIDCProviderConfig, httpClient *http.Client) *OIDCProvider {
+	return &OIDCProvider{Config: cfg, httpClient: httpClient}
+}
+
+// getDiscovery はディスカバリー文書を取得する。成功した結果はキャッシュし、失敗した場合は次回再取得する
+func (p *OIDCProvider) getDiscovery(ctx context.Context) (*oidcDiscovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	var d oidcDiscovery
+	if err := p.getJSON(ctx, p.Config.Issuer+"/.well-known/openid-configuration", &d); err != nil {
+		return nil, fmt.Errorf("oidc discovery: %w", err)
+	}
+	// 別の issuer の文書を返された場合に、その issuer のトークンを受け入れてしまわないようにする
+	if strings.TrimSuffix(d.Issuer, "/") != p.Config.Issuer {
+		return nil, fmt.Errorf("oidc discovery: issuer mismatch: %s", d.Issuer)
+	}
+	if d.AuthorizationEndpoint == "" || d.TokenEndpoint == "" || d.JWKSURI == "" {
+		return nil, fmt.Errorf("oidc discovery: missing endpoints")
+	}
+	p.discovery = &d
+	return p.discovery, nil
+}
+
+// AuthCodeURL は認可エンドポイントの URL を返す。codeVerifier から S256 の code_challenge を付与する
+func (p *OIDCProvider) AuthCodeURL(ctx context.Context, state string, nonce string, codeVerifier string) (string, error) {
+	d, err := p.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(d.AuthorizationEndpoint)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", p.Config.ClientID)
+	q.Set("redirect_uri", p.Config.RedirectURL)
+	q.Set("scope", strings.Join(p.Config.Scopes, " "))
+	q.Set("state", state)
+	q.Set("nonce", nonce)
+	q.Set("code_challenge", PKCEChallenge(codeVerifier))
+	q.Set("code_challenge_method", "S256")
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// PKCEChallenge は code_verifier から S256 の code_challenge を計算する
+func PKCEChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// Exchange は認可コードをトークンエンドポイントで ID トークンに交換する
+func (p *OIDCProvider) Exchange(ctx context.Context, code string, codeVerifier string) (string, error) {
+	d, err := p.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.Config.RedirectURL)
+	form.Set("code_verifier", codeVerifier)
+	if p.Config.ClientSecret == "" {
+		form.Set("client_id", p.Config.ClientID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.Config.ClientSecret != "" {
+		// client_secret_basic (RFC 6749 2.3.1 に従い URL エンコードする)
+		req.SetBasicAuth(url.QueryEscape(p.Config.ClientID), url.QueryEscape(p.Config.ClientSecret))
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("oidc token endpoint returned %d: %s", resp.StatusCode, body)
+	}
+
+	var res struct {
+		IDToken string `json:"id_token"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return "", err
+	}
+	if res.IDToken == "" {
+		return "", fmt.Errorf("oidc token response has no id_token")
+	}
+	return res.IDToken, nil
+}
+
+type oidcIDTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce           string      `json:"nonce"`
+	AuthorizedParty string      `json:"azp"`
+	Email           string      `json:"email"`
+	EmailVerified   interface{} `json:"email_verified"`
+	Name            string      `json:"name"`
+}
+
+// VerifyIDToken は ID トークンの署名 (JWKS)、issuer、audience、有効期限、nonce を検証する
+func (p *OIDCProvider) VerifyIDToken(ctx context.Context, rawIDToken string, nonce string) (*OIDCClaims, error) {
+	var claims oidcIDTokenClaims
+	_, err := jwt.ParseWithClaims(rawIDToken, &claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.getKey(ctx, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}),
+		jwt.WithIssuer(p.Config.Issuer),
+		jwt.WithAudience(p.Config.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(oidcClockSkew),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid id token: %w", err)
+	}
+
+	if claims.Nonce == "" || claims.Nonce != nonce {
+		return nil, fmt.Errorf("invalid id token: nonce mismatch")
+	}
+	// 複数の audience を含む場合は、azp がこのクライアントであることを確認する
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != p.Config.ClientID {
+		return nil, fmt.Errorf("invalid id token: azp mismatch")
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("invalid id token: missing sub")
+	}
+
+	return &OIDCClaims{
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: isTrue(claims.EmailVerified),
+		Name:          claims.Name,
+	}, nil
+}
+
+// isTrue は email_verified を真偽値に変換する。文字列の "true" を返すプロバイダもあるため両方に対応する
+func isTrue(v interface{}) bool {
+	switch b := v.(type) {
+	case bool:
+		return b
+	case string:
+		return strings.EqualFold(b, "true")
+	}
+	return false
+}
+
+// getKey は kid に一致する公開鍵を返す。キャッシュに無い、または期限が切れた場合は JWKS を再取得する
+func (p *OIDCProvider) getKey(ctx context.Context, kid string) (interface{}, error) {
+	d, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key, ok := p.keys[kid]
+	expired := time.Since(p.keysFetchedAt) >= oidcJWKSCacheTTL
+	if ok && !expired {
+		return key, nil
+	}
+	if !expired && time.Since(p.keysFetchedAt) < oidcJWKSMinRefreshInterval {
+		return nil, fmt.Errorf("unknown key id: %s", kid)
+	}
+
+	keys, err := p.fetchJWKS(ctx, d.JWKSURI)
+	if err != nil {
+		return nil, err
+	}
+	p.keys, p.keysFetchedAt = keys, time.Now()
+
+	key, ok = p.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id: %s", kid)
+	}
+	return key, nil
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (p *OIDCProvider) fetchJWKS(ctx context.Context, jwksURI string) (map[string]interface{}, error) {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := p.getJSON(ctx, jwksURI, &set); err != nil {
+		return nil, fmt.Errorf("oidc jwks: %w", err)
+	}
+
+	keys := map[string]interface{}{}
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			// 対応していない種類の鍵は無視する
+			continue
+		}
+		keys[k.Kid] = key
+	}
+	return keys, nil
+}
+
+func (k jsonWebKey) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		curves := map[string]elliptic.Curve{"P-256": elliptic.P256(), "P-384": elliptic.P384(), "P-521": elliptic.P521()}
+		curve, ok := curves[k.Crv]
+		if !ok {
+			return nil, fmt.Errorf("unsupported curve: %s", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	}
+	return nil, fmt.Errorf("unsupported key type: %s", k.Kty)
+}
+
+func (p *OIDCProvider) getJSON(ctx context.Context, target string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %d", target, resp.StatusCode)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(v)
+}
@@ -0,0 +1,116 @@
+package utils_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"todo-app/testutils"
+	"todo-app/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOIDCProvider_VerifyIDToken(t *testing.T) {
+	ctx := context.Background()
+	user := testutils.MockOIDCUser{Subject: "sub-1", Email: "user@example.com", EmailVerified: true, Name: "User"}
+
+	setup := func(t *testing.T) (*testutils.MockOIDCProvider, *utils.OIDCProvider) {
+		mock := testutils.NewMockOIDCProvider("todo-app", "secret")
+		t.Cleanup(mock.Close)
+		provider := utils.NewOIDCProvider(utils.OIDCProviderConfig{
+			Name:     "corp",
+			Issuer:   mock.Issuer(),
+			ClientID: "todo-app",
+		}, http.DefaultClient)
+		return mock, provider
+	}
+
+	t.Run("有効な ID トークンからクレームを返すこと", func(t *testing.T) {
+		mock, provider := setup(t)
+
+		claims, err := provider.VerifyIDToken(ctx, mock.SignIDToken(mock.IDTokenClaims(user, "nonce")), "nonce")
+		require.NoError(t, err)
+		assert.Equal(t, &utils.OIDCClaims{Subject: "sub-1", Email: "user@example.com", EmailVerified: true, Name: "User"}, claims)
+	})
+
+	t.Run("email_verified が文字列の場合も解釈すること", func(t *testing.T) {
+		mock, provider := setup(t)
+		c := mock.IDTokenClaims(user, "nonce")
+		c["email_verified"] = "true"
+
+		claims, err := provider.VerifyIDToken(ctx, mock.SignIDToken(c), "nonce")
+		require.NoError(t, err)
+		assert.True(t, claims.EmailVerified)
+	})
+
+	t.Run("不正なクレームの ID トークンを拒否すること", func(t *testing.T) {
+		mock, provider := setup(t)
+		cases := map[string]func(c map[string]interface{}){
+			"audience が異なる": func(c map[string]interface{}) { c["aud"] = "other-client" },
+			"issuer が異なる":   func(c map[string]interface{}) { c["iss"] = "https://evil.example.com" },
+			"有効期限が切れている":    func(c map[string]interface{}) { c["exp"] = time.Now().Add(-5 * time.Minute).Unix() },
+			"有効期限が無い":       func(c map[string]interface{}) { delete(c, "exp") },
+			"nonce が異なる":    func(c map[string]interface{}) { c["nonce"] = "other" },
+			"sub が無い":       func(c map[string]interface{}) { delete(c, "sub") },
+			"azp が別のクライアント": func(c map[string]interface{}) {
+				c["aud"] = []string{"todo-app", "other-client"}
+				c["azp"] = "other-client"
+			},
+		}
+		for name, modify := range cases {
+			c := mock.IDTokenClaims(user, "nonce")
+			modify(c)
+			_, err := provider.VerifyIDToken(ctx, mock.SignIDToken(c), "nonce")
+			assert.Error(t, err, name)
+		}
+	})
+
+	t.Run("JWKS をキャッシュし、直後の未知の kid では再取得しないこと", func(t *testing.T) {
+		mock, provider := setup(t)
+
+		for range 3 {
+			_, err := provider.VerifyIDToken(ctx, mock.SignIDToken(mock.IDTokenClaims(user, "nonce")), "nonce")
+			require.NoError(t, err)
+		}
+		assert.Equal(t, int32(1), mock.JWKSRequests.Load())
+
+		// 未知の kid で JWKS を何度も取得させられないよう、再取得の間隔を制限する
+		mock.RotateKey()
+		_, err := provider.VerifyIDToken(ctx, mock.SignIDToken(mock.IDTokenClaims(user, "nonce")), "nonce")
+		assert.Error(t, err)
+		assert.Equal(t, int32(1), mock.JWKSRequests.Load())
+	})
+
+	t.Run("JWKS の取得前に鍵が入れ替わっていても検証できること", func(t *testing.T) {
+		mock, provider := setup(t)
+		mock.RotateKey()
+
+		_, err := provider.VerifyIDToken(ctx, mock.SignIDToken(mock.IDTokenClaims(user, "nonce")), "nonce")
+		assert.NoError(t, err)
+	})
+}
+
+func TestOIDCProvider_AuthCodeURL(t *testing.T) {
+	mock := testutils.NewMockOIDCProvider("todo-app", "secret")
+	defer mock.Close()
+	provider := utils.NewOIDCProvider(utils.OIDCProviderConfig{
+		Name:        "corp",
+		Issuer:      mock.Issuer(),
+		ClientID:    "todo-app",
+		Scopes:      []string{"openid", "email"},
+		RedirectURL: "http://api.example.com/auth/oidc/corp/callback",
+	}, http.DefaultClient)
+
+	t.Run("PKCE の code_challenge を含む認可エンドポイントの URL を返すこと", func(t *testing.T) {
+		authURL, err := provider.AuthCodeURL(context.Background(), "state", "nonce", "verifier")
+		require.NoError(t, err)
+		assert.Contains(t, authURL, mock.Issuer()+"/authorize?")
+		assert.Contains(t, authURL, "code_challenge="+utils.PKCEChallenge("verifier"))
+		assert.Contains(t, authURL, "code_challenge_method=S256")
+		assert.Contains(t, authURL, "state=state")
+		assert.Contains(t, authURL, "nonce=nonce")
+	})
+}
@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"google.golang.org/genai"
+)
+
+// プロンプトに埋め込まれる現在日時は実行のたびに変わるため、フィクスチャのキーから除外する
+var currentTimePromptPattern = regexp.MustCompile(`現在\d{4}年\d{1,2}月\d{1,2}日\d{1,2}:\d{2}:\d{2}です。|The current time is \d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}\.`)
+
+// aiFixture は記録した Gemini とのやり取り1件分
+type aiFixture struct {
+	Model    string                         `json:"model"`
+	Prompt   string                         `json:"prompt"`
+	Response *genai.GenerateContentResponse `json:"response"`
+}
+
+func aiFixturePath(dir string, model string, contents []*genai.Content) (string, string) {
+	prompt := currentTimePromptPattern.ReplaceAllString(promptText(contents), "")
+	sum := sha256.Sum256([]byte(model + "\n" + prompt))
+	return filepath.Join(dir, hex.EncodeToString(sum[:8])+".json"), prompt
+}
+
+// RecordingGenAIClient は実際の API の応答をフィクスチャとして保存する IGenAIClient
+type RecordingGenAIClient struct {
+	client IGenAIClient
+	dir    string
+}
+
+func NewRecordingGenAIClient(client IGenAIClient, dir string) *RecordingGenAIClient {
+	return &RecordingGenAIClient{client: client, dir: dir}
+}
+
+// GenerateContent implements IGenAIClient
+func (c *RecordingGenAIClient) GenerateContent(ctx context.Context, model string, contents []*genai.Content, config *genai.GenerateContentConfig) (*genai.GenerateContentResponse, error) {
+	res, err := c.client.GenerateContent(ctx, model, contents, config)
+	if err != nil {
+		return nil, err
+	}
+
+	path, prompt := aiFixturePath(c.dir, model, contents)
+	data, err := json.MarshalIndent(aiFixture{Model: model, Prompt: prompt, Response: res}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(c.dir, 0o755); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ReplayGenAIClient は RecordingGenAIClient で保存したフィクスチャから応答を返す IGenAIClient
+type ReplayGenAIClient struct {
+	dir string
+}
+
+func NewReplayGenAIClient(dir string) *ReplayGenAIClient {
+	return &ReplayGenAIClient{dir: dir}
+}
+
+// GenerateContent implements IGenAIClient
+func (c *ReplayGenAIClient) GenerateContent(ctx context.Context, model string, contents []*genai.Content, config *genai.GenerateContentConfig) (*genai.GenerateContentResponse, error) {
+	path, _ := aiFixturePath(c.dir, model, contents)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("ai fixture not recorded: %s", path)
+		}
+		return nil, err
+	}
+
+	var fixture aiFixture
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		return nil, fmt.Errorf("invalid ai fixture %s: %w", path, err)
+	}
+	return fixture.Response, nil
+}
@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"context"
+	"sync"
+)
+
+// SharedCallGroup は singleflight と同様に、同じキーで同時に来た呼び出しを1回の実行にまとめる。
+// 待っている呼び出し元が全て context のキャンセルで離脱した場合は、実行中の処理の context もキャンセルする。
+type SharedCallGroup struct {
+	mu    sync.Mutex
+	calls map[string]*sharedCall
+}
+
+type sharedCall struct {
+	done    chan struct{}
+	val     interface{}
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// Do は key に対応する実行中の処理があればその結果を待ち、なければ fn を実行する。
+// fn には呼び出し元の値を引き継ぎ、待っている呼び出し元がいる間はキャンセルされない context が渡される。
+func (g *SharedCallGroup) Do(ctx context.Context, key string, fn func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*sharedCall)
+	}
+	call, ok := g.calls[key]
+	if !ok {
+		callCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		call = &sharedCall{done: make(chan struct{}), cancel: cancel}
+		g.calls[key] = call
+		go func() {
+			defer close(call.done)
+			defer cancel()
+			call.val, call.err = fn(callCtx)
+
+			g.mu.Lock()
+			if g.calls[key] == call {
+				delete(g.calls, key)
+			}
+			g.mu.Unlock()
+		}()
+	}
+	call.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.val, call.err
+	case <-ctx.Done():
+		g.mu.Lock()
+		call.waiters--
+		if call.waiters == 0 {
+			call.cancel()
+			// キャンセル済みの処理に後から来た呼び出しが合流しないようにする
+			if g.calls[key] == call {
+				delete(g.calls, key)
+			}
+		}
+		g.mu.Unlock()
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,109 @@
+package utils
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSharedCallGroup_Do(t *testing.T) {
+	t.Run("同時に来た同じキーの呼び出しは1回の実行を共有すること", func(t *testing.T) {
+		var g SharedCallGroup
+		var calls atomic.Int32
+		release := make(chan struct{})
+
+		const n = 5
+		var wg sync.WaitGroup
+		results := make([]interface{}, n)
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				v, err := g.Do(context.Background(), "key", func(ctx context.Context) (interface{}, error) {
+					calls.Add(1)
+					<-release
+					return "ok", nil
+				})
+				assert.NoError(t, err)
+				results[i] = v
+			}(i)
+		}
+
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		assert.Equal(t, int32(1), calls.Load())
+		for _, v := range results {
+			assert.Equal(t, "ok", v)
+		}
+	})
+
+	t.Run("待っている呼び出し元が全てキャンセルされた場合は実行中の処理もキャンセルされること", func(t *testing.T) {
+		var g SharedCallGroup
+		canceled := make(chan struct{})
+		started := make(chan struct{})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		errCh := make(chan error, 1)
+		go func() {
+			_, err := g.Do(ctx, "key", func(ctx context.Context) (interface{}, error) {
+				close(started)
+				<-ctx.Done()
+				close(canceled)
+				return nil, ctx.Err()
+			})
+			errCh <- err
+		}()
+
+		<-started
+		cancel()
+
+		assert.ErrorIs(t, <-errCh, context.Canceled)
+		select {
+		case <-canceled:
+		case <-time.After(time.Second):
+			t.Fatal("shared call was not canceled")
+		}
+	})
+
+	t.Run("他に待っている呼び出し元がいる場合は実行を継続すること", func(t *testing.T) {
+		var g SharedCallGroup
+		started := make(chan struct{})
+		release := make(chan struct{})
+		fn := func(ctx context.Context) (interface{}, error) {
+			close(started)
+			select {
+			case <-release:
+				return "ok", nil
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		firstErr := make(chan error, 1)
+		go func() {
+			_, err := g.Do(ctx, "key", fn)
+			firstErr <- err
+		}()
+		<-started
+
+		secondResult := make(chan interface{}, 1)
+		go func() {
+			v, _ := g.Do(context.Background(), "key", fn)
+			secondResult <- v
+		}()
+		time.Sleep(50 * time.Millisecond)
+
+		cancel()
+		assert.ErrorIs(t, <-firstErr, context.Canceled)
+
+		close(release)
+		assert.Equal(t, "ok", <-secondResult)
+	})
+}
@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v5"
+)
+
+// SSEWriter は Server-Sent Events 形式でイベントを送信する。
+// 最初のイベントを送信するまではヘッダーを書き込まないため、それまでは通常の JSON レスポンスを返せる。
+type SSEWriter struct {
+	c       *echo.Context
+	started bool
+}
+
+func NewSSEWriter(c *echo.Context) *SSEWriter {
+	return &SSEWriter{c: c}
+}
+
+// Started はストリームを開始済みかどうかを返す。
+func (w *SSEWriter) Started() bool {
+	return w.started
+}
+
+// Send はイベントを1件送信する。クライアントが切断済みの場合は context のエラーを返す。
+func (w *SSEWriter) Send(event string, data interface{}) error {
+	if err := w.c.Request().Context().Err(); err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	res := w.c.Response()
+	if !w.started {
+		res.Header().Set(echo.HeaderContentType, "text/event-stream")
+		res.Header().Set(echo.HeaderCacheControl, "no-cache")
+		res.Header().Set(echo.HeaderConnection, "keep-alive")
+		// リバースプロキシでバッファリングされないようにする
+		res.Header().Set("X-Accel-Buffering", "no")
+		res.WriteHeader(http.StatusOK)
+		w.started = true
+	}
+
+	if _, err := fmt.Fprintf(res, "event: %s\ndata: %s\n\n", event, b); err != nil {
+		return err
+	}
+	return http.NewResponseController(res).Flush()
+}
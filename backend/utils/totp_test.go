@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTOTP(t *testing.T) {
+	// RFC 6238 の付録 B のテストベクター (SHA-1) の下 6 桁
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+	t.Run("RFC 6238 のテストベクターと一致すること", func(t *testing.T) {
+		cases := map[int64]string{
+			59:          "287082",
+			1111111109:  "081804",
+			1111111111:  "050471",
+			1234567890:  "005924",
+			2000000000:  "279037",
+			20000000000: "353130",
+		}
+		for unix, want := range cases {
+			code, err := TOTPCode(secret, TOTPStep(time.Unix(unix, 0)))
+			assert.NoError(t, err)
+			assert.Equal(t, want, code, unix)
+		}
+	})
+
+	t.Run("前後 1 ステップのコードを受け付け、それより離れたコードは拒否すること", func(t *testing.T) {
+		now := time.Unix(1234567890, 0)
+		step := TOTPStep(now)
+		for _, d := range []int64{-1, 0, 1} {
+			code, _ := TOTPCode(secret, step+d)
+			got, ok := ValidateTOTP(secret, code, now, 0)
+			assert.True(t, ok)
+			assert.Equal(t, step+d, got)
+		}
+		for _, d := range []int64{-2, 2} {
+			code, _ := TOTPCode(secret, step+d)
+			_, ok := ValidateTOTP(secret, code, now, 0)
+			assert.False(t, ok)
+		}
+	})
+
+	t.Run("使用済みのステップ以前のコードは拒否すること", func(t *testing.T) {
+		now := time.Unix(1234567890, 0)
+		code, _ := TOTPCode(secret, TOTPStep(now))
+
+		_, ok := ValidateTOTP(secret, code, now, TOTPStep(now))
+		assert.False(t, ok)
+		_, ok = ValidateTOTP(secret, code, now, TOTPStep(now)-1)
+		assert.True(t, ok)
+	})
+
+	t.Run("プロビジョニング URI に必要なパラメータを含むこと", func(t *testing.T) {
+		uri := TOTPProvisioningURI("Todo App", "user@example.com", secret)
+		assert.True(t, strings.HasPrefix(uri, "otpauth://totp/Todo%20App:user@example.com?"), uri)
+		assert.Contains(t, uri, "secret="+secret)
+		assert.Contains(t, uri, "issuer=Todo+App")
+		assert.Contains(t, uri, "digits=6")
+		assert.Contains(t, uri, "period=30")
+	})
+}
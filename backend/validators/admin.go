@@ -0,0 +1,51 @@
+package validators
+
+// 管理者の一覧の API で limit を指定しない場合の件数
+const DefaultAdminListLimit = 20
+
+type ListAdminUsersRequest struct {
+	// メールアドレスか名前の一部。空の場合は全てのユーザー
+	Q     string `query:"q" validate:"max=255"`
+	Page  int    `query:"page" validate:"omitempty,min=1"`
+	Limit int    `query:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+func (r *ListAdminUsersRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
+
+type ListAdminAuditLogsRequest struct {
+	// 指定した場合はそのユーザーに対する操作に絞り込む
+	UserID int `query:"user_id" validate:"omitempty,min=1"`
+	Page   int `query:"page" validate:"omitempty,min=1"`
+	Limit  int `query:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+func (r *ListAdminAuditLogsRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
+
+// pageAndLimit はページと件数の指定が無い場合に既定値を返す
+func pageAndLimit(page int, limit int) (int, int) {
+	if page == 0 {
+		page = 1
+	}
+	if limit == 0 {
+		limit = DefaultAdminListLimit
+	}
+	return page, limit
+}
+
+func (r *ListAdminUsersRequest) GetPageAndLimit() (int, int) {
+	return pageAndLimit(r.Page, r.Limit)
+}
+
+func (r *ListAdminAuditLogsRequest) GetPageAndLimit() (int, int) {
+	return pageAndLimit(r.Page, r.Limit)
+}
@@ -11,3 +11,90 @@ func (r *LoginRequest) Validate() map[string]string {
 	}
 	return nil
 }
+
+type MFALoginRequest struct {
+	// OIDC でログインした場合は省略し、Cookie で送信する
+	MFAToken string `json:"mfa_token" validate:"max=1000"`
+	// 認証アプリのコード、またはリカバリーコード
+	Code string `json:"code" validate:"required,max=32"`
+}
+
+func (r *MFALoginRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
+
+type RegisterRequest struct {
+	Name     string `json:"name" validate:"required,max=100"`
+	Email    string `json:"email" validate:"required,email,max=255"`
+	Password string `json:"password" validate:"required,password"`
+	// 招待制 (REGISTRATION_MODE=invite) の場合に必要
+	InviteCode string `json:"invite_code" validate:"max=100"`
+}
+
+func (r *RegisterRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" validate:"required"`
+	NewPassword     string `json:"new_password" validate:"required,password"`
+}
+
+func (r *ChangePasswordRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+func (r *ForgotPasswordRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token" validate:"required,max=100"`
+	NewPassword string `json:"new_password" validate:"required,password"`
+}
+
+func (r *ResetPasswordRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
+
+type ConfirmEmailChangeRequest struct {
+	Token string `json:"token" validate:"required,max=100"`
+}
+
+func (r *ConfirmEmailChangeRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
+
+// CancelEmailChangeRequest は変更前のメールアドレスに送信したリンクから、メールアドレスの変更を取り消す
+type CancelEmailChangeRequest struct {
+	Token string `json:"token" validate:"required,max=100"`
+}
+
+func (r *CancelEmailChangeRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
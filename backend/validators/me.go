@@ -0,0 +1,110 @@
+package validators
+
+type CreatePersonalAccessTokenRequest struct {
+	Name   string   `json:"name" validate:"required,max=100"`
+	Scopes []string `json:"scopes" validate:"required,min=1,unique,dive,oneof=read write ai"`
+	// 未指定の場合は無期限
+	ExpiresInDays *int `json:"expires_in_days" validate:"omitempty,min=1,max=365"`
+}
+
+func (r *CreatePersonalAccessTokenRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
+
+// ConfirmTOTPRequest は認証アプリに表示されたコードで、2 段階認証の登録の確認やリカバリーコードの再発行を行う
+type ConfirmTOTPRequest struct {
+	Code string `json:"code" validate:"required,numeric,len=6"`
+}
+
+func (r *ConfirmTOTPRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
+
+type DisableTOTPRequest struct {
+	Password string `json:"password" validate:"required"`
+}
+
+func (r *DisableTOTPRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
+
+// DeleteMeRequest は退会の申請。本人であることをパスワードで確認する
+type DeleteMeRequest struct {
+	Password string `json:"password" validate:"required"`
+}
+
+func (r *DeleteMeRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
+
+// ConfirmDeletionRequest は退会の確認メールのリンクから、退会を申請する
+type ConfirmDeletionRequest struct {
+	Token string `json:"token" validate:"required,max=100"`
+}
+
+func (r *ConfirmDeletionRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
+
+// RequestEmailChangeRequest はパスワードを使わずに、メールアドレスの変更を申請する
+type RequestEmailChangeRequest struct {
+	Email string `json:"email" validate:"required,email,max=255"`
+}
+
+func (r *RequestEmailChangeRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
+
+// AuthorizeEmailChangeRequest は変更前のメールアドレスに送信したリンクから、メールアドレスの変更を申請する
+type AuthorizeEmailChangeRequest struct {
+	Token string `json:"token" validate:"required,max=100"`
+}
+
+func (r *AuthorizeEmailChangeRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
+
+// UpdateMeRequest はプロフィールの変更。指定しなかったフィールドは変更しない
+type UpdateMeRequest struct {
+	Name *string `json:"name" validate:"omitnil,min=1,max=100"`
+	// 新しいメールアドレスに確認のメールを送信し、リンクから確認した時点で変更する
+	Email       *string                   `json:"email" validate:"omitnil,email,max=255"`
+	Locale      *string                   `json:"locale" validate:"omitnil,oneof=ja en"`
+	TimeZone    *string                   `json:"time_zone" validate:"omitnil,min=1,max=64,timezone"`
+	Preferences *UpdatePreferencesRequest `json:"preferences"`
+	// メールアドレスを変更する場合は必須。パスワードを持たないユーザーは POST /me/email/request で変更する
+	CurrentPassword string `json:"current_password"`
+}
+
+type UpdatePreferencesRequest struct {
+	TodoPageSize    *int  `json:"todo_page_size" validate:"omitnil,min=1,max=100"`
+	TodoIncludeDone *bool `json:"todo_include_done"`
+}
+
+func (r *UpdateMeRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
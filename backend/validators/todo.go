@@ -1,8 +1,12 @@
 package validators
 
+import "time"
+
 type CreateTodoRequest struct {
 	Title       string `json:"title" validate:"required,max=100"`
 	Description string `json:"description" validate:"max=200"`
+	// 指定した場合は共有のリストに追加する。editor 以上の権限が必要
+	ListID *int `json:"list_id" validate:"omitempty,min=1"`
 }
 
 func (r *CreateTodoRequest) Validate() map[string]string {
@@ -34,3 +38,103 @@ func (r *UpdateDoneStatusRequest) Validate() map[string]string {
 	}
 	return nil
 }
+
+const (
+	AICreateTodoModeDryRun = "dry_run"
+	AICreateTodoModeCommit = "commit"
+)
+
+type AICreateTodoRequest struct {
+	Text string `json:"text" validate:"required_without=Drafts,max=400"`
+	Mode string `json:"mode" validate:"omitempty,oneof=dry_run commit"`
+	// dry_run で確認済みの下書きをそのまま登録する場合に指定する
+	Drafts []CreateTodoRequest `json:"drafts"`
+}
+
+func (r *AICreateTodoRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
+
+func (r *AICreateTodoRequest) IsDryRun() bool {
+	return r.Mode != AICreateTodoModeCommit
+}
+
+type AcceptTodoBreakdownRequest struct {
+	StepIndexes []int `json:"step_indexes" validate:"required,min=1,dive,min=0"`
+}
+
+func (r *AcceptTodoBreakdownRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
+
+type TodoSummaryRequest struct {
+	From    string `json:"from" query:"from" validate:"required"`
+	To      string `json:"to" query:"to" validate:"required"`
+	Refresh bool   `json:"refresh" query:"refresh"`
+}
+
+func (r *TodoSummaryRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	if _, _, errorMessages := r.Range(); errorMessages != nil {
+		return errorMessages
+	}
+	return nil
+}
+
+// Range は from / to を期間に変換する。
+// 日付のみ (YYYY-MM-DD) の場合、to はその日の終わりまでを含める。
+func (r *TodoSummaryRequest) Range() (time.Time, time.Time, map[string]string) {
+	from, _, err := parseTodoSummaryTime(r.From)
+	if err != nil {
+		return time.Time{}, time.Time{}, map[string]string{"from": "fromはRFC3339またはYYYY-MM-DD形式で指定してください"}
+	}
+	to, toDateOnly, err := parseTodoSummaryTime(r.To)
+	if err != nil {
+		return time.Time{}, time.Time{}, map[string]string{"to": "toはRFC3339またはYYYY-MM-DD形式で指定してください"}
+	}
+	if toDateOnly {
+		to = to.AddDate(0, 0, 1).Add(-time.Second)
+	}
+	if from.After(to) {
+		return time.Time{}, time.Time{}, map[string]string{"to": "toはfrom以降の日時を指定してください"}
+	}
+	return from, to, nil
+}
+
+func parseTodoSummaryTime(value string) (time.Time, bool, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, false, nil
+	}
+	t, err := time.ParseInLocation(time.DateOnly, value, time.Local)
+	return t, true, err
+}
+
+const DefaultSemanticSearchLimit = 10
+
+type SemanticSearchRequest struct {
+	Q     string `json:"q" query:"q" validate:"required,max=200"`
+	Limit int    `json:"limit" query:"limit" validate:"omitempty,min=1,max=50"`
+}
+
+func (r *SemanticSearchRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
+
+// GetLimit は件数の指定が無い場合に既定の件数を返す
+func (r *SemanticSearchRequest) GetLimit() int {
+	if r.Limit == 0 {
+		return DefaultSemanticSearchLimit
+	}
+	return r.Limit
+}
@@ -0,0 +1,57 @@
+package validators
+
+type CreateTodoListRequest struct {
+	Name string `json:"name" validate:"required,max=64"`
+}
+
+func (r *CreateTodoListRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
+
+type UpdateTodoListRequest struct {
+	Name string `json:"name" validate:"required,max=64"`
+}
+
+func (r *UpdateTodoListRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
+
+type InviteTodoListMemberRequest struct {
+	Email string `json:"email" validate:"required,email,max=255"`
+	Role  string `json:"role" validate:"required,oneof=viewer editor owner"`
+}
+
+func (r *InviteTodoListMemberRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
+
+type UpdateTodoListMemberRequest struct {
+	Role string `json:"role" validate:"required,oneof=viewer editor owner"`
+}
+
+func (r *UpdateTodoListMemberRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
+
+type AcceptTodoListInviteRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+func (r *AcceptTodoListInviteRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
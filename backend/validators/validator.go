@@ -7,6 +7,13 @@ import (
 	ja_translations "github.com/go-playground/validator/v10/translations/ja"
 	"reflect"
 	"strings"
+	"unicode"
+)
+
+const (
+	PasswordMinLength = 8
+	// bcrypt は 72 バイトを超える部分を無視するため、それ以上は受け付けない
+	PasswordMaxBytes = 72
 )
 
 var (
@@ -20,6 +27,22 @@ func init() {
 	translator, _ = uni.GetTranslator("ja")
 	_ = ja_translations.RegisterDefaultTranslations(validate, translator)
 
+	// ja_translations に含まれないタグの翻訳を追加
+	_ = validate.RegisterTranslation("required_without", translator, func(ut ut.Translator) error {
+		return ut.Add("required_without", "{0}は必須フィールドです", true)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		t, _ := ut.T("required_without", fe.Field())
+		return t
+	})
+
+	_ = validate.RegisterValidation("password", validatePassword)
+	_ = validate.RegisterTranslation("password", translator, func(ut ut.Translator) error {
+		return ut.Add("password", "{0}は8文字以上72バイト以下で、英字と数字を両方含めてください", true)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		t, _ := ut.T("password", fe.Field())
+		return t
+	})
+
 	// Use JSON tag as field name
 	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
 		name := strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
@@ -46,3 +69,23 @@ func TranslateError(err error) map[string]string {
 	}
 	return errorMessages
 }
+
+// validatePassword はパスワードの強度を検証する。
+// 8 文字以上 72 バイト以下で、英字と数字をそれぞれ 1 文字以上含む必要がある
+func validatePassword(fl validator.FieldLevel) bool {
+	password := fl.Field().String()
+	if len([]rune(password)) < PasswordMinLength || len(password) > PasswordMaxBytes {
+		return false
+	}
+
+	hasLetter, hasDigit := false, false
+	for _, r := range password {
+		switch {
+		case unicode.IsLetter(r):
+			hasLetter = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		}
+	}
+	return hasLetter && hasDigit
+}
@@ -0,0 +1,57 @@
+package validators
+
+type CreateWorkspaceRequest struct {
+	Name string `json:"name" validate:"required,max=64"`
+}
+
+func (r *CreateWorkspaceRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
+
+type UpdateWorkspaceRequest struct {
+	Name string `json:"name" validate:"required,max=64"`
+}
+
+func (r *UpdateWorkspaceRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
+
+type InviteWorkspaceMemberRequest struct {
+	Email string `json:"email" validate:"required,email,max=255"`
+	Role  string `json:"role" validate:"required,oneof=member admin"`
+}
+
+func (r *InviteWorkspaceMemberRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
+
+type UpdateWorkspaceMemberRequest struct {
+	Role string `json:"role" validate:"required,oneof=member admin"`
+}
+
+func (r *UpdateWorkspaceMemberRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
+
+type AcceptWorkspaceInviteRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+func (r *AcceptWorkspaceInviteRequest) Validate() map[string]string {
+	if err := validate.Struct(r); err != nil {
+		return TranslateError(err)
+	}
+	return nil
+}
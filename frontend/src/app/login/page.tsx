@@ -13,7 +13,7 @@ import { authService } from "@/services/auth";
 import { zodResolver } from "@hookform/resolvers/zod";
 import { isAxiosError } from "axios";
 import { useRouter } from "next/navigation";
-import { useState } from "react";
+import { type FormEvent, useEffect, useState } from "react";
 import { useForm } from "react-hook-form";
 import { toast } from "sonner";
 import { z } from "zod";
@@ -28,6 +28,17 @@ type LoginFormValues = z.infer<typeof loginSchema>;
 export default function LoginPage() {
   const router = useRouter();
   const [isLoading, setIsLoading] = useState(false);
+  // 2 段階認証のコードの入力待ちの場合に、/auth/login で発行されたトークン。
+  // OIDC でログインした場合は HttpOnly の Cookie で渡されるため、空文字列にする
+  const [mfaToken, setMfaToken] = useState<string | null>(null);
+  const [mfaCode, setMfaCode] = useState("");
+
+  // OIDC でログインした場合は、2 段階認証が必要であることがクエリパラメータで渡される
+  useEffect(() => {
+    if (new URLSearchParams(window.location.search).get("mfa_required")) {
+      setMfaToken("");
+    }
+  }, []);
 
   const {
     register,
@@ -45,7 +56,11 @@ export default function LoginPage() {
     setIsLoading(true);
 
     try {
-      await authService.login(data);
+      const res = await authService.login(data);
+      if (res.mfa_required && res.mfa_token) {
+        setMfaToken(res.mfa_token);
+        return;
+      }
       toast.success("Login successful");
       router.push("/");
     } catch (error) {
@@ -60,6 +75,73 @@ export default function LoginPage() {
     }
   };
 
+  const onSubmitMFA = async (e: FormEvent) => {
+    e.preventDefault();
+    if (mfaToken === null) return;
+    setIsLoading(true);
+
+    try {
+      await authService.loginMFA({
+        ...(mfaToken ? { mfa_token: mfaToken } : {}),
+        code: mfaCode.trim(),
+      });
+      toast.success("Login successful");
+      router.push("/");
+    } catch (error) {
+      console.error(error);
+      if (isAxiosError(error)) {
+        toast.error(error.response?.data?.error || "Verification failed");
+      } else {
+        toast.error("Verification failed");
+      }
+    } finally {
+      setIsLoading(false);
+    }
+  };
+
+  if (mfaToken !== null) {
+    return (
+      <div className="flex items-center justify-center px-4 w-full flex-1">
+        <Card className="w-full max-w-md">
+          <CardHeader className="space-y-1">
+            <CardTitle className="text-2xl font-bold text-center">
+              Two-factor authentication
+            </CardTitle>
+            <CardDescription className="text-center">
+              Enter the code from your authenticator app or a recovery code
+            </CardDescription>
+          </CardHeader>
+          <CardContent>
+            <form onSubmit={onSubmitMFA} className="space-y-4">
+              <div className="space-y-2">
+                <label
+                  htmlFor="mfa-code"
+                  className="text-sm font-medium leading-none peer-disabled:cursor-not-allowed peer-disabled:opacity-70"
+                >
+                  Code
+                </label>
+                <Input
+                  id="mfa-code"
+                  autoComplete="one-time-code"
+                  value={mfaCode}
+                  onChange={(e) => setMfaCode(e.target.value)}
+                  disabled={isLoading}
+                />
+              </div>
+              <Button
+                className="w-full"
+                type="submit"
+                disabled={isLoading || mfaCode.trim() === ""}
+              >
+                {isLoading ? "Verifying..." : "Verify"}
+              </Button>
+            </form>
+          </CardContent>
+        </Card>
+      </div>
+    );
+  }
+
   return (
     <div className="flex items-center justify-center px-4 w-full flex-1">
       <Card className="w-full max-w-md">
@@ -7,7 +7,7 @@ import { TodoModal } from "@/components/todo/TodoModal";
 import { Button } from "@/components/ui/button";
 import { Checkbox } from "@/components/ui/checkbox";
 import { Label } from "@/components/ui/label";
-import { useAIFilter } from "@/hooks/useAIFilter";
+import { describeAIFilter, useAIFilter } from "@/hooks/useAIFilter";
 import { useTodos } from "@/hooks/useTodos";
 import { Plus } from "lucide-react";
 import { useRouter, useSearchParams } from "next/navigation";
@@ -22,6 +22,7 @@ function HomeContent() {
   const {
     isFiltering,
     activeFilter,
+    aiFilterResult,
     filterHistories,
     handleAIFilter,
     handleHistoryFilter,
@@ -73,6 +74,7 @@ function HomeContent() {
     <div className="space-y-4">
       <AIFilterBar
         activeFilter={activeFilter}
+        interpretation={aiFilterResult && describeAIFilter(aiFilterResult)}
         filterHistories={filterHistories}
         onSearchAIFilter={(query) => handleAIFilter(query, currentPage)}
         onSearchHistory={(history) => handleHistoryFilter(history, currentPage)}
@@ -111,9 +113,11 @@ function HomeContent() {
         ))
       ) : (
         <div className="text-center text-muted-foreground p-8">
-          {activeFilter
-            ? "該当するToDoは見つかりませんでした。"
-            : "No todos found. Add one!"}
+          {aiFilterResult?.status === "no_tool"
+            ? "AIが条件を解釈できませんでした。別の言い方で試してください。"
+            : activeFilter
+              ? "該当するToDoは見つかりませんでした。"
+              : "No todos found. Add one!"}
         </div>
       )}
 
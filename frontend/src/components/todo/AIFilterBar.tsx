@@ -14,6 +14,7 @@ import { useState } from "react";
 export interface AIFilterBarProps {
   filterHistories: TodoFilterHistoryQuery[];
   activeFilter: TodoFilterHistoryQuery | null;
+  interpretation?: string | null;
   onSearchAIFilter: (query: string) => void;
   onSearchHistory: (history: TodoFilterHistoryQuery) => void;
   onClearFilter: () => void;
@@ -22,6 +23,7 @@ export interface AIFilterBarProps {
 export function AIFilterBar({
   filterHistories,
   activeFilter,
+  interpretation,
   onSearchAIFilter,
   onSearchHistory,
   onClearFilter,
@@ -127,6 +129,12 @@ export function AIFilterBar({
           </button>
         </Badge>
       )}
+
+      {activeFilter && interpretation && (
+        <p className="text-sm text-muted-foreground">
+          解釈: {interpretation}
+        </p>
+      )}
     </div>
   );
 }
@@ -3,6 +3,7 @@
 import { api } from "@/lib/api";
 import { showToast } from "@/lib/toast";
 import {
+  AIFilterResponse,
   ListTodoFilterHistoriesResponse,
   Todo,
   TodoFilterHistoryQuery,
@@ -17,6 +18,34 @@ const aiFilterSchema = z
   .min(1, "入力してください")
   .max(50, "50文字以内で入力してください");
 
+const formatDateTime = (value: string) =>
+  new Date(value).toLocaleString("ja-JP", {
+    dateStyle: "medium",
+    timeStyle: "short",
+  });
+
+// AI がクエリをどう解釈したかを表示用の文言にする
+export function describeAIFilter(result: AIFilterResponse): string | null {
+  if (result.status === "no_tool") {
+    return result.text || "条件を解釈できませんでした";
+  }
+  if (result.function_name === "ListTodosByDoneAt" && result.resolved_args) {
+    const from = result.resolved_args.done_from;
+    const to = result.resolved_args.done_to;
+    if (from && to) {
+      return `完了日時が ${formatDateTime(from)} 〜 ${formatDateTime(to)}`;
+    }
+    if (from) {
+      return `完了日時が ${formatDateTime(from)} 以降`;
+    }
+    if (to) {
+      return `完了日時が ${formatDateTime(to)} 以前`;
+    }
+    return "完了したすべての ToDo";
+  }
+  return null;
+}
+
 interface UseAIFilterProps {
   onFilterSuccess: (todos: Todo[]) => void;
   onClear: () => void;
@@ -26,6 +55,8 @@ export function useAIFilter({ onFilterSuccess, onClear }: UseAIFilterProps) {
   const [isFiltering, setIsFiltering] = useState(false);
   const [activeFilter, setActiveFilter] =
     useState<TodoFilterHistoryQuery | null>(null);
+  const [aiFilterResult, setAIFilterResult] =
+    useState<AIFilterResponse | null>(null);
   const [filterHistories, setFilterHistories] = useState<
     TodoFilterHistoryQuery[]
   >([]);
@@ -55,11 +86,12 @@ export function useAIFilter({ onFilterSuccess, onClear }: UseAIFilterProps) {
 
       setIsFiltering(true);
       try {
-        const res = await api.get<Todo[]>("/todo/ai_filter", {
+        const res = await api.get<AIFilterResponse>("/todo/ai_filter", {
           params: { query: validation.data },
         });
-        onFilterSuccess(res.data);
-        setActiveFilter({ id: "custom", query: validation.data });
+        onFilterSuccess(res.data.todos);
+        setAIFilterResult(res.data);
+        setActiveFilter({ id: res.data.query_id, query: validation.data });
         if (currentPage !== 1) {
           router.push("/?page=1");
         }
@@ -81,6 +113,7 @@ export function useAIFilter({ onFilterSuccess, onClear }: UseAIFilterProps) {
           params: { query_id: history.id },
         });
         onFilterSuccess(res.data);
+        setAIFilterResult(null);
         setActiveFilter(history);
         if (currentPage !== 1) {
           router.push("/?page=1");
@@ -97,12 +130,14 @@ export function useAIFilter({ onFilterSuccess, onClear }: UseAIFilterProps) {
 
   const clearFilter = useCallback(() => {
     setActiveFilter(null);
+    setAIFilterResult(null);
     onClear();
   }, [onClear]);
 
   return {
     isFiltering,
     activeFilter,
+    aiFilterResult,
     filterHistories,
     handleAIFilter,
     handleHistoryFilter,
@@ -17,12 +17,49 @@ api.interceptors.request.use((config) => {
   return config;
 });
 
+// 同時に複数のリクエストが 401 になっても、リフレッシュは 1 回だけ行う
+// (使用済みのリフレッシュトークンを再度送るとセッションごと失効するため)
+let refreshing: Promise<void> | null = null;
+
+const refreshAccessToken = () => {
+  if (!refreshing) {
+    refreshing = api
+      .post("/auth/refresh", undefined, { _skipRefresh: true })
+      .then(() => undefined)
+      .finally(() => {
+        refreshing = null;
+      });
+  }
+  return refreshing;
+};
+
 api.interceptors.response.use(
   (response) => response,
-  (error) => {
+  async (error) => {
+    const config = error.config;
+    if (
+      error.response?.status === 401 &&
+      config &&
+      !config._skipRefresh &&
+      !config._retried
+    ) {
+      try {
+        await refreshAccessToken();
+        return api({ ...config, _retried: true });
+      } catch {
+        // リフレッシュに失敗した場合は下でログイン画面に遷移する
+      }
+    }
     if (error.response?.status === 401) {
       window.location.href = "/login";
     }
     return Promise.reject(error);
   },
 );
+
+declare module "axios" {
+  interface AxiosRequestConfig {
+    _skipRefresh?: boolean;
+    _retried?: boolean;
+  }
+}
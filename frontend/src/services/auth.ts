@@ -5,9 +5,31 @@ export interface LoginRequest {
   password: string;
 }
 
+export interface LoginResponse {
+  message?: string;
+  // 2 段階認証を有効にしている場合は、mfa_token とコードで loginMFA を呼び出す
+  mfa_required?: boolean;
+  mfa_token?: string;
+}
+
+export interface LoginMFARequest {
+  // OIDC でログインした場合は省略し、Cookie で送信する
+  mfa_token?: string;
+  // 認証アプリのコード、またはリカバリーコード
+  code: string;
+}
+
 export const authService = {
-  login: async (data: LoginRequest) => {
-    const response = await api.post("/auth/login", data);
+  login: async (data: LoginRequest): Promise<LoginResponse> => {
+    const response = await api.post("/auth/login", data, {
+      _skipRefresh: true,
+    });
+    return response.data;
+  },
+  loginMFA: async (data: LoginMFARequest) => {
+    const response = await api.post("/auth/login/mfa", data, {
+      _skipRefresh: true,
+    });
     return response.data;
   },
 };
@@ -28,3 +28,15 @@ export interface TodoFilterHistoryQuery {
 export interface ListTodoFilterHistoriesResponse {
   queries: TodoFilterHistoryQuery[];
 }
+
+export type AIFilterStatus = "matched" | "no_results" | "no_tool";
+
+export interface AIFilterResponse {
+  status: AIFilterStatus;
+  query_id: string;
+  function_name: string | null;
+  args: Record<string, unknown> | null;
+  resolved_args: Record<string, string | null> | null;
+  text: string;
+  todos: Todo[];
+}